@@ -68,10 +68,17 @@ type Routes struct {
 	Plugin  *mux.Router // 'api/v4/plugins/{plugin_id:[A-Za-z0-9\\_\\-\\.]+}'
 
 	PublicFile *mux.Router // '/files/{file_id:[A-Za-z0-9]+}/public'
+	SharedFile *mux.Router // '/files/shared/{link_id:[A-Za-z0-9]+}'
 
 	Commands *mux.Router // 'api/v4/commands'
 	Command  *mux.Router // 'api/v4/commands/{command_id:[A-Za-z0-9]+}'
 
+	AutomationRulesForTeam *mux.Router // 'api/v4/teams/{team_id:[A-Za-z0-9]+}/automation_rules'
+	AutomationRule         *mux.Router // 'api/v4/automation_rules/{rule_id:[A-Za-z0-9]+}'
+
+	CustomProfileAttributeFields *mux.Router // 'api/v4/custom_profile_attributes/fields'
+	CustomProfileAttributeField  *mux.Router // 'api/v4/custom_profile_attributes/fields/{field_id:[A-Za-z0-9]+}'
+
 	Hooks         *mux.Router // 'api/v4/hooks'
 	IncomingHooks *mux.Router // 'api/v4/hooks/incoming'
 	IncomingHook  *mux.Router // 'api/v4/hooks/incoming/{hook_id:[A-Za-z0-9]+}'
@@ -125,6 +132,7 @@ type Routes struct {
 	Cloud *mux.Router // 'api/v4/cloud'
 
 	Imports *mux.Router // 'api/v4/imports'
+	Import  *mux.Router // 'api/v4/imports/{import_name:.+\\.zip}'
 
 	Exports *mux.Router // 'api/v4/exports'
 	Export  *mux.Router // 'api/v4/exports/{export_name:.+\\.zip}'
@@ -207,6 +215,7 @@ func Init(srv *app.Server) (*API, error) {
 	api.BaseRoutes.Files = api.BaseRoutes.APIRoot.PathPrefix("/files").Subrouter()
 	api.BaseRoutes.File = api.BaseRoutes.Files.PathPrefix("/{file_id:[A-Za-z0-9]+}").Subrouter()
 	api.BaseRoutes.PublicFile = api.BaseRoutes.Root.PathPrefix("/files/{file_id:[A-Za-z0-9]+}/public").Subrouter()
+	api.BaseRoutes.SharedFile = api.BaseRoutes.Root.PathPrefix("/files/shared/{link_id:[A-Za-z0-9]+}").Subrouter()
 
 	api.BaseRoutes.Uploads = api.BaseRoutes.APIRoot.PathPrefix("/uploads").Subrouter()
 	api.BaseRoutes.Upload = api.BaseRoutes.Uploads.PathPrefix("/{upload_id:[A-Za-z0-9]+}").Subrouter()
@@ -217,6 +226,12 @@ func Init(srv *app.Server) (*API, error) {
 	api.BaseRoutes.Commands = api.BaseRoutes.APIRoot.PathPrefix("/commands").Subrouter()
 	api.BaseRoutes.Command = api.BaseRoutes.Commands.PathPrefix("/{command_id:[A-Za-z0-9]+}").Subrouter()
 
+	api.BaseRoutes.AutomationRulesForTeam = api.BaseRoutes.Team.PathPrefix("/automation_rules").Subrouter()
+	api.BaseRoutes.AutomationRule = api.BaseRoutes.APIRoot.PathPrefix("/automation_rules/{rule_id:[A-Za-z0-9]+}").Subrouter()
+
+	api.BaseRoutes.CustomProfileAttributeFields = api.BaseRoutes.APIRoot.PathPrefix("/custom_profile_attributes/fields").Subrouter()
+	api.BaseRoutes.CustomProfileAttributeField = api.BaseRoutes.CustomProfileAttributeFields.PathPrefix("/{field_id:[A-Za-z0-9]+}").Subrouter()
+
 	api.BaseRoutes.Hooks = api.BaseRoutes.APIRoot.PathPrefix("/hooks").Subrouter()
 	api.BaseRoutes.IncomingHooks = api.BaseRoutes.Hooks.PathPrefix("/incoming").Subrouter()
 	api.BaseRoutes.IncomingHook = api.BaseRoutes.IncomingHooks.PathPrefix("/{hook_id:[A-Za-z0-9]+}").Subrouter()
@@ -260,6 +275,7 @@ func Init(srv *app.Server) (*API, error) {
 	api.BaseRoutes.Cloud = api.BaseRoutes.APIRoot.PathPrefix("/cloud").Subrouter()
 
 	api.BaseRoutes.Imports = api.BaseRoutes.APIRoot.PathPrefix("/imports").Subrouter()
+	api.BaseRoutes.Import = api.BaseRoutes.Imports.PathPrefix("/{import_name:.+\\.zip}").Subrouter()
 	api.BaseRoutes.Exports = api.BaseRoutes.APIRoot.PathPrefix("/exports").Subrouter()
 	api.BaseRoutes.Export = api.BaseRoutes.Exports.PathPrefix("/{export_name:.+\\.zip}").Subrouter()
 
@@ -293,6 +309,7 @@ func Init(srv *app.Server) (*API, error) {
 	api.InitSystem()
 	api.InitLicense()
 	api.InitConfig()
+	api.InitFeatureFlag()
 	api.InitWebhook()
 	api.InitPreference()
 	api.InitSaml()
@@ -305,6 +322,9 @@ func Init(srv *app.Server) (*API, error) {
 	api.InitBrand()
 	api.InitJob()
 	api.InitCommand()
+	api.InitAutomationRule()
+	api.InitCustomProfileAttribute()
+	api.InitUserManager()
 	api.InitStatus()
 	api.InitWebSocket()
 	api.InitEmoji()
@@ -332,6 +352,10 @@ func Init(srv *app.Server) (*API, error) {
 	api.InitLimits()
 	api.InitOutgoingOAuthConnection()
 	api.InitClientPerformanceMetrics()
+	api.InitInboundEmail()
+	api.InitEmailEvents()
+	api.InitEmailTemplate()
+	api.InitBatch()
 
 	srv.Router.Handle("/api/v4/{anything:.*}", http.HandlerFunc(api.Handle404))
 
@@ -401,6 +425,7 @@ func InitLocal(srv *app.Server) *API {
 	api.BaseRoutes.Upload = api.BaseRoutes.Uploads.PathPrefix("/{upload_id:[A-Za-z0-9]+}").Subrouter()
 
 	api.BaseRoutes.Imports = api.BaseRoutes.APIRoot.PathPrefix("/imports").Subrouter()
+	api.BaseRoutes.Import = api.BaseRoutes.Imports.PathPrefix("/{import_name:.+\\.zip}").Subrouter()
 	api.BaseRoutes.Exports = api.BaseRoutes.APIRoot.PathPrefix("/exports").Subrouter()
 	api.BaseRoutes.Export = api.BaseRoutes.Exports.PathPrefix("/{export_name:.+\\.zip}").Subrouter()
 
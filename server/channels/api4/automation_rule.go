@@ -0,0 +1,212 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/v8/channels/audit"
+)
+
+func (api *API) InitAutomationRule() {
+	api.BaseRoutes.AutomationRulesForTeam.Handle("", api.APISessionRequired(createAutomationRule)).Methods("POST")
+	api.BaseRoutes.AutomationRulesForTeam.Handle("", api.APISessionRequired(getAutomationRulesForTeam)).Methods("GET")
+
+	api.BaseRoutes.AutomationRule.Handle("", api.APISessionRequired(getAutomationRule)).Methods("GET")
+	api.BaseRoutes.AutomationRule.Handle("", api.APISessionRequired(updateAutomationRule)).Methods("PUT")
+	api.BaseRoutes.AutomationRule.Handle("", api.APISessionRequired(deleteAutomationRule)).Methods("DELETE")
+}
+
+func createAutomationRule(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireTeamId()
+	if c.Err != nil {
+		return
+	}
+
+	var rule model.AutomationRule
+	if jsonErr := json.NewDecoder(r.Body).Decode(&rule); jsonErr != nil {
+		c.SetInvalidParamWithErr("automation_rule", jsonErr)
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("createAutomationRule", audit.Fail)
+	audit.AddEventParameterAuditable(auditRec, "automation_rule", &rule)
+	defer c.LogAuditRec(auditRec)
+
+	if !c.App.SessionHasPermissionToTeam(*c.AppContext.Session(), c.Params.TeamId, model.PermissionManageTeam) {
+		c.SetPermissionError(model.PermissionManageTeam)
+		return
+	}
+
+	rule.TeamId = c.Params.TeamId
+	rule.CreatorId = c.AppContext.Session().UserId
+
+	if !checkAutomationRuleChannelPermissions(c, &rule) {
+		return
+	}
+
+	savedRule, err := c.App.CreateAutomationRule(c.AppContext, &rule)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddEventResultState(savedRule)
+	auditRec.AddEventObjectType("automation_rule")
+
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(savedRule); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func getAutomationRulesForTeam(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireTeamId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionToTeam(*c.AppContext.Session(), c.Params.TeamId, model.PermissionManageTeam) {
+		c.SetPermissionError(model.PermissionManageTeam)
+		return
+	}
+
+	rules, err := c.App.GetAutomationRulesForTeam(c.Params.TeamId, c.Params.Page, c.Params.PerPage)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(rules); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func getAutomationRule(c *Context, w http.ResponseWriter, r *http.Request) {
+	rule := getAutomationRuleForSession(c)
+	if c.Err != nil {
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(rule); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func updateAutomationRule(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireAutomationRuleId()
+	if c.Err != nil {
+		return
+	}
+
+	var rule model.AutomationRule
+	if jsonErr := json.NewDecoder(r.Body).Decode(&rule); jsonErr != nil || rule.Id != c.Params.AutomationRuleId {
+		c.SetInvalidParamWithErr("automation_rule", jsonErr)
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("updateAutomationRule", audit.Fail)
+	audit.AddEventParameterAuditable(auditRec, "automation_rule", &rule)
+	defer c.LogAuditRec(auditRec)
+
+	oldRule := getAutomationRuleForSession(c)
+	if c.Err != nil {
+		return
+	}
+	auditRec.AddEventPriorState(oldRule)
+
+	if rule.TeamId != "" && rule.TeamId != oldRule.TeamId {
+		c.SetInvalidParam("team_id")
+		return
+	}
+	rule.TeamId = oldRule.TeamId
+
+	if !checkAutomationRuleChannelPermissions(c, &rule) {
+		return
+	}
+
+	updatedRule, err := c.App.UpdateAutomationRule(c.AppContext, oldRule, &rule)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddEventResultState(updatedRule)
+	auditRec.AddEventObjectType("automation_rule")
+
+	if err := json.NewEncoder(w).Encode(updatedRule); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func deleteAutomationRule(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireAutomationRuleId()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("deleteAutomationRule", audit.Fail)
+	audit.AddEventParameter(auditRec, "automation_rule_id", c.Params.AutomationRuleId)
+	defer c.LogAuditRec(auditRec)
+
+	rule := getAutomationRuleForSession(c)
+	if c.Err != nil {
+		return
+	}
+	auditRec.AddEventPriorState(rule)
+	auditRec.AddEventObjectType("automation_rule")
+
+	if err := c.App.DeleteAutomationRule(rule); err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+
+	ReturnStatusOK(w)
+}
+
+// getAutomationRuleForSession returns the rule of the request when the session can manage its team.
+func getAutomationRuleForSession(c *Context) *model.AutomationRule {
+	c.RequireAutomationRuleId()
+	if c.Err != nil {
+		return nil
+	}
+
+	rule, err := c.App.GetAutomationRule(c.Params.AutomationRuleId)
+	if err != nil {
+		c.Err = err
+		return nil
+	}
+
+	if !c.App.SessionHasPermissionToTeam(*c.AppContext.Session(), rule.TeamId, model.PermissionManageTeam) {
+		// Return not found rather than a permission error to not leak the existence of the rule.
+		c.Err = model.NewAppError("getAutomationRuleForSession", "app.automation_rule.get.app_error", nil, "", http.StatusNotFound)
+		return nil
+	}
+
+	return rule
+}
+
+// checkAutomationRuleChannelPermissions makes sure that rules only reference channels the session can read.
+func checkAutomationRuleChannelPermissions(c *Context, rule *model.AutomationRule) bool {
+	for _, channelID := range rule.ChannelIds() {
+		if !model.IsValidId(channelID) {
+			c.SetInvalidParam("channel_id")
+			return false
+		}
+
+		if !c.App.SessionHasPermissionToChannel(c.AppContext, *c.AppContext.Session(), channelID, model.PermissionReadChannel) {
+			c.SetPermissionError(model.PermissionReadChannel)
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,103 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+func TestAutomationRules(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableAutomationRules = true })
+
+	newRule := func() *model.AutomationRule {
+		return &model.AutomationRule{
+			TeamId:      th.BasicTeam.Id,
+			DisplayName: "Welcome",
+			Enabled:     true,
+			Trigger:     model.AutomationTrigger{Type: model.AutomationTriggerUserJoinedChannel, ChannelId: th.BasicChannel.Id},
+			Actions: model.AutomationActions{
+				{Type: model.AutomationActionPostMessage, Message: "Welcome!"},
+			},
+		}
+	}
+
+	t.Run("team admins manage rules", func(t *testing.T) {
+		rule, resp, err := th.SystemAdminClient.CreateAutomationRule(context.Background(), newRule())
+		require.NoError(t, err)
+		CheckCreatedStatus(t, resp)
+		assert.Equal(t, th.SystemAdminUser.Id, rule.CreatorId)
+
+		rule.DisplayName = "Greetings"
+		rule, _, err = th.SystemAdminClient.UpdateAutomationRule(context.Background(), rule)
+		require.NoError(t, err)
+		assert.Equal(t, "Greetings", rule.DisplayName)
+
+		rules, _, err := th.SystemAdminClient.GetAutomationRulesForTeam(context.Background(), th.BasicTeam.Id, 0, 60)
+		require.NoError(t, err)
+		require.Len(t, rules, 1)
+
+		_, err = th.SystemAdminClient.DeleteAutomationRule(context.Background(), rule.Id)
+		require.NoError(t, err)
+
+		_, resp, err = th.SystemAdminClient.GetAutomationRule(context.Background(), rule.Id)
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+	})
+
+	t.Run("team members can't manage rules", func(t *testing.T) {
+		_, resp, err := th.Client.CreateAutomationRule(context.Background(), newRule())
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		rule, _, err := th.SystemAdminClient.CreateAutomationRule(context.Background(), newRule())
+		require.NoError(t, err)
+
+		_, resp, err = th.Client.GetAutomationRule(context.Background(), rule.Id)
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+
+		resp, err = th.Client.DeleteAutomationRule(context.Background(), rule.Id)
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+	})
+
+	t.Run("referenced channels must be readable", func(t *testing.T) {
+		th.LoginTeamAdmin()
+		defer th.LoginBasic()
+
+		rule := newRule()
+		rule.Actions[0].ChannelId = th.CreateChannelWithClientAndTeam(th.SystemAdminClient, model.ChannelTypePrivate, th.BasicTeam.Id).Id
+
+		_, resp, err := th.Client.CreateAutomationRule(context.Background(), rule)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("invalid rule", func(t *testing.T) {
+		rule := newRule()
+		rule.Actions = nil
+
+		_, resp, err := th.SystemAdminClient.CreateAutomationRule(context.Background(), rule)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableAutomationRules = false })
+		defer th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableAutomationRules = true })
+
+		_, resp, err := th.SystemAdminClient.CreateAutomationRule(context.Background(), newRule())
+		require.Error(t, err)
+		CheckNotImplementedStatus(t, resp)
+	})
+}
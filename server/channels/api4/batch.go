@@ -0,0 +1,112 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"sync"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/v8/channels/utils"
+)
+
+func (api *API) InitBatch() {
+	api.BaseRoutes.APIRoot.Handle("/batch", api.APISessionRequired(executeBatch)).Methods(http.MethodPost)
+}
+
+// executeBatch serves the read operations of the batch concurrently, each of them going through
+// the router, and so through the same authentication, permission checks and rate limiting as if
+// it had been requested on its own.
+func executeBatch(c *Context, w http.ResponseWriter, r *http.Request) {
+	var batch model.BatchRequest
+	if err := json.NewDecoder(r.Body).Decode(&batch); err != nil {
+		c.SetInvalidParamWithErr("batch", err)
+		return
+	}
+
+	if appErr := batch.IsValid(); appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	subpath, err := utils.GetSubpathFromConfig(c.App.Config())
+	if err != nil {
+		c.Err = model.NewAppError("executeBatch", "api.batch.subpath.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		return
+	}
+	prefix := path.Join(subpath, model.APIURLSuffix)
+
+	var handler http.Handler = c.App.Srv().RootRouter
+	if rateLimiter := c.App.Srv().RateLimiter; rateLimiter != nil {
+		handler = rateLimiter.RateLimitHandler(handler)
+	}
+
+	results := make([]*model.BatchResult, len(batch.Operations))
+	var wg sync.WaitGroup
+	for i, op := range batch.Operations {
+		wg.Add(1)
+		go func(i int, op *model.BatchOperation) {
+			defer wg.Done()
+			results[i] = executeBatchOperation(handler, r, prefix, op)
+		}(i, op)
+	}
+	wg.Wait()
+
+	if err := json.NewEncoder(w).Encode(&model.BatchResponse{Results: results}); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func executeBatchOperation(handler http.Handler, r *http.Request, prefix string, op *model.BatchOperation) *model.BatchResult {
+	result := &model.BatchResult{Name: op.Name}
+
+	opRequest, err := http.NewRequestWithContext(r.Context(), http.MethodGet, prefix+op.Path, nil)
+	if err != nil {
+		result.StatusCode = http.StatusBadRequest
+		result.Error = model.NewAppError("executeBatch", "model.batch.is_valid.path.app_error", map[string]any{"Name": op.Name}, "", http.StatusBadRequest).Wrap(err)
+		return result
+	}
+
+	// The operation is authenticated by the token or the cookies of the batch. The conditional
+	// header of the batch doesn't apply to its operations, and their responses are embedded in
+	// the batch response, so they must not be compressed on their own.
+	opRequest.Header = r.Header.Clone()
+	opRequest.Header.Del("Content-Type")
+	opRequest.Header.Del("Content-Length")
+	opRequest.Header.Del("Accept-Encoding")
+	opRequest.Header.Del(model.HeaderEtagClient)
+	opRequest.Host = r.Host
+	opRequest.RemoteAddr = r.RemoteAddr
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, opRequest)
+
+	result.StatusCode = recorder.Code
+	body := recorder.Body.Bytes()
+	if recorder.Code >= http.StatusBadRequest {
+		var appErr *model.AppError
+		if jsonErr := json.Unmarshal(body, &appErr); jsonErr != nil || appErr == nil || appErr.Id == "" {
+			appErr = model.NewAppError("executeBatch", "api.batch.operation_failed.app_error", map[string]any{"Name": op.Name}, string(body), recorder.Code)
+		}
+		result.Error = appErr
+		return result
+	}
+
+	if len(body) == 0 {
+		return result
+	}
+
+	if !json.Valid(body) {
+		result.StatusCode = http.StatusBadRequest
+		result.Error = model.NewAppError("executeBatch", "api.batch.unsupported_response.app_error", map[string]any{"Name": op.Name}, "", http.StatusBadRequest)
+		return result
+	}
+
+	result.Data = body
+	return result
+}
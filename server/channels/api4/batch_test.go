@@ -0,0 +1,109 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+func TestExecuteBatch(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+	client := th.Client
+
+	t.Run("the operations are served with the session of the batch", func(t *testing.T) {
+		response, resp, err := client.ExecuteBatch(context.Background(), &model.BatchRequest{
+			Operations: []*model.BatchOperation{
+				{Name: "me", Path: "/users/me"},
+				{Name: "teams", Path: "/users/me/teams"},
+				{Name: "channels", Path: "/users/me/teams/" + th.BasicTeam.Id + "/channels"},
+			},
+		})
+		require.NoError(t, err)
+		CheckOKStatus(t, resp)
+		require.Len(t, response.Results, 3)
+
+		assert.Equal(t, "me", response.Results[0].Name)
+		assert.Equal(t, http.StatusOK, response.Results[0].StatusCode)
+		var user model.User
+		require.NoError(t, json.Unmarshal(response.Results[0].Data, &user))
+		assert.Equal(t, th.BasicUser.Id, user.Id)
+
+		assert.Equal(t, "teams", response.Results[1].Name)
+		var teams []*model.Team
+		require.NoError(t, json.Unmarshal(response.Results[1].Data, &teams))
+		require.NotEmpty(t, teams)
+
+		assert.Equal(t, "channels", response.Results[2].Name)
+		var channels []*model.Channel
+		require.NoError(t, json.Unmarshal(response.Results[2].Data, &channels))
+		require.NotEmpty(t, channels)
+	})
+
+	t.Run("large responses are not compressed", func(t *testing.T) {
+		// The client accepts gzip, which the API compresses responses over 1 KB with.
+		response, resp, err := client.ExecuteBatch(context.Background(), &model.BatchRequest{
+			Operations: []*model.BatchOperation{{Name: "users", Path: "/users?per_page=200"}},
+		})
+		require.NoError(t, err)
+		CheckOKStatus(t, resp)
+		require.Len(t, response.Results, 1)
+
+		assert.Equal(t, http.StatusOK, response.Results[0].StatusCode)
+		assert.Nil(t, response.Results[0].Error)
+		require.Greater(t, len(response.Results[0].Data), 1024)
+		var users []*model.User
+		require.NoError(t, json.Unmarshal(response.Results[0].Data, &users))
+		require.NotEmpty(t, users)
+	})
+
+	t.Run("the operations fail independently", func(t *testing.T) {
+		response, resp, err := client.ExecuteBatch(context.Background(), &model.BatchRequest{
+			Operations: []*model.BatchOperation{
+				{Name: "me", Path: "/users/me"},
+				{Name: "forbidden", Path: "/users/" + th.BasicUser2.Id + "/preferences"},
+				{Name: "missing", Path: "/does_not_exist"},
+			},
+		})
+		require.NoError(t, err)
+		CheckOKStatus(t, resp)
+		require.Len(t, response.Results, 3)
+
+		assert.Equal(t, http.StatusOK, response.Results[0].StatusCode)
+		assert.Nil(t, response.Results[0].Error)
+
+		assert.Equal(t, http.StatusForbidden, response.Results[1].StatusCode)
+		require.NotNil(t, response.Results[1].Error)
+		assert.Equal(t, "api.context.permissions.app_error", response.Results[1].Error.Id)
+		assert.Empty(t, response.Results[1].Data)
+
+		assert.Equal(t, http.StatusNotFound, response.Results[2].StatusCode)
+		require.NotNil(t, response.Results[2].Error)
+	})
+
+	t.Run("invalid batch", func(t *testing.T) {
+		_, resp, err := client.ExecuteBatch(context.Background(), &model.BatchRequest{
+			Operations: []*model.BatchOperation{{Name: "batch", Path: "/batch"}},
+		})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("not logged in", func(t *testing.T) {
+		client := th.CreateClient()
+		_, resp, err := client.ExecuteBatch(context.Background(), &model.BatchRequest{
+			Operations: []*model.BatchOperation{{Name: "me", Path: "/users/me"}},
+		})
+		require.Error(t, err)
+		CheckUnauthorizedStatus(t, resp)
+	})
+}
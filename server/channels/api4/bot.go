@@ -22,6 +22,7 @@ func (api *API) InitBot() {
 	api.BaseRoutes.Bot.Handle("/enable", api.APISessionRequired(enableBot)).Methods("POST")
 	api.BaseRoutes.Bot.Handle("/convert_to_user", api.APISessionRequired(convertBotToUser)).Methods("POST")
 	api.BaseRoutes.Bot.Handle("/assign/{user_id:[A-Za-z0-9]+}", api.APISessionRequired(assignBot)).Methods("POST")
+	api.BaseRoutes.Bot.Handle("/scope", api.APISessionRequired(updateBotScope)).Methods("PUT")
 }
 
 func createBot(c *Context, w http.ResponseWriter, r *http.Request) {
@@ -272,6 +273,54 @@ func assignBot(c *Context, w http.ResponseWriter, _ *http.Request) {
 	}
 }
 
+func updateBotScope(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireBotUserId()
+	if c.Err != nil {
+		return
+	}
+	botUserId := c.Params.BotUserId
+
+	// A null body lifts the restriction.
+	var scope *model.BotScope
+	if err := json.NewDecoder(r.Body).Decode(&scope); err != nil {
+		c.SetInvalidParamWithErr("scope", err)
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("updateBotScope", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameter(auditRec, "id", botUserId)
+	if scope != nil {
+		audit.AddEventParameter(auditRec, "team_ids", scope.TeamIds)
+		audit.AddEventParameter(auditRec, "channel_ids", scope.ChannelIds)
+	}
+
+	if err := c.App.SessionHasPermissionToManageBot(c.AppContext, *c.AppContext.Session(), botUserId); err != nil {
+		c.Err = err
+		return
+	}
+
+	// Bots must not be able to widen their own scope, or the scope of other bots.
+	if c.AppContext.Session().IsBotUser() {
+		c.SetPermissionError(model.PermissionManageBots)
+		return
+	}
+
+	bot, appErr := c.App.UpdateBotScope(c.AppContext, botUserId, scope)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddEventResultState(bot)
+	auditRec.AddEventObjectType("bot")
+
+	if err := json.NewEncoder(w).Encode(bot); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
 func convertBotToUser(c *Context, w http.ResponseWriter, r *http.Request) {
 	c.RequireBotUserId()
 	if c.Err != nil {
@@ -318,3 +367,73 @@ func convertBotToUser(c *Context, w http.ResponseWriter, r *http.Request) {
 		c.Logger.Warn("Error while writing response", mlog.Err(err))
 	}
 }
+
+// filterChannelsForBotScope drops the channels out of the scope of the bot authenticated by the
+// session, which it may still be a member of.
+func filterChannelsForBotScope(c *Context, channels model.ChannelList) model.ChannelList {
+	scope := c.AppContext.Session().GetBotScope()
+	if scope == nil {
+		return channels
+	}
+
+	filtered := make(model.ChannelList, 0, len(channels))
+	for _, channel := range channels {
+		if scope.AllowsChannel(channel, c.AppContext.Session().UserId) {
+			filtered = append(filtered, channel)
+		}
+	}
+	return filtered
+}
+
+// filterChannelsWithTeamDataForBotScope is filterChannelsForBotScope for channels with their team data.
+func filterChannelsWithTeamDataForBotScope(c *Context, channels model.ChannelListWithTeamData) model.ChannelListWithTeamData {
+	scope := c.AppContext.Session().GetBotScope()
+	if scope == nil {
+		return channels
+	}
+
+	filtered := make(model.ChannelListWithTeamData, 0, len(channels))
+	for _, channel := range channels {
+		if scope.AllowsChannel(&channel.Channel, c.AppContext.Session().UserId) {
+			filtered = append(filtered, channel)
+		}
+	}
+	return filtered
+}
+
+// filterPostSearchResultsForBotScope drops the posts of the channels out of the scope of the bot
+// authenticated by the session.
+func filterPostSearchResultsForBotScope(c *Context, results *model.PostSearchResults) *model.PostSearchResults {
+	scope := c.AppContext.Session().GetBotScope()
+	if scope == nil {
+		return results
+	}
+
+	allowedChannels := map[string]bool{}
+	filtered := model.NewPostList()
+	matches := model.PostSearchMatches{}
+	for _, postID := range results.Order {
+		post, ok := results.Posts[postID]
+		if !ok {
+			continue
+		}
+
+		allowed, ok := allowedChannels[post.ChannelId]
+		if !ok {
+			channel, appErr := c.App.GetChannel(c.AppContext, post.ChannelId)
+			allowed = appErr == nil && scope.AllowsChannel(channel, c.AppContext.Session().UserId)
+			allowedChannels[post.ChannelId] = allowed
+		}
+		if !allowed {
+			continue
+		}
+
+		filtered.AddPost(post)
+		filtered.AddOrder(postID)
+		if match, ok := results.Matches[postID]; ok {
+			matches[postID] = match
+		}
+	}
+
+	return model.MakePostSearchResults(filtered, matches)
+}
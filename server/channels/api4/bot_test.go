@@ -1328,6 +1328,98 @@ func TestAssignBot(t *testing.T) {
 	})
 }
 
+func TestUpdateBotScope(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.ServiceSettings.EnableBotAccountCreation = true
+		*cfg.ServiceSettings.EnableUserAccessTokens = true
+	})
+
+	bot, _, err := th.SystemAdminClient.CreateBot(context.Background(), &model.Bot{
+		Username:    GenerateTestUsername(),
+		Description: "bot",
+	})
+	require.NoError(t, err)
+	defer th.App.PermanentDeleteBot(th.Context, bot.UserId)
+
+	botUser, appErr := th.App.GetUser(bot.UserId)
+	require.Nil(t, appErr)
+	th.LinkUserToTeam(botUser, th.BasicTeam)
+	th.AddUserToChannel(botUser, th.BasicChannel)
+	th.AddUserToChannel(botUser, th.BasicChannel2)
+
+	token, _, err := th.SystemAdminClient.CreateUserAccessToken(context.Background(), bot.UserId, "token")
+	require.NoError(t, err)
+	botClient := th.CreateClient()
+	botClient.AuthToken = token.Token
+
+	t.Run("random user can't update the scope", func(t *testing.T) {
+		_, resp, err := th.Client.UpdateBotScope(context.Background(), bot.UserId, &model.BotScope{})
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+	})
+
+	t.Run("bots can't update their own scope", func(t *testing.T) {
+		_, resp, err := botClient.UpdateBotScope(context.Background(), bot.UserId, nil)
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+	})
+
+	t.Run("invalid scope", func(t *testing.T) {
+		_, resp, err := th.SystemAdminClient.UpdateBotScope(context.Background(), bot.UserId, &model.BotScope{ChannelIds: []string{model.NewId()}})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("scoped bot can only access the channels of its scope", func(t *testing.T) {
+		scoped, _, err := th.SystemAdminClient.UpdateBotScope(context.Background(), bot.UserId, &model.BotScope{ChannelIds: []string{th.BasicChannel.Id}})
+		require.NoError(t, err)
+		require.Equal(t, []string{th.BasicChannel.Id}, scoped.Scope.ChannelIds)
+
+		_, _, err = botClient.CreatePost(context.Background(), &model.Post{ChannelId: th.BasicChannel.Id, Message: "in scope"})
+		require.NoError(t, err)
+
+		_, resp, err := botClient.GetChannel(context.Background(), th.BasicChannel2.Id, "")
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = botClient.CreatePost(context.Background(), &model.Post{ChannelId: th.BasicChannel2.Id, Message: "out of scope"})
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = th.SystemAdminClient.AddChannelMember(context.Background(), th.CreatePublicChannel().Id, bot.UserId)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		channels, _, err := botClient.GetChannelsForUserWithLastDeleteAt(context.Background(), bot.UserId, 0)
+		require.NoError(t, err)
+		require.Len(t, channels, 1)
+		require.Equal(t, th.BasicChannel.Id, channels[0].Id)
+
+		searched, _, err := botClient.SearchAllChannelsForUser(context.Background(), "")
+		require.NoError(t, err)
+		for _, channel := range searched {
+			require.Equal(t, th.BasicChannel.Id, channel.Id)
+		}
+
+		th.CreateMessagePostWithClient(th.Client, th.BasicChannel2, "scoped search")
+		posts, _, err := botClient.SearchPostsWithParams(context.Background(), "", &model.SearchParameter{Terms: model.NewString("scoped")})
+		require.NoError(t, err)
+		require.Empty(t, posts.Order)
+	})
+
+	t.Run("lifting the scope", func(t *testing.T) {
+		unscoped, _, err := th.SystemAdminClient.UpdateBotScope(context.Background(), bot.UserId, nil)
+		require.NoError(t, err)
+		require.Nil(t, unscoped.Scope)
+
+		_, _, err = botClient.CreatePost(context.Background(), &model.Post{ChannelId: th.BasicChannel2.Id, Message: "anywhere"})
+		require.NoError(t, err)
+	})
+}
+
 func TestConvertBotToUser(t *testing.T) {
 	th := Setup(t).InitBasic()
 	defer th.TearDown()
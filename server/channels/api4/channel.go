@@ -38,6 +38,7 @@ func (api *API) InitChannel() {
 	api.BaseRoutes.ChannelsForTeam.Handle("/search_autocomplete", api.APISessionRequired(autocompleteChannelsForTeamForSearch)).Methods("GET")
 	api.BaseRoutes.User.Handle("/teams/{team_id:[A-Za-z0-9]+}/channels", api.APISessionRequired(getChannelsForTeamForUser)).Methods("GET")
 	api.BaseRoutes.User.Handle("/channels", api.APISessionRequired(getChannelsForUser)).Methods("GET")
+	api.BaseRoutes.User.Handle("/channels/categories/unreads", api.APISessionRequired(getCategoryUnreadsForUser)).Methods("GET")
 
 	api.BaseRoutes.ChannelCategories.Handle("", api.APISessionRequired(getCategoriesForTeamForUser)).Methods("GET")
 	api.BaseRoutes.ChannelCategories.Handle("", api.APISessionRequired(createCategoryForTeamForUser)).Methods("POST")
@@ -524,6 +525,7 @@ func searchGroupChannels(c *Context, w http.ResponseWriter, r *http.Request) {
 		c.Err = appErr
 		return
 	}
+	groupChannels = filterChannelsForBotScope(c, groupChannels)
 
 	if err := json.NewEncoder(w).Encode(groupChannels); err != nil {
 		c.Logger.Warn("Error while writing response", mlog.Err(err))
@@ -989,6 +991,11 @@ func getChannelsForTeamForUser(c *Context, w http.ResponseWriter, r *http.Reques
 		return
 	}
 
+	etag := c.App.GetChannelsForUserEtag(c.Params.UserId, c.Params.TeamId)
+	if c.HandleEtag(etag, "Get Channels", w, r) {
+		return
+	}
+
 	channels, err := c.App.GetChannelsForTeamForUser(c.AppContext, c.Params.TeamId, c.Params.UserId, &model.ChannelSearchOpts{
 		IncludeDeleted: c.Params.IncludeDeleted,
 		LastDeleteAt:   lastDeleteAt,
@@ -998,17 +1005,13 @@ func getChannelsForTeamForUser(c *Context, w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	if c.HandleEtag(channels.Etag(), "Get Channels", w, r) {
-		return
-	}
-
 	err = c.App.FillInChannelsProps(c.AppContext, channels)
 	if err != nil {
 		c.Err = err
 		return
 	}
 
-	w.Header().Set(model.HeaderEtagServer, channels.Etag())
+	w.Header().Set(model.HeaderEtagServer, etag)
 	if err := json.NewEncoder(w).Encode(channels); err != nil {
 		c.Logger.Warn("Error while writing response", mlog.Err(err))
 	}
@@ -1035,6 +1038,12 @@ func getChannelsForUser(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	etag := c.App.GetChannelsForUserEtag(c.Params.UserId, "")
+	if c.HandleEtag(etag, "Get Channels For User", w, r) {
+		return
+	}
+	w.Header().Set(model.HeaderEtagServer, etag)
+
 	pageSize := 100
 	fromChannelID := ""
 	// We have to write `[` and `]` separately because we want to stream the response.
@@ -1046,6 +1055,7 @@ func getChannelsForUser(c *Context, w http.ResponseWriter, r *http.Request) {
 	// fully JSON.
 	w.Write([]byte(`[`))
 	enc := json.NewEncoder(w)
+	written := 0
 	for {
 		channels, err := c.App.GetChannelsForUser(c.AppContext, c.Params.UserId, c.Params.IncludeDeleted, lastDeleteAt, pageSize, fromChannelID)
 		if err != nil {
@@ -1058,31 +1068,33 @@ func getChannelsForUser(c *Context, w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
+		// The next page starts after the last channel of this one, even if it is filtered out.
+		lastPage := len(channels) < pageSize
+		if !lastPage {
+			fromChannelID = channels[len(channels)-1].Id
+		}
+		channels = filterChannelsForBotScope(c, channels)
+
 		err = c.App.FillInChannelsProps(c.AppContext, channels)
 		if err != nil {
 			c.Err = err
 			return
 		}
 
-		// intermediary comma between sets
-		if fromChannelID != "" {
-			w.Write([]byte(`,`))
-		}
-
-		for i, ch := range channels {
+		for _, ch := range channels {
+			// comma between channels, including across sets
+			if written > 0 {
+				w.Write([]byte(`,`))
+			}
 			if err := enc.Encode(ch); err != nil {
 				c.Logger.Warn("Error while writing response", mlog.Err(err))
 			}
-			if i < len(channels)-1 {
-				w.Write([]byte(`,`))
-			}
+			written++
 		}
 
-		if len(channels) < pageSize {
+		if lastPage {
 			break
 		}
-
-		fromChannelID = channels[len(channels)-1].Id
 	}
 	w.Write([]byte(`]`))
 }
@@ -1235,6 +1247,7 @@ func searchAllChannels(c *Context, w http.ResponseWriter, r *http.Request) {
 			c.Err = err
 			return
 		}
+		channels = filterChannelsWithTeamDataForBotScope(c, channels)
 
 		if err := json.NewEncoder(w).Encode(channels); err != nil {
 			c.Logger.Warn("Error while writing response", mlog.Err(err))
@@ -1279,6 +1292,11 @@ func searchAllChannels(c *Context, w http.ResponseWriter, r *http.Request) {
 		c.Err = appErr
 		return
 	}
+	// Only the channels of this page are known to be out of the scope of a scoped bot.
+	if filtered := filterChannelsWithTeamDataForBotScope(c, channels); len(filtered) != len(channels) {
+		totalCount -= int64(len(channels) - len(filtered))
+		channels = filtered
+	}
 
 	// Don't fill in channels props, since unused by client and potentially expensive.
 	if props.Page != nil && props.PerPage != nil {
@@ -1431,6 +1449,28 @@ func getChannelMembers(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Passing after, term or role pages by user id instead of by offset, which
+	// stays cheap however deep into a large channel the caller goes.
+	query := r.URL.Query()
+	if query.Has("after") || query.Has("term") || query.Has("role") {
+		members, err := c.App.GetChannelMembersAfter(c.AppContext, c.Params.ChannelId, &model.ChannelMembersGetOptions{
+			AfterUserId:    query.Get("after"),
+			Term:           query.Get("term"),
+			AllowFullNames: *c.App.Config().PrivacySettings.ShowFullName || c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem),
+			Role:           query.Get("role"),
+			Limit:          c.Params.PerPage,
+		})
+		if err != nil {
+			c.Err = err
+			return
+		}
+
+		if err := json.NewEncoder(w).Encode(members); err != nil {
+			c.Logger.Warn("Error while writing response", mlog.Err(err))
+		}
+		return
+	}
+
 	members, err := c.App.GetChannelMembersPage(c.AppContext, c.Params.ChannelId, c.Params.Page, c.Params.PerPage)
 	if err != nil {
 		c.Err = err
@@ -550,6 +550,53 @@ func TestUpdateCategoriesForTeamForUser(t *testing.T) {
 	})
 }
 
+func TestGetCategoryUnreadsForUser(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	user, client := setupUserForSubtest(t, th)
+
+	categories, _, err := client.GetSidebarCategoriesForTeamForUser(context.Background(), user.Id, th.BasicTeam.Id, "")
+	require.NoError(t, err)
+	channelsCategory := categories.Categories[1]
+	require.Equal(t, model.SidebarCategoryChannels, channelsCategory.Type)
+
+	_, _, err = th.Client.CreatePost(context.Background(), &model.Post{
+		ChannelId: th.BasicChannel.Id,
+		Message:   "hello @" + user.Username,
+	})
+	require.NoError(t, err)
+
+	t.Run("should roll up the unreads of the channels of each category", func(t *testing.T) {
+		unreads, resp, err := client.GetSidebarCategoryUnreadsForUser(context.Background(), user.Id)
+		require.NoError(t, err)
+		CheckOKStatus(t, resp)
+
+		var channelsUnread *model.SidebarCategoryUnread
+		for _, unread := range unreads {
+			if unread.CategoryId == channelsCategory.Id {
+				channelsUnread = unread
+			}
+		}
+		require.NotNil(t, channelsUnread)
+		assert.Equal(t, th.BasicTeam.Id, channelsUnread.TeamId)
+		assert.GreaterOrEqual(t, channelsUnread.MsgCount, int64(1))
+		assert.Equal(t, int64(1), channelsUnread.MentionCount)
+	})
+
+	t.Run("should not return the unreads of another user", func(t *testing.T) {
+		_, resp, err := client.GetSidebarCategoryUnreadsForUser(context.Background(), th.BasicUser.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("should return the unreads of another user to a system admin", func(t *testing.T) {
+		_, resp, err := th.SystemAdminClient.GetSidebarCategoryUnreadsForUser(context.Background(), user.Id)
+		require.NoError(t, err)
+		CheckOKStatus(t, resp)
+	})
+}
+
 func setupUserForSubtest(t *testing.T, th *TestHelper) (*model.User, *model.Client4) {
 	password := "password"
 	user, appErr := th.App.CreateUser(th.Context, &model.User{
@@ -1176,6 +1176,13 @@ func TestGetChannelsForTeamForUser(t *testing.T) {
 		channels, resp, _ = client.GetChannelsForTeamForUser(context.Background(), th.BasicTeam.Id, th.BasicUser.Id, false, resp.Etag)
 		CheckEtag(t, channels, resp)
 
+		th.CreatePublicChannel()
+		channels, resp2, err := client.GetChannelsForTeamForUser(context.Background(), th.BasicTeam.Id, th.BasicUser.Id, false, resp.Etag)
+		require.NoError(t, err)
+		CheckOKStatus(t, resp2)
+		require.NotEmpty(t, channels)
+		require.NotEqual(t, resp.Etag, resp2.Etag)
+
 		_, resp, err = client.GetChannelsForTeamForUser(context.Background(), th.BasicTeam.Id, "junk", false, "")
 		require.Error(t, err)
 		CheckBadRequestStatus(t, resp)
@@ -2469,6 +2476,42 @@ func TestGetChannelMembers(t *testing.T) {
 	CheckForbiddenStatus(t, resp)
 }
 
+func TestGetChannelMembersAfter(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+	client := th.Client
+
+	var got []string
+	opts := &model.ChannelMembersGetOptions{Limit: 2}
+	for {
+		members, _, err := client.GetChannelMembersAfter(context.Background(), th.BasicChannel.Id, opts)
+		require.NoError(t, err)
+		if len(members) == 0 {
+			break
+		}
+		require.LessOrEqual(t, len(members), 2)
+		for _, member := range members {
+			got = append(got, member.UserId)
+		}
+		opts.AfterUserId = members[len(members)-1].UserId
+	}
+	require.Len(t, got, 3)
+	require.True(t, sort.StringsAreSorted(got), "members should be returned in user id order")
+
+	members, _, err := client.GetChannelMembersAfter(context.Background(), th.BasicChannel.Id, &model.ChannelMembersGetOptions{Term: th.BasicUser.Username})
+	require.NoError(t, err)
+	require.Len(t, members, 1)
+	require.Equal(t, th.BasicUser.Id, members[0].UserId)
+
+	_, resp, err := client.GetChannelMembersAfter(context.Background(), th.BasicChannel.Id, &model.ChannelMembersGetOptions{Role: model.SystemAdminRoleId})
+	require.Error(t, err)
+	CheckBadRequestStatus(t, resp)
+
+	_, resp, err = client.GetChannelMembersAfter(context.Background(), th.BasicChannel.Id, &model.ChannelMembersGetOptions{AfterUserId: "junk"})
+	require.Error(t, err)
+	CheckBadRequestStatus(t, resp)
+}
+
 func TestGetChannelMembersByIds(t *testing.T) {
 	th := Setup(t).InitBasic()
 	defer th.TearDown()
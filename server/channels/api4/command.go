@@ -27,6 +27,9 @@ func (api *API) InitCommand() {
 	api.BaseRoutes.Team.Handle("/commands/autocomplete", api.APISessionRequired(listAutocompleteCommands)).Methods("GET")
 	api.BaseRoutes.Team.Handle("/commands/autocomplete_suggestions", api.APISessionRequired(listCommandAutocompleteSuggestions)).Methods("GET")
 	api.BaseRoutes.Command.Handle("/regen_token", api.APISessionRequired(regenCommandToken)).Methods("PUT")
+	api.BaseRoutes.Command.Handle("/regen_signing_secret", api.APISessionRequired(regenCommandSigningSecret)).Methods("PUT")
+	api.BaseRoutes.Command.Handle("/deliveries", api.APISessionRequired(getCommandDeliveries)).Methods("GET")
+	api.BaseRoutes.Command.Handle("/autocomplete", api.APISessionRequired(updateCommandAutocompleteData)).Methods("PUT")
 }
 
 func createCommand(c *Context, w http.ResponseWriter, r *http.Request) {
@@ -125,6 +128,60 @@ func updateCommand(c *Context, w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func updateCommandAutocompleteData(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireCommandId()
+	if c.Err != nil {
+		return
+	}
+
+	var data *model.AutocompleteData
+	if jsonErr := json.NewDecoder(r.Body).Decode(&data); jsonErr != nil {
+		c.SetInvalidParamWithErr("autocomplete_data", jsonErr)
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("updateCommandAutocompleteData", audit.Fail)
+	audit.AddEventParameter(auditRec, "command_id", c.Params.CommandId)
+	defer c.LogAuditRec(auditRec)
+	c.LogAudit("attempt")
+
+	cmd, err := c.App.GetCommand(c.Params.CommandId)
+	if err != nil {
+		c.SetCommandNotFoundError()
+		return
+	}
+	auditRec.AddEventPriorState(cmd)
+
+	if !c.App.SessionHasPermissionToTeam(*c.AppContext.Session(), cmd.TeamId, model.PermissionManageSlashCommands) {
+		c.LogAudit("fail - inappropriate permissions")
+		// here we return Not_found instead of a permissions error so we don't leak the existence of
+		// a command to someone without permissions for the team it belongs to.
+		c.SetCommandNotFoundError()
+		return
+	}
+
+	if c.AppContext.Session().UserId != cmd.CreatorId && !c.App.SessionHasPermissionToTeam(*c.AppContext.Session(), cmd.TeamId, model.PermissionManageOthersSlashCommands) {
+		c.LogAudit("fail - inappropriate permissions")
+		c.SetPermissionError(model.PermissionManageOthersSlashCommands)
+		return
+	}
+
+	rcmd, err := c.App.UpdateCommandAutocompleteData(cmd, data)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.AddEventResultState(rcmd)
+	auditRec.AddEventObjectType("command")
+	auditRec.Success()
+	c.LogAudit("success")
+
+	if err := json.NewEncoder(w).Encode(rcmd); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
 func moveCommand(c *Context, w http.ResponseWriter, r *http.Request) {
 	c.RequireCommandId()
 	if c.Err != nil {
@@ -307,6 +364,41 @@ func getCommand(c *Context, w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func getCommandDeliveries(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireCommandId()
+	if c.Err != nil {
+		return
+	}
+
+	cmd, err := c.App.GetCommand(c.Params.CommandId)
+	if err != nil {
+		c.SetCommandNotFoundError()
+		return
+	}
+
+	if !c.App.SessionHasPermissionToTeam(*c.AppContext.Session(), cmd.TeamId, model.PermissionManageSlashCommands) {
+		// here we return Not_found instead of a permissions error so we don't leak the existence of
+		// a command to someone without permissions for the team it belongs to.
+		c.SetCommandNotFoundError()
+		return
+	}
+
+	if c.AppContext.Session().UserId != cmd.CreatorId && !c.App.SessionHasPermissionToTeam(*c.AppContext.Session(), cmd.TeamId, model.PermissionManageOthersSlashCommands) {
+		c.SetPermissionError(model.PermissionManageOthersSlashCommands)
+		return
+	}
+
+	deliveries, appErr := c.App.GetIntegrationDeliveries(cmd.Id, c.Params.Page, c.Params.PerPage)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(deliveries); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
 func executeCommand(c *Context, w http.ResponseWriter, r *http.Request) {
 	var commandArgs model.CommandArgs
 	if jsonErr := json.NewDecoder(r.Body).Decode(&commandArgs); jsonErr != nil {
@@ -484,3 +576,51 @@ func regenCommandToken(c *Context, w http.ResponseWriter, r *http.Request) {
 
 	w.Write([]byte(model.MapToJSON(resp)))
 }
+
+func regenCommandSigningSecret(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireCommandId()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("regenCommandSigningSecret", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	c.LogAudit("attempt")
+
+	cmd, err := c.App.GetCommand(c.Params.CommandId)
+	if err != nil {
+		audit.AddEventParameter(auditRec, "command_id", c.Params.CommandId)
+		c.SetCommandNotFoundError()
+		return
+	}
+	auditRec.AddEventPriorState(cmd)
+	audit.AddEventParameter(auditRec, "command_id", c.Params.CommandId)
+
+	if !c.App.SessionHasPermissionToTeam(*c.AppContext.Session(), cmd.TeamId, model.PermissionManageSlashCommands) {
+		c.LogAudit("fail - inappropriate permissions")
+		// here we return Not_found instead of a permissions error so we don't leak the existence of
+		// a command to someone without permissions for the team it belongs to.
+		c.SetCommandNotFoundError()
+		return
+	}
+
+	if c.AppContext.Session().UserId != cmd.CreatorId && !c.App.SessionHasPermissionToTeam(*c.AppContext.Session(), cmd.TeamId, model.PermissionManageOthersSlashCommands) {
+		c.LogAudit("fail - inappropriate permissions")
+		c.SetPermissionError(model.PermissionManageOthersSlashCommands)
+		return
+	}
+
+	rcmd, err := c.App.RegenCommandSigningSecret(cmd)
+	if err != nil {
+		c.Err = err
+		return
+	}
+	auditRec.AddEventResultState(rcmd)
+	auditRec.Success()
+	c.LogAudit("success")
+
+	resp := make(map[string]string)
+	resp["signing_secret"] = rcmd.SigningSecret
+
+	w.Write([]byte(model.MapToJSON(resp)))
+}
@@ -11,6 +11,7 @@ import (
 	"net/url"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -625,6 +626,108 @@ func TestRegenToken(t *testing.T) {
 	require.Empty(t, token, "should not return the token")
 }
 
+func TestRegenCommandSigningSecret(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+	client := th.Client
+
+	enableCommands := *th.App.Config().ServiceSettings.EnableCommands
+	defer func() {
+		th.App.UpdateConfig(func(cfg *model.Config) { cfg.ServiceSettings.EnableCommands = &enableCommands })
+	}()
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableCommands = true })
+
+	newCmd := &model.Command{
+		CreatorId: th.BasicUser.Id,
+		TeamId:    th.BasicTeam.Id,
+		URL:       "http://nowhere.com",
+		Method:    model.CommandMethodPost,
+		Trigger:   "trigger"}
+
+	createdCmd, resp, err := th.SystemAdminClient.CreateCommand(context.Background(), newCmd)
+	require.NoError(t, err)
+	CheckCreatedStatus(t, resp)
+	require.NotEmpty(t, createdCmd.SigningSecret)
+
+	secret, _, err := th.SystemAdminClient.RegenCommandSigningSecret(context.Background(), createdCmd.Id)
+	require.NoError(t, err)
+	require.NotEmpty(t, secret)
+	require.NotEqual(t, createdCmd.SigningSecret, secret, "should update the signing secret")
+
+	secret, resp, err = client.RegenCommandSigningSecret(context.Background(), createdCmd.Id)
+	require.Error(t, err)
+	CheckNotFoundStatus(t, resp)
+	require.Empty(t, secret, "should not return the signing secret")
+}
+
+func TestUpdateCommandAutocompleteData(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+	client := th.Client
+
+	enableCommands := *th.App.Config().ServiceSettings.EnableCommands
+	defer func() {
+		th.App.UpdateConfig(func(cfg *model.Config) { cfg.ServiceSettings.EnableCommands = &enableCommands })
+	}()
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableCommands = true })
+
+	newCmd := &model.Command{
+		CreatorId: th.BasicUser.Id,
+		TeamId:    th.BasicTeam.Id,
+		URL:       "http://nowhere.com",
+		Method:    model.CommandMethodPost,
+		Trigger:   "trigger"}
+
+	createdCmd, _, err := th.SystemAdminClient.CreateCommand(context.Background(), newCmd)
+	require.NoError(t, err)
+
+	data := model.NewAutocompleteData("trigger", "[project]", "Pick a project")
+	data.AddDynamicListArgument("The project", "https://nowhere.com/projects", true)
+
+	t.Run("registers the autocomplete data", func(t *testing.T) {
+		cmd, _, err := th.SystemAdminClient.UpdateCommandAutocompleteData(context.Background(), createdCmd.Id, data)
+		require.NoError(t, err)
+		require.True(t, data.Equals(cmd.AutocompleteData))
+
+		// Updating the command keeps the autocomplete data.
+		cmd, _, err = th.SystemAdminClient.UpdateCommand(context.Background(), &model.Command{
+			Id:          cmd.Id,
+			TeamId:      cmd.TeamId,
+			URL:         cmd.URL,
+			Method:      cmd.Method,
+			Trigger:     cmd.Trigger,
+			DisplayName: "Projects",
+		})
+		require.NoError(t, err)
+		require.True(t, data.Equals(cmd.AutocompleteData))
+	})
+
+	t.Run("rejects invalid autocomplete data", func(t *testing.T) {
+		invalid := model.NewAutocompleteData("other", "", "")
+		_, resp, err := th.SystemAdminClient.UpdateCommandAutocompleteData(context.Background(), createdCmd.Id, invalid)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+
+		invalid = model.NewAutocompleteData("trigger", "", "")
+		invalid.AddDynamicListArgument("The project", "builtin:share", true)
+		_, resp, err = th.SystemAdminClient.UpdateCommandAutocompleteData(context.Background(), createdCmd.Id, invalid)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("requires permissions on the command", func(t *testing.T) {
+		_, resp, err := client.UpdateCommandAutocompleteData(context.Background(), createdCmd.Id, data)
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+	})
+
+	t.Run("removes the autocomplete data", func(t *testing.T) {
+		cmd, _, err := th.SystemAdminClient.UpdateCommandAutocompleteData(context.Background(), createdCmd.Id, nil)
+		require.NoError(t, err)
+		require.Nil(t, cmd.AutocompleteData)
+	})
+}
+
 func TestExecuteInvalidCommand(t *testing.T) {
 	th := Setup(t).InitBasic()
 	defer th.TearDown()
@@ -817,6 +920,53 @@ func TestExecutePostCommand(t *testing.T) {
 	require.Equal(t, expectedCommandResponse, commandResponse)
 }
 
+func TestGetCommandDeliveries(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.ServiceSettings.EnableCommands = true
+		*cfg.ServiceSettings.EnableIntegrationDeliveryLog = true
+		*cfg.ServiceSettings.AllowedUntrustedInternalConnections = "127.0.0.0/8"
+	})
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"text": "delivered"}`))
+	}))
+	defer ts.Close()
+
+	cmd, appErr := th.App.CreateCommand(&model.Command{
+		CreatorId: th.BasicUser.Id,
+		TeamId:    th.BasicTeam.Id,
+		URL:       ts.URL,
+		Method:    model.CommandMethodPost,
+		Trigger:   "deliveries",
+	})
+	require.Nil(t, appErr)
+
+	_, _, err := th.Client.ExecuteCommand(context.Background(), th.BasicChannel.Id, "/deliveries now")
+	require.NoError(t, err)
+
+	var deliveries []*model.IntegrationDelivery
+	require.Eventually(t, func() bool {
+		deliveries, _, err = th.SystemAdminClient.GetCommandDeliveries(context.Background(), cmd.Id, 0, 10)
+		return err == nil && len(deliveries) == 1
+	}, 5*time.Second, 100*time.Millisecond)
+
+	assert.Equal(t, model.IntegrationDeliveryTypeCommand, deliveries[0].Type)
+	assert.Equal(t, http.StatusOK, deliveries[0].StatusCode)
+	assert.Equal(t, `{"text": "delivered"}`, deliveries[0].Response)
+	assert.Equal(t, th.BasicUser.Id, deliveries[0].UserId)
+	assert.Contains(t, deliveries[0].Payload, "text=now")
+
+	// Another user of the team may not see the deliveries of the command.
+	th.LoginBasic2()
+	_, resp, err := th.Client.GetCommandDeliveries(context.Background(), cmd.Id, 0, 10)
+	require.Error(t, err)
+	assert.Contains(t, []int{http.StatusForbidden, http.StatusNotFound}, resp.StatusCode)
+}
+
 func TestExecuteCommandAgainstChannelOnAnotherTeam(t *testing.T) {
 	th := Setup(t).InitBasic()
 	defer th.TearDown()
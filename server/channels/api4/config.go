@@ -32,8 +32,11 @@ const (
 func (api *API) InitConfig() {
 	api.BaseRoutes.APIRoot.Handle("/config", api.APISessionRequired(getConfig)).Methods("GET")
 	api.BaseRoutes.APIRoot.Handle("/config", api.APISessionRequired(updateConfig)).Methods("PUT")
+	api.BaseRoutes.APIRoot.Handle("/config/validate", api.APISessionRequired(validateConfig)).Methods("PUT")
 	api.BaseRoutes.APIRoot.Handle("/config/patch", api.APISessionRequired(patchConfig)).Methods("PUT")
 	api.BaseRoutes.APIRoot.Handle("/config/reload", api.APISessionRequired(configReload)).Methods("POST")
+	api.BaseRoutes.APIRoot.Handle("/config/history", api.APISessionRequired(getConfigHistory)).Methods("GET")
+	api.BaseRoutes.APIRoot.Handle("/config/rollback/{config_version:[0-9]+}", api.APISessionRequired(rollbackConfig)).Methods("POST")
 	api.BaseRoutes.APIRoot.Handle("/config/client", api.APIHandler(getClientConfig)).Methods("GET")
 	api.BaseRoutes.APIRoot.Handle("/config/environment", api.APISessionRequired(getEnvironmentConfig)).Methods("GET")
 }
@@ -129,11 +132,6 @@ func updateConfig(c *Context, w http.ResponseWriter, r *http.Request) {
 	}
 
 	appCfg := c.App.Config()
-	if *appCfg.ServiceSettings.SiteURL != "" && *cfg.ServiceSettings.SiteURL == "" {
-		c.Err = model.NewAppError("updateConfig", "api.config.update_config.clear_siteurl.app_error", nil, "", http.StatusBadRequest)
-		return
-	}
-
 	cfg, err = config.Merge(appCfg, cfg, &utils.MergeConfig{
 		StructFieldFilter: func(structField reflect.StructField, base, patch reflect.Value) bool {
 			return writeFilter(c, structField)
@@ -167,17 +165,11 @@ func updateConfig(c *Context, w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	// if ES autocomplete was enabled, we need to make sure that index has been checked.
-	// we need to stop enabling ES autocomplete otherwise.
-	if !*appCfg.ElasticsearchSettings.EnableAutocomplete && *cfg.ElasticsearchSettings.EnableAutocomplete {
-		if !c.App.SearchEngine().ElasticsearchEngine.IsAutocompletionEnabled() {
-			c.Err = model.NewAppError("updateConfig", "api.config.update.elasticsearch.autocomplete_cannot_be_enabled_error", nil, "", http.StatusBadRequest)
-			return
-		}
+	if appErr := checkConfigChange(c, "updateConfig", appCfg, cfg); appErr != nil {
+		c.Err = appErr
+		return
 	}
 
-	c.App.HandleMessageExportConfig(cfg, appCfg)
-
 	if appErr := cfg.IsValid(); appErr != nil {
 		c.Err = appErr
 		return
@@ -189,13 +181,9 @@ func updateConfig(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// If the config for default server locale has changed, reinitialize the server's translations.
-	if oldCfg.LocalizationSettings.DefaultServerLocale != newCfg.LocalizationSettings.DefaultServerLocale {
-		s := newCfg.LocalizationSettings
-		if err = i18n.InitTranslations(*s.DefaultServerLocale, *s.DefaultClientLocale); err != nil {
-			c.Err = model.NewAppError("updateConfig", "api.config.update_config.translations.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
-			return
-		}
+	if appErr := reloadConfigTranslations("updateConfig", oldCfg, newCfg); appErr != nil {
+		c.Err = appErr
+		return
 	}
 
 	diffs, err := config.Diff(oldCfg, newCfg)
@@ -205,6 +193,10 @@ func updateConfig(c *Context, w http.ResponseWriter, r *http.Request) {
 	}
 	auditRec.AddEventPriorState(&diffs)
 
+	if _, appErr := c.App.RecordConfigChange(c.AppContext.Session().UserId, oldCfg, newCfg); appErr != nil {
+		c.Logger.Warn("Failed to record the config change", mlog.Err(appErr))
+	}
+
 	newCfg.Sanitize()
 
 	cfg, err = config.Merge(&model.Config{}, newCfg, &utils.MergeConfig{
@@ -238,6 +230,86 @@ func updateConfig(c *Context, w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// checkConfigChange applies the checks shared by the handlers that replace the configuration to
+// cfg, the merged configuration about to be saved in place of appCfg.
+func checkConfigChange(c *Context, where string, appCfg, cfg *model.Config) *model.AppError {
+	if *appCfg.ServiceSettings.SiteURL != "" && *cfg.ServiceSettings.SiteURL == "" {
+		return model.NewAppError(where, "api.config.update_config.clear_siteurl.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	// if ES autocomplete was enabled, we need to make sure that index has been checked.
+	// we need to stop enabling ES autocomplete otherwise.
+	if !*appCfg.ElasticsearchSettings.EnableAutocomplete && *cfg.ElasticsearchSettings.EnableAutocomplete {
+		if !c.App.SearchEngine().ElasticsearchEngine.IsAutocompletionEnabled() {
+			return model.NewAppError(where, "api.config.update.elasticsearch.autocomplete_cannot_be_enabled_error", nil, "", http.StatusBadRequest)
+		}
+	}
+
+	c.App.HandleMessageExportConfig(cfg, appCfg)
+
+	// Secret references can only be introduced through the config file or the environment.
+	if paths := config.NewSecretReferences(cfg, c.App.Srv().Platform().GetConfigStore().GetNoEnv()); len(paths) > 0 {
+		return model.NewAppError(where, "api.config.update_config.secret_reference.app_error", map[string]any{"Name": paths[0]}, "", http.StatusForbidden)
+	}
+
+	return nil
+}
+
+// reloadConfigTranslations reinitializes the server's translations if the saved configuration
+// changed the default server locale.
+func reloadConfigTranslations(where string, oldCfg, newCfg *model.Config) *model.AppError {
+	if oldCfg.LocalizationSettings.DefaultServerLocale == newCfg.LocalizationSettings.DefaultServerLocale {
+		return nil
+	}
+
+	s := newCfg.LocalizationSettings
+	if err := i18n.InitTranslations(*s.DefaultServerLocale, *s.DefaultClientLocale); err != nil {
+		return model.NewAppError(where, "api.config.update_config.translations.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return nil
+}
+
+func validateConfig(c *Context, w http.ResponseWriter, r *http.Request) {
+	var cfg *model.Config
+	err := json.NewDecoder(r.Body).Decode(&cfg)
+	if err != nil || cfg == nil {
+		c.SetInvalidParamWithErr("config", err)
+		return
+	}
+
+	cfg.SetDefaults()
+
+	if !c.App.SessionHasPermissionToAny(*c.AppContext.Session(), model.SysconsoleWritePermissions) {
+		c.SetPermissionError(model.SysconsoleWritePermissions...)
+		return
+	}
+
+	// Validate the config that updateConfig would apply: only the settings the session is allowed
+	// to write are taken from the candidate, and the sanitized secrets are the current ones.
+	appCfg := c.App.Config()
+	cfg, err = config.Merge(appCfg, cfg, &utils.MergeConfig{
+		StructFieldFilter: func(structField reflect.StructField, base, patch reflect.Value) bool {
+			return writeFilter(c, structField)
+		},
+	})
+	if err != nil {
+		c.Err = model.NewAppError("validateConfig", "api.config.update_config.restricted_merge.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		return
+	}
+	config.Desanitize(appCfg, cfg)
+	c.App.HandleMessageExportConfig(cfg, appCfg)
+
+	result := c.App.ValidateConfig(c.AppContext, cfg, r.URL.Query().Get("check_connections") == "true")
+	for _, validationErr := range result.Errors {
+		validationErr.Error.Translate(c.AppContext.T)
+	}
+
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
 func getClientConfig(c *Context, w http.ResponseWriter, r *http.Request) {
 	format := r.URL.Query().Get("format")
 
@@ -256,6 +328,16 @@ func getClientConfig(c *Context, w http.ResponseWriter, r *http.Request) {
 		config = c.App.Srv().Platform().LimitedClientConfigWithComputed()
 	} else {
 		config = c.App.Srv().Platform().ClientConfigWithComputed()
+
+		// Feature flags can be targeted to some of the users only.
+		flags, appErr := c.App.GetFeatureFlagsForUser(c.AppContext.Session().UserId)
+		if appErr != nil {
+			c.Err = appErr
+			return
+		}
+		for key, value := range flags.ToMap() {
+			config["FeatureFlag"+key] = value
+		}
 	}
 
 	w.Write([]byte(model.MapToJSON(config)))
@@ -289,11 +371,6 @@ func patchConfig(c *Context, w http.ResponseWriter, r *http.Request) {
 	}
 
 	appCfg := c.App.Config()
-	if *appCfg.ServiceSettings.SiteURL != "" && cfg.ServiceSettings.SiteURL != nil && *cfg.ServiceSettings.SiteURL == "" {
-		c.Err = model.NewAppError("patchConfig", "api.config.update_config.clear_siteurl.app_error", nil, "", http.StatusBadRequest)
-		return
-	}
-
 	filterFn := func(structField reflect.StructField, base, patch reflect.Value) bool {
 		return writeFilter(c, structField)
 	}
@@ -321,10 +398,6 @@ func patchConfig(c *Context, w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	if cfg.MessageExportSettings.EnableExport != nil {
-		c.App.HandleMessageExportConfig(cfg, appCfg)
-	}
-
 	updatedCfg, err := config.Merge(appCfg, cfg, &utils.MergeConfig{
 		StructFieldFilter: filterFn,
 	})
@@ -333,6 +406,11 @@ func patchConfig(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if appErr := checkConfigChange(c, "patchConfig", appCfg, updatedCfg); appErr != nil {
+		c.Err = appErr
+		return
+	}
+
 	appErr := updatedCfg.IsValid()
 	if appErr != nil {
 		c.Err = appErr
@@ -345,6 +423,11 @@ func patchConfig(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if appErr = reloadConfigTranslations("patchConfig", oldCfg, newCfg); appErr != nil {
+		c.Err = appErr
+		return
+	}
+
 	diffs, err := config.Diff(oldCfg, newCfg)
 	if err != nil {
 		c.Err = model.NewAppError("patchConfig", "api.config.patch_config.diff.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
@@ -353,6 +436,10 @@ func patchConfig(c *Context, w http.ResponseWriter, r *http.Request) {
 
 	auditRec.AddEventPriorState(&diffs)
 
+	if _, appErr := c.App.RecordConfigChange(c.AppContext.Session().UserId, oldCfg, newCfg); appErr != nil {
+		c.Logger.Warn("Failed to record the config change", mlog.Err(appErr))
+	}
+
 	newCfg.Sanitize()
 
 	auditRec.Success()
@@ -445,3 +532,118 @@ func makeFilterConfigByPermission(accessType filterType) func(c *Context, struct
 		return c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem)
 	}
 }
+
+func getConfigHistory(c *Context, w http.ResponseWriter, r *http.Request) {
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem) {
+		c.SetPermissionError(model.PermissionManageSystem)
+		return
+	}
+
+	perPage := min(c.Params.PerPage, model.ConfigHistoryMaxPerPage)
+	history, appErr := c.App.GetConfigHistory(c.Params.Page, perPage)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(history); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func rollbackConfig(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireConfigVersion()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("rollbackConfig", audit.Fail)
+	audit.AddEventParameter(auditRec, "config_version", c.Params.ConfigVersion)
+	defer c.LogAuditRec(auditRec)
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem) {
+		c.SetPermissionError(model.PermissionManageSystem)
+		return
+	}
+
+	restoredCfg, appErr := c.App.GetConfigAtVersion(c.Params.ConfigVersion)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	// Only restore the settings the session is allowed to write, the same way updateConfig does.
+	appCfg := c.App.Config()
+	cfg, err := config.Merge(appCfg, restoredCfg, &utils.MergeConfig{
+		StructFieldFilter: func(structField reflect.StructField, base, patch reflect.Value) bool {
+			return writeFilter(c, structField)
+		},
+	})
+	if err != nil {
+		c.Err = model.NewAppError("rollbackConfig", "api.config.rollback_config.restricted_merge.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		return
+	}
+
+	*cfg.PluginSettings.EnableUploads = *appCfg.PluginSettings.EnableUploads
+	cfg.PluginSettings.SignaturePublicKeyFiles = appCfg.PluginSettings.SignaturePublicKeyFiles
+	if !*appCfg.PluginSettings.EnableUploads {
+		*cfg.PluginSettings.MarketplaceURL = *appCfg.PluginSettings.MarketplaceURL
+	}
+
+	if c.App.Channels().License().IsCloud() && *appCfg.ComplianceSettings.Directory != *cfg.ComplianceSettings.Directory {
+		c.Err = model.NewAppError("rollbackConfig", "api.config.update_config.not_allowed_security.app_error", map[string]any{"Name": "ComplianceSettings.Directory"}, "", http.StatusForbidden)
+		return
+	}
+
+	if appErr = checkConfigChange(c, "rollbackConfig", appCfg, cfg); appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	if appErr = cfg.IsValid(); appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	oldCfg, newCfg, appErr := c.App.SaveConfig(cfg, true)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	if appErr = reloadConfigTranslations("rollbackConfig", oldCfg, newCfg); appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	diffs, err := config.Diff(oldCfg, newCfg)
+	if err != nil {
+		c.Err = model.NewAppError("rollbackConfig", "api.config.update_config.diff.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		return
+	}
+	auditRec.AddEventPriorState(&diffs)
+
+	if _, appErr := c.App.RecordConfigChange(c.AppContext.Session().UserId, oldCfg, newCfg); appErr != nil {
+		c.Logger.Warn("Failed to record the config change", mlog.Err(appErr))
+	}
+
+	newCfg.Sanitize()
+
+	cfg, err = config.Merge(&model.Config{}, newCfg, &utils.MergeConfig{
+		StructFieldFilter: func(structField reflect.StructField, base, patch reflect.Value) bool {
+			return readFilter(c, structField)
+		},
+	})
+	if err != nil {
+		c.Err = model.NewAppError("rollbackConfig", "api.config.rollback_config.restricted_merge.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		return
+	}
+
+	auditRec.AddEventObjectType("config")
+	auditRec.Success()
+
+	w.Header().Set("Cache-Control", "no-cache, no-store, must-revalidate")
+	if err := json.NewEncoder(w).Encode(cfg); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
@@ -478,6 +478,35 @@ func TestUpdateConfigRestrictSystemAdmin(t *testing.T) {
 	})
 }
 
+func TestUpdateConfigSecretReferences(t *testing.T) {
+	th := Setup(t)
+	defer th.TearDown()
+
+	cfg, _, err := th.SystemAdminClient.GetConfig(context.Background())
+	require.NoError(t, err)
+
+	cfg.EmailSettings.SMTPPassword = model.NewString("file:///proc/self/environ")
+	_, resp, err := th.SystemAdminClient.UpdateConfig(context.Background(), cfg)
+	require.Error(t, err)
+	CheckForbiddenStatus(t, resp)
+
+	_, resp, err = th.SystemAdminClient.PatchConfig(context.Background(), &model.Config{
+		EmailSettings: model.EmailSettings{SMTPPassword: model.NewString("vault://secret/data/mattermost#smtp")},
+	})
+	require.Error(t, err)
+	CheckForbiddenStatus(t, resp)
+
+	t.Run("non-sensitive settings are saved as they are", func(t *testing.T) {
+		cfg, _, err := th.SystemAdminClient.GetConfig(context.Background())
+		require.NoError(t, err)
+
+		cfg.TeamSettings.SiteName = model.NewString("file:///proc/self/environ")
+		cfg, _, err = th.SystemAdminClient.UpdateConfig(context.Background(), cfg)
+		require.NoError(t, err)
+		require.Equal(t, "file:///proc/self/environ", *cfg.TeamSettings.SiteName)
+	})
+}
+
 func TestUpdateConfigDiffInAuditRecord(t *testing.T) {
 	logFile, err := os.CreateTemp("", "adv.log")
 	require.NoError(t, err)
@@ -867,3 +896,127 @@ func TestMigrateConfig(t *testing.T) {
 		require.NoError(t, err)
 	})
 }
+
+func TestValidateConfig(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	cfg, _, err := th.SystemAdminClient.GetConfig(context.Background())
+	require.NoError(t, err)
+
+	t.Run("users can't validate the config", func(t *testing.T) {
+		_, resp, err := th.Client.ValidateConfig(context.Background(), cfg, false)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("valid config", func(t *testing.T) {
+		result, _, err := th.SystemAdminClient.ValidateConfig(context.Background(), cfg, false)
+		require.NoError(t, err)
+		assert.True(t, result.Valid)
+		assert.Empty(t, result.Errors)
+	})
+
+	t.Run("invalid config isn't applied", func(t *testing.T) {
+		invalidCfg := cfg.Clone()
+		*invalidCfg.TeamSettings.MaxUsersPerTeam = 0
+		*invalidCfg.PasswordSettings.MinimumLength = 1
+
+		result, _, err := th.SystemAdminClient.ValidateConfig(context.Background(), invalidCfg, false)
+		require.NoError(t, err)
+		assert.False(t, result.Valid)
+		require.Len(t, result.Errors, 2)
+		assert.Equal(t, "TeamSettings", result.Errors[0].Field)
+		assert.Equal(t, "model.config.is_valid.max_users.app_error", result.Errors[0].Error.Id)
+		assert.NotEqual(t, result.Errors[0].Error.Id, result.Errors[0].Error.Message)
+		assert.Equal(t, "PasswordSettings.MinimumLength", result.Errors[1].Field)
+
+		assert.Equal(t, *cfg.TeamSettings.MaxUsersPerTeam, *th.App.Config().TeamSettings.MaxUsersPerTeam)
+	})
+
+	t.Run("connection checks", func(t *testing.T) {
+		unreachableCfg := cfg.Clone()
+		*unreachableCfg.EmailSettings.SendEmailNotifications = true
+		*unreachableCfg.EmailSettings.SMTPServer = "localhost"
+		*unreachableCfg.EmailSettings.SMTPPort = "1"
+
+		result, _, err := th.SystemAdminClient.ValidateConfig(context.Background(), unreachableCfg, false)
+		require.NoError(t, err)
+		assert.True(t, result.Valid)
+
+		result, _, err = th.SystemAdminClient.ValidateConfig(context.Background(), unreachableCfg, true)
+		require.NoError(t, err)
+		assert.False(t, result.Valid)
+		require.Len(t, result.Errors, 1)
+		assert.Equal(t, "EmailSettings", result.Errors[0].Field)
+		assert.Equal(t, "app.config.validate.smtp_connection.app_error", result.Errors[0].Error.Id)
+	})
+}
+
+func TestConfigHistoryAndRollback(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.SiteURL = "" })
+
+	cfg, _, err := th.SystemAdminClient.GetConfig(context.Background())
+	require.NoError(t, err)
+	originalName := *cfg.TeamSettings.SiteName
+
+	*cfg.TeamSettings.SiteName = "first"
+	_, _, err = th.SystemAdminClient.UpdateConfig(context.Background(), cfg)
+	require.NoError(t, err)
+
+	_, _, err = th.SystemAdminClient.PatchConfig(context.Background(), &model.Config{TeamSettings: model.TeamSettings{SiteName: model.NewString("second")}})
+	require.NoError(t, err)
+
+	t.Run("users can't read the history", func(t *testing.T) {
+		_, resp, err := th.Client.GetConfigHistory(context.Background(), 0, 10)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	// Other tests can record changes too, only the latest two are ours.
+	history, _, err := th.SystemAdminClient.GetConfigHistory(context.Background(), 0, 2)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	assert.Equal(t, history[1].Version+1, history[0].Version)
+	assert.Equal(t, th.SystemAdminUser.Id, history[0].UserId)
+	assert.Contains(t, history[0].Diff, "TeamSettings.SiteName")
+	assert.Contains(t, history[1].Diff, originalName)
+
+	t.Run("users can't roll back", func(t *testing.T) {
+		_, resp, err := th.Client.RollbackConfig(context.Background(), history[1].Version)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("unknown version", func(t *testing.T) {
+		_, resp, err := th.SystemAdminClient.RollbackConfig(context.Background(), history[0].Version+100)
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+	})
+
+	t.Run("roll back", func(t *testing.T) {
+		cfg, _, err := th.SystemAdminClient.RollbackConfig(context.Background(), history[1].Version)
+		require.NoError(t, err)
+		assert.Equal(t, "first", *cfg.TeamSettings.SiteName)
+		assert.Equal(t, "first", *th.App.Config().TeamSettings.SiteName)
+
+		latest, _, err := th.SystemAdminClient.GetConfigHistory(context.Background(), 0, 1)
+		require.NoError(t, err)
+		require.Len(t, latest, 1)
+		assert.Equal(t, history[0].Version+1, latest[0].Version)
+	})
+
+	t.Run("can't clear the site URL", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.SiteURL = "http://example.com" })
+		defer th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.SiteURL = "" })
+
+		_, resp, err := th.SystemAdminClient.RollbackConfig(context.Background(), history[1].Version)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+		CheckErrorID(t, err, "api.config.update_config.clear_siteurl.app_error")
+		assert.Equal(t, "http://example.com", *th.App.Config().ServiceSettings.SiteURL)
+	})
+}
@@ -0,0 +1,208 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/v8/channels/audit"
+)
+
+func (api *API) InitCustomProfileAttribute() {
+	api.BaseRoutes.CustomProfileAttributeFields.Handle("", api.APISessionRequired(getCustomProfileAttributeFields)).Methods("GET")
+	api.BaseRoutes.CustomProfileAttributeFields.Handle("", api.APISessionRequired(createCustomProfileAttributeField)).Methods("POST")
+
+	api.BaseRoutes.CustomProfileAttributeField.Handle("", api.APISessionRequired(updateCustomProfileAttributeField)).Methods("PUT")
+	api.BaseRoutes.CustomProfileAttributeField.Handle("", api.APISessionRequired(deleteCustomProfileAttributeField)).Methods("DELETE")
+
+	api.BaseRoutes.User.Handle("/custom_profile_attributes", api.APISessionRequired(getUserCustomProfileAttributes)).Methods("GET")
+	api.BaseRoutes.User.Handle("/custom_profile_attributes", api.APISessionRequired(patchUserCustomProfileAttributes)).Methods("PATCH")
+}
+
+func getCustomProfileAttributeFields(c *Context, w http.ResponseWriter, r *http.Request) {
+	fields, err := c.App.GetCustomProfileAttributeFields()
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(fields); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func createCustomProfileAttributeField(c *Context, w http.ResponseWriter, r *http.Request) {
+	var field model.CustomProfileAttributeField
+	if jsonErr := json.NewDecoder(r.Body).Decode(&field); jsonErr != nil {
+		c.SetInvalidParamWithErr("custom_profile_attribute_field", jsonErr)
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("createCustomProfileAttributeField", audit.Fail)
+	audit.AddEventParameterAuditable(auditRec, "custom_profile_attribute_field", &field)
+	defer c.LogAuditRec(auditRec)
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem) {
+		c.SetPermissionError(model.PermissionManageSystem)
+		return
+	}
+
+	savedField, err := c.App.CreateCustomProfileAttributeField(&field)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddEventResultState(savedField)
+	auditRec.AddEventObjectType("custom_profile_attribute_field")
+
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(savedField); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func updateCustomProfileAttributeField(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireFieldId()
+	if c.Err != nil {
+		return
+	}
+
+	var field model.CustomProfileAttributeField
+	if jsonErr := json.NewDecoder(r.Body).Decode(&field); jsonErr != nil || field.Id != c.Params.FieldId {
+		c.SetInvalidParamWithErr("custom_profile_attribute_field", jsonErr)
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("updateCustomProfileAttributeField", audit.Fail)
+	audit.AddEventParameterAuditable(auditRec, "custom_profile_attribute_field", &field)
+	defer c.LogAuditRec(auditRec)
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem) {
+		c.SetPermissionError(model.PermissionManageSystem)
+		return
+	}
+
+	oldField, err := c.App.GetCustomProfileAttributeField(c.Params.FieldId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+	auditRec.AddEventPriorState(oldField)
+
+	if field.Type != "" && field.Type != oldField.Type {
+		c.SetInvalidParam("type")
+		return
+	}
+
+	updatedField, err := c.App.UpdateCustomProfileAttributeField(oldField, &field)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddEventResultState(updatedField)
+	auditRec.AddEventObjectType("custom_profile_attribute_field")
+
+	if err := json.NewEncoder(w).Encode(updatedField); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func deleteCustomProfileAttributeField(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireFieldId()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("deleteCustomProfileAttributeField", audit.Fail)
+	audit.AddEventParameter(auditRec, "field_id", c.Params.FieldId)
+	defer c.LogAuditRec(auditRec)
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem) {
+		c.SetPermissionError(model.PermissionManageSystem)
+		return
+	}
+
+	field, err := c.App.GetCustomProfileAttributeField(c.Params.FieldId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+	auditRec.AddEventPriorState(field)
+	auditRec.AddEventObjectType("custom_profile_attribute_field")
+
+	if err := c.App.DeleteCustomProfileAttributeField(field.Id); err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+
+	ReturnStatusOK(w)
+}
+
+func getUserCustomProfileAttributes(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	canSee, err := c.App.UserCanSeeOtherUser(c.AppContext, c.AppContext.Session().UserId, c.Params.UserId)
+	if err != nil || !canSee {
+		c.SetPermissionError(model.PermissionViewMembers)
+		return
+	}
+
+	showHidden := c.AppContext.Session().UserId == c.Params.UserId || c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem)
+	values, err := c.App.GetCustomProfileAttributeValues(c.Params.UserId, showHidden)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(values); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func patchUserCustomProfileAttributes(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	var patch map[string]string
+	if jsonErr := json.NewDecoder(r.Body).Decode(&patch); jsonErr != nil {
+		c.SetInvalidParamWithErr("custom_profile_attributes", jsonErr)
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("patchUserCustomProfileAttributes", audit.Fail)
+	audit.AddEventParameter(auditRec, "user_id", c.Params.UserId)
+	audit.AddEventParameter(auditRec, "custom_profile_attributes", patch)
+	defer c.LogAuditRec(auditRec)
+
+	if !c.App.SessionHasPermissionToUser(*c.AppContext.Session(), c.Params.UserId) {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+		return
+	}
+
+	values, err := c.App.PatchCustomProfileAttributeValues(c.Params.UserId, patch)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+
+	if err := json.NewEncoder(w).Encode(values); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
@@ -0,0 +1,191 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+func TestCustomProfileAttributeFields(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	newField := func() *model.CustomProfileAttributeField {
+		return &model.CustomProfileAttributeField{
+			Name:    "Location",
+			Type:    model.CustomProfileAttributeTypeSelect,
+			Options: model.StringArray{"Berlin", "Toronto"},
+		}
+	}
+
+	t.Run("system admins manage fields", func(t *testing.T) {
+		field, resp, err := th.SystemAdminClient.CreateCustomProfileAttributeField(context.Background(), newField())
+		require.NoError(t, err)
+		CheckCreatedStatus(t, resp)
+		assert.Equal(t, model.CustomProfileAttributeVisibilityAlways, field.Visibility)
+
+		field.Name = "Office"
+		field, _, err = th.SystemAdminClient.UpdateCustomProfileAttributeField(context.Background(), field)
+		require.NoError(t, err)
+		assert.Equal(t, "Office", field.Name)
+
+		field.Type = model.CustomProfileAttributeTypeText
+		_, resp, err = th.SystemAdminClient.UpdateCustomProfileAttributeField(context.Background(), field)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+
+		fields, _, err := th.Client.GetCustomProfileAttributeFields(context.Background())
+		require.NoError(t, err)
+		require.Len(t, fields, 1)
+		assert.Equal(t, field.Id, fields[0].Id)
+
+		_, err = th.SystemAdminClient.DeleteCustomProfileAttributeField(context.Background(), field.Id)
+		require.NoError(t, err)
+
+		fields, _, err = th.Client.GetCustomProfileAttributeFields(context.Background())
+		require.NoError(t, err)
+		assert.Empty(t, fields)
+	})
+
+	t.Run("users can't manage fields", func(t *testing.T) {
+		_, resp, err := th.Client.CreateCustomProfileAttributeField(context.Background(), newField())
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		field, _, err := th.SystemAdminClient.CreateCustomProfileAttributeField(context.Background(), newField())
+		require.NoError(t, err)
+		defer th.SystemAdminClient.DeleteCustomProfileAttributeField(context.Background(), field.Id)
+
+		_, resp, err = th.Client.UpdateCustomProfileAttributeField(context.Background(), field)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		resp, err = th.Client.DeleteCustomProfileAttributeField(context.Background(), field.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("invalid field", func(t *testing.T) {
+		field := newField()
+		field.Options = nil
+		_, resp, err := th.SystemAdminClient.CreateCustomProfileAttributeField(context.Background(), field)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+}
+
+func TestUserCustomProfileAttributes(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	location, _, err := th.SystemAdminClient.CreateCustomProfileAttributeField(context.Background(), &model.CustomProfileAttributeField{
+		Name:    "Location",
+		Type:    model.CustomProfileAttributeTypeSelect,
+		Options: model.StringArray{"Berlin", "Toronto"},
+	})
+	require.NoError(t, err)
+	costCenter, _, err := th.SystemAdminClient.CreateCustomProfileAttributeField(context.Background(), &model.CustomProfileAttributeField{
+		Name:            "Cost center",
+		Type:            model.CustomProfileAttributeTypeText,
+		ValidationRegex: "^[0-9]{4}$",
+		Visibility:      model.CustomProfileAttributeVisibilityHidden,
+	})
+	require.NoError(t, err)
+	synced, _, err := th.SystemAdminClient.CreateCustomProfileAttributeField(context.Background(), &model.CustomProfileAttributeField{
+		Name:          "Department",
+		Type:          model.CustomProfileAttributeTypeText,
+		LdapAttribute: "department",
+	})
+	require.NoError(t, err)
+
+	t.Run("users set their own values", func(t *testing.T) {
+		values, _, err := th.Client.PatchUserCustomProfileAttributes(context.Background(), th.BasicUser.Id, map[string]string{
+			location.Id:   "Berlin",
+			costCenter.Id: "1234",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{location.Id: "Berlin", costCenter.Id: "1234"}, values)
+
+		user, _, err := th.Client.GetUser(context.Background(), th.BasicUser.Id, "")
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{location.Id: "Berlin", costCenter.Id: "1234"}, user.CustomProfileAttributes)
+	})
+
+	t.Run("hidden values are only shown to the user and admins", func(t *testing.T) {
+		th.LoginBasic2()
+		defer th.LoginBasic()
+
+		values, _, err := th.Client.GetUserCustomProfileAttributes(context.Background(), th.BasicUser.Id)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{location.Id: "Berlin"}, values)
+
+		user, _, err := th.Client.GetUser(context.Background(), th.BasicUser.Id, "")
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{location.Id: "Berlin"}, user.CustomProfileAttributes)
+
+		values, _, err = th.SystemAdminClient.GetUserCustomProfileAttributes(context.Background(), th.BasicUser.Id)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{location.Id: "Berlin", costCenter.Id: "1234"}, values)
+	})
+
+	t.Run("invalid values", func(t *testing.T) {
+		_, resp, err := th.Client.PatchUserCustomProfileAttributes(context.Background(), th.BasicUser.Id, map[string]string{location.Id: "Paris"})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+
+		_, resp, err = th.Client.PatchUserCustomProfileAttributes(context.Background(), th.BasicUser.Id, map[string]string{costCenter.Id: "abcd"})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+
+		_, resp, err = th.Client.PatchUserCustomProfileAttributes(context.Background(), th.BasicUser.Id, map[string]string{synced.Id: "Sales"})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("users can't set the values of others", func(t *testing.T) {
+		_, resp, err := th.Client.PatchUserCustomProfileAttributes(context.Background(), th.BasicUser2.Id, map[string]string{location.Id: "Toronto"})
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		values, _, err := th.SystemAdminClient.PatchUserCustomProfileAttributes(context.Background(), th.BasicUser2.Id, map[string]string{location.Id: "Toronto"})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{location.Id: "Toronto"}, values)
+	})
+
+	t.Run("filter users", func(t *testing.T) {
+		users, _, err := th.Client.GetUsersWithCustomQueryParameters(context.Background(), 0, 100, "custom_profile_attribute="+location.Id+":Berlin", "")
+		require.NoError(t, err)
+		require.Len(t, users, 1)
+		assert.Equal(t, th.BasicUser.Id, users[0].Id)
+
+		users, _, err = th.Client.SearchUsers(context.Background(), &model.UserSearch{
+			Term:                    th.BasicUser2.Username,
+			CustomProfileAttributes: map[string]string{location.Id: "Toronto"},
+		})
+		require.NoError(t, err)
+		require.Len(t, users, 1)
+		assert.Equal(t, th.BasicUser2.Id, users[0].Id)
+
+		_, resp, err := th.Client.GetUsersWithCustomQueryParameters(context.Background(), 0, 100, "custom_profile_attribute="+costCenter.Id+":1234", "")
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+
+		users, _, err = th.SystemAdminClient.GetUsersWithCustomQueryParameters(context.Background(), 0, 100, "custom_profile_attribute="+costCenter.Id+":1234", "")
+		require.NoError(t, err)
+		require.Len(t, users, 1)
+		assert.Equal(t, th.BasicUser.Id, users[0].Id)
+	})
+
+	t.Run("clear a value", func(t *testing.T) {
+		values, _, err := th.Client.PatchUserCustomProfileAttributes(context.Background(), th.BasicUser.Id, map[string]string{location.Id: ""})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{costCenter.Id: "1234"}, values)
+	})
+}
@@ -0,0 +1,51 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"crypto/subtle"
+	"io"
+	"net/http"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/v8/platform/shared/mail"
+)
+
+func (api *API) InitEmailEvents() {
+	api.BaseRoutes.APIRoot.Handle("/email/events", api.APIHandler(receiveEmailEvents)).Methods("POST")
+}
+
+// receiveEmailEvents accepts the bounce and complaint callbacks of Amazon SES (through Amazon SNS),
+// SendGrid and Mailgun.
+func receiveEmailEvents(c *Context, w http.ResponseWriter, r *http.Request) {
+	secret := *c.App.Config().EmailSettings.EmailEventsSecret
+	if !*c.App.Config().EmailSettings.EnableBounceHandling || secret == "" {
+		c.Err = model.NewAppError("receiveEmailEvents", "api.email_events.disabled.app_error", nil, "", http.StatusNotImplemented)
+		return
+	}
+
+	if subtle.ConstantTimeCompare([]byte(r.URL.Query().Get("secret")), []byte(secret)) != 1 {
+		c.Err = model.NewAppError("receiveEmailEvents", "api.email_events.invalid_secret.app_error", nil, "", http.StatusUnauthorized)
+		return
+	}
+
+	data, err := io.ReadAll(http.MaxBytesReader(w, r.Body, model.EmailEventsMaxSize))
+	if err != nil {
+		c.SetInvalidParamWithErr("events", err)
+		return
+	}
+
+	events, err := mail.ParseEmailEvents(data)
+	if err != nil {
+		c.SetInvalidParamWithErr("events", err)
+		return
+	}
+
+	if appErr := c.App.ProcessEmailEvents(c.AppContext, events); appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	ReturnStatusOK(w)
+}
@@ -0,0 +1,122 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+func TestReceiveEmailEvents(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	secret := model.NewId()
+	eventsURL := th.Client.APIURL + "/email/events?secret=" + url.QueryEscape(secret)
+
+	post := func(t *testing.T, requestURL string, body string) int {
+		t.Helper()
+		req, err := http.NewRequest("POST", requestURL, bytes.NewReader([]byte(body)))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := th.Client.HTTPClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	bounce := `[{"email": "` + th.BasicUser.Email + `", "event": "bounce", "type": "bounce", "reason": "550 5.1.1 user unknown"}]`
+
+	t.Run("disabled", func(t *testing.T) {
+		assert.Equal(t, http.StatusNotImplemented, post(t, eventsURL, bounce))
+	})
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.EmailSettings.EnableBounceHandling = true
+		*cfg.EmailSettings.EmailEventsSecret = secret
+	})
+
+	t.Run("invalid secret", func(t *testing.T) {
+		assert.Equal(t, http.StatusUnauthorized, post(t, th.Client.APIURL+"/email/events?secret=invalid", bounce))
+	})
+
+	t.Run("invalid events", func(t *testing.T) {
+		assert.Equal(t, http.StatusBadRequest, post(t, eventsURL, `{"unknown": true}`))
+	})
+
+	t.Run("invalid subscription url", func(t *testing.T) {
+		assert.Equal(t, http.StatusBadRequest, post(t, eventsURL, `{"Type": "SubscriptionConfirmation", "SubscribeURL": "http://localhost/confirm"}`))
+	})
+
+	t.Run("bounce", func(t *testing.T) {
+		require.Equal(t, http.StatusOK, post(t, eventsURL, bounce))
+
+		status, _, err := th.SystemAdminClient.GetUserEmailStatus(context.Background(), th.BasicUser.Id)
+		require.NoError(t, err)
+		assert.True(t, status.Undeliverable)
+		assert.Equal(t, model.UndeliverableEmailReasonBounce, status.Reason)
+		assert.Equal(t, "550 5.1.1 user unknown", status.Detail)
+
+		assert.True(t, th.App.Srv().EmailService.IsUndeliverable(th.BasicUser.Email))
+	})
+}
+
+func TestUserEmailStatus(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.EmailSettings.EnableBounceHandling = true })
+
+	_, err := th.App.Srv().Store().UndeliverableEmail().Save(&model.UndeliverableEmail{
+		Email:  th.BasicUser2.Email,
+		Reason: model.UndeliverableEmailReasonComplaint,
+	})
+	require.NoError(t, err)
+
+	t.Run("requires permission", func(t *testing.T) {
+		_, resp, err := th.Client.GetUserEmailStatus(context.Background(), th.BasicUser2.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		resp, err = th.Client.ClearUserEmailUndeliverable(context.Background(), th.BasicUser2.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("deliverable", func(t *testing.T) {
+		status, _, err := th.SystemAdminClient.GetUserEmailStatus(context.Background(), th.BasicUser.Id)
+		require.NoError(t, err)
+		assert.Equal(t, th.BasicUser.Id, status.UserId)
+		assert.False(t, status.Undeliverable)
+	})
+
+	t.Run("undeliverable then cleared", func(t *testing.T) {
+		status, _, err := th.SystemAdminClient.GetUserEmailStatus(context.Background(), th.BasicUser2.Id)
+		require.NoError(t, err)
+		assert.True(t, status.Undeliverable)
+		assert.Equal(t, model.UndeliverableEmailReasonComplaint, status.Reason)
+
+		_, err = th.SystemAdminClient.ClearUserEmailUndeliverable(context.Background(), th.BasicUser2.Id)
+		require.NoError(t, err)
+
+		status, _, err = th.SystemAdminClient.GetUserEmailStatus(context.Background(), th.BasicUser2.Id)
+		require.NoError(t, err)
+		assert.False(t, status.Undeliverable)
+		assert.False(t, th.App.Srv().EmailService.IsUndeliverable(th.BasicUser2.Email))
+	})
+
+	t.Run("unknown user", func(t *testing.T) {
+		_, resp, err := th.SystemAdminClient.GetUserEmailStatus(context.Background(), model.NewId())
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+	})
+}
@@ -0,0 +1,83 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+)
+
+func (api *API) InitEmailTemplate() {
+	api.BaseRoutes.APIRoot.Handle("/email/templates", api.APISessionRequired(getEmailTemplates)).Methods("GET")
+	api.BaseRoutes.APIRoot.Handle("/email/templates/validate", api.APISessionRequired(validateEmailTemplateOverride)).Methods("POST")
+	api.BaseRoutes.APIRoot.Handle("/email/templates/preview", api.APISessionRequired(previewEmailTemplate)).Methods("POST")
+}
+
+func getEmailTemplates(c *Context, w http.ResponseWriter, r *http.Request) {
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionSysconsoleReadSiteCustomization) {
+		c.SetPermissionError(model.PermissionSysconsoleReadSiteCustomization)
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(c.App.GetEmailTemplates()); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func validateEmailTemplateOverride(c *Context, w http.ResponseWriter, r *http.Request) {
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionSysconsoleWriteSiteCustomization) {
+		c.SetPermissionError(model.PermissionSysconsoleWriteSiteCustomization)
+		return
+	}
+
+	var override model.EmailTemplateOverride
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, 2*model.EmailTemplateOverrideMaxSize)).Decode(&override); err != nil {
+		c.SetInvalidParamWithErr("override", err)
+		return
+	}
+	if len(override.Content) > model.EmailTemplateOverrideMaxSize {
+		c.SetInvalidParam("content")
+		return
+	}
+
+	emailTemplates, appErr := c.App.ValidateEmailTemplateOverride(&override)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(emailTemplates); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func previewEmailTemplate(c *Context, w http.ResponseWriter, r *http.Request) {
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionSysconsoleWriteSiteCustomization) {
+		c.SetPermissionError(model.PermissionSysconsoleWriteSiteCustomization)
+		return
+	}
+
+	var request model.EmailTemplatePreviewRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, 2*model.EmailTemplateOverrideMaxSize)).Decode(&request); err != nil {
+		c.SetInvalidParamWithErr("request", err)
+		return
+	}
+	if appErr := request.IsValid(); appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	preview, appErr := c.App.PreviewEmailTemplate(&request)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(preview); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+func TestEmailTemplates(t *testing.T) {
+	th := Setup(t)
+	defer th.TearDown()
+
+	overridesDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(overridesDir, "verify_body.html"), []byte(`{{ define "verify_body" }}<p>Custom {{ .Props.Title }}</p>{{ end }}`), 0600))
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.EmailSettings.EmailTemplateOverridesDirectory = overridesDir })
+	defer th.App.UpdateConfig(func(cfg *model.Config) { *cfg.EmailSettings.EmailTemplateOverridesDirectory = "" })
+
+	th.LoginBasic()
+
+	t.Run("requires permission", func(t *testing.T) {
+		_, resp, err := th.Client.GetEmailTemplates(context.Background())
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = th.Client.ValidateEmailTemplateOverride(context.Background(), &model.EmailTemplateOverride{Content: `{{ define "verify_body" }}{{ end }}`})
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = th.Client.PreviewEmailTemplate(context.Background(), &model.EmailTemplatePreviewRequest{TemplateName: "verify_body"})
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("list", func(t *testing.T) {
+		emailTemplates, _, err := th.SystemAdminClient.GetEmailTemplates(context.Background())
+		require.NoError(t, err)
+
+		found := map[string]bool{}
+		for _, emailTemplate := range emailTemplates {
+			found[emailTemplate.Name] = emailTemplate.Overridden
+		}
+		assert.Contains(t, found, "reset_body")
+		assert.False(t, found["reset_body"])
+		assert.True(t, found["verify_body"])
+	})
+
+	t.Run("validate", func(t *testing.T) {
+		emailTemplates, _, err := th.SystemAdminClient.ValidateEmailTemplateOverride(context.Background(), &model.EmailTemplateOverride{
+			Content: `{{ define "reset_body" }}reset{{ end }}{{ define "custom_partial" }}partial{{ end }}`,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []*model.EmailTemplate{
+			{Name: "custom_partial", Overridden: false},
+			{Name: "reset_body", Overridden: true},
+		}, emailTemplates)
+
+		_, resp, err := th.SystemAdminClient.ValidateEmailTemplateOverride(context.Background(), &model.EmailTemplateOverride{
+			Content: `{{ define "reset_body" }}{{ .Props.Title }`,
+		})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("preview", func(t *testing.T) {
+		preview, _, err := th.SystemAdminClient.PreviewEmailTemplate(context.Background(), &model.EmailTemplatePreviewRequest{
+			TemplateName: "verify_body",
+			Props:        map[string]string{"Title": "<b>title</b>"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "<p>Custom &lt;b&gt;title&lt;/b&gt;</p>", preview.Html)
+
+		preview, _, err = th.SystemAdminClient.PreviewEmailTemplate(context.Background(), &model.EmailTemplatePreviewRequest{
+			TemplateName: "verify_body",
+			Content:      `{{ define "verify_body" }}Draft {{ .Props.Title }}{{ end }}`,
+			Props:        map[string]string{"Title": "title"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "Draft title", preview.Html)
+
+		_, resp, err := th.SystemAdminClient.PreviewEmailTemplate(context.Background(), &model.EmailTemplatePreviewRequest{TemplateName: "unknown"})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+
+		_, resp, err = th.SystemAdminClient.PreviewEmailTemplate(context.Background(), &model.EmailTemplatePreviewRequest{})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+}
@@ -0,0 +1,116 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/v8/channels/audit"
+)
+
+func (api *API) InitFeatureFlag() {
+	api.BaseRoutes.APIRoot.Handle("/feature_flags", api.APISessionRequired(getFeatureFlags)).Methods("GET")
+	api.BaseRoutes.APIRoot.Handle("/feature_flags/{flag_name:[A-Za-z0-9]+}", api.APISessionRequired(getFeatureFlag)).Methods("GET")
+	api.BaseRoutes.APIRoot.Handle("/feature_flags/{flag_name:[A-Za-z0-9]+}/rule", api.APISessionRequired(updateFeatureFlagRule)).Methods("PUT")
+	api.BaseRoutes.APIRoot.Handle("/feature_flags/{flag_name:[A-Za-z0-9]+}/rule", api.APISessionRequired(deleteFeatureFlagRule)).Methods("DELETE")
+}
+
+func getFeatureFlags(c *Context, w http.ResponseWriter, r *http.Request) {
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionSysconsoleReadExperimentalFeatureFlags) {
+		c.SetPermissionError(model.PermissionSysconsoleReadExperimentalFeatureFlags)
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(c.App.GetFeatureFlags()); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func getFeatureFlag(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireFeatureFlagName()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionSysconsoleReadExperimentalFeatureFlags) {
+		c.SetPermissionError(model.PermissionSysconsoleReadExperimentalFeatureFlags)
+		return
+	}
+
+	flag, appErr := c.App.GetFeatureFlag(c.Params.FeatureFlagName)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(flag); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func updateFeatureFlagRule(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireFeatureFlagName()
+	if c.Err != nil {
+		return
+	}
+
+	var rule *model.FeatureFlagRule
+	if err := json.NewDecoder(r.Body).Decode(&rule); err != nil || rule == nil {
+		c.SetInvalidParamWithErr("rule", err)
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("updateFeatureFlagRule", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameter(auditRec, "flag_name", c.Params.FeatureFlagName)
+	audit.AddEventParameter(auditRec, "value", rule.Value)
+	audit.AddEventParameter(auditRec, "percentage", rule.Percentage)
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionSysconsoleWriteExperimentalFeatureFlags) {
+		c.SetPermissionError(model.PermissionSysconsoleWriteExperimentalFeatureFlags)
+		return
+	}
+
+	flag, appErr := c.App.SetFeatureFlagRule(c.Params.FeatureFlagName, rule)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.Success()
+	c.LogAudit("flag=" + flag.Name)
+
+	if err := json.NewEncoder(w).Encode(flag); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func deleteFeatureFlagRule(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireFeatureFlagName()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("deleteFeatureFlagRule", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameter(auditRec, "flag_name", c.Params.FeatureFlagName)
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionSysconsoleWriteExperimentalFeatureFlags) {
+		c.SetPermissionError(model.PermissionSysconsoleWriteExperimentalFeatureFlags)
+		return
+	}
+
+	if appErr := c.App.DeleteFeatureFlagRule(c.Params.FeatureFlagName); appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.Success()
+	c.LogAudit("flag=" + c.Params.FeatureFlagName)
+
+	ReturnStatusOK(w)
+}
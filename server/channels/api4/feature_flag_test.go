@@ -0,0 +1,94 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+func TestFeatureFlagRules(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	t.Run("users can't manage feature flags", func(t *testing.T) {
+		_, resp, err := th.Client.GetFeatureFlags(context.Background())
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = th.Client.UpdateFeatureFlagRule(context.Background(), "TestBoolFeature", &model.FeatureFlagRule{Value: "on"})
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("get feature flags", func(t *testing.T) {
+		flags, _, err := th.SystemAdminClient.GetFeatureFlags(context.Background())
+		require.NoError(t, err)
+		names := []string{}
+		for _, flag := range flags {
+			names = append(names, flag.Name)
+		}
+		assert.Contains(t, names, "TestBoolFeature")
+
+		flag, _, err := th.SystemAdminClient.GetFeatureFlag(context.Background(), "TestBoolFeature")
+		require.NoError(t, err)
+		assert.Equal(t, "false", flag.Value)
+		assert.Nil(t, flag.Rule)
+
+		_, resp, err := th.SystemAdminClient.GetFeatureFlag(context.Background(), "UnknownFeature")
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+	})
+
+	t.Run("invalid rule", func(t *testing.T) {
+		_, resp, err := th.SystemAdminClient.UpdateFeatureFlagRule(context.Background(), "TestBoolFeature", &model.FeatureFlagRule{Value: "on", Percentage: 101})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+
+		_, resp, err = th.SystemAdminClient.UpdateFeatureFlagRule(context.Background(), "UnknownFeature", &model.FeatureFlagRule{Value: "on"})
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+	})
+
+	t.Run("rule targets the pilot team", func(t *testing.T) {
+		flag, _, err := th.SystemAdminClient.UpdateFeatureFlagRule(context.Background(), "TestBoolFeature", &model.FeatureFlagRule{
+			Value:   "on",
+			TeamIds: []string{th.BasicTeam.Id},
+		})
+		require.NoError(t, err)
+		require.NotNil(t, flag.Rule)
+		assert.Equal(t, []string{th.BasicTeam.Id}, flag.Rule.TeamIds)
+		assert.Equal(t, "false", flag.Value, "the server-wide value must not change")
+
+		config, _, err := th.Client.GetOldClientConfig(context.Background(), "")
+		require.NoError(t, err)
+		assert.Equal(t, "true", config["FeatureFlagTestBoolFeature"])
+
+		user := th.CreateUser()
+		client := th.CreateClient()
+		_, _, err = client.Login(context.Background(), user.Email, user.Password)
+		require.NoError(t, err)
+		config, _, err = client.GetOldClientConfig(context.Background(), "")
+		require.NoError(t, err)
+		assert.Equal(t, "false", config["FeatureFlagTestBoolFeature"])
+	})
+
+	t.Run("delete rule", func(t *testing.T) {
+		_, err := th.SystemAdminClient.DeleteFeatureFlagRule(context.Background(), "TestBoolFeature")
+		require.NoError(t, err)
+
+		config, _, err := th.Client.GetOldClientConfig(context.Background(), "")
+		require.NoError(t, err)
+		assert.Equal(t, "false", config["FeatureFlagTestBoolFeature"])
+
+		resp, err := th.SystemAdminClient.DeleteFeatureFlagRule(context.Background(), "TestBoolFeature")
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+	})
+}
@@ -38,10 +38,14 @@ func (api *API) InitFile() {
 	api.BaseRoutes.File.Handle("/link", api.APISessionRequired(getFileLink)).Methods("GET")
 	api.BaseRoutes.File.Handle("/preview", api.APISessionRequiredTrustRequester(getFilePreview)).Methods("GET")
 	api.BaseRoutes.File.Handle("/info", api.APISessionRequired(getFileInfo)).Methods("GET")
+	api.BaseRoutes.File.Handle("/share", api.APISessionRequired(createFileShareLink)).Methods("POST")
+	api.BaseRoutes.File.Handle("/share", api.APISessionRequired(getFileShareLinks)).Methods("GET")
+	api.BaseRoutes.File.Handle("/share/{link_id:[A-Za-z0-9]+}", api.APISessionRequired(revokeFileShareLink)).Methods("DELETE")
 
 	api.BaseRoutes.Team.Handle("/files/search", api.APISessionRequiredDisableWhenBusy(searchFiles)).Methods("POST")
 
 	api.BaseRoutes.PublicFile.Handle("", api.APIHandler(getPublicFile)).Methods("GET", "HEAD")
+	api.BaseRoutes.SharedFile.Handle("", api.APIHandler(getSharedFile)).Methods("GET", "HEAD", "POST")
 }
 
 func parseMultipartRequestHeader(req *http.Request) (boundary string, err error) {
@@ -709,6 +713,146 @@ func getPublicFile(c *Context, w http.ResponseWriter, r *http.Request) {
 	web.WriteFileResponse(info.Name, info.MimeType, info.Size, time.Unix(0, info.UpdateAt*int64(1000*1000)), *c.App.Config().ServiceSettings.WebserverMode, fileReader, false, w, r)
 }
 
+// getFileForShareLinkManagement returns the file if the session is allowed to manage its share links,
+// which is limited to the creator of the file and system admins.
+func getFileForShareLinkManagement(c *Context, w http.ResponseWriter) *model.FileInfo {
+	info, err := c.App.GetFileInfo(c.AppContext, c.Params.FileId)
+	if err != nil {
+		c.Err = err
+		setInaccessibleFileHeader(w, err)
+		return nil
+	}
+
+	if info.CreatorId != c.AppContext.Session().UserId && !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem) {
+		c.SetPermissionError(model.PermissionManageSystem)
+		return nil
+	}
+
+	return info
+}
+
+func createFileShareLink(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireFileId()
+	if c.Err != nil {
+		return
+	}
+
+	var linkRequest model.FileShareLinkRequest
+	if err := json.NewDecoder(r.Body).Decode(&linkRequest); err != nil {
+		c.SetInvalidParamWithErr("file_share_link", err)
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("createFileShareLink", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameter(auditRec, "file_id", c.Params.FileId)
+
+	info := getFileForShareLinkManagement(c, w)
+	if c.Err != nil {
+		return
+	}
+
+	link, appErr := c.App.CreateFileShareLink(c.AppContext, info, c.AppContext.Session().UserId, &linkRequest)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddEventResultState(link)
+	auditRec.AddEventObjectType("file_share_link")
+
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(link); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func getFileShareLinks(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireFileId()
+	if c.Err != nil {
+		return
+	}
+
+	info := getFileForShareLinkManagement(c, w)
+	if c.Err != nil {
+		return
+	}
+
+	links, appErr := c.App.GetFileShareLinksForFile(c.AppContext, info.Id)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(links); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func revokeFileShareLink(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireFileId().RequireFileShareLinkId()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("revokeFileShareLink", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameter(auditRec, "file_id", c.Params.FileId)
+	audit.AddEventParameter(auditRec, "link_id", c.Params.FileShareLinkId)
+
+	info := getFileForShareLinkManagement(c, w)
+	if c.Err != nil {
+		return
+	}
+
+	link, appErr := c.App.GetFileShareLink(c.AppContext, c.Params.FileShareLinkId)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+	auditRec.AddEventPriorState(link)
+
+	if link.FileId != info.Id {
+		c.Err = model.NewAppError("revokeFileShareLink", "app.file_share_link.get.not_found.app_error", nil, "", http.StatusNotFound)
+		return
+	}
+
+	if appErr = c.App.RevokeFileShareLink(c.AppContext, link.Id); appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.Success()
+	ReturnStatusOK(w)
+}
+
+func getSharedFile(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireFileShareLinkId()
+	if c.Err != nil {
+		return
+	}
+
+	// The password is only read from a posted form so that it doesn't end up in the URL, and
+	// HEAD requests don't count against the download limit as they don't transfer the file.
+	info, appErr := c.App.UseFileShareLink(c.AppContext, c.Params.FileShareLinkId, r.URL.Query().Get("h"), r.PostFormValue("password"), r.Method != http.MethodHead)
+	if appErr != nil {
+		c.Err = appErr
+		utils.RenderWebAppError(c.App.Config(), w, r, c.Err, c.App.AsymmetricSigningKey())
+		return
+	}
+
+	fileReader, appErr := c.App.FileReader(info.Path)
+	if appErr != nil {
+		c.Err = appErr
+		c.Err.StatusCode = http.StatusNotFound
+		return
+	}
+	defer fileReader.Close()
+
+	web.WriteFileResponse(info.Name, info.MimeType, info.Size, time.Unix(0, info.UpdateAt*int64(1000*1000)), *c.App.Config().ServiceSettings.WebserverMode, fileReader, true, w, r)
+}
+
 func searchFiles(c *Context, w http.ResponseWriter, r *http.Request) {
 	c.RequireTeamId()
 	if c.Err != nil {
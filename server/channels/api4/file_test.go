@@ -1301,3 +1301,149 @@ func TestSearchFiles(t *testing.T) {
 	require.Error(t, err)
 	CheckUnauthorizedStatus(t, resp)
 }
+
+func TestFileShareLinks(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+	client := th.Client
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.FileSettings.EnableFileShareLinks = true
+		*cfg.FileSettings.PublicLinkSalt = model.NewRandomString(32)
+	})
+
+	data, err := testutils.ReadTestFile("test.png")
+	require.NoError(t, err)
+
+	fileResp, _, err := client.UploadFile(context.Background(), data, th.BasicChannel.Id, "test.png")
+	require.NoError(t, err)
+	fileId := fileResp.FileInfos[0].Id
+
+	t.Run("create and download", func(t *testing.T) {
+		link, resp, err := client.CreateFileShareLink(context.Background(), fileId, &model.FileShareLinkRequest{ExpiresIn: 3600})
+		require.NoError(t, err)
+		CheckCreatedStatus(t, resp)
+		require.NotEmpty(t, link.Link)
+		require.False(t, link.HasPassword)
+
+		httpResp, err := http.Get(link.Link)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, httpResp.StatusCode)
+
+		httpResp, err = http.Get(link.Link[:strings.LastIndex(link.Link, "?")])
+		require.NoError(t, err)
+		require.Equal(t, http.StatusBadRequest, httpResp.StatusCode, "should've failed without hash")
+	})
+
+	t.Run("password protected", func(t *testing.T) {
+		link, _, err := client.CreateFileShareLink(context.Background(), fileId, &model.FileShareLinkRequest{ExpiresIn: 3600, Password: "secret"})
+		require.NoError(t, err)
+		require.True(t, link.HasPassword)
+
+		httpResp, err := http.Get(link.Link)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusUnauthorized, httpResp.StatusCode)
+
+		httpResp, err = http.PostForm(link.Link, url.Values{"password": {"wrong"}})
+		require.NoError(t, err)
+		require.Equal(t, http.StatusUnauthorized, httpResp.StatusCode)
+
+		httpResp, err = http.Get(link.Link + "&password=secret")
+		require.NoError(t, err)
+		require.Equal(t, http.StatusUnauthorized, httpResp.StatusCode, "should've ignored the password in the URL")
+
+		httpResp, err = http.PostForm(link.Link, url.Values{"password": {"secret"}})
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, httpResp.StatusCode)
+	})
+
+	t.Run("download limit", func(t *testing.T) {
+		link, _, err := client.CreateFileShareLink(context.Background(), fileId, &model.FileShareLinkRequest{ExpiresIn: 3600, MaxDownloads: 1})
+		require.NoError(t, err)
+
+		httpResp, err := http.Head(link.Link)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, httpResp.StatusCode)
+
+		httpResp, err = http.Get(link.Link)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, httpResp.StatusCode)
+
+		httpResp, err = http.Get(link.Link)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusGone, httpResp.StatusCode)
+	})
+
+	t.Run("invalid expiry", func(t *testing.T) {
+		_, resp, err := client.CreateFileShareLink(context.Background(), fileId, &model.FileShareLinkRequest{})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+
+		maxExpiresIn := int64(*th.App.Config().FileSettings.MaxFileShareLinkExpiryHours)*60*60 + 1
+		_, resp, err = client.CreateFileShareLink(context.Background(), fileId, &model.FileShareLinkRequest{ExpiresIn: maxExpiresIn})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("list and revoke", func(t *testing.T) {
+		link, _, err := client.CreateFileShareLink(context.Background(), fileId, &model.FileShareLinkRequest{ExpiresIn: 3600})
+		require.NoError(t, err)
+
+		links, _, err := client.GetFileShareLinks(context.Background(), fileId)
+		require.NoError(t, err)
+		found := false
+		for _, l := range links {
+			require.Empty(t, l.Password)
+			if l.Id == link.Id {
+				found = true
+			}
+		}
+		require.True(t, found)
+
+		resp, err := client.RevokeFileShareLink(context.Background(), fileId, link.Id)
+		require.NoError(t, err)
+		CheckOKStatus(t, resp)
+
+		httpResp, err := http.Get(link.Link)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusGone, httpResp.StatusCode)
+
+		resp, err = client.RevokeFileShareLink(context.Background(), fileId, link.Id)
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+	})
+
+	t.Run("other users can't manage links", func(t *testing.T) {
+		th.LoginBasic2()
+		defer th.LoginBasic()
+
+		_, resp, err := client.CreateFileShareLink(context.Background(), fileId, &model.FileShareLinkRequest{ExpiresIn: 3600})
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = client.GetFileShareLinks(context.Background(), fileId)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("system admins can manage links", func(t *testing.T) {
+		_, _, err := th.SystemAdminClient.CreateFileShareLink(context.Background(), fileId, &model.FileShareLinkRequest{ExpiresIn: 3600})
+		require.NoError(t, err)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		link, _, err := client.CreateFileShareLink(context.Background(), fileId, &model.FileShareLinkRequest{ExpiresIn: 3600})
+		require.NoError(t, err)
+
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.FileSettings.EnableFileShareLinks = false })
+		defer th.App.UpdateConfig(func(cfg *model.Config) { *cfg.FileSettings.EnableFileShareLinks = true })
+
+		_, resp, err := client.CreateFileShareLink(context.Background(), fileId, &model.FileShareLinkRequest{ExpiresIn: 3600})
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		httpResp, err := http.Get(link.Link)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusForbidden, httpResp.StatusCode)
+	})
+}
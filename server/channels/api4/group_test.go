@@ -1024,6 +1024,61 @@ func TestGetGroupChannels(t *testing.T) {
 	CheckUnauthorizedStatus(t, response)
 }
 
+func TestPatchGroupMentionSettings(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.Srv().SetLicense(model.NewTestLicenseSKU(model.LicenseShortSkuProfessional, "ldap"))
+
+	group, _, err := th.Client.CreateGroup(context.Background(), &model.Group{
+		DisplayName:    "dn_" + model.NewId(),
+		Name:           model.NewString("name" + model.NewId()),
+		Source:         model.GroupSourceCustom,
+		AllowReference: true,
+	})
+	require.NoError(t, err)
+
+	t.Run("users can't change the mention settings", func(t *testing.T) {
+		_, resp, err := th.Client.PatchGroup(context.Background(), group.Id, &model.GroupPatch{MentionCooldownSeconds: model.NewInt(60)})
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = th.Client.CreateGroup(context.Background(), &model.Group{
+			DisplayName:    "dn_" + model.NewId(),
+			Name:           model.NewString("name" + model.NewId()),
+			Source:         model.GroupSourceCustom,
+			AllowReference: true,
+			MentionPolicy:  model.GroupMentionPolicyMembers,
+		})
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("admins change the mention settings", func(t *testing.T) {
+		policy := model.GroupMentionPolicyMembers
+		patched, _, err := th.SystemAdminClient.PatchGroup(context.Background(), group.Id, &model.GroupPatch{
+			MentionPolicy:          &policy,
+			MentionCooldownSeconds: model.NewInt(60),
+			MentionMemberLimit:     model.NewInt(500),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, model.GroupMentionPolicyMembers, patched.MentionPolicy)
+		assert.Equal(t, 60, patched.MentionCooldownSeconds)
+		assert.Equal(t, 500, patched.MentionMemberLimit)
+	})
+
+	t.Run("invalid mention settings", func(t *testing.T) {
+		policy := model.GroupMentionPolicy("everyone")
+		_, resp, err := th.SystemAdminClient.PatchGroup(context.Background(), group.Id, &model.GroupPatch{MentionPolicy: &policy})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+
+		_, resp, err = th.SystemAdminClient.PatchGroup(context.Background(), group.Id, &model.GroupPatch{MentionCooldownSeconds: model.NewInt(-1)})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+}
+
 func TestPatchGroupTeam(t *testing.T) {
 	th := Setup(t).InitBasic()
 	defer th.TearDown()
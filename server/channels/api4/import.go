@@ -6,13 +6,16 @@ package api4
 import (
 	"encoding/json"
 	"net/http"
+	"strconv"
 
 	"github.com/mattermost/mattermost/server/public/model"
 	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/v8/channels/audit"
 )
 
 func (api *API) InitImport() {
 	api.BaseRoutes.Imports.Handle("", api.APISessionRequired(listImports)).Methods("GET")
+	api.BaseRoutes.Import.Handle("/merge", api.APISessionRequired(mergeImport)).Methods("POST")
 }
 
 func listImports(c *Context, w http.ResponseWriter, r *http.Request) {
@@ -31,3 +34,58 @@ func listImports(c *Context, w http.ResponseWriter, r *http.Request) {
 		c.Logger.Warn("Error writing imports", mlog.Err(err))
 	}
 }
+
+func mergeImport(c *Context, w http.ResponseWriter, r *http.Request) {
+	var mapping model.ImportMergeMapping
+	if jsonErr := json.NewDecoder(r.Body).Decode(&mapping); jsonErr != nil {
+		c.SetInvalidParamWithErr("mapping", jsonErr)
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("mergeImport", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameter(auditRec, "import_name", c.Params.ImportName)
+
+	if !c.IsSystemAdmin() {
+		c.SetPermissionError(model.PermissionManageSystem)
+		return
+	}
+
+	if appErr := mapping.IsValid(); appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	mappingJSON, err := json.Marshal(mapping)
+	if err != nil {
+		c.SetInvalidParamWithErr("mapping", err)
+		return
+	}
+
+	extractContent := true
+	if value := r.URL.Query().Get("extract_content"); value != "" {
+		extractContent, _ = strconv.ParseBool(value)
+	}
+
+	job, appErr := c.App.CreateJob(c.AppContext, &model.Job{
+		Type: model.JobTypeImportMerge,
+		Data: map[string]string{
+			"import_file":     c.Params.ImportName,
+			"mapping":         string(mappingJSON),
+			"extract_content": strconv.FormatBool(extractContent),
+		},
+	})
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddEventResultState(job)
+	auditRec.AddEventObjectType("job")
+
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(job); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
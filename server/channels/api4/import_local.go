@@ -5,4 +5,5 @@ package api4
 
 func (api *API) InitImportLocal() {
 	api.BaseRoutes.Imports.Handle("", api.APILocal(listImports)).Methods("GET")
+	api.BaseRoutes.Import.Handle("/merge", api.APILocal(mergeImport)).Methods("POST")
 }
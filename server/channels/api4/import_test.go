@@ -147,3 +147,40 @@ func TestImportInLocalMode(t *testing.T) {
 	// Just a sanity check to ensure new posts are actually added in the system.
 	require.Greater(t, cnt2, cnt1)
 }
+
+func TestMergeImport(t *testing.T) {
+	th := Setup(t)
+	defer th.TearDown()
+
+	mapping := &model.ImportMergeMapping{
+		Users: map[string]string{"john": "john.acme"},
+		Teams: map[string]string{"engineering": "acme-engineering"},
+	}
+
+	t.Run("no permissions", func(t *testing.T) {
+		job, resp, err := th.Client.MergeImport(context.Background(), "export.zip", mapping, false)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+		require.Nil(t, job)
+	})
+
+	t.Run("invalid mapping", func(t *testing.T) {
+		job, resp, err := th.SystemAdminClient.MergeImport(context.Background(), "export.zip", &model.ImportMergeMapping{
+			Users: map[string]string{"john": "John Doe"},
+		}, false)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+		require.Nil(t, job)
+	})
+
+	th.TestForSystemAdminAndLocal(t, func(t *testing.T, c *model.Client4) {
+		job, resp, err := c.MergeImport(context.Background(), "export.zip", mapping, false)
+		require.NoError(t, err)
+		CheckCreatedStatus(t, resp)
+		require.NotNil(t, job)
+		require.Equal(t, model.JobTypeImportMerge, job.Type)
+		require.Equal(t, "export.zip", job.Data["import_file"])
+		require.Equal(t, "false", job.Data["extract_content"])
+		require.JSONEq(t, `{"users":{"john":"john.acme"},"teams":{"engineering":"acme-engineering"},"source_site_url":""}`, job.Data["mapping"])
+	}, "merge import")
+}
@@ -0,0 +1,107 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"crypto/subtle"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/v8/platform/shared/mail"
+)
+
+const inboundEmailMaxMemory = 10 * 1024 * 1024
+
+func (api *API) InitInboundEmail() {
+	api.BaseRoutes.APIRoot.Handle("/email/inbound", api.APIHandler(receiveInboundEmail)).Methods("POST")
+}
+
+// receiveInboundEmail accepts the emails forwarded by an inbound email provider, either as a raw
+// message (message/rfc822 body, or the "email" field of a form) or in the parsed form of the
+// SendGrid Inbound Parse webhook.
+func receiveInboundEmail(c *Context, w http.ResponseWriter, r *http.Request) {
+	secret := *c.App.Config().EmailSettings.InboundEmailSecret
+	if !*c.App.Config().EmailSettings.EnableReplyByEmail || secret == "" {
+		c.Err = model.NewAppError("receiveInboundEmail", "api.inbound_email.disabled.app_error", nil, "", http.StatusNotImplemented)
+		return
+	}
+
+	if subtle.ConstantTimeCompare([]byte(r.URL.Query().Get("secret")), []byte(secret)) != 1 {
+		c.Err = model.NewAppError("receiveInboundEmail", "api.inbound_email.invalid_secret.app_error", nil, "", http.StatusUnauthorized)
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, model.InboundEmailMaxSize)
+
+	email, err := parseInboundEmailRequest(r)
+	if err != nil {
+		c.SetInvalidParamWithErr("email", err)
+		return
+	}
+
+	post, appErr := c.App.ProcessInboundEmail(c.AppContext, email)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	c.LogAudit("post_id=" + post.Id)
+
+	ReturnStatusOK(w)
+}
+
+func parseInboundEmailRequest(r *http.Request) (*model.InboundEmail, error) {
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if mediaType == "message/rfc822" {
+		return mail.ParseInboundEmail(r.Body)
+	}
+
+	if err := r.ParseMultipartForm(inboundEmailMaxMemory); err != nil {
+		return nil, err
+	}
+	defer r.MultipartForm.RemoveAll()
+
+	if raw := r.FormValue("email"); raw != "" {
+		return mail.ParseInboundEmail(strings.NewReader(raw))
+	}
+
+	email := &model.InboundEmail{
+		From:    r.FormValue("from"),
+		To:      []string{r.FormValue("to")},
+		Subject: r.FormValue("subject"),
+		Text:    r.FormValue("text"),
+	}
+	if cc := r.FormValue("cc"); cc != "" {
+		email.To = append(email.To, cc)
+	}
+
+	for _, headers := range r.MultipartForm.File {
+		for _, header := range headers {
+			if len(email.Attachments) >= model.InboundEmailMaxAttachments {
+				break
+			}
+
+			file, err := header.Open()
+			if err != nil {
+				return nil, err
+			}
+			data, err := io.ReadAll(file)
+			file.Close()
+			if err != nil {
+				return nil, err
+			}
+
+			email.Attachments = append(email.Attachments, &model.InboundEmailAttachment{
+				Name:        header.Filename,
+				ContentType: header.Header.Get("Content-Type"),
+				Data:        data,
+			})
+		}
+	}
+
+	return email, nil
+}
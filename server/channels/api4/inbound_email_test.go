@@ -0,0 +1,87 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"bytes"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+func TestReceiveInboundEmail(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	secret := model.NewId()
+	inboundURL := th.Client.APIURL + "/email/inbound?secret=" + url.QueryEscape(secret)
+
+	post := func(t *testing.T, requestURL, contentType string, body []byte) int {
+		t.Helper()
+		req, err := http.NewRequest("POST", requestURL, bytes.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", contentType)
+		resp, err := th.Client.HTTPClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	raw := []byte("From: " + th.BasicUser.Email + "\r\nTo: reply@example.com\r\nSubject: Re: hello\r\n\r\nSounds good\r\n")
+
+	t.Run("disabled", func(t *testing.T) {
+		assert.Equal(t, http.StatusNotImplemented, post(t, inboundURL, "message/rfc822", raw))
+	})
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.EmailSettings.EnableReplyByEmail = true
+		*cfg.EmailSettings.ReplyByEmailAddress = "reply@example.com"
+		*cfg.EmailSettings.InboundEmailSecret = secret
+	})
+
+	t.Run("invalid secret", func(t *testing.T) {
+		assert.Equal(t, http.StatusUnauthorized, post(t, th.Client.APIURL+"/email/inbound?secret=invalid", "message/rfc822", raw))
+		assert.Equal(t, http.StatusUnauthorized, post(t, th.Client.APIURL+"/email/inbound", "message/rfc822", raw))
+	})
+
+	t.Run("raw message", func(t *testing.T) {
+		// The message is parsed, but isn't a reply to a notification email.
+		assert.Equal(t, http.StatusBadRequest, post(t, inboundURL, "message/rfc822", raw))
+	})
+
+	t.Run("invalid raw message", func(t *testing.T) {
+		assert.Equal(t, http.StatusBadRequest, post(t, inboundURL, "message/rfc822", []byte("not an email")))
+	})
+
+	t.Run("parsed form", func(t *testing.T) {
+		var body bytes.Buffer
+		writer := multipart.NewWriter(&body)
+		require.NoError(t, writer.WriteField("from", th.BasicUser.Email))
+		require.NoError(t, writer.WriteField("to", "reply@example.com"))
+		require.NoError(t, writer.WriteField("text", "Sounds good"))
+		part, err := writer.CreateFormFile("attachment1", "notes.txt")
+		require.NoError(t, err)
+		_, err = part.Write([]byte("some notes"))
+		require.NoError(t, err)
+		require.NoError(t, writer.Close())
+
+		assert.Equal(t, http.StatusBadRequest, post(t, inboundURL, writer.FormDataContentType(), body.Bytes()))
+	})
+
+	t.Run("raw message in a form", func(t *testing.T) {
+		var body bytes.Buffer
+		writer := multipart.NewWriter(&body)
+		require.NoError(t, writer.WriteField("email", strings.Replace(string(raw), "reply@example.com", "other@example.com", 1)))
+		require.NoError(t, writer.Close())
+
+		assert.Equal(t, http.StatusBadRequest, post(t, inboundURL, writer.FormDataContentType(), body.Bytes()))
+	})
+}
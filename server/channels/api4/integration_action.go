@@ -16,6 +16,7 @@ func (api *API) InitAction() {
 
 	api.BaseRoutes.APIRoot.Handle("/actions/dialogs/open", api.APIHandler(openDialog)).Methods("POST")
 	api.BaseRoutes.APIRoot.Handle("/actions/dialogs/submit", api.APISessionRequired(submitDialog)).Methods("POST")
+	api.BaseRoutes.APIRoot.Handle("/actions/dialogs/lookup", api.APISessionRequired(lookupDialog)).Methods("POST")
 }
 
 func doPostAction(c *Context, w http.ResponseWriter, r *http.Request) {
@@ -128,3 +129,40 @@ func submitDialog(c *Context, w http.ResponseWriter, r *http.Request) {
 
 	w.Write(b)
 }
+
+func lookupDialog(c *Context, w http.ResponseWriter, r *http.Request) {
+	var lookup model.DialogLookupRequest
+
+	jsonErr := json.NewDecoder(r.Body).Decode(&lookup)
+	if jsonErr != nil {
+		c.SetInvalidParamWithErr("lookup", jsonErr)
+		return
+	}
+
+	if lookup.URL == "" {
+		c.SetInvalidParam("url")
+		return
+	}
+
+	lookup.UserId = c.AppContext.Session().UserId
+
+	if !c.App.SessionHasPermissionToChannel(c.AppContext, *c.AppContext.Session(), lookup.ChannelId, model.PermissionReadChannelContent) {
+		c.SetPermissionError(model.PermissionReadChannelContent)
+		return
+	}
+
+	if !c.App.SessionHasPermissionToTeam(*c.AppContext.Session(), lookup.TeamId, model.PermissionViewTeam) {
+		c.SetPermissionError(model.PermissionViewTeam)
+		return
+	}
+
+	resp, err := c.App.LookupInteractiveDialog(c.AppContext, lookup)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
@@ -345,3 +345,77 @@ func TestSubmitDialog(t *testing.T) {
 	CheckForbiddenStatus(t, resp)
 	assert.Nil(t, submitResp)
 }
+
+func TestLookupDialog(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+	client := th.Client
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.ServiceSettings.AllowedUntrustedInternalConnections = "localhost,127.0.0.1"
+	})
+
+	lookup := model.DialogLookupRequest{
+		CallbackId: "callbackid",
+		State:      "somestate",
+		ChannelId:  th.BasicChannel.Id,
+		TeamId:     th.BasicTeam.Id,
+		Submission: map[string]any{"somename": "somevalue"},
+		Name:       "someselect",
+		Query:      "ab",
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request model.DialogLookupRequest
+		err := json.NewDecoder(r.Body).Decode(&request)
+		require.NoError(t, err)
+
+		assert.Equal(t, "", request.URL)
+		assert.Equal(t, th.BasicUser.Id, request.UserId)
+		assert.Equal(t, lookup.ChannelId, request.ChannelId)
+		assert.Equal(t, lookup.TeamId, request.TeamId)
+		assert.Equal(t, lookup.Name, request.Name)
+		assert.Equal(t, lookup.Query, request.Query)
+
+		items := make([]*model.PostActionOptions, 0, model.DialogLookupMaxItems+1)
+		for i := 0; i <= model.DialogLookupMaxItems; i++ {
+			items = append(items, &model.PostActionOptions{Text: "abc", Value: model.NewId()})
+		}
+		err = json.NewEncoder(w).Encode(model.DialogLookupResponse{Items: items})
+		require.NoError(t, err)
+	}))
+	defer ts.Close()
+
+	t.Run("returns the integration options", func(t *testing.T) {
+		lookup.URL = ts.URL
+		lookupResp, _, err := client.LookupInteractiveDialog(context.Background(), lookup)
+		require.NoError(t, err)
+		assert.Len(t, lookupResp.Items, model.DialogLookupMaxItems)
+	})
+
+	t.Run("URL is required", func(t *testing.T) {
+		req := lookup
+		req.URL = ""
+		_, resp, err := client.LookupInteractiveDialog(context.Background(), req)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("requires access to the channel", func(t *testing.T) {
+		req := lookup
+		req.URL = ts.URL
+		req.ChannelId = model.NewId()
+		_, resp, err := client.LookupInteractiveDialog(context.Background(), req)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("requires access to the team", func(t *testing.T) {
+		req := lookup
+		req.URL = ts.URL
+		req.TeamId = model.NewId()
+		_, resp, err := client.LookupInteractiveDialog(context.Background(), req)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+}
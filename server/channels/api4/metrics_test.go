@@ -22,6 +22,7 @@ func setupMetricsMock() *mocks.MetricsInterface {
 	metricsMock.On("IncrementWebsocketEvent", mock.AnythingOfType("model.WebsocketEventType")).Return()
 	metricsMock.On("IncrementWebSocketBroadcastBufferSize", mock.AnythingOfType("string"), mock.AnythingOfType("float64")).Return()
 	metricsMock.On("DecrementWebSocketBroadcastBufferSize", mock.AnythingOfType("string"), mock.AnythingOfType("float64")).Return()
+	metricsMock.On("ObserveWebSocketBroadcastDuration", mock.AnythingOfType("model.WebsocketEventType"), mock.AnythingOfType("float64")).Return()
 	metricsMock.On("IncrementMemCacheInvalidationCounter", mock.AnythingOfType("string")).Return()
 	metricsMock.On("IncrementMemCacheMissCounter", mock.AnythingOfType("string")).Return()
 	metricsMock.On("IncrementMemCacheHitCounter", mock.AnythingOfType("string")).Return()
@@ -30,6 +31,8 @@ func setupMetricsMock() *mocks.MetricsInterface {
 	metricsMock.On("IncrementHTTPError").Return()
 	metricsMock.On("IncrementHTTPRequest").Return()
 	metricsMock.On("ObserveAPIEndpointDuration", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("float64")).Return()
+	metricsMock.On("ObserveAPIRouteDuration", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("float64")).Return()
+	metricsMock.On("IncrementAPIRouteResponse", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return()
 	metricsMock.On("Register").Return()
 
 	return metricsMock
@@ -800,6 +800,8 @@ func searchPosts(c *Context, w http.ResponseWriter, r *http.Request, teamId stri
 		return
 	}
 
+	results = filterPostSearchResultsForBotScope(c, results)
+
 	clientPostList := c.App.PreparePostListForClient(c.AppContext, results.PostList)
 	clientPostList, err = c.App.SanitizePostListMetadataForUser(c.AppContext, clientPostList, c.AppContext.Session().UserId)
 	if err != nil {
@@ -20,6 +20,9 @@ func (api *API) InitPreference() {
 	api.BaseRoutes.Preferences.Handle("/delete", api.APISessionRequired(deletePreferences)).Methods("POST")
 	api.BaseRoutes.Preferences.Handle("/{category:[A-Za-z0-9_]+}", api.APISessionRequired(getPreferencesByCategory)).Methods("GET")
 	api.BaseRoutes.Preferences.Handle("/{category:[A-Za-z0-9_]+}/name/{preference_name:[A-Za-z0-9_]+}", api.APISessionRequired(getPreferenceByCategoryAndName)).Methods("GET")
+
+	api.BaseRoutes.User.Handle("/email_digest", api.APISessionRequired(getEmailDigestSettings)).Methods("GET")
+	api.BaseRoutes.User.Handle("/email_digest", api.APISessionRequired(updateEmailDigestSettings)).Methods("PUT")
 }
 
 func getPreferences(c *Context, w http.ResponseWriter, r *http.Request) {
@@ -33,12 +36,18 @@ func getPreferences(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	etag := c.App.GetPreferencesEtag(c.Params.UserId)
+	if c.HandleEtag(etag, "Get Preferences", w, r) {
+		return
+	}
+
 	preferences, err := c.App.GetPreferencesForUser(c.AppContext, c.Params.UserId)
 	if err != nil {
 		c.Err = err
 		return
 	}
 
+	w.Header().Set(model.HeaderEtagServer, etag)
 	if err := json.NewEncoder(w).Encode(preferences); err != nil {
 		c.Logger.Warn("Error while writing response", mlog.Err(err))
 	}
@@ -140,6 +149,61 @@ func updatePreferences(c *Context, w http.ResponseWriter, r *http.Request) {
 	ReturnStatusOK(w)
 }
 
+func getEmailDigestSettings(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionToUser(*c.AppContext.Session(), c.Params.UserId) {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+		return
+	}
+
+	settings, err := c.App.GetEmailDigestSettings(c.Params.UserId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(settings); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func updateEmailDigestSettings(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("updateEmailDigestSettings", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameter(auditRec, "user_id", c.Params.UserId)
+
+	if !c.App.SessionHasPermissionToUser(*c.AppContext.Session(), c.Params.UserId) {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+		return
+	}
+
+	var settings model.EmailDigestSettings
+	if err := json.NewDecoder(r.Body).Decode(&settings); err != nil {
+		c.SetInvalidParamWithErr("email_digest", err)
+		return
+	}
+	audit.AddEventParameter(auditRec, "frequency", settings.Frequency)
+
+	if err := c.App.UpdateEmailDigestSettings(c.AppContext, c.Params.UserId, &settings); err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	if err := json.NewEncoder(w).Encode(settings); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
 func deletePreferences(c *Context, w http.ResponseWriter, r *http.Request) {
 	c.RequireUserId()
 	if c.Err != nil {
@@ -6,6 +6,7 @@ package api4
 import (
 	"context"
 	"encoding/json"
+	"net/http"
 	"testing"
 	"time"
 
@@ -76,6 +77,34 @@ func TestGetPreferences(t *testing.T) {
 	CheckUnauthorizedStatus(t, resp)
 }
 
+func TestGetPreferencesEtag(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+	client := th.Client
+
+	r, err := client.DoAPIGet(context.Background(), "/users/"+th.BasicUser.Id+"/preferences", "")
+	require.NoError(t, err)
+	closeBody(r)
+	etag := r.Header.Get(model.HeaderEtagServer)
+	require.NotEmpty(t, etag)
+
+	r, err = client.DoAPIGet(context.Background(), "/users/"+th.BasicUser.Id+"/preferences", etag)
+	require.NoError(t, err)
+	closeBody(r)
+	require.Equal(t, http.StatusNotModified, r.StatusCode)
+
+	_, err = client.UpdatePreferences(context.Background(), th.BasicUser.Id, model.Preferences{
+		{UserId: th.BasicUser.Id, Category: model.NewId(), Name: model.NewId(), Value: "value"},
+	})
+	require.NoError(t, err)
+
+	r, err = client.DoAPIGet(context.Background(), "/users/"+th.BasicUser.Id+"/preferences", etag)
+	require.NoError(t, err)
+	closeBody(r)
+	require.Equal(t, http.StatusOK, r.StatusCode)
+	require.NotEqual(t, etag, r.Header.Get(model.HeaderEtagServer))
+}
+
 func TestGetPreferencesByCategory(t *testing.T) {
 	th := Setup(t).InitBasic()
 	defer th.TearDown()
@@ -577,6 +606,47 @@ func TestUpdateSidebarPreferences(t *testing.T) {
 	})
 }
 
+func TestEmailDigestSettings(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+	client := th.Client
+
+	th.LoginBasic()
+	user := th.BasicUser
+
+	settings, _, err := client.GetEmailDigestSettings(context.Background(), user.Id)
+	require.NoError(t, err)
+	require.Equal(t, model.EmailDigestFrequencyOff, settings.Frequency)
+
+	updated, _, err := client.UpdateEmailDigestSettings(context.Background(), user.Id, &model.EmailDigestSettings{Frequency: model.EmailDigestFrequencyDaily, Hour: 7})
+	require.NoError(t, err)
+	require.Equal(t, &model.EmailDigestSettings{Frequency: model.EmailDigestFrequencyDaily, Hour: 7}, updated)
+
+	settings, _, err = client.GetEmailDigestSettings(context.Background(), user.Id)
+	require.NoError(t, err)
+	require.Equal(t, updated, settings)
+
+	_, resp, err := client.UpdateEmailDigestSettings(context.Background(), user.Id, &model.EmailDigestSettings{Frequency: model.EmailDigestFrequencyDaily, Hour: 24})
+	require.Error(t, err)
+	CheckBadRequestStatus(t, resp)
+
+	_, resp, err = client.GetEmailDigestSettings(context.Background(), th.BasicUser2.Id)
+	require.Error(t, err)
+	CheckForbiddenStatus(t, resp)
+
+	_, resp, err = client.UpdateEmailDigestSettings(context.Background(), th.BasicUser2.Id, &model.EmailDigestSettings{Frequency: model.EmailDigestFrequencyHourly})
+	require.Error(t, err)
+	CheckForbiddenStatus(t, resp)
+
+	_, _, err = th.SystemAdminClient.UpdateEmailDigestSettings(context.Background(), user.Id, &model.EmailDigestSettings{Frequency: model.EmailDigestFrequencyOff})
+	require.NoError(t, err)
+
+	client.Logout(context.Background())
+	_, resp, err = client.GetEmailDigestSettings(context.Background(), user.Id)
+	require.Error(t, err)
+	CheckUnauthorizedStatus(t, resp)
+}
+
 func TestDeletePreferences(t *testing.T) {
 	th := Setup(t).InitBasic()
 	defer th.TearDown()
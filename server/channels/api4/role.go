@@ -6,6 +6,7 @@ package api4
 import (
 	"encoding/json"
 	"net/http"
+	"slices"
 
 	"github.com/mattermost/mattermost/server/public/model"
 	"github.com/mattermost/mattermost/server/public/shared/mlog"
@@ -22,9 +23,11 @@ var notAllowedPermissions = []string{
 
 func (api *API) InitRole() {
 	api.BaseRoutes.Roles.Handle("", api.APISessionRequired(getAllRoles)).Methods("GET")
+	api.BaseRoutes.Roles.Handle("", api.APISessionRequired(createRole)).Methods("POST")
 	api.BaseRoutes.Roles.Handle("/{role_id:[A-Za-z0-9]+}", api.APISessionRequiredTrustRequester(getRole)).Methods("GET")
 	api.BaseRoutes.Roles.Handle("/name/{role_name:[a-z0-9_]+}", api.APISessionRequiredTrustRequester(getRoleByName)).Methods("GET")
 	api.BaseRoutes.Roles.Handle("/names", api.APISessionRequiredTrustRequester(getRolesByNames)).Methods("POST")
+	api.BaseRoutes.Roles.Handle("/{role_id:[A-Za-z0-9]+}", api.APISessionRequired(deleteRole)).Methods("DELETE")
 	api.BaseRoutes.Roles.Handle("/{role_id:[A-Za-z0-9]+}/patch", api.APISessionRequired(patchRole)).Methods("PUT")
 }
 
@@ -229,3 +232,73 @@ func patchRole(c *Context, w http.ResponseWriter, r *http.Request) {
 		c.Logger.Warn("Error while writing response", mlog.Err(err))
 	}
 }
+
+func createRole(c *Context, w http.ResponseWriter, r *http.Request) {
+	var role model.Role
+	if err := json.NewDecoder(r.Body).Decode(&role); err != nil {
+		c.SetInvalidParamWithErr("role", err)
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("createRole", audit.Fail)
+	audit.AddEventParameter(auditRec, "role_name", role.Name)
+	audit.AddEventParameter(auditRec, "permissions", role.Permissions)
+	defer c.LogAuditRec(auditRec)
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionSysconsoleWriteUserManagementPermissions) {
+		c.SetPermissionError(model.PermissionSysconsoleWriteUserManagementPermissions)
+		return
+	}
+
+	for _, permission := range role.Permissions {
+		if slices.Contains(notAllowedPermissions, permission) {
+			c.Err = model.NewAppError("Api4.CreateRole", "api.roles.patch_roles.not_allowed_permission.error", nil, "Cannot add permission: "+permission, http.StatusNotImplemented)
+			return
+		}
+	}
+
+	newRole, appErr := c.App.CreateCustomRole(&role)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.AddEventResultState(newRole)
+	auditRec.AddEventObjectType("role")
+	auditRec.Success()
+
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(newRole); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func deleteRole(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireRoleId()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("deleteRole", audit.Fail)
+	audit.AddEventParameter(auditRec, "role_id", c.Params.RoleId)
+	defer c.LogAuditRec(auditRec)
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionSysconsoleWriteUserManagementPermissions) {
+		c.SetPermissionError(model.PermissionSysconsoleWriteUserManagementPermissions)
+		return
+	}
+
+	role, appErr := c.App.DeleteCustomRole(c.Params.RoleId)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.AddEventResultState(role)
+	auditRec.AddEventObjectType("role")
+	auditRec.Success()
+
+	if err := json.NewEncoder(w).Encode(role); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
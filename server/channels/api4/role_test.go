@@ -329,3 +329,74 @@ func TestPatchRole(t *testing.T) {
 		})
 	})
 }
+
+func TestCreateAndDeleteRole(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	role := &model.Role{
+		Name:        "moderator",
+		DisplayName: "Moderator",
+		Permissions: []string{model.PermissionReadChannel.Id, model.PermissionDeletePost.Id, model.PermissionDeleteOthersPosts.Id},
+	}
+
+	t.Run("users can't create roles", func(t *testing.T) {
+		_, resp, err := th.Client.CreateRole(context.Background(), role)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("not allowed permissions", func(t *testing.T) {
+		_, resp, err := th.SystemAdminClient.CreateRole(context.Background(), &model.Role{
+			Name:        "roles_manager",
+			DisplayName: "Roles manager",
+			Permissions: []string{model.PermissionManageRoles.Id},
+		})
+		require.Error(t, err)
+		CheckNotImplementedStatus(t, resp)
+	})
+
+	t.Run("missing dependency", func(t *testing.T) {
+		_, resp, err := th.SystemAdminClient.CreateRole(context.Background(), &model.Role{
+			Name:        "cleaner",
+			DisplayName: "Cleaner",
+			Permissions: []string{model.PermissionDeleteOthersPosts.Id},
+		})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	created, resp, err := th.SystemAdminClient.CreateRole(context.Background(), role)
+	require.NoError(t, err)
+	CheckCreatedStatus(t, resp)
+	assert.Equal(t, role.Name, created.Name)
+	assert.False(t, created.SchemeManaged)
+
+	t.Run("assign to a team member", func(t *testing.T) {
+		_, err := th.SystemAdminClient.UpdateTeamMemberRoles(context.Background(), th.BasicTeam.Id, th.BasicUser2.Id, "team_user moderator")
+		require.NoError(t, err)
+
+		member, _, err := th.SystemAdminClient.GetTeamMember(context.Background(), th.BasicTeam.Id, th.BasicUser2.Id, "")
+		require.NoError(t, err)
+		assert.Contains(t, member.ExplicitRoles, "moderator")
+	})
+
+	t.Run("users can't delete roles", func(t *testing.T) {
+		_, resp, err := th.Client.DeleteRole(context.Background(), created.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("builtin roles can't be deleted", func(t *testing.T) {
+		channelUser, _, err := th.SystemAdminClient.GetRoleByName(context.Background(), model.ChannelUserRoleId)
+		require.NoError(t, err)
+
+		_, resp, err := th.SystemAdminClient.DeleteRole(context.Background(), channelUser.Id)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	deleted, _, err := th.SystemAdminClient.DeleteRole(context.Background(), created.Id)
+	require.NoError(t, err)
+	assert.NotZero(t, deleted.DeleteAt)
+}
@@ -15,6 +15,8 @@ func (api *API) InitStatus() {
 	api.BaseRoutes.User.Handle("/status", api.APISessionRequired(getUserStatus)).Methods("GET")
 	api.BaseRoutes.Users.Handle("/status/ids", api.APISessionRequired(getUserStatusesByIds)).Methods("POST")
 	api.BaseRoutes.User.Handle("/status", api.APISessionRequired(updateUserStatus)).Methods("PUT")
+	api.BaseRoutes.User.Handle("/status/timed", api.APISessionRequired(updateUserTimedStatus)).Methods("PUT")
+	api.BaseRoutes.Users.Handle("/status/timed", api.APISessionRequired(updateUserTimedStatuses)).Methods("PUT")
 	api.BaseRoutes.User.Handle("/status/custom", api.APISessionRequired(updateUserCustomStatus)).Methods("PUT")
 	api.BaseRoutes.User.Handle("/status/custom", api.APISessionRequired(removeUserCustomStatus)).Methods("DELETE")
 
@@ -126,6 +128,82 @@ func updateUserStatus(c *Context, w http.ResponseWriter, r *http.Request) {
 	getUserStatus(c, w, r)
 }
 
+func updateUserTimedStatus(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	var status model.TimedStatus
+	if jsonErr := json.NewDecoder(r.Body).Decode(&status); jsonErr != nil {
+		c.SetInvalidParamWithErr("status", jsonErr)
+		return
+	}
+
+	// The user being updated in the payload must be the same one as indicated in the URL.
+	if status.UserId != c.Params.UserId {
+		c.SetInvalidParam("user_id")
+		return
+	}
+
+	if !c.App.SessionHasPermissionToUser(*c.AppContext.Session(), c.Params.UserId) {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+		return
+	}
+
+	if appErr := c.App.SetTimedStatuses(c.AppContext, []*model.TimedStatus{&status}); appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	getUserStatus(c, w, r)
+}
+
+// updateUserTimedStatuses lets calendar providers set the statuses of many users at once.
+func updateUserTimedStatuses(c *Context, w http.ResponseWriter, r *http.Request) {
+	var statuses []*model.TimedStatus
+	if jsonErr := json.NewDecoder(r.Body).Decode(&statuses); jsonErr != nil {
+		c.SetInvalidParamWithErr("statuses", jsonErr)
+		return
+	} else if len(statuses) == 0 {
+		c.SetInvalidParam("statuses")
+		return
+	}
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionEditOtherUsers) {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+		return
+	}
+
+	userIds := make([]string, 0, len(statuses))
+	for _, status := range statuses {
+		if status == nil {
+			c.SetInvalidParam("statuses")
+			return
+		}
+		userIds = append(userIds, status.UserId)
+	}
+
+	if appErr := c.App.SetTimedStatuses(c.AppContext, statuses); appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	updated, appErr := c.App.GetUserStatusesByIds(userIds)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	js, err := json.Marshal(updated)
+	if err != nil {
+		c.Err = model.NewAppError("updateUserTimedStatuses", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		return
+	}
+
+	w.Write(js)
+}
+
 func updateUserCustomStatus(c *Context, w http.ResponseWriter, r *http.Request) {
 	c.RequireUserId()
 	if c.Err != nil {
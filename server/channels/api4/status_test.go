@@ -243,3 +243,71 @@ func TestUpdateUserStatus(t *testing.T) {
 		CheckUnauthorizedStatus(t, resp)
 	})
 }
+
+func TestUpdateUserTimedStatuses(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	endTime := time.Now().Add(time.Hour).Unix()
+
+	t.Run("set own meeting status", func(t *testing.T) {
+		th.App.SetStatusOnline(th.BasicUser.Id, true)
+
+		status, _, err := th.Client.UpdateUserTimedStatus(context.Background(), th.BasicUser.Id, &model.TimedStatus{
+			UserId:  th.BasicUser.Id,
+			Status:  model.StatusDnd,
+			EndTime: endTime,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, model.StatusDnd, status.Status)
+		assert.Equal(t, endTime, status.DNDEndTime)
+	})
+
+	t.Run("regular users can't set the status of others", func(t *testing.T) {
+		_, resp, err := th.Client.UpdateUserTimedStatus(context.Background(), th.BasicUser2.Id, &model.TimedStatus{
+			UserId:  th.BasicUser2.Id,
+			Status:  model.StatusDnd,
+			EndTime: endTime,
+		})
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = th.Client.UpdateUserTimedStatuses(context.Background(), []*model.TimedStatus{
+			{UserId: th.BasicUser2.Id, Status: model.StatusOutOfOffice, EndTime: endTime},
+		})
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("bulk set statuses", func(t *testing.T) {
+		statuses, _, err := th.SystemAdminClient.UpdateUserTimedStatuses(context.Background(), []*model.TimedStatus{
+			{UserId: th.BasicUser.Id, Status: model.StatusDnd, EndTime: endTime},
+			{UserId: th.BasicUser2.Id, Status: model.StatusOutOfOffice, EndTime: endTime},
+		})
+		require.NoError(t, err)
+		require.Len(t, statuses, 2)
+
+		for _, status := range statuses {
+			if status.UserId == th.BasicUser2.Id {
+				assert.Equal(t, model.StatusOutOfOffice, status.Status)
+			} else {
+				assert.Equal(t, model.StatusDnd, status.Status)
+			}
+		}
+	})
+
+	t.Run("invalid statuses aren't applied", func(t *testing.T) {
+		th.App.SetStatusOnline(th.BasicUser2.Id, true)
+
+		_, resp, err := th.SystemAdminClient.UpdateUserTimedStatuses(context.Background(), []*model.TimedStatus{
+			{UserId: th.BasicUser2.Id, Status: model.StatusDnd, EndTime: endTime},
+			{UserId: th.BasicUser.Id, Status: model.StatusOnline, EndTime: endTime},
+		})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+
+		status, appErr := th.App.GetStatus(th.BasicUser2.Id)
+		require.Nil(t, appErr)
+		assert.Equal(t, model.StatusOnline, status.Status)
+	})
+}
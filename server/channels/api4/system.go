@@ -12,7 +12,9 @@ import (
 	"path"
 	"reflect"
 	"runtime"
+	"slices"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -31,6 +33,8 @@ const (
 	RedirectLocationCacheExpiry   = 1 * time.Hour
 	DefaultServerBusySeconds      = 3600
 	MaxServerBusySeconds          = 86400
+	DefaultDrainTimeoutSeconds    = 300
+	MaxDrainTimeoutSeconds        = 3600
 )
 
 var redirectLocationDataCache = cache.NewLRU(cache.LRUOptions{
@@ -51,7 +55,11 @@ func (api *API) InitSystem() {
 
 	api.BaseRoutes.APIRoot.Handle("/logs", api.APISessionRequired(getLogs)).Methods("GET")
 	api.BaseRoutes.APIRoot.Handle("/logs/query", api.APISessionRequired(queryLogs)).Methods("POST")
+	api.BaseRoutes.APIRoot.Handle("/logs/query", api.APISessionRequired(queryLogsWithParams)).Methods("GET")
 	api.BaseRoutes.APIRoot.Handle("/logs", api.APIHandler(postLog)).Methods("POST")
+	api.BaseRoutes.APIRoot.Handle("/logs/level", api.APISessionRequired(getLogLevelOverrides)).Methods("GET")
+	api.BaseRoutes.APIRoot.Handle("/logs/level", api.APISessionRequired(setLogLevelOverride)).Methods("PUT")
+	api.BaseRoutes.APIRoot.Handle("/logs/level", api.APISessionRequired(removeLogLevelOverride)).Methods("DELETE")
 
 	api.BaseRoutes.APIRoot.Handle("/analytics/old", api.APISessionRequired(getAnalytics)).Methods("GET")
 	api.BaseRoutes.APIRoot.Handle("/latest_version", api.APISessionRequired(getLatestVersion)).Methods("GET")
@@ -63,12 +71,25 @@ func (api *API) InitSystem() {
 	api.BaseRoutes.APIRoot.Handle("/server_busy", api.APISessionRequired(setServerBusy)).Methods("POST")
 	api.BaseRoutes.APIRoot.Handle("/server_busy", api.APISessionRequired(getServerBusyExpires)).Methods("GET")
 	api.BaseRoutes.APIRoot.Handle("/server_busy", api.APISessionRequired(clearServerBusy)).Methods("DELETE")
+	api.BaseRoutes.System.Handle("/drain", api.APISessionRequired(drainServer)).Methods("POST")
+	api.BaseRoutes.System.Handle("/drain", api.APISessionRequired(getDrainStatus)).Methods("GET")
+	api.BaseRoutes.APIRoot.Handle("/maintenance_mode", api.APISessionRequired(getMaintenanceMode)).Methods("GET")
+	api.BaseRoutes.APIRoot.Handle("/maintenance_mode", api.APISessionRequired(enableMaintenanceMode)).Methods("POST")
+	api.BaseRoutes.APIRoot.Handle("/maintenance_mode", api.APISessionRequired(disableMaintenanceMode)).Methods("DELETE")
 	api.BaseRoutes.APIRoot.Handle("/upgrade_to_enterprise", api.APISessionRequired(upgradeToEnterprise)).Methods("POST")
 	api.BaseRoutes.APIRoot.Handle("/upgrade_to_enterprise/status", api.APISessionRequired(upgradeToEnterpriseStatus)).Methods("GET")
 	api.BaseRoutes.APIRoot.Handle("/restart", api.APISessionRequired(restart)).Methods("POST")
 	api.BaseRoutes.System.Handle("/notices/{team_id:[A-Za-z0-9]+}", api.APISessionRequired(getProductNotices)).Methods("GET")
 	api.BaseRoutes.System.Handle("/notices/view", api.APISessionRequired(updateViewedProductNotices)).Methods("PUT")
 	api.BaseRoutes.System.Handle("/support_packet", api.APISessionRequired(generateSupportPacket)).Methods("GET")
+	api.BaseRoutes.System.Handle("/profiles", api.APISessionRequired(captureProfile)).Methods("POST")
+	api.BaseRoutes.System.Handle("/profiles", api.APISessionRequired(listProfiles)).Methods("GET")
+	api.BaseRoutes.System.Handle("/profiles/{profile_name:[A-Za-z0-9_\\-\\.]+}", api.APISessionRequired(downloadProfile)).Methods("GET")
+	api.BaseRoutes.System.Handle("/profiles/{profile_name:[A-Za-z0-9_\\-\\.]+}", api.APISessionRequired(deleteProfile)).Methods("DELETE")
+	api.BaseRoutes.System.Handle("/slow_operations", api.APISessionRequired(getSlowOperations)).Methods("GET")
+	api.BaseRoutes.System.Handle("/data_seed", api.APISessionRequired(startDataSeed)).Methods("POST")
+	api.BaseRoutes.System.Handle("/data_seed", api.APISessionRequired(getDataSeedStatus)).Methods("GET")
+	api.BaseRoutes.System.Handle("/data_seed", api.APISessionRequired(cancelDataSeed)).Methods("DELETE")
 	api.BaseRoutes.System.Handle("/onboarding/complete", api.APISessionRequired(getOnboarding)).Methods("GET")
 	api.BaseRoutes.System.Handle("/onboarding/complete", api.APISessionRequired(completeOnboarding)).Methods("POST")
 	api.BaseRoutes.System.Handle("/schema/version", api.APISessionRequired(getAppliedSchemaMigrations)).Methods("GET")
@@ -140,6 +161,22 @@ func generateSupportPacket(c *Context, w http.ResponseWriter, r *http.Request) {
 }
 
 func getSystemPing(c *Context, w http.ResponseWriter, r *http.Request) {
+	// The deep mode reports the health of the dependencies, which is only available to the
+	// system admins.
+	deep := r.FormValue("deep") == "true"
+	if deep {
+		if !c.AppContext.Session().IsUnrestricted() {
+			c.SessionRequired()
+			if c.Err != nil {
+				return
+			}
+		}
+		if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem) {
+			c.SetPermissionError(model.PermissionManageSystem)
+			return
+		}
+	}
+
 	reqs := c.App.Config().ClientRequirements
 
 	s := make(map[string]string)
@@ -160,6 +197,13 @@ func getSystemPing(c *Context, w http.ResponseWriter, r *http.Request) {
 		s[model.STATUS] = model.StatusUnhealthy
 	}
 
+	// A draining server reports itself unhealthy so that the load balancers stop sending it
+	// new connections.
+	if c.App.Srv().Platform().IsDraining() {
+		s["draining"] = "true"
+		s[model.STATUS] = model.StatusUnhealthy
+	}
+
 	// Enhanced ping health check:
 	// If an extra form value is provided then perform extra health checks for
 	// database and file storage backends.
@@ -205,10 +249,33 @@ func getSystemPing(c *Context, w http.ResponseWriter, r *http.Request) {
 
 	s["ActiveSearchBackend"] = c.App.ActiveSearchBackend()
 
+	var dependencies []*model.DependencyHealth
+	if deep {
+		dependencies = c.App.CheckDependencyHealth(c.AppContext)
+		for _, dependency := range dependencies {
+			if dependency.Status != model.StatusOk {
+				s[model.STATUS] = model.StatusUnhealthy
+			}
+		}
+	}
+
 	if s[model.STATUS] != model.StatusOk && r.FormValue("use_rest_semantics") != "true" {
 		w.WriteHeader(http.StatusInternalServerError)
 	}
-	w.Write([]byte(model.MapToJSON(s)))
+
+	if !deep {
+		w.Write([]byte(model.MapToJSON(s)))
+		return
+	}
+
+	response := make(map[string]any, len(s)+1)
+	for key, value := range s {
+		response[key] = value
+	}
+	response["dependencies"] = dependencies
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
 }
 
 func testEmail(c *Context, w http.ResponseWriter, r *http.Request) {
@@ -363,6 +430,72 @@ func queryLogs(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	auditRec.AddMeta("page", c.Params.Page)
+	auditRec.AddMeta("logs_per_page", c.Params.LogsPerPage)
+
+	writeQueriedLogs(c, w, logFilter)
+}
+
+// queryLogsWithParams queries the logs like queryLogs, with the filter passed as URL parameters.
+func queryLogsWithParams(c *Context, w http.ResponseWriter, r *http.Request) {
+	auditRec := c.MakeAuditRecord("queryLogsWithParams", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+
+	if *c.App.Config().ExperimentalSettings.RestrictSystemAdmin {
+		c.Err = model.NewAppError("queryLogsWithParams", "api.restricted_system_admin", nil, "", http.StatusForbidden)
+		return
+	}
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionGetLogs) {
+		c.SetPermissionError(model.PermissionGetLogs)
+		return
+	}
+
+	query := r.URL.Query()
+	logFilter := &model.LogFilter{
+		Caller:    query.Get("caller"),
+		UserId:    query.Get("user_id"),
+		RequestId: query.Get("request_id"),
+	}
+	if serverNames := query.Get("server_names"); serverNames != "" {
+		logFilter.ServerNames = strings.Split(serverNames, ",")
+	}
+	if levels := query.Get("levels"); levels != "" {
+		logFilter.LogLevels = strings.Split(levels, ",")
+	}
+
+	if logFilter.UserId != "" && !model.IsValidId(logFilter.UserId) {
+		c.SetInvalidURLParam("user_id")
+		return
+	}
+	if logFilter.RequestId != "" && !model.IsValidId(logFilter.RequestId) {
+		c.SetInvalidURLParam("request_id")
+		return
+	}
+
+	for param, date := range map[string]*string{"since": &logFilter.DateFrom, "until": &logFilter.DateTo} {
+		value := query.Get(param)
+		if value == "" {
+			continue
+		}
+		millis, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || millis < 0 {
+			c.SetInvalidURLParam(param)
+			return
+		}
+		*date = model.GetTimeForMillis(millis).Format(model.LogFilterDateFormat)
+	}
+
+	auditRec.AddMeta("page", c.Params.Page)
+	auditRec.AddMeta("logs_per_page", c.Params.LogsPerPage)
+
+	writeQueriedLogs(c, w, logFilter)
+	if c.Err == nil {
+		auditRec.Success()
+	}
+}
+
+func writeQueriedLogs(c *Context, w http.ResponseWriter, logFilter *model.LogFilter) {
 	logs, logerr := c.App.QueryLogs(c.AppContext, c.Params.Page, c.Params.LogsPerPage, logFilter)
 	if logerr != nil {
 		c.Err = logerr
@@ -382,9 +515,6 @@ func queryLogs(c *Context, w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	auditRec.AddMeta("page", c.Params.Page)
-	auditRec.AddMeta("logs_per_page", c.Params.LogsPerPage)
-
 	w.Write(model.ToJSON(logsJSON))
 }
 
@@ -743,6 +873,389 @@ func clearServerBusy(c *Context, w http.ResponseWriter, r *http.Request) {
 	ReturnStatusOK(w)
 }
 
+func drainServer(c *Context, w http.ResponseWriter, r *http.Request) {
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem) {
+		c.SetPermissionError(model.PermissionManageSystem)
+		return
+	}
+
+	timeout := r.URL.Query().Get("timeout")
+	if timeout == "" {
+		timeout = strconv.Itoa(DefaultDrainTimeoutSeconds)
+	}
+
+	secs, err := strconv.Atoi(timeout)
+	if err != nil || secs <= 0 || secs > MaxDrainTimeoutSeconds {
+		c.SetInvalidURLParam(fmt.Sprintf("timeout must be 1 - %d", MaxDrainTimeoutSeconds))
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("drainServer", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameter(auditRec, "timeout", secs)
+
+	status, started := c.App.Srv().Platform().StartDrain(time.Second * time.Duration(secs))
+	if started {
+		c.Logger.Warn("Server drain started - new websocket connections are rejected", mlog.Int("timeout", secs))
+	}
+
+	auditRec.Success()
+
+	if err := json.NewEncoder(w).Encode(status); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func getDrainStatus(c *Context, w http.ResponseWriter, r *http.Request) {
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem) {
+		c.SetPermissionError(model.PermissionManageSystem)
+		return
+	}
+
+	status := c.App.Srv().Platform().DrainStatus()
+	if status == nil {
+		status = &model.DrainStatus{}
+	}
+
+	if err := json.NewEncoder(w).Encode(status); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+// checkUnrestrictedSystemAdmin restricts the access to the server internals to the system admins
+// who aren't restricted, and to local mode.
+func checkUnrestrictedSystemAdmin(c *Context, where string) bool {
+	if !c.AppContext.Session().IsUnrestricted() && *c.App.Config().ExperimentalSettings.RestrictSystemAdmin {
+		c.Err = model.NewAppError(where, "api.restricted_system_admin", nil, "", http.StatusForbidden)
+		return false
+	}
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem) {
+		c.SetPermissionError(model.PermissionManageSystem)
+		return false
+	}
+
+	return true
+}
+
+func captureProfile(c *Context, w http.ResponseWriter, r *http.Request) {
+	var profileRequest model.ProfileRequest
+	if err := json.NewDecoder(r.Body).Decode(&profileRequest); err != nil {
+		c.SetInvalidParamWithErr("profile", err)
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("captureProfile", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameter(auditRec, "type", profileRequest.Type)
+	audit.AddEventParameter(auditRec, "duration_seconds", profileRequest.DurationSeconds)
+
+	if !checkUnrestrictedSystemAdmin(c, "captureProfile") {
+		return
+	}
+
+	profile, appErr := c.App.CaptureProfile(c.AppContext, &profileRequest)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddEventResultState(profile)
+
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(profile); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func listProfiles(c *Context, w http.ResponseWriter, r *http.Request) {
+	if !checkUnrestrictedSystemAdmin(c, "listProfiles") {
+		return
+	}
+
+	profiles, appErr := c.App.ListProfiles()
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(profiles); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func downloadProfile(c *Context, w http.ResponseWriter, r *http.Request) {
+	auditRec := c.MakeAuditRecord("downloadProfile", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameter(auditRec, "profile_name", c.Params.ProfileName)
+
+	if !checkUnrestrictedSystemAdmin(c, "downloadProfile") {
+		return
+	}
+
+	file, appErr := c.App.ProfileReader(c.Params.ProfileName)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+	defer file.Close()
+
+	auditRec.Success()
+
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Header().Set("Content-Disposition", "attachment;filename=\""+c.Params.ProfileName+"\"")
+	http.ServeContent(w, r, c.Params.ProfileName, time.Time{}, file)
+}
+
+func deleteProfile(c *Context, w http.ResponseWriter, r *http.Request) {
+	auditRec := c.MakeAuditRecord("deleteProfile", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameter(auditRec, "profile_name", c.Params.ProfileName)
+
+	if !checkUnrestrictedSystemAdmin(c, "deleteProfile") {
+		return
+	}
+
+	if appErr := c.App.DeleteProfile(c.Params.ProfileName); appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.Success()
+	ReturnStatusOK(w)
+}
+
+func getLogLevelOverrides(c *Context, w http.ResponseWriter, r *http.Request) {
+	if !checkUnrestrictedSystemAdmin(c, "getLogLevelOverrides") {
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(c.App.Srv().Platform().LogLevelOverrides()); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func setLogLevelOverride(c *Context, w http.ResponseWriter, r *http.Request) {
+	var override model.LogLevelOverride
+	if err := json.NewDecoder(r.Body).Decode(&override); err != nil {
+		c.SetInvalidParamWithErr("log_level_override", err)
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("setLogLevelOverride", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameterAuditable(auditRec, "log_level_override", &override)
+
+	if !checkUnrestrictedSystemAdmin(c, "setLogLevelOverride") {
+		return
+	}
+
+	override.SetDefaults()
+	if appErr := override.IsValid(); appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	result, err := c.App.Srv().Platform().SetLogLevelOverride(override)
+	if err != nil {
+		c.Err = model.NewAppError("setLogLevelOverride", "api.system.log_level.reconfigure.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddEventResultState(result)
+
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func removeLogLevelOverride(c *Context, w http.ResponseWriter, r *http.Request) {
+	module := r.URL.Query().Get("module")
+	if !slices.Contains(model.LogModules, module) {
+		c.SetInvalidURLParam("module")
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("removeLogLevelOverride", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameter(auditRec, "module", module)
+
+	if !checkUnrestrictedSystemAdmin(c, "removeLogLevelOverride") {
+		return
+	}
+
+	if err := c.App.Srv().Platform().RemoveLogLevelOverride(module); err != nil {
+		c.Err = model.NewAppError("removeLogLevelOverride", "api.system.log_level.reconfigure.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		return
+	}
+
+	auditRec.Success()
+	ReturnStatusOK(w)
+}
+
+func getSlowOperations(c *Context, w http.ResponseWriter, r *http.Request) {
+	if !checkUnrestrictedSystemAdmin(c, "getSlowOperations") {
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(c.App.Srv().Platform().SlowOperations().SlowOperations()); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+// checkDataSeedAllowed restricts the generation of synthetic data to the system admins of the
+// servers which enabled it explicitly.
+func checkDataSeedAllowed(c *Context) bool {
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem) {
+		c.SetPermissionError(model.PermissionManageSystem)
+		return false
+	}
+
+	if !*c.App.Config().ServiceSettings.EnableAPIDataSeeding {
+		c.Err = model.NewAppError("checkDataSeedAllowed", "api.system.data_seed.disabled.app_error", nil, "", http.StatusForbidden)
+		return false
+	}
+
+	return true
+}
+
+func startDataSeed(c *Context, w http.ResponseWriter, r *http.Request) {
+	var seedRequest model.DataSeedRequest
+	if err := json.NewDecoder(r.Body).Decode(&seedRequest); err != nil {
+		c.SetInvalidParamWithErr("data_seed", err)
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("startDataSeed", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameterAuditable(auditRec, "data_seed", &seedRequest)
+
+	if !checkDataSeedAllowed(c) {
+		return
+	}
+
+	status, appErr := c.App.StartDataSeed(c.AppContext, seedRequest)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddEventResultState(status)
+
+	w.WriteHeader(http.StatusAccepted)
+	if err := json.NewEncoder(w).Encode(status); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func getDataSeedStatus(c *Context, w http.ResponseWriter, r *http.Request) {
+	if !checkDataSeedAllowed(c) {
+		return
+	}
+
+	status := c.App.GetDataSeedStatus()
+	if status == nil {
+		c.Err = model.NewAppError("getDataSeedStatus", "app.data_seed.not_found.app_error", nil, "", http.StatusNotFound)
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(status); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func cancelDataSeed(c *Context, w http.ResponseWriter, r *http.Request) {
+	auditRec := c.MakeAuditRecord("cancelDataSeed", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+
+	if !checkDataSeedAllowed(c) {
+		return
+	}
+
+	if appErr := c.App.CancelDataSeed(); appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.Success()
+	ReturnStatusOK(w)
+}
+
+func getMaintenanceMode(c *Context, w http.ResponseWriter, r *http.Request) {
+	if err := json.NewEncoder(w).Encode(c.App.GetMaintenanceMode()); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func enableMaintenanceMode(c *Context, w http.ResponseWriter, r *http.Request) {
+	var mode model.MaintenanceMode
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&mode); err != nil {
+			c.SetInvalidParamWithErr("maintenance_mode", err)
+			return
+		}
+	}
+
+	auditRec := c.MakeAuditRecord("enableMaintenanceMode", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameter(auditRec, "message", mode.Message)
+	audit.AddEventParameter(auditRec, "retry_after_seconds", mode.RetryAfterSeconds)
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem) {
+		c.SetPermissionError(model.PermissionManageSystem)
+		return
+	}
+
+	if !c.AppContext.Session().IsUnrestricted() && *c.App.Config().ExperimentalSettings.RestrictSystemAdmin {
+		c.Err = model.NewAppError("enableMaintenanceMode", "api.restricted_system_admin", nil, "", http.StatusForbidden)
+		return
+	}
+
+	updatedMode, appErr := c.App.EnableMaintenanceMode(mode.Message, mode.RetryAfterSeconds)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+	c.Logger.Warn("Maintenance mode enabled - write requests to the API are rejected")
+
+	auditRec.Success()
+
+	if err := json.NewEncoder(w).Encode(updatedMode); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func disableMaintenanceMode(c *Context, w http.ResponseWriter, r *http.Request) {
+	auditRec := c.MakeAuditRecord("disableMaintenanceMode", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem) {
+		c.SetPermissionError(model.PermissionManageSystem)
+		return
+	}
+
+	if !c.AppContext.Session().IsUnrestricted() && *c.App.Config().ExperimentalSettings.RestrictSystemAdmin {
+		c.Err = model.NewAppError("disableMaintenanceMode", "api.restricted_system_admin", nil, "", http.StatusForbidden)
+		return
+	}
+
+	updatedMode, appErr := c.App.DisableMaintenanceMode()
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+	c.Logger.Info("Maintenance mode disabled")
+
+	auditRec.Success()
+
+	if err := json.NewEncoder(w).Encode(updatedMode); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
 func getServerBusyExpires(c *Context, w http.ResponseWriter, r *http.Request) {
 	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem) {
 		c.SetPermissionError(model.PermissionManageSystem)
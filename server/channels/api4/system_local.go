@@ -14,10 +14,26 @@ import (
 func (api *API) InitSystemLocal() {
 	api.BaseRoutes.System.Handle("/ping", api.APILocal(getSystemPing)).Methods("GET")
 	api.BaseRoutes.APIRoot.Handle("/logs", api.APILocal(getLogs)).Methods("GET")
+	api.BaseRoutes.APIRoot.Handle("/logs/level", api.APILocal(getLogLevelOverrides)).Methods("GET")
+	api.BaseRoutes.APIRoot.Handle("/logs/level", api.APILocal(setLogLevelOverride)).Methods("PUT")
+	api.BaseRoutes.APIRoot.Handle("/logs/level", api.APILocal(removeLogLevelOverride)).Methods("DELETE")
 	api.BaseRoutes.APIRoot.Handle("/server_busy", api.APILocal(setServerBusy)).Methods("POST")
 	api.BaseRoutes.APIRoot.Handle("/server_busy", api.APILocal(getServerBusyExpires)).Methods("GET")
 	api.BaseRoutes.APIRoot.Handle("/server_busy", api.APILocal(clearServerBusy)).Methods("DELETE")
+	api.BaseRoutes.System.Handle("/drain", api.APILocal(drainServer)).Methods("POST")
+	api.BaseRoutes.System.Handle("/drain", api.APILocal(getDrainStatus)).Methods("GET")
+	api.BaseRoutes.APIRoot.Handle("/maintenance_mode", api.APILocal(getMaintenanceMode)).Methods("GET")
+	api.BaseRoutes.APIRoot.Handle("/maintenance_mode", api.APILocal(enableMaintenanceMode)).Methods("POST")
+	api.BaseRoutes.APIRoot.Handle("/maintenance_mode", api.APILocal(disableMaintenanceMode)).Methods("DELETE")
 	api.BaseRoutes.System.Handle("/support_packet", api.APILocal(generateSupportPacket)).Methods("GET")
+	api.BaseRoutes.System.Handle("/profiles", api.APILocal(captureProfile)).Methods("POST")
+	api.BaseRoutes.System.Handle("/profiles", api.APILocal(listProfiles)).Methods("GET")
+	api.BaseRoutes.System.Handle("/profiles/{profile_name:[A-Za-z0-9_\\-\\.]+}", api.APILocal(downloadProfile)).Methods("GET")
+	api.BaseRoutes.System.Handle("/profiles/{profile_name:[A-Za-z0-9_\\-\\.]+}", api.APILocal(deleteProfile)).Methods("DELETE")
+	api.BaseRoutes.System.Handle("/slow_operations", api.APILocal(getSlowOperations)).Methods("GET")
+	api.BaseRoutes.System.Handle("/data_seed", api.APILocal(startDataSeed)).Methods("POST")
+	api.BaseRoutes.System.Handle("/data_seed", api.APILocal(getDataSeedStatus)).Methods("GET")
+	api.BaseRoutes.System.Handle("/data_seed", api.APILocal(cancelDataSeed)).Methods("DELETE")
 	api.BaseRoutes.APIRoot.Handle("/integrity", api.APILocal(localCheckIntegrity)).Methods("POST")
 	api.BaseRoutes.System.Handle("/schema/version", api.APILocal(getAppliedSchemaMigrations)).Methods("GET")
 }
@@ -23,6 +23,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
 	"github.com/mattermost/mattermost/server/v8/channels/utils/fileutils"
 )
 
@@ -93,6 +94,30 @@ func TestGetPing(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, "unknown", respMap["CanReceiveNotifications"]) // Unrecognized platform
 	}, "ping and test push notification")
+
+	t.Run("deep mode requires system admin", func(t *testing.T) {
+		_, resp, err := th.CreateClient().GetPingWithDependencies(context.Background())
+		require.Error(t, err)
+		CheckUnauthorizedStatus(t, resp)
+
+		_, resp, err = th.Client.GetPingWithDependencies(context.Background())
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	th.TestForSystemAdminAndLocal(t, func(t *testing.T, client *model.Client4) {
+		health, _, err := client.GetPingWithDependencies(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, model.StatusOk, health.Status)
+
+		names := make([]string, 0, len(health.Dependencies))
+		for _, dependency := range health.Dependencies {
+			assert.Equal(t, model.StatusOk, dependency.Status, dependency.Name)
+			names = append(names, dependency.Name)
+		}
+		assert.Contains(t, names, "database_primary")
+		assert.Contains(t, names, "file_store")
+	}, "deep mode")
 }
 
 func TestGetAudits(t *testing.T) {
@@ -402,6 +427,65 @@ func TestGetLogs(t *testing.T) {
 	CheckUnauthorizedStatus(t, resp)
 }
 
+func TestQueryLogsWithParams(t *testing.T) {
+	th := Setup(t)
+	defer th.TearDown()
+
+	requestID := model.NewId()
+	for i := 0; i < 20; i++ {
+		if i%2 == 0 {
+			th.TestLogger.Info(strconv.Itoa(i), mlog.String("request_id", requestID))
+		} else {
+			th.TestLogger.Info(strconv.Itoa(i))
+		}
+	}
+	require.NoError(t, th.TestLogger.Flush(), "failed to flush log")
+
+	queryLogs := func(t *testing.T, client *model.Client4, query string) (map[string][]map[string]any, *model.Response, error) {
+		r, err := client.DoAPIGet(context.Background(), "/logs/query?"+query, "")
+		if err != nil {
+			return nil, model.BuildResponse(r), err
+		}
+		defer r.Body.Close()
+
+		var logs map[string][]map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&logs))
+		return logs, model.BuildResponse(r), nil
+	}
+
+	th.TestForSystemAdminAndLocal(t, func(t *testing.T, client *model.Client4) {
+		logs, _, err := queryLogs(t, client, "request_id="+requestID+"&logs_per_page=5")
+		require.NoError(t, err)
+		require.Len(t, logs["default"], 5)
+		for i, entry := range logs["default"] {
+			assert.Equal(t, requestID, entry["request_id"])
+			assert.Equal(t, strconv.Itoa(10+i*2), entry["msg"])
+		}
+
+		logs, _, err = queryLogs(t, client, "request_id="+requestID+"&levels=error")
+		require.NoError(t, err)
+		assert.Empty(t, logs["default"])
+
+		logs, _, err = queryLogs(t, client, "request_id="+requestID+"&since="+strconv.FormatInt(model.GetMillis()+60000, 10))
+		require.NoError(t, err)
+		assert.Empty(t, logs["default"])
+	})
+
+	t.Run("invalid parameters", func(t *testing.T) {
+		for _, query := range []string{"request_id=invalid", "user_id=invalid", "since=yesterday"} {
+			_, resp, err := queryLogs(t, th.SystemAdminClient, query)
+			require.Error(t, err)
+			CheckBadRequestStatus(t, resp)
+		}
+	})
+
+	t.Run("without permission", func(t *testing.T) {
+		_, resp, err := queryLogs(t, th.Client, "request_id="+requestID)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+}
+
 func TestPostLog(t *testing.T) {
 	th := Setup(t)
 	defer th.TearDown()
@@ -1037,3 +1121,341 @@ func TestCheckHasNilFields(t *testing.T) {
 		require.True(t, res)
 	})
 }
+
+func TestMaintenanceMode(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	t.Run("users can't enable the maintenance mode", func(t *testing.T) {
+		_, resp, err := th.Client.EnableMaintenanceMode(context.Background(), "", 0)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	mode, _, err := th.SystemAdminClient.EnableMaintenanceMode(context.Background(), "Database migration", 120)
+	require.NoError(t, err)
+	assert.True(t, mode.Enabled)
+	assert.Equal(t, "Database migration", mode.Message)
+	assert.Equal(t, 120, mode.RetryAfterSeconds)
+
+	t.Run("writes are rejected", func(t *testing.T) {
+		_, resp, err := th.Client.CreatePost(context.Background(), &model.Post{ChannelId: th.BasicChannel.Id, Message: "message"})
+		require.Error(t, err)
+		CheckServiceUnavailableStatus(t, resp)
+		CheckErrorID(t, err, "api.context.maintenance_mode.app_error")
+		assert.Equal(t, "120", resp.Header.Get("Retry-After"))
+	})
+
+	t.Run("reads and sign in are allowed", func(t *testing.T) {
+		mode, _, err := th.Client.GetMaintenanceMode(context.Background())
+		require.NoError(t, err)
+		assert.True(t, mode.Enabled)
+
+		_, _, err = th.Client.GetPostsForChannel(context.Background(), th.BasicChannel.Id, 0, 10, "", false, false)
+		require.NoError(t, err)
+
+		response, _, err := th.Client.ExecuteBatch(context.Background(), &model.BatchRequest{
+			Operations: []*model.BatchOperation{{Name: "me", Path: "/users/me"}},
+		})
+		require.NoError(t, err)
+		require.Len(t, response.Results, 1)
+		assert.Equal(t, http.StatusOK, response.Results[0].StatusCode)
+
+		client := th.CreateClient()
+		_, _, err = client.Login(context.Background(), th.BasicUser.Email, th.BasicUser.Password)
+		require.NoError(t, err)
+	})
+
+	t.Run("local mode is allowed", func(t *testing.T) {
+		_, _, err := th.LocalClient.PatchConfig(context.Background(), &model.Config{TeamSettings: model.TeamSettings{SiteName: model.NewString("Maintenance")}})
+		require.NoError(t, err)
+	})
+
+	mode, _, err = th.LocalClient.DisableMaintenanceMode(context.Background())
+	require.NoError(t, err)
+	assert.False(t, mode.Enabled)
+
+	_, _, err = th.Client.CreatePost(context.Background(), &model.Post{ChannelId: th.BasicChannel.Id, Message: "message"})
+	require.NoError(t, err)
+}
+
+func TestDrainServer(t *testing.T) {
+	th := Setup(t)
+	defer th.TearDown()
+
+	t.Run("as system user", func(t *testing.T) {
+		_, resp, err := th.Client.DrainServer(context.Background(), 1)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+		require.False(t, th.App.Srv().Platform().IsDraining())
+	})
+
+	t.Run("invalid timeout", func(t *testing.T) {
+		for _, timeout := range []int{-1, MaxDrainTimeoutSeconds + 1} {
+			_, resp, err := th.SystemAdminClient.DrainServer(context.Background(), timeout)
+			require.Error(t, err)
+			CheckBadRequestStatus(t, resp)
+		}
+		require.False(t, th.App.Srv().Platform().IsDraining())
+	})
+
+	status, _, err := th.SystemAdminClient.GetDrainStatus(context.Background())
+	require.NoError(t, err)
+	assert.False(t, status.Draining)
+
+	status, _, err = th.SystemAdminClient.DrainServer(context.Background(), 1)
+	require.NoError(t, err)
+	assert.True(t, status.Draining)
+	assert.True(t, th.App.Srv().Platform().IsDraining())
+
+	t.Run("new websocket connections are rejected", func(t *testing.T) {
+		_, err := th.CreateWebSocketClient()
+		require.Error(t, err)
+	})
+
+	t.Run("ping reports the server unhealthy", func(t *testing.T) {
+		status, resp, err := th.Client.GetPing(context.Background())
+		require.Error(t, err)
+		CheckInternalErrorStatus(t, resp)
+		assert.Equal(t, model.StatusUnhealthy, status)
+	})
+
+	require.Eventually(t, func() bool {
+		status, _, err = th.SystemAdminClient.GetDrainStatus(context.Background())
+		require.NoError(t, err)
+		return status.IsComplete()
+	}, 10*time.Second, 100*time.Millisecond)
+	assert.Zero(t, status.WebSocketConnections)
+}
+
+func TestProfiles(t *testing.T) {
+	th := Setup(t)
+	defer th.TearDown()
+
+	t.Run("as system user", func(t *testing.T) {
+		_, resp, err := th.Client.CaptureProfile(context.Background(), &model.ProfileRequest{Type: model.ProfileTypeHeap})
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = th.Client.GetProfiles(context.Background())
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("as restricted system admin", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ExperimentalSettings.RestrictSystemAdmin = true })
+		defer th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ExperimentalSettings.RestrictSystemAdmin = false })
+
+		_, resp, err := th.SystemAdminClient.CaptureProfile(context.Background(), &model.ProfileRequest{Type: model.ProfileTypeHeap})
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, _, err = th.LocalClient.GetProfiles(context.Background())
+		require.NoError(t, err, "local mode isn't restricted")
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		for _, profileRequest := range []*model.ProfileRequest{
+			{Type: "unknown"},
+			{Type: model.ProfileTypeCPU, DurationSeconds: model.ProfileMaxDurationSeconds + 1},
+		} {
+			_, resp, err := th.SystemAdminClient.CaptureProfile(context.Background(), profileRequest)
+			require.Error(t, err)
+			CheckBadRequestStatus(t, resp)
+		}
+	})
+
+	th.TestForSystemAdminAndLocal(t, func(t *testing.T, client *model.Client4) {
+		profile, resp, err := client.CaptureProfile(context.Background(), &model.ProfileRequest{Type: model.ProfileTypeCPU, DurationSeconds: 1})
+		require.NoError(t, err)
+		CheckCreatedStatus(t, resp)
+		assert.Equal(t, model.ProfileTypeCPU, profile.Type)
+		assert.NotZero(t, profile.Size)
+
+		profiles, _, err := client.GetProfiles(context.Background())
+		require.NoError(t, err)
+		require.NotEmpty(t, profiles)
+		assert.Equal(t, profile.Name, profiles[0].Name)
+		assert.Equal(t, model.ProfileTypeCPU, profiles[0].Type)
+
+		var b bytes.Buffer
+		n, _, err := client.DownloadProfile(context.Background(), profile.Name, &b)
+		require.NoError(t, err)
+		assert.Equal(t, profile.Size, n)
+
+		_, err = client.DeleteProfile(context.Background(), profile.Name)
+		require.NoError(t, err)
+
+		_, resp, err = client.DownloadProfile(context.Background(), profile.Name, &b)
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+	})
+}
+
+func TestGetSlowOperations(t *testing.T) {
+	th := Setup(t)
+	defer th.TearDown()
+
+	t.Run("as system user", func(t *testing.T) {
+		_, resp, err := th.Client.GetSlowOperations(context.Background())
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("as restricted system admin", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ExperimentalSettings.RestrictSystemAdmin = true })
+		defer th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ExperimentalSettings.RestrictSystemAdmin = false })
+
+		_, resp, err := th.SystemAdminClient.GetSlowOperations(context.Background())
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	th.TestForSystemAdminAndLocal(t, func(t *testing.T, client *model.Client4) {
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.SlowOperationSettings.Enable = false })
+
+		operations, _, err := client.GetSlowOperations(context.Background())
+		require.NoError(t, err)
+		assert.False(t, operations.Enabled)
+		assert.Empty(t, operations.Current.Requests)
+
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.SlowOperationSettings.Enable = true })
+		defer th.App.UpdateConfig(func(cfg *model.Config) { *cfg.SlowOperationSettings.Enable = false })
+
+		th.App.Srv().Platform().SlowOperations().RecordRequest(http.MethodGet, "/api/v4/users/{user_id}", "200", time.Minute)
+		th.App.Srv().Platform().SlowOperations().RecordQuery("SELECT * FROM Users WHERE Email = 'user@example.com'", time.Minute)
+
+		operations, _, err = client.GetSlowOperations(context.Background())
+		require.NoError(t, err)
+		assert.True(t, operations.Enabled)
+		require.NotEmpty(t, operations.Current.Requests)
+		assert.Equal(t, "/api/v4/users/{user_id}", operations.Current.Requests[0].Route)
+		require.NotEmpty(t, operations.Current.Queries)
+		assert.Equal(t, "SELECT * FROM Users WHERE Email = '?'", operations.Current.Queries[0].Query)
+	})
+}
+
+func TestLogLevelOverrides(t *testing.T) {
+	th := Setup(t)
+	defer th.TearDown()
+
+	t.Run("as system user", func(t *testing.T) {
+		_, resp, err := th.Client.SetLogLevelOverride(context.Background(), &model.LogLevelOverride{Module: model.LogModuleLDAP, Level: "debug"})
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = th.Client.GetLogLevelOverrides(context.Background())
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("invalid override", func(t *testing.T) {
+		_, resp, err := th.SystemAdminClient.SetLogLevelOverride(context.Background(), &model.LogLevelOverride{Module: "unknown", Level: "debug"})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+
+		resp, err = th.SystemAdminClient.RemoveLogLevelOverride(context.Background(), "unknown")
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	th.TestForSystemAdminAndLocal(t, func(t *testing.T, client *model.Client4) {
+		override, _, err := client.SetLogLevelOverride(context.Background(), &model.LogLevelOverride{Module: model.LogModuleLDAP, Level: "debug"})
+		require.NoError(t, err)
+		assert.Equal(t, model.LogLevelOverrideDefaultDurationMinutes, override.DurationMinutes)
+		assert.Greater(t, override.ExpireAt, model.GetMillis())
+
+		overrides, _, err := client.GetLogLevelOverrides(context.Background())
+		require.NoError(t, err)
+		require.Len(t, overrides, 1)
+		assert.Equal(t, "debug", overrides[0].Level)
+
+		_, err = client.RemoveLogLevelOverride(context.Background(), model.LogModuleLDAP)
+		require.NoError(t, err)
+
+		overrides, _, err = client.GetLogLevelOverrides(context.Background())
+		require.NoError(t, err)
+		assert.Empty(t, overrides)
+	})
+}
+
+func TestDataSeed(t *testing.T) {
+	th := Setup(t)
+	defer th.TearDown()
+
+	seedRequest := &model.DataSeedRequest{
+		Teams:           2,
+		ChannelsPerTeam: 3,
+		Users:           4,
+		Posts:           20,
+		ReplyPercentage: 50,
+		Seed:            1,
+		Password:        "Seed-Passw0rd!",
+	}
+
+	t.Run("as system user", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableAPIDataSeeding = true })
+		defer th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableAPIDataSeeding = false })
+
+		_, resp, err := th.Client.StartDataSeed(context.Background(), seedRequest)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		_, resp, err := th.SystemAdminClient.StartDataSeed(context.Background(), seedRequest)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableAPIDataSeeding = true })
+	defer th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableAPIDataSeeding = false })
+
+	t.Run("invalid request", func(t *testing.T) {
+		_, resp, err := th.SystemAdminClient.StartDataSeed(context.Background(), &model.DataSeedRequest{Users: 1, Password: "Seed-Passw0rd!"})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("no data seeding started", func(t *testing.T) {
+		_, resp, err := th.SystemAdminClient.GetDataSeedStatus(context.Background())
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+
+		resp, err = th.SystemAdminClient.CancelDataSeed(context.Background())
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+	})
+
+	t.Run("generates the data", func(t *testing.T) {
+		status, resp, err := th.SystemAdminClient.StartDataSeed(context.Background(), seedRequest)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusAccepted, resp.StatusCode)
+		assert.Empty(t, status.Request.Password)
+
+		require.Eventually(t, func() bool {
+			status, _, err = th.SystemAdminClient.GetDataSeedStatus(context.Background())
+			require.NoError(t, err)
+			return status.IsComplete()
+		}, 30*time.Second, 100*time.Millisecond)
+
+		require.Empty(t, status.Error)
+		assert.False(t, status.Canceled)
+		assert.Equal(t, 2, status.Teams)
+		assert.Equal(t, 8, status.Channels)
+		assert.Equal(t, 4, status.Users)
+		assert.Equal(t, 20, status.Posts)
+
+		user, _, err := th.SystemAdminClient.GetUserByUsername(context.Background(), status.Prefix+".user0", "")
+		require.NoError(t, err)
+
+		client := th.CreateClient()
+		_, _, err = client.Login(context.Background(), user.Email, seedRequest.Password)
+		require.NoError(t, err)
+
+		teams, _, err := client.GetTeamsForUser(context.Background(), user.Id, "")
+		require.NoError(t, err)
+		require.NotEmpty(t, teams)
+		assert.Contains(t, teams[0].Name, status.Prefix)
+	})
+}
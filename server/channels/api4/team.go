@@ -586,6 +586,16 @@ func getTeamMembers(c *Context, w http.ResponseWriter, r *http.Request) {
 		ViewRestrictions:    restrictions,
 	}
 
+	// The members visible through restrictions change with the memberships of the other users, which the etag
+	// of the members doesn't cover.
+	var etag string
+	if restrictions == nil {
+		etag = c.App.GetTeamMembersEtag(c.Params.TeamId)
+		if c.HandleEtag(etag, "Get Team Members", w, r) {
+			return
+		}
+	}
+
 	members, appErr := c.App.GetTeamMembers(c.Params.TeamId, c.Params.Page*c.Params.PerPage, c.Params.PerPage, teamMembersGetOptions)
 	if appErr != nil {
 		c.Err = appErr
@@ -598,6 +608,9 @@ func getTeamMembers(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if etag != "" {
+		w.Header().Set(model.HeaderEtagServer, etag)
+	}
 	w.Write(js)
 }
 
@@ -2008,6 +2008,23 @@ func TestGetTeamMembers(t *testing.T) {
 	require.NoError(t, err)
 	require.Empty(t, rmembers, "should be no member")
 
+	t.Run("etag", func(t *testing.T) {
+		_, resp, err := client.GetTeamMembers(context.Background(), team.Id, 0, 100, "")
+		require.NoError(t, err)
+		require.NotEmpty(t, resp.Etag)
+
+		rmembers, resp, _ := client.GetTeamMembers(context.Background(), team.Id, 0, 100, resp.Etag)
+		CheckEtag(t, rmembers, resp)
+
+		th.UpdateUserToTeamAdmin(th.BasicUser2, team)
+
+		rmembers, resp2, err := client.GetTeamMembers(context.Background(), team.Id, 0, 100, resp.Etag)
+		require.NoError(t, err)
+		CheckOKStatus(t, resp2)
+		require.NotEmpty(t, rmembers)
+		require.NotEqual(t, resp.Etag, resp2.Etag)
+	})
+
 	rmembers, _, err = client.GetTeamMembers(context.Background(), team.Id, 0, 2, "")
 	require.NoError(t, err)
 	rmembers2, _, err := client.GetTeamMembers(context.Background(), team.Id, 1, 2, "")
@@ -16,6 +16,8 @@ import (
 func (api *API) InitTermsOfService() {
 	api.BaseRoutes.TermsOfService.Handle("", api.APISessionRequired(getLatestTermsOfService)).Methods("GET")
 	api.BaseRoutes.TermsOfService.Handle("", api.APISessionRequired(createTermsOfService)).Methods("POST")
+	api.BaseRoutes.TermsOfService.Handle("/versions", api.APISessionRequired(getTermsOfServiceVersions)).Methods("GET")
+	api.BaseRoutes.TermsOfService.Handle("/{terms_of_service_id:[A-Za-z0-9]+}/stats", api.APISessionRequired(getTermsOfServiceStats)).Methods("GET")
 }
 
 func getLatestTermsOfService(c *Context, w http.ResponseWriter, r *http.Request) {
@@ -46,21 +48,28 @@ func createTermsOfService(c *Context, w http.ResponseWriter, r *http.Request) {
 
 	props := model.MapFromJSON(r.Body)
 	text := props["text"]
+	audience := props["audience"]
 	userId := c.AppContext.Session().UserId
+	audit.AddEventParameter(auditRec, "audience", audience)
 
 	if text == "" {
 		c.Err = model.NewAppError("Config.IsValid", "api.create_terms_of_service.empty_text.app_error", nil, "", http.StatusBadRequest)
 		return
 	}
 
+	if !model.IsValidTermsOfServiceAudience(audience) {
+		c.SetInvalidParam("audience")
+		return
+	}
+
 	oldTermsOfService, err := c.App.GetLatestTermsOfService()
 	if err != nil && err.Id != app.ErrorTermsOfServiceNoRowsFound {
 		c.Err = err
 		return
 	}
 
-	if oldTermsOfService == nil || oldTermsOfService.Text != text {
-		termsOfService, err := c.App.CreateTermsOfService(text, userId)
+	if oldTermsOfService == nil || oldTermsOfService.Text != text || oldTermsOfService.Audience != audience {
+		termsOfService, err := c.App.CreateTermsOfServiceForAudience(text, userId, audience)
 		if err != nil {
 			c.Err = err
 			return
@@ -76,3 +85,42 @@ func createTermsOfService(c *Context, w http.ResponseWriter, r *http.Request) {
 	}
 	auditRec.Success()
 }
+
+func getTermsOfServiceVersions(c *Context, w http.ResponseWriter, r *http.Request) {
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionSysconsoleReadComplianceCustomTermsOfService) {
+		c.SetPermissionError(model.PermissionSysconsoleReadComplianceCustomTermsOfService)
+		return
+	}
+
+	versions, err := c.App.GetTermsOfServiceVersions(c.Params.Page, c.Params.PerPage)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(versions); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func getTermsOfServiceStats(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireTermsOfServiceId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionSysconsoleReadComplianceCustomTermsOfService) {
+		c.SetPermissionError(model.PermissionSysconsoleReadComplianceCustomTermsOfService)
+		return
+	}
+
+	stats, err := c.App.GetTermsOfServiceStats(c.Params.TermsOfServiceId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(stats); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
@@ -57,3 +57,55 @@ func TestCreateTermsOfServiceAdminUser(t *testing.T) {
 	assert.Equal(t, "terms of service new_2", termsOfService.Text)
 	assert.Equal(t, th.SystemAdminUser.Id, termsOfService.UserId)
 }
+
+func TestTermsOfServiceVersions(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.Srv().SetLicense(model.NewTestLicense("EnableCustomTermsOfService"))
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.SupportSettings.CustomTermsOfServiceEnabled = true })
+
+	first, _, err := th.SystemAdminClient.CreateTermsOfService(context.Background(), "first", th.SystemAdminUser.Id)
+	require.NoError(t, err)
+	require.NoError(t, th.App.SaveUserTermsOfService(th.BasicUser.Id, first.Id, true))
+
+	second, _, err := th.SystemAdminClient.CreateTermsOfServiceForAudience(context.Background(), "second", model.TermsOfServiceAudienceGuests)
+	require.NoError(t, err)
+	assert.Equal(t, first.Version+1, second.Version)
+	assert.Equal(t, model.TermsOfServiceAudienceGuests, second.Audience)
+
+	t.Run("invalid audience", func(t *testing.T) {
+		_, resp, err := th.SystemAdminClient.CreateTermsOfServiceForAudience(context.Background(), "third", "admins")
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("versions", func(t *testing.T) {
+		_, resp, err := th.Client.GetTermsOfServiceVersions(context.Background(), 0, 10)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		versions, _, err := th.SystemAdminClient.GetTermsOfServiceVersions(context.Background(), 0, 10)
+		require.NoError(t, err)
+		require.Len(t, versions, 2)
+		assert.Equal(t, second.Id, versions[0].Id)
+		assert.Equal(t, first.Id, versions[1].Id)
+	})
+
+	t.Run("stats", func(t *testing.T) {
+		_, resp, err := th.Client.GetTermsOfServiceStats(context.Background(), first.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		stats, _, err := th.SystemAdminClient.GetTermsOfServiceStats(context.Background(), first.Id)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), stats.AcceptedUsers)
+		assert.NotZero(t, stats.TargetedUsers)
+		assert.Zero(t, stats.GracePeriodEndAt)
+
+		stats, _, err = th.SystemAdminClient.GetTermsOfServiceStats(context.Background(), second.Id)
+		require.NoError(t, err)
+		assert.Zero(t, stats.AcceptedUsers)
+		assert.Zero(t, stats.TargetedUsers)
+	})
+}
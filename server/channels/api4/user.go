@@ -75,16 +75,24 @@ func (api *API) InitUser() {
 	api.BaseRoutes.User.Handle("/sessions/revoke/all", api.APISessionRequired(revokeAllSessionsForUser)).Methods("POST")
 	api.BaseRoutes.Users.Handle("/sessions/revoke/all", api.APISessionRequired(revokeAllSessionsAllUsers)).Methods("POST")
 	api.BaseRoutes.Users.Handle("/sessions/device", api.APISessionRequired(attachDeviceId)).Methods("PUT")
+	api.BaseRoutes.Users.Handle("/sessions/web_push", api.APISessionRequired(attachWebPushSubscription)).Methods("PUT")
+	api.BaseRoutes.Users.Handle("/sessions/web_push", api.APISessionRequired(detachWebPushSubscription)).Methods("DELETE")
+	api.BaseRoutes.Users.Handle("/sessions/web_push/vapid_key", api.APISessionRequired(getWebPushVAPIDKey)).Methods("GET")
 	api.BaseRoutes.User.Handle("/audits", api.APISessionRequired(getUserAudits)).Methods("GET")
+	api.BaseRoutes.User.Handle("/notifications/history", api.APISessionRequired(getNotificationDeliveries)).Methods("GET")
+	api.BaseRoutes.User.Handle("/email/status", api.APISessionRequired(getUserEmailStatus)).Methods("GET")
+	api.BaseRoutes.User.Handle("/email/status", api.APISessionRequired(clearUserEmailUndeliverable)).Methods("DELETE")
 
 	api.BaseRoutes.User.Handle("/tokens", api.APISessionRequired(createUserAccessToken)).Methods("POST")
 	api.BaseRoutes.User.Handle("/tokens", api.APISessionRequired(getUserAccessTokensForUser)).Methods("GET")
 	api.BaseRoutes.Users.Handle("/tokens", api.APISessionRequired(getUserAccessTokens)).Methods("GET")
 	api.BaseRoutes.Users.Handle("/tokens/search", api.APISessionRequired(searchUserAccessTokens)).Methods("POST")
+	api.BaseRoutes.Users.Handle("/tokens/expiring", api.APISessionRequired(getExpiringUserAccessTokens)).Methods("GET")
 	api.BaseRoutes.Users.Handle("/tokens/{token_id:[A-Za-z0-9]+}", api.APISessionRequired(getUserAccessToken)).Methods("GET")
 	api.BaseRoutes.Users.Handle("/tokens/revoke", api.APISessionRequired(revokeUserAccessToken)).Methods("POST")
 	api.BaseRoutes.Users.Handle("/tokens/disable", api.APISessionRequired(disableUserAccessToken)).Methods("POST")
 	api.BaseRoutes.Users.Handle("/tokens/enable", api.APISessionRequired(enableUserAccessToken)).Methods("POST")
+	api.BaseRoutes.Users.Handle("/tokens/rotate", api.APISessionRequired(rotateUserAccessToken)).Methods("POST")
 
 	api.BaseRoutes.User.Handle("/typing", api.APISessionRequiredDisableWhenBusy(publishUserTyping)).Methods("POST")
 
@@ -222,6 +230,12 @@ func getUser(c *Context, w http.ResponseWriter, r *http.Request) {
 	} else {
 		c.App.SanitizeProfile(user, c.IsSystemAdmin())
 	}
+
+	if err := c.App.FillInCustomProfileAttributes([]*model.User{user}, c.AppContext.Session().UserId, c.IsSystemAdmin()); err != nil {
+		c.Err = err
+		return
+	}
+
 	c.App.Srv().Platform().UpdateLastActivityAtIfNeeded(*c.AppContext.Session())
 	w.Header().Set(model.HeaderEtagServer, etag)
 	if err := json.NewEncoder(w).Encode(user); err != nil {
@@ -661,6 +675,7 @@ func getUsers(c *Context, w http.ResponseWriter, r *http.Request) {
 		rolesString        = query.Get("roles")
 		channelRolesString = query.Get("channel_roles")
 		teamRolesString    = query.Get("team_roles")
+		customAttributes   = query["custom_profile_attribute"]
 	)
 
 	if notInChannelId != "" && inTeamId == "" {
@@ -770,6 +785,31 @@ func getUsers(c *Context, w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	// Custom profile attributes are filtered with repeated field_id:value pairs, and only when
+	// listing all the users or the users of a team.
+	var customProfileAttributes map[string]string
+	if len(customAttributes) > 0 {
+		if inChannelId != "" || notInChannelId != "" || notInTeamId != "" || withoutTeamBool || inGroupId != "" || notInGroupId != "" || sort != "" {
+			c.SetInvalidURLParam("custom_profile_attribute")
+			return
+		}
+
+		customProfileAttributes = make(map[string]string, len(customAttributes))
+		for _, attribute := range customAttributes {
+			fieldID, value, found := strings.Cut(attribute, ":")
+			if !found || !model.IsValidId(fieldID) || value == "" {
+				c.SetInvalidURLParam("custom_profile_attribute")
+				return
+			}
+			customProfileAttributes[fieldID] = value
+		}
+
+		if appErr := c.App.ValidateCustomProfileAttributesFilter(customProfileAttributes, c.IsSystemAdmin()); appErr != nil {
+			c.Err = appErr
+			return
+		}
+	}
+
 	restrictions, appErr := c.App.GetViewUsersRestrictions(c.AppContext, c.AppContext.Session().UserId)
 	if appErr != nil {
 		c.Err = appErr
@@ -777,24 +817,25 @@ func getUsers(c *Context, w http.ResponseWriter, r *http.Request) {
 	}
 
 	userGetOptions := &model.UserGetOptions{
-		InTeamId:         inTeamId,
-		InChannelId:      inChannelId,
-		NotInTeamId:      notInTeamId,
-		NotInChannelId:   notInChannelId,
-		InGroupId:        inGroupId,
-		NotInGroupId:     notInGroupId,
-		GroupConstrained: groupConstrainedBool,
-		WithoutTeam:      withoutTeamBool,
-		Inactive:         inactiveBool,
-		Active:           activeBool,
-		Role:             role,
-		Roles:            roles,
-		ChannelRoles:     channelRoles,
-		TeamRoles:        teamRoles,
-		Sort:             sort,
-		Page:             c.Params.Page,
-		PerPage:          c.Params.PerPage,
-		ViewRestrictions: restrictions,
+		InTeamId:                inTeamId,
+		InChannelId:             inChannelId,
+		NotInTeamId:             notInTeamId,
+		NotInChannelId:          notInChannelId,
+		InGroupId:               inGroupId,
+		NotInGroupId:            notInGroupId,
+		GroupConstrained:        groupConstrainedBool,
+		WithoutTeam:             withoutTeamBool,
+		Inactive:                inactiveBool,
+		Active:                  activeBool,
+		Role:                    role,
+		Roles:                   roles,
+		ChannelRoles:            channelRoles,
+		TeamRoles:               teamRoles,
+		CustomProfileAttributes: customProfileAttributes,
+		Sort:                    sort,
+		Page:                    c.Params.Page,
+		PerPage:                 c.Params.PerPage,
+		ViewRestrictions:        restrictions,
 	}
 
 	var (
@@ -837,7 +878,7 @@ func getUsers(c *Context, w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
-		etag = c.App.GetUsersNotInTeamEtag(inTeamId, restrictions.Hash())
+		etag = c.App.GetUsersNotInTeamEtag(notInTeamId, restrictions.Hash())
 		if c.HandleEtag(etag, "Get Users Not in Team", w, r) {
 			return
 		}
@@ -912,6 +953,15 @@ func getUsers(c *Context, w http.ResponseWriter, r *http.Request) {
 			c.Err = appErr
 			return
 		}
+
+		// The users visible through restrictions change with the memberships of the users, which the etag of
+		// the profiles doesn't cover.
+		if userGetOptions.ViewRestrictions == nil {
+			etag = c.App.GetUsersEtag("")
+			if c.HandleEtag(etag, "Get Users", w, r) {
+				return
+			}
+		}
 		profiles, appErr = c.App.GetUsersPage(userGetOptions, c.IsSystemAdmin())
 	}
 
@@ -920,6 +970,11 @@ func getUsers(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if appErr = c.App.FillInCustomProfileAttributes(profiles, c.AppContext.Session().UserId, c.IsSystemAdmin()); appErr != nil {
+		c.Err = appErr
+		return
+	}
+
 	if etag != "" {
 		w.Header().Set(model.HeaderEtagServer, etag)
 	}
@@ -1098,6 +1153,14 @@ func searchUsers(c *Context, w http.ResponseWriter, r *http.Request) {
 		TeamRoles:        props.TeamRoles,
 	}
 
+	if len(props.CustomProfileAttributes) > 0 {
+		if appErr := c.App.ValidateCustomProfileAttributesFilter(props.CustomProfileAttributes, c.IsSystemAdmin()); appErr != nil {
+			c.Err = appErr
+			return
+		}
+		options.CustomProfileAttributes = props.CustomProfileAttributes
+	}
+
 	if c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem) {
 		options.AllowEmails = true
 		options.AllowFullNames = true
@@ -1118,6 +1181,11 @@ func searchUsers(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if appErr = c.App.FillInCustomProfileAttributes(profiles, c.AppContext.Session().UserId, c.IsSystemAdmin()); appErr != nil {
+		c.Err = appErr
+		return
+	}
+
 	js, err := json.Marshal(profiles)
 	if err != nil {
 		c.Err = model.NewAppError("searchUsers", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
@@ -2262,6 +2330,50 @@ func attachDeviceId(c *Context, w http.ResponseWriter, r *http.Request) {
 	ReturnStatusOK(w)
 }
 
+func attachWebPushSubscription(c *Context, w http.ResponseWriter, r *http.Request) {
+	var subscription model.WebPushSubscription
+	if err := json.NewDecoder(r.Body).Decode(&subscription); err != nil {
+		c.SetInvalidParamWithErr("subscription", err)
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("attachWebPushSubscription", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+
+	if err := c.App.AttachWebPushSubscription(c.AppContext, c.AppContext.Session(), &subscription); err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	ReturnStatusOK(w)
+}
+
+func detachWebPushSubscription(c *Context, w http.ResponseWriter, r *http.Request) {
+	auditRec := c.MakeAuditRecord("detachWebPushSubscription", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+
+	if err := c.App.DetachWebPushSubscription(c.AppContext, c.AppContext.Session()); err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	ReturnStatusOK(w)
+}
+
+func getWebPushVAPIDKey(c *Context, w http.ResponseWriter, r *http.Request) {
+	key, err := c.App.GetWebPushVAPIDKey()
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(key); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
 func getUserAudits(c *Context, w http.ResponseWriter, r *http.Request) {
 	c.RequireUserId()
 	if c.Err != nil {
@@ -2520,6 +2632,33 @@ func getUserAccessTokens(c *Context, w http.ResponseWriter, r *http.Request) {
 	w.Write(js)
 }
 
+func getExpiringUserAccessTokens(c *Context, w http.ResponseWriter, r *http.Request) {
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem) {
+		c.SetPermissionError(model.PermissionManageSystem)
+		return
+	}
+
+	withinDays := model.UserAccessTokenExpiringDefaultDays
+	if withinDaysStr := r.URL.Query().Get("within_days"); withinDaysStr != "" {
+		var err error
+		withinDays, err = strconv.Atoi(withinDaysStr)
+		if err != nil || withinDays < 0 {
+			c.SetInvalidParamWithErr("within_days", err)
+			return
+		}
+	}
+
+	accessTokens, appErr := c.App.GetExpiringUserAccessTokens(withinDays, c.Params.Page, c.Params.PerPage)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(accessTokens); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
 func getUserAccessTokensForUser(c *Context, w http.ResponseWriter, r *http.Request) {
 	c.RequireUserId()
 	if c.Err != nil {
@@ -2889,6 +3028,93 @@ func publishUserTyping(c *Context, w http.ResponseWriter, r *http.Request) {
 	ReturnStatusOK(w)
 }
 
+func getNotificationDeliveries(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionToUser(*c.AppContext.Session(), c.Params.UserId) {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+		return
+	}
+
+	opts := model.NotificationDeliveryGetOptions{
+		PostId:  r.URL.Query().Get("post_id"),
+		Page:    c.Params.Page,
+		PerPage: c.Params.PerPage,
+	}
+	if opts.PostId != "" && !model.IsValidId(opts.PostId) {
+		c.SetInvalidURLParam("post_id")
+		return
+	}
+	if sinceString := r.URL.Query().Get("since"); sinceString != "" {
+		since, err := strconv.ParseInt(sinceString, 10, 64)
+		if err != nil {
+			c.SetInvalidParamWithErr("since", err)
+			return
+		}
+		opts.Since = since
+	}
+
+	deliveries, appErr := c.App.GetNotificationDeliveries(c.Params.UserId, opts)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(deliveries); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func getUserEmailStatus(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionSysconsoleReadUserManagementUsers) {
+		c.SetPermissionError(model.PermissionSysconsoleReadUserManagementUsers)
+		return
+	}
+
+	status, appErr := c.App.GetUserEmailStatus(c.Params.UserId)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(status); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func clearUserEmailUndeliverable(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("clearUserEmailUndeliverable", audit.Fail)
+	audit.AddEventParameter(auditRec, "user_id", c.Params.UserId)
+	defer c.LogAuditRec(auditRec)
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionSysconsoleWriteUserManagementUsers) {
+		c.SetPermissionError(model.PermissionSysconsoleWriteUserManagementUsers)
+		return
+	}
+
+	if appErr := c.App.ClearUserEmailUndeliverable(c.Params.UserId); appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.Success()
+
+	ReturnStatusOK(w)
+}
+
 func verifyUserEmailWithoutToken(c *Context, w http.ResponseWriter, r *http.Request) {
 	c.RequireUserId()
 	if c.Err != nil {
@@ -3426,3 +3652,73 @@ func getUsersWithInvalidEmails(c *Context, w http.ResponseWriter, r *http.Reques
 		c.Logger.Warn("Error writing response", mlog.Err(err))
 	}
 }
+
+func rotateUserAccessToken(c *Context, w http.ResponseWriter, r *http.Request) {
+	var props struct {
+		TokenId   string `json:"token_id"`
+		ExpiresAt int64  `json:"expires_at"`
+	}
+	if jsonErr := json.NewDecoder(r.Body).Decode(&props); jsonErr != nil {
+		c.SetInvalidParamWithErr("token_id", jsonErr)
+		return
+	}
+
+	if !model.IsValidId(props.TokenId) {
+		c.SetInvalidParam("token_id")
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("rotateUserAccessToken", audit.Fail)
+	audit.AddEventParameter(auditRec, "token_id", props.TokenId)
+	audit.AddEventParameter(auditRec, "expires_at", props.ExpiresAt)
+	defer c.LogAuditRec(auditRec)
+	c.LogAudit("")
+
+	if c.AppContext.Session().IsOAuth {
+		c.SetPermissionError(model.PermissionCreateUserAccessToken)
+		c.Err.DetailedError += ", attempted access by oauth app"
+		return
+	}
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionCreateUserAccessToken) {
+		c.SetPermissionError(model.PermissionCreateUserAccessToken)
+		return
+	}
+
+	accessToken, err := c.App.GetUserAccessToken(props.TokenId, false)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	user, err := c.App.GetUser(accessToken.UserId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+	audit.AddEventParameterAuditable(auditRec, "user", user)
+
+	if !c.App.SessionHasPermissionToUserOrBot(c.AppContext, *c.AppContext.Session(), accessToken.UserId) {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+		return
+	}
+
+	if user.IsSystemAdmin() && !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem) {
+		c.SetPermissionError(model.PermissionManageSystem)
+		return
+	}
+
+	token, err := c.App.RotateUserAccessToken(c.AppContext, accessToken, props.ExpiresAt)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddMeta("new_token_id", token.Id)
+	c.LogAudit("success - token_id=" + accessToken.Id + " new_token_id=" + token.Id)
+
+	if err := json.NewEncoder(w).Encode(token); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/v8/channels/audit"
+)
+
+func (api *API) InitUserManager() {
+	api.BaseRoutes.User.Handle("/manager", api.APISessionRequired(setUserManager)).Methods("PUT")
+	api.BaseRoutes.User.Handle("/reporting_chain", api.APISessionRequired(getUserReportingChain)).Methods("GET")
+	api.BaseRoutes.User.Handle("/direct_reports", api.APISessionRequired(getUserDirectReports)).Methods("GET")
+}
+
+func setUserManager(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	props := model.MapFromJSON(r.Body)
+	managerID, ok := props["manager_id"]
+	if !ok || (managerID != "" && !model.IsValidId(managerID)) {
+		c.SetInvalidParam("manager_id")
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("setUserManager", audit.Fail)
+	audit.AddEventParameter(auditRec, "user_id", c.Params.UserId)
+	audit.AddEventParameter(auditRec, "manager_id", managerID)
+	defer c.LogAuditRec(auditRec)
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionEditOtherUsers) {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+		return
+	}
+
+	user, err := c.App.SetUserManager(c.AppContext, c.Params.UserId, managerID)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddEventResultState(user)
+	auditRec.AddEventObjectType("user")
+
+	c.App.SanitizeProfile(user, c.IsSystemAdmin())
+	if err := json.NewEncoder(w).Encode(user); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func getUserReportingChain(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	canSee, err := c.App.UserCanSeeOtherUser(c.AppContext, c.AppContext.Session().UserId, c.Params.UserId)
+	if err != nil || !canSee {
+		c.SetPermissionError(model.PermissionViewMembers)
+		return
+	}
+
+	users, err := c.App.GetReportingChain(c.Params.UserId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	writeManagerUsers(c, w, users)
+}
+
+func getUserDirectReports(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	canSee, err := c.App.UserCanSeeOtherUser(c.AppContext, c.AppContext.Session().UserId, c.Params.UserId)
+	if err != nil || !canSee {
+		c.SetPermissionError(model.PermissionViewMembers)
+		return
+	}
+
+	users, err := c.App.GetDirectReports(c.Params.UserId, c.Params.Page, c.Params.PerPage)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	writeManagerUsers(c, w, users)
+}
+
+// writeManagerUsers writes the managers or reports of a user, leaving out those the session isn't
+// allowed to see.
+func writeManagerUsers(c *Context, w http.ResponseWriter, users []*model.User) {
+	visible := make([]*model.User, 0, len(users))
+	for _, user := range users {
+		canSee, err := c.App.UserCanSeeOtherUser(c.AppContext, c.AppContext.Session().UserId, user.Id)
+		if err != nil {
+			c.Err = err
+			return
+		}
+		if !canSee {
+			continue
+		}
+
+		c.App.SanitizeProfile(user, c.IsSystemAdmin())
+		visible = append(visible, user)
+	}
+
+	if err := json.NewEncoder(w).Encode(visible); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
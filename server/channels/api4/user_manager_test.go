@@ -0,0 +1,103 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package api4
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+func TestUserManager(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	manager := th.CreateUser()
+	th.LinkUserToTeam(manager, th.BasicTeam)
+
+	t.Run("users can't set a manager", func(t *testing.T) {
+		_, resp, err := th.Client.SetUserManager(context.Background(), th.BasicUser.Id, manager.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("admins set a manager", func(t *testing.T) {
+		user, _, err := th.SystemAdminClient.SetUserManager(context.Background(), th.BasicUser.Id, manager.Id)
+		require.NoError(t, err)
+		assert.Equal(t, manager.Id, user.ManagerId)
+
+		_, _, err = th.SystemAdminClient.SetUserManager(context.Background(), th.BasicUser2.Id, manager.Id)
+		require.NoError(t, err)
+
+		user, _, err = th.Client.GetUser(context.Background(), th.BasicUser.Id, "")
+		require.NoError(t, err)
+		assert.Equal(t, manager.Id, user.ManagerId)
+	})
+
+	t.Run("invalid manager", func(t *testing.T) {
+		_, resp, err := th.SystemAdminClient.SetUserManager(context.Background(), th.BasicUser.Id, "junk")
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+
+		_, resp, err = th.SystemAdminClient.SetUserManager(context.Background(), manager.Id, th.BasicUser.Id)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("reporting chain", func(t *testing.T) {
+		chain, _, err := th.Client.GetUserReportingChain(context.Background(), th.BasicUser.Id)
+		require.NoError(t, err)
+		require.Len(t, chain, 1)
+		assert.Equal(t, manager.Id, chain[0].Id)
+	})
+
+	t.Run("direct reports", func(t *testing.T) {
+		reports, _, err := th.Client.GetUserDirectReports(context.Background(), manager.Id, 0, 100)
+		require.NoError(t, err)
+		require.Len(t, reports, 2)
+
+		reports, _, err = th.Client.GetUserDirectReports(context.Background(), manager.Id, 1, 1)
+		require.NoError(t, err)
+		require.Len(t, reports, 1)
+	})
+
+	t.Run("clear the manager", func(t *testing.T) {
+		user, _, err := th.SystemAdminClient.SetUserManager(context.Background(), th.BasicUser.Id, "")
+		require.NoError(t, err)
+		assert.Empty(t, user.ManagerId)
+
+		reports, _, err := th.Client.GetUserDirectReports(context.Background(), manager.Id, 0, 100)
+		require.NoError(t, err)
+		require.Len(t, reports, 1)
+		assert.Equal(t, th.BasicUser2.Id, reports[0].Id)
+	})
+
+	t.Run("users that can't be seen are left out", func(t *testing.T) {
+		boss := th.CreateUser()
+		stranger := th.CreateUser()
+		_, _, err := th.SystemAdminClient.SetUserManager(context.Background(), manager.Id, boss.Id)
+		require.NoError(t, err)
+		_, _, err = th.SystemAdminClient.SetUserManager(context.Background(), stranger.Id, manager.Id)
+		require.NoError(t, err)
+
+		defaultRolePermissions := th.SaveDefaultRolePermissions()
+		defer th.RestoreDefaultRolePermissions(defaultRolePermissions)
+		th.RemovePermissionFromRole(model.PermissionViewMembers.Id, model.SystemUserRoleId)
+		th.RemovePermissionFromRole(model.PermissionViewMembers.Id, model.TeamUserRoleId)
+
+		chain, _, err := th.Client.GetUserReportingChain(context.Background(), th.BasicUser2.Id)
+		require.NoError(t, err)
+		require.Len(t, chain, 1)
+		assert.Equal(t, manager.Id, chain[0].Id)
+
+		reports, _, err := th.Client.GetUserDirectReports(context.Background(), manager.Id, 0, 100)
+		require.NoError(t, err)
+		require.Len(t, reports, 1)
+		assert.Equal(t, th.BasicUser2.Id, reports[0].Id)
+	})
+}
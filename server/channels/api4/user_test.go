@@ -5,6 +5,7 @@ package api4
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -3660,6 +3661,65 @@ func TestAttachDeviceId(t *testing.T) {
 	})
 }
 
+func TestWebPushSubscription(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	subscription := &model.WebPushSubscription{
+		Endpoint: "https://push.example.com/send/abc",
+		Keys: model.WebPushSubscriptionKeys{
+			P256dh: base64.RawURLEncoding.EncodeToString(append([]byte{0x04}, make([]byte, 64)...)),
+			Auth:   base64.RawURLEncoding.EncodeToString(make([]byte, 16)),
+		},
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.EmailSettings.EnableWebPushNotifications = false })
+
+		_, resp, err := th.Client.GetWebPushVAPIDKey(context.Background())
+		require.Error(t, err)
+		CheckNotImplementedStatus(t, resp)
+
+		resp, err = th.Client.AttachWebPushSubscription(context.Background(), subscription)
+		require.Error(t, err)
+		CheckNotImplementedStatus(t, resp)
+	})
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.EmailSettings.EnableWebPushNotifications = true })
+
+	t.Run("vapid key", func(t *testing.T) {
+		key, _, err := th.Client.GetWebPushVAPIDKey(context.Background())
+		require.NoError(t, err)
+		decoded, err := base64.RawURLEncoding.DecodeString(key.PublicKey)
+		require.NoError(t, err)
+		assert.Len(t, decoded, 65)
+	})
+
+	t.Run("invalid subscription", func(t *testing.T) {
+		invalid := *subscription
+		invalid.Endpoint = "http://push.example.com/send/abc"
+		resp, err := th.Client.AttachWebPushSubscription(context.Background(), &invalid)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("attach and detach", func(t *testing.T) {
+		_, err := th.Client.AttachWebPushSubscription(context.Background(), subscription)
+		require.NoError(t, err)
+
+		session, appErr := th.App.GetSession(th.Client.AuthToken)
+		require.Nil(t, appErr)
+		assert.Equal(t, subscription, model.WebPushSubscriptionFromSession(session))
+
+		_, err = th.Client.DetachWebPushSubscription(context.Background())
+		require.NoError(t, err)
+
+		session, appErr = th.App.GetSession(th.Client.AuthToken)
+		require.Nil(t, appErr)
+		assert.Nil(t, model.WebPushSubscriptionFromSession(session))
+	})
+}
+
 func TestGetUserAudits(t *testing.T) {
 	th := Setup(t).InitBasic()
 	defer th.TearDown()
@@ -3684,6 +3744,60 @@ func TestGetUserAudits(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestGetNotificationDeliveries(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+	user := th.BasicUser
+
+	_, resp, err := th.Client.GetNotificationDeliveries(context.Background(), user.Id, model.NotificationDeliveryGetOptions{})
+	require.Error(t, err)
+	CheckNotImplementedStatus(t, resp)
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.NotificationLogSettings.EnableDeliveryHistory = true })
+
+	postID := model.NewId()
+	for _, delivery := range []*model.NotificationDelivery{
+		{UserId: user.Id, PostId: postID, ChannelId: th.BasicChannel.Id, Type: model.NotificationTypeEmail, Status: model.NotificationStatusSuccess},
+		{UserId: user.Id, PostId: postID, ChannelId: th.BasicChannel.Id, Type: model.NotificationTypePush, Status: model.NotificationStatusNotSent, Reason: model.NotificationReasonUserIsActive},
+		{UserId: user.Id, PostId: model.NewId(), ChannelId: th.BasicChannel.Id, Type: model.NotificationTypePush, Status: model.NotificationStatusSuccess},
+		{UserId: th.BasicUser2.Id, PostId: postID, ChannelId: th.BasicChannel.Id, Type: model.NotificationTypeEmail, Status: model.NotificationStatusSuccess},
+	} {
+		_, err = th.App.Srv().Store().NotificationDelivery().Save(delivery)
+		require.NoError(t, err)
+	}
+
+	t.Run("own history", func(t *testing.T) {
+		deliveries, _, err := th.Client.GetNotificationDeliveries(context.Background(), user.Id, model.NotificationDeliveryGetOptions{PerPage: 10})
+		require.NoError(t, err)
+		require.Len(t, deliveries, 3)
+		for _, delivery := range deliveries {
+			assert.Equal(t, user.Id, delivery.UserId)
+		}
+	})
+
+	t.Run("post", func(t *testing.T) {
+		deliveries, _, err := th.Client.GetNotificationDeliveries(context.Background(), user.Id, model.NotificationDeliveryGetOptions{PostId: postID, PerPage: 10})
+		require.NoError(t, err)
+		require.Len(t, deliveries, 2)
+	})
+
+	t.Run("invalid post id", func(t *testing.T) {
+		_, resp, err := th.Client.GetNotificationDeliveries(context.Background(), user.Id, model.NotificationDeliveryGetOptions{PostId: "invalid"})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("other user", func(t *testing.T) {
+		_, resp, err := th.Client.GetNotificationDeliveries(context.Background(), th.BasicUser2.Id, model.NotificationDeliveryGetOptions{})
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		deliveries, _, err := th.SystemAdminClient.GetNotificationDeliveries(context.Background(), th.BasicUser2.Id, model.NotificationDeliveryGetOptions{})
+		require.NoError(t, err)
+		require.Len(t, deliveries, 1)
+	})
+}
+
 func TestVerifyUserEmail(t *testing.T) {
 	th := Setup(t)
 	defer th.TearDown()
@@ -5481,6 +5595,91 @@ func TestUserAccessTokenDisableConfigBotsExcluded(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestRotateUserAccessToken(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.ServiceSettings.EnableBotAccountCreation = true
+		*cfg.ServiceSettings.UserAccessTokenGracePeriodMinutes = 0
+	})
+
+	bot, _, err := th.SystemAdminClient.CreateBot(context.Background(), &model.Bot{
+		Username:    GenerateTestUsername(),
+		DisplayName: "a bot",
+	})
+	require.NoError(t, err)
+
+	token, _, err := th.SystemAdminClient.CreateUserAccessToken(context.Background(), bot.UserId, "deploy token")
+	require.NoError(t, err)
+	assertToken(t, th, token, bot.UserId)
+
+	t.Run("rotate without permission", func(t *testing.T) {
+		_, resp, err := th.Client.RotateUserAccessToken(context.Background(), token.Id, 0)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("rotate with an expiry in the past", func(t *testing.T) {
+		_, resp, err := th.SystemAdminClient.RotateUserAccessToken(context.Background(), token.Id, model.GetMillis()-1000)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+		assertToken(t, th, token, bot.UserId)
+	})
+
+	t.Run("rotate", func(t *testing.T) {
+		expiresAt := model.GetMillis() + 24*60*60*1000
+		newToken, _, err := th.SystemAdminClient.RotateUserAccessToken(context.Background(), token.Id, expiresAt)
+		require.NoError(t, err)
+		require.NotEqual(t, token.Id, newToken.Id)
+		assert.Equal(t, "deploy token", newToken.Description)
+		assert.Equal(t, expiresAt, newToken.ExpiresAt)
+
+		assertToken(t, th, newToken, bot.UserId)
+		// Without a grace period, the rotated token expires right away.
+		assertInvalidToken(t, th, token)
+
+		tokens, _, err := th.SystemAdminClient.GetExpiringUserAccessTokens(context.Background(), 7, 0, 100)
+		require.NoError(t, err)
+		var ids []string
+		for _, expiring := range tokens {
+			assert.Empty(t, expiring.Token)
+			ids = append(ids, expiring.Id)
+		}
+		assert.Contains(t, ids, token.Id)
+		assert.Contains(t, ids, newToken.Id)
+
+		_, resp, err := th.SystemAdminClient.RotateUserAccessToken(context.Background(), token.Id, 0)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("grace period", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.UserAccessTokenGracePeriodMinutes = 60 })
+
+		token, _, err := th.SystemAdminClient.CreateUserAccessToken(context.Background(), bot.UserId, "grace token")
+		require.NoError(t, err)
+		assertToken(t, th, token, bot.UserId)
+
+		newToken, _, err := th.SystemAdminClient.RotateUserAccessToken(context.Background(), token.Id, 0)
+		require.NoError(t, err)
+		assert.Zero(t, newToken.ExpiresAt)
+
+		assertToken(t, th, token, bot.UserId)
+		assertToken(t, th, newToken, bot.UserId)
+
+		rotated, _, err := th.SystemAdminClient.GetUserAccessToken(context.Background(), token.Id)
+		require.NoError(t, err)
+		assert.InDelta(t, model.GetMillis()+60*60*1000, rotated.ExpiresAt, float64(60*1000))
+	})
+
+	t.Run("expiring tokens require manage_system", func(t *testing.T) {
+		_, resp, err := th.Client.GetExpiringUserAccessTokens(context.Background(), 7, 0, 100)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+}
+
 func TestGetUsersByStatus(t *testing.T) {
 	th := Setup(t)
 	defer th.TearDown()
@@ -25,6 +25,9 @@ func (api *API) InitWebhook() {
 	api.BaseRoutes.OutgoingHook.Handle("", api.APISessionRequired(updateOutgoingHook)).Methods("PUT")
 	api.BaseRoutes.OutgoingHook.Handle("", api.APISessionRequired(deleteOutgoingHook)).Methods("DELETE")
 	api.BaseRoutes.OutgoingHook.Handle("/regen_token", api.APISessionRequired(regenOutgoingHookToken)).Methods("POST")
+	api.BaseRoutes.OutgoingHook.Handle("/regen_signing_secret", api.APISessionRequired(regenOutgoingHookSigningSecret)).Methods("POST")
+	api.BaseRoutes.OutgoingHook.Handle("/deliveries", api.APISessionRequired(getOutgoingHookDeliveries)).Methods("GET")
+	api.BaseRoutes.OutgoingHook.Handle("/deliveries/{delivery_id:[A-Za-z0-9]+}/replay", api.APISessionRequired(replayOutgoingHookDelivery)).Methods("POST")
 }
 
 func createIncomingHook(c *Context, w http.ResponseWriter, r *http.Request) {
@@ -556,6 +559,80 @@ func getOutgoingHook(c *Context, w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// getManageableOutgoingHook returns the outgoing webhook of the request if the session is
+// allowed to manage it.
+func getManageableOutgoingHook(c *Context) *model.OutgoingWebhook {
+	hook, err := c.App.GetOutgoingWebhook(c.Params.HookId)
+	if err != nil {
+		c.Err = err
+		return nil
+	}
+
+	if !c.App.SessionHasPermissionToTeam(*c.AppContext.Session(), hook.TeamId, model.PermissionManageOutgoingWebhooks) {
+		c.SetPermissionError(model.PermissionManageOutgoingWebhooks)
+		return nil
+	}
+
+	if c.AppContext.Session().UserId != hook.CreatorId && !c.App.SessionHasPermissionToTeam(*c.AppContext.Session(), hook.TeamId, model.PermissionManageOthersOutgoingWebhooks) {
+		c.SetPermissionError(model.PermissionManageOthersOutgoingWebhooks)
+		return nil
+	}
+
+	return hook
+}
+
+func getOutgoingHookDeliveries(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireHookId()
+	if c.Err != nil {
+		return
+	}
+
+	hook := getManageableOutgoingHook(c)
+	if c.Err != nil {
+		return
+	}
+
+	deliveries, appErr := c.App.GetIntegrationDeliveries(hook.Id, c.Params.Page, c.Params.PerPage)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(deliveries); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func replayOutgoingHookDelivery(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireHookId().RequireDeliveryId()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("replayOutgoingHookDelivery", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameter(auditRec, "hook_id", c.Params.HookId)
+	audit.AddEventParameter(auditRec, "delivery_id", c.Params.DeliveryId)
+
+	hook := getManageableOutgoingHook(c)
+	if c.Err != nil {
+		return
+	}
+
+	delivery, appErr := c.App.ReplayOutgoingWebhookDelivery(c.AppContext, hook, c.Params.DeliveryId)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddMeta("replay_id", delivery.Id)
+
+	if err := json.NewEncoder(w).Encode(delivery); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
 func regenOutgoingHookToken(c *Context, w http.ResponseWriter, r *http.Request) {
 	c.RequireHookId()
 	if c.Err != nil {
@@ -603,6 +680,53 @@ func regenOutgoingHookToken(c *Context, w http.ResponseWriter, r *http.Request)
 	}
 }
 
+func regenOutgoingHookSigningSecret(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireHookId()
+	if c.Err != nil {
+		return
+	}
+
+	hook, err := c.App.GetOutgoingWebhook(c.Params.HookId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("regenOutgoingHookSigningSecret", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	auditRec.AddMeta("hook_id", hook.Id)
+	auditRec.AddMeta("hook_display", hook.DisplayName)
+	auditRec.AddMeta("channel_id", hook.ChannelId)
+	auditRec.AddMeta("team_id", hook.TeamId)
+	c.LogAudit("attempt")
+
+	if !c.App.SessionHasPermissionToTeam(*c.AppContext.Session(), hook.TeamId, model.PermissionManageOutgoingWebhooks) {
+		c.SetPermissionError(model.PermissionManageOutgoingWebhooks)
+		return
+	}
+
+	if c.AppContext.Session().UserId != hook.CreatorId && !c.App.SessionHasPermissionToTeam(*c.AppContext.Session(), hook.TeamId, model.PermissionManageOthersOutgoingWebhooks) {
+		c.LogAudit("fail - inappropriate permissions")
+		c.SetPermissionError(model.PermissionManageOthersOutgoingWebhooks)
+		return
+	}
+
+	rhook, err := c.App.RegenOutgoingWebhookSigningSecret(hook)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.AddEventResultState(rhook)
+	auditRec.AddEventObjectType("outgoing_webhook")
+	auditRec.Success()
+	c.LogAudit("success")
+
+	if err := json.NewEncoder(w).Encode(rhook); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
 func deleteOutgoingHook(c *Context, w http.ResponseWriter, r *http.Request) {
 	c.RequireHookId()
 	if c.Err != nil {
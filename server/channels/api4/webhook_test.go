@@ -5,7 +5,12 @@ package api4
 
 import (
 	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -994,6 +999,45 @@ func TestRegenOutgoingHookToken(t *testing.T) {
 	CheckNotImplementedStatus(t, resp)
 }
 
+func TestRegenOutgoingHookSigningSecret(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+	client := th.Client
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableOutgoingWebhooks = true })
+
+	hook := &model.OutgoingWebhook{ChannelId: th.BasicChannel.Id, TeamId: th.BasicChannel.TeamId, CallbackURLs: []string{"http://nowhere.com"}}
+	rhook, _, err := th.SystemAdminClient.CreateOutgoingWebhook(context.Background(), hook)
+	require.NoError(t, err)
+	require.NotEmpty(t, rhook.SigningSecret)
+
+	_, resp, err := th.SystemAdminClient.RegenOutgoingHookSigningSecret(context.Background(), "junk")
+	require.Error(t, err)
+	CheckBadRequestStatus(t, resp)
+
+	regenHook, _, err := th.SystemAdminClient.RegenOutgoingHookSigningSecret(context.Background(), rhook.Id)
+	require.NoError(t, err)
+	require.NotEmpty(t, regenHook.SigningSecret)
+	require.NotEqual(t, rhook.SigningSecret, regenHook.SigningSecret)
+	require.Equal(t, rhook.Token, regenHook.Token, "token should be unchanged")
+
+	// Updating the webhook without the secret shouldn't clear it
+	regenHook.SigningSecret = ""
+	regenHook.DisplayName = "Signed"
+	updatedHook, _, err := th.SystemAdminClient.UpdateOutgoingWebhook(context.Background(), regenHook)
+	require.NoError(t, err)
+	require.NotEmpty(t, updatedHook.SigningSecret)
+
+	_, resp, err = client.RegenOutgoingHookSigningSecret(context.Background(), rhook.Id)
+	require.Error(t, err)
+	CheckForbiddenStatus(t, resp)
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableOutgoingWebhooks = false })
+	_, resp, err = th.SystemAdminClient.RegenOutgoingHookSigningSecret(context.Background(), rhook.Id)
+	require.Error(t, err)
+	CheckNotImplementedStatus(t, resp)
+}
+
 func TestUpdateOutgoingHook(t *testing.T) {
 	th := Setup(t).InitBasic()
 	defer th.TearDown()
@@ -1277,3 +1321,117 @@ func TestDeleteOutgoingHook(t *testing.T) {
 		CheckForbiddenStatus(t, resp)
 	})
 }
+
+func TestOutgoingHookDeliveries(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.ServiceSettings.EnableOutgoingWebhooks = true
+		*cfg.ServiceSettings.AllowedUntrustedInternalConnections = "127.0.0.0/8"
+	})
+
+	var mut sync.Mutex
+	var tokens []string
+	fail := true
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload model.OutgoingWebhookPayload
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+
+		mut.Lock()
+		defer mut.Unlock()
+		tokens = append(tokens, payload.Token)
+		if fail {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte("something went wrong"))
+			return
+		}
+		w.Write([]byte(`{"text": "replayed"}`))
+	}))
+	defer ts.Close()
+
+	hook, _, err := th.SystemAdminClient.CreateOutgoingWebhook(context.Background(), &model.OutgoingWebhook{
+		ChannelId:    th.BasicChannel.Id,
+		TeamId:       th.BasicTeam.Id,
+		CallbackURLs: []string{ts.URL},
+		ContentType:  "application/json",
+		TriggerWords: []string{"deliveries"},
+	})
+	require.NoError(t, err)
+
+	t.Run("disabled", func(t *testing.T) {
+		_, resp, err := th.SystemAdminClient.GetOutgoingWebhookDeliveries(context.Background(), hook.Id, 0, 10)
+		require.Error(t, err)
+		CheckNotImplementedStatus(t, resp)
+	})
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableIntegrationDeliveryLog = true })
+
+	_, _, err = th.Client.CreatePost(context.Background(), &model.Post{ChannelId: th.BasicChannel.Id, Message: "deliveries please"})
+	require.NoError(t, err)
+
+	var deliveries []*model.IntegrationDelivery
+	require.Eventually(t, func() bool {
+		deliveries, _, err = th.SystemAdminClient.GetOutgoingWebhookDeliveries(context.Background(), hook.Id, 0, 10)
+		return err == nil && len(deliveries) == 1
+	}, 5*time.Second, 100*time.Millisecond)
+
+	failed := deliveries[0]
+	assert.Equal(t, model.IntegrationDeliveryTypeOutgoingWebhook, failed.Type)
+	assert.Equal(t, ts.URL, failed.URL)
+	assert.Equal(t, http.StatusInternalServerError, failed.StatusCode)
+	assert.Equal(t, "something went wrong", failed.Response)
+	assert.Equal(t, th.BasicUser.Id, failed.UserId)
+	assert.Equal(t, th.BasicChannel.Id, failed.ChannelId)
+	assert.Contains(t, failed.Payload, "deliveries please")
+
+	t.Run("requires permission", func(t *testing.T) {
+		_, resp, err := th.Client.GetOutgoingWebhookDeliveries(context.Background(), hook.Id, 0, 10)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = th.Client.ReplayOutgoingWebhookDelivery(context.Background(), hook.Id, failed.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("replay with the current token", func(t *testing.T) {
+		hook, _, err = th.SystemAdminClient.RegenOutgoingHookToken(context.Background(), hook.Id)
+		require.NoError(t, err)
+
+		mut.Lock()
+		fail = false
+		mut.Unlock()
+
+		replayed, _, err := th.SystemAdminClient.ReplayOutgoingWebhookDelivery(context.Background(), hook.Id, failed.Id)
+		require.NoError(t, err)
+		assert.Equal(t, failed.Id, replayed.ReplayOf)
+		assert.Equal(t, http.StatusOK, replayed.StatusCode)
+		assert.True(t, replayed.IsSuccess())
+
+		mut.Lock()
+		assert.Equal(t, hook.Token, tokens[len(tokens)-1])
+		mut.Unlock()
+
+		deliveries, _, err := th.SystemAdminClient.GetOutgoingWebhookDeliveries(context.Background(), hook.Id, 0, 10)
+		require.NoError(t, err)
+		require.Len(t, deliveries, 2)
+		assert.Equal(t, replayed.Id, deliveries[0].Id)
+	})
+
+	t.Run("unknown delivery", func(t *testing.T) {
+		_, resp, err := th.SystemAdminClient.ReplayOutgoingWebhookDelivery(context.Background(), hook.Id, model.NewId())
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+	})
+
+	t.Run("callback url removed", func(t *testing.T) {
+		hook.CallbackURLs = []string{"http://nowhere.com"}
+		hook, _, err = th.SystemAdminClient.UpdateOutgoingWebhook(context.Background(), hook)
+		require.NoError(t, err)
+
+		_, resp, err := th.SystemAdminClient.ReplayOutgoingWebhookDelivery(context.Background(), hook.Id, failed.Id)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+}
@@ -26,6 +26,11 @@ func (api *API) InitWebSocket() {
 }
 
 func connectWebSocket(c *Context, w http.ResponseWriter, r *http.Request) {
+	if c.App.Srv().Platform().IsDraining() {
+		c.Err = model.NewAppError("connect", "api.web_socket.connect.draining.app_error", nil, "", http.StatusServiceUnavailable)
+		return
+	}
+
 	upgrader := websocket.Upgrader{
 		ReadBufferSize:  model.SocketMaxMessageSizeKb,
 		WriteBufferSize: model.SocketMaxMessageSizeKb,
@@ -33,6 +33,7 @@ import (
 	"github.com/mattermost/mattermost/server/v8/platform/services/remotecluster"
 	"github.com/mattermost/mattermost/server/v8/platform/services/searchengine"
 	"github.com/mattermost/mattermost/server/v8/platform/shared/filestore"
+	"github.com/mattermost/mattermost/server/v8/platform/shared/mail"
 )
 
 // AppIface is extracted from App struct and contains all it's exported methods. It's provided to allow partial interface passing and app layers creation.
@@ -54,10 +55,24 @@ type AppIface interface {
 	AddPublicKey(name string, key io.Reader) *model.AppError
 	// AddUserToChannel adds a user to a given channel.
 	AddUserToChannel(c request.CTX, user *model.User, channel *model.Channel, skipTeamMemberIntegrityCheck bool) (*model.ChannelMember, *model.AppError)
+	// AttachWebPushSubscription stores the Web Push subscription of the browser of the session,
+	// replacing any previous one.
+	AttachWebPushSubscription(c request.CTX, session *model.Session, subscription *model.WebPushSubscription) *model.AppError
+	// BulkImportWithOpts imports a JSONL file like BulkImportWithPath. If a job is given, the
+	// progress of the import and the line to resume it from are recorded in the job data as
+	// the lines get imported.
+	BulkImportWithOpts(c request.CTX, jsonlReader io.Reader, attachmentsReader *zip.Reader, job *model.Job, opts model.BulkImportOpts) (*model.AppError, int)
 	// Caller must close the first return value
 	ExportFileReader(path string) (filestore.ReadCloseSeeker, *model.AppError)
 	// Caller must close the first return value
 	FileReader(path string) (filestore.ReadCloseSeeker, *model.AppError)
+	// CancelDataSeed stops the ongoing generation of synthetic data. The data generated so far is
+	// kept.
+	CancelDataSeed() *model.AppError
+	// CaptureProfile captures a runtime profile of this server and stores it in the file backend.
+	// The CPU profiles and the execution traces are captured over the requested duration, and only
+	// one of each can be captured at a time.
+	CaptureProfile(rctx request.CTX, profileRequest *model.ProfileRequest) (*model.Profile, *model.AppError)
 	// ChannelMembersMinusGroupMembers returns the set of users in the given channel minus the set of users in the given
 	// groups.
 	//
@@ -71,12 +86,20 @@ type AppIface interface {
 	// If includeRemovedMembers is true, then channel members who left or were removed from the channel will
 	// be included; otherwise, they will be excluded.
 	ChannelMembersToAdd(since int64, channelID *string, includeRemovedMembers bool) ([]*model.UserChannelIDPair, *model.AppError)
+	// CheckDependencyHealth checks the services the server depends on and reports their health and
+	// latency. Only the dependencies enabled in the configuration are checked.
+	CheckDependencyHealth(rctx request.CTX) []*model.DependencyHealth
 	// CheckProviderAttributes returns the empty string if the patch can be applied without
 	// overriding attributes set by the user's login provider; otherwise, the name of the offending
 	// field is returned.
 	CheckProviderAttributes(c request.CTX, user *model.User, patch *model.UserPatch) string
+	// ClearUserEmailUndeliverable resumes sending emails to the email address of the user.
+	ClearUserEmailUndeliverable(userID string) *model.AppError
 	// CommandsForTeam returns all the plugin commands for the given team.
 	CommandsForTeam(teamID string) []*model.Command
+	// ComputeFileInfoContentHash hashes the stored content of the given file and saves the
+	// result. It is used to backfill the content hash of files uploaded before hashing existed.
+	ComputeFileInfoContentHash(rctx request.CTX, fileInfo *model.FileInfo) error
 	// ComputeLastAccessibleFileTime updates cache with CreateAt time of the last accessible file as per the cloud plan's limit.
 	// Use GetLastAccessibleFileTime() to access the result.
 	ComputeLastAccessibleFileTime() error
@@ -93,6 +116,9 @@ type AppIface interface {
 	CreateBot(rctx request.CTX, bot *model.Bot) (*model.Bot, *model.AppError)
 	// CreateChannelScheme creates a new Scheme of scope channel and assigns it to the channel.
 	CreateChannelScheme(c request.CTX, channel *model.Channel) (*model.Scheme, *model.AppError)
+	// CreateCustomRole creates a role with an arbitrary set of team and channel permissions, which can
+	// then be assigned to team and channel members as an explicit role.
+	CreateCustomRole(role *model.Role) (*model.Role, *model.AppError)
 	// CreateDefaultMemberships adds users to teams and channels based on their group memberships and how those groups
 	// are configured to sync with teams and channels for group members on or after the given timestamp.
 	// If includeRemovedMembers is true, then members who left or were removed from a team/channel will
@@ -101,6 +127,9 @@ type AppIface interface {
 	// CreateGuest creates a guest and sets several fields of the returned User struct to
 	// their zero values.
 	CreateGuest(c request.CTX, user *model.User) (*model.User, *model.AppError)
+	// CreateTermsOfServiceForAudience creates a new version of the terms of service. Only the users in
+	// the audience are asked to accept it again if they already accepted a previous version.
+	CreateTermsOfServiceForAudience(text, userID, audience string) (*model.TermsOfService, *model.AppError)
 	// CreateUser creates a user and sets several fields of the returned User struct to
 	// their zero values.
 	CreateUser(c request.CTX, user *model.User) (*model.User, *model.AppError)
@@ -119,11 +148,18 @@ type AppIface interface {
 	DefaultChannelNames(c request.CTX) []string
 	// DeleteChannelScheme deletes a channels scheme and sets its SchemeId to nil.
 	DeleteChannelScheme(c request.CTX, channel *model.Channel) (*model.Channel, *model.AppError)
+	// DeleteCustomProfileAttributeField deletes the field along with the values of the users.
+	DeleteCustomProfileAttributeField(fieldID string) *model.AppError
+	// DeleteCustomRole deletes a role created with CreateCustomRole. Members who were assigned the role
+	// keep it in their explicit roles, but a deleted role doesn't grant any permission.
+	DeleteCustomRole(roleID string) (*model.Role, *model.AppError)
 	// DeleteGroupConstrainedMemberships deletes team and channel memberships of users who aren't members of the allowed
 	// groups of all group-constrained teams and channels.
 	DeleteGroupConstrainedMemberships(rctx request.CTX) error
 	// DeletePersistentNotification stops the persistent notifications.
 	DeletePersistentNotification(c request.CTX, post *model.Post) *model.AppError
+	// DeleteProfile removes a stored profile.
+	DeleteProfile(name string) *model.AppError
 	// DeletePublicKey will delete plugin public key from the config.
 	DeletePublicKey(name string) *model.AppError
 	// DemoteUserToGuest Convert user's roles and all his membership's roles from
@@ -131,11 +167,17 @@ type AppIface interface {
 	DemoteUserToGuest(c request.CTX, user *model.User) *model.AppError
 	// DetachPlugin allows the server to bind to an existing plugin instance launched elsewhere.
 	DetachPlugin(pluginId string) *model.AppError
+	// DetachWebPushSubscription removes the Web Push subscription of the session, if any.
+	DetachWebPushSubscription(c request.CTX, session *model.Session) *model.AppError
 	// DisablePlugin will set the config for an installed plugin to disabled, triggering deactivation if active.
 	// Notifies cluster peers through config change.
 	DisablePlugin(id string) *model.AppError
 	// DoPermissionsMigrations execute all the permissions migrations need by the current version.
 	DoPermissionsMigrations() error
+	// EnableMaintenanceMode saves the maintenance mode in the config, so that every server of the
+	// cluster rejects the write requests until it's disabled. The current message and retry delay
+	// are kept when not given.
+	EnableMaintenanceMode(message string, retryAfterSeconds int) (*model.MaintenanceMode, *model.AppError)
 	// EnablePlugin will set the config for an installed plugin to enabled, triggering asynchronous
 	// activation if inactive anywhere in the cluster.
 	// Notifies cluster peers through config change.
@@ -148,10 +190,17 @@ type AppIface interface {
 	// attributes of the attachment structure. The Slack attachment structure is
 	// documented here: https://api.slack.com/docs/attachments
 	ProcessSlackAttachments(attachments []*model.SlackAttachment) []*model.SlackAttachment
+	// ExportCompliancePosts streams the posts of a compliance export in batches of batchSize,
+	// ordered by UpdateAt. The position of the export is checkpointed after each batch has been
+	// handled, so an interrupted export resumes from the last checkpoint instead of starting over.
+	ExportCompliancePosts(rctx request.CTX, job *model.Compliance, batchSize int, handleBatch func(posts []*model.CompliancePost) error) *model.AppError
 	// ExtendSessionExpiryIfNeeded extends Session.ExpiresAt based on session lengths in config.
 	// A new ExpiresAt is only written if enough time has elapsed since last update.
 	// Returns true only if the session was extended.
 	ExtendSessionExpiryIfNeeded(rctx request.CTX, session *model.Session) bool
+	// FillInCustomProfileAttributes sets the custom profile attributes of the users. Values of hidden
+	// fields are only set for the viewer's own profile, or when asAdmin is set.
+	FillInCustomProfileAttributes(users []*model.User, viewerID string, asAdmin bool) *model.AppError
 	// FillInPostProps should be invoked before saving posts to fill in properties such as
 	// channel_mentions.
 	//
@@ -172,24 +221,62 @@ type AppIface interface {
 	GetBots(rctx request.CTX, options *model.BotGetOptions) (model.BotList, *model.AppError)
 	// GetChannelGroupUsers returns the users who are associated to the channel via GroupChannels and GroupMembers.
 	GetChannelGroupUsers(channelID string) ([]*model.User, *model.AppError)
+	// GetChannelMembersAfter returns the next page of a channel's members in user id
+	// order, starting after opts.AfterUserId.
+	GetChannelMembersAfter(c request.CTX, channelID string, opts *model.ChannelMembersGetOptions) (model.ChannelMembers, *model.AppError)
 	// GetChannelModerationsForChannel Gets a channels ChannelModerations from either the higherScoped roles or from the channel scheme roles.
 	GetChannelModerationsForChannel(c request.CTX, channel *model.Channel) ([]*model.ChannelModeration, *model.AppError)
+	// GetChannelsForUserEtag returns the etag of the channels of the user in the team, or in all the teams when the
+	// team is empty. It's computed without loading the channels.
+	GetChannelsForUserEtag(userID, teamID string) string
 	// GetClusterPluginStatuses returns the status for plugins installed anywhere in the cluster.
 	GetClusterPluginStatuses() (model.PluginStatuses, *model.AppError)
+	// GetConfigAtVersion returns the whole config as it was after the given version of the config
+	// history was saved.
+	GetConfigAtVersion(version int64) (*model.Config, *model.AppError)
 	// GetConfigFile proxies access to the given configuration file to the underlying config store.
 	GetConfigFile(name string) ([]byte, error)
+	// GetCustomProfileAttributeFields returns the fields by sort order. The returned fields are
+	// shared with the cache and must not be modified.
+	GetCustomProfileAttributeFields() ([]*model.CustomProfileAttributeField, *model.AppError)
+	// GetCustomProfileAttributeValues returns the values of the user keyed by field id. Values of
+	// hidden fields are only returned when showHidden is set.
+	GetCustomProfileAttributeValues(userID string, showHidden bool) (map[string]string, *model.AppError)
+	// GetDataSeedStatus returns the progress of the last generation of synthetic data, or nil if
+	// none was started since the server started.
+	GetDataSeedStatus() *model.DataSeedStatus
+	// GetDirectReports returns the active users who report to the given user.
+	GetDirectReports(userID string, page, perPage int) ([]*model.User, *model.AppError)
+	// GetEmailDigestSettings returns the digest schedule of the user, which is disabled unless
+	// the user has set one.
+	GetEmailDigestSettings(userID string) (*model.EmailDigestSettings, *model.AppError)
+	// GetEmailTemplates returns the templates used to render the emails, and whether they are
+	// overridden by the templates of EmailSettings.EmailTemplateOverridesDirectory.
+	GetEmailTemplates() []*model.EmailTemplate
 	// GetEmojiStaticURL returns a relative static URL for system default emojis,
 	// and the API route for custom ones. Errors if not found or if custom and deleted.
 	GetEmojiStaticURL(c request.CTX, emojiName string) (string, *model.AppError)
 	// GetEnvironmentConfig returns a map of configuration keys whose values have been overridden by an environment variable.
 	// If filter is not nil and returns false for a struct field, that field will be omitted.
 	GetEnvironmentConfig(filter func(reflect.StructField) bool) map[string]any
+	// GetExpiringUserAccessTokens returns the active tokens expiring within the given number of days,
+	// including the ones already expired.
+	GetExpiringUserAccessTokens(withinDays, page, perPage int) ([]*model.UserAccessToken, *model.AppError)
+	// GetFeatureFlagsForUser returns the feature flags with the values of the targeting rules
+	// matching the user, on top of the server-wide values.
+	GetFeatureFlagsForUser(userID string) (*model.FeatureFlags, *model.AppError)
 	// GetFileInfosForPost also returns firstInaccessibleFileTime based on cloud plan's limit.
 	GetFileInfosForPost(rctx request.CTX, postID string, fromMaster bool, includeDeleted bool) ([]*model.FileInfo, int64, *model.AppError)
+	// GetFileShareLinksForFile returns the links of a file that haven't been revoked, including
+	// expired ones so that their owner can still see them.
+	GetFileShareLinksForFile(rctx request.CTX, fileID string) ([]*model.FileShareLink, *model.AppError)
 	// GetFilteredUsersStats is used to get a count of users based on the set of filters supported by UserCountOptions.
 	GetFilteredUsersStats(options *model.UserCountOptions) (*model.UsersStats, *model.AppError)
 	// GetGroupsByTeam returns the paged list and the total count of group associated to the given team.
 	GetGroupsByTeam(teamID string, opts model.GroupSearchOpts) ([]*model.GroupWithSchemeAdmin, int, *model.AppError)
+	// GetIntegrationDeliveries returns the recorded deliveries of the outgoing webhook or the slash
+	// command, most recent first.
+	GetIntegrationDeliveries(hookID string, page, perPage int) ([]*model.IntegrationDelivery, *model.AppError)
 	// GetKnownUsers returns the list of user ids of users with any direct
 	// relationship with a user. That means any user sharing any channel, including
 	// direct and group channels.
@@ -206,6 +293,9 @@ type AppIface interface {
 	// GetMarketplacePlugins returns a list of plugins from the marketplace-server,
 	// and plugins that are installed locally.
 	GetMarketplacePlugins(rctx request.CTX, filter *model.MarketplacePluginFilter) ([]*model.MarketplacePlugin, *model.AppError)
+	// GetNotificationDeliveries returns the recorded outcomes of the notifications sent to the
+	// user, most recent first.
+	GetNotificationDeliveries(userID string, opts model.NotificationDeliveryGetOptions) ([]*model.NotificationDelivery, *model.AppError)
 	// GetPluginStatus returns the status for a plugin installed on this server.
 	GetPluginStatus(id string) (*model.PluginStatus, *model.AppError)
 	// GetPluginStatuses returns the status for plugins installed on this server.
@@ -227,6 +317,9 @@ type AppIface interface {
 	GetProfileImagePath(user *model.User) (string, *model.AppError)
 	// GetPublicKey will return the actual public key saved in the `name` file.
 	GetPublicKey(name string) ([]byte, *model.AppError)
+	// GetReportingChain returns the managers of a user, starting with the direct manager
+	// and ending with the top of the org chart.
+	GetReportingChain(userID string) ([]*model.User, *model.AppError)
 	// GetSanitizedConfig gets the configuration for a system admin without any secrets.
 	GetSanitizedConfig() *model.Config
 	// GetSchemeRolesForChannel Checks if a channel or its team has an override scheme for channel roles and returns the scheme roles or default channel roles.
@@ -234,18 +327,32 @@ type AppIface interface {
 	// GetSessionLengthInMillis returns the session length, in milliseconds,
 	// based on the type of session (Mobile, SSO, Web/LDAP).
 	GetSessionLengthInMillis(session *model.Session) int64
+	// GetSidebarCategoryUnreads returns the unread messages and mentions of the user rolled up by
+	// sidebar category, on all of their teams.
+	GetSidebarCategoryUnreads(c request.CTX, userID string) ([]*model.SidebarCategoryUnread, *model.AppError)
 	// GetStorageUsage returns the sum of files' sizes stored on this instance
 	GetStorageUsage() (int64, *model.AppError)
 	// GetSuggestions returns suggestions for user input.
 	GetSuggestions(c request.CTX, commandArgs *model.CommandArgs, commands []*model.Command, roleID string) []model.AutocompleteSuggestion
 	// GetTeamGroupUsers returns the users who are associated to the team via GroupTeams and GroupMembers.
 	GetTeamGroupUsers(teamID string) ([]*model.User, *model.AppError)
+	// GetTeamMembersEtag returns the etag of the members of the team. It covers the users of the members too, since
+	// the deactivated users may be left out of the list.
+	GetTeamMembersEtag(teamID string) string
 	// GetTeamSchemeChannelRoles Checks if a team has an override scheme and returns the scheme channel role names or default channel role names.
 	GetTeamSchemeChannelRoles(c request.CTX, teamID string) (guestRoleName string, userRoleName string, adminRoleName string, err *model.AppError)
+	// GetTermsOfServiceStats returns how many of the active users targeted by the given version of the
+	// terms of service have accepted it.
+	GetTermsOfServiceStats(termsOfServiceID string) (*model.TermsOfServiceStats, *model.AppError)
 	// GetTotalUsersStats is used for the DM list total
 	GetTotalUsersStats(viewRestrictions *model.ViewUsersRestrictions) (*model.UsersStats, *model.AppError)
+	// GetUserEmailStatus returns whether the email address of the user was reported as undeliverable.
+	GetUserEmailStatus(userID string) (*model.UserEmailStatus, *model.AppError)
 	// GetUserStatusesByIds used by apiV4
 	GetUserStatusesByIds(userIDs []string) ([]*model.Status, *model.AppError)
+	// GetWebPushVAPIDKey returns the public key browsers need to subscribe to the Web Push
+	// notifications of the server. The asymmetric signing key of the server is used as VAPID key.
+	GetWebPushVAPIDKey() (*model.WebPushVAPIDKey, *model.AppError)
 	// HasRemote returns whether a given channelID is present in the channel remotes or not.
 	HasRemote(channelID string, remoteID string) (bool, error)
 	// HubRegister registers a connection to a hub.
@@ -255,10 +362,18 @@ type AppIface interface {
 	// InstallPlugin unpacks and installs a plugin but does not enable or activate it unless the the
 	// plugin was already enabled.
 	InstallPlugin(pluginFile io.ReadSeeker, replace bool) (*model.Manifest, *model.AppError)
+	// IsTermsOfServiceAcceptanceOverdue returns true if the user must accept the latest terms of
+	// service and its grace period has ended. Bots never have to accept them.
+	IsTermsOfServiceAcceptanceOverdue(userID string) (bool, *model.AppError)
+	// ListProfiles returns the profiles stored in the file backend, most recent first.
+	ListProfiles() ([]*model.Profile, *model.AppError)
 	// LogAuditRec logs an audit record using default LvlAuditCLI.
 	LogAuditRec(rctx request.CTX, rec *audit.Record, err error)
 	// LogAuditRecWithLevel logs an audit record using specified Level.
 	LogAuditRecWithLevel(rctx request.CTX, rec *audit.Record, level mlog.Level, err error)
+	// LookupInteractiveDialog asks the integration for the options of a dynamic select element
+	// of a dialog, matching what the user typed.
+	LookupInteractiveDialog(c request.CTX, request model.DialogLookupRequest) (*model.DialogLookupResponse, *model.AppError)
 	// MakeAuditRecord creates a audit record pre-populated with defaults.
 	MakeAuditRecord(rctx request.CTX, event string, initialStatus string) *audit.Record
 	// MarkChanelAsUnreadFromPost will take a post and set the channel as unread from that one.
@@ -269,6 +384,14 @@ type AppIface interface {
 	// MentionsToTeamMembers returns all the @ mentions found in message that
 	// belong to users in the specified team, linking them to their users
 	MentionsToTeamMembers(c request.CTX, message, teamID string) model.UserMentionMap
+	// MergeBulkImport imports a bulk export of another instance, renaming its users and teams
+	// according to the mapping so that they don't collide with the existing ones. Once the
+	// posts are imported, the permalinks between them are translated to the ids of the
+	// imported posts.
+	//
+	// All the JSONL files of the archive are imported in order. It returns the number of the
+	// line of the remapped import that failed along with the error.
+	MergeBulkImport(c request.CTX, importZip *zip.Reader, job *model.Job, mapping *model.ImportMergeMapping, opts model.BulkImportOpts) (*model.AppError, int)
 	// MoveChannel method is prone to data races if someone joins to channel during the move process. However this
 	// function is only exposed to sysadmins and the possibility of this edge case is relatively small.
 	MoveChannel(c request.CTX, team *model.Team, channel *model.Channel, user *model.User) *model.AppError
@@ -293,6 +416,10 @@ type AppIface interface {
 	PatchBot(rctx request.CTX, botUserId string, botPatch *model.BotPatch) (*model.Bot, *model.AppError)
 	// PatchChannelModerationsForChannel Updates a channels scheme roles based on a given ChannelModerationPatch, if the permissions match the higher scoped role the scheme is deleted.
 	PatchChannelModerationsForChannel(c request.CTX, channel *model.Channel, channelModerationsPatch []*model.ChannelModerationPatch) ([]*model.ChannelModeration, *model.AppError)
+	// PatchCustomProfileAttributeValues sets the given values, keyed by field id, for the user. An
+	// empty value clears the value of the user. Fields synchronized from an identity provider can't be
+	// modified.
+	PatchCustomProfileAttributeValues(userID string, patch map[string]string) (map[string]string, *model.AppError)
 	// Perform an HTTP POST request to an integration's action endpoint.
 	// Caller must consume and close returned http.Response as necessary.
 	// For internal requests, requests are routed directly to a plugin ServerHTTP hook
@@ -302,29 +429,75 @@ type AppIface interface {
 	// PopulateWebConnConfig checks if the connection id already exists in the hub,
 	// and if so, accordingly populates the other fields of the webconn.
 	PopulateWebConnConfig(s *model.Session, cfg *platform.WebConnConfig, seqVal string) (*platform.WebConnConfig, error)
+	// PreviewEmailTemplate renders an email template with sample data, overriding the templates with
+	// the content of the request, if any.
+	PreviewEmailTemplate(request *model.EmailTemplatePreviewRequest) (*model.EmailTemplatePreview, *model.AppError)
+	// ProcessEmailEvents marks the addresses reported by the email provider as undeliverable, so that
+	// no further email is sent to them.
+	ProcessEmailEvents(c request.CTX, events *mail.EmailEvents) *model.AppError
+	// ProcessInboundEmail posts a reply to a notification email in the thread of the post the email
+	// notified about, as the user the notification was sent to.
+	ProcessInboundEmail(c request.CTX, email *model.InboundEmail) (*model.Post, *model.AppError)
+	// ProfileReader returns a reader of the content of a stored profile.
+	ProfileReader(name string) (filestore.ReadCloseSeeker, *model.AppError)
 	// PromoteGuestToUser Convert user's roles and all his membership's roles from
 	// guest roles to regular user roles.
 	PromoteGuestToUser(c request.CTX, user *model.User, requestorId string) *model.AppError
 	// ReattachPlugin allows the server to bind to an existing plugin instance launched elsewhere.
 	ReattachPlugin(manifest *model.Manifest, pluginReattachConfig *model.PluginReattachConfig) *model.AppError
+	// RecordConfigChange stores a new version of the config history with the changes between the
+	// old and new configs. Nothing is stored when the configs are the same.
+	RecordConfigChange(userID string, oldCfg, newCfg *model.Config) (*model.ConfigHistory, *model.AppError)
+	// RecordNotificationDelivery records the outcome of sending a notification of a post to a user,
+	// if the delivery history is enabled. The delivery is saved in the background so that it
+	// doesn't slow down the notifications.
+	RecordNotificationDelivery(userID, postID, channelID string, notificationType model.NotificationType, status model.NotificationStatus, reason model.NotificationReason, detail string)
 	// Removes a listener function by the unique ID returned when AddConfigListener was called
 	RemoveConfigListener(id string)
+	// RemoveFileInfoBlobs removes the stored files of infos, which are about to be permanently
+	// deleted. Deduplicated files share their stored blob, so a blob is kept while FileInfos other
+	// than the given ones still reference its path.
+	RemoveFileInfoBlobs(rctx request.CTX, infos []*model.FileInfo)
 	// RenameChannel is used to rename the channel Name and the DisplayName fields
 	RenameChannel(c request.CTX, channel *model.Channel, newChannelName string, newDisplayName string) (*model.Channel, *model.AppError)
 	// RenameTeam is used to rename the team Name and the DisplayName fields
 	RenameTeam(team *model.Team, newTeamName string, newDisplayName string) (*model.Team, *model.AppError)
+	// ReplayOutgoingWebhookDelivery sends the payload of a recorded delivery to the outgoing webhook
+	// again, with the current token of the webhook, and returns the new delivery.
+	ReplayOutgoingWebhookDelivery(c request.CTX, hook *model.OutgoingWebhook, deliveryID string) (*model.IntegrationDelivery, *model.AppError)
 	// ResolvePersistentNotification stops the persistent notifications, if a loggedInUserID(except the post owner) reacts, reply or ack on the post.
 	// Post-owner can only delete the original post to stop the notifications.
 	ResolvePersistentNotification(c request.CTX, post *model.Post, loggedInUserID string) *model.AppError
+	// ResumeIncrementalComplianceJobs restarts the incremental compliance exports that were
+	// interrupted while running, from their last checkpoint.
+	ResumeIncrementalComplianceJobs(rctx request.CTX)
 	// RevokeSessionsFromAllUsers will go through all the sessions active
 	// in the server and revoke them
 	RevokeSessionsFromAllUsers() *model.AppError
+	// RotateUserAccessToken issues a new token for the user of the given one, with the same description,
+	// and lets the given token expire at the end of the grace period configured for rotations.
+	RotateUserAccessToken(c request.CTX, token *model.UserAccessToken, expiresAt int64) (*model.UserAccessToken, *model.AppError)
+	// RunIncrementalComplianceJob writes the report of an incremental compliance export. The posts
+	// are streamed with ExportCompliancePosts into a CSV file, which is synced before each checkpoint,
+	// so a job interrupted by a restart appends to the file from its last checkpoint. The finished
+	// report is zipped where GetComplianceFile reads it.
+	RunIncrementalComplianceJob(rctx request.CTX, job *model.Compliance) *model.AppError
+	// RunJsonlMessageExport exports the posts updated since the given time in the line-delimited
+	// JSON format to the export file store, and returns the directory of the export along with
+	// the number of warnings encountered. A negative limit exports all posts.
+	//
+	// The export is streamed in batches through temporary files so that its size isn't bound
+	// by the available memory.
+	RunJsonlMessageExport(rctx request.CTX, since int64, limit int) (string, int64, *model.AppError)
 	// SaveConfig replaces the active configuration, optionally notifying cluster peers.
 	SaveConfig(newCfg *model.Config, sendConfigChangeClusterMessage bool) (*model.Config, *model.Config, *model.AppError)
 	// SearchAllChannels returns a list of channels, the total count of the results of the search (if the paginate search option is true), and an error.
 	SearchAllChannels(c request.CTX, term string, opts model.ChannelSearchOpts) (model.ChannelListWithTeamData, int64, *model.AppError)
 	// SearchAllTeams returns a team list and the total count of the results
 	SearchAllTeams(searchOpts *model.TeamSearch) ([]*model.Team, int64, *model.AppError)
+	// SendEmailDigests sends the digests that are due to the users who enabled them, according
+	// to their schedule and timezone.
+	SendEmailDigests() error
 	// SessionHasPermissionToChannels returns true only if user has access to all channels.
 	SessionHasPermissionToChannels(c request.CTX, session model.Session, channelIDs []string, permission *model.Permission) bool
 	// SessionHasPermissionToManageBot returns nil if the session has access to manage the given bot.
@@ -335,6 +508,9 @@ type AppIface interface {
 	SessionHasPermissionToTeams(c request.CTX, session model.Session, teamIDs []string, permission *model.Permission) bool
 	// SessionIsRegistered determines if a specific session has been registered
 	SessionIsRegistered(session model.Session) bool
+	// SetFeatureFlagRule saves the targeting rule of the feature flag in the config, replacing the
+	// existing one.
+	SetFeatureFlagRule(name string, rule *model.FeatureFlagRule) (*model.FeatureFlagStatus, *model.AppError)
 	// SetSessionExpireInHours sets the session's expiry the specified number of hours
 	// relative to either the session creation date or the current time, depending
 	// on the `ExtendSessionOnActivity` config setting.
@@ -346,6 +522,15 @@ type AppIface interface {
 	// status to away if needed. Used by the WS to set status to away if an 'online' device disconnects
 	// while an 'away' device is still connected
 	SetStatusLastActivityAt(userID string, activityAt int64)
+	// SetTimedStatuses sets the time-boxed statuses reported by a calendar provider. The previous status of each
+	// user is restored once the end time of their timed status is reached, see UpdateDNDStatusOfUsers.
+	SetTimedStatuses(c request.CTX, statuses []*model.TimedStatus) *model.AppError
+	// SetUserManager sets the manager of a user, or clears it when managerID is empty.
+	SetUserManager(rctx request.CTX, userID, managerID string) (*model.User, *model.AppError)
+	// StartDataSeed starts generating synthetic teams, channels, users and posts in the background,
+	// through the app layer, so that staging servers can be populated for load tests. Only one
+	// generation can run at a time on a server.
+	StartDataSeed(rctx request.CTX, seedRequest model.DataSeedRequest) (*model.DataSeedStatus, *model.AppError)
 	// SyncLdap starts an LDAP sync job.
 	// If includeRemovedMembers is true, then members who left or were removed from a team/channel will
 	// be re-added; otherwise, they will not be re-added.
@@ -386,13 +571,25 @@ type AppIface interface {
 	UpdateBotActive(rctx request.CTX, botUserId string, active bool) (*model.Bot, *model.AppError)
 	// UpdateBotOwner changes a bot's owner to the given value.
 	UpdateBotOwner(rctx request.CTX, botUserId, newOwnerId string) (*model.Bot, *model.AppError)
+	// UpdateBotScope restricts a bot to the given teams and channels, or lifts the restriction when scope is nil.
+	// The sessions of the bot are revoked so that its access tokens pick up the new scope.
+	UpdateBotScope(rctx request.CTX, botUserId string, scope *model.BotScope) (*model.Bot, *model.AppError)
 	// UpdateChannel updates a given channel by its Id. It also publishes the CHANNEL_UPDATED event.
 	UpdateChannel(c request.CTX, channel *model.Channel) (*model.Channel, *model.AppError)
 	// UpdateChannelScheme saves the new SchemeId of the channel passed.
 	UpdateChannelScheme(c request.CTX, channel *model.Channel) (*model.Channel, *model.AppError)
+	// UpdateCommandAutocompleteData registers the autocomplete tree of a command, or removes it when
+	// data is nil so that the command falls back to its AutoCompleteHint and AutoCompleteDesc.
+	UpdateCommandAutocompleteData(cmd *model.Command, data *model.AutocompleteData) (*model.Command, *model.AppError)
+	// UpdateCustomProfileAttributeField updates the field. The type of a field can't be changed since
+	// the values of the users would no longer be valid.
+	UpdateCustomProfileAttributeField(oldField, updatedField *model.CustomProfileAttributeField) (*model.CustomProfileAttributeField, *model.AppError)
 	// UpdateDNDStatusOfUsers is a recurring task which is started when server starts
 	// which unsets dnd status of users if needed and saves and broadcasts it
 	UpdateDNDStatusOfUsers()
+	// UpdateEmailDigestSettings saves the digest schedule of the user. The activity that took
+	// place before digests were enabled isn't included in the first one.
+	UpdateEmailDigestSettings(c request.CTX, userID string, settings *model.EmailDigestSettings) *model.AppError
 	// UpdateProductNotices is called periodically from a scheduled worker to fetch new notices and update the cache
 	UpdateProductNotices() *model.AppError
 	// UpdateSharedChannelCursor updates the cursor for the specified channelID and remoteID.
@@ -416,9 +613,26 @@ type AppIface interface {
 	// upload, returning a rejection error. In this case FileInfo would have
 	// contained the last "good" FileInfo before the execution of that plugin.
 	UploadFileX(c request.CTX, channelID, name string, input io.Reader, opts ...func(*UploadFileTask)) (*model.FileInfo, *model.AppError)
+	// UseFileShareLink checks the signature, expiry, password and download limit of a share link
+	// and, if the link can be used, returns the shared file. The download is only recorded when
+	// countDownload is set, so that requests which don't transfer the file leave the limit alone.
+	UseFileShareLink(rctx request.CTX, linkID, hash, password string, countDownload bool) (*model.FileInfo, *model.AppError)
 	// UserIsInAdminRoleGroup returns true at least one of the user's groups are configured to set the members as
 	// admins in the given syncable.
 	UserIsInAdminRoleGroup(userID, syncableID string, syncableType model.GroupSyncableType) (bool, *model.AppError)
+	// ValidateBulkImport checks the lines of a JSONL file in a single streaming pass, without
+	// importing them or checking them against the existing data. It returns the number of lines
+	// of the file, or the number of the first invalid line along with the error.
+	ValidateBulkImport(c request.CTX, jsonlReader io.Reader) (*model.AppError, int)
+	// ValidateConfig validates the given config without applying it. When checkConnections is set,
+	// the connections to the email server, the file store and Elasticsearch are tested as well.
+	ValidateConfig(rctx request.CTX, cfg *model.Config, checkConnections bool) *model.ConfigValidationResult
+	// ValidateCustomProfileAttributesFilter checks that the users can be filtered by the given
+	// values, keyed by field id. Only admins can filter by hidden fields.
+	ValidateCustomProfileAttributesFilter(filter map[string]string, asAdmin bool) *model.AppError
+	// ValidateEmailTemplateOverride checks that the content of a template override is valid, and
+	// returns the templates it defines.
+	ValidateEmailTemplateOverride(override *model.EmailTemplateOverride) ([]*model.EmailTemplate, *model.AppError)
 	// ValidateUserPermissionsOnChannels filters channelIds based on whether userId is authorized to manage channel members. Unauthorized channels are removed from the returned list.
 	ValidateUserPermissionsOnChannels(c request.CTX, userId string, channelIds []string) []string
 	// VerifyPlugin checks that the given signature corresponds to the given plugin and matches a trusted certificate.
@@ -514,12 +728,15 @@ type AppIface interface {
 	CountNotification(notificationType model.NotificationType)
 	CountNotificationAck(notificationType model.NotificationType)
 	CountNotificationReason(notificationStatus model.NotificationStatus, notificationType model.NotificationType, notificationReason model.NotificationReason)
+	CreateAutomationRule(c request.CTX, rule *model.AutomationRule) (*model.AutomationRule, *model.AppError)
 	CreateChannel(c request.CTX, channel *model.Channel, addMember bool) (*model.Channel, *model.AppError)
 	CreateChannelBookmark(c request.CTX, newBookmark *model.ChannelBookmark, connectionId string) (*model.ChannelBookmarkWithFileInfo, *model.AppError)
 	CreateChannelWithUser(c request.CTX, channel *model.Channel, userID string) (*model.Channel, *model.AppError)
 	CreateCommand(cmd *model.Command) (*model.Command, *model.AppError)
 	CreateCommandWebhook(commandID string, args *model.CommandArgs) (*model.CommandWebhook, *model.AppError)
+	CreateCustomProfileAttributeField(field *model.CustomProfileAttributeField) (*model.CustomProfileAttributeField, *model.AppError)
 	CreateEmoji(c request.CTX, sessionUserId string, emoji *model.Emoji, multiPartImageData *multipart.Form) (*model.Emoji, *model.AppError)
+	CreateFileShareLink(rctx request.CTX, info *model.FileInfo, creatorID string, linkRequest *model.FileShareLinkRequest) (*model.FileShareLink, *model.AppError)
 	CreateGroup(group *model.Group) (*model.Group, *model.AppError)
 	CreateGroupChannel(c request.CTX, userIDs []string, creatorId string) (*model.Channel, *model.AppError)
 	CreateGroupWithUserIds(group *model.GroupWithUserIds) (*model.Group, *model.AppError)
@@ -558,6 +775,7 @@ type AppIface interface {
 	DeleteAcknowledgementForPost(c request.CTX, postID, userID string) *model.AppError
 	DeleteAllExpiredPluginKeys() *model.AppError
 	DeleteAllKeysForPlugin(pluginID string) *model.AppError
+	DeleteAutomationRule(rule *model.AutomationRule) *model.AppError
 	DeleteBrandImage(rctx request.CTX) *model.AppError
 	DeleteChannel(c request.CTX, channel *model.Channel, userID string) *model.AppError
 	DeleteChannelBookmark(bookmarkId, connectionId string) (*model.ChannelBookmarkWithFileInfo, *model.AppError)
@@ -566,6 +784,7 @@ type AppIface interface {
 	DeleteEmoji(c request.CTX, emoji *model.Emoji) *model.AppError
 	DeleteEphemeralPost(rctx request.CTX, userID, postID string)
 	DeleteExport(name string) *model.AppError
+	DeleteFeatureFlagRule(name string) *model.AppError
 	DeleteGroup(groupID string) (*model.Group, *model.AppError)
 	DeleteGroupMember(groupID string, userID string) (*model.GroupMember, *model.AppError)
 	DeleteGroupMembers(groupID string, userIDs []string) ([]*model.GroupMember, *model.AppError)
@@ -584,10 +803,11 @@ type AppIface interface {
 	DeleteSidebarCategory(c request.CTX, userID, teamID, categoryId string) *model.AppError
 	DeleteToken(token *model.Token) *model.AppError
 	DisableAutoResponder(rctx request.CTX, userID string, asAdmin bool) *model.AppError
+	DisableMaintenanceMode() (*model.MaintenanceMode, *model.AppError)
 	DisableUserAccessToken(c request.CTX, token *model.UserAccessToken) *model.AppError
 	DoAppMigrations()
 	DoCheckForAdminNotifications(trial bool) *model.AppError
-	DoCommandRequest(rctx request.CTX, cmd *model.Command, p url.Values) (*model.Command, *model.CommandResponse, *model.AppError)
+	DoCommandRequest(rctx request.CTX, cmd *model.Command, p url.Values) (_ *model.Command, _ *model.CommandResponse, appErr *model.AppError)
 	DoEmojisPermissionsMigration()
 	DoGuestRolesCreationMigration()
 	DoLocalRequest(c request.CTX, rawURL string, body []byte) (*http.Response, *model.AppError)
@@ -638,6 +858,8 @@ type AppIface interface {
 	GetAuditsPage(rctx request.CTX, userID string, page int, perPage int) (model.Audits, *model.AppError)
 	GetAuthorizationCode(c request.CTX, w http.ResponseWriter, r *http.Request, service string, props map[string]string, loginHint string) (string, *model.AppError)
 	GetAuthorizedAppsForUser(userID string, page, perPage int) ([]*model.OAuthApp, *model.AppError)
+	GetAutomationRule(ruleID string) (*model.AutomationRule, *model.AppError)
+	GetAutomationRulesForTeam(teamID string, page, perPage int) ([]*model.AutomationRule, *model.AppError)
 	GetBookmark(bookmarkId string, includeDeleted bool) (*model.ChannelBookmarkWithFileInfo, *model.AppError)
 	GetBrandImage(rctx request.CTX) ([]byte, *model.AppError)
 	GetBulkReactionsForPosts(postIDs []string) (map[string][]*model.Reaction, *model.AppError)
@@ -676,7 +898,9 @@ type AppIface interface {
 	GetComplianceFile(job *model.Compliance) ([]byte, *model.AppError)
 	GetComplianceReport(reportId string) (*model.Compliance, *model.AppError)
 	GetComplianceReports(page, perPage int) (model.Compliances, *model.AppError)
+	GetConfigHistory(page, perPage int) ([]*model.ConfigHistory, *model.AppError)
 	GetCookieDomain() string
+	GetCustomProfileAttributeField(fieldID string) (*model.CustomProfileAttributeField, *model.AppError)
 	GetCustomStatus(userID string) (*model.CustomStatus, *model.AppError)
 	GetDefaultProfileImage(user *model.User) ([]byte, *model.AppError)
 	GetDeletedChannels(c request.CTX, teamID string, offset int, limit int, userID string) (model.ChannelList, *model.AppError)
@@ -687,10 +911,13 @@ type AppIface interface {
 	GetEmojiByName(c request.CTX, emojiName string) (*model.Emoji, *model.AppError)
 	GetEmojiImage(c request.CTX, emojiId string) ([]byte, string, *model.AppError)
 	GetEmojiList(c request.CTX, page, perPage int, sort string) ([]*model.Emoji, *model.AppError)
+	GetFeatureFlag(name string) (*model.FeatureFlagStatus, *model.AppError)
+	GetFeatureFlags() []*model.FeatureFlagStatus
 	GetFile(rctx request.CTX, fileID string) ([]byte, *model.AppError)
 	GetFileInfo(rctx request.CTX, fileID string) (*model.FileInfo, *model.AppError)
 	GetFileInfos(rctx request.CTX, page, perPage int, opt *model.GetFileInfosOptions) ([]*model.FileInfo, *model.AppError)
 	GetFileInfosForPostWithMigration(rctx request.CTX, postID string, includeDeleted bool) ([]*model.FileInfo, *model.AppError)
+	GetFileShareLink(rctx request.CTX, linkID string) (*model.FileShareLink, *model.AppError)
 	GetFlaggedPosts(userID string, offset int, limit int) (*model.PostList, *model.AppError)
 	GetFlaggedPostsForChannel(userID, channelID string, offset int, limit int) (*model.PostList, *model.AppError)
 	GetFlaggedPostsForTeam(userID, teamID string, offset int, limit int) (*model.PostList, *model.AppError)
@@ -727,6 +954,7 @@ type AppIface interface {
 	GetLatestVersion(rctx request.CTX, latestVersionUrl string) (*model.GithubReleaseInfo, *model.AppError)
 	GetLogs(rctx request.CTX, page, perPage int) ([]string, *model.AppError)
 	GetLogsSkipSend(rctx request.CTX, page, perPage int, logFilter *model.LogFilter) ([]string, *model.AppError)
+	GetMaintenanceMode() *model.MaintenanceMode
 	GetMemberCountsByGroup(rctx request.CTX, channelID string, includeTimezones bool) ([]*model.ChannelMemberCountByGroup, *model.AppError)
 	GetMessageForNotification(post *model.Post, teamName, siteUrl string, translateFunc i18n.TranslateFunc) string
 	GetMultipleEmojiByName(c request.CTX, names []string) ([]*model.Emoji, *model.AppError)
@@ -774,6 +1002,7 @@ type AppIface interface {
 	GetPostsSince(options model.GetPostsSinceOptions) (*model.PostList, *model.AppError)
 	GetPreferenceByCategoryAndNameForUser(c request.CTX, userID string, category string, preferenceName string) (*model.Preference, *model.AppError)
 	GetPreferenceByCategoryForUser(c request.CTX, userID string, category string) (model.Preferences, *model.AppError)
+	GetPreferencesEtag(userID string) string
 	GetPreferencesForUser(c request.CTX, userID string) (model.Preferences, *model.AppError)
 	GetPrevPostIdFromPostList(postList *model.PostList, collapsedThreads bool) string
 	GetPriorityForPost(postId string) (*model.PostPriority, *model.AppError)
@@ -846,6 +1075,7 @@ type AppIface interface {
 	GetTeamsUnreadForUser(excludeTeamId string, userID string, includeCollapsedThreads bool) ([]*model.TeamUnread, *model.AppError)
 	GetTeamsUsage() (*model.TeamsUsage, *model.AppError)
 	GetTermsOfService(id string) (*model.TermsOfService, *model.AppError)
+	GetTermsOfServiceVersions(page, perPage int) ([]*model.TermsOfService, *model.AppError)
 	GetThreadForUser(threadMembership *model.ThreadMembership, extended bool) (*model.ThreadResponse, *model.AppError)
 	GetThreadMembershipForUser(userId, threadId string) (*model.ThreadMembership, *model.AppError)
 	GetThreadMembershipsForUser(userID, teamID string) ([]*model.ThreadMembership, error)
@@ -1000,7 +1230,9 @@ type AppIface interface {
 	QueryLogs(rctx request.CTX, page, perPage int, logFilter *model.LogFilter) (map[string][]string, *model.AppError)
 	ReadFile(path string) ([]byte, *model.AppError)
 	RecycleDatabaseConnection(rctx request.CTX)
+	RegenCommandSigningSecret(cmd *model.Command) (*model.Command, *model.AppError)
 	RegenCommandToken(cmd *model.Command) (*model.Command, *model.AppError)
+	RegenOutgoingWebhookSigningSecret(hook *model.OutgoingWebhook) (*model.OutgoingWebhook, *model.AppError)
 	RegenOutgoingWebhookToken(hook *model.OutgoingWebhook) (*model.OutgoingWebhook, *model.AppError)
 	RegenerateOAuthAppSecret(app *model.OAuthApp) (*model.OAuthApp, *model.AppError)
 	RegenerateTeamInviteId(teamID string) (*model.Team, *model.AppError)
@@ -1037,6 +1269,7 @@ type AppIface interface {
 	ReturnSessionToPool(session *model.Session)
 	RevokeAccessToken(c request.CTX, token string) *model.AppError
 	RevokeAllSessions(c request.CTX, userID string) *model.AppError
+	RevokeFileShareLink(rctx request.CTX, linkID string) *model.AppError
 	RevokeSession(c request.CTX, session *model.Session) *model.AppError
 	RevokeSessionById(c request.CTX, sessionID string) *model.AppError
 	RevokeSessionsForDeviceId(c request.CTX, userID string, deviceID string, currentSessionId string) *model.AppError
@@ -1157,6 +1390,7 @@ type AppIface interface {
 	UnregisterPluginForSharedChannels(pluginID string) error
 	UnshareChannel(channelID string) (bool, error)
 	UpdateActive(c request.CTX, user *model.User, active bool) (*model.User, *model.AppError)
+	UpdateAutomationRule(c request.CTX, oldRule, updatedRule *model.AutomationRule) (*model.AutomationRule, *model.AppError)
 	UpdateChannelBookmark(c request.CTX, updateBookmark *model.ChannelBookmarkWithFileInfo, connectionId string) (*model.UpdateChannelBookmarkResponse, *model.AppError)
 	UpdateChannelBookmarkSortOrder(bookmarkId, channelId string, newIndex int64, connectionId string) ([]*model.ChannelBookmarkWithFileInfo, *model.AppError)
 	UpdateChannelMemberNotifyProps(c request.CTX, data map[string]string, channelID string, userID string) (*model.ChannelMember, *model.AppError)
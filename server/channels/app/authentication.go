@@ -163,6 +163,11 @@ func (a *App) checkLdapUserPasswordAndAllCriteria(rctx request.CTX, ldapId *stri
 		return nil, err
 	}
 
+	a.Srv().Go(func() {
+		a.syncCustomProfileAttributesFromLdap(rctx, ldapUser)
+		a.syncManagerFromLdap(rctx, ldapUser)
+	})
+
 	// user successfully authenticated
 	return ldapUser, nil
 }
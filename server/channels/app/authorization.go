@@ -38,6 +38,10 @@ func (a *App) SessionHasPermissionToTeam(session model.Session, teamID string, p
 		return true
 	}
 
+	if scope := session.GetBotScope(); scope != nil && !scope.AllowsTeam(teamID) {
+		return false
+	}
+
 	teamMember := session.GetTeamByTeamId(teamID)
 	if teamMember != nil {
 		if a.RolesGrantPermission(teamMember.GetRoles(), permission.Id) {
@@ -60,6 +64,14 @@ func (a *App) SessionHasPermissionToTeams(c request.CTX, session model.Session,
 		}
 	}
 
+	if scope := session.GetBotScope(); scope != nil {
+		for _, teamID := range teamIDs {
+			if !scope.AllowsTeam(teamID) {
+				return false
+			}
+		}
+	}
+
 	// Check session permission, if it allows access, no need to check teams.
 	if a.SessionHasPermissionTo(session, permission) {
 		return true
@@ -82,6 +94,10 @@ func (a *App) SessionHasPermissionToChannel(c request.CTX, session model.Session
 		return false
 	}
 
+	if !a.sessionBotScopeAllowsChannel(c, session, channelID) {
+		return false
+	}
+
 	ids, err := a.Srv().Store().Channel().GetAllChannelMembersForUser(session.UserId, true, true)
 	var channelRoles []string
 	if err == nil {
@@ -121,6 +137,12 @@ func (a *App) SessionHasPermissionToChannels(c request.CTX, session model.Sessio
 		}
 	}
 
+	for _, channelID := range channelIDs {
+		if !a.sessionBotScopeAllowsChannel(c, session, channelID) {
+			return false
+		}
+	}
+
 	// if System Roles (ie. Admin, TeamAdmin) allow permissions
 	// if so, no reason to check team
 	if a.SessionHasPermissionTo(session, permission) {
@@ -151,6 +173,22 @@ func (a *App) SessionHasPermissionToChannels(c request.CTX, session model.Sessio
 	return true
 }
 
+// sessionBotScopeAllowsChannel returns whether the channel is in the scope of the bot authenticated by
+// the session, always allowing sessions without a scope.
+func (a *App) sessionBotScopeAllowsChannel(c request.CTX, session model.Session, channelID string) bool {
+	scope := session.GetBotScope()
+	if scope == nil {
+		return true
+	}
+
+	channel, appErr := a.GetChannel(c, channelID)
+	if appErr != nil {
+		return false
+	}
+
+	return scope.AllowsChannel(channel, session.UserId)
+}
+
 func (a *App) SessionHasPermissionToGroup(session model.Session, groupID string, permission *model.Permission) bool {
 	groupMember, err := a.Srv().Store().Group().GetMember(groupID, session.UserId)
 	// don't reject immediately on ErrNoRows error because there's further authz logic below for non-groupmembers
@@ -175,6 +213,13 @@ func (a *App) SessionHasPermissionToChannelByPost(session model.Session, postID
 		return false
 	}
 
+	if scope := session.GetBotScope(); scope != nil {
+		channel, err := a.Srv().Store().Channel().GetForPost(postID)
+		if err != nil || !scope.AllowsChannel(channel, session.UserId) {
+			return false
+		}
+	}
+
 	if channelMember, err := a.Srv().Store().Channel().GetMemberForPost(postID, session.UserId, *a.Config().TeamSettings.ExperimentalViewArchivedChannels); err == nil {
 		if a.RolesGrantPermission(channelMember.GetRoles(), permission.Id) {
 			return true
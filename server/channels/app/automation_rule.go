@@ -0,0 +1,374 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/plugin"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/audit"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+)
+
+const (
+	automationRuleCacheSize = 10000
+	// automationRuleCacheExpiry bounds how long other cluster nodes keep running a rule after
+	// it was changed, since the cache is only invalidated on the node handling the change.
+	automationRuleCacheExpiry = time.Minute
+)
+
+func automationRuleCacheKey(teamID, triggerType string) string {
+	return teamID + ":" + triggerType
+}
+
+func (a *App) CreateAutomationRule(c request.CTX, rule *model.AutomationRule) (*model.AutomationRule, *model.AppError) {
+	if !*a.Config().ServiceSettings.EnableAutomationRules {
+		return nil, model.NewAppError("CreateAutomationRule", "app.automation_rule.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	rule.Id = ""
+	if appErr := a.validateAutomationRuleChannels(c, rule); appErr != nil {
+		return nil, appErr
+	}
+
+	savedRule, err := a.Srv().Store().AutomationRule().Save(rule)
+	if err != nil {
+		var appErr *model.AppError
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		default:
+			return nil, model.NewAppError("CreateAutomationRule", "app.automation_rule.save.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	a.invalidateAutomationRuleCache(savedRule)
+
+	return savedRule, nil
+}
+
+func (a *App) GetAutomationRule(ruleID string) (*model.AutomationRule, *model.AppError) {
+	if !*a.Config().ServiceSettings.EnableAutomationRules {
+		return nil, model.NewAppError("GetAutomationRule", "app.automation_rule.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	rule, err := a.Srv().Store().AutomationRule().Get(ruleID)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("GetAutomationRule", "app.automation_rule.get.app_error", nil, "", http.StatusNotFound).Wrap(err)
+		default:
+			return nil, model.NewAppError("GetAutomationRule", "app.automation_rule.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	return rule, nil
+}
+
+func (a *App) GetAutomationRulesForTeam(teamID string, page, perPage int) ([]*model.AutomationRule, *model.AppError) {
+	if !*a.Config().ServiceSettings.EnableAutomationRules {
+		return nil, model.NewAppError("GetAutomationRulesForTeam", "app.automation_rule.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	rules, err := a.Srv().Store().AutomationRule().GetForTeam(teamID, page, perPage)
+	if err != nil {
+		return nil, model.NewAppError("GetAutomationRulesForTeam", "app.automation_rule.get_for_team.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return rules, nil
+}
+
+func (a *App) UpdateAutomationRule(c request.CTX, oldRule, updatedRule *model.AutomationRule) (*model.AutomationRule, *model.AppError) {
+	if !*a.Config().ServiceSettings.EnableAutomationRules {
+		return nil, model.NewAppError("UpdateAutomationRule", "app.automation_rule.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	updatedRule.Id = oldRule.Id
+	updatedRule.TeamId = oldRule.TeamId
+	updatedRule.CreatorId = oldRule.CreatorId
+	updatedRule.CreateAt = oldRule.CreateAt
+	updatedRule.DeleteAt = oldRule.DeleteAt
+
+	if appErr := a.validateAutomationRuleChannels(c, updatedRule); appErr != nil {
+		return nil, appErr
+	}
+
+	rule, err := a.Srv().Store().AutomationRule().Update(updatedRule)
+	if err != nil {
+		var appErr *model.AppError
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("UpdateAutomationRule", "app.automation_rule.get.app_error", nil, "", http.StatusNotFound).Wrap(err)
+		default:
+			return nil, model.NewAppError("UpdateAutomationRule", "app.automation_rule.update.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	a.invalidateAutomationRuleCache(oldRule)
+	a.invalidateAutomationRuleCache(rule)
+
+	return rule, nil
+}
+
+func (a *App) DeleteAutomationRule(rule *model.AutomationRule) *model.AppError {
+	if !*a.Config().ServiceSettings.EnableAutomationRules {
+		return model.NewAppError("DeleteAutomationRule", "app.automation_rule.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	if err := a.Srv().Store().AutomationRule().Delete(rule.Id, model.GetMillis()); err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return model.NewAppError("DeleteAutomationRule", "app.automation_rule.get.app_error", nil, "", http.StatusNotFound).Wrap(err)
+		default:
+			return model.NewAppError("DeleteAutomationRule", "app.automation_rule.delete.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	a.invalidateAutomationRuleCache(rule)
+
+	return nil
+}
+
+// validateAutomationRuleChannels checks that the channels referenced by the rule belong to its team.
+func (a *App) validateAutomationRuleChannels(c request.CTX, rule *model.AutomationRule) *model.AppError {
+	for _, channelID := range rule.ChannelIds() {
+		channel, appErr := a.GetChannel(c, channelID)
+		if appErr != nil {
+			return appErr
+		}
+
+		if channel.TeamId != rule.TeamId {
+			return model.NewAppError("validateAutomationRuleChannels", "app.automation_rule.channel.app_error", nil, "channel_id="+channelID, http.StatusBadRequest)
+		}
+	}
+
+	return nil
+}
+
+func (a *App) invalidateAutomationRuleCache(rule *model.AutomationRule) {
+	if err := a.Srv().automationRuleCache.Remove(automationRuleCacheKey(rule.TeamId, rule.Trigger.Type)); err != nil {
+		a.Log().Warn("Failed to invalidate the automation rule cache", mlog.String("team_id", rule.TeamId), mlog.Err(err))
+	}
+}
+
+func (a *App) getEnabledAutomationRules(teamID, triggerType string) ([]*model.AutomationRule, error) {
+	key := automationRuleCacheKey(teamID, triggerType)
+
+	var rules []*model.AutomationRule
+	if err := a.Srv().automationRuleCache.Get(key, &rules); err == nil {
+		return rules, nil
+	}
+
+	rules, err := a.Srv().Store().AutomationRule().GetEnabledForTrigger(teamID, triggerType)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.Srv().automationRuleCache.SetWithDefaultExpiry(key, rules); err != nil {
+		a.Log().Warn("Failed to cache automation rules", mlog.String("team_id", teamID), mlog.Err(err))
+	}
+
+	return rules, nil
+}
+
+// triggerAutomationRules runs, in the background, the rules of the team matching the event.
+func (a *App) triggerAutomationRules(c request.CTX, event *model.AutomationEvent) {
+	if !*a.Config().ServiceSettings.EnableAutomationRules || event.TeamId == "" {
+		return
+	}
+
+	a.Srv().Go(func() {
+		rules, err := a.getEnabledAutomationRules(event.TeamId, event.Type)
+		if err != nil {
+			c.Logger().Warn("Failed to get the automation rules", mlog.String("team_id", event.TeamId), mlog.Err(err))
+			return
+		}
+
+		for _, rule := range rules {
+			if rule.Trigger.Matches(event) {
+				a.runAutomationRule(c, rule, *event)
+			}
+		}
+	})
+}
+
+func (a *App) runAutomationRule(c request.CTX, rule *model.AutomationRule, event model.AutomationEvent) {
+	event.RuleId = rule.Id
+
+	auditRec := a.MakeAuditRecord(c, "runAutomationRule", audit.Fail)
+	defer a.LogAuditRec(c, auditRec, nil)
+	audit.AddEventParameterAuditable(auditRec, "automation_rule", rule)
+	audit.AddEventParameter(auditRec, "channel_id", event.ChannelId)
+	audit.AddEventParameter(auditRec, "user_id", event.UserId)
+
+	failed := false
+	for i, action := range rule.Actions {
+		if err := a.runAutomationAction(c, rule, action, &event); err != nil {
+			failed = true
+			auditRec.AddMeta(fmt.Sprintf("action_%d_error", i), err.Error())
+			c.Logger().Warn("Failed to run automation rule action",
+				mlog.String("rule_id", rule.Id),
+				mlog.String("action", action.Type),
+				mlog.Err(err),
+			)
+		}
+	}
+
+	if !failed {
+		auditRec.Success()
+	}
+}
+
+func (a *App) runAutomationAction(c request.CTX, rule *model.AutomationRule, action *model.AutomationAction, event *model.AutomationEvent) error {
+	switch action.Type {
+	case model.AutomationActionPostMessage:
+		return a.runAutomationPostMessage(c, rule, action, event)
+	case model.AutomationActionAddToChannel:
+		return a.runAutomationAddToChannel(c, action, event)
+	case model.AutomationActionCallWebhook:
+		return a.runAutomationCallWebhook(action, event)
+	case model.AutomationActionCreatePlaybookRun:
+		return a.runAutomationCreatePlaybookRun(rule, action, event)
+	}
+
+	return errors.Errorf("unknown action type %q", action.Type)
+}
+
+func (a *App) runAutomationPostMessage(c request.CTX, rule *model.AutomationRule, action *model.AutomationAction, event *model.AutomationEvent) error {
+	channelID := action.ChannelId
+	if channelID == "" {
+		channelID = event.ChannelId
+	}
+
+	channel, appErr := a.GetChannel(c, channelID)
+	if appErr != nil {
+		return appErr
+	}
+	if channel.TeamId != rule.TeamId {
+		return errors.New("the channel is not in the team of the rule")
+	}
+
+	bot, appErr := a.GetSystemBot(c)
+	if appErr != nil {
+		return appErr
+	}
+
+	post := &model.Post{
+		ChannelId: channel.Id,
+		UserId:    bot.UserId,
+		Message:   action.Message,
+	}
+	post.AddProp(model.PostPropsFromAutomation, rule.Id)
+
+	if _, appErr := a.CreatePost(c, post, channel, false, false); appErr != nil {
+		return appErr
+	}
+
+	return nil
+}
+
+func (a *App) runAutomationAddToChannel(c request.CTX, action *model.AutomationAction, event *model.AutomationEvent) error {
+	channel, appErr := a.GetChannel(c, action.ChannelId)
+	if appErr != nil {
+		return appErr
+	}
+	if channel.TeamId != event.TeamId {
+		return errors.New("the channel is not in the team of the rule")
+	}
+
+	if _, appErr := a.AddChannelMember(c, event.UserId, channel, ChannelMemberOpts{}); appErr != nil {
+		return appErr
+	}
+
+	return nil
+}
+
+func (a *App) runAutomationCallWebhook(action *model.AutomationAction, event *model.AutomationEvent) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(*a.Config().ServiceSettings.OutgoingIntegrationRequestsTimeout)*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, action.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := a.Srv().outgoingWebhookClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, MaxIntegrationResponseSize))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return errors.Errorf("the webhook responded with status %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+func (a *App) runAutomationCreatePlaybookRun(rule *model.AutomationRule, action *model.AutomationAction, event *model.AutomationEvent) error {
+	hooks, err := a.ch.HooksForPlugin(model.PluginIdPlaybooks)
+	if err != nil {
+		return errors.Wrap(err, "the playbooks plugin is not running")
+	}
+
+	name := action.Message
+	if name == "" {
+		name = rule.DisplayName
+	}
+	channelID := action.ChannelId
+	if channelID == "" {
+		channelID = event.ChannelId
+	}
+
+	body, err := json.Marshal(map[string]string{
+		"name":          name,
+		"owner_user_id": rule.CreatorId,
+		"team_id":       rule.TeamId,
+		"playbook_id":   action.PlaybookId,
+		"channel_id":    channelID,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, "/api/v0/runs", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	// The run is created on behalf of the creator of the rule, with their permissions.
+	req.Header.Set("Mattermost-User-Id", rule.CreatorId)
+
+	w := &LocalResponseWriter{}
+	hooks.ServeHTTP(&plugin.Context{RequestId: model.NewId()}, w, req)
+
+	if w.status != 0 && (w.status < 200 || w.status >= 300) {
+		return errors.Errorf("the playbooks plugin responded with status %d: %s", w.status, w.data)
+	}
+
+	return nil
+}
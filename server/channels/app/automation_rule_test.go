@@ -0,0 +1,194 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+func TestCreateAutomationRule(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	newRule := func() *model.AutomationRule {
+		return &model.AutomationRule{
+			TeamId:      th.BasicTeam.Id,
+			CreatorId:   th.BasicUser.Id,
+			DisplayName: "Welcome",
+			Enabled:     true,
+			Trigger:     model.AutomationTrigger{Type: model.AutomationTriggerUserJoinedChannel},
+			Actions: model.AutomationActions{
+				{Type: model.AutomationActionPostMessage, Message: "Welcome!", ChannelId: th.BasicChannel.Id},
+			},
+		}
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableAutomationRules = false })
+
+		_, appErr := th.App.CreateAutomationRule(th.Context, newRule())
+		require.NotNil(t, appErr)
+		assert.Equal(t, http.StatusNotImplemented, appErr.StatusCode)
+	})
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableAutomationRules = true })
+
+	t.Run("channel of another team", func(t *testing.T) {
+		otherTeam := th.CreateTeam()
+		rule := newRule()
+		rule.Actions[0].ChannelId = th.CreateChannel(th.Context, otherTeam).Id
+
+		_, appErr := th.App.CreateAutomationRule(th.Context, rule)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.automation_rule.channel.app_error", appErr.Id)
+	})
+
+	t.Run("create, update and delete", func(t *testing.T) {
+		rule, appErr := th.App.CreateAutomationRule(th.Context, newRule())
+		require.Nil(t, appErr)
+
+		updated := newRule()
+		updated.DisplayName = "Greetings"
+		updated.Enabled = false
+		rule, appErr = th.App.UpdateAutomationRule(th.Context, rule, updated)
+		require.Nil(t, appErr)
+		assert.Equal(t, "Greetings", rule.DisplayName)
+
+		rules, appErr := th.App.GetAutomationRulesForTeam(th.BasicTeam.Id, 0, 10)
+		require.Nil(t, appErr)
+		require.Len(t, rules, 1)
+
+		require.Nil(t, th.App.DeleteAutomationRule(rule))
+
+		_, appErr = th.App.GetAutomationRule(rule.Id)
+		require.NotNil(t, appErr)
+		assert.Equal(t, http.StatusNotFound, appErr.StatusCode)
+	})
+}
+
+func TestRunAutomationRules(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.ServiceSettings.EnableAutomationRules = true
+		*cfg.ServiceSettings.AllowedUntrustedInternalConnections = "localhost,127.0.0.1"
+	})
+
+	t.Run("keyword posted runs post_message and call_webhook", func(t *testing.T) {
+		events := make(chan *model.AutomationEvent, 10)
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var event model.AutomationEvent
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&event))
+			events <- &event
+		}))
+		defer ts.Close()
+
+		channel := th.CreateChannel(th.Context, th.BasicTeam)
+		rule, appErr := th.App.CreateAutomationRule(th.Context, &model.AutomationRule{
+			TeamId:      th.BasicTeam.Id,
+			CreatorId:   th.BasicUser.Id,
+			DisplayName: "Outages",
+			Enabled:     true,
+			Trigger: model.AutomationTrigger{
+				Type:      model.AutomationTriggerKeywordPosted,
+				ChannelId: channel.Id,
+				Keywords:  []string{"outage"},
+			},
+			Actions: model.AutomationActions{
+				{Type: model.AutomationActionPostMessage, Message: "An outage was reported, paging the on-call."},
+				{Type: model.AutomationActionCallWebhook, URL: ts.URL},
+			},
+		})
+		require.Nil(t, appErr)
+
+		post, appErr := th.App.CreatePostAsUser(th.Context, &model.Post{
+			ChannelId: channel.Id,
+			UserId:    th.BasicUser.Id,
+			Message:   "There is an outage in production",
+		}, "", true)
+		require.Nil(t, appErr)
+
+		select {
+		case event := <-events:
+			assert.Equal(t, rule.Id, event.RuleId)
+			assert.Equal(t, post.Id, event.PostId)
+			assert.Equal(t, th.BasicUser.Id, event.UserId)
+		case <-time.After(5 * time.Second):
+			require.Fail(t, "the webhook was not called")
+		}
+
+		require.Eventually(t, func() bool {
+			posts, err := th.App.GetPostsPage(model.GetPostsOptions{ChannelId: channel.Id, PerPage: 10})
+			require.Nil(t, err)
+			for _, p := range posts.Posts {
+				if p.GetProp(model.PostPropsFromAutomation) == rule.Id {
+					return true
+				}
+			}
+			return false
+		}, 5*time.Second, 100*time.Millisecond)
+
+		// The message posted by the rule mentions the keyword, but doesn't run the rule again.
+		time.Sleep(500 * time.Millisecond)
+		assert.Empty(t, events)
+	})
+
+	t.Run("user joined channel runs add_to_channel", func(t *testing.T) {
+		lobby := th.CreateChannel(th.Context, th.BasicTeam)
+		announcements := th.CreateChannel(th.Context, th.BasicTeam)
+
+		_, appErr := th.App.CreateAutomationRule(th.Context, &model.AutomationRule{
+			TeamId:      th.BasicTeam.Id,
+			CreatorId:   th.BasicUser.Id,
+			DisplayName: "Announcements",
+			Enabled:     true,
+			Trigger:     model.AutomationTrigger{Type: model.AutomationTriggerUserJoinedChannel, ChannelId: lobby.Id},
+			Actions: model.AutomationActions{
+				{Type: model.AutomationActionAddToChannel, ChannelId: announcements.Id},
+			},
+		})
+		require.Nil(t, appErr)
+
+		_, appErr = th.App.AddChannelMember(th.Context, th.BasicUser2.Id, lobby, ChannelMemberOpts{})
+		require.Nil(t, appErr)
+
+		require.Eventually(t, func() bool {
+			_, err := th.App.GetChannelMember(th.Context, announcements.Id, th.BasicUser2.Id)
+			return err == nil
+		}, 5*time.Second, 100*time.Millisecond)
+	})
+
+	t.Run("disabled rules don't run", func(t *testing.T) {
+		lobby := th.CreateChannel(th.Context, th.BasicTeam)
+		announcements := th.CreateChannel(th.Context, th.BasicTeam)
+
+		_, appErr := th.App.CreateAutomationRule(th.Context, &model.AutomationRule{
+			TeamId:      th.BasicTeam.Id,
+			CreatorId:   th.BasicUser.Id,
+			DisplayName: "Announcements",
+			Trigger:     model.AutomationTrigger{Type: model.AutomationTriggerUserJoinedChannel, ChannelId: lobby.Id},
+			Actions: model.AutomationActions{
+				{Type: model.AutomationActionAddToChannel, ChannelId: announcements.Id},
+			},
+		})
+		require.Nil(t, appErr)
+
+		_, appErr = th.App.AddChannelMember(th.Context, th.BasicUser2.Id, lobby, ChannelMemberOpts{})
+		require.Nil(t, appErr)
+
+		time.Sleep(500 * time.Millisecond)
+		_, err := th.App.GetChannelMember(th.Context, announcements.Id, th.BasicUser2.Id)
+		require.NotNil(t, err)
+	})
+}
@@ -453,6 +453,63 @@ func (a *App) UpdateBotOwner(rctx request.CTX, botUserId, newOwnerId string) (*m
 	return bot, nil
 }
 
+// UpdateBotScope restricts a bot to the given teams and channels, or lifts the restriction when scope is nil.
+// The sessions of the bot are revoked so that its access tokens pick up the new scope.
+func (a *App) UpdateBotScope(rctx request.CTX, botUserId string, scope *model.BotScope) (*model.Bot, *model.AppError) {
+	if scope != nil {
+		if appErr := scope.IsValid(); appErr != nil {
+			return nil, appErr
+		}
+
+		if len(scope.TeamIds) > 0 {
+			teams, appErr := a.GetTeams(scope.TeamIds)
+			if appErr != nil && appErr.StatusCode != http.StatusNotFound {
+				return nil, appErr
+			}
+			if len(teams) != len(scope.TeamIds) {
+				return nil, model.NewAppError("UpdateBotScope", "app.bot.scope.team_not_found.app_error", nil, "", http.StatusBadRequest)
+			}
+		}
+
+		if len(scope.ChannelIds) > 0 {
+			channels, appErr := a.GetChannels(rctx, scope.ChannelIds)
+			if appErr != nil && appErr.StatusCode != http.StatusNotFound {
+				return nil, appErr
+			}
+			if len(channels) != len(scope.ChannelIds) {
+				return nil, model.NewAppError("UpdateBotScope", "app.bot.scope.channel_not_found.app_error", nil, "", http.StatusBadRequest)
+			}
+		}
+	}
+
+	bot, appErr := a.GetBot(rctx, botUserId, true)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	bot.Scope = scope
+
+	bot, err := a.Srv().Store().Bot().Update(bot)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		var appErr *model.AppError
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.MakeBotNotFoundError("SqlBotStore.Get", nfErr.ID).Wrap(err)
+		case errors.As(err, &appErr): // in case we haven't converted to plain error.
+			return nil, appErr
+		default: // last fallback in case it doesn't map to an existing app error.
+			return nil, model.NewAppError("UpdateBotScope", "app.bot.patchbot.internal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	if appErr := a.RevokeAllSessions(rctx, botUserId); appErr != nil {
+		return nil, appErr
+	}
+
+	return bot, nil
+}
+
 // disableUserBots disables all bots owned by the given user.
 func (a *App) disableUserBots(rctx request.CTX, userID string) *model.AppError {
 	perPage := 20
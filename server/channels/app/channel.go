@@ -25,6 +25,10 @@ import (
 
 const (
 	UpdateMultipleMaximum = 200
+
+	// channelMembersAddedEventMaxUsers caps the user ids carried by a single
+	// channel_members_added event.
+	channelMembersAddedEventMaxUsers = 200
 )
 
 // DefaultChannelNames returns the list of system-wide default channel names.
@@ -1173,6 +1177,9 @@ func (a *App) UpdateChannelMemberRoles(c request.CTX, channelID string, userID s
 			err.StatusCode = http.StatusBadRequest
 			return nil, err
 		}
+		if role.DeleteAt != 0 {
+			return nil, model.NewAppError("UpdateChannelMemberRoles", "api.channel.update_channel_member_roles.deleted_role.app_error", nil, "role_name="+roleName, http.StatusBadRequest)
+		}
 
 		if !role.SchemeManaged {
 			// The role is not scheme-managed, so it's OK to apply it to the explicit roles field.
@@ -1540,6 +1547,16 @@ func (a *App) addUserToChannel(c request.CTX, user *model.User, channel *model.C
 		}
 	}
 
+	if user.IsBot {
+		bot, appErr := a.GetBot(c, user.Id, true)
+		if appErr != nil {
+			return nil, appErr
+		}
+		if bot.Scope != nil && !bot.Scope.AllowsChannel(channel, bot.UserId) {
+			return nil, model.NewAppError("addUserToChannel", "app.bot.scope.channel.app_error", nil, "", http.StatusForbidden)
+		}
+	}
+
 	newMember := &model.ChannelMember{
 		ChannelId:   channel.Id,
 		UserId:      user.Id,
@@ -1575,6 +1592,10 @@ func (a *App) addUserToChannel(c request.CTX, user *model.User, channel *model.C
 
 // AddUserToChannel adds a user to a given channel.
 func (a *App) AddUserToChannel(c request.CTX, user *model.User, channel *model.Channel, skipTeamMemberIntegrityCheck bool) (*model.ChannelMember, *model.AppError) {
+	return a.addUserToChannelAndPublish(c, user, channel, skipTeamMemberIntegrityCheck, false)
+}
+
+func (a *App) addUserToChannelAndPublish(c request.CTX, user *model.User, channel *model.Channel, skipTeamMemberIntegrityCheck, skipChannelEvent bool) (*model.ChannelMember, *model.AppError) {
 	if !skipTeamMemberIntegrityCheck {
 		teamMember, nErr := a.Srv().Store().Team().GetMember(c, channel.TeamId, user.Id)
 		if nErr != nil {
@@ -1600,10 +1621,12 @@ func (a *App) AddUserToChannel(c request.CTX, user *model.User, channel *model.C
 	// We are sending separate websocket events to the user added and to the channel
 	// This is to get around potential cluster syncing issues where other nodes may not receive the most up to date channel members
 	// There is likely some issue syncing these that needs to be looked at, but this is the current fix.
-	message := model.NewWebSocketEvent(model.WebsocketEventUserAdded, "", channel.Id, "", map[string]bool{user.Id: true}, "")
-	message.Add("user_id", user.Id)
-	message.Add("team_id", channel.TeamId)
-	a.Publish(message)
+	if !skipChannelEvent {
+		message := model.NewWebSocketEvent(model.WebsocketEventUserAdded, "", channel.Id, "", map[string]bool{user.Id: true}, "")
+		message.Add("user_id", user.Id)
+		message.Add("team_id", channel.TeamId)
+		a.Publish(message)
+	}
 
 	userMessage := model.NewWebSocketEvent(model.WebsocketEventUserAdded, "", channel.Id, user.Id, nil, "")
 	userMessage.Add("user_id", user.Id)
@@ -1621,6 +1644,23 @@ type ChannelMemberOpts struct {
 	// This is useful to avoid in scenarios when we just added the team member,
 	// and thereby know that there is no need to check this.
 	SkipTeamMemberIntegrityCheck bool
+	// SkipChannelEvent suppresses the user_added event to the rest of the channel,
+	// for callers adding many members at once that publish a single
+	// channel_members_added event instead.
+	SkipChannelEvent bool
+}
+
+// publishChannelMembersAdded tells the members of a channel about users added to it in bulk,
+// splitting the user ids over as many events as needed to keep each payload bounded.
+func (a *App) publishChannelMembersAdded(channel *model.Channel, userIDs []string) {
+	for start := 0; start < len(userIDs); start += channelMembersAddedEventMaxUsers {
+		end := min(start+channelMembersAddedEventMaxUsers, len(userIDs))
+
+		message := model.NewWebSocketEvent(model.WebsocketEventChannelMembersAdded, "", channel.Id, "", nil, "")
+		message.Add("user_ids", userIDs[start:end])
+		message.Add("team_id", channel.TeamId)
+		a.Publish(message)
+	}
 }
 
 // AddChannelMember adds a user to a channel. It is a wrapper over AddUserToChannel.
@@ -1652,7 +1692,7 @@ func (a *App) AddChannelMember(c request.CTX, userID string, channel *model.Chan
 		}
 	}
 
-	cm, err := a.AddUserToChannel(c, user, channel, opts.SkipTeamMemberIntegrityCheck)
+	cm, err := a.addUserToChannelAndPublish(c, user, channel, opts.SkipTeamMemberIntegrityCheck, opts.SkipChannelEvent)
 	if err != nil {
 		return nil, err
 	}
@@ -1665,6 +1705,13 @@ func (a *App) AddChannelMember(c request.CTX, userID string, channel *model.Chan
 		}, plugin.UserHasJoinedChannelID)
 	})
 
+	a.triggerAutomationRules(c, &model.AutomationEvent{
+		Type:      model.AutomationTriggerUserJoinedChannel,
+		TeamId:    channel.TeamId,
+		ChannelId: channel.Id,
+		UserId:    cm.UserId,
+	})
+
 	if opts.UserRequestorID == "" || userID == opts.UserRequestorID {
 		if err := a.postJoinChannelMessage(c, user, channel); err != nil {
 			return nil, err
@@ -1957,6 +2004,12 @@ func (a *App) GetChannelsForUser(c request.CTX, userID string, includeDeleted bo
 	return list, nil
 }
 
+// GetChannelsForUserEtag returns the etag of the channels of the user in the team, or in all the teams when the
+// team is empty. It's computed without loading the channels.
+func (a *App) GetChannelsForUserEtag(userID, teamID string) string {
+	return a.Srv().Store().Channel().GetEtagForUserChannels(userID, teamID)
+}
+
 func (a *App) GetAllChannels(c request.CTX, page, perPage int, opts model.ChannelSearchOpts) (model.ChannelListWithTeamData, *model.AppError) {
 	if opts.ExcludeDefaultChannels {
 		opts.ExcludeChannelNames = a.DefaultChannelNames(c)
@@ -2092,6 +2145,21 @@ func (a *App) GetChannelMembersPage(c request.CTX, channelID string, page, perPa
 	return channelMembers, nil
 }
 
+// GetChannelMembersAfter returns the next page of a channel's members in user id
+// order, starting after opts.AfterUserId.
+func (a *App) GetChannelMembersAfter(c request.CTX, channelID string, opts *model.ChannelMembersGetOptions) (model.ChannelMembers, *model.AppError) {
+	if appErr := opts.IsValid(); appErr != nil {
+		return nil, appErr
+	}
+
+	channelMembers, err := a.Srv().Store().Channel().GetMembersAfter(channelID, opts)
+	if err != nil {
+		return nil, model.NewAppError("GetChannelMembersAfter", "app.channel.get_members.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return channelMembers, nil
+}
+
 func (a *App) GetChannelMembersTimezones(c request.CTX, channelID string) ([]string, *model.AppError) {
 	membersTimezones, err := a.Srv().Store().Channel().GetChannelMembersTimezones(channelID)
 	if err != nil {
@@ -2264,6 +2332,13 @@ func (a *App) JoinChannel(c request.CTX, channel *model.Channel, userID string)
 		}, plugin.UserHasJoinedChannelID)
 	})
 
+	a.triggerAutomationRules(c, &model.AutomationEvent{
+		Type:      model.AutomationTriggerUserJoinedChannel,
+		TeamId:    channel.TeamId,
+		ChannelId: channel.Id,
+		UserId:    cm.UserId,
+	})
+
 	if err := a.postJoinChannelMessage(c, user, channel); err != nil {
 		return err
 	}
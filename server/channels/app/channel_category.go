@@ -89,6 +89,17 @@ func (a *App) GetSidebarCategoryOrder(c request.CTX, userID, teamID string) ([]s
 	return categories, nil
 }
 
+// GetSidebarCategoryUnreads returns the unread messages and mentions of the user rolled up by
+// sidebar category, on all of their teams.
+func (a *App) GetSidebarCategoryUnreads(c request.CTX, userID string) ([]*model.SidebarCategoryUnread, *model.AppError) {
+	unreads, err := a.Srv().Store().Channel().GetSidebarCategoryUnreads(userID)
+	if err != nil {
+		return nil, model.NewAppError("GetSidebarCategoryUnreads", "app.channel.sidebar_category_unreads.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return unreads, nil
+}
+
 func (a *App) GetSidebarCategory(c request.CTX, categoryId string) (*model.SidebarCategoryWithChannels, *model.AppError) {
 	category, err := a.Srv().Store().Channel().GetSidebarCategory(categoryId)
 	if err != nil {
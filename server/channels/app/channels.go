@@ -76,16 +76,19 @@ type Channels struct {
 
 	postReminderMut  sync.Mutex
 	postReminderTask *model.ScheduledTask
+
+	typingAggregator *typingAggregator
 }
 
 func NewChannels(s *Server) (*Channels, error) {
 	ch := &Channels{
-		srv:             s,
-		imageProxy:      imageproxy.MakeImageProxy(s.platform, s.httpService, s.Log()),
-		uploadLockMap:   map[string]bool{},
-		filestore:       s.FileBackend(),
-		exportFilestore: s.ExportFileBackend(),
-		cfgSvc:          s.Platform(),
+		srv:              s,
+		imageProxy:       imageproxy.MakeImageProxy(s.platform, s.httpService, s.Log()),
+		uploadLockMap:    map[string]bool{},
+		filestore:        s.FileBackend(),
+		exportFilestore:  s.ExportFileBackend(),
+		cfgSvc:           s.Platform(),
+		typingAggregator: newTypingAggregator(),
 	}
 
 	// We are passing a partially filled Channels struct so that the enterprise
@@ -204,6 +207,8 @@ func (ch *Channels) Stop() error {
 	}
 	ch.dndTaskMut.Unlock()
 
+	ch.typingAggregator.stop()
+
 	return nil
 }
 
@@ -14,6 +14,7 @@ import (
 	"sync"
 	"time"
 	"unicode"
+	"unicode/utf8"
 
 	"github.com/mattermost/mattermost/server/public/model"
 	"github.com/mattermost/mattermost/server/public/shared/i18n"
@@ -481,10 +482,31 @@ func (a *App) tryExecuteCustomCommand(c request.CTX, args *model.CommandArgs, tr
 	return a.DoCommandRequest(c, cmd, p)
 }
 
-func (a *App) DoCommandRequest(rctx request.CTX, cmd *model.Command, p url.Values) (*model.Command, *model.CommandResponse, *model.AppError) {
+func (a *App) DoCommandRequest(rctx request.CTX, cmd *model.Command, p url.Values) (_ *model.Command, _ *model.CommandResponse, appErr *model.AppError) {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(*a.Config().ServiceSettings.OutgoingIntegrationRequestsTimeout)*time.Second)
 	defer cancel()
 
+	delivery := &model.IntegrationDelivery{
+		HookId:    cmd.Id,
+		Type:      model.IntegrationDeliveryTypeCommand,
+		URL:       cmd.URL,
+		Payload:   p.Encode(),
+		UserId:    p.Get("user_id"),
+		ChannelId: p.Get("channel_id"),
+	}
+	if cmd.Method != model.CommandMethodGet {
+		delivery.ContentType = "application/x-www-form-urlencoded"
+	}
+	defer func() {
+		if appErr != nil && delivery.Error == "" {
+			delivery.Error = appErr.Error()
+		}
+		// Recording the delivery shouldn't delay the response to the command.
+		a.Srv().Go(func() {
+			a.recordIntegrationDelivery(rctx, delivery)
+		})
+	}()
+
 	var accessToken *model.OutgoingOAuthConnectionToken
 
 	// Retrieve an access token from a connection if one exists to use for the webhook request
@@ -523,6 +545,11 @@ func (a *App) DoCommandRequest(rctx request.CTX, cmd *model.Command, p url.Value
 	}
 
 	req.Header.Set("Accept", "application/json")
+	if cmd.Method == model.CommandMethodGet {
+		signIntegrationRequest(req, cmd.SigningSecret, []byte(req.URL.RawQuery))
+	} else {
+		signIntegrationRequest(req, cmd.SigningSecret, []byte(p.Encode()))
+	}
 	if cmd.Token != "" {
 		req.Header.Set("Authorization", "Token "+cmd.Token)
 	}
@@ -535,7 +562,9 @@ func (a *App) DoCommandRequest(rctx request.CTX, cmd *model.Command, p url.Value
 		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 	}
 
+	start := time.Now()
 	resp, err := a.Srv().outgoingWebhookClient.Do(req)
+	delivery.Latency = time.Since(start).Milliseconds()
 	if err != nil {
 		if errors.Is(err, context.DeadlineExceeded) {
 			rctx.Logger().Info("Outgoing Command request timed out. Consider increasing ServiceSettings.OutgoingIntegrationRequestsTimeout.")
@@ -546,7 +575,13 @@ func (a *App) DoCommandRequest(rctx request.CTX, cmd *model.Command, p url.Value
 	defer resp.Body.Close()
 
 	// Handle the response
-	body := io.LimitReader(resp.Body, MaxIntegrationResponseSize)
+	delivery.StatusCode = resp.StatusCode
+	// Keep the beginning of the response for the delivery log while it's read.
+	responseStart := &limitedBuffer{limit: model.IntegrationDeliveryResponseMaxRunes * utf8.UTFMax}
+	body := io.TeeReader(io.LimitReader(resp.Body, MaxIntegrationResponseSize), responseStart)
+	defer func() {
+		delivery.Response = responseStart.String()
+	}()
 
 	if resp.StatusCode != http.StatusOK {
 		// Ignore the error below because the resulting string will just be the empty string if bodyBytes is nil
@@ -726,6 +761,8 @@ func (a *App) UpdateCommand(oldCmd, updatedCmd *model.Command) (*model.Command,
 	updatedCmd.Trigger = strings.ToLower(updatedCmd.Trigger)
 	updatedCmd.Id = oldCmd.Id
 	updatedCmd.Token = oldCmd.Token
+	updatedCmd.SigningSecret = oldCmd.SigningSecret
+	updatedCmd.AutocompleteData = oldCmd.AutocompleteData
 	updatedCmd.CreateAt = oldCmd.CreateAt
 	updatedCmd.UpdateAt = model.GetMillis()
 	updatedCmd.DeleteAt = oldCmd.DeleteAt
@@ -794,6 +831,56 @@ func (a *App) RegenCommandToken(cmd *model.Command) (*model.Command, *model.AppE
 	return command, nil
 }
 
+func (a *App) RegenCommandSigningSecret(cmd *model.Command) (*model.Command, *model.AppError) {
+	if !*a.Config().ServiceSettings.EnableCommands {
+		return nil, model.NewAppError("RegenCommandSigningSecret", "api.command.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	cmd.SigningSecret = model.NewIntegrationSigningSecret()
+
+	command, err := a.Srv().Store().Command().Update(cmd)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		var appErr *model.AppError
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("SqlCommandStore.Update", "store.sql_command.update.missing.app_error", map[string]any{"command_id": cmd.Id}, "", http.StatusNotFound).Wrap(err)
+		case errors.As(err, &appErr):
+			return nil, appErr
+		default:
+			return nil, model.NewAppError("RegenCommandSigningSecret", "app.command.regencommandtoken.internal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	return command, nil
+}
+
+// UpdateCommandAutocompleteData registers the autocomplete tree of a command, or removes it when
+// data is nil so that the command falls back to its AutoCompleteHint and AutoCompleteDesc.
+func (a *App) UpdateCommandAutocompleteData(cmd *model.Command, data *model.AutocompleteData) (*model.Command, *model.AppError) {
+	if !*a.Config().ServiceSettings.EnableCommands {
+		return nil, model.NewAppError("UpdateCommandAutocompleteData", "api.command.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	cmd.AutocompleteData = data
+
+	command, err := a.Srv().Store().Command().Update(cmd)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		var appErr *model.AppError
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("SqlCommandStore.Update", "store.sql_command.update.missing.app_error", map[string]any{"command_id": cmd.Id}, "", http.StatusNotFound).Wrap(err)
+		case errors.As(err, &appErr):
+			return nil, appErr
+		default:
+			return nil, model.NewAppError("UpdateCommandAutocompleteData", "app.command.updatecommand.internal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	return command, nil
+}
+
 func (a *App) DeleteCommand(commandID string) *model.AppError {
 	if !*a.Config().ServiceSettings.EnableCommands {
 		return model.NewAppError("DeleteCommand", "api.command.disabled.app_error", nil, "", http.StatusNotImplemented)
@@ -804,6 +891,10 @@ func (a *App) DeleteCommand(commandID string) *model.AppError {
 		return model.NewAppError("DeleteCommand", "app.command.deletecommand.internal_error", nil, "", http.StatusInternalServerError).Wrap(err)
 	}
 
+	if err := a.Srv().Store().IntegrationDelivery().PermanentDeleteByHook(commandID); err != nil {
+		a.Log().Warn("Failed to delete the integration deliveries of the command", mlog.String("command_id", commandID), mlog.Err(err))
+	}
+
 	return nil
 }
 
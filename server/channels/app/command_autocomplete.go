@@ -4,18 +4,25 @@
 package app
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"net/http"
 	"net/url"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/mattermost/mattermost/server/public/model"
 	"github.com/mattermost/mattermost/server/public/shared/mlog"
 	"github.com/mattermost/mattermost/server/public/shared/request"
 )
 
+const dynamicListCacheSize = 10000
+
 // AutocompleteDynamicArgProvider dynamically provides auto-completion args for built-in commands.
 type AutocompleteDynamicArgProvider interface {
 	GetAutoCompleteListItems(c request.CTX, a *App, commandArgs *model.CommandArgs, arg *model.AutocompleteArg, parsed, toBeParsed string) ([]model.AutocompleteListItem, error)
@@ -255,15 +262,6 @@ func (a *App) getDynamicListArgument(c request.CTX, commandArgs *model.CommandAr
 	params.Add("user_input", parsed+toBeParsed)
 	params.Add("parsed", parsed)
 
-	// Encode the information normally provided to a plugin slash command handler into the request parameters
-	// Encode PluginContext:
-	pluginContext := pluginContext(c)
-	params.Add("request_id", pluginContext.RequestId)
-	params.Add("session_id", pluginContext.SessionId)
-	params.Add("ip_address", pluginContext.IPAddress)
-	params.Add("accept_language", pluginContext.AcceptLanguage)
-	params.Add("user_agent", pluginContext.UserAgent)
-
 	// Encode CommandArgs:
 	params.Add("channel_id", commandArgs.ChannelId)
 	params.Add("team_id", commandArgs.TeamId)
@@ -271,19 +269,122 @@ func (a *App) getDynamicListArgument(c request.CTX, commandArgs *model.CommandAr
 	params.Add("user_id", commandArgs.UserId)
 	params.Add("site_url", commandArgs.SiteURL)
 
-	resp, err := a.doPluginRequest(c, "GET", dynamicArg.FetchURL, params, nil)
+	// The items may depend on everything sent to the integration but the request specific parameters below.
+	cacheKey := dynamicArg.FetchURL + "?" + params.Encode()
+
+	var listResponse model.AutocompleteDynamicListResponse
+	if err := a.Srv().dynamicListCache.Get(cacheKey, &listResponse); err != nil {
+		// Encode the information normally provided to a plugin slash command handler into the request parameters
+		// Encode PluginContext:
+		pluginContext := pluginContext(c)
+		params.Add("request_id", pluginContext.RequestId)
+		params.Add("session_id", pluginContext.SessionId)
+		params.Add("ip_address", pluginContext.IPAddress)
+		params.Add("accept_language", pluginContext.AcceptLanguage)
+		params.Add("user_agent", pluginContext.UserAgent)
+
+		fetched, err := a.fetchDynamicListArgument(c, commandArgs, dynamicArg.FetchURL, parsed, params)
+		if err != nil {
+			c.Logger().Error("Can't fetch dynamic list arguments for", mlog.String("url", dynamicArg.FetchURL), mlog.Err(err))
+			return false, parsed, toBeParsed, []model.AutocompleteSuggestion{}
+		}
+		listResponse = *fetched
+
+		if listResponse.CacheTTL > 0 {
+			ttl := min(listResponse.CacheTTL, model.AutocompleteDynamicListMaxCacheTTL)
+			if err := a.Srv().dynamicListCache.SetWithExpiry(cacheKey, listResponse, time.Duration(ttl)*time.Second); err != nil {
+				c.Logger().Warn("Failed to cache dynamic list arguments", mlog.String("url", dynamicArg.FetchURL), mlog.Err(err))
+			}
+		}
+	}
+
+	found, alreadyParsed, yetToBeParsed, suggestions = parseListItems(listResponse.Items, parsed, toBeParsed)
+	if found && listResponse.HasMore {
+		suggestions = append(suggestions, model.AutocompleteSuggestion{
+			Complete:    parsed + toBeParsed,
+			Description: c.T("app.command.autocomplete.has_more"),
+		})
+	}
+
+	return found, alreadyParsed, yetToBeParsed, suggestions
+}
+
+// fetchDynamicListArgument requests the items of a dynamic list argument. Plugins serve them
+// through their HTTP handler while other integrations are called at the URL they registered,
+// with the token and signature of their command.
+func (a *App) fetchDynamicListArgument(c request.CTX, commandArgs *model.CommandArgs, fetchURL, parsed string, params url.Values) (*model.AutocompleteDynamicListResponse, error) {
+	var resp *http.Response
+	if model.IsValidHTTPURL(fetchURL) {
+		var err error
+		resp, err = a.doDynamicListRequest(commandArgs.TeamId, strings.Fields(parsed)[0], fetchURL, params)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		var appErr *model.AppError
+		resp, appErr = a.doPluginRequest(c, "GET", fetchURL, params, nil)
+		if appErr != nil {
+			return nil, appErr
+		}
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, MaxIntegrationResponseSize))
+	if err != nil {
+		return nil, err
+	}
+
+	// Integrations may answer with a plain list of items, or with an object carrying cache and pagination hints.
+	var listResponse model.AutocompleteDynamicListResponse
+	if jsonErr := json.Unmarshal(body, &listResponse.Items); jsonErr != nil {
+		if jsonErr = json.Unmarshal(body, &listResponse); jsonErr != nil {
+			c.Logger().Warn("Failed to decode from JSON", mlog.Err(jsonErr))
+		}
+	}
+
+	return &listResponse, nil
+}
+
+func (a *App) doDynamicListRequest(teamID, trigger, fetchURL string, params url.Values) (*http.Response, error) {
+	cmd, err := a.Srv().Store().Command().GetByTrigger(teamID, trigger)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(*a.Config().ServiceSettings.OutgoingIntegrationRequestsTimeout)*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fetchURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	if req.URL.RawQuery != "" {
+		req.URL.RawQuery += "&"
+	}
+	req.URL.RawQuery += params.Encode()
 
+	req.Header.Set("Accept", "application/json")
+	signIntegrationRequest(req, cmd.SigningSecret, []byte(req.URL.RawQuery))
+	req.Header.Set("Authorization", "Token "+cmd.Token)
+
+	resp, err := a.Srv().outgoingWebhookClient.Do(req)
 	if err != nil {
-		c.Logger().Error("Can't fetch dynamic list arguments for", mlog.String("url", dynamicArg.FetchURL), mlog.Err(err))
-		return false, parsed, toBeParsed, []model.AutocompleteSuggestion{}
+		return nil, err
 	}
 
-	var listItems []model.AutocompleteListItem
-	if jsonErr := json.NewDecoder(resp.Body).Decode(&listItems); jsonErr != nil {
-		c.Logger().Warn("Failed to decode from JSON", mlog.Err(jsonErr))
+	// Read the body before the request context is cancelled.
+	body, err := io.ReadAll(io.LimitReader(resp.Body, MaxIntegrationResponseSize))
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
 	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
 
-	return parseListItems(listItems, parsed, toBeParsed)
+	return resp, nil
 }
 
 func parseListItems(items []model.AutocompleteListItem, parsed, toBeParsed string) (bool, string, string, []model.AutocompleteSuggestion) {
@@ -4,10 +4,15 @@
 package app
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/mattermost/mattermost/server/public/model"
 	"github.com/mattermost/mattermost/server/public/shared/i18n"
@@ -637,6 +642,73 @@ func TestDynamicListArgsForBuiltin(t *testing.T) {
 	})
 }
 
+func TestDynamicListArgsForCustomCommand(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.ServiceSettings.EnableCommands = true
+		*cfg.ServiceSettings.AllowedUntrustedInternalConnections = "localhost,127.0.0.1"
+	})
+
+	var requests atomic.Int32
+	var command *model.Command
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		assert.Equal(t, "Token "+command.Token, r.Header.Get("Authorization"))
+		assert.True(t, model.VerifyIntegrationSignature(command.SigningSecret, r.Header.Get(model.HeaderIntegrationTimestamp), []byte(r.URL.RawQuery), r.Header.Get(model.HeaderIntegrationSignature)))
+		assert.Equal(t, "projects --project ", r.URL.Query().Get("user_input"))
+		assert.Equal(t, th.BasicUser.Id, r.URL.Query().Get("user_id"))
+
+		w.Header().Set("Content-Type", "application/json")
+		err := json.NewEncoder(w).Encode(model.AutocompleteDynamicListResponse{
+			Items: []model.AutocompleteListItem{
+				{Item: "alpha", HelpText: "Project alpha"},
+				{Item: "beta", HelpText: "Project beta"},
+			},
+			CacheTTL: 60,
+			HasMore:  true,
+		})
+		require.NoError(t, err)
+	}))
+	defer ts.Close()
+
+	autocompleteData := model.NewAutocompleteData("projects", "[project]", "Pick a project")
+	autocompleteData.AddNamedDynamicListArgument("project", "The project", ts.URL+"/projects", true)
+
+	var appErr *model.AppError
+	command, appErr = th.App.CreateCommand(&model.Command{
+		CreatorId:        th.BasicUser.Id,
+		TeamId:           th.BasicTeam.Id,
+		URL:              ts.URL,
+		Method:           model.CommandMethodPost,
+		Trigger:          "projects",
+		AutoComplete:     true,
+		AutocompleteData: autocompleteData,
+	})
+	require.Nil(t, appErr)
+
+	commandArgs := &model.CommandArgs{
+		TeamId:  th.BasicTeam.Id,
+		UserId:  th.BasicUser.Id,
+		Command: "projects --project ",
+	}
+	commands, appErr := th.App.ListAutocompleteCommands(th.BasicTeam.Id, th.Context.T)
+	require.Nil(t, appErr)
+
+	for i := 0; i < 2; i++ {
+		suggestions := th.App.GetSuggestions(th.Context, commandArgs, commands, model.SystemUserRoleId)
+		require.Len(t, suggestions, 3)
+		assert.Equal(t, "alpha", suggestions[0].Suggestion)
+		assert.Equal(t, "beta", suggestions[1].Suggestion)
+		assert.Equal(t, "projects --project ", suggestions[2].Complete)
+		assert.Empty(t, suggestions[2].Suggestion)
+	}
+
+	// The second suggestions are served from the cache.
+	assert.Equal(t, int32(1), requests.Load())
+}
+
 type testCommandProvider struct {
 }
 
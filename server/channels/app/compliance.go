@@ -4,9 +4,13 @@
 package app
 
 import (
+	"archive/zip"
+	"encoding/csv"
 	"errors"
+	"io"
 	"net/http"
 	"os"
+	"path/filepath"
 
 	"github.com/mattermost/mattermost/server/public/model"
 	"github.com/mattermost/mattermost/server/public/shared/mlog"
@@ -34,6 +38,12 @@ func (a *App) SaveComplianceReport(rctx request.CTX, job *model.Compliance) (*mo
 
 	job.Type = model.ComplianceTypeAdhoc
 
+	if job.Incremental {
+		if appErr := a.setComplianceIncrementalStart(job); appErr != nil {
+			return nil, appErr
+		}
+	}
+
 	rctx = rctx.WithLogger(rctx.Logger().With(job.LoggerFields()...))
 
 	job, err := a.Srv().Store().Compliance().Save(job)
@@ -49,7 +59,12 @@ func (a *App) SaveComplianceReport(rctx request.CTX, job *model.Compliance) (*mo
 
 	jCopy := job.DeepCopy()
 	a.Srv().Go(func() {
-		err := a.Compliance().RunComplianceJob(rctx, jCopy)
+		var err *model.AppError
+		if jCopy.Incremental {
+			err = a.RunIncrementalComplianceJob(rctx, jCopy)
+		} else {
+			err = a.Compliance().RunComplianceJob(rctx, jCopy)
+		}
 		if err != nil {
 			rctx.Logger().Warn("Error running compliance job", mlog.Err(err))
 		}
@@ -84,3 +99,192 @@ func (a *App) GetComplianceFile(job *model.Compliance) ([]byte, *model.AppError)
 	}
 	return f, nil
 }
+
+// setComplianceIncrementalStart makes an incremental export start where the last successful
+// incremental export ended. The first incremental export uses the requested start time.
+func (a *App) setComplianceIncrementalStart(job *model.Compliance) *model.AppError {
+	last, err := a.Srv().Store().Compliance().GetLastSuccessfulIncremental()
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		if errors.As(err, &nfErr) {
+			return nil
+		}
+		return model.NewAppError("SaveComplianceReport", "app.compliance.get.finding.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	job.StartAt = last.EndAt
+	if job.EndAt == 0 {
+		job.EndAt = model.GetMillis()
+	}
+
+	return nil
+}
+
+// ExportCompliancePosts streams the posts of a compliance export in batches of batchSize,
+// ordered by UpdateAt. The position of the export is checkpointed after each batch has been
+// handled, so an interrupted export resumes from the last checkpoint instead of starting over.
+func (a *App) ExportCompliancePosts(rctx request.CTX, job *model.Compliance, batchSize int, handleBatch func(posts []*model.CompliancePost) error) *model.AppError {
+	cursor := job.Cursor()
+	for {
+		posts, nextCursor, err := a.Srv().Store().Compliance().ComplianceExportIncremental(job, cursor, batchSize)
+		if err != nil {
+			return model.NewAppError("ExportCompliancePosts", "app.compliance.export.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+
+		if len(posts) == 0 {
+			return nil
+		}
+
+		if err := handleBatch(posts); err != nil {
+			return model.NewAppError("ExportCompliancePosts", "app.compliance.export.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+
+		cursor = nextCursor
+		job.SetCursor(cursor)
+		job.Count += len(posts)
+		if _, err := a.Srv().Store().Compliance().Update(job); err != nil {
+			return model.NewAppError("ExportCompliancePosts", "app.compliance.save.saving.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+
+		rctx.Logger().Debug("Compliance export checkpoint", mlog.Int("count", job.Count), mlog.Millis("last_post_update_at", cursor.LastPostUpdateAt))
+
+		if len(posts) < batchSize {
+			return nil
+		}
+	}
+}
+
+// complianceIncrementalExportBatchSize is the number of posts written to an incremental
+// compliance report between two checkpoints.
+const complianceIncrementalExportBatchSize = 1000
+
+// RunIncrementalComplianceJob writes the report of an incremental compliance export. The posts
+// are streamed with ExportCompliancePosts into a CSV file, which is synced before each checkpoint,
+// so a job interrupted by a restart appends to the file from its last checkpoint. The finished
+// report is zipped where GetComplianceFile reads it.
+func (a *App) RunIncrementalComplianceJob(rctx request.CTX, job *model.Compliance) *model.AppError {
+	dir := filepath.Join(*a.Config().ComplianceSettings.Directory, "compliance")
+	csvPath := filepath.Join(dir, job.JobName()+".csv")
+
+	appErr := a.writeIncrementalComplianceCSV(rctx, job, dir, csvPath)
+	if appErr == nil {
+		appErr = zipComplianceReport(csvPath, filepath.Join(dir, job.JobName()+".zip"), job.JobName()+".csv")
+	}
+
+	job.Status = model.ComplianceStatusFinished
+	if appErr != nil {
+		job.Status = model.ComplianceStatusFailed
+	}
+	if _, err := a.Srv().Store().Compliance().Update(job); err != nil {
+		rctx.Logger().Warn("Failed to update the compliance job status", mlog.String("compliance_id", job.Id), mlog.Err(err))
+	}
+	if appErr != nil {
+		return appErr
+	}
+
+	if err := os.Remove(csvPath); err != nil {
+		rctx.Logger().Warn("Failed to remove the compliance report file", mlog.String("path", csvPath), mlog.Err(err))
+	}
+
+	return nil
+}
+
+func (a *App) writeIncrementalComplianceCSV(rctx request.CTX, job *model.Compliance, dir, csvPath string) *model.AppError {
+	if err := os.MkdirAll(dir, 0750); err != nil {
+		return model.NewAppError("RunIncrementalComplianceJob", "app.compliance.export.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	resuming := job.Cursor() != model.ComplianceIncrementalExportCursor{}
+	flags := os.O_CREATE | os.O_WRONLY | os.O_TRUNC
+	if resuming {
+		flags = os.O_CREATE | os.O_WRONLY | os.O_APPEND
+	}
+	file, err := os.OpenFile(csvPath, flags, 0600)
+	if err != nil {
+		return model.NewAppError("RunIncrementalComplianceJob", "app.compliance.export.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	defer file.Close()
+
+	writer := csv.NewWriter(file)
+	writeRows := func(rows ...[]string) error {
+		if err := writer.WriteAll(rows); err != nil {
+			return err
+		}
+		return file.Sync()
+	}
+
+	if !resuming {
+		if err := writeRows(model.CompliancePostHeader()); err != nil {
+			return model.NewAppError("RunIncrementalComplianceJob", "app.compliance.export.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	job.Status = model.ComplianceStatusRunning
+	if _, err := a.Srv().Store().Compliance().Update(job); err != nil {
+		return model.NewAppError("RunIncrementalComplianceJob", "app.compliance.save.saving.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return a.ExportCompliancePosts(rctx, job, complianceIncrementalExportBatchSize, func(posts []*model.CompliancePost) error {
+		rows := make([][]string, 0, len(posts))
+		for _, post := range posts {
+			rows = append(rows, post.Row())
+		}
+		return writeRows(rows...)
+	})
+}
+
+func zipComplianceReport(csvPath, zipPath, name string) *model.AppError {
+	csvFile, err := os.Open(csvPath)
+	if err != nil {
+		return model.NewAppError("RunIncrementalComplianceJob", "app.compliance.export.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	defer csvFile.Close()
+
+	zipFile, err := os.Create(zipPath)
+	if err != nil {
+		return model.NewAppError("RunIncrementalComplianceJob", "app.compliance.export.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	defer zipFile.Close()
+
+	zipWriter := zip.NewWriter(zipFile)
+	entry, err := zipWriter.Create(name)
+	if err == nil {
+		_, err = io.Copy(entry, csvFile)
+	}
+	if err == nil {
+		err = zipWriter.Close()
+	}
+	if err != nil {
+		return model.NewAppError("RunIncrementalComplianceJob", "app.compliance.export.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return nil
+}
+
+// ResumeIncrementalComplianceJobs restarts the incremental compliance exports that were
+// interrupted while running, from their last checkpoint.
+func (a *App) ResumeIncrementalComplianceJobs(rctx request.CTX) {
+	const perPage = 100
+	for page := 0; ; page++ {
+		compliances, err := a.Srv().Store().Compliance().GetAll(page*perPage, perPage)
+		if err != nil {
+			rctx.Logger().Warn("Failed to get the compliance jobs to resume", mlog.Err(err))
+			return
+		}
+
+		for i := range compliances {
+			job := compliances[i]
+			if !job.Incremental || job.Status != model.ComplianceStatusRunning {
+				continue
+			}
+			rctx.Logger().Info("Resuming incremental compliance export", job.LoggerFields()...)
+			if appErr := a.RunIncrementalComplianceJob(rctx, &job); appErr != nil {
+				rctx.Logger().Warn("Error running compliance job", mlog.Err(appErr))
+			}
+		}
+
+		if len(compliances) < perPage {
+			return
+		}
+	}
+}
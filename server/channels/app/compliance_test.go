@@ -0,0 +1,137 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"archive/zip"
+	"encoding/csv"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+func TestExportCompliancePosts(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	startAt := model.GetMillis()
+	for i := 0; i < 5; i++ {
+		th.CreatePost(th.BasicChannel)
+	}
+
+	newJob := func() *model.Compliance {
+		job, err := th.App.Srv().Store().Compliance().Save(&model.Compliance{
+			Desc:    "test",
+			UserId:  th.SystemAdminUser.Id,
+			Type:    model.ComplianceTypeAdhoc,
+			StartAt: startAt,
+			EndAt:   model.GetMillis() + 1,
+			Emails:  th.BasicUser.Email,
+		})
+		require.NoError(t, err)
+		return job
+	}
+
+	t.Run("streams all posts in batches", func(t *testing.T) {
+		job := newJob()
+
+		var batches int
+		var postIDs []string
+		appErr := th.App.ExportCompliancePosts(th.Context, job, 2, func(posts []*model.CompliancePost) error {
+			batches++
+			for _, post := range posts {
+				postIDs = append(postIDs, post.PostId)
+			}
+			return nil
+		})
+		require.Nil(t, appErr)
+		require.Len(t, postIDs, 5)
+		require.Equal(t, 3, batches)
+		require.Equal(t, 5, job.Count)
+	})
+
+	t.Run("resumes from the last checkpoint", func(t *testing.T) {
+		job := newJob()
+
+		var postIDs []string
+		appErr := th.App.ExportCompliancePosts(th.Context, job, 2, func(posts []*model.CompliancePost) error {
+			if len(postIDs) == 2 {
+				return errors.New("interrupted")
+			}
+			for _, post := range posts {
+				postIDs = append(postIDs, post.PostId)
+			}
+			return nil
+		})
+		require.NotNil(t, appErr)
+		require.Len(t, postIDs, 2)
+
+		// Reload the job as if the export was restarted
+		job, err := th.App.Srv().Store().Compliance().Get(job.Id)
+		require.NoError(t, err)
+		require.Equal(t, postIDs[1], job.LastPostId)
+
+		appErr = th.App.ExportCompliancePosts(th.Context, job, 2, func(posts []*model.CompliancePost) error {
+			for _, post := range posts {
+				postIDs = append(postIDs, post.PostId)
+			}
+			return nil
+		})
+		require.Nil(t, appErr)
+		require.Len(t, postIDs, 5)
+		require.Equal(t, 5, job.Count)
+	})
+}
+
+func TestRunIncrementalComplianceJob(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	dir := t.TempDir()
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.ComplianceSettings.Directory = dir
+	})
+
+	startAt := model.GetMillis()
+	for i := 0; i < 3; i++ {
+		th.CreatePost(th.BasicChannel)
+	}
+
+	job, err := th.App.Srv().Store().Compliance().Save(&model.Compliance{
+		Desc:        "test",
+		UserId:      th.SystemAdminUser.Id,
+		Type:        model.ComplianceTypeAdhoc,
+		StartAt:     startAt,
+		EndAt:       model.GetMillis() + 1,
+		Emails:      th.BasicUser.Email,
+		Incremental: true,
+	})
+	require.NoError(t, err)
+
+	appErr := th.App.RunIncrementalComplianceJob(th.Context, job)
+	require.Nil(t, appErr)
+	require.Equal(t, model.ComplianceStatusFinished, job.Status)
+	require.Equal(t, 3, job.Count)
+
+	reader, err := zip.OpenReader(filepath.Join(dir, "compliance", job.JobName()+".zip"))
+	require.NoError(t, err)
+	defer reader.Close()
+	require.Len(t, reader.File, 1)
+
+	entry, err := reader.File[0].Open()
+	require.NoError(t, err)
+	defer entry.Close()
+	records, err := csv.NewReader(entry).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 4)
+	require.Equal(t, model.CompliancePostHeader(), records[0])
+
+	_, err = os.Stat(filepath.Join(dir, "compliance", job.JobName()+".csv"))
+	require.True(t, os.IsNotExist(err))
+}
@@ -7,6 +7,7 @@ import (
 	"crypto/ecdsa"
 	"crypto/rand"
 	"encoding/json"
+	"net/http"
 	"net/url"
 	"reflect"
 	"strconv"
@@ -16,6 +17,7 @@ import (
 
 	"github.com/mattermost/mattermost/server/public/model"
 	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/public/shared/request"
 	"github.com/mattermost/mattermost/server/v8/channels/utils"
 	"github.com/mattermost/mattermost/server/v8/platform/shared/mail"
 )
@@ -230,6 +232,42 @@ func (a *App) SaveConfig(newCfg *model.Config, sendConfigChangeClusterMessage bo
 	return a.Srv().platform.SaveConfig(newCfg, sendConfigChangeClusterMessage)
 }
 
+// ValidateConfig validates the given config without applying it. When checkConnections is set,
+// the connections to the email server, the file store and Elasticsearch are tested as well.
+func (a *App) ValidateConfig(rctx request.CTX, cfg *model.Config, checkConnections bool) *model.ConfigValidationResult {
+	validationErrors := cfg.ValidationErrors()
+
+	if checkConnections {
+		if *cfg.EmailSettings.SendEmailNotifications && *cfg.EmailSettings.SMTPServer != "" {
+			if err := mail.TestConnection(mailServiceConfigFromConfig(cfg)); err != nil {
+				validationErrors = append(validationErrors, &model.ConfigValidationError{
+					Field: "EmailSettings",
+					Error: model.NewAppError("ValidateConfig", "app.config.validate.smtp_connection.app_error", nil, "", http.StatusBadRequest).Wrap(err),
+				})
+			}
+		}
+
+		if appErr := a.TestFileStoreConnectionWithConfig(&cfg.FileSettings); appErr != nil {
+			validationErrors = append(validationErrors, &model.ConfigValidationError{Field: "FileSettings", Error: appErr})
+		}
+
+		if engine := a.SearchEngine().ElasticsearchEngine; engine != nil && *cfg.ElasticsearchSettings.EnableIndexing {
+			if appErr := engine.TestConfig(rctx, cfg); appErr != nil {
+				validationErrors = append(validationErrors, &model.ConfigValidationError{Field: "ElasticsearchSettings", Error: appErr})
+			}
+		}
+	}
+
+	if validationErrors == nil {
+		validationErrors = []*model.ConfigValidationError{}
+	}
+
+	return &model.ConfigValidationResult{
+		Valid:  len(validationErrors) == 0,
+		Errors: validationErrors,
+	}
+}
+
 func (a *App) HandleMessageExportConfig(cfg *model.Config, appCfg *model.Config) {
 	// If the Message Export feature has been toggled in the System Console, rewrite the ExportFromTimestamp field to an
 	// appropriate value. The rewriting occurs here to ensure it doesn't affect values written to the config file
@@ -247,8 +285,12 @@ func (a *App) HandleMessageExportConfig(cfg *model.Config, appCfg *model.Config)
 }
 
 func (s *Server) MailServiceConfig() *mail.SMTPConfig {
-	emailSettings := s.platform.Config().EmailSettings
-	hostname := utils.GetHostnameFromSiteURL(*s.platform.Config().ServiceSettings.SiteURL)
+	return mailServiceConfigFromConfig(s.platform.Config())
+}
+
+func mailServiceConfigFromConfig(config *model.Config) *mail.SMTPConfig {
+	emailSettings := config.EmailSettings
+	hostname := utils.GetHostnameFromSiteURL(*config.ServiceSettings.SiteURL)
 	cfg := mail.SMTPConfig{
 		Hostname:                          hostname,
 		ConnectionSecurity:                *emailSettings.ConnectionSecurity,
@@ -0,0 +1,85 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+	"github.com/mattermost/mattermost/server/v8/config"
+)
+
+// RecordConfigChange stores a new version of the config history with the changes between the
+// old and new configs. Nothing is stored when the configs are the same.
+func (a *App) RecordConfigChange(userID string, oldCfg, newCfg *model.Config) (*model.ConfigHistory, *model.AppError) {
+	diffs, err := config.Diff(oldCfg, newCfg)
+	if err != nil {
+		return nil, model.NewAppError("RecordConfigChange", "api.config.update_config.diff.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	if len(diffs) == 0 {
+		return nil, nil
+	}
+
+	// The persisted config is stored rather than the new one, so that the secrets resolved from
+	// external providers are kept as references.
+	cfgJSON, err := json.Marshal(a.Srv().Platform().GetConfigStore().GetNoEnv())
+	if err != nil {
+		return nil, model.NewAppError("RecordConfigChange", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	diffJSON, err := json.Marshal(diffs.Sanitize())
+	if err != nil {
+		return nil, model.NewAppError("RecordConfigChange", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	history, err := a.Srv().Store().ConfigHistory().Save(&model.ConfigHistory{
+		UserId: userID,
+		Diff:   string(diffJSON),
+		Config: string(cfgJSON),
+	})
+	if err != nil {
+		var appErr *model.AppError
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		default:
+			return nil, model.NewAppError("RecordConfigChange", "app.config_history.save.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	return history, nil
+}
+
+func (a *App) GetConfigHistory(page, perPage int) ([]*model.ConfigHistory, *model.AppError) {
+	history, err := a.Srv().Store().ConfigHistory().GetAll(page*perPage, perPage)
+	if err != nil {
+		return nil, model.NewAppError("GetConfigHistory", "app.config_history.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return history, nil
+}
+
+// GetConfigAtVersion returns the whole config as it was after the given version of the config
+// history was saved.
+func (a *App) GetConfigAtVersion(version int64) (*model.Config, *model.AppError) {
+	history, err := a.Srv().Store().ConfigHistory().GetByVersion(version)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("GetConfigAtVersion", "app.config_history.get.not_found.app_error", nil, "", http.StatusNotFound).Wrap(err)
+		default:
+			return nil, model.NewAppError("GetConfigAtVersion", "app.config_history.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	var cfg *model.Config
+	if err := json.Unmarshal([]byte(history.Config), &cfg); err != nil || cfg == nil {
+		return nil, model.NewAppError("GetConfigAtVersion", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	cfg.SetDefaults()
+
+	return cfg, nil
+}
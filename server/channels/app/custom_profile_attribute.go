@@ -0,0 +1,359 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+)
+
+const (
+	customProfileAttributeFieldsCacheKey = "fields"
+	// customProfileAttributeFieldsCacheExpiry bounds how long other cluster nodes keep using
+	// outdated fields, since the cache is only invalidated on the node handling the change.
+	customProfileAttributeFieldsCacheExpiry = time.Minute
+)
+
+func (a *App) CreateCustomProfileAttributeField(field *model.CustomProfileAttributeField) (*model.CustomProfileAttributeField, *model.AppError) {
+	fields, appErr := a.GetCustomProfileAttributeFields()
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if len(fields) >= model.CustomProfileAttributeFieldsMax {
+		return nil, model.NewAppError("CreateCustomProfileAttributeField", "app.custom_profile_attribute.limit.app_error", map[string]any{"Max": model.CustomProfileAttributeFieldsMax}, "", http.StatusBadRequest)
+	}
+
+	field.Id = ""
+	savedField, err := a.Srv().Store().CustomProfileAttribute().SaveField(field)
+	if err != nil {
+		var appErr *model.AppError
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		default:
+			return nil, model.NewAppError("CreateCustomProfileAttributeField", "app.custom_profile_attribute.save.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	a.invalidateCustomProfileAttributeFieldsCache()
+
+	return savedField, nil
+}
+
+func (a *App) GetCustomProfileAttributeField(fieldID string) (*model.CustomProfileAttributeField, *model.AppError) {
+	field, err := a.Srv().Store().CustomProfileAttribute().GetField(fieldID)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("GetCustomProfileAttributeField", "app.custom_profile_attribute.get.app_error", nil, "", http.StatusNotFound).Wrap(err)
+		default:
+			return nil, model.NewAppError("GetCustomProfileAttributeField", "app.custom_profile_attribute.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	return field, nil
+}
+
+// GetCustomProfileAttributeFields returns the fields by sort order. The returned fields are
+// shared with the cache and must not be modified.
+func (a *App) GetCustomProfileAttributeFields() ([]*model.CustomProfileAttributeField, *model.AppError) {
+	var fields []*model.CustomProfileAttributeField
+	if err := a.Srv().customProfileAttributeFieldsCache.Get(customProfileAttributeFieldsCacheKey, &fields); err == nil {
+		return fields, nil
+	}
+
+	fields, err := a.Srv().Store().CustomProfileAttribute().GetFields()
+	if err != nil {
+		return nil, model.NewAppError("GetCustomProfileAttributeFields", "app.custom_profile_attribute.get_fields.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	if err := a.Srv().customProfileAttributeFieldsCache.SetWithDefaultExpiry(customProfileAttributeFieldsCacheKey, fields); err != nil {
+		a.Log().Warn("Failed to cache the custom profile attribute fields", mlog.Err(err))
+	}
+
+	return fields, nil
+}
+
+// UpdateCustomProfileAttributeField updates the field. The type of a field can't be changed since
+// the values of the users would no longer be valid.
+func (a *App) UpdateCustomProfileAttributeField(oldField, updatedField *model.CustomProfileAttributeField) (*model.CustomProfileAttributeField, *model.AppError) {
+	updatedField.Id = oldField.Id
+	updatedField.Type = oldField.Type
+	updatedField.CreateAt = oldField.CreateAt
+	updatedField.DeleteAt = oldField.DeleteAt
+
+	field, err := a.Srv().Store().CustomProfileAttribute().UpdateField(updatedField)
+	if err != nil {
+		var appErr *model.AppError
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("UpdateCustomProfileAttributeField", "app.custom_profile_attribute.get.app_error", nil, "", http.StatusNotFound).Wrap(err)
+		default:
+			return nil, model.NewAppError("UpdateCustomProfileAttributeField", "app.custom_profile_attribute.update.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	a.invalidateCustomProfileAttributeFieldsCache()
+
+	return field, nil
+}
+
+// DeleteCustomProfileAttributeField deletes the field along with the values of the users.
+func (a *App) DeleteCustomProfileAttributeField(fieldID string) *model.AppError {
+	if err := a.Srv().Store().CustomProfileAttribute().DeleteField(fieldID, model.GetMillis()); err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return model.NewAppError("DeleteCustomProfileAttributeField", "app.custom_profile_attribute.get.app_error", nil, "", http.StatusNotFound).Wrap(err)
+		default:
+			return model.NewAppError("DeleteCustomProfileAttributeField", "app.custom_profile_attribute.delete.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	a.invalidateCustomProfileAttributeFieldsCache()
+
+	return nil
+}
+
+func (a *App) invalidateCustomProfileAttributeFieldsCache() {
+	if err := a.Srv().customProfileAttributeFieldsCache.Remove(customProfileAttributeFieldsCacheKey); err != nil {
+		a.Log().Warn("Failed to invalidate the custom profile attribute fields cache", mlog.Err(err))
+	}
+}
+
+// GetCustomProfileAttributeValues returns the values of the user keyed by field id. Values of
+// hidden fields are only returned when showHidden is set.
+func (a *App) GetCustomProfileAttributeValues(userID string, showHidden bool) (map[string]string, *model.AppError) {
+	user := &model.User{Id: userID}
+	if appErr := a.fillInCustomProfileAttributes([]*model.User{user}, func(*model.User) bool { return showHidden }); appErr != nil {
+		return nil, appErr
+	}
+
+	if user.CustomProfileAttributes == nil {
+		return map[string]string{}, nil
+	}
+
+	return user.CustomProfileAttributes, nil
+}
+
+// PatchCustomProfileAttributeValues sets the given values, keyed by field id, for the user. An
+// empty value clears the value of the user. Fields synchronized from an identity provider can't be
+// modified.
+func (a *App) PatchCustomProfileAttributeValues(userID string, patch map[string]string) (map[string]string, *model.AppError) {
+	fields, appErr := a.GetCustomProfileAttributeFields()
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	fieldsByID := make(map[string]*model.CustomProfileAttributeField, len(fields))
+	for _, field := range fields {
+		fieldsByID[field.Id] = field
+	}
+
+	updateAt := model.GetMillis()
+	var upserts []*model.CustomProfileAttributeValue
+	var deletes []string
+	for fieldID, value := range patch {
+		field, ok := fieldsByID[fieldID]
+		if !ok {
+			return nil, model.NewAppError("PatchCustomProfileAttributeValues", "app.custom_profile_attribute.get.app_error", nil, "field_id="+fieldID, http.StatusBadRequest)
+		}
+
+		if field.IsSynced() {
+			return nil, model.NewAppError("PatchCustomProfileAttributeValues", "app.custom_profile_attribute.synced.app_error", map[string]any{"Name": field.Name}, "", http.StatusBadRequest)
+		}
+
+		if appErr := field.ValidateValue(value); appErr != nil {
+			return nil, appErr
+		}
+
+		if value == "" {
+			deletes = append(deletes, fieldID)
+			continue
+		}
+
+		upserts = append(upserts, &model.CustomProfileAttributeValue{
+			FieldId:  fieldID,
+			UserId:   userID,
+			Value:    value,
+			UpdateAt: updateAt,
+		})
+	}
+
+	if err := a.Srv().Store().CustomProfileAttribute().UpsertValues(upserts); err != nil {
+		return nil, model.NewAppError("PatchCustomProfileAttributeValues", "app.custom_profile_attribute.save_values.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	if err := a.Srv().Store().CustomProfileAttribute().DeleteValues(userID, deletes); err != nil {
+		return nil, model.NewAppError("PatchCustomProfileAttributeValues", "app.custom_profile_attribute.save_values.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	// Bump the user so that the etags of the profile change with the values.
+	if _, err := a.Srv().Store().User().UpdateUpdateAt(userID); err != nil {
+		return nil, model.NewAppError("PatchCustomProfileAttributeValues", "app.user.update_update.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	a.InvalidateCacheForUser(userID)
+
+	return a.GetCustomProfileAttributeValues(userID, true)
+}
+
+// FillInCustomProfileAttributes sets the custom profile attributes of the users. Values of hidden
+// fields are only set for the viewer's own profile, or when asAdmin is set.
+func (a *App) FillInCustomProfileAttributes(users []*model.User, viewerID string, asAdmin bool) *model.AppError {
+	return a.fillInCustomProfileAttributes(users, func(user *model.User) bool {
+		return asAdmin || user.Id == viewerID
+	})
+}
+
+func (a *App) fillInCustomProfileAttributes(users []*model.User, showHidden func(*model.User) bool) *model.AppError {
+	if len(users) == 0 {
+		return nil
+	}
+
+	fields, appErr := a.GetCustomProfileAttributeFields()
+	if appErr != nil {
+		return appErr
+	}
+
+	if len(fields) == 0 {
+		return nil
+	}
+
+	hiddenFields := make(map[string]bool, len(fields))
+	for _, field := range fields {
+		hiddenFields[field.Id] = field.Visibility == model.CustomProfileAttributeVisibilityHidden
+	}
+
+	usersByID := make(map[string]*model.User, len(users))
+	userIDs := make([]string, 0, len(users))
+	for _, user := range users {
+		usersByID[user.Id] = user
+		userIDs = append(userIDs, user.Id)
+	}
+
+	values, err := a.Srv().Store().CustomProfileAttribute().GetValuesForUsers(userIDs)
+	if err != nil {
+		return model.NewAppError("FillInCustomProfileAttributes", "app.custom_profile_attribute.get_values.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	for _, value := range values {
+		user := usersByID[value.UserId]
+		hidden, ok := hiddenFields[value.FieldId]
+		if user == nil || !ok || (hidden && !showHidden(user)) {
+			continue
+		}
+
+		if user.CustomProfileAttributes == nil {
+			user.CustomProfileAttributes = map[string]string{}
+		}
+		user.CustomProfileAttributes[value.FieldId] = value.Value
+	}
+
+	return nil
+}
+
+// ValidateCustomProfileAttributesFilter checks that the users can be filtered by the given
+// values, keyed by field id. Only admins can filter by hidden fields.
+func (a *App) ValidateCustomProfileAttributesFilter(filter map[string]string, asAdmin bool) *model.AppError {
+	if len(filter) == 0 {
+		return nil
+	}
+
+	fields, appErr := a.GetCustomProfileAttributeFields()
+	if appErr != nil {
+		return appErr
+	}
+
+	visibleFields := make(map[string]bool, len(fields))
+	for _, field := range fields {
+		visibleFields[field.Id] = asAdmin || field.Visibility != model.CustomProfileAttributeVisibilityHidden
+	}
+
+	for fieldID := range filter {
+		if !visibleFields[fieldID] {
+			return model.NewAppError("ValidateCustomProfileAttributesFilter", "app.custom_profile_attribute.filter.app_error", nil, "field_id="+fieldID, http.StatusBadRequest)
+		}
+	}
+
+	return nil
+}
+
+// syncCustomProfileAttributesFromLdap updates the values of the fields mapped to an LDAP
+// attribute with the attributes of the user in the directory.
+func (a *App) syncCustomProfileAttributesFromLdap(rctx request.CTX, user *model.User) {
+	if a.Ldap() == nil || user.AuthData == nil {
+		return
+	}
+
+	fields, appErr := a.GetCustomProfileAttributeFields()
+	if appErr != nil {
+		rctx.Logger().Warn("Failed to get the custom profile attribute fields", mlog.Err(appErr))
+		return
+	}
+
+	var attributes []string
+	for _, field := range fields {
+		if field.LdapAttribute != "" {
+			attributes = append(attributes, field.LdapAttribute)
+		}
+	}
+
+	if len(attributes) == 0 {
+		return
+	}
+
+	ldapValues, appErr := a.Ldap().GetUserAttributes(rctx, *user.AuthData, attributes)
+	if appErr != nil {
+		rctx.Logger().Warn("Failed to get the LDAP attributes of the user", mlog.String("user_id", user.Id), mlog.Err(appErr))
+		return
+	}
+
+	updateAt := model.GetMillis()
+	var upserts []*model.CustomProfileAttributeValue
+	var deletes []string
+	for _, field := range fields {
+		if field.LdapAttribute == "" {
+			continue
+		}
+
+		value := ldapValues[field.LdapAttribute]
+		if value == "" {
+			deletes = append(deletes, field.Id)
+			continue
+		}
+
+		if appErr := field.ValidateValue(value); appErr != nil {
+			rctx.Logger().Warn("Ignoring an invalid LDAP attribute value", mlog.String("user_id", user.Id), mlog.String("field_id", field.Id), mlog.Err(appErr))
+			continue
+		}
+
+		upserts = append(upserts, &model.CustomProfileAttributeValue{
+			FieldId:  field.Id,
+			UserId:   user.Id,
+			Value:    value,
+			UpdateAt: updateAt,
+		})
+	}
+
+	if err := a.Srv().Store().CustomProfileAttribute().UpsertValues(upserts); err != nil {
+		rctx.Logger().Warn("Failed to save the custom profile attributes synchronized from LDAP", mlog.String("user_id", user.Id), mlog.Err(err))
+		return
+	}
+
+	if err := a.Srv().Store().CustomProfileAttribute().DeleteValues(user.Id, deletes); err != nil {
+		rctx.Logger().Warn("Failed to clear the custom profile attributes synchronized from LDAP", mlog.String("user_id", user.Id), mlog.Err(err))
+	}
+}
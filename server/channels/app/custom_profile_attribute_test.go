@@ -0,0 +1,77 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+func TestCreateCustomProfileAttributeField(t *testing.T) {
+	th := Setup(t)
+	defer th.TearDown()
+
+	for i := 0; i < model.CustomProfileAttributeFieldsMax; i++ {
+		_, appErr := th.App.CreateCustomProfileAttributeField(&model.CustomProfileAttributeField{
+			Name: fmt.Sprintf("Field %d", i),
+			Type: model.CustomProfileAttributeTypeText,
+		})
+		require.Nil(t, appErr)
+	}
+
+	_, appErr := th.App.CreateCustomProfileAttributeField(&model.CustomProfileAttributeField{Name: "One too many", Type: model.CustomProfileAttributeTypeText})
+	require.NotNil(t, appErr)
+	assert.Equal(t, http.StatusBadRequest, appErr.StatusCode)
+	assert.Equal(t, "app.custom_profile_attribute.limit.app_error", appErr.Id)
+}
+
+func TestFillInCustomProfileAttributes(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	visible, appErr := th.App.CreateCustomProfileAttributeField(&model.CustomProfileAttributeField{Name: "Team", Type: model.CustomProfileAttributeTypeText})
+	require.Nil(t, appErr)
+	hidden, appErr := th.App.CreateCustomProfileAttributeField(&model.CustomProfileAttributeField{
+		Name:       "Cost center",
+		Type:       model.CustomProfileAttributeTypeText,
+		Visibility: model.CustomProfileAttributeVisibilityHidden,
+	})
+	require.Nil(t, appErr)
+
+	_, appErr = th.App.PatchCustomProfileAttributeValues(th.BasicUser.Id, map[string]string{visible.Id: "Platform", hidden.Id: "1234"})
+	require.Nil(t, appErr)
+
+	t.Run("other users only see visible fields", func(t *testing.T) {
+		users := []*model.User{{Id: th.BasicUser.Id}, {Id: th.BasicUser2.Id}}
+		require.Nil(t, th.App.FillInCustomProfileAttributes(users, th.BasicUser2.Id, false))
+		assert.Equal(t, map[string]string{visible.Id: "Platform"}, users[0].CustomProfileAttributes)
+		assert.Nil(t, users[1].CustomProfileAttributes)
+	})
+
+	t.Run("users see their own hidden fields", func(t *testing.T) {
+		users := []*model.User{{Id: th.BasicUser.Id}}
+		require.Nil(t, th.App.FillInCustomProfileAttributes(users, th.BasicUser.Id, false))
+		assert.Equal(t, map[string]string{visible.Id: "Platform", hidden.Id: "1234"}, users[0].CustomProfileAttributes)
+	})
+
+	t.Run("admins see hidden fields", func(t *testing.T) {
+		users := []*model.User{{Id: th.BasicUser.Id}}
+		require.Nil(t, th.App.FillInCustomProfileAttributes(users, th.SystemAdminUser.Id, true))
+		assert.Equal(t, map[string]string{visible.Id: "Platform", hidden.Id: "1234"}, users[0].CustomProfileAttributes)
+	})
+
+	t.Run("deleted fields are no longer returned", func(t *testing.T) {
+		require.Nil(t, th.App.DeleteCustomProfileAttributeField(visible.Id))
+
+		users := []*model.User{{Id: th.BasicUser.Id}}
+		require.Nil(t, th.App.FillInCustomProfileAttributes(users, th.BasicUser.Id, false))
+		assert.Equal(t, map[string]string{hidden.Id: "1234"}, users[0].CustomProfileAttributes)
+	})
+}
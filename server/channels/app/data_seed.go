@@ -0,0 +1,423 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+//nolint:gosec
+package app
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	"net/http"
+	"time"
+
+	"github.com/icrowley/fake"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+)
+
+const (
+	// dataSeedExtraTeamPercentage is the share of the users joining a second team.
+	dataSeedExtraTeamPercentage = 30
+	// dataSeedPrivateChannelPercentage is the share of the generated channels which are private.
+	dataSeedPrivateChannelPercentage = 20
+	// dataSeedRecentRoots is the number of the latest root posts of each channel the replies are
+	// made to.
+	dataSeedRecentRoots = 20
+	// dataSeedMentionPercentage is the share of the posts mentioning another member.
+	dataSeedMentionPercentage = 3
+)
+
+// StartDataSeed starts generating synthetic teams, channels, users and posts in the background,
+// through the app layer, so that staging servers can be populated for load tests. Only one
+// generation can run at a time on a server.
+func (a *App) StartDataSeed(rctx request.CTX, seedRequest model.DataSeedRequest) (*model.DataSeedStatus, *model.AppError) {
+	seedRequest.SetDefaults()
+	if appErr := seedRequest.IsValid(); appErr != nil {
+		return nil, appErr
+	}
+
+	password := seedRequest.Password
+	seedRequest.Password = ""
+	if appErr := a.IsPasswordValid(rctx, password); appErr != nil {
+		return nil, appErr
+	}
+
+	// Each user joins one team at least, the other memberships are skipped when the teams are full.
+	maxUsersPerTeam := *a.Config().TeamSettings.MaxUsersPerTeam
+	if (seedRequest.Users+seedRequest.Teams-1)/seedRequest.Teams > maxUsersPerTeam {
+		return nil, model.NewAppError("StartDataSeed", "app.data_seed.max_users_per_team.app_error", map[string]any{"Max": maxUsersPerTeam}, "", http.StatusBadRequest)
+	}
+
+	if seedRequest.Seed == 0 {
+		seedRequest.Seed = time.Now().UnixNano()
+	}
+
+	s := a.Srv()
+	s.dataSeedMut.Lock()
+	defer s.dataSeedMut.Unlock()
+
+	if s.dataSeedStatus != nil && !s.dataSeedStatus.IsComplete() {
+		return nil, model.NewAppError("StartDataSeed", "app.data_seed.in_progress.app_error", nil, "", http.StatusConflict)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s.dataSeedCancel = cancel
+	s.dataSeedStatus = &model.DataSeedStatus{
+		Request: seedRequest,
+		Prefix:  "seed" + model.NewId()[:6],
+		StartAt: model.GetMillis(),
+	}
+
+	seeder := &dataSeeder{
+		a:        a,
+		rctx:     request.EmptyContext(a.Log()).WithContext(ctx),
+		request:  seedRequest,
+		prefix:   s.dataSeedStatus.Prefix,
+		password: password,
+		creator:  rctx.Session().UserId,
+		rand:     rand.New(rand.NewSource(seedRequest.Seed)),
+	}
+	status := *s.dataSeedStatus
+
+	s.Go(func() {
+		err := seeder.run()
+
+		s.dataSeedMut.Lock()
+		defer s.dataSeedMut.Unlock()
+		s.dataSeedStatus.CompleteAt = model.GetMillis()
+		s.dataSeedStatus.Canceled = ctx.Err() != nil
+		if err != nil && !s.dataSeedStatus.Canceled {
+			s.dataSeedStatus.Error = err.Error()
+		}
+		cancel()
+
+		a.Log().Info("Data seeding completed",
+			mlog.String("prefix", s.dataSeedStatus.Prefix),
+			mlog.Int("users", s.dataSeedStatus.Users),
+			mlog.Int("posts", s.dataSeedStatus.Posts),
+			mlog.Bool("canceled", s.dataSeedStatus.Canceled),
+			mlog.Err(err),
+		)
+	})
+
+	rctx.Logger().Info("Data seeding started", mlog.String("prefix", status.Prefix), mlog.Int("seed", seedRequest.Seed))
+	return &status, nil
+}
+
+// GetDataSeedStatus returns the progress of the last generation of synthetic data, or nil if
+// none was started since the server started.
+func (a *App) GetDataSeedStatus() *model.DataSeedStatus {
+	s := a.Srv()
+	s.dataSeedMut.Lock()
+	defer s.dataSeedMut.Unlock()
+
+	if s.dataSeedStatus == nil {
+		return nil
+	}
+
+	status := *s.dataSeedStatus
+	return &status
+}
+
+// CancelDataSeed stops the ongoing generation of synthetic data. The data generated so far is
+// kept.
+func (a *App) CancelDataSeed() *model.AppError {
+	s := a.Srv()
+	s.dataSeedMut.Lock()
+	defer s.dataSeedMut.Unlock()
+
+	if s.dataSeedStatus == nil || s.dataSeedStatus.IsComplete() {
+		return model.NewAppError("CancelDataSeed", "app.data_seed.not_running.app_error", nil, "", http.StatusNotFound)
+	}
+
+	s.dataSeedCancel()
+	return nil
+}
+
+func (s *Server) stopDataSeed() {
+	s.dataSeedMut.Lock()
+	defer s.dataSeedMut.Unlock()
+
+	if s.dataSeedCancel != nil {
+		s.dataSeedCancel()
+	}
+}
+
+type seedChannel struct {
+	channel *model.Channel
+	// members are the indexes of the member users.
+	members []int32
+	// roots are the ids of the latest root posts.
+	roots []string
+}
+
+type seedTeam struct {
+	team     *model.Team
+	channels []*seedChannel
+}
+
+// dataSeeder generates the synthetic data. The activity is skewed the way it is on real
+// servers: a few channels get most of the members and of the posts, and a few members of each
+// channel write most of its posts.
+type dataSeeder struct {
+	a        *App
+	rctx     request.CTX
+	request  model.DataSeedRequest
+	prefix   string
+	password string
+	creator  string
+	rand     *rand.Rand
+
+	teams    []*seedTeam
+	users    []*model.User
+	channels []*seedChannel
+}
+
+func (s *dataSeeder) run() error {
+	if err := s.createTeams(); err != nil {
+		return err
+	}
+
+	if err := s.createUsers(); err != nil {
+		return err
+	}
+
+	return s.createPosts()
+}
+
+func (s *dataSeeder) updateStatus(f func(status *model.DataSeedStatus)) {
+	srv := s.a.Srv()
+	srv.dataSeedMut.Lock()
+	defer srv.dataSeedMut.Unlock()
+
+	f(srv.dataSeedStatus)
+}
+
+func (s *dataSeeder) createTeams() error {
+	for i := 0; i < s.request.Teams; i++ {
+		if err := s.rctx.Context().Err(); err != nil {
+			return err
+		}
+
+		team, appErr := s.a.CreateTeam(s.rctx, &model.Team{
+			Name:        fmt.Sprintf("%s-team-%d", s.prefix, i),
+			DisplayName: fake.Company(),
+			Description: truncateRunes(fake.Sentence(), model.TeamDescriptionMaxLength),
+			Type:        model.TeamOpen,
+		})
+		if appErr != nil {
+			return appErr
+		}
+
+		t := &seedTeam{team: team}
+		townSquare, appErr := s.a.GetChannelByName(s.rctx, model.DefaultChannelName, team.Id, false)
+		if appErr != nil {
+			return appErr
+		}
+		t.channels = append(t.channels, &seedChannel{channel: townSquare})
+
+		for j := 0; j < s.request.ChannelsPerTeam; j++ {
+			channelType := model.ChannelTypeOpen
+			if s.rand.Intn(100) < dataSeedPrivateChannelPercentage {
+				channelType = model.ChannelTypePrivate
+			}
+
+			channel, appErr := s.a.CreateChannel(s.rctx, &model.Channel{
+				TeamId:      team.Id,
+				Name:        fmt.Sprintf("%s-channel-%d", s.prefix, j),
+				DisplayName: fmt.Sprintf("%s %d", fake.Word(), j),
+				Purpose:     truncateRunes(fake.Sentence(), model.ChannelPurposeMaxRunes),
+				Type:        channelType,
+				CreatorId:   s.creator,
+			}, false)
+			if appErr != nil {
+				return appErr
+			}
+			t.channels = append(t.channels, &seedChannel{channel: channel})
+		}
+
+		s.teams = append(s.teams, t)
+		s.updateStatus(func(status *model.DataSeedStatus) {
+			status.Teams++
+			status.Channels += 1 + s.request.ChannelsPerTeam
+		})
+	}
+
+	return nil
+}
+
+func (s *dataSeeder) createUsers() error {
+	for i := 0; i < s.request.Users; i++ {
+		if err := s.rctx.Context().Err(); err != nil {
+			return err
+		}
+
+		firstName := fake.FirstName()
+		lastName := fake.LastName()
+		user := &model.User{
+			Username:      fmt.Sprintf("%s.user%d", s.prefix, i),
+			Email:         fmt.Sprintf("%s.user%d@sample.mattermost.com", s.prefix, i),
+			Password:      s.password,
+			FirstName:     firstName,
+			LastName:      lastName,
+			Position:      fake.JobTitle(),
+			EmailVerified: true,
+		}
+		// The generated users have no real mailbox.
+		user.SetDefaultNotifications()
+		user.NotifyProps[model.EmailNotifyProp] = "false"
+
+		user, appErr := s.a.CreateUser(s.rctx, user)
+		if appErr != nil {
+			return appErr
+		}
+		s.users = append(s.users, user)
+
+		teams := []*seedTeam{s.teams[i%len(s.teams)]}
+		if len(s.teams) > 1 && s.rand.Intn(100) < dataSeedExtraTeamPercentage {
+			if extra := s.teams[s.rand.Intn(len(s.teams))]; extra != teams[0] {
+				teams = append(teams, extra)
+			}
+		}
+
+		for k, t := range teams {
+			if _, appErr := s.a.JoinUserToTeam(s.rctx, t.team, user, s.creator); appErr != nil {
+				// The extra teams may be full already.
+				if k > 0 && appErr.Id == "app.team.join_user_to_team.max_accounts.app_error" {
+					continue
+				}
+				return appErr
+			}
+
+			if err := s.joinChannels(t, user, int32(i)); err != nil {
+				return err
+			}
+		}
+
+		s.updateStatus(func(status *model.DataSeedStatus) {
+			status.Users++
+		})
+	}
+
+	return nil
+}
+
+// joinChannels adds the user to the channels of the team, the first ones of which are the most
+// popular. The town square is joined with the team.
+func (s *dataSeeder) joinChannels(t *seedTeam, user *model.User, index int32) error {
+	t.channels[0].members = append(t.channels[0].members, index)
+
+	for j, c := range t.channels[1:] {
+		probability := 1 / math.Sqrt(float64(j+1))
+		if c.channel.Type == model.ChannelTypePrivate {
+			probability /= 2
+		}
+		if s.rand.Float64() >= probability {
+			continue
+		}
+
+		if _, appErr := s.a.AddUserToChannel(s.rctx, user, c.channel, true); appErr != nil {
+			return appErr
+		}
+		c.members = append(c.members, index)
+	}
+
+	return nil
+}
+
+// createPosts creates the posts in chronological order over the days of history, so that the
+// replies are always more recent than their root posts.
+func (s *dataSeeder) createPosts() error {
+	for _, t := range s.teams {
+		for _, c := range t.channels {
+			if len(c.members) > 0 {
+				s.channels = append(s.channels, c)
+			}
+		}
+	}
+	if len(s.channels) == 0 || s.request.Posts == 0 {
+		return nil
+	}
+
+	// The channels are shuffled so that the most active ones are spread over the teams.
+	s.rand.Shuffle(len(s.channels), func(i, j int) {
+		s.channels[i], s.channels[j] = s.channels[j], s.channels[i]
+	})
+	channelZipf := rand.NewZipf(s.rand, 1.1, 1, uint64(len(s.channels)-1))
+
+	history := time.Duration(s.request.DaysOfHistory) * 24 * time.Hour
+	startAt := time.Now().Add(-history)
+	step := history / time.Duration(s.request.Posts)
+
+	for i := 0; i < s.request.Posts; i++ {
+		if err := s.rctx.Context().Err(); err != nil {
+			return err
+		}
+
+		c := s.channels[channelZipf.Uint64()]
+		authorZipf := rand.NewZipf(s.rand, 1.2, 1, uint64(len(c.members)-1))
+		author := s.users[c.members[authorZipf.Uint64()]]
+
+		createAt := startAt.Add(time.Duration(i)*step + time.Duration(s.rand.Int63n(int64(step)+1)))
+		post := &model.Post{
+			ChannelId: c.channel.Id,
+			UserId:    author.Id,
+			Message:   s.randomMessage(c),
+			CreateAt:  model.GetMillisForTime(createAt),
+		}
+		if len(c.roots) > 0 && s.rand.Intn(100) < s.request.ReplyPercentage {
+			post.RootId = c.roots[s.rand.Intn(len(c.roots))]
+		}
+
+		post, appErr := s.a.CreatePost(s.rctx, post, c.channel, false, false)
+		if appErr != nil {
+			return appErr
+		}
+
+		if post.RootId == "" {
+			c.roots = append(c.roots, post.Id)
+			if len(c.roots) > dataSeedRecentRoots {
+				c.roots = c.roots[1:]
+			}
+		}
+
+		s.updateStatus(func(status *model.DataSeedStatus) {
+			status.Posts++
+		})
+	}
+
+	return nil
+}
+
+func (s *dataSeeder) randomMessage(c *seedChannel) string {
+	var message string
+	switch n := s.rand.Intn(10); {
+	case n < 6:
+		message = fake.Sentence()
+	case n < 9:
+		message = fake.Paragraph()
+	default:
+		message = fake.Sentence()
+		for j := 0; j < s.rand.Intn(4)+1; j++ {
+			message += "\n  * " + fake.Word()
+		}
+	}
+
+	if s.rand.Intn(100) < dataSeedMentionPercentage {
+		mentioned := s.users[c.members[s.rand.Intn(len(c.members))]]
+		message = "@" + mentioned.Username + " " + message
+	}
+
+	return message
+}
+
+func truncateRunes(s string, max int) string {
+	if runes := []rune(s); len(runes) > max {
+		return string(runes[:max])
+	}
+	return s
+}
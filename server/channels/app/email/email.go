@@ -18,7 +18,7 @@ import (
 	"github.com/mattermost/mattermost/server/public/model"
 	"github.com/mattermost/mattermost/server/public/shared/i18n"
 	"github.com/mattermost/mattermost/server/public/shared/mlog"
-	"github.com/mattermost/mattermost/server/v8/platform/shared/mail"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
 	"github.com/mattermost/mattermost/server/v8/platform/shared/templates"
 
 	"github.com/microcosm-cc/bluemonday"
@@ -810,44 +810,71 @@ func (es *Service) SendNotificationMail(to, subject, htmlBody string) error {
 	return es.sendMail(to, subject, htmlBody, "NotificationEmail")
 }
 
+// IsUndeliverable returns whether the email provider reported the address as undeliverable, through
+// a bounce or a complaint. No email is sent to such addresses while bounce handling is enabled.
+func (es *Service) IsUndeliverable(email string) bool {
+	if !*es.config().EmailSettings.EnableBounceHandling {
+		return false
+	}
+
+	if _, err := es.store.UndeliverableEmail().Get(email); err != nil {
+		var nfErr *store.ErrNotFound
+		if !errors.As(err, &nfErr) {
+			mlog.Warn("Unable to check whether the email address is undeliverable", mlog.Err(err))
+		}
+		return false
+	}
+
+	return true
+}
+
 func (es *Service) sendMail(to, subject, htmlBody, category string) error {
 	return es.sendMailWithCC(to, subject, htmlBody, "", category)
 }
 
 func (es *Service) sendEmailWithCustomReplyTo(to, subject, htmlBody, replyToAddress, category string) error {
+	if es.IsUndeliverable(to) {
+		return UndeliverableError
+	}
+
 	license := es.license()
 	mailConfig := es.mailServiceConfig(replyToAddress)
 
 	category = getSendGridCategory(category, license.IsCloud())
 
-	return mail.SendMailUsingConfig(to, subject, htmlBody, mailConfig, license != nil && *license.Features.Compliance, "", "", "", "", category)
+	return es.smtpPool.SendMailUsingConfig(to, subject, htmlBody, mailConfig, license != nil && *license.Features.Compliance, "", "", "", "", category)
 }
 
 func (es *Service) sendMailWithCC(to, subject, htmlBody, ccMail, category string) error {
+	if es.IsUndeliverable(to) {
+		return UndeliverableError
+	}
+
 	license := es.license()
 	mailConfig := es.mailServiceConfig("")
 
 	category = getSendGridCategory(category, license.IsCloud())
 
-	return mail.SendMailUsingConfig(to, subject, htmlBody, mailConfig, license != nil && *license.Features.Compliance, "", "", "", ccMail, category)
+	return es.smtpPool.SendMailUsingConfig(to, subject, htmlBody, mailConfig, license != nil && *license.Features.Compliance, "", "", "", ccMail, category)
 }
 
-func (es *Service) SendMailWithEmbeddedFilesAndCustomReplyTo(to, subject, htmlBody, replyToAddress string, embeddedFiles map[string]io.Reader, category string) error {
+// SendMailWithEmbeddedFilesAndCustomReplyTo sends an email like SendMailWithEmbeddedFiles, replacing
+// the configured reply-to address unless replyToAddress is empty.
+func (es *Service) SendMailWithEmbeddedFilesAndCustomReplyTo(to, subject, htmlBody, replyToAddress string, embeddedFiles map[string]io.Reader, messageID string, inReplyTo string, references string, category string) error {
+	if es.IsUndeliverable(to) {
+		return UndeliverableError
+	}
+
 	license := es.license()
 	mailConfig := es.mailServiceConfig(replyToAddress)
 
 	category = getSendGridCategory(category, license.IsCloud())
 
-	return mail.SendMailWithEmbeddedFilesUsingConfig(to, subject, htmlBody, embeddedFiles, mailConfig, license != nil && *license.Features.Compliance, "", "", "", "", category)
+	return es.smtpPool.SendMailWithEmbeddedFilesUsingConfig(to, subject, htmlBody, embeddedFiles, mailConfig, license != nil && *license.Features.Compliance, messageID, inReplyTo, references, "", category)
 }
 
 func (es *Service) SendMailWithEmbeddedFiles(to, subject, htmlBody string, embeddedFiles map[string]io.Reader, messageID string, inReplyTo string, references string, category string) error {
-	license := es.license()
-	mailConfig := es.mailServiceConfig("")
-
-	category = getSendGridCategory(category, license.IsCloud())
-
-	return mail.SendMailWithEmbeddedFilesUsingConfig(to, subject, htmlBody, embeddedFiles, mailConfig, license != nil && *license.Features.Compliance, messageID, inReplyTo, references, "", category)
+	return es.SendMailWithEmbeddedFilesAndCustomReplyTo(to, subject, htmlBody, "", embeddedFiles, messageID, inReplyTo, references, category)
 }
 
 func (es *Service) InvalidateVerifyEmailTokensForUser(userID string) *model.AppError {
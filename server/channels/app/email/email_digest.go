@@ -0,0 +1,158 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package email
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"io"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/i18n"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/v8/channels/utils"
+)
+
+// DigestChannel holds the activity of a channel that the recipient of a digest missed.
+type DigestChannel struct {
+	Channel  *model.Channel
+	TeamName string
+	// DisplayName is the name of the channel as seen by the recipient, which for direct
+	// messages is the name of the other user.
+	DisplayName  string
+	URL          string
+	MsgCount     int64
+	MentionCount int64
+	// Posts are the most recent posts of the channel, only included for the channels
+	// in which the recipient was mentioned.
+	Posts []*model.Post
+}
+
+type digestHighlight struct {
+	ChannelName string
+	ChannelURL  string
+	Summary     string
+}
+
+// SendEmailDigest sends the user an email summarizing the missed mentions and channel activity.
+func (es *Service) SendEmailDigest(user *model.User, channels []*DigestChannel) error {
+	translateFunc := i18n.GetUserTranslations(user.Locale)
+	displayNameFormat := *es.config().TeamSettings.TeammateNameDisplay
+	siteURL := *es.config().ServiceSettings.SiteURL
+
+	emailNotificationContentsType := model.EmailNotificationContentsFull
+	if license := es.license(); license != nil && *license.Features.EmailNotificationContents {
+		emailNotificationContentsType = *es.config().EmailSettings.EmailNotificationContentsType
+	}
+
+	var useMilitaryTime bool
+	if data, err := es.store.Preference().Get(user.Id, model.PreferenceCategoryDisplaySettings, model.PreferenceNameUseMilitaryTime); err == nil {
+		useMilitaryTime = data.Value == "true"
+	}
+
+	var mentionCount int64
+	postsData := []*postData{}
+	highlights := []*digestHighlight{}
+	embeddedFiles := make(map[string]io.Reader)
+	senderPhotos := make(map[string]string)
+
+	for _, digestChannel := range channels {
+		channel := digestChannel.Channel
+		mentionCount += digestChannel.MentionCount
+
+		channelDisplayName := digestChannel.DisplayName
+		showChannelIcon := true
+		otherChannelMembersCount := 0
+		if channel.Type == model.ChannelTypeGroup {
+			otherChannelMembersCount = len(strings.Split(digestChannel.DisplayName, ",")) - 1
+			showChannelIcon = false
+			channelDisplayName = truncateUserNames(digestChannel.DisplayName, 11)
+		}
+
+		highlights = append(highlights, &digestHighlight{
+			ChannelName: digestChannel.DisplayName,
+			ChannelURL:  digestChannel.URL,
+			Summary: translateFunc("api.email_digest.highlight", map[string]any{
+				"Messages": translateFunc("api.email_digest.highlight.messages", digestChannel.MsgCount, map[string]any{"Count": digestChannel.MsgCount}),
+				"Mentions": translateFunc("api.email_digest.highlight.mentions", digestChannel.MentionCount, map[string]any{"Count": digestChannel.MentionCount}),
+			}),
+		})
+
+		if emailNotificationContentsType != model.EmailNotificationContentsFull {
+			continue
+		}
+
+		for _, post := range digestChannel.Posts {
+			sender, err := es.userService.GetUser(post.UserId)
+			if err != nil {
+				mlog.Warn("Unable to find sender of post for email digest", mlog.String("post_id", post.Id), mlog.Err(err))
+				continue
+			}
+
+			senderPhoto, ok := senderPhotos[sender.Id]
+			if !ok {
+				senderPhoto = fmt.Sprintf("user-avatar-%d.png", len(senderPhotos))
+				senderPhotos[sender.Id] = senderPhoto
+				if senderProfileImage, _, err := es.userService.GetProfileImage(sender); err != nil {
+					mlog.Warn("Unable to get the sender user profile image.", mlog.String("user_id", sender.Id), mlog.Err(err))
+				} else {
+					embeddedFiles[senderPhoto] = bytes.NewReader(senderProfileImage)
+				}
+			}
+
+			formattedTime := utils.GetFormattedPostTime(user, post, useMilitaryTime, translateFunc)
+			postsData = append(postsData, &postData{
+				SenderPhoto:              senderPhoto,
+				SenderName:               truncateUserNames(sender.GetDisplayName(displayNameFormat), 22),
+				Time:                     translateFunc("api.email_batching.send_batched_email_notification.time", formattedTime),
+				ChannelName:              channelDisplayName,
+				Message:                  template.HTML(es.GetMessageForNotification(post, digestChannel.TeamName, siteURL, translateFunc)),
+				MessageURL:               siteURL + "/" + digestChannel.TeamName + "/pl/" + post.Id,
+				ShowChannelIcon:          showChannelIcon,
+				OtherChannelMembersCount: otherChannelMembersCount,
+				MessageAttachments:       ProcessMessageAttachments(post, siteURL),
+			})
+		}
+	}
+
+	formattedTime := utils.GetFormattedPostTime(user, &model.Post{CreateAt: model.GetMillis()}, useMilitaryTime, translateFunc)
+	subject := translateFunc("api.email_digest.subject", map[string]any{
+		"SiteName": es.config().TeamSettings.SiteName,
+		"Year":     formattedTime.Year,
+		"Month":    formattedTime.Month,
+		"Day":      formattedTime.Day,
+	})
+
+	data := es.NewEmailTemplateData(user.Locale)
+	data.Props["SiteURL"] = siteURL
+	data.Props["Title"] = translateFunc("api.email_batching.send_batched_email_notification.title")
+	if mentionCount > 0 {
+		data.Props["Title"] = translateFunc("api.email_digest.title", mentionCount, map[string]any{"Count": mentionCount})
+	}
+	data.Props["SubTitle"] = translateFunc("api.email_digest.subTitle")
+	data.Props["Button"] = translateFunc("api.email_batching.send_batched_email_notification.button")
+	data.Props["ButtonURL"] = siteURL
+	data.Props["Posts"] = postsData
+	data.Props["MessageButton"] = translateFunc("api.email_batching.send_batched_email_notification.messageButton")
+	data.Props["HighlightsTitle"] = translateFunc("api.email_digest.highlights_title")
+	data.Props["Highlights"] = highlights
+	data.Props["NotificationFooterTitle"] = translateFunc("app.notification.footer.title")
+	data.Props["NotificationFooterInfoLogin"] = translateFunc("app.notification.footer.infoLogin")
+	data.Props["NotificationFooterInfo"] = translateFunc("app.notification.footer.info")
+
+	renderedPage, err := es.templatesContainer.RenderToString("digest_notification", data)
+	if err != nil {
+		return errors.Wrap(err, "unable to render the digest email")
+	}
+
+	if err := es.SendMailWithEmbeddedFiles(user.Email, subject, renderedPage, embeddedFiles, "", "", "", "EmailDigest"); err != nil {
+		return errors.Wrap(err, "unable to send the digest email")
+	}
+
+	return nil
+}
@@ -312,6 +312,9 @@ func TestMailServiceConfig(t *testing.T) {
 					SMTPServer:                        new(string),
 					SMTPPort:                          new(string),
 					SMTPServerTimeout:                 new(int),
+					SMTPMaxConnections:                new(int),
+					SMTPMaxMessagesPerSecond:          new(int),
+					SMTPMaxRetries:                    new(int),
 					ConnectionSecurity:                new(string),
 					SendPushNotifications:             new(bool),
 					PushNotificationServer:            new(string),
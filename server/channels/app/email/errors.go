@@ -11,4 +11,5 @@ var (
 	SetupRateLimiterError  = errors.New("the rate limiter could not be set")
 	RateLimitExceededError = errors.New("the rate limit is exceeded")
 	SendMailError          = errors.New("could not send the email")
+	UndeliverableError     = errors.New("the email address is undeliverable")
 )
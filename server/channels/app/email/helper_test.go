@@ -17,6 +17,7 @@ import (
 	"github.com/mattermost/mattermost/server/v8/channels/store/storetest/mocks"
 	"github.com/mattermost/mattermost/server/v8/channels/testlib"
 	"github.com/mattermost/mattermost/server/v8/config"
+	"github.com/mattermost/mattermost/server/v8/platform/shared/mail"
 	"github.com/mattermost/mattermost/server/v8/platform/shared/templates"
 )
 
@@ -123,6 +124,7 @@ func setupTestHelper(s store.Store, tb testing.TB) *TestHelper {
 		license:            licenseFn,
 		config:             configStore.Get,
 		templatesContainer: htmlTemplateWatcher,
+		smtpPool:           mail.NewSMTPPool(),
 	}
 
 	if err := service.setUpRateLimiters(); err != nil {
@@ -11,6 +11,8 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	email "github.com/mattermost/mattermost/server/v8/channels/app/email"
+
 	model "github.com/mattermost/mattermost/server/public/model"
 
 	store "github.com/mattermost/mattermost/server/v8/channels/store"
@@ -146,6 +148,24 @@ func (_m *ServiceInterface) InitEmailBatching() {
 	_m.Called()
 }
 
+// IsUndeliverable provides a mock function with given fields: _a0
+func (_m *ServiceInterface) IsUndeliverable(_a0 string) bool {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsUndeliverable")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // NewEmailTemplateData provides a mock function with given fields: locale
 func (_m *ServiceInterface) NewEmailTemplateData(locale string) templates.Data {
 	ret := _m.Called(locale)
@@ -254,6 +274,24 @@ func (_m *ServiceInterface) SendEmailChangeVerifyEmail(newUserEmail string, loca
 	return r0
 }
 
+// SendEmailDigest provides a mock function with given fields: user, channels
+func (_m *ServiceInterface) SendEmailDigest(user *model.User, channels []*email.DigestChannel) error {
+	ret := _m.Called(user, channels)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendEmailDigest")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*model.User, []*email.DigestChannel) error); ok {
+		r0 = rf(user, channels)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SendGuestInviteEmails provides a mock function with given fields: team, channels, senderName, senderUserId, senderProfileImage, invites, siteURL, message, errorWhenNotSent, isSystemAdmin, isFirstAdmin
 func (_m *ServiceInterface) SendGuestInviteEmails(team *model.Team, channels []*model.Channel, senderName string, senderUserId string, senderProfileImage []byte, invites []string, siteURL string, message string, errorWhenNotSent bool, isSystemAdmin bool, isFirstAdmin bool) error {
 	ret := _m.Called(team, channels, senderName, senderUserId, senderProfileImage, invites, siteURL, message, errorWhenNotSent, isSystemAdmin, isFirstAdmin)
@@ -374,6 +412,24 @@ func (_m *ServiceInterface) SendMailWithEmbeddedFiles(to string, subject string,
 	return r0
 }
 
+// SendMailWithEmbeddedFilesAndCustomReplyTo provides a mock function with given fields: to, subject, htmlBody, replyToAddress, embeddedFiles, messageID, inReplyTo, references, category
+func (_m *ServiceInterface) SendMailWithEmbeddedFilesAndCustomReplyTo(to string, subject string, htmlBody string, replyToAddress string, embeddedFiles map[string]io.Reader, messageID string, inReplyTo string, references string, category string) error {
+	ret := _m.Called(to, subject, htmlBody, replyToAddress, embeddedFiles, messageID, inReplyTo, references, category)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendMailWithEmbeddedFilesAndCustomReplyTo")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, string, string, map[string]io.Reader, string, string, string, string) error); ok {
+		r0 = rf(to, subject, htmlBody, replyToAddress, embeddedFiles, messageID, inReplyTo, references, category)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SendMfaChangeEmail provides a mock function with given fields: _a0, activated, locale, siteURL
 func (_m *ServiceInterface) SendMfaChangeEmail(_a0 string, activated bool, locale string, siteURL string) error {
 	ret := _m.Called(_a0, activated, locale, siteURL)
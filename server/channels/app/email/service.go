@@ -17,6 +17,7 @@ import (
 	"github.com/mattermost/mattermost/server/public/shared/mlog"
 	"github.com/mattermost/mattermost/server/v8/channels/app/users"
 	"github.com/mattermost/mattermost/server/v8/channels/store"
+	"github.com/mattermost/mattermost/server/v8/platform/shared/mail"
 	"github.com/mattermost/mattermost/server/v8/platform/shared/templates"
 )
 
@@ -52,6 +53,10 @@ type Service struct {
 	perHourEmailRateLimiter *throttled.GCRARateLimiter
 	perDayEmailRateLimiter  *throttled.GCRARateLimiter
 	EmailBatching           *EmailBatchingJob
+
+	// smtpPool reuses the connections to the SMTP server across emails, and throttles
+	// the emails according to the configured limits.
+	smtpPool *mail.SMTPPool
 }
 
 type ServiceConfig struct {
@@ -73,6 +78,7 @@ func NewService(config ServiceConfig) (*Service, error) {
 		license:            config.LicenseFn,
 		store:              config.Store,
 		userService:        config.UserService,
+		smtpPool:           mail.NewSMTPPool(),
 	}
 	if err := service.setUpRateLimiters(); err != nil {
 		return nil, err
@@ -86,6 +92,7 @@ func (es *Service) Stop() {
 	if es.EmailBatching != nil {
 		es.EmailBatching.Stop()
 	}
+	es.smtpPool.Close()
 }
 
 func (c *ServiceConfig) validate() error {
@@ -145,9 +152,12 @@ type ServiceInterface interface {
 	SendDeactivateAccountEmail(email string, locale, siteURL string) error
 	SendNotificationMail(to, subject, htmlBody string) error
 	SendMailWithEmbeddedFiles(to, subject, htmlBody string, embeddedFiles map[string]io.Reader, messageID string, inReplyTo string, references string, category string) error
+	IsUndeliverable(email string) bool
+	SendMailWithEmbeddedFilesAndCustomReplyTo(to, subject, htmlBody, replyToAddress string, embeddedFiles map[string]io.Reader, messageID string, inReplyTo string, references string, category string) error
 	SendLicenseUpForRenewalEmail(email, name, locale, siteURL, ctaTitle, ctaLink, ctaText string, daysToExpiration int) error
 	SendRemoveExpiredLicenseEmail(ctaText, ctaLink, email, locale, siteURL string) error
 	AddNotificationEmailToBatch(user *model.User, post *model.Post, team *model.Team) *model.AppError
+	SendEmailDigest(user *model.User, channels []*DigestChannel) error
 	GetMessageForNotification(post *model.Post, teamName, siteUrl string, translateFunc i18n.TranslateFunc) string
 	GenerateHyperlinkForChannels(postMessage, teamName, teamURL string) (string, error)
 	InitEmailBatching()
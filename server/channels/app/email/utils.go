@@ -31,6 +31,9 @@ func (es *Service) mailServiceConfig(replyToAddress string) *mail.SMTPConfig {
 		FeedbackName:                      *emailSettings.FeedbackName,
 		FeedbackEmail:                     *emailSettings.FeedbackEmail,
 		ReplyToAddress:                    replyToAddress,
+		MaxConnections:                    *emailSettings.SMTPMaxConnections,
+		MaxMessagesPerSecond:              *emailSettings.SMTPMaxMessagesPerSecond,
+		MaxRetries:                        *emailSettings.SMTPMaxRetries,
 	}
 	return &cfg
 }
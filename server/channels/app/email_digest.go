@@ -0,0 +1,266 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/app/email"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+)
+
+const (
+	emailDigestMaxChannels        = 20
+	emailDigestMaxPostsPerChannel = 3
+)
+
+// GetEmailDigestSettings returns the digest schedule of the user, which is disabled unless
+// the user has set one.
+func (a *App) GetEmailDigestSettings(userID string) (*model.EmailDigestSettings, *model.AppError) {
+	preference, err := a.Srv().Store().Preference().Get(userID, model.PreferenceCategoryNotifications, model.PreferenceNameEmailDigest)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		if errors.As(err, &nfErr) {
+			return model.EmailDigestSettingsFromPreference(nil), nil
+		}
+		return nil, model.NewAppError("GetEmailDigestSettings", "app.preference.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return model.EmailDigestSettingsFromPreference(preference), nil
+}
+
+// UpdateEmailDigestSettings saves the digest schedule of the user. The activity that took
+// place before digests were enabled isn't included in the first one.
+func (a *App) UpdateEmailDigestSettings(c request.CTX, userID string, settings *model.EmailDigestSettings) *model.AppError {
+	if appErr := settings.IsValid(); appErr != nil {
+		return appErr
+	}
+
+	current, appErr := a.GetEmailDigestSettings(userID)
+	if appErr != nil {
+		return appErr
+	}
+
+	value, err := json.Marshal(settings)
+	if err != nil {
+		return model.NewAppError("UpdateEmailDigestSettings", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	preferences := model.Preferences{{
+		UserId:   userID,
+		Category: model.PreferenceCategoryNotifications,
+		Name:     model.PreferenceNameEmailDigest,
+		Value:    string(value),
+	}}
+	if settings.IsEnabled() && !current.IsEnabled() {
+		preferences = append(preferences, model.Preference{
+			UserId:   userID,
+			Category: model.PreferenceCategoryNotifications,
+			Name:     model.PreferenceNameEmailDigestLastSent,
+			Value:    strconv.FormatInt(model.GetMillis(), 10),
+		})
+	}
+
+	return a.UpdatePreferences(c, userID, preferences)
+}
+
+// userHasEmailDigest reports whether the missed activity is emailed to the user in digests
+// rather than in notification emails.
+func (a *App) userHasEmailDigest(userID string) bool {
+	settings, appErr := a.GetEmailDigestSettings(userID)
+	return appErr == nil && settings.IsEnabled()
+}
+
+// SendEmailDigests sends the digests that are due to the users who enabled them, according
+// to their schedule and timezone.
+func (a *App) SendEmailDigests() error {
+	if !*a.Config().EmailSettings.SendEmailNotifications {
+		return nil
+	}
+
+	preferences, err := a.Srv().Store().Preference().GetCategoryAndName(model.PreferenceCategoryNotifications, model.PreferenceNameEmailDigest)
+	if err != nil {
+		return model.NewAppError("SendEmailDigests", "app.preference.get_category.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	lastSentPreferences, err := a.Srv().Store().Preference().GetCategoryAndName(model.PreferenceCategoryNotifications, model.PreferenceNameEmailDigestLastSent)
+	if err != nil {
+		return model.NewAppError("SendEmailDigests", "app.preference.get_category.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	lastSentAt := make(map[string]int64, len(lastSentPreferences))
+	for _, preference := range lastSentPreferences {
+		lastSentAt[preference.UserId], _ = strconv.ParseInt(preference.Value, 10, 64)
+	}
+
+	c := request.EmptyContext(a.Log())
+	now := time.Now()
+	sent := 0
+	for i := range preferences {
+		settings := model.EmailDigestSettingsFromPreference(&preferences[i])
+		if !settings.IsEnabled() {
+			continue
+		}
+
+		user, appErr := a.GetUser(preferences[i].UserId)
+		if appErr != nil {
+			c.Logger().Warn("Unable to get the recipient of an email digest", mlog.String("user_id", preferences[i].UserId), mlog.Err(appErr))
+			continue
+		}
+		if user.DeleteAt != 0 || user.IsBot || (*a.Config().EmailSettings.RequireEmailVerification && !user.EmailVerified) {
+			continue
+		}
+
+		if !settings.IsDue(now, lastSentAt[user.Id], user.GetTimezoneLocation()) {
+			continue
+		}
+
+		if err := a.sendEmailDigest(c, user, lastSentAt[user.Id]); err != nil {
+			c.Logger().Warn("Unable to send email digest", mlog.String("user_id", user.Id), mlog.Err(err))
+			continue
+		}
+		sent++
+
+		if err := a.Srv().Store().Preference().Save(model.Preferences{{
+			UserId:   user.Id,
+			Category: model.PreferenceCategoryNotifications,
+			Name:     model.PreferenceNameEmailDigestLastSent,
+			Value:    strconv.FormatInt(now.UnixMilli(), 10),
+		}}); err != nil {
+			c.Logger().Warn("Unable to save when the email digest was sent", mlog.String("user_id", user.Id), mlog.Err(err))
+		}
+	}
+
+	c.Logger().Debug("Email digests sent", mlog.Int("count", sent))
+
+	return nil
+}
+
+// sendEmailDigest emails the user the channels with activity the user hasn't seen since the
+// last digest, along with the latest posts of the channels in which the user was mentioned.
+// Nothing is sent if there is no such activity.
+func (a *App) sendEmailDigest(c request.CTX, user *model.User, since int64) error {
+	unreads, err := a.Srv().Store().Team().GetChannelUnreadsSince(user.Id, since, emailDigestMaxChannels)
+	if err != nil {
+		return errors.Wrap(err, "unable to get the unread channels")
+	}
+
+	// Muted channels are left out unless the user was mentioned in them.
+	filtered := unreads[:0]
+	channelIDs := make([]string, 0, len(unreads))
+	for _, unread := range unreads {
+		if unread.MentionCount == 0 && unread.NotifyProps[model.MarkUnreadNotifyProp] == model.ChannelMarkUnreadMention {
+			continue
+		}
+		filtered = append(filtered, unread)
+		channelIDs = append(channelIDs, unread.ChannelId)
+	}
+	if len(filtered) == 0 {
+		return nil
+	}
+
+	channels, err := a.Srv().Store().Channel().GetChannelsByIds(channelIDs, false)
+	if err != nil {
+		return errors.Wrap(err, "unable to get the unread channels")
+	}
+	channelsByID := make(map[string]*model.Channel, len(channels))
+	for _, channel := range channels {
+		channelsByID[channel.Id] = channel
+	}
+
+	teamNames := make(map[string]string)
+	teamName := func(teamID string) (string, error) {
+		if name, ok := teamNames[teamID]; ok {
+			return name, nil
+		}
+		var name string
+		if teamID == "" {
+			// Direct and group messages are linked to in the first team of the user.
+			teams, err := a.Srv().Store().Team().GetTeamsByUserId(user.Id)
+			if err != nil {
+				return "", errors.Wrap(err, "unable to get the teams of the user")
+			}
+			name = "select_team"
+			if len(teams) > 0 {
+				name = teams[0].Name
+			}
+		} else {
+			team, err := a.Srv().Store().Team().Get(teamID)
+			if err != nil {
+				return "", errors.Wrap(err, "unable to get the team of the channel")
+			}
+			name = team.Name
+		}
+		teamNames[teamID] = name
+		return name, nil
+	}
+
+	siteURL := a.GetSiteURL()
+	nameFormat := a.GetNotificationNameFormat(user)
+	digestChannels := make([]*email.DigestChannel, 0, len(filtered))
+	for _, unread := range filtered {
+		channel, ok := channelsByID[unread.ChannelId]
+		if !ok {
+			continue
+		}
+
+		name, err := teamName(channel.TeamId)
+		if err != nil {
+			return err
+		}
+
+		digestChannel := &email.DigestChannel{
+			Channel:      channel,
+			TeamName:     name,
+			DisplayName:  channel.DisplayName,
+			URL:          siteURL + "/" + name + "/channels/" + channel.Name,
+			MsgCount:     unread.MsgCount,
+			MentionCount: unread.MentionCount,
+		}
+		switch channel.Type {
+		case model.ChannelTypeDirect:
+			otherUser, appErr := a.GetUser(channel.GetOtherUserIdForDM(user.Id))
+			if appErr != nil {
+				c.Logger().Warn("Unable to get the other user of a direct channel", mlog.String("channel_id", channel.Id), mlog.Err(appErr))
+				continue
+			}
+			digestChannel.DisplayName = otherUser.GetDisplayName(nameFormat)
+			digestChannel.URL = siteURL + "/" + name + "/messages/@" + otherUser.Username
+		case model.ChannelTypeGroup:
+			digestChannel.URL = siteURL + "/" + name + "/messages/" + channel.Name
+		}
+
+		if unread.MentionCount > 0 {
+			postList, err := a.Srv().Store().Post().GetPosts(model.GetPostsOptions{
+				ChannelId: channel.Id,
+				PerPage:   emailDigestMaxPostsPerChannel,
+			}, false, map[string]bool{})
+			if err != nil {
+				return errors.Wrap(err, "unable to get the posts of the channel")
+			}
+			// The posts are listed from the newest to the oldest.
+			for i := len(postList.Order) - 1; i >= 0; i-- {
+				post := postList.Posts[postList.Order[i]]
+				if post.CreateAt <= since || post.UserId == user.Id || post.IsSystemMessage() {
+					continue
+				}
+				digestChannel.Posts = append(digestChannel.Posts, post)
+			}
+		}
+
+		digestChannels = append(digestChannels, digestChannel)
+	}
+
+	if len(digestChannels) == 0 {
+		return nil
+	}
+
+	return a.Srv().EmailService.SendEmailDigest(user, digestChannels)
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/v8/channels/app/email"
+	emailmocks "github.com/mattermost/mattermost/server/v8/channels/app/email/mocks"
+)
+
+func TestEmailDigestSettings(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	settings, appErr := th.App.GetEmailDigestSettings(th.BasicUser.Id)
+	require.Nil(t, appErr)
+	assert.Equal(t, model.EmailDigestFrequencyOff, settings.Frequency)
+	assert.False(t, th.App.userHasEmailDigest(th.BasicUser.Id))
+
+	appErr = th.App.UpdateEmailDigestSettings(th.Context, th.BasicUser.Id, &model.EmailDigestSettings{Frequency: "weekly"})
+	require.NotNil(t, appErr)
+
+	appErr = th.App.UpdateEmailDigestSettings(th.Context, th.BasicUser.Id, &model.EmailDigestSettings{Frequency: model.EmailDigestFrequencyDaily, Hour: 8})
+	require.Nil(t, appErr)
+
+	settings, appErr = th.App.GetEmailDigestSettings(th.BasicUser.Id)
+	require.Nil(t, appErr)
+	assert.Equal(t, &model.EmailDigestSettings{Frequency: model.EmailDigestFrequencyDaily, Hour: 8}, settings)
+	assert.True(t, th.App.userHasEmailDigest(th.BasicUser.Id))
+
+	// Enabling the digests starts them from now on.
+	lastSent, err := th.App.Srv().Store().Preference().Get(th.BasicUser.Id, model.PreferenceCategoryNotifications, model.PreferenceNameEmailDigestLastSent)
+	require.NoError(t, err)
+	lastSentAt, err := strconv.ParseInt(lastSent.Value, 10, 64)
+	require.NoError(t, err)
+	assert.InDelta(t, model.GetMillis(), lastSentAt, 60000)
+}
+
+func TestSendEmailDigests(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.EmailSettings.SendEmailNotifications = true
+	})
+
+	user := th.BasicUser
+	require.Nil(t, th.App.UpdateEmailDigestSettings(th.Context, user.Id, &model.EmailDigestSettings{Frequency: model.EmailDigestFrequencyHourly}))
+	// Pretend the last digest was sent two hours ago.
+	require.NoError(t, th.App.Srv().Store().Preference().Save(model.Preferences{{
+		UserId:   user.Id,
+		Category: model.PreferenceCategoryNotifications,
+		Name:     model.PreferenceNameEmailDigestLastSent,
+		Value:    strconv.FormatInt(model.GetMillis()-2*60*60*1000, 10),
+	}}))
+
+	mention, appErr := th.App.CreatePost(th.Context, &model.Post{
+		UserId:    th.BasicUser2.Id,
+		ChannelId: th.BasicChannel.Id,
+		Message:   "@" + user.Username + " hello",
+	}, th.BasicChannel, false, true)
+	require.Nil(t, appErr)
+	require.NoError(t, th.App.Srv().Store().Channel().IncrementMentionCount(th.BasicChannel.Id, []string{user.Id}, false, false))
+
+	emailServiceMock := emailmocks.ServiceInterface{}
+	emailServiceMock.On("SendEmailDigest", mock.MatchedBy(func(u *model.User) bool {
+		return u.Id == user.Id
+	}), mock.MatchedBy(func(channels []*email.DigestChannel) bool {
+		for _, channel := range channels {
+			if channel.Channel.Id == th.BasicChannel.Id {
+				return channel.MentionCount > 0 && len(channel.Posts) > 0 && channel.Posts[len(channel.Posts)-1].Id == mention.Id
+			}
+		}
+		return false
+	})).Once().Return(nil)
+	emailServiceMock.On("Stop").Once().Return()
+	th.App.Srv().EmailService = &emailServiceMock
+
+	require.NoError(t, th.App.SendEmailDigests())
+
+	// The digest isn't sent again before the next hour.
+	require.NoError(t, th.App.SendEmailDigests())
+	emailServiceMock.AssertExpectations(t)
+}
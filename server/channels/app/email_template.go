@@ -0,0 +1,83 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/i18n"
+)
+
+// GetEmailTemplates returns the templates used to render the emails, and whether they are
+// overridden by the templates of EmailSettings.EmailTemplateOverridesDirectory.
+func (a *App) GetEmailTemplates() []*model.EmailTemplate {
+	container := a.Srv().TemplatesContainer()
+
+	overridden := map[string]bool{}
+	for _, name := range container.Overridden() {
+		overridden[name] = true
+	}
+
+	names := container.Names()
+	emailTemplates := make([]*model.EmailTemplate, 0, len(names))
+	for _, name := range names {
+		emailTemplates = append(emailTemplates, &model.EmailTemplate{Name: name, Overridden: overridden[name]})
+	}
+	return emailTemplates
+}
+
+// ValidateEmailTemplateOverride checks that the content of a template override is valid, and
+// returns the templates it defines.
+func (a *App) ValidateEmailTemplateOverride(override *model.EmailTemplateOverride) ([]*model.EmailTemplate, *model.AppError) {
+	container := a.Srv().TemplatesContainer()
+
+	names, err := container.Validate(override.Content)
+	if err != nil {
+		return nil, model.NewAppError("ValidateEmailTemplateOverride", "app.email_template.invalid_override.app_error", map[string]any{"Error": err.Error()}, "", http.StatusBadRequest).Wrap(err)
+	}
+
+	existing := map[string]bool{}
+	for _, name := range container.Names() {
+		existing[name] = true
+	}
+
+	emailTemplates := make([]*model.EmailTemplate, 0, len(names))
+	for _, name := range names {
+		emailTemplates = append(emailTemplates, &model.EmailTemplate{Name: name, Overridden: existing[name]})
+	}
+	return emailTemplates, nil
+}
+
+// PreviewEmailTemplate renders an email template with sample data, overriding the templates with
+// the content of the request, if any.
+func (a *App) PreviewEmailTemplate(request *model.EmailTemplatePreviewRequest) (*model.EmailTemplatePreview, *model.AppError) {
+	locale := request.Locale
+	if locale == "" {
+		locale = *a.Config().LocalizationSettings.DefaultServerLocale
+	}
+	T := i18n.GetUserTranslations(locale)
+
+	data := a.Srv().EmailService.NewEmailTemplateData(locale)
+	data.Props["SiteURL"] = a.GetSiteURL()
+	data.Props["Title"] = T("api.templates.verify_body.title")
+	data.Props["SubTitle"] = T("api.templates.verify_body.subTitle1")
+	data.Props["SubTitle1"] = T("api.templates.verify_body.subTitle1")
+	data.Props["SubTitle2"] = T("api.templates.verify_body.subTitle2")
+	data.Props["ButtonURL"] = a.GetSiteURL()
+	data.Props["Button"] = T("api.templates.verify_body.button")
+	data.Props["Info"] = T("api.templates.verify_body.info")
+	data.Props["QuestionTitle"] = T("api.templates.questions_footer.title")
+	data.Props["QuestionInfo"] = T("api.templates.questions_footer.info")
+	for key, value := range request.Props {
+		data.Props[key] = value
+	}
+
+	html, err := a.Srv().TemplatesContainer().RenderPreview(request.TemplateName, request.Content, data)
+	if err != nil {
+		return nil, model.NewAppError("PreviewEmailTemplate", "app.email_template.preview.app_error", map[string]any{"Error": err.Error()}, "", http.StatusBadRequest).Wrap(err)
+	}
+
+	return &model.EmailTemplatePreview{Html: html}, nil
+}
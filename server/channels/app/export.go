@@ -68,16 +68,118 @@ var exportablePreferences = map[imports.ComparablePreference]string{{
 }: "EmailInterval",
 }
 
+// exportScope keeps track of what a filtered export includes.
+type exportScope struct {
+	opts       model.BulkExportOpts
+	teamIDs    map[string]bool
+	channelIDs map[string]bool
+
+	// exportedChannels contains the ids of the channels written to a filtered export.
+	exportedChannels map[string]bool
+}
+
+func (a *App) newExportScope(opts model.BulkExportOpts) (*exportScope, *model.AppError) {
+	scope := &exportScope{
+		opts:             opts,
+		teamIDs:          make(map[string]bool, len(opts.TeamIds)),
+		channelIDs:       make(map[string]bool, len(opts.ChannelIds)),
+		exportedChannels: make(map[string]bool),
+	}
+
+	for _, teamID := range opts.TeamIds {
+		scope.teamIDs[teamID] = true
+	}
+
+	if len(opts.ChannelIds) > 0 {
+		channels, err := a.Srv().Store().Channel().GetMany(opts.ChannelIds, false)
+		if err != nil {
+			return nil, model.NewAppError("BulkExport", "app.channel.get_channels_by_ids.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+		for _, channel := range channels {
+			scope.channelIDs[channel.Id] = true
+			// The team of an exported channel has to be exported too.
+			if len(opts.TeamIds) == 0 {
+				scope.teamIDs[channel.TeamId] = true
+			}
+		}
+	}
+
+	return scope, nil
+}
+
+func (s *exportScope) includesTeam(teamID string) bool {
+	return !s.opts.IsFiltered() || s.teamIDs[teamID]
+}
+
+func (s *exportScope) includesChannel(channelID string) bool {
+	return len(s.channelIDs) == 0 || s.channelIDs[channelID]
+}
+
+// includesExportedChannel reports whether the channel was written to the export.
+func (s *exportScope) includesExportedChannel(channelID string) bool {
+	return !s.opts.IsFiltered() || s.exportedChannels[channelID]
+}
+
+// chunkedExportWriter splits the JSONL file of an export archive into several files of at
+// most chunkSize lines. The first line written, which is the version line, is repeated at the
+// start of every chunk and isn't counted in chunkSize, so every chunk holds some data. It relies
+// on exportWriteLine writing a line at a time.
+type chunkedExportWriter struct {
+	zipWr     *zip.Writer
+	chunkSize int
+
+	current io.Writer
+	chunk   int
+	lines   int
+	header  []byte
+}
+
+func (w *chunkedExportWriter) Write(line []byte) (int, error) {
+	if w.header == nil {
+		w.header = append([]byte{}, line...)
+		if err := w.nextChunk(); err != nil {
+			return 0, err
+		}
+		return len(line), nil
+	}
+
+	if w.lines >= w.chunkSize {
+		if err := w.nextChunk(); err != nil {
+			return 0, err
+		}
+	}
+
+	w.lines++
+	return w.current.Write(line)
+}
+
+func (w *chunkedExportWriter) nextChunk() error {
+	w.chunk++
+	current, err := w.zipWr.Create(fmt.Sprintf("import_%04d.jsonl", w.chunk))
+	if err != nil {
+		return err
+	}
+	w.current = current
+	w.lines = 0
+
+	_, err = w.current.Write(w.header)
+	return err
+}
+
 func (a *App) BulkExport(ctx request.CTX, writer io.Writer, outPath string, job *model.Job, opts model.BulkExportOpts) *model.AppError {
 	var zipWr *zip.Writer
 	if opts.CreateArchive {
 		var err error
 		zipWr = zip.NewWriter(writer)
 		defer zipWr.Close()
-		writer, err = zipWr.Create("import.jsonl")
-		if err != nil {
-			return model.NewAppError("BulkExport", "app.export.zip_create.error",
-				nil, "err="+err.Error(), http.StatusInternalServerError)
+		if opts.ChunkSize > 0 {
+			writer = &chunkedExportWriter{zipWr: zipWr, chunkSize: opts.ChunkSize}
+		} else {
+			writer, err = zipWr.Create("import.jsonl")
+			if err != nil {
+				return model.NewAppError("BulkExport", "app.export.zip_create.error",
+					nil, "err="+err.Error(), http.StatusInternalServerError)
+			}
 		}
 	}
 
@@ -85,6 +187,11 @@ func (a *App) BulkExport(ctx request.CTX, writer io.Writer, outPath string, job
 		job.Data = make(model.StringMap)
 	}
 
+	scope, appErr := a.newExportScope(opts)
+	if appErr != nil {
+		return appErr
+	}
+
 	ctx.Logger().Info("Bulk export: exporting version")
 	if err := a.exportVersion(writer); err != nil {
 		return err
@@ -97,24 +204,24 @@ func (a *App) BulkExport(ctx request.CTX, writer io.Writer, outPath string, job
 	}
 
 	ctx.Logger().Info("Bulk export: exporting teams")
-	teamNames, err := a.exportAllTeams(ctx, job, writer)
+	teamNames, err := a.exportAllTeams(ctx, job, writer, scope)
 	if err != nil {
 		return err
 	}
 
 	ctx.Logger().Info("Bulk export: exporting channels")
-	if err = a.exportAllChannels(ctx, job, writer, teamNames, opts.IncludeArchivedChannels); err != nil {
+	if err = a.exportAllChannels(ctx, job, writer, teamNames, opts.IncludeArchivedChannels, scope); err != nil {
 		return err
 	}
 
 	ctx.Logger().Info("Bulk export: exporting users")
-	profilePictures, err := a.exportAllUsers(ctx, job, writer, opts.IncludeArchivedChannels, opts.IncludeProfilePictures)
+	profilePictures, err := a.exportAllUsers(ctx, job, writer, opts.IncludeArchivedChannels, opts.IncludeProfilePictures, teamNames, scope)
 	if err != nil {
 		return err
 	}
 
 	ctx.Logger().Info("Bulk export: exporting posts")
-	attachments, err := a.exportAllPosts(ctx, job, writer, opts.IncludeAttachments, opts.IncludeArchivedChannels)
+	attachments, err := a.exportAllPosts(ctx, job, writer, opts.IncludeAttachments, opts.IncludeArchivedChannels, scope)
 	if err != nil {
 		return err
 	}
@@ -125,15 +232,18 @@ func (a *App) BulkExport(ctx request.CTX, writer io.Writer, outPath string, job
 		return err
 	}
 
-	ctx.Logger().Info("Bulk export: exporting direct channels")
-	if err = a.exportAllDirectChannels(ctx, job, writer, opts.IncludeArchivedChannels); err != nil {
-		return err
-	}
+	var directAttachments []imports.AttachmentImportData
+	if !opts.IsFiltered() {
+		ctx.Logger().Info("Bulk export: exporting direct channels")
+		if err = a.exportAllDirectChannels(ctx, job, writer, opts.IncludeArchivedChannels); err != nil {
+			return err
+		}
 
-	ctx.Logger().Info("Bulk export: exporting direct posts")
-	directAttachments, err := a.exportAllDirectPosts(ctx, job, writer, opts.IncludeAttachments, opts.IncludeArchivedChannels)
-	if err != nil {
-		return err
+		ctx.Logger().Info("Bulk export: exporting direct posts")
+		directAttachments, err = a.exportAllDirectPosts(ctx, job, writer, opts.IncludeAttachments, opts.IncludeArchivedChannels, scope)
+		if err != nil {
+			return err
+		}
 	}
 
 	if opts.IncludeAttachments {
@@ -322,7 +432,7 @@ func (a *App) exportSchemes(ctx request.CTX, job *model.Job, writer io.Writer, s
 	}
 }
 
-func (a *App) exportAllTeams(ctx request.CTX, job *model.Job, writer io.Writer) (map[string]bool, *model.AppError) {
+func (a *App) exportAllTeams(ctx request.CTX, job *model.Job, writer io.Writer, scope *exportScope) (map[string]bool, *model.AppError) {
 	afterId := strings.Repeat("0", 26)
 	teamNames := make(map[string]bool)
 	cnt := 0
@@ -345,6 +455,10 @@ func (a *App) exportAllTeams(ctx request.CTX, job *model.Job, writer io.Writer)
 			if team.DeleteAt != 0 {
 				continue
 			}
+			// Skip teams that aren't part of a filtered export.
+			if !scope.includesTeam(team.Id) {
+				continue
+			}
 			teamNames[team.Name] = true
 
 			teamLine := ImportLineFromTeam(team)
@@ -357,7 +471,7 @@ func (a *App) exportAllTeams(ctx request.CTX, job *model.Job, writer io.Writer)
 	return teamNames, nil
 }
 
-func (a *App) exportAllChannels(ctx request.CTX, job *model.Job, writer io.Writer, teamNames map[string]bool, withArchived bool) *model.AppError {
+func (a *App) exportAllChannels(ctx request.CTX, job *model.Job, writer io.Writer, teamNames map[string]bool, withArchived bool, scope *exportScope) *model.AppError {
 	afterId := strings.Repeat("0", 26)
 	cnt := 0
 	for {
@@ -380,10 +494,17 @@ func (a *App) exportAllChannels(ctx request.CTX, job *model.Job, writer io.Write
 			if channel.DeleteAt != 0 && !withArchived {
 				continue
 			}
-			// Skip channels on deleted teams.
+			// Skip channels on deleted or filtered out teams.
 			if ok := teamNames[channel.TeamName]; !ok {
 				continue
 			}
+			// Skip channels that aren't part of a filtered export.
+			if !scope.includesChannel(channel.Id) {
+				continue
+			}
+			if scope.opts.IsFiltered() {
+				scope.exportedChannels[channel.Id] = true
+			}
 
 			channelLine := ImportLineFromChannel(channel)
 			if err := a.exportWriteLine(writer, channelLine); err != nil {
@@ -395,7 +516,7 @@ func (a *App) exportAllChannels(ctx request.CTX, job *model.Job, writer io.Write
 	return nil
 }
 
-func (a *App) exportAllUsers(ctx request.CTX, job *model.Job, writer io.Writer, includeArchivedChannels, includeProfilePictures bool) ([]string, *model.AppError) {
+func (a *App) exportAllUsers(ctx request.CTX, job *model.Job, writer io.Writer, includeArchivedChannels, includeProfilePictures bool, teamNames map[string]bool, scope *exportScope) ([]string, *model.AppError) {
 	afterId := strings.Repeat("0", 26)
 	cnt := 0
 	profilePictures := []string{}
@@ -470,7 +591,7 @@ func (a *App) exportAllUsers(ctx request.CTX, job *model.Job, writer io.Writer,
 			userLine.User.NotifyProps = a.buildUserNotifyProps(user.NotifyProps)
 
 			// Do the Team Memberships.
-			members, err := a.buildUserTeamAndChannelMemberships(ctx, user.Id, includeArchivedChannels)
+			members, err := a.buildUserTeamAndChannelMemberships(ctx, user.Id, includeArchivedChannels, teamNames, scope)
 			if err != nil {
 				return profilePictures, err
 			}
@@ -486,7 +607,7 @@ func (a *App) exportAllUsers(ctx request.CTX, job *model.Job, writer io.Writer,
 	return profilePictures, nil
 }
 
-func (a *App) buildUserTeamAndChannelMemberships(c request.CTX, userID string, includeArchivedChannels bool) (*[]imports.UserTeamImportData, *model.AppError) {
+func (a *App) buildUserTeamAndChannelMemberships(c request.CTX, userID string, includeArchivedChannels bool, teamNames map[string]bool, scope *exportScope) (*[]imports.UserTeamImportData, *model.AppError) {
 	var memberships []imports.UserTeamImportData
 
 	members, err := a.Srv().Store().Team().GetTeamMembersForExport(userID)
@@ -501,10 +622,15 @@ func (a *App) buildUserTeamAndChannelMemberships(c request.CTX, userID string, i
 			continue
 		}
 
+		// Skip teams that aren't part of a filtered export.
+		if scope.opts.IsFiltered() && !teamNames[member.TeamName] {
+			continue
+		}
+
 		memberData := ImportUserTeamDataFromTeamMember(member)
 
 		// Do the Channel Memberships.
-		channelMembers, err := a.buildUserChannelMemberships(c, userID, member.TeamId, includeArchivedChannels)
+		channelMembers, err := a.buildUserChannelMemberships(c, userID, member.TeamId, includeArchivedChannels, scope)
 		if err != nil {
 			return nil, err
 		}
@@ -523,7 +649,7 @@ func (a *App) buildUserTeamAndChannelMemberships(c request.CTX, userID string, i
 	return &memberships, nil
 }
 
-func (a *App) buildUserChannelMemberships(c request.CTX, userID string, teamID string, includeArchivedChannels bool) (*[]imports.UserChannelImportData, *model.AppError) {
+func (a *App) buildUserChannelMemberships(c request.CTX, userID string, teamID string, includeArchivedChannels bool, scope *exportScope) (*[]imports.UserChannelImportData, *model.AppError) {
 	members, nErr := a.Srv().Store().Channel().GetChannelMembersForExport(userID, teamID, includeArchivedChannels)
 	if nErr != nil {
 		return nil, model.NewAppError("buildUserChannelMemberships", "app.channel.get_members.app_error", nil, "", http.StatusInternalServerError).Wrap(nErr)
@@ -535,9 +661,13 @@ func (a *App) buildUserChannelMemberships(c request.CTX, userID string, teamID s
 		return nil, err
 	}
 
-	memberships := make([]imports.UserChannelImportData, len(members))
-	for i, member := range members {
-		memberships[i] = *ImportUserChannelDataFromChannelMemberAndPreferences(member, &preferences)
+	memberships := make([]imports.UserChannelImportData, 0, len(members))
+	for _, member := range members {
+		// Skip channels that aren't part of a filtered export.
+		if !scope.includesExportedChannel(member.ChannelId) {
+			continue
+		}
+		memberships = append(memberships, *ImportUserChannelDataFromChannelMemberAndPreferences(member, &preferences))
 	}
 	return &memberships, nil
 }
@@ -562,7 +692,7 @@ func (a *App) buildUserNotifyProps(notifyProps model.StringMap) *imports.UserNot
 	}
 }
 
-func (a *App) exportAllPosts(ctx request.CTX, job *model.Job, writer io.Writer, withAttachments bool, includeArchivedChannels bool) ([]imports.AttachmentImportData, *model.AppError) {
+func (a *App) exportAllPosts(ctx request.CTX, job *model.Job, writer io.Writer, withAttachments bool, includeArchivedChannels bool, scope *exportScope) ([]imports.AttachmentImportData, *model.AppError) {
 	var attachments []imports.AttachmentImportData
 	afterId := strings.Repeat("0", 26)
 	var postProcessCount uint64
@@ -595,6 +725,11 @@ func (a *App) exportAllPosts(ctx request.CTX, job *model.Job, writer io.Writer,
 				continue
 			}
 
+			// Skip threads outside of the exported channels and date range.
+			if !scope.includesExportedChannel(post.ChannelId) || !scope.opts.IncludesTime(post.CreateAt) {
+				continue
+			}
+
 			postLine := ImportLineForPost(post)
 
 			replies, replyAttachments, err := a.buildPostReplies(ctx, post.Id, withAttachments)
@@ -857,7 +992,7 @@ func (a *App) buildFavoritedByList(channelID string) ([]string, *model.AppError)
 	return userIDs, nil
 }
 
-func (a *App) exportAllDirectPosts(ctx request.CTX, job *model.Job, writer io.Writer, withAttachments, includeArchivedChannels bool) ([]imports.AttachmentImportData, *model.AppError) {
+func (a *App) exportAllDirectPosts(ctx request.CTX, job *model.Job, writer io.Writer, withAttachments, includeArchivedChannels bool, scope *exportScope) ([]imports.AttachmentImportData, *model.AppError) {
 	var attachments []imports.AttachmentImportData
 	afterId := strings.Repeat("0", 26)
 	var postProcessCount uint64
@@ -890,6 +1025,11 @@ func (a *App) exportAllDirectPosts(ctx request.CTX, job *model.Job, writer io.Wr
 				continue
 			}
 
+			// Skip threads outside of the exported date range.
+			if !scope.opts.IncludesTime(post.CreateAt) {
+				continue
+			}
+
 			// Handle attachments.
 			var postAttachments []imports.AttachmentImportData
 			var err *model.AppError
@@ -168,6 +168,7 @@ func ImportLineForPost(post *model.PostForExport) *imports.LineImportData {
 	return &imports.LineImportData{
 		Type: "post",
 		Post: &imports.PostImportData{
+			Id:       &post.Id,
 			Team:     &post.TeamName,
 			Channel:  &post.ChannelName,
 			User:     &post.Username,
@@ -188,6 +189,7 @@ func ImportLineForDirectPost(post *model.DirectPostForExport) *imports.LineImpor
 	return &imports.LineImportData{
 		Type: "direct_post",
 		DirectPost: &imports.DirectPostImportData{
+			Id:             &post.Id,
 			ChannelMembers: &channelMembers,
 			User:           &post.User,
 			Type:           &post.Type,
@@ -201,6 +203,7 @@ func ImportLineForDirectPost(post *model.DirectPostForExport) *imports.LineImpor
 
 func ImportReplyFromPost(post *model.ReplyForExport) *imports.ReplyImportData {
 	return &imports.ReplyImportData{
+		Id:       &post.Id,
 		User:     &post.Username,
 		Type:     &post.Type,
 		Message:  &post.Message,
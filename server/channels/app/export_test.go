@@ -4,8 +4,10 @@
 package app
 
 import (
+	"archive/zip"
 	"bytes"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"sort"
@@ -104,7 +106,7 @@ func TestExportUserChannels(t *testing.T) {
 	require.NoError(t, err)
 
 	th.App.UpdateChannelMemberNotifyProps(th.Context, notifyProps, channel.Id, user.Id)
-	exportData, appErr := th.App.buildUserChannelMemberships(th.Context, user.Id, team.Id, false)
+	exportData, appErr := th.App.buildUserChannelMemberships(th.Context, user.Id, team.Id, false, &exportScope{})
 	require.Nil(t, appErr)
 	assert.Equal(t, len(*exportData), 3)
 	for _, data := range *exportData {
@@ -805,6 +807,91 @@ func TestExportArchivedChannels(t *testing.T) {
 	require.True(t, found, "archived channel not found after import")
 }
 
+func TestExportFiltered(t *testing.T) {
+	th1 := Setup(t).InitBasic()
+	defer th1.TearDown()
+
+	otherTeam := th1.CreateTeam()
+	otherChannel := th1.CreateChannel(th1.Context, otherTeam)
+	th1.CreatePost(otherChannel)
+
+	oldPost, appErr := th1.App.CreatePost(th1.Context, &model.Post{
+		UserId:    th1.BasicUser.Id,
+		ChannelId: th1.BasicChannel.Id,
+		Message:   "old post",
+		CreateAt:  model.GetMillis() - 10000,
+	}, th1.BasicChannel, false, false)
+	require.Nil(t, appErr)
+	newPost := th1.CreatePost(th1.BasicChannel)
+	filteredChannel := th1.CreateChannel(th1.Context, th1.BasicTeam)
+	th1.CreatePost(filteredChannel)
+
+	var b bytes.Buffer
+	appErr = th1.App.BulkExport(th1.Context, &b, "somePath", nil, model.BulkExportOpts{
+		ChannelIds: []string{th1.BasicChannel.Id},
+		StartTime:  oldPost.CreateAt + 1,
+	})
+	require.Nil(t, appErr)
+
+	th2 := Setup(t)
+	defer th2.TearDown()
+	appErr, i := th2.App.BulkImport(th2.Context, &b, nil, false, 5)
+	require.Nil(t, appErr)
+	assert.Equal(t, 0, i)
+
+	_, appErr = th2.App.GetTeamByName(otherTeam.Name)
+	require.NotNil(t, appErr, "the team of the filtered out channel shouldn't be exported")
+
+	team2, appErr := th2.App.GetTeamByName(th1.BasicTeam.Name)
+	require.Nil(t, appErr)
+
+	_, appErr = th2.App.GetChannelByName(th2.Context, filteredChannel.Name, team2.Id, false)
+	require.NotNil(t, appErr, "the filtered out channel shouldn't be exported")
+
+	channel2, appErr := th2.App.GetChannelByName(th2.Context, th1.BasicChannel.Name, team2.Id, false)
+	require.Nil(t, appErr)
+
+	posts, err := th2.App.Srv().Store().Post().GetPostsSince(model.GetPostsSinceOptions{ChannelId: channel2.Id}, false, map[string]bool{})
+	require.NoError(t, err)
+	var messages []string
+	for _, post := range posts.Posts {
+		messages = append(messages, post.Message)
+	}
+	assert.Contains(t, messages, newPost.Message)
+	assert.NotContains(t, messages, oldPost.Message, "posts before the start time shouldn't be exported")
+}
+
+func TestExportChunked(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	var b bytes.Buffer
+	appErr := th.App.BulkExport(th.Context, &b, "somePath", nil, model.BulkExportOpts{
+		CreateArchive: true,
+		ChunkSize:     2,
+	})
+	require.Nil(t, appErr)
+
+	zipReader, err := zip.NewReader(bytes.NewReader(b.Bytes()), int64(b.Len()))
+	require.NoError(t, err)
+	require.Greater(t, len(zipReader.File), 1)
+
+	for i, file := range zipReader.File {
+		require.Equal(t, fmt.Sprintf("import_%04d.jsonl", i+1), file.Name)
+
+		reader, err := file.Open()
+		require.NoError(t, err)
+		data, err := io.ReadAll(reader)
+		reader.Close()
+		require.NoError(t, err)
+
+		lines := bytes.Split(bytes.TrimSpace(data), []byte("\n"))
+		require.Contains(t, string(lines[0]), `"type":"version"`, "every chunk should start with the version line")
+		require.LessOrEqual(t, len(lines[1:]), 2, "the version line shouldn't count toward the chunk size")
+		require.NotEmpty(t, lines[1:])
+	}
+}
+
 func TestExportRoles(t *testing.T) {
 	t.Run("defaults", func(t *testing.T) {
 		th1 := Setup(t).InitBasic()
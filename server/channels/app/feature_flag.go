@@ -0,0 +1,105 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+	"sort"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+func (a *App) GetFeatureFlags() []*model.FeatureFlagStatus {
+	cfg := a.Config()
+
+	flags := []*model.FeatureFlagStatus{}
+	for name, value := range cfg.FeatureFlags.ToMap() {
+		flags = append(flags, &model.FeatureFlagStatus{
+			Name:  name,
+			Value: value,
+			Rule:  cfg.FeatureFlagSettings.Rules[name],
+		})
+	}
+	sort.Slice(flags, func(i, j int) bool {
+		return flags[i].Name < flags[j].Name
+	})
+
+	return flags
+}
+
+func (a *App) GetFeatureFlag(name string) (*model.FeatureFlagStatus, *model.AppError) {
+	cfg := a.Config()
+
+	value, ok := cfg.FeatureFlags.ToMap()[name]
+	if !ok {
+		return nil, model.NewAppError("GetFeatureFlag", "app.feature_flag.get.not_found.app_error", map[string]any{"Name": name}, "", http.StatusNotFound)
+	}
+
+	return &model.FeatureFlagStatus{
+		Name:  name,
+		Value: value,
+		Rule:  cfg.FeatureFlagSettings.Rules[name],
+	}, nil
+}
+
+// SetFeatureFlagRule saves the targeting rule of the feature flag in the config, replacing the
+// existing one.
+func (a *App) SetFeatureFlagRule(name string, rule *model.FeatureFlagRule) (*model.FeatureFlagStatus, *model.AppError) {
+	if _, appErr := a.GetFeatureFlag(name); appErr != nil {
+		return nil, appErr
+	}
+	if appErr := rule.IsValid(); appErr != nil {
+		return nil, appErr
+	}
+
+	cfg := a.Config().Clone()
+	cfg.FeatureFlagSettings.Rules[name] = rule
+	if _, _, appErr := a.SaveConfig(cfg, true); appErr != nil {
+		return nil, appErr
+	}
+
+	return a.GetFeatureFlag(name)
+}
+
+func (a *App) DeleteFeatureFlagRule(name string) *model.AppError {
+	if _, appErr := a.GetFeatureFlag(name); appErr != nil {
+		return appErr
+	}
+
+	cfg := a.Config().Clone()
+	if _, ok := cfg.FeatureFlagSettings.Rules[name]; !ok {
+		return model.NewAppError("DeleteFeatureFlagRule", "app.feature_flag.delete_rule.not_found.app_error", map[string]any{"Name": name}, "", http.StatusNotFound)
+	}
+
+	delete(cfg.FeatureFlagSettings.Rules, name)
+	if _, _, appErr := a.SaveConfig(cfg, true); appErr != nil {
+		return appErr
+	}
+
+	return nil
+}
+
+// GetFeatureFlagsForUser returns the feature flags with the values of the targeting rules
+// matching the user, on top of the server-wide values.
+func (a *App) GetFeatureFlagsForUser(userID string) (*model.FeatureFlags, *model.AppError) {
+	cfg := a.Config()
+
+	flags := *cfg.FeatureFlags
+	if len(cfg.FeatureFlagSettings.Rules) == 0 {
+		return &flags, nil
+	}
+
+	teamIDs, err := a.Srv().Store().Team().GetUserTeamIds(userID, true)
+	if err != nil {
+		return nil, model.NewAppError("GetFeatureFlagsForUser", "app.team.get_user_team_ids.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	for name, rule := range cfg.FeatureFlagSettings.Rules {
+		if rule.Matches(name, userID, teamIDs) {
+			flags.SetValue(name, rule.Value)
+		}
+	}
+
+	return &flags, nil
+}
@@ -9,6 +9,7 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"image"
 	"io"
@@ -770,7 +771,8 @@ func (a *App) UploadFileX(c request.CTX, channelID, name string, input io.Reader
 		}
 	}
 
-	written, aerr := t.writeFile(io.MultiReader(t.buf, t.limitedInput), t.fileinfo.Path)
+	hasher := sha256.New()
+	written, aerr := t.writeFile(io.TeeReader(io.MultiReader(t.buf, t.limitedInput), hasher), t.fileinfo.Path)
 	if aerr != nil {
 		return nil, aerr
 	}
@@ -783,6 +785,7 @@ func (a *App) UploadFileX(c request.CTX, channelID, name string, input io.Reader
 	}
 
 	t.fileinfo.Size = written
+	t.fileinfo.ContentHash = hex.EncodeToString(hasher.Sum(nil))
 
 	file, aerr := a.FileReader(t.fileinfo.Path)
 	if aerr != nil {
@@ -804,6 +807,8 @@ func (a *App) UploadFileX(c request.CTX, channelID, name string, input io.Reader
 		t.postprocessImage(file)
 	}
 
+	a.deduplicateFile(c, t.fileinfo, true)
+
 	if _, err := t.saveToDatabase(c, t.fileinfo); err != nil {
 		var appErr *model.AppError
 		switch {
@@ -1064,6 +1069,12 @@ func (a *App) DoUploadFileExpectModification(c request.CTX, now time.Time, rawTe
 		return nil, data, err
 	}
 
+	// Previews and thumbnails are generated by the caller once the file is saved, so only
+	// the uploaded file itself is deduplicated here.
+	contentHash := sha256.Sum256(data)
+	info.ContentHash = hex.EncodeToString(contentHash[:])
+	a.deduplicateFile(c, info, false)
+
 	if _, err := a.Srv().Store().FileInfo().Save(c, info); err != nil {
 		var appErr *model.AppError
 		switch {
@@ -1490,6 +1501,116 @@ func (a *App) ExtractContentFromFileInfo(rctx request.CTX, fileInfo *model.FileI
 	return nil
 }
 
+// hashFileContent returns the hex-encoded SHA-256 digest of the file stored at path.
+func (a *App) hashFileContent(path string) (string, *model.AppError) {
+	file, aerr := a.FileReader(path)
+	if aerr != nil {
+		return "", aerr
+	}
+	defer file.Close()
+
+	hasher := sha256.New()
+	if _, err := io.Copy(hasher, file); err != nil {
+		return "", model.NewAppError("hashFileContent", "api.file.read_file.reading_local.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return hex.EncodeToString(hasher.Sum(nil)), nil
+}
+
+// ComputeFileInfoContentHash hashes the stored content of the given file and saves the
+// result. It is used to backfill the content hash of files uploaded before hashing existed.
+func (a *App) ComputeFileInfoContentHash(rctx request.CTX, fileInfo *model.FileInfo) error {
+	hash, aerr := a.hashFileContent(fileInfo.Path)
+	if aerr != nil {
+		return errors.Wrap(aerr, "failed to hash file content")
+	}
+
+	if err := a.Srv().Store().FileInfo().SetContentHash(rctx, fileInfo.Id, hash); err != nil {
+		return errors.Wrap(err, "failed to save the file content hash")
+	}
+	fileInfo.ContentHash = hash
+
+	return nil
+}
+
+// deduplicateFile points info at an already stored blob with identical content, when file
+// deduplication is enabled, and removes the copy that was just written for info. The shared
+// blob is reference counted by the FileInfos pointing at its path. Previews and thumbnails are
+// only shared when previewsWritten is set, as those of info have to exist before they can be
+// swapped for the stored ones.
+func (a *App) deduplicateFile(rctx request.CTX, info *model.FileInfo, previewsWritten bool) {
+	if !*a.Config().FileSettings.EnableFileDeduplication || info.ContentHash == "" {
+		return
+	}
+
+	existing, err := a.Srv().Store().FileInfo().GetByContentHash(info.ContentHash, info.Size)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		if !errors.As(err, &nfErr) {
+			rctx.Logger().Warn("Failed to look up file by content hash", mlog.String("file_info_id", info.Id), mlog.Err(err))
+		}
+		return
+	}
+
+	if existing.Path == info.Path {
+		return
+	}
+
+	if exists, aerr := a.FileExists(existing.Path); aerr != nil || !exists {
+		rctx.Logger().Warn("Stored file for content hash is missing, skipping deduplication", mlog.String("path", existing.Path), mlog.Err(aerr))
+		return
+	}
+
+	duplicatePaths := []string{info.Path}
+	info.Path = existing.Path
+	if previewsWritten && info.PreviewPath != "" && existing.PreviewPath != "" && info.ThumbnailPath != "" && existing.ThumbnailPath != "" {
+		duplicatePaths = append(duplicatePaths, info.PreviewPath, info.ThumbnailPath)
+		info.PreviewPath = existing.PreviewPath
+		info.ThumbnailPath = existing.ThumbnailPath
+	}
+
+	for _, path := range duplicatePaths {
+		if aerr := a.RemoveFile(path); aerr != nil {
+			rctx.Logger().Warn("Failed to remove duplicate file", mlog.String("path", path), mlog.Err(aerr))
+		}
+	}
+}
+
+// RemoveFileInfoBlobs removes the stored files of infos, which are about to be permanently
+// deleted. Deduplicated files share their stored blob, so a blob is kept while FileInfos other
+// than the given ones still reference its path.
+func (a *App) RemoveFileInfoBlobs(rctx request.CTX, infos []*model.FileInfo) {
+	pathRefs := make(map[string]int64, len(infos))
+	for _, info := range infos {
+		pathRefs[info.Path]++
+	}
+
+	for path, ownRefs := range pathRefs {
+		refs, err := a.Srv().Store().FileInfo().CountByPath(path)
+		if err != nil {
+			rctx.Logger().Warn("Error counting references to file", mlog.String("path", path), mlog.Err(err))
+			continue
+		}
+		if refs > ownRefs {
+			continue
+		}
+
+		exists, appErr := a.FileExists(path)
+		if appErr != nil {
+			rctx.Logger().Warn("Error checking existence of file", mlog.String("path", path), mlog.Err(appErr))
+			continue
+		}
+		if !exists {
+			rctx.Logger().Warn("File not found", mlog.String("path", path))
+			continue
+		}
+
+		if appErr := a.RemoveFile(path); appErr != nil {
+			rctx.Logger().Warn("Unable to remove file", mlog.String("path", path), mlog.Err(appErr))
+		}
+	}
+}
+
 // GetLastAccessibleFileTime returns CreateAt time(from cache) of the last accessible post as per the cloud limit
 func (a *App) GetLastAccessibleFileTime() (int64, *model.AppError) {
 	license := a.Srv().License()
@@ -0,0 +1,179 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/app/users"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+)
+
+// GenerateFileShareLinkHash signs a share link. The expiry is part of the signature so that
+// a link can't be extended by tampering with the stored record alone.
+func GenerateFileShareLinkHash(linkID, fileID string, expireAt int64, salt string) string {
+	mac := hmac.New(sha256.New, []byte(salt))
+	mac.Write([]byte(linkID))
+	mac.Write([]byte(fileID))
+	mac.Write([]byte(strconv.FormatInt(expireAt, 10)))
+
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func (a *App) generateFileShareLinkURL(link *model.FileShareLink) string {
+	hash := GenerateFileShareLinkHash(link.Id, link.FileId, link.ExpireAt, *a.Config().FileSettings.PublicLinkSalt)
+	return fmt.Sprintf("%s/files/shared/%s?h=%s", a.GetSiteURL(), link.Id, hash)
+}
+
+func (a *App) CreateFileShareLink(rctx request.CTX, info *model.FileInfo, creatorID string, linkRequest *model.FileShareLinkRequest) (*model.FileShareLink, *model.AppError) {
+	if !*a.Config().FileSettings.EnableFileShareLinks {
+		return nil, model.NewAppError("CreateFileShareLink", "app.file_share_link.disabled.app_error", nil, "", http.StatusForbidden)
+	}
+
+	maxExpiresIn := int64(*a.Config().FileSettings.MaxFileShareLinkExpiryHours) * 60 * 60
+	if linkRequest.ExpiresIn <= 0 || linkRequest.ExpiresIn > maxExpiresIn {
+		return nil, model.NewAppError("CreateFileShareLink", "app.file_share_link.create.expires_in.app_error", map[string]any{"Max": *a.Config().FileSettings.MaxFileShareLinkExpiryHours}, "", http.StatusBadRequest)
+	}
+
+	if len(linkRequest.Password) > model.FileShareLinkMaxPasswordLength {
+		return nil, model.NewAppError("CreateFileShareLink", "app.file_share_link.create.password.app_error", map[string]any{"Max": model.FileShareLinkMaxPasswordLength}, "", http.StatusBadRequest)
+	}
+
+	now := model.GetMillis()
+	link := &model.FileShareLink{
+		FileId:       info.Id,
+		CreatorId:    creatorID,
+		CreateAt:     now,
+		ExpireAt:     now + linkRequest.ExpiresIn*1000,
+		MaxDownloads: linkRequest.MaxDownloads,
+	}
+	if linkRequest.Password != "" {
+		link.Password = users.HashPassword(linkRequest.Password)
+	}
+
+	link, err := a.Srv().Store().FileShareLink().Save(link)
+	if err != nil {
+		var appErr *model.AppError
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		default:
+			return nil, model.NewAppError("CreateFileShareLink", "app.file_share_link.save.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	link.Link = a.generateFileShareLinkURL(link)
+	link.Sanitize()
+
+	return link, nil
+}
+
+func (a *App) GetFileShareLink(rctx request.CTX, linkID string) (*model.FileShareLink, *model.AppError) {
+	link, err := a.Srv().Store().FileShareLink().Get(linkID)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("GetFileShareLink", "app.file_share_link.get.not_found.app_error", nil, "", http.StatusNotFound).Wrap(err)
+		default:
+			return nil, model.NewAppError("GetFileShareLink", "app.file_share_link.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	return link, nil
+}
+
+// GetFileShareLinksForFile returns the links of a file that haven't been revoked, including
+// expired ones so that their owner can still see them.
+func (a *App) GetFileShareLinksForFile(rctx request.CTX, fileID string) ([]*model.FileShareLink, *model.AppError) {
+	links, err := a.Srv().Store().FileShareLink().GetForFile(fileID)
+	if err != nil {
+		return nil, model.NewAppError("GetFileShareLinksForFile", "app.file_share_link.get_for_file.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	for _, link := range links {
+		link.Link = a.generateFileShareLinkURL(link)
+		link.Sanitize()
+	}
+
+	return links, nil
+}
+
+func (a *App) RevokeFileShareLink(rctx request.CTX, linkID string) *model.AppError {
+	if err := a.Srv().Store().FileShareLink().Revoke(linkID, model.GetMillis()); err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return model.NewAppError("RevokeFileShareLink", "app.file_share_link.get.not_found.app_error", nil, "", http.StatusNotFound).Wrap(err)
+		default:
+			return model.NewAppError("RevokeFileShareLink", "app.file_share_link.revoke.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	return nil
+}
+
+// UseFileShareLink checks the signature, expiry, password and download limit of a share link
+// and, if the link can be used, returns the shared file. The download is only recorded when
+// countDownload is set, so that requests which don't transfer the file leave the limit alone.
+func (a *App) UseFileShareLink(rctx request.CTX, linkID, hash, password string, countDownload bool) (*model.FileInfo, *model.AppError) {
+	if !*a.Config().FileSettings.EnableFileShareLinks {
+		return nil, model.NewAppError("UseFileShareLink", "app.file_share_link.disabled.app_error", nil, "", http.StatusForbidden)
+	}
+
+	link, appErr := a.GetFileShareLink(rctx, linkID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	expected := GenerateFileShareLinkHash(link.Id, link.FileId, link.ExpireAt, *a.Config().FileSettings.PublicLinkSalt)
+	if hash == "" || subtle.ConstantTimeCompare([]byte(hash), []byte(expected)) != 1 {
+		return nil, model.NewAppError("UseFileShareLink", "app.file_share_link.invalid.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	now := model.GetMillis()
+	if link.DeleteAt != 0 || link.IsExpired(now) || link.IsExhausted() {
+		return nil, model.NewAppError("UseFileShareLink", "app.file_share_link.expired.app_error", nil, "", http.StatusGone)
+	}
+
+	if link.Password != "" {
+		if err := users.ComparePassword(link.Password, password); err != nil {
+			return nil, model.NewAppError("UseFileShareLink", "app.file_share_link.password.app_error", nil, "", http.StatusUnauthorized)
+		}
+	}
+
+	info, appErr := a.GetFileInfo(rctx, link.FileId)
+	if appErr != nil {
+		return nil, appErr
+	}
+	if info.DeleteAt != 0 {
+		return nil, model.NewAppError("UseFileShareLink", "app.file_share_link.expired.app_error", nil, "", http.StatusGone)
+	}
+
+	if !countDownload {
+		return info, nil
+	}
+
+	// The download is counted atomically so that concurrent requests can't exceed the limit.
+	if err := a.Srv().Store().FileShareLink().IncrementDownloadCount(link.Id, now); err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("UseFileShareLink", "app.file_share_link.expired.app_error", nil, "", http.StatusGone).Wrap(err)
+		default:
+			return nil, model.NewAppError("UseFileShareLink", "app.file_share_link.save.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	return info, nil
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/mattermost/mattermost/server/v8/channels/store"
 	storemocks "github.com/mattermost/mattermost/server/v8/channels/store/storetest/mocks"
 	"github.com/mattermost/mattermost/server/v8/channels/utils/fileutils"
+	"github.com/mattermost/mattermost/server/v8/channels/utils/testutils"
 	eMocks "github.com/mattermost/mattermost/server/v8/einterfaces/mocks"
 	"github.com/mattermost/mattermost/server/v8/platform/services/searchengine/mocks"
 	filesStoreMocks "github.com/mattermost/mattermost/server/v8/platform/shared/filestore/mocks"
@@ -136,6 +137,105 @@ func TestUploadFile(t *testing.T) {
 	assert.Equal(t, value, info1.Path, "Stored file at incorrect path")
 }
 
+func TestUploadFileDeduplication(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	data := []byte("duplicated content")
+
+	t.Run("disabled", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.FileSettings.EnableFileDeduplication = false })
+
+		info1, err := th.App.UploadFile(th.Context, data, th.BasicChannel.Id, "first.txt")
+		require.Nil(t, err)
+		info2, err := th.App.UploadFile(th.Context, data, th.BasicChannel.Id, "second.txt")
+		require.Nil(t, err)
+
+		assert.NotEmpty(t, info1.ContentHash)
+		assert.Equal(t, info1.ContentHash, info2.ContentHash)
+		assert.NotEqual(t, info1.Path, info2.Path)
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.FileSettings.EnableFileDeduplication = true })
+
+		info1, err := th.App.UploadFile(th.Context, data, th.BasicChannel.Id, "first.txt")
+		require.Nil(t, err)
+		info2, err := th.App.UploadFile(th.Context, data, th.BasicChannel.Id, "second.txt")
+		require.Nil(t, err)
+
+		assert.Equal(t, info1.Path, info2.Path)
+
+		count, storeErr := th.App.Srv().Store().FileInfo().CountByPath(info1.Path)
+		require.NoError(t, storeErr)
+		assert.GreaterOrEqual(t, count, int64(2))
+
+		stored, err := th.App.ReadFile(info2.Path)
+		require.Nil(t, err)
+		assert.Equal(t, data, stored)
+	})
+
+	t.Run("images keep their own previews", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.FileSettings.EnableFileDeduplication = true })
+
+		imageData, readErr := testutils.ReadTestFile("test.png")
+		require.NoError(t, readErr)
+
+		info1, err := th.App.UploadFile(th.Context, imageData, th.BasicChannel.Id, "first.png")
+		require.Nil(t, err)
+		info2, err := th.App.UploadFile(th.Context, imageData, th.BasicChannel.Id, "second.png")
+		require.Nil(t, err)
+
+		assert.Equal(t, info1.Path, info2.Path)
+		assert.NotEqual(t, info1.PreviewPath, info2.PreviewPath)
+		for _, path := range []string{info2.PreviewPath, info2.ThumbnailPath} {
+			exists, err := th.App.FileExists(path)
+			require.Nil(t, err)
+			assert.True(t, exists, path)
+		}
+	})
+
+	t.Run("shared blob is removed with its last reference", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.FileSettings.EnableFileDeduplication = true })
+
+		shared := []byte("content shared by two files")
+		info1, err := th.App.UploadFile(th.Context, shared, th.BasicChannel.Id, "first.txt")
+		require.Nil(t, err)
+		info2, err := th.App.UploadFile(th.Context, shared, th.BasicChannel.Id, "second.txt")
+		require.Nil(t, err)
+		require.Equal(t, info1.Path, info2.Path)
+
+		th.App.RemoveFileInfoBlobs(th.Context, []*model.FileInfo{info1})
+		exists, err := th.App.FileExists(info1.Path)
+		require.Nil(t, err)
+		assert.True(t, exists)
+
+		th.App.RemoveFileInfoBlobs(th.Context, []*model.FileInfo{info1, info2})
+		exists, err = th.App.FileExists(info1.Path)
+		require.Nil(t, err)
+		assert.False(t, exists)
+	})
+}
+
+func TestComputeFileInfoContentHash(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	info, err := th.App.UploadFile(th.Context, []byte("abcd"), th.BasicChannel.Id, "test.txt")
+	require.Nil(t, err)
+	expected := info.ContentHash
+
+	require.NoError(t, th.App.Srv().Store().FileInfo().SetContentHash(th.Context, info.Id, ""))
+	info.ContentHash = ""
+
+	require.NoError(t, th.App.ComputeFileInfoContentHash(th.Context, info))
+	assert.Equal(t, expected, info.ContentHash)
+
+	stored, storeErr := th.App.Srv().Store().FileInfo().Get(info.Id)
+	require.NoError(t, storeErr)
+	assert.Equal(t, expected, stored.ContentHash)
+}
+
 func TestParseOldFilenames(t *testing.T) {
 	th := Setup(t).InitBasic()
 	defer th.TearDown()
@@ -0,0 +1,134 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/platform/shared/mail"
+)
+
+const dependencyHealthTimeout = 10 * time.Second
+
+// appErrorOrNil avoids wrapping a nil *model.AppError into a non-nil error.
+func appErrorOrNil(appErr *model.AppError) error {
+	if appErr == nil {
+		return nil
+	}
+	return appErr
+}
+
+// CheckDependencyHealth checks the services the server depends on and reports their health and
+// latency. Only the dependencies enabled in the configuration are checked.
+func (a *App) CheckDependencyHealth(rctx request.CTX) []*model.DependencyHealth {
+	ctx, cancel := context.WithTimeout(context.Background(), dependencyHealthTimeout)
+	defer cancel()
+
+	cfg := a.Config()
+	checks := map[string]func() error{
+		"file_store": func() error {
+			return appErrorOrNil(a.TestFileStoreConnection())
+		},
+	}
+
+	if engine := a.SearchEngine().ElasticsearchEngine; engine != nil && engine.IsActive() {
+		checks["search_engine"] = func() error {
+			return appErrorOrNil(engine.TestConfig(rctx, cfg))
+		}
+	}
+
+	if *cfg.EmailSettings.SendPushNotifications && *cfg.EmailSettings.PushNotificationServer != "" {
+		checks["push_proxy"] = func() error {
+			return a.checkPushProxyHealth(ctx, *cfg.EmailSettings.PushNotificationServer)
+		}
+	}
+
+	if *cfg.EmailSettings.SendEmailNotifications && *cfg.EmailSettings.SMTPServer != "" {
+		checks["smtp"] = func() error {
+			return mail.TestConnection(a.Srv().MailServiceConfig())
+		}
+	}
+
+	if cluster := a.Cluster(); cluster != nil && *cfg.ClusterSettings.Enable {
+		checks["cluster_peers"] = func() error {
+			_, appErr := cluster.GetClusterStats()
+			return appErrorOrNil(appErr)
+		}
+	}
+
+	var (
+		mut    sync.Mutex
+		wg     sync.WaitGroup
+		health = a.Srv().Platform().DatabaseHealth(ctx)
+	)
+	pending := make(map[string]time.Time, len(checks))
+	for name, check := range checks {
+		pending[name] = time.Now()
+		wg.Add(1)
+		go func(name string, check func() error) {
+			defer wg.Done()
+			start := time.Now()
+			err := check()
+
+			mut.Lock()
+			defer mut.Unlock()
+			if _, ok := pending[name]; ok {
+				delete(pending, name)
+				health = append(health, model.NewDependencyHealth(name, start, err))
+			}
+		}(name, check)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+	}
+
+	// The checks which don't accept a context are reported unhealthy once the timeout is over.
+	mut.Lock()
+	defer mut.Unlock()
+	for name, start := range pending {
+		health = append(health, model.NewDependencyHealth(name, start, errors.New("the check timed out")))
+	}
+	pending = nil
+
+	sort.Slice(health, func(i, j int) bool {
+		return health[i].Name < health[j].Name
+	})
+	return health
+}
+
+func (a *App) checkPushProxyHealth(ctx context.Context, server string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimRight(server, "/")+"/version", nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := a.Srv().pushNotificationClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return fmt.Errorf("the push proxy responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
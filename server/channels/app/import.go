@@ -11,6 +11,7 @@ import (
 	"io"
 	"net/http"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"sync"
 
@@ -26,6 +27,8 @@ const (
 	importMultiplePostsThreshold = 1000
 	maxScanTokenSize             = 16 * 1024 * 1024 // Need to set a higher limit than default because some customers cross the limit. See MM-22314
 	statusUpdateAfterLines       = 8192
+	// importCheckpointAfterLines is the maximum number of lines imported between two checkpoints.
+	importCheckpointAfterLines = 64 * 1024
 )
 
 func stopOnError(c request.CTX, err imports.LineImportWorkerError) bool {
@@ -172,18 +175,96 @@ func (a *App) bulkImportWorker(c request.CTX, dryRun, extractContent bool, wg *s
 }
 
 func (a *App) BulkImport(c request.CTX, jsonlReader io.Reader, attachmentsReader *zip.Reader, dryRun bool, workers int) (*model.AppError, int) {
-	return a.bulkImport(c, jsonlReader, attachmentsReader, dryRun, true, workers, "")
+	return a.bulkImport(c, jsonlReader, attachmentsReader, nil, model.BulkImportOpts{
+		DryRun:         dryRun,
+		ExtractContent: true,
+		Workers:        workers,
+	})
 }
 
 func (a *App) BulkImportWithPath(c request.CTX, jsonlReader io.Reader, attachmentsReader *zip.Reader, dryRun, extractContent bool, workers int, importPath string) (*model.AppError, int) {
-	return a.bulkImport(c, jsonlReader, attachmentsReader, dryRun, extractContent, workers, importPath)
+	return a.bulkImport(c, jsonlReader, attachmentsReader, nil, model.BulkImportOpts{
+		DryRun:         dryRun,
+		ExtractContent: extractContent,
+		Workers:        workers,
+		ImportPath:     importPath,
+	})
+}
+
+// BulkImportWithOpts imports a JSONL file like BulkImportWithPath. If a job is given, the
+// progress of the import and the line to resume it from are recorded in the job data as
+// the lines get imported.
+func (a *App) BulkImportWithOpts(c request.CTX, jsonlReader io.Reader, attachmentsReader *zip.Reader, job *model.Job, opts model.BulkImportOpts) (*model.AppError, int) {
+	if job != nil && job.Data == nil {
+		job.Data = make(model.StringMap)
+	}
+
+	return a.bulkImport(c, jsonlReader, attachmentsReader, job, opts)
+}
+
+// ValidateBulkImport checks the lines of a JSONL file in a single streaming pass, without
+// importing them or checking them against the existing data. It returns the number of lines
+// of the file, or the number of the first invalid line along with the error.
+func (a *App) ValidateBulkImport(c request.CTX, jsonlReader io.Reader) (*model.AppError, int) {
+	scanner := bufio.NewScanner(jsonlReader)
+	buf := make([]byte, 0, 64*1024)
+	scanner.Buffer(buf, maxScanTokenSize)
+
+	maxPostSize := a.MaxPostSize()
+	orderValidator := imports.NewLineOrderValidator()
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		if lineNumber%statusUpdateAfterLines == 0 {
+			c.Logger().Info("Validation progress", mlog.Int("processed_lines", lineNumber))
+		}
+
+		var line imports.LineImportData
+		if err := json.Unmarshal(scanner.Bytes(), &line); err != nil {
+			return model.NewAppError("BulkImport", "app.import.bulk_import.json_decode.error", nil, "", http.StatusBadRequest).Wrap(err), lineNumber
+		}
+
+		if lineNumber == 1 {
+			importDataFileVersion, appErr := processImportDataFileVersionLine(line)
+			if appErr != nil {
+				return appErr, lineNumber
+			}
+
+			if importDataFileVersion != 1 {
+				return model.NewAppError("BulkImport", "app.import.bulk_import.unsupported_version.error", nil, "", http.StatusBadRequest), lineNumber
+			}
+			continue
+		}
+
+		if appErr := orderValidator.Validate(line.Type); appErr != nil {
+			return appErr, lineNumber
+		}
+
+		if appErr := imports.ValidateLine(&line, maxPostSize); appErr != nil {
+			// Skip the same errors the import skips.
+			if stopOnError(c, imports.LineImportWorkerError{Error: appErr, LineNumber: lineNumber}) {
+				return appErr, lineNumber
+			}
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return model.NewAppError("BulkImport", "app.import.bulk_import.file_scan.error", nil, "", http.StatusInternalServerError).Wrap(err), lineNumber
+	}
+
+	return nil, lineNumber
 }
 
 // bulkImport will extract attachments from attachmentsReader if it is
 // not nil. If it is nil, it will look for attachments on the
 // filesystem in the locations specified by the JSONL file according
-// to the older behavior
-func (a *App) bulkImport(c request.CTX, jsonlReader io.Reader, attachmentsReader *zip.Reader, dryRun, extractContent bool, workers int, importPath string) (*model.AppError, int) {
+// to the older behavior.
+//
+// Lines are handed to a pool of workers in segments. Lines that don't depend on each other
+// share a segment, while a line depending on the lines of the current segment waits for
+// them to be imported first. Every segment that finishes is a checkpoint the import can be
+// resumed from.
+func (a *App) bulkImport(c request.CTX, jsonlReader io.Reader, attachmentsReader *zip.Reader, job *model.Job, opts model.BulkImportOpts) (*model.AppError, int) {
 	scanner := bufio.NewScanner(jsonlReader)
 	buf := make([]byte, 0, 64*1024)
 	scanner.Buffer(buf, maxScanTokenSize)
@@ -193,10 +274,13 @@ func (a *App) bulkImport(c request.CTX, jsonlReader io.Reader, attachmentsReader
 	a.Srv().Store().LockToMaster()
 	defer a.Srv().Store().UnlockFromMaster()
 
+	workers := opts.Workers
 	errorsChan := make(chan imports.LineImportWorkerError, (2*workers)+1) // size chosen to ensure it never gets filled up completely.
 	var wg sync.WaitGroup
 	var linesChan chan imports.LineImportWorkerData
 	lastLineType := ""
+	segmentTypes := map[string]bool{}
+	segmentStart := 0
 
 	var attachedFiles map[string]*zip.File
 	if attachmentsReader != nil {
@@ -206,18 +290,55 @@ func (a *App) bulkImport(c request.CTX, jsonlReader io.Reader, attachmentsReader
 		}
 	}
 
+	// waitForWorkers closes the current segment and waits for its lines to be imported.
+	waitForWorkers := func() *imports.LineImportWorkerError {
+		if linesChan != nil {
+			close(linesChan)
+			linesChan = nil
+		}
+		wg.Wait()
+
+		// Check no errors occurred while waiting for the queue to empty.
+		for len(errorsChan) != 0 {
+			err := <-errorsChan
+			if stopOnError(c, err) {
+				return &err
+			}
+		}
+		return nil
+	}
+
+	// checkpoint records that all the lines before the given one have been imported.
+	checkpoint := func(nextLine int) {
+		if job == nil || opts.DryRun {
+			return
+		}
+
+		importedLines := opts.PreviousLines + nextLine - 1
+		if opts.TotalLines > 0 {
+			job.Progress = int64(importedLines * 100 / opts.TotalLines)
+		}
+		job.Data["resume_from_line"] = strconv.Itoa(nextLine)
+		updateJobProgress(c.Logger(), a.Srv().Store(), job, "imported_lines", importedLines)
+	}
+
 	for scanner.Scan() {
 		lineNumber++
 		if lineNumber%statusUpdateAfterLines == 0 {
 			c.Logger().Info("Reader progress", mlog.Int("processed_lines", lineNumber))
 		}
 
+		// Skip the lines imported by a previous run.
+		if lineNumber > 1 && lineNumber < opts.ResumeFromLine {
+			continue
+		}
+
 		var line imports.LineImportData
 		if err := json.Unmarshal(scanner.Bytes(), &line); err != nil {
 			return model.NewAppError("BulkImport", "app.import.bulk_import.json_decode.error", nil, "", http.StatusBadRequest).Wrap(err), lineNumber
 		}
 
-		if err := processAttachments(c, &line, importPath, attachedFiles); err != nil {
+		if err := processAttachments(c, &line, opts.ImportPath, attachedFiles); err != nil {
 			c.Logger().Warn("Error while processing import attachments. Objects might be broken.", mlog.Err(err))
 		}
 
@@ -234,26 +355,29 @@ func (a *App) bulkImport(c request.CTX, jsonlReader io.Reader, attachmentsReader
 			continue
 		}
 
-		if line.Type != lastLineType {
+		newSegment := linesChan == nil || lineNumber-segmentStart >= importCheckpointAfterLines
+		if !newSegment && !segmentTypes[line.Type] {
+			for segmentType := range segmentTypes {
+				if imports.LineDependsOn(line.Type, segmentType) || imports.LineDependsOn(segmentType, line.Type) {
+					newSegment = true
+					break
+				}
+			}
+		}
+
+		if newSegment {
 			// Only clear the worker queue if is not the first data entry
-			if lineNumber != 2 {
+			if linesChan != nil {
 				c.Logger().Info(
 					"Finished parsing segment, waiting for workers to finish",
 					mlog.String("old_segment", lastLineType),
 					mlog.String("new_segment", line.Type),
 				)
 
-				// Changing type. Clear out the worker queue before continuing.
-				close(linesChan)
-				wg.Wait()
-
-				// Check no errors occurred while waiting for the queue to empty.
-				if len(errorsChan) != 0 {
-					err := <-errorsChan
-					if stopOnError(c, err) {
-						return err.Error, err.LineNumber
-					}
+				if err := waitForWorkers(); err != nil {
+					return err.Error, err.LineNumber
 				}
+				checkpoint(lineNumber)
 			}
 
 			c.Logger().Info(
@@ -263,14 +387,17 @@ func (a *App) bulkImport(c request.CTX, jsonlReader io.Reader, attachmentsReader
 				mlog.Int("workers", workers),
 			)
 
-			// Set up the workers and channel for this type.
-			lastLineType = line.Type
+			// Set up the workers and channel for this segment.
+			segmentTypes = map[string]bool{}
+			segmentStart = lineNumber
 			linesChan = make(chan imports.LineImportWorkerData, workers)
 			for i := 0; i < workers; i++ {
 				wg.Add(1)
-				go a.bulkImportWorker(c, dryRun, extractContent, &wg, linesChan, errorsChan)
+				go a.bulkImportWorker(c, opts.DryRun, opts.ExtractContent, &wg, linesChan, errorsChan)
 			}
 		}
+		segmentTypes[line.Type] = true
+		lastLineType = line.Type
 
 		select {
 		case linesChan <- imports.LineImportWorkerData{LineImportData: line, LineNumber: lineNumber}:
@@ -284,23 +411,16 @@ func (a *App) bulkImport(c request.CTX, jsonlReader io.Reader, attachmentsReader
 	}
 
 	// No more lines. Clear out the worker queue before continuing.
-	if linesChan != nil {
-		close(linesChan)
-	}
-	wg.Wait()
-
-	// Check no errors occurred while waiting for the queue to empty.
-	if len(errorsChan) != 0 {
-		err := <-errorsChan
-		if stopOnError(c, err) {
-			return err.Error, err.LineNumber
-		}
+	if err := waitForWorkers(); err != nil {
+		return err.Error, err.LineNumber
 	}
 
 	if err := scanner.Err(); err != nil {
 		return model.NewAppError("BulkImport", "app.import.bulk_import.file_scan.error", nil, "", http.StatusInternalServerError).Wrap(err), 0
 	}
 
+	checkpoint(lineNumber + 1)
+
 	return nil, 0
 }
 
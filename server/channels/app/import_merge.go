@@ -0,0 +1,303 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/app/imports"
+)
+
+const mergePermalinksBatchSize = 100
+
+// MergeBulkImport imports a bulk export of another instance, renaming its users and teams
+// according to the mapping so that they don't collide with the existing ones. Once the
+// posts are imported, the permalinks between them are translated to the ids of the
+// imported posts.
+//
+// All the JSONL files of the archive are imported in order. It returns the number of the
+// line of the remapped import that failed along with the error.
+func (a *App) MergeBulkImport(c request.CTX, importZip *zip.Reader, job *model.Job, mapping *model.ImportMergeMapping, opts model.BulkImportOpts) (*model.AppError, int) {
+	if appErr := mapping.IsValid(); appErr != nil {
+		return appErr, 0
+	}
+
+	var jsonlFiles []*zip.File
+	for _, f := range importZip.File {
+		if filepath.Ext(f.Name) != ".jsonl" {
+			continue
+		}
+		// avoid "zip slip"
+		if strings.Contains(f.Name, "..") {
+			return model.NewAppError("MergeBulkImport", "app.import.merge.open_file.app_error", nil, "jsonFilePath contains path traversal", http.StatusForbidden), 0
+		}
+		jsonlFiles = append(jsonlFiles, f)
+	}
+	if len(jsonlFiles) == 0 {
+		return model.NewAppError("MergeBulkImport", "app.import.merge.missing_jsonl.app_error", nil, "", http.StatusBadRequest), 0
+	}
+	sort.Slice(jsonlFiles, func(i, j int) bool {
+		return jsonlFiles[i].Name < jsonlFiles[j].Name
+	})
+
+	remappedFile, err := os.CreateTemp("", "import-merge")
+	if err != nil {
+		return model.NewAppError("MergeBulkImport", "app.import.merge.create_temp.app_error", nil, "", http.StatusInternalServerError).Wrap(err), 0
+	}
+	defer os.Remove(remappedFile.Name())
+	defer remappedFile.Close()
+
+	remapper := imports.NewRemapper(mapping, *a.Config().ServiceSettings.SiteURL)
+	if appErr := remapImportFiles(jsonlFiles, remappedFile, remapper); appErr != nil {
+		return appErr, 0
+	}
+
+	if _, err = remappedFile.Seek(0, io.SeekStart); err != nil {
+		return model.NewAppError("MergeBulkImport", "app.import.merge.create_temp.app_error", nil, "", http.StatusInternalServerError).Wrap(err), 0
+	}
+	appErr, lines := a.ValidateBulkImport(c, remappedFile)
+	if appErr != nil {
+		return appErr, lines
+	}
+
+	if _, err = remappedFile.Seek(0, io.SeekStart); err != nil {
+		return model.NewAppError("MergeBulkImport", "app.import.merge.create_temp.app_error", nil, "", http.StatusInternalServerError).Wrap(err), 0
+	}
+	opts.TotalLines = lines
+	if appErr, lineNumber := a.BulkImportWithOpts(c, remappedFile, importZip, job, opts); appErr != nil {
+		return appErr, lineNumber
+	}
+
+	if opts.DryRun {
+		return nil, 0
+	}
+
+	if _, err = remappedFile.Seek(0, io.SeekStart); err != nil {
+		return model.NewAppError("MergeBulkImport", "app.import.merge.create_temp.app_error", nil, "", http.StatusInternalServerError).Wrap(err), 0
+	}
+	return a.translateMergedPermalinks(c, remappedFile, remapper)
+}
+
+// remapImportFiles writes the remapped lines of the files to a single import file, which
+// starts with the version line of the first file.
+func remapImportFiles(files []*zip.File, output io.Writer, remapper *imports.Remapper) *model.AppError {
+	encoder := json.NewEncoder(output)
+	for i, f := range files {
+		reader, err := f.Open()
+		if err != nil {
+			return model.NewAppError("MergeBulkImport", "app.import.merge.open_file.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+
+		decoder := json.NewDecoder(reader)
+		for lineNumber := 1; ; lineNumber++ {
+			var line imports.LineImportData
+			if err = decoder.Decode(&line); err == io.EOF {
+				break
+			} else if err != nil {
+				reader.Close()
+				return model.NewAppError("MergeBulkImport", "app.import.bulk_import.json_decode.error", map[string]any{"File": f.Name, "Line": lineNumber}, "", http.StatusBadRequest).Wrap(err)
+			}
+
+			if line.Type == "version" && i > 0 {
+				continue
+			}
+			remapper.RemapLine(&line)
+
+			if err = encoder.Encode(&line); err != nil {
+				reader.Close()
+				return model.NewAppError("MergeBulkImport", "app.import.merge.write.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+			}
+		}
+		reader.Close()
+	}
+	return nil
+}
+
+type mergedPost struct {
+	channelID string
+	rootID    string
+	sourceID  *string
+	createAt  *int64
+	message   *string
+}
+
+// translateMergedPermalinks replaces the ids of the exported posts in the permalinks of the
+// imported posts by the ids of the posts they were imported as. The imported posts are
+// found the same way the import finds existing posts, by channel, creation time and message.
+func (a *App) translateMergedPermalinks(c request.CTX, jsonlReader io.Reader, remapper *imports.Remapper) (*model.AppError, int) {
+	if len(remapper.ReferencedPostIds) == 0 {
+		return nil, 0
+	}
+
+	scanner := bufio.NewScanner(jsonlReader)
+	buf := make([]byte, 0, 64*1024)
+	scanner.Buffer(buf, maxScanTokenSize)
+
+	postIDs := make(map[string]string, len(remapper.ReferencedPostIds))
+	var postsWithPermalinks []*model.Post
+	channelIDs := make(map[string]string)
+
+	// lookup records the imported post matching the line if it's linked to or has
+	// permalinks, and returns its id.
+	lookup := func(post mergedPost, force bool) (string, *model.AppError) {
+		hasPermalinks := remapper.HasPermalinks(*post.message)
+		if !force && !hasPermalinks && (post.sourceID == nil || !remapper.ReferencedPostIds[*post.sourceID]) {
+			return "", nil
+		}
+
+		posts, err := a.Srv().Store().Post().GetPostsCreatedAt(post.channelID, *post.createAt)
+		if err != nil {
+			return "", model.NewAppError("MergeBulkImport", "app.post.get_posts_created_at.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+		for _, p := range posts {
+			if p.Message != *post.message || p.RootId != post.rootID {
+				continue
+			}
+			if post.sourceID != nil {
+				postIDs[*post.sourceID] = p.Id
+			}
+			if hasPermalinks {
+				postsWithPermalinks = append(postsWithPermalinks, p)
+			}
+			return p.Id, nil
+		}
+		return "", nil
+	}
+
+	lookupThread := func(root mergedPost, replies *[]imports.ReplyImportData) *model.AppError {
+		hasReplies := false
+		if replies != nil {
+			for _, reply := range *replies {
+				if remapper.HasPermalinks(*reply.Message) || (reply.Id != nil && remapper.ReferencedPostIds[*reply.Id]) {
+					hasReplies = true
+					break
+				}
+			}
+		}
+
+		rootID, appErr := lookup(root, hasReplies)
+		if appErr != nil || !hasReplies || rootID == "" {
+			return appErr
+		}
+		for _, reply := range *replies {
+			if _, appErr := lookup(mergedPost{channelID: root.channelID, rootID: rootID, sourceID: reply.Id, createAt: reply.CreateAt, message: reply.Message}, false); appErr != nil {
+				return appErr
+			}
+		}
+		return nil
+	}
+
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+
+		var line imports.LineImportData
+		if err := json.Unmarshal(scanner.Bytes(), &line); err != nil {
+			return model.NewAppError("MergeBulkImport", "app.import.bulk_import.json_decode.error", nil, "", http.StatusBadRequest).Wrap(err), lineNumber
+		}
+
+		var appErr *model.AppError
+		switch line.Type {
+		case "post":
+			var channelID string
+			if channelID, appErr = a.mergedChannelID(channelIDs, *line.Post.Team, *line.Post.Channel); appErr == nil {
+				appErr = lookupThread(mergedPost{channelID: channelID, sourceID: line.Post.Id, createAt: line.Post.CreateAt, message: line.Post.Message}, line.Post.Replies)
+			}
+		case "direct_post":
+			var channelID string
+			if channelID, appErr = a.mergedDirectChannelID(channelIDs, *line.DirectPost.ChannelMembers); appErr == nil {
+				appErr = lookupThread(mergedPost{channelID: channelID, sourceID: line.DirectPost.Id, createAt: line.DirectPost.CreateAt, message: line.DirectPost.Message}, line.DirectPost.Replies)
+			}
+		}
+		if appErr != nil {
+			return appErr, lineNumber
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return model.NewAppError("MergeBulkImport", "app.import.bulk_import.file_scan.error", nil, "", http.StatusInternalServerError).Wrap(err), lineNumber
+	}
+
+	var updated []*model.Post
+	for _, post := range postsWithPermalinks {
+		message := remapper.TranslatePermalinks(post.Message, postIDs)
+		if message == post.Message {
+			continue
+		}
+		post.Message = message
+		updated = append(updated, post)
+	}
+
+	for i := 0; i < len(updated); i += mergePermalinksBatchSize {
+		batch := updated[i:min(i+mergePermalinksBatchSize, len(updated))]
+		if _, _, err := a.Srv().Store().Post().OverwriteMultiple(batch); err != nil {
+			return model.NewAppError("MergeBulkImport", "app.post.overwrite.app_error", nil, "", http.StatusInternalServerError).Wrap(err), 0
+		}
+	}
+
+	c.Logger().Info("Translated the permalinks of the merged posts", mlog.Int("posts", len(updated)), mlog.Int("linked_posts", len(postIDs)))
+
+	return nil, 0
+}
+
+func (a *App) mergedChannelID(cache map[string]string, teamName, channelName string) (string, *model.AppError) {
+	key := teamName + "/" + channelName
+	if id, ok := cache[key]; ok {
+		return id, nil
+	}
+
+	team, err := a.Srv().Store().Team().GetByName(teamName)
+	if err != nil {
+		return "", model.NewAppError("MergeBulkImport", "app.team.get_by_name.missing.app_error", nil, "", http.StatusNotFound).Wrap(err)
+	}
+	channel, err := a.Srv().Store().Channel().GetByName(team.Id, channelName, true)
+	if err != nil {
+		return "", model.NewAppError("MergeBulkImport", "app.channel.get_by_name.missing.app_error", nil, "", http.StatusNotFound).Wrap(err)
+	}
+
+	cache[key] = channel.Id
+	return channel.Id, nil
+}
+
+func (a *App) mergedDirectChannelID(cache map[string]string, members []string) (string, *model.AppError) {
+	sorted := append([]string{}, members...)
+	sort.Strings(sorted)
+	key := strings.Join(sorted, ",")
+	if id, ok := cache[key]; ok {
+		return id, nil
+	}
+
+	users, appErr := a.getUsersByUsernames(members)
+	if appErr != nil {
+		return "", appErr
+	}
+	var userIDs []string
+	for _, username := range members {
+		userIDs = append(userIDs, users[strings.ToLower(username)].Id)
+	}
+
+	var name string
+	if len(userIDs) == 2 {
+		name = model.GetDMNameFromIds(userIDs[0], userIDs[1])
+	} else {
+		name = model.GetGroupNameFromUserIds(userIDs)
+	}
+	channel, err := a.Srv().Store().Channel().GetByName("", name, true)
+	if err != nil {
+		return "", model.NewAppError("MergeBulkImport", "app.channel.get_by_name.missing.app_error", nil, "", http.StatusNotFound).Wrap(err)
+	}
+
+	cache[key] = channel.Id
+	return channel.Id, nil
+}
@@ -0,0 +1,96 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+func TestMergeBulkImport(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.ServiceSettings.SiteURL = "https://chat.example.com"
+	})
+
+	// The export collides with the existing team and user.
+	teamName := th.BasicTeam.Name
+	username := th.BasicUser.Username
+	newTeamName := model.NewRandomTeamName()
+	newUsername := "merged" + model.NewId()[:10]
+	linkedPostID := model.NewId()
+	createAt := model.GetMillis()
+
+	lines := []string{
+		`{"type":"version","version":1}`,
+		`{"type":"team","team":{"type":"O","display_name":"Merged","name":"` + teamName + `"}}`,
+		`{"type":"channel","channel":{"type":"O","display_name":"Merged","team":"` + teamName + `","name":"merged"}}`,
+		`{"type":"user","user":{"username":"` + username + `","email":"` + newUsername + `@example.com","teams":[{"name":"` + teamName + `","channels":[{"name":"merged"}]}]}}`,
+		`{"type":"post","post":{"id":"` + linkedPostID + `","team":"` + teamName + `","channel":"merged","user":"` + username + `","message":"linked","create_at":` + strconv.FormatInt(createAt, 10) + `}}`,
+		`{"type":"post","post":{"team":"` + teamName + `","channel":"merged","user":"` + username + `","message":"see https://chat.acme.com/` + teamName + `/pl/` + linkedPostID + ` @` + username + `","create_at":` + strconv.FormatInt(createAt+1, 10) + `}}`,
+	}
+
+	var buf bytes.Buffer
+	zipWriter := zip.NewWriter(&buf)
+	for i, chunk := range [][]string{lines[:4], append([]string{lines[0]}, lines[4:]...)} {
+		w, err := zipWriter.Create(fmt.Sprintf("import_%04d.jsonl", i+1))
+		require.NoError(t, err)
+		for _, line := range chunk {
+			_, err = w.Write([]byte(line + "\n"))
+			require.NoError(t, err)
+		}
+	}
+	require.NoError(t, zipWriter.Close())
+	importZip, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+
+	mapping := &model.ImportMergeMapping{
+		Users:         map[string]string{username: newUsername},
+		Teams:         map[string]string{teamName: newTeamName},
+		SourceSiteURL: "https://chat.acme.com",
+	}
+
+	t.Run("invalid mapping", func(t *testing.T) {
+		appErr, _ := th.App.MergeBulkImport(th.Context, importZip, nil, &model.ImportMergeMapping{Users: map[string]string{username: "Invalid Name"}}, model.BulkImportOpts{Workers: 2})
+		require.NotNil(t, appErr)
+	})
+
+	appErr, line := th.App.MergeBulkImport(th.Context, importZip, nil, mapping, model.BulkImportOpts{Workers: 2})
+	require.Nil(t, appErr, line)
+
+	team, appErr := th.App.GetTeamByName(newTeamName)
+	require.Nil(t, appErr)
+	assert.Equal(t, "Merged", team.DisplayName)
+
+	existingTeam, appErr := th.App.GetTeamByName(teamName)
+	require.Nil(t, appErr)
+	assert.Equal(t, th.BasicTeam.DisplayName, existingTeam.DisplayName, "the existing team shouldn't be modified")
+
+	user, appErr := th.App.GetUserByUsername(newUsername)
+	require.Nil(t, appErr)
+	assert.NotEqual(t, th.BasicUser.Id, user.Id)
+
+	channel, appErr := th.App.GetChannelByName(th.Context, "merged", team.Id, false)
+	require.Nil(t, appErr)
+
+	linked, err := th.App.Srv().Store().Post().GetPostsCreatedAt(channel.Id, createAt)
+	require.NoError(t, err)
+	require.Len(t, linked, 1)
+
+	posts, err := th.App.Srv().Store().Post().GetPostsCreatedAt(channel.Id, createAt+1)
+	require.NoError(t, err)
+	require.Len(t, posts, 1)
+	assert.Equal(t, "see https://chat.example.com/"+newTeamName+"/pl/"+linked[0].Id+" @"+newUsername, posts[0].Message)
+	assert.Equal(t, user.Id, posts[0].UserId)
+}
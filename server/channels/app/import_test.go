@@ -245,6 +245,102 @@ func TestImportBulkImport(t *testing.T) {
 		require.Nil(t, err, "BulkImport should have succeeded")
 		require.Equal(t, 0, line, "BulkImport line should be 0")
 	})
+
+	t.Run("Role and a user with the role", func(t *testing.T) {
+		roleName := model.NewId()
+		roleUsername := model.NewId()
+		data := `{"type": "version", "version": 1}
+{"type": "role", "role": {"name": "` + roleName + `", "display_name": "Imported role", "permissions": ["create_post"]}}
+{"type": "user", "user": {"username": "` + roleUsername + `", "email": "` + roleUsername + `@example.com", "roles": "system_user ` + roleName + `"}}`
+
+		err, line := th.App.BulkImport(th.Context, strings.NewReader(data), nil, false, 2)
+		require.Nil(t, err, "BulkImport should have succeeded")
+		require.Equal(t, 0, line, "BulkImport line should be 0")
+
+		user, appErr := th.App.GetUserByUsername(roleUsername)
+		require.Nil(t, appErr)
+		require.Equal(t, "system_user "+roleName, user.Roles)
+	})
+}
+
+func TestImportValidateBulkImport(t *testing.T) {
+	th := Setup(t)
+	defer th.TearDown()
+
+	teamName := model.NewRandomTeamName()
+	channelName := model.NewId()
+	username := model.NewId()
+
+	t.Run("valid", func(t *testing.T) {
+		data := `{"type": "version", "version": 1}
+{"type": "team", "team": {"type": "O", "display_name": "lskmw2d7a5ao7ppwqh5ljchvr4", "name": "` + teamName + `"}}
+{"type": "channel", "channel": {"type": "O", "display_name": "xr6m6udffngark2uekvr3hoeny", "team": "` + teamName + `", "name": "` + channelName + `"}}
+{"type": "user", "user": {"username": "` + username + `", "email": "` + username + `@example.com", "teams": [{"name": "` + teamName + `", "channels": [{"name": "` + channelName + `"}]}]}}
+{"type": "post", "post": {"team": "` + teamName + `", "channel": "` + channelName + `", "user": "` + username + `", "message": "Hello World", "create_at": 123456789012}}`
+
+		err, lines := th.App.ValidateBulkImport(th.Context, strings.NewReader(data))
+		require.Nil(t, err)
+		require.Equal(t, 5, lines)
+
+		team, _ := th.App.GetTeamByName(teamName)
+		require.Nil(t, team, "validating shouldn't import anything")
+	})
+
+	t.Run("out of order", func(t *testing.T) {
+		data := `{"type": "version", "version": 1}
+{"type": "user", "user": {"username": "` + username + `", "email": "` + username + `@example.com"}}
+{"type": "team", "team": {"type": "O", "display_name": "lskmw2d7a5ao7ppwqh5ljchvr4", "name": "` + teamName + `"}}`
+
+		err, line := th.App.ValidateBulkImport(th.Context, strings.NewReader(data))
+		require.NotNil(t, err)
+		require.Equal(t, "app.import.validate_line_order.out_of_order.error", err.Id)
+		require.Equal(t, 3, line)
+	})
+
+	t.Run("invalid line", func(t *testing.T) {
+		data := `{"type": "version", "version": 1}
+{"type": "team", "team": {"type": "O", "display_name": "lskmw2d7a5ao7ppwqh5ljchvr4", "name": "` + teamName + `"}}
+{"type": "channel", "channel": {"type": "O", "team": "` + teamName + `", "name": "` + channelName + `"}}`
+
+		err, line := th.App.ValidateBulkImport(th.Context, strings.NewReader(data))
+		require.NotNil(t, err)
+		require.Equal(t, 3, line)
+	})
+}
+
+func TestImportBulkImportWithOpts(t *testing.T) {
+	th := Setup(t)
+	defer th.TearDown()
+
+	teamName := model.NewRandomTeamName()
+	skippedTeamName := model.NewRandomTeamName()
+	channelName := model.NewId()
+
+	data := `{"type": "version", "version": 1}
+{"type": "team", "team": {"type": "O", "display_name": "lskmw2d7a5ao7ppwqh5ljchvr4", "name": "` + skippedTeamName + `"}}
+{"type": "team", "team": {"type": "O", "display_name": "lskmw2d7a5ao7ppwqh5ljchvr4", "name": "` + teamName + `"}}
+{"type": "channel", "channel": {"type": "O", "display_name": "xr6m6udffngark2uekvr3hoeny", "team": "` + teamName + `", "name": "` + channelName + `"}}`
+
+	job := &model.Job{Id: model.NewId(), Type: model.JobTypeImportProcess}
+	err, line := th.App.BulkImportWithOpts(th.Context, strings.NewReader(data), nil, job, model.BulkImportOpts{
+		Workers:        2,
+		ResumeFromLine: 3,
+		TotalLines:     4,
+	})
+	require.Nil(t, err)
+	require.Equal(t, 0, line)
+
+	_, err = th.App.GetTeamByName(skippedTeamName)
+	require.NotNil(t, err, "lines before the resume line shouldn't be imported")
+
+	team, err := th.App.GetTeamByName(teamName)
+	require.Nil(t, err)
+	_, err = th.App.GetChannelByName(th.Context, channelName, team.Id, false)
+	require.Nil(t, err)
+
+	assert.Equal(t, "5", job.Data["resume_from_line"])
+	assert.Equal(t, "4", job.Data["imported_lines"])
+	assert.Equal(t, int64(100), job.Progress)
 }
 
 func TestImportProcessImportDataFileVersionLine(t *testing.T) {
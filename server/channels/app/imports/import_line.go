@@ -0,0 +1,106 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package imports
+
+import (
+	"net/http"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+// lineTypeDependents maps each line type to the line types that may reference the objects it
+// creates, and which therefore have to be imported after it.
+var lineTypeDependents = map[string][]string{
+	"role":           {"team", "channel", "user"},
+	"scheme":         {"team", "channel"},
+	"team":           {"channel", "user", "post"},
+	"channel":        {"user", "post"},
+	"user":           {"post", "direct_channel", "direct_post"},
+	"direct_channel": {"direct_post"},
+}
+
+// LineDependsOn reports whether lines of type lineType may reference objects created by lines
+// of type other, so that they can't be imported concurrently.
+func LineDependsOn(lineType, other string) bool {
+	for _, dependent := range lineTypeDependents[other] {
+		if dependent == lineType {
+			return true
+		}
+	}
+	return false
+}
+
+// LineOrderValidator checks that no line of an import file comes after a line that
+// depends on it.
+type LineOrderValidator struct {
+	seen map[string]bool
+}
+
+func NewLineOrderValidator() *LineOrderValidator {
+	return &LineOrderValidator{
+		seen: make(map[string]bool),
+	}
+}
+
+func (v *LineOrderValidator) Validate(lineType string) *model.AppError {
+	for _, dependent := range lineTypeDependents[lineType] {
+		if v.seen[dependent] {
+			return model.NewAppError("BulkImport", "app.import.validate_line_order.out_of_order.error", map[string]any{"Type": lineType, "Dependent": dependent}, "", http.StatusBadRequest)
+		}
+	}
+	v.seen[lineType] = true
+
+	return nil
+}
+
+// ValidateLine validates the data of an import line without checking it against the
+// existing objects.
+func ValidateLine(line *LineImportData, maxPostSize int) *model.AppError {
+	switch line.Type {
+	case "role":
+		if line.Role == nil {
+			return model.NewAppError("BulkImport", "app.import.import_line.null_role.error", nil, "", http.StatusBadRequest)
+		}
+		return ValidateRoleImportData(line.Role)
+	case "scheme":
+		if line.Scheme == nil {
+			return model.NewAppError("BulkImport", "app.import.import_line.null_scheme.error", nil, "", http.StatusBadRequest)
+		}
+		return ValidateSchemeImportData(line.Scheme)
+	case "team":
+		if line.Team == nil {
+			return model.NewAppError("BulkImport", "app.import.import_line.null_team.error", nil, "", http.StatusBadRequest)
+		}
+		return ValidateTeamImportData(line.Team)
+	case "channel":
+		if line.Channel == nil {
+			return model.NewAppError("BulkImport", "app.import.import_line.null_channel.error", nil, "", http.StatusBadRequest)
+		}
+		return ValidateChannelImportData(line.Channel)
+	case "user":
+		if line.User == nil {
+			return model.NewAppError("BulkImport", "app.import.import_line.null_user.error", nil, "", http.StatusBadRequest)
+		}
+		return ValidateUserImportData(line.User)
+	case "post":
+		if line.Post == nil {
+			return model.NewAppError("BulkImport", "app.import.import_line.null_post.error", nil, "", http.StatusBadRequest)
+		}
+		return ValidatePostImportData(line.Post, maxPostSize)
+	case "direct_channel":
+		if line.DirectChannel == nil {
+			return model.NewAppError("BulkImport", "app.import.import_line.null_direct_channel.error", nil, "", http.StatusBadRequest)
+		}
+		return ValidateDirectChannelImportData(line.DirectChannel)
+	case "direct_post":
+		if line.DirectPost == nil {
+			return model.NewAppError("BulkImport", "app.import.import_line.null_direct_post.error", nil, "", http.StatusBadRequest)
+		}
+		return ValidateDirectPostImportData(line.DirectPost, maxPostSize)
+	case "emoji":
+		return ValidateEmojiImportData(line.Emoji)
+	default:
+		return model.NewAppError("BulkImport", "app.import.import_line.unknown_line_type.error", map[string]any{"Type": line.Type}, "", http.StatusBadRequest)
+	}
+}
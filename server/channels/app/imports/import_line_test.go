@@ -0,0 +1,77 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package imports
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportLineDependsOn(t *testing.T) {
+	assert.True(t, LineDependsOn("post", "user"))
+	assert.True(t, LineDependsOn("channel", "team"))
+	assert.True(t, LineDependsOn("direct_post", "direct_channel"))
+	assert.True(t, LineDependsOn("user", "role"))
+	assert.False(t, LineDependsOn("user", "post"))
+	assert.False(t, LineDependsOn("emoji", "post"))
+	assert.False(t, LineDependsOn("post", "post"))
+}
+
+func TestImportLineOrderValidator(t *testing.T) {
+	t.Run("export order", func(t *testing.T) {
+		v := NewLineOrderValidator()
+		for _, lineType := range []string{"scheme", "role", "team", "channel", "user", "post", "emoji", "direct_channel", "direct_post"} {
+			require.Nil(t, v.Validate(lineType))
+		}
+	})
+
+	t.Run("interleaved independent lines", func(t *testing.T) {
+		v := NewLineOrderValidator()
+		for _, lineType := range []string{"team", "emoji", "channel", "post", "emoji", "post"} {
+			require.Nil(t, v.Validate(lineType))
+		}
+	})
+
+	t.Run("out of order", func(t *testing.T) {
+		v := NewLineOrderValidator()
+		require.Nil(t, v.Validate("team"))
+		require.Nil(t, v.Validate("user"))
+		appErr := v.Validate("channel")
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.import.validate_line_order.out_of_order.error", appErr.Id)
+	})
+}
+
+func TestImportValidateLine(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		line := &LineImportData{
+			Type: "team",
+			Team: &TeamImportData{
+				Name:        ptrStr("teamname"),
+				DisplayName: ptrStr("Display Name"),
+				Type:        ptrStr("O"),
+			},
+		}
+		require.Nil(t, ValidateLine(line, 100))
+	})
+
+	t.Run("missing data", func(t *testing.T) {
+		appErr := ValidateLine(&LineImportData{Type: "post"}, 100)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.import.import_line.null_post.error", appErr.Id)
+	})
+
+	t.Run("invalid data", func(t *testing.T) {
+		appErr := ValidateLine(&LineImportData{Type: "user", User: &UserImportData{}}, 100)
+		require.NotNil(t, appErr)
+	})
+
+	t.Run("unknown type", func(t *testing.T) {
+		appErr := ValidateLine(&LineImportData{Type: "unknown"}, 100)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.import.import_line.unknown_line_type.error", appErr.Id)
+	})
+}
@@ -0,0 +1,201 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package imports
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+var remapMentionRegexp = regexp.MustCompile(`(^|[^\w@])@([\w.\-]+)`)
+
+// Remapper renames the users and teams of the lines of an export from another instance
+// according to an ImportMergeMapping, including the mentions and permalinks of the messages.
+type Remapper struct {
+	users map[string]string
+	teams map[string]string
+
+	sourcePermalink *regexp.Regexp
+	permalink       *regexp.Regexp
+	siteURL         string
+
+	// ReferencedPostIds holds the ids of the exported posts that permalinks link to.
+	ReferencedPostIds map[string]bool
+}
+
+func NewRemapper(mapping *model.ImportMergeMapping, siteURL string) *Remapper {
+	r := &Remapper{
+		users:             make(map[string]string, len(mapping.Users)),
+		teams:             make(map[string]string, len(mapping.Teams)),
+		siteURL:           strings.TrimSuffix(siteURL, "/"),
+		ReferencedPostIds: make(map[string]bool),
+	}
+	for from, to := range mapping.Users {
+		r.users[strings.ToLower(from)] = to
+	}
+	for from, to := range mapping.Teams {
+		r.teams[strings.ToLower(from)] = to
+	}
+
+	if mapping.SourceSiteURL != "" && r.siteURL != "" {
+		r.sourcePermalink = regexp.MustCompile(`(?i)` + regexp.QuoteMeta(strings.TrimSuffix(mapping.SourceSiteURL, "/")) + `/([a-z0-9\-_]+)/pl/([a-z0-9]{26})\b`)
+		r.permalink = regexp.MustCompile(`(?i)` + regexp.QuoteMeta(r.siteURL) + `/([a-z0-9\-_]+)/pl/([a-z0-9]{26})\b`)
+	}
+
+	return r
+}
+
+// RemapLine renames the users and teams of the line in place.
+func (r *Remapper) RemapLine(line *LineImportData) {
+	switch line.Type {
+	case "team":
+		if line.Team != nil {
+			r.remapTeam(line.Team.Name)
+		}
+	case "channel":
+		if line.Channel != nil {
+			r.remapTeam(line.Channel.Team)
+		}
+	case "user":
+		if line.User != nil {
+			r.remapUser(line.User.Username)
+			if line.User.Teams != nil {
+				for i := range *line.User.Teams {
+					r.remapTeam((*line.User.Teams)[i].Name)
+				}
+			}
+		}
+	case "post":
+		if line.Post != nil {
+			r.remapTeam(line.Post.Team)
+			r.remapUser(line.Post.User)
+			r.remapUsers(line.Post.FlaggedBy)
+			r.remapReactions(line.Post.Reactions)
+			r.remapReplies(line.Post.Replies)
+			r.remapMessage(line.Post.Message)
+		}
+	case "direct_channel":
+		if line.DirectChannel != nil {
+			r.remapUsers(line.DirectChannel.Members)
+			r.remapUsers(line.DirectChannel.FavoritedBy)
+		}
+	case "direct_post":
+		if line.DirectPost != nil {
+			r.remapUsers(line.DirectPost.ChannelMembers)
+			r.remapUser(line.DirectPost.User)
+			r.remapUsers(line.DirectPost.FlaggedBy)
+			r.remapReactions(line.DirectPost.Reactions)
+			r.remapReplies(line.DirectPost.Replies)
+			r.remapMessage(line.DirectPost.Message)
+		}
+	}
+}
+
+func (r *Remapper) remapUser(username *string) {
+	if username == nil {
+		return
+	}
+	if to, ok := r.users[strings.ToLower(*username)]; ok {
+		*username = to
+	}
+}
+
+func (r *Remapper) remapUsers(usernames *[]string) {
+	if usernames == nil {
+		return
+	}
+	for i := range *usernames {
+		r.remapUser(&(*usernames)[i])
+	}
+}
+
+func (r *Remapper) remapTeam(name *string) {
+	if name == nil {
+		return
+	}
+	if to, ok := r.teams[strings.ToLower(*name)]; ok {
+		*name = to
+	}
+}
+
+func (r *Remapper) remapReactions(reactions *[]ReactionImportData) {
+	if reactions == nil {
+		return
+	}
+	for i := range *reactions {
+		r.remapUser((*reactions)[i].User)
+	}
+}
+
+func (r *Remapper) remapReplies(replies *[]ReplyImportData) {
+	if replies == nil {
+		return
+	}
+	for i := range *replies {
+		reply := &(*replies)[i]
+		r.remapUser(reply.User)
+		r.remapUsers(reply.FlaggedBy)
+		r.remapReactions(reply.Reactions)
+		r.remapMessage(reply.Message)
+	}
+}
+
+// remapMessage renames the mentioned users and rewrites the permalinks to the exported
+// instance to point to this one. The ids of the linked posts are recorded so that they can
+// be translated once the posts are imported.
+func (r *Remapper) remapMessage(message *string) {
+	if message == nil {
+		return
+	}
+
+	if len(r.users) > 0 {
+		*message = remapMentionRegexp.ReplaceAllStringFunc(*message, func(match string) string {
+			groups := remapMentionRegexp.FindStringSubmatch(match)
+			username := groups[2]
+			// A trailing dot or dash is more likely to be punctuation than part of the username.
+			trimmed := strings.TrimRight(username, ".-_")
+			if to, ok := r.users[strings.ToLower(username)]; ok {
+				return groups[1] + "@" + to
+			} else if to, ok := r.users[strings.ToLower(trimmed)]; ok {
+				return groups[1] + "@" + to + username[len(trimmed):]
+			}
+			return match
+		})
+	}
+
+	if r.sourcePermalink != nil {
+		*message = r.sourcePermalink.ReplaceAllStringFunc(*message, func(match string) string {
+			groups := r.sourcePermalink.FindStringSubmatch(match)
+			team := groups[1]
+			if to, ok := r.teams[strings.ToLower(team)]; ok {
+				team = to
+			}
+			r.ReferencedPostIds[groups[2]] = true
+			return r.siteURL + "/" + team + "/pl/" + groups[2]
+		})
+	}
+}
+
+// HasPermalinks reports whether a remapped message links to posts of this instance.
+func (r *Remapper) HasPermalinks(message string) bool {
+	return r.permalink != nil && r.permalink.MatchString(message)
+}
+
+// TranslatePermalinks replaces the ids of the exported posts linked to by a remapped
+// message by the ids of the imported posts.
+func (r *Remapper) TranslatePermalinks(message string, postIds map[string]string) string {
+	if r.permalink == nil {
+		return message
+	}
+
+	return r.permalink.ReplaceAllStringFunc(message, func(match string) string {
+		groups := r.permalink.FindStringSubmatch(match)
+		if id, ok := postIds[groups[2]]; ok {
+			return match[:len(match)-len(groups[2])] + id
+		}
+		return match
+	})
+}
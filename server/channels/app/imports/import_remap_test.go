@@ -0,0 +1,105 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package imports
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+func TestRemapper(t *testing.T) {
+	postID := model.NewId()
+	mapping := &model.ImportMergeMapping{
+		Users:         map[string]string{"john": "john.acme"},
+		Teams:         map[string]string{"engineering": "acme-engineering"},
+		SourceSiteURL: "https://chat.acme.com/",
+	}
+
+	t.Run("users and teams", func(t *testing.T) {
+		r := NewRemapper(mapping, "https://chat.example.com")
+
+		user := &LineImportData{
+			Type: "user",
+			User: &UserImportData{
+				Username: ptrStr("John"),
+				Teams:    &[]UserTeamImportData{{Name: ptrStr("engineering")}, {Name: ptrStr("sales")}},
+			},
+		}
+		r.RemapLine(user)
+		assert.Equal(t, "john.acme", *user.User.Username)
+		assert.Equal(t, "acme-engineering", *(*user.User.Teams)[0].Name)
+		assert.Equal(t, "sales", *(*user.User.Teams)[1].Name)
+
+		post := &LineImportData{
+			Type: "post",
+			Post: &PostImportData{
+				Team:      ptrStr("engineering"),
+				User:      ptrStr("john"),
+				Message:   ptrStr("hi"),
+				FlaggedBy: &[]string{"jane", "john"},
+				Reactions: &[]ReactionImportData{{User: ptrStr("john")}},
+				Replies:   &[]ReplyImportData{{User: ptrStr("john"), Message: ptrStr("@john.")}},
+			},
+		}
+		r.RemapLine(post)
+		assert.Equal(t, "acme-engineering", *post.Post.Team)
+		assert.Equal(t, "john.acme", *post.Post.User)
+		assert.Equal(t, []string{"jane", "john.acme"}, *post.Post.FlaggedBy)
+		assert.Equal(t, "john.acme", *(*post.Post.Reactions)[0].User)
+		assert.Equal(t, "john.acme", *(*post.Post.Replies)[0].User)
+		assert.Equal(t, "@john.acme.", *(*post.Post.Replies)[0].Message)
+
+		directChannel := &LineImportData{
+			Type:          "direct_channel",
+			DirectChannel: &DirectChannelImportData{Members: &[]string{"john", "jane"}},
+		}
+		r.RemapLine(directChannel)
+		assert.Equal(t, []string{"john.acme", "jane"}, *directChannel.DirectChannel.Members)
+	})
+
+	t.Run("mentions", func(t *testing.T) {
+		r := NewRemapper(mapping, "https://chat.example.com")
+		for message, expected := range map[string]string{
+			"@john hello":               "@john.acme hello",
+			"hello @John, and @johnny":  "hello @john.acme, and @johnny",
+			"(@john)":                   "(@john.acme)",
+			"mail john@john.com":        "mail john@john.com",
+			"no mentions":               "no mentions",
+			"@jane and @john and @john": "@jane and @john.acme and @john.acme",
+		} {
+			message := message
+			r.remapMessage(&message)
+			assert.Equal(t, expected, message)
+		}
+	})
+
+	t.Run("permalinks", func(t *testing.T) {
+		r := NewRemapper(mapping, "https://chat.example.com/")
+
+		message := "see https://chat.acme.com/engineering/pl/" + postID + " and https://chat.acme.com/sales/pl/" + postID + "."
+		r.remapMessage(&message)
+		assert.Equal(t, "see https://chat.example.com/acme-engineering/pl/"+postID+" and https://chat.example.com/sales/pl/"+postID+".", message)
+		assert.Equal(t, map[string]bool{postID: true}, r.ReferencedPostIds)
+		require.True(t, r.HasPermalinks(message))
+		assert.False(t, r.HasPermalinks("https://chat.acme.com/engineering/pl/"+postID))
+
+		newID := model.NewId()
+		assert.Equal(t, "see https://chat.example.com/acme-engineering/pl/"+newID+" and https://chat.example.com/sales/pl/"+newID+".", r.TranslatePermalinks(message, map[string]string{postID: newID}))
+		assert.Equal(t, message, r.TranslatePermalinks(message, map[string]string{}))
+	})
+
+	t.Run("no source site url", func(t *testing.T) {
+		r := NewRemapper(&model.ImportMergeMapping{}, "https://chat.example.com")
+
+		message := "https://chat.acme.com/engineering/pl/" + postID
+		r.remapMessage(&message)
+		assert.Equal(t, "https://chat.acme.com/engineering/pl/"+postID, message)
+		assert.Empty(t, r.ReferencedPostIds)
+		assert.False(t, r.HasPermalinks(message))
+	})
+}
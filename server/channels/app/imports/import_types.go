@@ -141,6 +141,9 @@ type ReactionImportData struct {
 }
 
 type ReplyImportData struct {
+	// Id is the id of the post on the exported instance. It's only used to translate the
+	// permalinks to the post when merging instances.
+	Id   *string `json:"id,omitempty"`
 	User *string `json:"user"`
 
 	Type     *string `json:"type"`
@@ -154,6 +157,7 @@ type ReplyImportData struct {
 }
 
 type PostImportData struct {
+	Id      *string `json:"id,omitempty"`
 	Team    *string `json:"team"`
 	Channel *string `json:"channel"`
 	User    *string `json:"user"`
@@ -179,6 +183,7 @@ type DirectChannelImportData struct {
 }
 
 type DirectPostImportData struct {
+	Id             *string   `json:"id,omitempty"`
 	ChannelMembers *[]string `json:"channel_members"`
 	User           *string   `json:"user"`
 
@@ -481,7 +481,9 @@ func (a *App) OpenInteractiveDialog(c request.CTX, request model.OpenDialogReque
 func (a *App) SubmitInteractiveDialog(c request.CTX, request model.SubmitDialogRequest) (*model.SubmitDialogResponse, *model.AppError) {
 	url := request.URL
 	request.URL = ""
-	request.Type = "dialog_submission"
+	if request.Type != model.SubmitDialogTypeRefresh {
+		request.Type = model.SubmitDialogTypeSubmission
+	}
 
 	b, err := json.Marshal(request)
 	if err != nil {
@@ -499,5 +501,49 @@ func (a *App) SubmitInteractiveDialog(c request.CTX, request model.SubmitDialogR
 	var response model.SubmitDialogResponse
 	json.NewDecoder(resp.Body).Decode(&response) // Don't fail, an empty response is acceptable
 
+	if response.Type == model.SubmitDialogResponseTypeForm {
+		if formErr := response.IsValid(); formErr != nil {
+			c.Logger().Warn("Interactive dialog form is invalid", mlog.Err(formErr))
+		}
+	}
+
+	return &response, nil
+}
+
+// LookupInteractiveDialog asks the integration for the options of a dynamic select element
+// of a dialog, matching what the user typed.
+func (a *App) LookupInteractiveDialog(c request.CTX, request model.DialogLookupRequest) (*model.DialogLookupResponse, *model.AppError) {
+	url := request.URL
+	request.URL = ""
+
+	b, err := json.Marshal(request)
+	if err != nil {
+		return nil, model.NewAppError("LookupInteractiveDialog", "app.lookup_interactive_dialog.json_error", nil, "", http.StatusBadRequest).Wrap(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(*a.Config().ServiceSettings.OutgoingIntegrationRequestsTimeout)*time.Second)
+	defer cancel()
+	resp, appErr := a.DoActionRequest(c.WithContext(ctx), url, b)
+	if appErr != nil {
+		return nil, appErr
+	}
+	defer resp.Body.Close()
+
+	var response model.DialogLookupResponse
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return nil, model.NewAppError("LookupInteractiveDialog", "app.lookup_interactive_dialog.decode_json_error", nil, "", http.StatusBadRequest).Wrap(err)
+	}
+
+	items := make([]*model.PostActionOptions, 0, len(response.Items))
+	for _, item := range response.Items {
+		if item != nil {
+			items = append(items, item)
+		}
+	}
+	if len(items) > model.DialogLookupMaxItems {
+		items = items[:model.DialogLookupMaxItems]
+	}
+	response.Items = items
+
 	return &response, nil
 }
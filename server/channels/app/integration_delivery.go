@@ -0,0 +1,142 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+)
+
+// limitedBuffer keeps the first limit bytes written to it, discarding the rest.
+type limitedBuffer struct {
+	bytes.Buffer
+	limit int
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if room := b.limit - b.Len(); room > 0 {
+		b.Buffer.Write(p[:min(len(p), room)])
+	}
+	return len(p), nil
+}
+
+func (a *App) integrationDeliveryLogEnabled() bool {
+	return *a.Config().ServiceSettings.EnableIntegrationDeliveryLog
+}
+
+// recordIntegrationDelivery saves a delivery to an outgoing webhook or a slash command, if the
+// integration delivery log is enabled, keeping only the most recent deliveries of the integration.
+func (a *App) recordIntegrationDelivery(c request.CTX, delivery *model.IntegrationDelivery) {
+	if !a.integrationDeliveryLogEnabled() {
+		return
+	}
+
+	if _, err := a.Srv().Store().IntegrationDelivery().Save(delivery); err != nil {
+		c.Logger().Warn("Failed to record integration delivery", mlog.String("hook_id", delivery.HookId), mlog.Err(err))
+		return
+	}
+
+	if err := a.Srv().Store().IntegrationDelivery().PruneForHook(delivery.HookId, model.IntegrationDeliveryMaxPerHook); err != nil {
+		c.Logger().Warn("Failed to prune integration deliveries", mlog.String("hook_id", delivery.HookId), mlog.Err(err))
+	}
+}
+
+// GetIntegrationDeliveries returns the recorded deliveries of the outgoing webhook or the slash
+// command, most recent first.
+func (a *App) GetIntegrationDeliveries(hookID string, page, perPage int) ([]*model.IntegrationDelivery, *model.AppError) {
+	if !a.integrationDeliveryLogEnabled() {
+		return nil, model.NewAppError("GetIntegrationDeliveries", "app.integration_delivery.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	if perPage <= 0 || perPage > model.IntegrationDeliveryMaxPage {
+		perPage = model.IntegrationDeliveryMaxPage
+	}
+
+	deliveries, err := a.Srv().Store().IntegrationDelivery().GetForHook(hookID, page, perPage)
+	if err != nil {
+		return nil, model.NewAppError("GetIntegrationDeliveries", "app.integration_delivery.get_for_hook.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return deliveries, nil
+}
+
+// ReplayOutgoingWebhookDelivery sends the payload of a recorded delivery to the outgoing webhook
+// again, with the current token of the webhook, and returns the new delivery.
+func (a *App) ReplayOutgoingWebhookDelivery(c request.CTX, hook *model.OutgoingWebhook, deliveryID string) (*model.IntegrationDelivery, *model.AppError) {
+	if !*a.Config().ServiceSettings.EnableOutgoingWebhooks {
+		return nil, model.NewAppError("ReplayOutgoingWebhookDelivery", "api.outgoing_webhook.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+	if !a.integrationDeliveryLogEnabled() {
+		return nil, model.NewAppError("ReplayOutgoingWebhookDelivery", "app.integration_delivery.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	delivery, err := a.Srv().Store().IntegrationDelivery().Get(deliveryID)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		if errors.As(err, &nfErr) {
+			return nil, model.NewAppError("ReplayOutgoingWebhookDelivery", "app.integration_delivery.get.not_found.app_error", nil, "", http.StatusNotFound).Wrap(err)
+		}
+		return nil, model.NewAppError("ReplayOutgoingWebhookDelivery", "app.integration_delivery.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	if delivery.HookId != hook.Id || delivery.Type != model.IntegrationDeliveryTypeOutgoingWebhook {
+		return nil, model.NewAppError("ReplayOutgoingWebhookDelivery", "app.integration_delivery.get.not_found.app_error", nil, "", http.StatusNotFound)
+	}
+	if delivery.Payload == "" {
+		return nil, model.NewAppError("ReplayOutgoingWebhookDelivery", "app.integration_delivery.replay.no_payload.app_error", nil, "id="+delivery.Id, http.StatusBadRequest)
+	}
+
+	// The callback URLs of the webhook may have changed since the delivery.
+	if !hook.CallbackURLs.Contains(delivery.URL) {
+		return nil, model.NewAppError("ReplayOutgoingWebhookDelivery", "app.integration_delivery.replay.callback_url.app_error", nil, "id="+delivery.Id, http.StatusBadRequest)
+	}
+
+	body, err := replaceOutgoingWebhookPayloadToken(delivery.ContentType, delivery.Payload, hook.Token)
+	if err != nil {
+		return nil, model.NewAppError("ReplayOutgoingWebhookDelivery", "app.integration_delivery.replay.payload.app_error", nil, "id="+delivery.Id, http.StatusBadRequest).Wrap(err)
+	}
+
+	post, appErr := a.GetSinglePost(c, delivery.PostId, false)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	channel, appErr := a.GetChannel(c, delivery.ChannelId)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	return a.deliverOutgoingWebhook(c, hook, delivery.URL, delivery.ContentType, body, post, channel, delivery.Id), nil
+}
+
+// replaceOutgoingWebhookPayloadToken sets the token of an encoded outgoing webhook payload, which
+// may have been regenerated since the payload was sent.
+func replaceOutgoingWebhookPayloadToken(contentType, body, token string) (string, error) {
+	if contentType == "application/json" {
+		var payload model.OutgoingWebhookPayload
+		if err := json.Unmarshal([]byte(body), &payload); err != nil {
+			return "", err
+		}
+		payload.Token = token
+		jsonBytes, err := json.Marshal(payload)
+		if err != nil {
+			return "", err
+		}
+		return string(jsonBytes), nil
+	}
+
+	values, err := url.ParseQuery(body)
+	if err != nil {
+		return "", err
+	}
+	values.Set("token", token)
+	return values.Encode(), nil
+}
@@ -0,0 +1,60 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"encoding/json"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+func TestReplaceOutgoingWebhookPayloadToken(t *testing.T) {
+	payload := &model.OutgoingWebhookPayload{Token: "old", TeamId: model.NewId(), Text: "hello"}
+
+	t.Run("json", func(t *testing.T) {
+		jsonBytes, err := json.Marshal(payload)
+		require.NoError(t, err)
+
+		body, err := replaceOutgoingWebhookPayloadToken("application/json", string(jsonBytes), "new")
+		require.NoError(t, err)
+
+		var replaced model.OutgoingWebhookPayload
+		require.NoError(t, json.Unmarshal([]byte(body), &replaced))
+		assert.Equal(t, "new", replaced.Token)
+		assert.Equal(t, payload.TeamId, replaced.TeamId)
+		assert.Equal(t, "hello", replaced.Text)
+
+		_, err = replaceOutgoingWebhookPayloadToken("application/json", "not json", "new")
+		assert.Error(t, err)
+	})
+
+	t.Run("form", func(t *testing.T) {
+		body, err := replaceOutgoingWebhookPayloadToken("application/x-www-form-urlencoded", payload.ToFormValues(), "new")
+		require.NoError(t, err)
+
+		values, err := url.ParseQuery(body)
+		require.NoError(t, err)
+		assert.Equal(t, "new", values.Get("token"))
+		assert.Equal(t, "hello", values.Get("text"))
+	})
+}
+
+func TestLimitedBuffer(t *testing.T) {
+	b := &limitedBuffer{limit: 5}
+
+	n, err := b.Write([]byte("abc"))
+	require.NoError(t, err)
+	assert.Equal(t, 3, n)
+
+	n, err = b.Write([]byte("defgh"))
+	require.NoError(t, err)
+	assert.Equal(t, 5, n)
+
+	assert.Equal(t, "abcde", b.String())
+}
@@ -79,13 +79,17 @@ func (a *App) SessionHasPermissionToCreateJob(session model.Session, job *model.
 		model.JobTypePlugins,
 		model.JobTypeProductNotices,
 		model.JobTypeExpiryNotify,
+		model.JobTypeEmailDigest,
 		model.JobTypeActiveUsers,
 		model.JobTypeImportProcess,
 		model.JobTypeImportDelete,
+		model.JobTypeTeamsImport,
+		model.JobTypeImportMerge,
 		model.JobTypeExportProcess,
 		model.JobTypeExportDelete,
 		model.JobTypeCloud,
-		model.JobTypeExtractContent:
+		model.JobTypeExtractContent,
+		model.JobTypeFileContentHashBackfill:
 		return a.SessionHasPermissionTo(session, model.PermissionManageJobs), model.PermissionManageJobs
 	}
 
@@ -110,13 +114,17 @@ func (a *App) SessionHasPermissionToReadJob(session model.Session, jobType strin
 		model.JobTypePlugins,
 		model.JobTypeProductNotices,
 		model.JobTypeExpiryNotify,
+		model.JobTypeEmailDigest,
 		model.JobTypeActiveUsers,
 		model.JobTypeImportProcess,
 		model.JobTypeImportDelete,
+		model.JobTypeTeamsImport,
+		model.JobTypeImportMerge,
 		model.JobTypeExportProcess,
 		model.JobTypeExportDelete,
 		model.JobTypeCloud,
-		model.JobTypeExtractContent:
+		model.JobTypeExtractContent,
+		model.JobTypeFileContentHashBackfill:
 		return a.SessionHasPermissionTo(session, model.PermissionReadJobs), model.PermissionReadJobs
 	}
 
@@ -0,0 +1,46 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+func (a *App) GetMaintenanceMode() *model.MaintenanceMode {
+	return a.Srv().Platform().MaintenanceMode()
+}
+
+// EnableMaintenanceMode saves the maintenance mode in the config, so that every server of the
+// cluster rejects the write requests until it's disabled. The current message and retry delay
+// are kept when not given.
+func (a *App) EnableMaintenanceMode(message string, retryAfterSeconds int) (*model.MaintenanceMode, *model.AppError) {
+	cfg := a.Config().Clone()
+	cfg.ServiceSettings.EnableMaintenanceMode = model.NewBool(true)
+	if message != "" {
+		cfg.ServiceSettings.MaintenanceModeMessage = model.NewString(message)
+	}
+	if retryAfterSeconds != 0 {
+		cfg.ServiceSettings.MaintenanceModeRetryAfterSeconds = model.NewInt(retryAfterSeconds)
+	}
+
+	if appErr := cfg.IsValid(); appErr != nil {
+		return nil, appErr
+	}
+	if _, _, appErr := a.SaveConfig(cfg, true); appErr != nil {
+		return nil, appErr
+	}
+
+	return a.GetMaintenanceMode(), nil
+}
+
+func (a *App) DisableMaintenanceMode() (*model.MaintenanceMode, *model.AppError) {
+	cfg := a.Config().Clone()
+	cfg.ServiceSettings.EnableMaintenanceMode = model.NewBool(false)
+
+	if _, _, appErr := a.SaveConfig(cfg, true); appErr != nil {
+		return nil, appErr
+	}
+
+	return a.GetMaintenanceMode(), nil
+}
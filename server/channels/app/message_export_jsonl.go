@@ -0,0 +1,95 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"strconv"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/platform/services/jsonlexport"
+)
+
+const jsonlMessageExportDir = "export"
+
+// RunJsonlMessageExport exports the posts updated since the given time in the line-delimited
+// JSON format to the export file store, and returns the directory of the export along with
+// the number of warnings encountered. A negative limit exports all posts.
+//
+// The export is streamed in batches through temporary files so that its size isn't bound
+// by the available memory.
+func (a *App) RunJsonlMessageExport(rctx request.CTX, since int64, limit int) (string, int64, *model.AppError) {
+	postsFile, err := os.CreateTemp("", "jsonl-export-posts")
+	if err != nil {
+		return "", 0, model.NewAppError("RunJsonlMessageExport", "app.message_export.jsonl.create_temp.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	defer os.Remove(postsFile.Name())
+	defer postsFile.Close()
+
+	attachmentsFile, err := os.CreateTemp("", "jsonl-export-attachments")
+	if err != nil {
+		return "", 0, model.NewAppError("RunJsonlMessageExport", "app.message_export.jsonl.create_temp.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	defer os.Remove(attachmentsFile.Name())
+	defer attachmentsFile.Close()
+
+	writer := jsonlexport.NewWriter(postsFile, attachmentsFile)
+	getFileInfos := func(postID string) ([]*model.FileInfo, error) {
+		return a.Srv().Store().FileInfo().GetForPost(postID, false, true, false)
+	}
+
+	batchSize := *a.Config().MessageExportSettings.BatchSize
+	if batchSize <= 0 {
+		return "", 0, model.NewAppError("RunJsonlMessageExport", "app.message_export.jsonl.batch_size.app_error", map[string]any{"BatchSize": batchSize}, "", http.StatusBadRequest)
+	}
+
+	cursor := model.MessageExportCursor{LastPostUpdateAt: since}
+	var warnings int64
+	exported := 0
+	for limit < 0 || exported < limit {
+		batchLimit := batchSize
+		if limit >= 0 && limit-exported < batchLimit {
+			batchLimit = limit - exported
+		}
+
+		var posts []*model.MessageExport
+		posts, cursor, err = a.Srv().Store().Compliance().MessageExport(rctx, cursor, batchLimit)
+		if err != nil {
+			return "", warnings, model.NewAppError("RunJsonlMessageExport", "app.message_export.jsonl.export.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+
+		batchWarnings, err := jsonlexport.Export(rctx, writer, posts, getFileInfos, a.FileBackend())
+		warnings += batchWarnings
+		if err != nil {
+			return "", warnings, model.NewAppError("RunJsonlMessageExport", "app.message_export.jsonl.export.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+
+		exported += len(posts)
+		if len(posts) < batchLimit {
+			break
+		}
+	}
+
+	exportDir := path.Join(jsonlMessageExportDir, model.ComplianceExportTypeJsonl+"-"+strconv.FormatInt(model.GetMillis(), 10))
+	for name, file := range map[string]*os.File{
+		jsonlexport.PostsFileName:       postsFile,
+		jsonlexport.AttachmentsFileName: attachmentsFile,
+	} {
+		if _, err := file.Seek(0, io.SeekStart); err != nil {
+			return "", warnings, model.NewAppError("RunJsonlMessageExport", "app.message_export.jsonl.write.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+		if _, err := a.ExportFileBackend().WriteFile(file, path.Join(exportDir, name)); err != nil {
+			return "", warnings, model.NewAppError("RunJsonlMessageExport", "app.message_export.jsonl.write.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	rctx.Logger().Info("JSONL message export finished", mlog.String("export_dir", exportDir), mlog.Int("posts", exported), mlog.Int("warnings", warnings))
+
+	return exportDir, warnings, nil
+}
@@ -5,6 +5,7 @@ package app
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
 	"net/http"
 	"sort"
@@ -171,6 +172,23 @@ func (a *App) SendNotifications(c request.CTX, post *model.Post, team *model.Tea
 		// Iterate through all groups that were mentioned and insert group members into the list of mentions or potential mentions
 		for groupID := range mentions.GroupMentions {
 			group := groups[groupID]
+			restriction, err := a.checkGroupMentionRestrictions(sender, group)
+			if err != nil {
+				a.CountNotificationReason(model.NotificationStatusError, model.NotificationTypeAll, model.NotificationReasonFetchError)
+				a.NotificationsLog().Error("Failed to check group mention restrictions",
+					mlog.String("sender_id", sender.Id),
+					mlog.String("post_id", post.Id),
+					mlog.String("status", model.NotificationStatusError),
+					mlog.String("reason", model.NotificationReasonFetchError),
+					mlog.Err(err),
+				)
+				return nil, err
+			}
+			if restriction != "" {
+				a.sendGroupMentionRestricted(c, sender, post, channel, group, restriction)
+				continue
+			}
+
 			anyUsersMentionedByGroup, err := a.insertGroupMentions(sender.Id, group, channel, profileMap, mentions)
 			if err != nil {
 				a.CountNotificationReason(model.NotificationStatusError, model.NotificationTypeAll, model.NotificationReasonFetchError)
@@ -189,6 +207,18 @@ func (a *App) SendNotifications(c request.CTX, post *model.Post, team *model.Tea
 			}
 		}
 
+		if err := a.insertTeamOfMentions(c, sender.Id, post, profileMap, mentions); err != nil {
+			a.CountNotificationReason(model.NotificationStatusError, model.NotificationTypeAll, model.NotificationReasonFetchError)
+			a.NotificationsLog().Error("Failed to populate team-of mentions",
+				mlog.String("sender_id", sender.Id),
+				mlog.String("post_id", post.Id),
+				mlog.String("status", model.NotificationStatusError),
+				mlog.String("reason", model.NotificationReasonFetchError),
+				mlog.Err(err),
+			)
+			return nil, err
+		}
+
 		go func() {
 			_, err := a.sendOutOfChannelMentions(c, sender, post, channel, mentions.OtherPotentialMentions)
 			if err != nil {
@@ -364,6 +394,10 @@ func (a *App) SendNotifications(c request.CTX, post *model.Post, team *model.Tea
 		}
 	}
 
+	// Quiet hours and muted keywords of the channel members silence emails and push notifications.
+	silenced := a.getSilencedUsers(post, profileMap, channelMemberNotifyPropsMap, mentions)
+	notificationsForCRT.removeSilenced(silenced)
+
 	notification := &PostNotification{
 		Post:       post.Clone(),
 		Channel:    channel,
@@ -394,6 +428,12 @@ func (a *App) SendNotifications(c request.CTX, post *model.Post, team *model.Tea
 				continue
 			}
 
+			if reason, ok := silenced[id]; ok {
+				a.CountNotificationReason(model.NotificationStatusNotSent, model.NotificationTypeEmail, reason)
+				a.RecordNotificationDelivery(id, post.Id, post.ChannelId, model.NotificationTypeEmail, model.NotificationStatusNotSent, reason, "")
+				continue
+			}
+
 			//If email verification is required and user email is not verified don't send email.
 			if *a.Config().EmailSettings.RequireEmailVerification && !profileMap[id].EmailVerified {
 				a.CountNotificationReason(model.NotificationStatusNotSent, model.NotificationTypeEmail, model.NotificationReasonEmailNotVerified)
@@ -406,6 +446,21 @@ func (a *App) SendNotifications(c request.CTX, post *model.Post, team *model.Tea
 					mlog.String("receiver_id", id),
 				)
 				c.Logger().Debug("Skipped sending notification email, address not verified.", mlog.String("user_email", profileMap[id].Email), mlog.String("user_id", id))
+				a.RecordNotificationDelivery(id, post.Id, post.ChannelId, model.NotificationTypeEmail, model.NotificationStatusNotSent, model.NotificationReasonEmailNotVerified, "")
+				continue
+			}
+
+			if a.Srv().EmailService.IsUndeliverable(profileMap[id].Email) {
+				a.CountNotificationReason(model.NotificationStatusNotSent, model.NotificationTypeEmail, model.NotificationReasonEmailUndeliverable)
+				a.NotificationsLog().Debug("Email address undeliverable",
+					mlog.String("type", model.NotificationTypeEmail),
+					mlog.String("post_id", post.Id),
+					mlog.String("status", model.NotificationStatusNotSent),
+					mlog.String("reason", model.NotificationReasonEmailUndeliverable),
+					mlog.String("sender_id", sender.Id),
+					mlog.String("receiver_id", id),
+				)
+				a.RecordNotificationDelivery(id, post.Id, post.ChannelId, model.NotificationTypeEmail, model.NotificationStatusNotSent, model.NotificationReasonEmailUndeliverable, "")
 				continue
 			}
 
@@ -426,16 +481,20 @@ func (a *App) SendNotifications(c request.CTX, post *model.Post, team *model.Tea
 						mlog.Err(err),
 					)
 					c.Logger().Warn("Unable to send notification email.", mlog.Err(err))
+					a.RecordNotificationDelivery(id, post.Id, post.ChannelId, model.NotificationTypeEmail, model.NotificationStatusError, model.NotificationReasonEmailSendError, err.Error())
+				} else {
+					a.RecordNotificationDelivery(id, post.Id, post.ChannelId, model.NotificationTypeEmail, model.NotificationStatusSuccess, "", "")
 				}
 			} else {
 				a.NotificationsLog().Debug("Email disallowed by user",
 					mlog.String("type", model.NotificationTypeEmail),
 					mlog.String("post_id", post.Id),
 					mlog.String("status", model.NotificationStatusNotSent),
-					mlog.String("reason", "email_disallowed_by_user"),
+					mlog.String("reason", model.NotificationReasonEmailDisallowedByUser),
 					mlog.String("sender_id", sender.Id),
 					mlog.String("receiver_id", id),
 				)
+				a.RecordNotificationDelivery(id, post.Id, post.ChannelId, model.NotificationTypeEmail, model.NotificationStatusNotSent, model.NotificationReasonEmailDisallowedByUser, "")
 			}
 		}
 
@@ -495,7 +554,7 @@ func (a *App) SendNotifications(c request.CTX, post *model.Post, team *model.Tea
 		}
 	}
 
-	if a.canSendPushNotifications() {
+	if a.canSendPushNotifications() || a.canSendWebPushNotifications() {
 		a.NotificationsLog().Trace("Begin sending push notifications",
 			mlog.String("type", model.NotificationTypePush),
 			mlog.String("sender_id", sender.Id),
@@ -526,6 +585,12 @@ func (a *App) SendNotifications(c request.CTX, post *model.Post, team *model.Tea
 				continue
 			}
 
+			if reason, ok := silenced[id]; ok {
+				a.CountNotificationReason(model.NotificationStatusNotSent, model.NotificationTypePush, reason)
+				a.RecordNotificationDelivery(id, post.Id, post.ChannelId, model.NotificationTypePush, model.NotificationStatusNotSent, reason, "")
+				continue
+			}
+
 			var status *model.Status
 			var err *model.AppError
 			if status, err = a.GetStatus(id); err != nil {
@@ -578,6 +643,12 @@ func (a *App) SendNotifications(c request.CTX, post *model.Post, team *model.Tea
 				continue
 			}
 
+			if reason, ok := silenced[id]; ok {
+				a.CountNotificationReason(model.NotificationStatusNotSent, model.NotificationTypePush, reason)
+				a.RecordNotificationDelivery(id, post.Id, post.ChannelId, model.NotificationTypePush, model.NotificationStatusNotSent, reason, "")
+				continue
+			}
+
 			if _, ok := mentions.Mentions[id]; !ok {
 				var status *model.Status
 				var err *model.AppError
@@ -628,6 +699,7 @@ func (a *App) SendNotifications(c request.CTX, post *model.Post, team *model.Tea
 				)
 			} else {
 				a.CountNotificationReason(model.NotificationStatusNotSent, model.NotificationTypePush, statusReason)
+				a.RecordNotificationDelivery(id, post.Id, post.ChannelId, model.NotificationTypePush, model.NotificationStatusNotSent, statusReason, "")
 				a.NotificationsLog().Debug("Notification not sent - status",
 					mlog.String("type", model.NotificationTypePush),
 					mlog.String("post_id", post.Id),
@@ -1153,6 +1225,63 @@ func (a *App) sendNoUsersNotifiedByGroupInChannel(c request.CTX, sender *model.U
 	a.SendEphemeralPost(c, post.UserId, ephemeralPost)
 }
 
+// checkGroupMentionRestrictions returns the id of the message explaining why a mention of the group by
+// the sender doesn't notify its members, or an empty string if it does. A mention that passes the
+// checks starts the cooldown of the group.
+func (a *App) checkGroupMentionRestrictions(sender *model.User, group *model.Group) (string, *model.AppError) {
+	switch group.MentionPolicy {
+	case model.GroupMentionPolicyAdmins:
+		if !sender.IsSystemAdmin() {
+			return "api.post.group_mention_restricted.admins", nil
+		}
+	case model.GroupMentionPolicyMembers:
+		if _, err := a.Srv().Store().Group().GetMember(group.Id, sender.Id); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return "api.post.group_mention_restricted.members", nil
+			}
+			return "", model.NewAppError("checkGroupMentionRestrictions", "app.select_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	if group.MentionMemberLimit > 0 {
+		count, err := a.Srv().Store().Group().GetMemberCount(group.Id)
+		if err != nil {
+			return "", model.NewAppError("checkGroupMentionRestrictions", "app.select_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+		if count > int64(group.MentionMemberLimit) {
+			return "api.post.group_mention_restricted.member_limit", nil
+		}
+	}
+
+	if group.MentionCooldownSeconds > 0 {
+		cooldown := int64(group.MentionCooldownSeconds) * 1000
+		recorded, err := a.Srv().Store().Group().UpdateLastMentionAt(group.Id, model.GetMillis(), cooldown)
+		if err != nil {
+			return "", model.NewAppError("checkGroupMentionRestrictions", "app.update_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+		if !recorded {
+			return "api.post.group_mention_restricted.cooldown", nil
+		}
+	}
+
+	return "", nil
+}
+
+func (a *App) sendGroupMentionRestricted(c request.CTX, sender *model.User, post *model.Post, channel *model.Channel, group *model.Group, messageID string) {
+	T := i18n.GetUserTranslations(sender.Locale)
+	ephemeralPost := &model.Post{
+		UserId:    sender.Id,
+		RootId:    post.RootId,
+		ChannelId: channel.Id,
+		Message: T(messageID, model.StringInterface{
+			"GroupName": group.Name,
+			"Limit":     group.MentionMemberLimit,
+			"Seconds":   group.MentionCooldownSeconds,
+		}),
+	}
+	a.SendEphemeralPost(c, post.UserId, ephemeralPost)
+}
+
 // sendOutOfChannelMentions sends an ephemeral post to the sender of a post if any of the given potential mentions
 // are outside of the post's channel. Returns whether or not an ephemeral post was sent.
 func (a *App) sendOutOfChannelMentions(c request.CTX, sender *model.User, post *model.Post, channel *model.Channel, potentialMentions []string) (bool, error) {
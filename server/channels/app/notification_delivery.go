@@ -0,0 +1,64 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+)
+
+func (a *App) notificationDeliveryHistoryEnabled() bool {
+	return *a.Config().NotificationLogSettings.EnableDeliveryHistory
+}
+
+// RecordNotificationDelivery records the outcome of sending a notification of a post to a user,
+// if the delivery history is enabled. The delivery is saved in the background so that it
+// doesn't slow down the notifications.
+func (a *App) RecordNotificationDelivery(userID, postID, channelID string, notificationType model.NotificationType, status model.NotificationStatus, reason model.NotificationReason, detail string) {
+	if !a.notificationDeliveryHistoryEnabled() {
+		return
+	}
+
+	delivery := &model.NotificationDelivery{
+		UserId:    userID,
+		PostId:    postID,
+		ChannelId: channelID,
+		Type:      notificationType,
+		Status:    status,
+		Reason:    reason,
+		Detail:    detail,
+	}
+
+	a.Srv().Go(func() {
+		if _, err := a.Srv().Store().NotificationDelivery().Save(delivery); err != nil {
+			a.NotificationsLog().Warn("Failed to record notification delivery",
+				mlog.String("type", notificationType),
+				mlog.String("user_id", userID),
+				mlog.String("post_id", postID),
+				mlog.Err(err),
+			)
+		}
+	})
+}
+
+// GetNotificationDeliveries returns the recorded outcomes of the notifications sent to the
+// user, most recent first.
+func (a *App) GetNotificationDeliveries(userID string, opts model.NotificationDeliveryGetOptions) ([]*model.NotificationDelivery, *model.AppError) {
+	if !a.notificationDeliveryHistoryEnabled() {
+		return nil, model.NewAppError("GetNotificationDeliveries", "app.notification_delivery.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	if opts.PerPage <= 0 || opts.PerPage > model.NotificationDeliveryHistoryMaxPage {
+		opts.PerPage = model.NotificationDeliveryHistoryMaxPage
+	}
+
+	deliveries, err := a.Srv().Store().NotificationDelivery().GetForUser(userID, opts)
+	if err != nil {
+		return nil, model.NewAppError("GetNotificationDeliveries", "app.notification_delivery.get_for_user.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return deliveries, nil
+}
@@ -48,6 +48,11 @@ func (a *App) sendNotificationEmail(c request.CTX, notification *PostNotificatio
 		}
 	}
 
+	// the post will be included in the next digest of the user instead
+	if a.userHasEmailDigest(user.Id) {
+		return nil
+	}
+
 	if *a.Config().EmailSettings.EnableEmailBatching {
 		var sendBatched bool
 		if data, err := a.Srv().Store().Preference().Get(user.Id, model.PreferenceCategoryNotifications, model.PreferenceNameEmailInterval); err != nil {
@@ -128,8 +133,10 @@ func (a *App) sendNotificationEmail(c request.CTX, notification *PostNotificatio
 		references = referencesVal
 	}
 
+	replyToAddress := a.getReplyByEmailAddress(user.Id, post.Id)
+
 	a.Srv().Go(func() {
-		if nErr := a.Srv().EmailService.SendMailWithEmbeddedFiles(user.Email, html.UnescapeString(subjectText), bodyText, embeddedFiles, messageID, inReplyTo, references, "Notification"); nErr != nil {
+		if nErr := a.Srv().EmailService.SendMailWithEmbeddedFilesAndCustomReplyTo(user.Email, html.UnescapeString(subjectText), bodyText, replyToAddress, embeddedFiles, messageID, inReplyTo, references, "Notification"); nErr != nil {
 			c.Logger().Error("Error while sending the email", mlog.String("user_email", user.Email), mlog.Err(nErr))
 		}
 	})
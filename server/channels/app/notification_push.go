@@ -112,22 +112,12 @@ func (a *App) sendPushNotificationToAllSessions(rctx request.CTX, msg *model.Pus
 			mlog.String("rejection_reason", rejectionReason),
 			mlog.String("user_id", userID),
 		)
+		if msg.Type == model.PushTypeMessage {
+			a.RecordNotificationDelivery(userID, msg.PostId, msg.ChannelId, model.NotificationTypePush, model.NotificationStatusNotSent, model.NotificationReasonRejectedByPlugin, rejectionReason)
+		}
 		return nil
 	}
 
-	sessions, appErr := a.getMobileAppSessions(userID)
-	if appErr != nil {
-		a.CountNotificationReason(model.NotificationStatusError, model.NotificationTypePush, model.NotificationReasonFetchError)
-		a.NotificationsLog().Error("Failed to send mobile app sessions",
-			mlog.String("type", model.NotificationTypePush),
-			mlog.String("status", model.NotificationStatusError),
-			mlog.String("reason", model.NotificationReasonFetchError),
-			mlog.String("user_id", userID),
-			mlog.Err(appErr),
-		)
-		return appErr
-	}
-
 	if msg == nil {
 		a.CountNotificationReason(model.NotificationStatusError, model.NotificationTypePush, model.NotificationReasonParseError)
 		a.NotificationsLog().Error("Failed to parse push notification",
@@ -145,6 +135,36 @@ func (a *App) sendPushNotificationToAllSessions(rctx request.CTX, msg *model.Pus
 		)
 	}
 
+	// Browsers subscribed to Web Push only show the notifications of new messages.
+	if msg.Type == model.PushTypeMessage && a.canSendWebPushNotifications() {
+		if appErr := a.sendWebPushNotifications(rctx, msg, userID, skipSessionId); appErr != nil {
+			a.NotificationsLog().Error("Failed to send web push notifications",
+				mlog.String("type", model.NotificationTypePush),
+				mlog.String("status", model.NotificationStatusError),
+				mlog.String("user_id", userID),
+				mlog.Err(appErr),
+			)
+		}
+
+		// Message notifications are also sent when only Web Push is enabled.
+		if !a.canSendPushNotifications() {
+			return nil
+		}
+	}
+
+	sessions, appErr := a.getMobileAppSessions(userID)
+	if appErr != nil {
+		a.CountNotificationReason(model.NotificationStatusError, model.NotificationTypePush, model.NotificationReasonFetchError)
+		a.NotificationsLog().Error("Failed to send mobile app sessions",
+			mlog.String("type", model.NotificationTypePush),
+			mlog.String("status", model.NotificationStatusError),
+			mlog.String("reason", model.NotificationReasonFetchError),
+			mlog.String("user_id", userID),
+			mlog.Err(appErr),
+		)
+		return appErr
+	}
+
 	for _, session := range sessions {
 		// Don't send notifications to this session if it's expired or we want to skip it
 		if session.IsExpired() || (skipSessionId != "" && skipSessionId == session.Id) {
@@ -195,6 +215,9 @@ func (a *App) sendPushNotificationToAllSessions(rctx request.CTX, msg *model.Pus
 				mlog.String("device_id", tmpMessage.DeviceId),
 				mlog.Err(err),
 			)
+			if msg.Type == model.PushTypeMessage {
+				a.RecordNotificationDelivery(session.UserId, msg.PostId, msg.ChannelId, model.NotificationTypePush, model.NotificationStatusError, model.NotificationReasonPushProxySendError, err.Error())
+			}
 			continue
 		}
 
@@ -213,6 +236,7 @@ func (a *App) sendPushNotificationToAllSessions(rctx request.CTX, msg *model.Pus
 
 		if msg.Type == model.PushTypeMessage {
 			a.CountNotification(model.NotificationTypePush)
+			a.RecordNotificationDelivery(session.UserId, msg.PostId, msg.ChannelId, model.NotificationTypePush, model.NotificationStatusSuccess, "", "")
 		}
 	}
 
@@ -587,6 +611,7 @@ func (a *App) ShouldSendPushNotification(user *model.User, channelNotifyProps mo
 			mlog.String("sender_id", post.UserId),
 			mlog.String("receiver_id", user.Id),
 		)
+		a.RecordNotificationDelivery(user.Id, post.Id, post.ChannelId, model.NotificationTypePush, model.NotificationStatusNotSent, notifyPropsAllowedReason, "")
 		return false
 	}
 
@@ -601,6 +626,7 @@ func (a *App) ShouldSendPushNotification(user *model.User, channelNotifyProps mo
 			mlog.String("receiver_id", user.Id),
 			mlog.String("receiver_status", status.Status),
 		)
+		a.RecordNotificationDelivery(user.Id, post.Id, post.ChannelId, model.NotificationTypePush, model.NotificationStatusNotSent, statusAllowedReason, "")
 		return false
 	}
 
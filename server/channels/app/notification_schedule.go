@@ -0,0 +1,78 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"time"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+)
+
+// silencedNotificationReason returns why the channel notify props of the user silence the
+// notifications of the post, either its quiet hours or its muted keywords, or an empty
+// reason if they don't. Explicit mentions of the user are never silenced.
+func silencedNotificationReason(user *model.User, channelMemberNotifyProps model.StringMap, post *model.Post, mentionType MentionType, now time.Time) model.NotificationReason {
+	if mentionType >= DMMention {
+		return ""
+	}
+
+	if model.MessageContainsMutedKeyword(post.Message, model.ChannelMutedKeywordsFromNotifyProps(channelMemberNotifyProps)) {
+		return model.NotificationReasonMutedKeyword
+	}
+
+	if quietHours := model.ChannelQuietHoursFromNotifyProps(channelMemberNotifyProps); quietHours != nil && quietHours.IsActive(now, user.GetTimezoneLocation()) {
+		return model.NotificationReasonQuietHours
+	}
+
+	return ""
+}
+
+// getSilencedUsers returns the users of the channel whose notifications for the post are
+// silenced by their channel notify props, along with the reason.
+func (a *App) getSilencedUsers(post *model.Post, profileMap map[string]*model.User, channelMemberNotifyPropsMap map[string]model.StringMap, mentions *MentionResults) map[string]model.NotificationReason {
+	silenced := make(map[string]model.NotificationReason)
+	now := time.Now()
+	for id, props := range channelMemberNotifyPropsMap {
+		if props[model.QuietHoursNotifyProp] == "" && props[model.MutedKeywordsNotifyProp] == "" {
+			continue
+		}
+		profile := profileMap[id]
+		if profile == nil {
+			continue
+		}
+
+		if reason := silencedNotificationReason(profile, props, post, mentions.Mentions[id], now); reason != "" {
+			silenced[id] = reason
+			a.NotificationsLog().Debug("Notifications silenced by channel notify props",
+				mlog.String("type", model.NotificationTypeAll),
+				mlog.String("post_id", post.Id),
+				mlog.String("status", model.NotificationStatusNotSent),
+				mlog.String("reason", reason),
+				mlog.String("sender_id", post.UserId),
+				mlog.String("receiver_id", id),
+			)
+		}
+	}
+	return silenced
+}
+
+// removeSilenced removes the silenced users from the thread followers to notify.
+func (c *CRTNotifiers) removeSilenced(silenced map[string]model.NotificationReason) {
+	if len(silenced) == 0 {
+		return
+	}
+	filter := func(ids model.StringArray) model.StringArray {
+		filtered := ids[:0]
+		for _, id := range ids {
+			if _, ok := silenced[id]; !ok {
+				filtered = append(filtered, id)
+			}
+		}
+		return filtered
+	}
+	c.Desktop = filter(c.Desktop)
+	c.Email = filter(c.Email)
+	c.Push = filter(c.Push)
+}
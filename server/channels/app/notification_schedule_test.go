@@ -0,0 +1,67 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+func TestSilencedNotificationReason(t *testing.T) {
+	user := &model.User{Id: model.NewId(), Timezone: model.StringMap{
+		"useAutomaticTimezone": "false",
+		"manualTimezone":       "UTC",
+	}}
+	post := &model.Post{UserId: model.NewId(), Message: "[RESOLVED] disk usage on db-1"}
+	night := time.Date(2024, 5, 6, 23, 0, 0, 0, time.UTC)
+	day := time.Date(2024, 5, 6, 12, 0, 0, 0, time.UTC)
+
+	quietHours := model.StringMap{model.QuietHoursNotifyProp: `{"enabled":true,"start":"22:00","end":"07:00"}`}
+	mutedKeywords := model.StringMap{model.MutedKeywordsNotifyProp: "resolved"}
+
+	t.Run("no schedule", func(t *testing.T) {
+		assert.Empty(t, silencedNotificationReason(user, model.GetDefaultChannelNotifyProps(), post, NoMention, night))
+	})
+
+	t.Run("quiet hours", func(t *testing.T) {
+		assert.Equal(t, model.NotificationReasonQuietHours, silencedNotificationReason(user, quietHours, post, NoMention, night))
+		assert.Equal(t, model.NotificationReasonQuietHours, silencedNotificationReason(user, quietHours, post, ChannelMention, night))
+		assert.Equal(t, model.NotificationReasonQuietHours, silencedNotificationReason(user, quietHours, post, ThreadMention, night))
+		assert.Empty(t, silencedNotificationReason(user, quietHours, post, NoMention, day))
+	})
+
+	t.Run("muted keywords", func(t *testing.T) {
+		assert.Equal(t, model.NotificationReasonMutedKeyword, silencedNotificationReason(user, mutedKeywords, post, NoMention, day))
+		assert.Empty(t, silencedNotificationReason(user, mutedKeywords, &model.Post{Message: "disk usage on db-1"}, NoMention, day))
+	})
+
+	t.Run("explicit mentions are never silenced", func(t *testing.T) {
+		props := model.StringMap{
+			model.QuietHoursNotifyProp:    quietHours[model.QuietHoursNotifyProp],
+			model.MutedKeywordsNotifyProp: mutedKeywords[model.MutedKeywordsNotifyProp],
+		}
+		assert.Empty(t, silencedNotificationReason(user, props, post, KeywordMention, night))
+		assert.Empty(t, silencedNotificationReason(user, props, post, DMMention, night))
+		assert.Empty(t, silencedNotificationReason(user, props, post, GroupMention, night))
+	})
+}
+
+func TestCRTNotifiersRemoveSilenced(t *testing.T) {
+	silencedID, otherID := model.NewId(), model.NewId()
+	notifiers := &CRTNotifiers{
+		Desktop: model.StringArray{silencedID, otherID},
+		Email:   model.StringArray{silencedID},
+		Push:    model.StringArray{otherID, silencedID},
+	}
+
+	notifiers.removeSilenced(map[string]model.NotificationReason{silencedID: model.NotificationReasonQuietHours})
+
+	assert.Equal(t, model.StringArray{otherID}, notifiers.Desktop)
+	assert.Empty(t, notifiers.Email)
+	assert.Equal(t, model.StringArray{otherID}, notifiers.Push)
+}
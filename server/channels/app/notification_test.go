@@ -2509,6 +2509,78 @@ func TestUserAllowsEmail(t *testing.T) {
 	})
 }
 
+func TestCheckGroupMentionRestrictions(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	member := th.CreateUser()
+	group := th.CreateGroup()
+	_, appErr := th.App.UpsertGroupMember(group.Id, member.Id)
+	require.Nil(t, appErr)
+
+	t.Run("no restrictions", func(t *testing.T) {
+		restriction, appErr := th.App.checkGroupMentionRestrictions(th.BasicUser, group)
+		require.Nil(t, appErr)
+		assert.Empty(t, restriction)
+	})
+
+	t.Run("members policy", func(t *testing.T) {
+		group.MentionPolicy = model.GroupMentionPolicyMembers
+		defer func() { group.MentionPolicy = "" }()
+
+		restriction, appErr := th.App.checkGroupMentionRestrictions(th.BasicUser, group)
+		require.Nil(t, appErr)
+		assert.Equal(t, "api.post.group_mention_restricted.members", restriction)
+
+		restriction, appErr = th.App.checkGroupMentionRestrictions(member, group)
+		require.Nil(t, appErr)
+		assert.Empty(t, restriction)
+	})
+
+	t.Run("admins policy", func(t *testing.T) {
+		group.MentionPolicy = model.GroupMentionPolicyAdmins
+		defer func() { group.MentionPolicy = "" }()
+
+		restriction, appErr := th.App.checkGroupMentionRestrictions(member, group)
+		require.Nil(t, appErr)
+		assert.Equal(t, "api.post.group_mention_restricted.admins", restriction)
+
+		restriction, appErr = th.App.checkGroupMentionRestrictions(th.SystemAdminUser, group)
+		require.Nil(t, appErr)
+		assert.Empty(t, restriction)
+	})
+
+	t.Run("member limit", func(t *testing.T) {
+		_, appErr := th.App.UpsertGroupMember(group.Id, th.BasicUser2.Id)
+		require.Nil(t, appErr)
+
+		group.MentionMemberLimit = 1
+		defer func() { group.MentionMemberLimit = 0 }()
+
+		restriction, appErr := th.App.checkGroupMentionRestrictions(th.BasicUser, group)
+		require.Nil(t, appErr)
+		assert.Equal(t, "api.post.group_mention_restricted.member_limit", restriction)
+
+		group.MentionMemberLimit = 2
+		restriction, appErr = th.App.checkGroupMentionRestrictions(th.BasicUser, group)
+		require.Nil(t, appErr)
+		assert.Empty(t, restriction)
+	})
+
+	t.Run("cooldown", func(t *testing.T) {
+		group.MentionCooldownSeconds = 60
+		defer func() { group.MentionCooldownSeconds = 0 }()
+
+		restriction, appErr := th.App.checkGroupMentionRestrictions(th.BasicUser, group)
+		require.Nil(t, appErr)
+		assert.Empty(t, restriction)
+
+		restriction, appErr = th.App.checkGroupMentionRestrictions(th.BasicUser2, group)
+		require.Nil(t, appErr)
+		assert.Equal(t, "api.post.group_mention_restricted.cooldown", restriction)
+	})
+}
+
 func TestInsertGroupMentions(t *testing.T) {
 	th := Setup(t).InitBasic()
 	defer th.TearDown()
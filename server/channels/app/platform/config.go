@@ -156,6 +156,10 @@ func (ps *PlatformService) ConfigureLogger(name string, logger *mlog.Logger, log
 		return fmt.Errorf("invalid config source for %s, %w", name, err)
 	}
 
+	if logger == ps.logger {
+		addLogLevelOverrides(cfg, ps.logLevelOverrideModules())
+	}
+
 	// this will remove any existing targets and replace with those defined in cfg.
 	if err := logger.ConfigureTargets(cfg, nil); err != nil {
 		return fmt.Errorf("invalid config for %s, %w", name, err)
@@ -206,7 +210,9 @@ func (ps *PlatformService) regenerateClientConfig() {
 			mlog.Err(err)
 		} else {
 			clientConfig["CustomTermsOfServiceId"] = termsOfService.Id
+			clientConfig["CustomTermsOfServiceAudience"] = termsOfService.Audience
 			limitedClientConfig["CustomTermsOfServiceId"] = termsOfService.Id
+			limitedClientConfig["CustomTermsOfServiceAudience"] = termsOfService.Audience
 		}
 	}
 
@@ -82,6 +82,7 @@ func TestConfigSave(t *testing.T) {
 		metricsMock.On("IncrementWebsocketEvent", model.WebsocketEventConfigChanged).Return()
 		metricsMock.On("IncrementWebSocketBroadcastBufferSize", mock.AnythingOfType("string"), mock.AnythingOfType("float64")).Return()
 		metricsMock.On("DecrementWebSocketBroadcastBufferSize", mock.AnythingOfType("string"), mock.AnythingOfType("float64")).Return()
+		metricsMock.On("ObserveWebSocketBroadcastDuration", model.WebsocketEventConfigChanged, mock.AnythingOfType("float64")).Return()
 		metricsMock.On("Register").Return()
 		th.Service.metricsIFace = metricsMock
 
@@ -0,0 +1,154 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package platform
+
+import (
+	"errors"
+	"time"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/v8/channels/jobs"
+)
+
+const drainInterval = time.Second
+
+// IsDraining returns whether the server is being drained, in which case it doesn't accept new
+// websocket connections.
+func (ps *PlatformService) IsDraining() bool {
+	return ps.draining.Load()
+}
+
+// DrainStatus returns the progress of the drain, or nil if the server isn't being drained.
+func (ps *PlatformService) DrainStatus() *model.DrainStatus {
+	ps.drainMut.Lock()
+	defer ps.drainMut.Unlock()
+
+	if ps.drainStatus == nil {
+		return nil
+	}
+
+	status := *ps.drainStatus
+	if !status.IsComplete() {
+		status.WebSocketConnections = ps.TotalWebsocketConnections()
+	}
+	return &status
+}
+
+// StartDrain prepares the server to be stopped. It stops accepting new websocket connections,
+// asks the connected clients to reconnect to another server while closing their connections
+// gradually over the timeout, and stops the job workers once their in-flight jobs are done.
+// It returns false if the server was already being drained.
+func (ps *PlatformService) StartDrain(timeout time.Duration) (*model.DrainStatus, bool) {
+	ps.drainMut.Lock()
+	if ps.drainStatus != nil {
+		ps.drainMut.Unlock()
+		return ps.DrainStatus(), false
+	}
+
+	now := time.Now()
+	deadline := now.Add(timeout)
+	connections := ps.TotalWebsocketConnections()
+	ps.drainStatus = &model.DrainStatus{
+		Draining:                    true,
+		StartAt:                     model.GetMillisForTime(now),
+		Deadline:                    model.GetMillisForTime(deadline),
+		InitialWebSocketConnections: connections,
+		WebSocketConnections:        connections,
+	}
+	ps.draining.Store(true)
+	ps.drainMut.Unlock()
+
+	ps.logger.Info("Draining the server", mlog.Int("websocket_connections", connections), mlog.Duration("timeout", timeout))
+
+	message := model.NewWebSocketEvent(model.WebsocketEventServerDraining, "", "", "", nil, "")
+	message.Add("deadline", model.GetMillisForTime(deadline))
+	ps.PublishSkipClusterSend(message)
+
+	jobsStopped := make(chan struct{})
+	go func() {
+		ps.stopJobsForDrain()
+		ps.drainMut.Lock()
+		ps.drainStatus.JobsStopped = true
+		ps.drainMut.Unlock()
+		close(jobsStopped)
+	}()
+
+	go ps.runDrain(deadline, jobsStopped)
+
+	return ps.DrainStatus(), true
+}
+
+func (ps *PlatformService) stopJobsForDrain() {
+	if ps.Jobs == nil {
+		return
+	}
+
+	if err := ps.Jobs.StopSchedulers(); err != nil && !errors.Is(err, jobs.ErrSchedulersNotRunning) {
+		ps.logger.Warn("Failed to stop job server schedulers while draining", mlog.Err(err))
+	}
+	// This returns once the workers are done with the jobs they are running.
+	if err := ps.Jobs.StopWorkers(); err != nil && !errors.Is(err, jobs.ErrWorkersNotRunning) {
+		ps.logger.Warn("Failed to stop job server workers while draining", mlog.Err(err))
+	}
+}
+
+// runDrain closes the websocket connections in batches spread until the deadline, so that the
+// clients don't all reconnect to the other servers at the same time.
+func (ps *PlatformService) runDrain(deadline time.Time, jobsStopped <-chan struct{}) {
+	ticker := time.NewTicker(drainInterval)
+	defer ticker.Stop()
+
+	var jobsDone bool
+	for {
+		select {
+		case <-jobsStopped:
+			jobsDone = true
+			jobsStopped = nil
+		case <-ticker.C:
+		case <-ps.drainStop:
+			return
+		}
+
+		remaining := ps.TotalWebsocketConnections()
+		left := time.Until(deadline)
+		if left <= 0 || (remaining == 0 && jobsDone) {
+			ps.closeWebConns(-1)
+			ps.completeDrain()
+			return
+		}
+
+		batches := int(left / drainInterval)
+		if batches < 1 {
+			batches = 1
+		}
+		ps.closeWebConns((remaining + batches - 1) / batches)
+	}
+}
+
+func (ps *PlatformService) closeWebConns(count int) {
+	for _, hub := range ps.hubs {
+		if count == 0 {
+			return
+		}
+
+		closed := hub.CloseConns(count)
+		if count > 0 {
+			count -= closed
+		}
+	}
+}
+
+func (ps *PlatformService) completeDrain() {
+	ps.drainMut.Lock()
+	defer ps.drainMut.Unlock()
+
+	ps.drainStatus.CompleteAt = model.GetMillis()
+	ps.drainStatus.WebSocketConnections = ps.TotalWebsocketConnections()
+
+	ps.logger.Info("The server is drained",
+		mlog.Int("websocket_connections", ps.drainStatus.WebSocketConnections),
+		mlog.Bool("jobs_stopped", ps.drainStatus.JobsStopped),
+	)
+}
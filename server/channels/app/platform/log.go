@@ -10,6 +10,7 @@ import (
 	"io"
 	"net/http"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/mattermost/mattermost/server/public/model"
@@ -174,9 +175,12 @@ func (ps *PlatformService) GetLogsSkipSend(page, perPage int, logFilter *model.L
 					err = json.Unmarshal(line, &entry)
 					if err != nil {
 						mlog.Debug("Failed to parse line, skipping")
+						// The fields of the line are unknown, so it can't match them.
+						filtered = logFilter.Caller != "" || logFilter.UserId != "" || logFilter.RequestId != ""
 					} else {
 						filtered = isLogFilteredByLevel(logFilter, entry) || filtered
 						filtered = isLogFilteredByDate(logFilter, entry) || filtered
+						filtered = isLogFilteredByFields(logFilter, entry) || filtered
 					}
 
 					if filtered {
@@ -226,16 +230,16 @@ func isLogFilteredByDate(logFilter *model.LogFilter, entry *model.LogEntry) bool
 		return false
 	}
 
-	dateFrom, err := time.Parse("2006-01-02 15:04:05.999 -07:00", logFilter.DateFrom)
+	dateFrom, err := time.Parse(model.LogFilterDateFormat, logFilter.DateFrom)
 	if err != nil {
 		dateFrom = time.Time{}
 	}
-	dateTo, err := time.Parse("2006-01-02 15:04:05.999 -07:00", logFilter.DateTo)
+	dateTo, err := time.Parse(model.LogFilterDateFormat, logFilter.DateTo)
 	if err != nil {
 		dateTo = time.Now()
 	}
 
-	timestamp, err := time.Parse("2006-01-02 15:04:05.999 -07:00", entry.Timestamp)
+	timestamp, err := time.Parse(model.LogFilterDateFormat, entry.Timestamp)
 	if err != nil {
 		mlog.Debug("Cannot parse timestamp, skipping")
 		return false
@@ -250,3 +254,17 @@ func isLogFilteredByDate(logFilter *model.LogFilter, entry *model.LogEntry) bool
 
 	return true
 }
+
+func isLogFilteredByFields(logFilter *model.LogFilter, entry *model.LogEntry) bool {
+	if logFilter.Caller != "" && !strings.Contains(entry.Caller, logFilter.Caller) {
+		return true
+	}
+	if logFilter.UserId != "" && entry.UserId != logFilter.UserId {
+		return true
+	}
+	if logFilter.RequestId != "" && entry.RequestId != logFilter.RequestId {
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,208 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package platform
+
+import (
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+)
+
+type moduleLogLevel struct {
+	std      mlog.Level
+	discrete mlog.Level
+}
+
+type logModule struct {
+	// multiLevel is whether the module logs its records at both the standard and the discrete
+	// levels, in which case a discrete level isn't enabled on the targets already enabling the
+	// standard level, so that the records aren't logged twice.
+	multiLevel bool
+	levels     []moduleLogLevel
+}
+
+// logModules maps the modules to the discrete levels they log with, along with the standard
+// level each of them corresponds to.
+var logModules = map[string]logModule{
+	model.LogModuleLDAP: {
+		multiLevel: true,
+		levels: []moduleLogLevel{
+			{mlog.LvlError, mlog.LvlLDAPError},
+			{mlog.LvlWarn, mlog.LvlLDAPWarn},
+			{mlog.LvlInfo, mlog.LvlLDAPInfo},
+			{mlog.LvlDebug, mlog.LvlLDAPDebug},
+			{mlog.LvlTrace, mlog.LvlLDAPTrace},
+		},
+	},
+	model.LogModuleRemoteCluster: {
+		levels: []moduleLogLevel{
+			{mlog.LvlError, mlog.LvlRemoteClusterServiceError},
+			{mlog.LvlWarn, mlog.LvlRemoteClusterServiceWarn},
+			{mlog.LvlDebug, mlog.LvlRemoteClusterServiceDebug},
+		},
+	},
+	model.LogModuleSharedChannels: {
+		levels: []moduleLogLevel{
+			{mlog.LvlError, mlog.LvlSharedChannelServiceError},
+			{mlog.LvlWarn, mlog.LvlSharedChannelServiceWarn},
+			{mlog.LvlDebug, mlog.LvlSharedChannelServiceDebug},
+			{mlog.LvlTrace, mlog.LvlSharedChannelServiceMessagesInbound},
+			{mlog.LvlTrace, mlog.LvlSharedChannelServiceMessagesOutbound},
+		},
+	},
+}
+
+type logLevelOverride struct {
+	override model.LogLevelOverride
+	timer    *time.Timer
+}
+
+// SetLogLevelOverride raises the level of the logs of a module on this server until the override
+// expires, replacing any previous override of the module. The logger is reconfigured right away.
+func (ps *PlatformService) SetLogLevelOverride(override model.LogLevelOverride) (*model.LogLevelOverride, error) {
+	duration := time.Duration(override.DurationMinutes) * time.Minute
+	override.ExpireAt = model.GetMillisForTime(time.Now().Add(duration))
+
+	ps.logLevelOverridesMut.Lock()
+	if previous, ok := ps.logLevelOverrides[override.Module]; ok {
+		previous.timer.Stop()
+	}
+	expireAt := override.ExpireAt
+	ps.logLevelOverrides[override.Module] = &logLevelOverride{
+		override: override,
+		timer: time.AfterFunc(duration, func() {
+			ps.expireLogLevelOverride(override.Module, expireAt)
+		}),
+	}
+	ps.logLevelOverridesMut.Unlock()
+
+	ps.logger.Info("Log level override set", mlog.String("module", override.Module), mlog.String("level", override.Level), mlog.Int("duration_minutes", override.DurationMinutes))
+
+	return &override, ps.ReconfigureLogger()
+}
+
+// RemoveLogLevelOverride removes the override of the level of the logs of a module, if any.
+func (ps *PlatformService) RemoveLogLevelOverride(module string) error {
+	ps.logLevelOverridesMut.Lock()
+	previous, ok := ps.logLevelOverrides[module]
+	if ok {
+		previous.timer.Stop()
+		delete(ps.logLevelOverrides, module)
+	}
+	ps.logLevelOverridesMut.Unlock()
+
+	if !ok {
+		return nil
+	}
+
+	ps.logger.Info("Log level override removed", mlog.String("module", module))
+	return ps.ReconfigureLogger()
+}
+
+// LogLevelOverrides returns the active overrides of the level of the logs of the modules.
+func (ps *PlatformService) LogLevelOverrides() []*model.LogLevelOverride {
+	ps.logLevelOverridesMut.Lock()
+	defer ps.logLevelOverridesMut.Unlock()
+
+	overrides := make([]*model.LogLevelOverride, 0, len(ps.logLevelOverrides))
+	for _, o := range ps.logLevelOverrides {
+		override := o.override
+		overrides = append(overrides, &override)
+	}
+
+	sort.Slice(overrides, func(i, j int) bool {
+		return overrides[i].Module < overrides[j].Module
+	})
+
+	return overrides
+}
+
+func (ps *PlatformService) expireLogLevelOverride(module string, expireAt int64) {
+	ps.logLevelOverridesMut.Lock()
+	o, ok := ps.logLevelOverrides[module]
+	// The override may have been replaced since the timer was started.
+	ok = ok && o.override.ExpireAt == expireAt
+	if ok {
+		delete(ps.logLevelOverrides, module)
+	}
+	ps.logLevelOverridesMut.Unlock()
+
+	if !ok {
+		return
+	}
+
+	ps.logger.Info("Log level override expired", mlog.String("module", module))
+	if err := ps.ReconfigureLogger(); err != nil {
+		ps.logger.Error("Failed to reconfigure the logger after a log level override expired", mlog.Err(err))
+	}
+}
+
+func (ps *PlatformService) stopLogLevelOverrides() {
+	ps.logLevelOverridesMut.Lock()
+	defer ps.logLevelOverridesMut.Unlock()
+
+	for _, o := range ps.logLevelOverrides {
+		o.timer.Stop()
+	}
+}
+
+// logLevelOverrideModules returns the levels of the modules enabled by the log level overrides.
+func (ps *PlatformService) logLevelOverrideModules() []logModule {
+	ps.logLevelOverridesMut.Lock()
+	defer ps.logLevelOverridesMut.Unlock()
+
+	var modules []logModule
+	for module, o := range ps.logLevelOverrides {
+		maxLevel := stdLevelFromName(o.override.Level)
+		enabled := logModule{multiLevel: logModules[module].multiLevel}
+		for _, l := range logModules[module].levels {
+			if l.std.ID <= maxLevel.ID {
+				enabled.levels = append(enabled.levels, l)
+			}
+		}
+		modules = append(modules, enabled)
+	}
+	return modules
+}
+
+// addLogLevelOverrides enables the levels of the overrides on the targets of the configuration
+// receiving the standard logs, leaving aside the targets dedicated to discrete levels.
+func addLogLevelOverrides(cfg mlog.LoggerConfiguration, modules []logModule) {
+	if len(modules) == 0 {
+		return
+	}
+
+	for name, target := range cfg {
+		hasLevel := func(level mlog.Level) bool {
+			return slices.ContainsFunc(target.Levels, func(l mlog.Level) bool { return l.ID == level.ID })
+		}
+		if !hasLevel(mlog.LvlError) {
+			continue
+		}
+
+		// The levels may be shared with the configuration source.
+		target.Levels = slices.Clone(target.Levels)
+		for _, module := range modules {
+			for _, l := range module.levels {
+				if hasLevel(l.discrete) || (module.multiLevel && hasLevel(l.std)) {
+					continue
+				}
+				target.Levels = append(target.Levels, l.discrete)
+			}
+		}
+		cfg[name] = target
+	}
+}
+
+func stdLevelFromName(name string) mlog.Level {
+	for _, l := range mlog.StdAll {
+		if l.Name == name {
+			return l
+		}
+	}
+	return mlog.LvlError
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package platform
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+)
+
+func TestAddLogLevelOverrides(t *testing.T) {
+	newConfig := func() mlog.LoggerConfiguration {
+		return mlog.LoggerConfiguration{
+			"console": {Type: "console", Levels: []mlog.Level{mlog.LvlPanic, mlog.LvlFatal, mlog.LvlError, mlog.LvlWarn, mlog.LvlInfo}},
+			"audit":   {Type: "file", Levels: []mlog.Level{mlog.LvlAuditAPI}},
+		}
+	}
+
+	ps := &PlatformService{logLevelOverrides: map[string]*logLevelOverride{}}
+	ps.logLevelOverrides[model.LogModuleLDAP] = &logLevelOverride{override: model.LogLevelOverride{Module: model.LogModuleLDAP, Level: "debug"}}
+	ps.logLevelOverrides[model.LogModuleSharedChannels] = &logLevelOverride{override: model.LogLevelOverride{Module: model.LogModuleSharedChannels, Level: "warn"}}
+
+	cfg := newConfig()
+	addLogLevelOverrides(cfg, ps.logLevelOverrideModules())
+
+	t.Run("the levels of the modules are added to the standard targets", func(t *testing.T) {
+		levels := cfg["console"].Levels
+		assert.Contains(t, levels, mlog.LvlLDAPDebug)
+		assert.Contains(t, levels, mlog.LvlSharedChannelServiceError)
+		assert.Contains(t, levels, mlog.LvlSharedChannelServiceWarn)
+		assert.NotContains(t, levels, mlog.LvlLDAPTrace)
+		assert.NotContains(t, levels, mlog.LvlSharedChannelServiceDebug)
+	})
+
+	t.Run("the levels already logged at their standard level aren't added", func(t *testing.T) {
+		levels := cfg["console"].Levels
+		assert.NotContains(t, levels, mlog.LvlLDAPError)
+		assert.NotContains(t, levels, mlog.LvlLDAPInfo)
+	})
+
+	t.Run("the targets of discrete levels are left unchanged", func(t *testing.T) {
+		assert.Equal(t, newConfig()["audit"], cfg["audit"])
+	})
+}
+
+func TestLogLevelOverrides(t *testing.T) {
+	th := Setup(t)
+	defer th.TearDown()
+
+	override, err := th.Service.SetLogLevelOverride(model.LogLevelOverride{Module: model.LogModuleLDAP, Level: "debug", DurationMinutes: 10})
+	require.NoError(t, err)
+	assert.Greater(t, override.ExpireAt, model.GetMillis())
+
+	overrides := th.Service.LogLevelOverrides()
+	require.Len(t, overrides, 1)
+	assert.Equal(t, model.LogModuleLDAP, overrides[0].Module)
+
+	t.Run("the override expires", func(t *testing.T) {
+		th.Service.expireLogLevelOverride(model.LogModuleLDAP, override.ExpireAt-1)
+		require.Len(t, th.Service.LogLevelOverrides(), 1, "a replaced override must not be expired")
+
+		th.Service.expireLogLevelOverride(model.LogModuleLDAP, override.ExpireAt)
+		assert.Empty(t, th.Service.LogLevelOverrides())
+	})
+
+	t.Run("the override is removed", func(t *testing.T) {
+		_, err := th.Service.SetLogLevelOverride(model.LogLevelOverride{Module: model.LogModuleRemoteCluster, Level: "debug", DurationMinutes: 10})
+		require.NoError(t, err)
+
+		require.NoError(t, th.Service.RemoveLogLevelOverride(model.LogModuleRemoteCluster))
+		assert.Empty(t, th.Service.LogLevelOverrides())
+	})
+}
@@ -0,0 +1,41 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package platform
+
+import (
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+func maintenanceModeFromConfig(cfg *model.Config) model.MaintenanceMode {
+	return model.MaintenanceMode{
+		Enabled:           *cfg.ServiceSettings.EnableMaintenanceMode,
+		Message:           *cfg.ServiceSettings.MaintenanceModeMessage,
+		RetryAfterSeconds: *cfg.ServiceSettings.MaintenanceModeRetryAfterSeconds,
+	}
+}
+
+// MaintenanceMode returns the state of the maintenance mode, during which the write requests to
+// the API are rejected.
+func (ps *PlatformService) MaintenanceMode() *model.MaintenanceMode {
+	mode := maintenanceModeFromConfig(ps.Config())
+	return &mode
+}
+
+// publishMaintenanceModeChanged notifies the clients connected to this server when the
+// maintenance mode changes. Every server of the cluster reacts to the config change on its own,
+// so the event isn't sent through the cluster.
+func (ps *PlatformService) publishMaintenanceModeChanged(oldCfg, newCfg *model.Config) {
+	mode := maintenanceModeFromConfig(newCfg)
+	if mode == maintenanceModeFromConfig(oldCfg) {
+		return
+	}
+
+	message := model.NewWebSocketEvent(model.WebsocketEventMaintenanceModeChanged, "", "", "", nil, "")
+	message.Add("enabled", mode.Enabled)
+	message.Add("message", mode.Message)
+	message.Add("retry_after_seconds", mode.RetryAfterSeconds)
+	ps.Go(func() {
+		ps.PublishSkipClusterSend(message)
+	})
+}
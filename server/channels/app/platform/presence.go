@@ -0,0 +1,59 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package platform
+
+import (
+	"net/http"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+// subscribePresence replaces the users whose status changes are sent to the connection, and
+// responds with their current statuses so that the client only has to apply the deltas after.
+func (ps *PlatformService) subscribePresence(conn *WebConn, r *model.WebSocketRequest) {
+	userIDs := model.RemoveDuplicateStringsNonSort(model.ArrayFromInterface(r.Data["user_ids"]))
+	if len(userIDs) > model.PresenceSubscriptionMaxUsers {
+		err := model.NewAppError("subscribePresence", "api.web_socket_router.presence_subscribe.too_many_users.app_error", map[string]any{"Max": model.PresenceSubscriptionMaxUsers}, "", http.StatusBadRequest)
+		returnWebSocketError(ps, conn, r, err)
+		return
+	}
+
+	for _, userID := range userIDs {
+		if !model.IsValidId(userID) {
+			err := model.NewAppError("subscribePresence", "api.websocket_handler.invalid_param.app_error", map[string]any{"Name": "user_ids"}, "", http.StatusBadRequest)
+			returnWebSocketError(ps, conn, r, err)
+			return
+		}
+	}
+
+	hub := ps.GetHubForUserId(conn.UserId)
+	if hub == nil {
+		return
+	}
+	hub.SubscribePresence(conn, userIDs)
+
+	statuses := []*model.Status{}
+	if len(userIDs) > 0 {
+		var appErr *model.AppError
+		statuses, appErr = ps.GetUserStatusesByIds(userIDs)
+		if appErr != nil {
+			returnWebSocketError(ps, conn, r, appErr)
+			return
+		}
+	}
+
+	hub.SendMessage(conn, model.NewWebSocketResponse(model.StatusOk, r.Seq, map[string]any{"statuses": statuses}))
+}
+
+// publishPresence sends the status change of a user to the connections subscribed to its presence,
+// on all the nodes of the cluster.
+func (ps *PlatformService) publishPresence(status *model.Status) {
+	event := model.NewWebSocketEvent(model.WebsocketEventPresenceChanged, "", "", "", nil, "")
+	event = event.SetBroadcast(&model.WebsocketBroadcast{PresenceUserId: status.UserId})
+	event.Add("user_id", status.UserId)
+	event.Add("status", status.Status)
+	event.Add("manual", status.Manual)
+	event.Add("last_activity_at", status.LastActivityAt)
+	ps.Publish(event)
+}
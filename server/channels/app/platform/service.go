@@ -4,6 +4,7 @@
 package platform
 
 import (
+	"context"
 	"crypto/ecdsa"
 	"fmt"
 	"hash/maphash"
@@ -28,6 +29,7 @@ import (
 	"github.com/mattermost/mattermost/server/v8/platform/services/cache"
 	"github.com/mattermost/mattermost/server/v8/platform/services/searchengine"
 	"github.com/mattermost/mattermost/server/v8/platform/services/searchengine/bleveengine"
+	"github.com/mattermost/mattermost/server/v8/platform/services/slowops"
 	"github.com/mattermost/mattermost/server/v8/platform/shared/filestore"
 )
 
@@ -84,6 +86,16 @@ type PlatformService struct {
 	clusterIFace           einterfaces.ClusterInterface
 	Busy                   *Busy
 
+	draining    atomic.Bool
+	drainMut    sync.Mutex
+	drainStatus *model.DrainStatus
+	drainStop   chan struct{}
+
+	logLevelOverridesMut sync.Mutex
+	logLevelOverrides    map[string]*logLevelOverride
+
+	slowOperations *slowops.Recorder
+
 	SearchEngine            *searchengine.Broker
 	searchConfigListenerId  string
 	searchLicenseListenerId string
@@ -121,6 +133,8 @@ func New(sc ServiceConfig, options ...Option) (*PlatformService, error) {
 		hashSeed:            maphash.MakeSeed(),
 		goroutineExitSignal: make(chan struct{}, 1),
 		goroutineBuffered:   make(chan struct{}, runtime.NumCPU()),
+		drainStop:           make(chan struct{}),
+		logLevelOverrides:   make(map[string]*logLevelOverride),
 		WebSocketRouter: &WebSocketRouter{
 			handlers: make(map[string]webSocketHandler),
 		},
@@ -192,6 +206,12 @@ func New(sc ServiceConfig, options ...Option) (*PlatformService, error) {
 		ps.metricsIFace = metricsInterfaceFn(ps, *ps.configStore.Get().SqlSettings.DriverName, *ps.configStore.Get().SqlSettings.DataSource)
 	}
 
+	ps.slowOperations = slowops.New(ps.metricsIFace)
+	ps.slowOperations.Configure(ps.Config().SlowOperationSettings)
+	ps.AddConfigListener(func(oldCfg, newCfg *model.Config) {
+		ps.slowOperations.Configure(newCfg.SlowOperationSettings)
+	})
+
 	// Step 6: Store.
 	// Depends on Step 0 (config), 1 (cacheProvider), 3 (search engine), 5 (metrics) and cluster.
 	if ps.newStore == nil {
@@ -211,6 +231,7 @@ func New(sc ServiceConfig, options ...Option) (*PlatformService, error) {
 			if err != nil {
 				return nil, err
 			}
+			ps.sqlStore.SetSlowOperationRecorder(ps.slowOperations)
 
 			searchStore := searchlayer.NewSearchLayer(
 				retrylayer.New(ps.sqlStore),
@@ -349,16 +370,25 @@ func New(sc ServiceConfig, options ...Option) (*PlatformService, error) {
 func (ps *PlatformService) Start(broadcastHooks map[string]BroadcastHook) error {
 	ps.hubStart(broadcastHooks)
 
-	ps.configListenerId = ps.AddConfigListener(func(_, _ *model.Config) {
+	ps.configListenerId = ps.AddConfigListener(func(oldCfg, newCfg *model.Config) {
 		ps.regenerateClientConfig()
 
 		message := model.NewWebSocketEvent(model.WebsocketEventConfigChanged, "", "", "", nil, "")
 
-		message.Add("config", ps.ClientConfigWithComputed())
+		clientConfig := ps.ClientConfigWithComputed()
+		// Targeted feature flags are evaluated for each user when fetching the client config, so
+		// their server-wide values must not be broadcast.
+		for name := range ps.Config().FeatureFlagSettings.Rules {
+			delete(clientConfig, "FeatureFlag"+name)
+		}
+
+		message.Add("config", clientConfig)
 		ps.Go(func() {
 			ps.Publish(message)
 		})
 
+		ps.publishMaintenanceModeChanged(oldCfg, newCfg)
+
 		if err := ps.ReconfigureLogger(); err != nil {
 			mlog.Error("Error re-configuring logging after config change", mlog.Err(err))
 			return
@@ -437,6 +467,8 @@ func (ps *PlatformService) TotalWebsocketConnections() int {
 }
 
 func (ps *PlatformService) Shutdown() error {
+	close(ps.drainStop)
+	ps.stopLogLevelOverrides()
 	ps.HubStop()
 
 	ps.RemoveLicenseListener(ps.licenseListenerId)
@@ -468,11 +500,24 @@ func (ps *PlatformService) StatusCache() cache.Cache {
 	return ps.statusCache
 }
 
+// SlowOperations returns the recorder capturing the slowest queries and requests of this server.
+func (ps *PlatformService) SlowOperations() *slowops.Recorder {
+	return ps.slowOperations
+}
+
 // SetSqlStore is used for plugin testing
 func (ps *PlatformService) SetSqlStore(s *sqlstore.SqlStore) {
 	ps.sqlStore = s
 }
 
+// DatabaseHealth pings the master database and each of the replicas.
+func (ps *PlatformService) DatabaseHealth(ctx context.Context) []*model.DependencyHealth {
+	if ps.sqlStore == nil {
+		return nil
+	}
+	return ps.sqlStore.DatabaseHealth(ctx)
+}
+
 func (ps *PlatformService) SetSharedChannelService(s SharedChannelServiceIFace) {
 	ps.shareChannelServiceMux.Lock()
 	defer ps.shareChannelServiceMux.Unlock()
@@ -164,6 +164,8 @@ func (ps *PlatformService) BroadcastStatus(status *model.Status) {
 	event.Add("status", status.Status)
 	event.Add("user_id", status.UserId)
 	ps.Publish(event)
+
+	ps.publishPresence(status)
 }
 
 func (ps *PlatformService) SaveAndBroadcastStatus(status *model.Status) {
@@ -368,6 +370,32 @@ func (ps *PlatformService) SetStatusDoNotDisturbTimed(userId string, endtime int
 	ps.SaveAndBroadcastStatus(status)
 }
 
+// SetStatusTimed takes endtime in unix epoch format in UTC and sets the status of given userId to
+// dnd or ooo, which will be restored back after endtime. Setting a timed status while another one is
+// running keeps the status to restore, so that back to back meetings end on the original status.
+func (ps *PlatformService) SetStatusTimed(userID string, statusValue string, endtime int64) {
+	if !*ps.Config().ServiceSettings.EnableUserStatuses {
+		return
+	}
+
+	status, err := ps.GetStatus(userID)
+
+	if err != nil {
+		status = &model.Status{UserId: userID, Status: model.StatusOffline, Manual: false, LastActivityAt: 0, ActiveChannel: ""}
+	}
+
+	isTimed := status.DNDEndTime > 0 && (status.Status == model.StatusDnd || status.Status == model.StatusOutOfOffice)
+	if !isTimed {
+		status.PrevStatus = status.Status
+	}
+	status.Status = statusValue
+	status.Manual = true
+
+	status.DNDEndTime = endtime
+
+	ps.SaveAndBroadcastStatus(status)
+}
+
 func (ps *PlatformService) SetStatusDoNotDisturb(userID string) {
 	if !*ps.Config().ServiceSettings.EnableUserStatuses {
 		return
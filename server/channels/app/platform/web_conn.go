@@ -72,6 +72,7 @@ type WebConnConfig struct {
 
 	// These aren't necessary to be exported to api layer.
 	sequence         int
+	presenceUserIds  []string
 	activeQueue      chan model.WebSocketMessage
 	deadQueue        []*model.WebSocketEvent
 	deadQueuePointer int
@@ -121,6 +122,15 @@ type WebConn struct {
 	// The client type behind the connection (i.e. web, desktop or mobile)
 	originClient string
 
+	// botScopeChannels caches whether the channels of the events are in the scope of the bot
+	// authenticated by the connection, if its session is scoped.
+	botScopeChannels         map[string]bool
+	lastBotScopeChannelsTime int64
+
+	// presenceUserIds are the users whose presence the connection is subscribed to.
+	// They are only accessed by the hub of the connection.
+	presenceUserIds []string
+
 	activeChannelID                 atomic.Value
 	activeTeamID                    atomic.Value
 	activeRHSThreadChannelID        atomic.Value
@@ -147,6 +157,7 @@ type CheckConnResult struct {
 	DeadQueue        []*model.WebSocketEvent
 	DeadQueuePointer int
 	ReuseCount       int
+	PresenceUserIds  []string
 }
 
 // PopulateWebConnConfig checks if the connection id already exists in the hub,
@@ -170,6 +181,7 @@ func (ps *PlatformService) PopulateWebConnConfig(s *model.Session, cfg *WebConnC
 		cfg.deadQueuePointer = res.DeadQueuePointer
 		cfg.Active = false
 		cfg.ReuseCount = res.ReuseCount
+		cfg.presenceUserIds = res.PresenceUserIds
 		// Now we get the sequence number
 		if seqVal == "" {
 			// Sequence_number must be sent with connection id.
@@ -238,6 +250,7 @@ func (ps *PlatformService) NewWebConn(cfg *WebConnConfig, suite SuiteIFace, runn
 		Locale:             cfg.Locale,
 		PostedAck:          cfg.PostedAck,
 		reuseCount:         cfg.ReuseCount,
+		presenceUserIds:    cfg.presenceUserIds,
 		endWritePump:       make(chan struct{}),
 		pumpFinished:       make(chan struct{}),
 		pluginPosted:       make(chan pluginWSPostedHook, 10),
@@ -721,6 +734,8 @@ func (wc *WebConn) drainDeadQueue(index int) error {
 func (wc *WebConn) InvalidateCache() {
 	wc.allChannelMembers = nil
 	wc.lastAllChannelMembersTime = 0
+	wc.botScopeChannels = nil
+	wc.lastBotScopeChannelsTime = 0
 	wc.SetSession(nil)
 	wc.SetSessionExpiresAt(0)
 }
@@ -864,6 +879,12 @@ func (wc *WebConn) ShouldSendEvent(msg *model.WebSocketEvent) bool {
 		return false
 	}
 
+	// A scoped bot doesn't receive the events of teams and channels out of its scope, even those
+	// it is a member of.
+	if !wc.botScopeAllowsEvent(msg) {
+		return false
+	}
+
 	// If the event is destined to a specific user
 	if msg.GetBroadcast().UserId != "" {
 		return wc.UserId == msg.GetBroadcast().UserId
@@ -922,6 +943,46 @@ func (wc *WebConn) ShouldSendEvent(msg *model.WebSocketEvent) bool {
 	return true
 }
 
+// botScopeAllowsEvent returns whether the team and channel of the event are in the scope of the
+// bot authenticated by the connection, always allowing connections without a scope.
+func (wc *WebConn) botScopeAllowsEvent(msg *model.WebSocketEvent) bool {
+	scope := wc.GetSession().GetBotScope()
+	if scope == nil {
+		return true
+	}
+
+	if chID := msg.GetBroadcast().ChannelId; chID != "" {
+		if model.GetMillis()-wc.lastBotScopeChannelsTime > webConnMemberCacheTime {
+			wc.botScopeChannels = nil
+			wc.lastBotScopeChannelsTime = 0
+		}
+
+		if allowed, ok := wc.botScopeChannels[chID]; ok {
+			return allowed
+		}
+
+		channel, err := wc.Platform.Store.Channel().Get(chID, true)
+		if err != nil {
+			mlog.Error("webhub.shouldSendEvent.", mlog.Err(err))
+			return false
+		}
+
+		if wc.botScopeChannels == nil {
+			wc.botScopeChannels = map[string]bool{}
+			wc.lastBotScopeChannelsTime = model.GetMillis()
+		}
+		allowed := scope.AllowsChannel(channel, wc.UserId)
+		wc.botScopeChannels[chID] = allowed
+		return allowed
+	}
+
+	if teamID := msg.GetBroadcast().TeamId; teamID != "" {
+		return scope.AllowsTeam(teamID)
+	}
+
+	return true
+}
+
 func (wc *WebConn) notInChannel(val string) bool {
 	return (wc.isSet(wc.GetActiveChannelID()) && val != wc.GetActiveChannelID())
 }
@@ -55,6 +55,21 @@ type webConnCountMessage struct {
 	result chan int
 }
 
+type webConnBroadcastMessage struct {
+	event    *model.WebSocketEvent
+	queuedAt time.Time
+}
+
+type webConnPresenceMessage struct {
+	conn    *WebConn
+	userIDs []string
+}
+
+type webConnCloseMessage struct {
+	count  int
+	result chan int
+}
+
 // Hub is the central place to manage all websocket connections in the server.
 // It handles different websocket events and sending messages to individual
 // user connections.
@@ -66,7 +81,7 @@ type Hub struct {
 	connectionIndex int
 	register        chan *WebConn
 	unregister      chan *WebConn
-	broadcast       chan *model.WebSocketEvent
+	broadcast       chan *webConnBroadcastMessage
 	stop            chan struct{}
 	didStop         chan struct{}
 	invalidateUser  chan string
@@ -76,6 +91,8 @@ type Hub struct {
 	checkRegistered chan *webConnSessionMessage
 	checkConn       chan *webConnCheckMessage
 	connCount       chan *webConnCountMessage
+	closeConns      chan *webConnCloseMessage
+	presence        chan *webConnPresenceMessage
 	broadcastHooks  map[string]BroadcastHook
 }
 
@@ -85,7 +102,7 @@ func newWebHub(ps *PlatformService) *Hub {
 		platform:        ps,
 		register:        make(chan *WebConn),
 		unregister:      make(chan *WebConn),
-		broadcast:       make(chan *model.WebSocketEvent, broadcastQueueSize),
+		broadcast:       make(chan *webConnBroadcastMessage, broadcastQueueSize),
 		stop:            make(chan struct{}),
 		didStop:         make(chan struct{}),
 		invalidateUser:  make(chan string),
@@ -94,6 +111,8 @@ func newWebHub(ps *PlatformService) *Hub {
 		checkRegistered: make(chan *webConnSessionMessage),
 		checkConn:       make(chan *webConnCheckMessage),
 		connCount:       make(chan *webConnCountMessage),
+		closeConns:      make(chan *webConnCloseMessage),
+		presence:        make(chan *webConnPresenceMessage),
 	}
 }
 
@@ -311,6 +330,32 @@ func (h *Hub) WebConnCountForUser(userID string) int {
 	return 0
 }
 
+// CloseConns closes up to count active connections of the hub, or all of them when count is
+// negative, and returns the number of closed connections. The clients are expected to reconnect.
+func (h *Hub) CloseConns(count int) int {
+	req := &webConnCloseMessage{
+		count:  count,
+		result: make(chan int),
+	}
+	select {
+	case h.closeConns <- req:
+		return <-req.result
+	case <-h.stop:
+	}
+	return 0
+}
+
+// SubscribePresence replaces the users whose status changes are sent to the connection.
+func (h *Hub) SubscribePresence(conn *WebConn, userIDs []string) {
+	select {
+	case h.presence <- &webConnPresenceMessage{
+		conn:    conn,
+		userIDs: userIDs,
+	}:
+	case <-h.stop:
+	}
+}
+
 // Broadcast broadcasts the message to all connections in the hub.
 func (h *Hub) Broadcast(message *model.WebSocketEvent) {
 	// XXX: The hub nil check is because of the way we setup our tests. We call
@@ -325,7 +370,7 @@ func (h *Hub) Broadcast(message *model.WebSocketEvent) {
 			metrics.IncrementWebSocketBroadcastBufferSize(strconv.Itoa(h.connectionIndex), 1)
 		}
 		select {
-		case h.broadcast <- message:
+		case h.broadcast <- &webConnBroadcastMessage{event: message, queuedAt: time.Now()}:
 		case <-h.stop:
 		}
 	}
@@ -408,11 +453,29 @@ func (h *Hub) Start() {
 						DeadQueue:        conn.deadQueue,
 						DeadQueuePointer: conn.deadQueuePointer,
 						ReuseCount:       conn.reuseCount + 1,
+						PresenceUserIds:  conn.presenceUserIds,
 					}
 				}
 				req.result <- res
 			case req := <-h.connCount:
 				req.result <- connIndex.ForUserActiveCount(req.userID)
+			case req := <-h.closeConns:
+				var closed int
+				for webConn := range connIndex.All() {
+					if req.count >= 0 && closed >= req.count {
+						break
+					}
+					if !webConn.active.Load() {
+						continue
+					}
+					// Closing the socket makes the pumps unregister the connection. Waiting
+					// for them here would block the hub, so it is marked inactive right away.
+					webConn.active.Store(false)
+					webConn.WebSocket.Close()
+					closed++
+				}
+				atomic.StoreInt64(&h.connectionCount, int64(connIndex.AllActive()))
+				req.result <- closed
 			case <-ticker.C:
 				connIndex.RemoveInactiveConnections()
 			case webConn := <-h.register:
@@ -485,6 +548,10 @@ func (h *Hub) Start() {
 						h.platform.SetStatusLastActivityAt(userID, latestActivity)
 					})
 				}
+			case req := <-h.presence:
+				if connIndex.Has(req.conn) {
+					connIndex.SetPresenceUserIds(req.conn, req.userIDs)
+				}
 			case userID := <-h.invalidateUser:
 				for _, webConn := range connIndex.ForUser(userID) {
 					webConn.InvalidateCache()
@@ -514,13 +581,13 @@ func (h *Hub) Start() {
 					close(directMsg.conn.send)
 					connIndex.Remove(directMsg.conn)
 				}
-			case msg := <-h.broadcast:
+			case queuedMsg := <-h.broadcast:
 				if metrics := h.platform.metricsIFace; metrics != nil {
 					metrics.DecrementWebSocketBroadcastBufferSize(strconv.Itoa(h.connectionIndex), 1)
 				}
 
 				// Remove the broadcast hook information before precomputing the JSON so that those aren't included in it
-				msg, broadcastHooks, broadcastHookArgs := msg.WithoutBroadcastHooks()
+				msg, broadcastHooks, broadcastHookArgs := queuedMsg.event.WithoutBroadcastHooks()
 
 				msg = msg.PrecomputeJSON()
 
@@ -544,22 +611,32 @@ func (h *Hub) Start() {
 					}
 				}
 
-				if connID := msg.GetBroadcast().ConnectionId; connID != "" {
-					if webConn := connIndex.ForConnection(connID); webConn != nil {
-						broadcast(webConn)
-						continue
-					}
-				} else if msg.GetBroadcast().UserId != "" {
+				var connTarget *WebConn
+				connID := msg.GetBroadcast().ConnectionId
+				if connID != "" {
+					connTarget = connIndex.ForConnection(connID)
+				}
+
+				if connTarget != nil {
+					broadcast(connTarget)
+				} else if connID == "" && msg.GetBroadcast().UserId != "" {
 					candidates := connIndex.ForUser(msg.GetBroadcast().UserId)
 					for _, webConn := range candidates {
 						broadcast(webConn)
 					}
-					continue
+				} else if connID == "" && msg.GetBroadcast().PresenceUserId != "" {
+					for webConn := range connIndex.ForPresenceUser(msg.GetBroadcast().PresenceUserId) {
+						broadcast(webConn)
+					}
+				} else {
+					candidates := connIndex.All()
+					for webConn := range candidates {
+						broadcast(webConn)
+					}
 				}
 
-				candidates := connIndex.All()
-				for webConn := range candidates {
-					broadcast(webConn)
+				if metrics := h.platform.metricsIFace; metrics != nil {
+					metrics.ObserveWebSocketBroadcastDuration(msg.EventType(), time.Since(queuedMsg.queuedAt).Seconds())
 				}
 			case <-h.stop:
 				for webConn := range connIndex.All() {
@@ -620,6 +697,8 @@ type hubConnectionIndex struct {
 	// in the value of byUserId map, and also to get all connections.
 	byConnection   map[*WebConn]int
 	byConnectionId map[string]*WebConn
+	// byPresenceUserId stores the connections subscribed to the presence of a given userID
+	byPresenceUserId map[string]map[*WebConn]struct{}
 	// staleThreshold is the limit beyond which inactive connections
 	// will be deleted.
 	staleThreshold time.Duration
@@ -627,10 +706,11 @@ type hubConnectionIndex struct {
 
 func newHubConnectionIndex(interval time.Duration) *hubConnectionIndex {
 	return &hubConnectionIndex{
-		byUserId:       make(map[string][]*WebConn),
-		byConnection:   make(map[*WebConn]int),
-		byConnectionId: make(map[string]*WebConn),
-		staleThreshold: interval,
+		byUserId:         make(map[string][]*WebConn),
+		byConnection:     make(map[*WebConn]int),
+		byConnectionId:   make(map[string]*WebConn),
+		byPresenceUserId: make(map[string]map[*WebConn]struct{}),
+		staleThreshold:   interval,
 	}
 }
 
@@ -638,6 +718,7 @@ func (i *hubConnectionIndex) Add(wc *WebConn) {
 	i.byUserId[wc.UserId] = append(i.byUserId[wc.UserId], wc)
 	i.byConnection[wc] = len(i.byUserId[wc.UserId]) - 1
 	i.byConnectionId[wc.GetConnectionID()] = wc
+	i.addPresence(wc)
 }
 
 func (i *hubConnectionIndex) Remove(wc *WebConn) {
@@ -663,6 +744,42 @@ func (i *hubConnectionIndex) Remove(wc *WebConn) {
 
 	delete(i.byConnection, wc)
 	delete(i.byConnectionId, wc.GetConnectionID())
+	// The subscriptions of the connection are kept, so that they're carried over
+	// when it's reused.
+	i.removePresence(wc)
+}
+
+// SetPresenceUserIds replaces the users whose presence the connection is subscribed to.
+func (i *hubConnectionIndex) SetPresenceUserIds(wc *WebConn, userIDs []string) {
+	i.removePresence(wc)
+	wc.presenceUserIds = userIDs
+	i.addPresence(wc)
+}
+
+func (i *hubConnectionIndex) addPresence(wc *WebConn) {
+	for _, userID := range wc.presenceUserIds {
+		conns, ok := i.byPresenceUserId[userID]
+		if !ok {
+			conns = make(map[*WebConn]struct{})
+			i.byPresenceUserId[userID] = conns
+		}
+		conns[wc] = struct{}{}
+	}
+}
+
+func (i *hubConnectionIndex) removePresence(wc *WebConn) {
+	for _, userID := range wc.presenceUserIds {
+		conns := i.byPresenceUserId[userID]
+		delete(conns, wc)
+		if len(conns) == 0 {
+			delete(i.byPresenceUserId, userID)
+		}
+	}
+}
+
+// ForPresenceUser returns the connections subscribed to the presence of a user ID.
+func (i *hubConnectionIndex) ForPresenceUser(id string) map[*WebConn]struct{} {
+	return i.byPresenceUserId[id]
 }
 
 func (i *hubConnectionIndex) Has(wc *WebConn) bool {
@@ -478,6 +478,58 @@ func TestHubConnIndexInactive(t *testing.T) {
 	assert.Len(t, connIndex.All(), 2)
 }
 
+func TestHubConnIndexPresence(t *testing.T) {
+	th := Setup(t)
+	defer th.TearDown()
+
+	connIndex := newHubConnectionIndex(1 * time.Second)
+
+	watchedUserID := model.NewId()
+	otherUserID := model.NewId()
+
+	wc1 := &WebConn{
+		Platform: th.Service,
+		UserId:   model.NewId(),
+	}
+	wc1.SetConnectionID(model.NewId())
+	wc1.SetSession(&model.Session{})
+
+	wc2 := &WebConn{
+		Platform: th.Service,
+		UserId:   model.NewId(),
+	}
+	wc2.SetConnectionID(model.NewId())
+	wc2.SetSession(&model.Session{})
+
+	connIndex.Add(wc1)
+	connIndex.Add(wc2)
+
+	connIndex.SetPresenceUserIds(wc1, []string{watchedUserID, otherUserID})
+	connIndex.SetPresenceUserIds(wc2, []string{watchedUserID})
+	assert.Len(t, connIndex.ForPresenceUser(watchedUserID), 2)
+	assert.Len(t, connIndex.ForPresenceUser(otherUserID), 1)
+
+	t.Run("replacing the subscriptions", func(t *testing.T) {
+		connIndex.SetPresenceUserIds(wc1, []string{otherUserID})
+		assert.Equal(t, map[*WebConn]struct{}{wc2: {}}, connIndex.ForPresenceUser(watchedUserID))
+		assert.Equal(t, map[*WebConn]struct{}{wc1: {}}, connIndex.ForPresenceUser(otherUserID))
+	})
+
+	t.Run("removing the connection", func(t *testing.T) {
+		connIndex.Remove(wc2)
+		assert.Empty(t, connIndex.ForPresenceUser(watchedUserID))
+
+		// The subscriptions are restored when the connection is reused
+		connIndex.Add(wc2)
+		assert.Equal(t, map[*WebConn]struct{}{wc2: {}}, connIndex.ForPresenceUser(watchedUserID))
+	})
+
+	t.Run("unsubscribing", func(t *testing.T) {
+		connIndex.SetPresenceUserIds(wc1, nil)
+		assert.Empty(t, connIndex.ForPresenceUser(otherUserID))
+	})
+}
+
 func TestReliableWebSocketSend(t *testing.T) {
 	testCluster := &testlib.FakeClusterInterface{}
 
@@ -379,6 +379,10 @@ func (api *PluginAPI) SetUserStatusTimedDND(userID string, endTime int64) (*mode
 	return api.app.GetStatus(userID)
 }
 
+func (api *PluginAPI) SetUserTimedStatuses(statuses []*model.TimedStatus) *model.AppError {
+	return api.app.SetTimedStatuses(api.ctx, statuses)
+}
+
 func (api *PluginAPI) UpdateUserCustomStatus(userID string, customStatus *model.CustomStatus) *model.AppError {
 	return api.app.SetCustomStatus(api.ctx, userID, customStatus)
 }
@@ -12,11 +12,14 @@ import (
 	"strings"
 
 	"github.com/gorilla/mux"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/ext"
 
 	"github.com/mattermost/mattermost/server/public/model"
 	"github.com/mattermost/mattermost/server/public/plugin"
 	"github.com/mattermost/mattermost/server/public/shared/mlog"
 	"github.com/mattermost/mattermost/server/v8/channels/utils"
+	"github.com/mattermost/mattermost/server/v8/platform/services/tracing"
 )
 
 func (ch *Channels) ServePluginRequest(w http.ResponseWriter, r *http.Request) {
@@ -226,5 +229,19 @@ func (ch *Channels) servePluginRequest(w http.ResponseWriter, r *http.Request, h
 	r.URL.RawQuery = newQuery.Encode()
 	r.URL.Path = strings.TrimPrefix(r.URL.Path, path.Join(subpath, "plugins", params["plugin_id"]))
 
+	if tracing.IsEnabled(ch.cfgSvc.Config()) {
+		span, ctx := tracing.StartSpanFromRequest(r, "plugin:ServeHTTP")
+		ext.HTTPMethod.Set(span, r.Method)
+		ext.HTTPUrl.Set(span, r.URL.Path)
+		span.SetTag("plugin_id", params["plugin_id"])
+		span.SetTag("request_id", context.RequestId)
+		defer span.Finish()
+
+		// The plugin continues the trace from the headers of the request or from the context.
+		_ = opentracing.GlobalTracer().Inject(span.Context(), opentracing.HTTPHeaders, opentracing.HTTPHeadersCarrier(r.Header))
+		context.TraceContext = tracing.TraceContext(ctx)
+		r = r.WithContext(opentracing.ContextWithSpan(r.Context(), span))
+	}
+
 	handler(context, w, r)
 }
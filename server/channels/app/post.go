@@ -347,6 +347,17 @@ func (a *App) CreatePost(c request.CTX, post *model.Post, channel *model.Channel
 		}, plugin.MessageHasBeenPostedID)
 	})
 
+	if !rpost.IsSystemMessage() && rpost.GetProp(model.PostPropsFromAutomation) == nil {
+		a.triggerAutomationRules(c, &model.AutomationEvent{
+			Type:      model.AutomationTriggerKeywordPosted,
+			TeamId:    channel.TeamId,
+			ChannelId: rpost.ChannelId,
+			UserId:    rpost.UserId,
+			PostId:    rpost.Id,
+			Message:   rpost.Message,
+		})
+	}
+
 	// Normally, we would let the API layer call PreparePostForClient, but we do it here since it also needs
 	// to be done when we send the post over the websocket in handlePostEvents
 	// PS: we don't want to include PostPriority from the db to avoid the replica lag,
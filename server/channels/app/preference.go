@@ -21,6 +21,10 @@ func (a *App) GetPreferencesForUser(c request.CTX, userID string) (model.Prefere
 	return preferences, nil
 }
 
+func (a *App) GetPreferencesEtag(userID string) string {
+	return a.Srv().Store().Preference().GetEtag(userID)
+}
+
 func (a *App) GetPreferenceByCategoryForUser(c request.CTX, userID string, category string) (model.Preferences, *model.AppError) {
 	preferences, err := a.Srv().Store().Preference().GetCategory(userID, category)
 	if err != nil {
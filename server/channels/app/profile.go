@@ -0,0 +1,182 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"bytes"
+	"net/http"
+	"os"
+	"path"
+	"regexp"
+	"runtime/pprof"
+	"runtime/trace"
+	"sort"
+	"time"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/platform/shared/filestore"
+)
+
+const profilesDirectory = "profiles"
+
+var (
+	profileNameRegexp    = regexp.MustCompile(`^[A-Za-z0-9_\-\.]+$`)
+	profileHostnameChars = regexp.MustCompile(`[^A-Za-z0-9\-]+`)
+)
+
+// CaptureProfile captures a runtime profile of this server and stores it in the file backend.
+// The CPU profiles and the execution traces are captured over the requested duration, and only
+// one of each can be captured at a time.
+func (a *App) CaptureProfile(rctx request.CTX, profileRequest *model.ProfileRequest) (*model.Profile, *model.AppError) {
+	profileRequest.SetDefaults()
+	if appErr := profileRequest.IsValid(); appErr != nil {
+		return nil, appErr
+	}
+
+	duration := time.Duration(profileRequest.DurationSeconds) * time.Second
+
+	var b bytes.Buffer
+	var err error
+	switch profileRequest.Type {
+	case model.ProfileTypeCPU:
+		if err = pprof.StartCPUProfile(&b); err != nil {
+			return nil, model.NewAppError("CaptureProfile", "app.profile.capture.in_progress.app_error", nil, "", http.StatusConflict).Wrap(err)
+		}
+		waitForProfile(rctx, duration)
+		pprof.StopCPUProfile()
+	case model.ProfileTypeTrace:
+		if err = trace.Start(&b); err != nil {
+			return nil, model.NewAppError("CaptureProfile", "app.profile.capture.in_progress.app_error", nil, "", http.StatusConflict).Wrap(err)
+		}
+		waitForProfile(rctx, duration)
+		trace.Stop()
+	case model.ProfileTypeHeap:
+		err = pprof.Lookup("heap").WriteTo(&b, 0)
+	case model.ProfileTypeGoroutine:
+		err = pprof.Lookup("goroutine").WriteTo(&b, 0)
+	}
+	if err != nil {
+		return nil, model.NewAppError("CaptureProfile", "app.profile.capture.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	now := time.Now()
+	profile := &model.Profile{
+		Name:     profileFileName(profileRequest.Type, now),
+		Type:     profileRequest.Type,
+		Size:     int64(b.Len()),
+		CreateAt: model.GetMillisForTime(now),
+	}
+
+	if _, appErr := a.WriteFile(&b, path.Join(profilesDirectory, profile.Name)); appErr != nil {
+		return nil, appErr
+	}
+
+	rctx.Logger().Info("Captured a runtime profile", mlog.String("name", profile.Name), mlog.Int("size", profile.Size))
+	return profile, nil
+}
+
+// waitForProfile waits for the duration of the profile, or until the request is canceled, in
+// which case the profile captured so far is kept.
+func waitForProfile(rctx request.CTX, duration time.Duration) {
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+	case <-rctx.Context().Done():
+	}
+}
+
+// profileFileName names the profiles after their type, the server capturing them and the time,
+// so that the profiles of the servers of a cluster can be told apart.
+func profileFileName(profileType string, now time.Time) string {
+	hostname, err := os.Hostname()
+	if err != nil || hostname == "" {
+		hostname = "unknown"
+	}
+	hostname = profileHostnameChars.ReplaceAllString(hostname, "-")
+
+	ext := ".pprof"
+	if profileType == model.ProfileTypeTrace {
+		ext = ".out"
+	}
+
+	return profileType + "_" + hostname + "_" + now.UTC().Format("20060102T150405.000Z") + ext
+}
+
+// ListProfiles returns the profiles stored in the file backend, most recent first.
+func (a *App) ListProfiles() ([]*model.Profile, *model.AppError) {
+	paths, appErr := a.ListDirectory(profilesDirectory)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	profiles := make([]*model.Profile, 0, len(paths))
+	for _, p := range paths {
+		name := path.Base(p)
+		if !profileNameRegexp.MatchString(name) {
+			continue
+		}
+
+		size, appErr := a.FileSize(p)
+		if appErr != nil {
+			return nil, appErr
+		}
+
+		modTime, appErr := a.FileModTime(p)
+		if appErr != nil {
+			return nil, appErr
+		}
+
+		profiles = append(profiles, &model.Profile{
+			Name:     name,
+			Type:     model.ProfileTypeFromName(name),
+			Size:     size,
+			CreateAt: model.GetMillisForTime(modTime),
+		})
+	}
+
+	sort.Slice(profiles, func(i, j int) bool {
+		return profiles[i].CreateAt > profiles[j].CreateAt
+	})
+
+	return profiles, nil
+}
+
+// ProfileReader returns a reader of the content of a stored profile.
+func (a *App) ProfileReader(name string) (filestore.ReadCloseSeeker, *model.AppError) {
+	filePath, appErr := a.profilePath(name)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	return a.FileReader(filePath)
+}
+
+// DeleteProfile removes a stored profile.
+func (a *App) DeleteProfile(name string) *model.AppError {
+	filePath, appErr := a.profilePath(name)
+	if appErr != nil {
+		return appErr
+	}
+
+	return a.RemoveFile(filePath)
+}
+
+func (a *App) profilePath(name string) (string, *model.AppError) {
+	if !profileNameRegexp.MatchString(name) || name == "." || name == ".." {
+		return "", model.NewAppError("profilePath", "app.profile.not_found.app_error", nil, "", http.StatusNotFound)
+	}
+
+	filePath := path.Join(profilesDirectory, name)
+	if ok, appErr := a.FileExists(filePath); appErr != nil {
+		return "", appErr
+	} else if !ok {
+		return "", model.NewAppError("profilePath", "app.profile.not_found.app_error", nil, "", http.StatusNotFound)
+	}
+
+	return filePath, nil
+}
@@ -8,6 +8,8 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/throttled/throttled"
@@ -129,3 +131,61 @@ func setRateLimitHeaders(w http.ResponseWriter, context throttled.RateLimitResul
 		w.Header().Add("Retry-After", strconv.Itoa(vi))
 	}
 }
+
+// incomingWebhookRateLimitStoreSize is the number of webhooks tracked by each rate limiter.
+const incomingWebhookRateLimitStoreSize = 10000
+
+// incomingWebhookRateLimiter limits the number of posts each incoming webhook can create per
+// minute. Since webhooks can have different limits, a GCRA rate limiter is kept per limit.
+type incomingWebhookRateLimiter struct {
+	mut      sync.Mutex
+	limiters map[int]*throttled.GCRARateLimiter
+}
+
+func newIncomingWebhookRateLimiter() *incomingWebhookRateLimiter {
+	return &incomingWebhookRateLimiter{
+		limiters: make(map[int]*throttled.GCRARateLimiter),
+	}
+}
+
+func (rl *incomingWebhookRateLimiter) limiter(perMinute int) (*throttled.GCRARateLimiter, error) {
+	rl.mut.Lock()
+	defer rl.mut.Unlock()
+
+	if limiter, ok := rl.limiters[perMinute]; ok {
+		return limiter, nil
+	}
+
+	store, err := memstore.New(incomingWebhookRateLimitStoreSize)
+	if err != nil {
+		return nil, err
+	}
+
+	// Allow a full minute worth of posts at once, as CI systems tend to report in bursts.
+	limiter, err := throttled.NewGCRARateLimiter(store, throttled.RateQuota{
+		MaxRate:  throttled.PerMin(perMinute),
+		MaxBurst: perMinute - 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	rl.limiters[perMinute] = limiter
+
+	return limiter, nil
+}
+
+// RateLimit reports whether a post by the webhook would exceed perMinute and, if so, how long
+// to wait before retrying.
+func (rl *incomingWebhookRateLimiter) RateLimit(hookID string, perMinute int) (bool, time.Duration, error) {
+	limiter, err := rl.limiter(perMinute)
+	if err != nil {
+		return false, 0, err
+	}
+
+	limited, result, err := limiter.RateLimit(hookID, 1)
+	if err != nil {
+		return false, 0, err
+	}
+
+	return limited, result.RetryAfter, nil
+}
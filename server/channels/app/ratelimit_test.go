@@ -8,6 +8,7 @@ import (
 	"net/http/httptest"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -103,3 +104,23 @@ func TestGenerateKey_TrustedHeader(t *testing.T) {
 	key = rateLimiter.GenerateKey(req)
 	require.Equal(t, "10.10.10.5", key, "Wrong key on test without allowed trusted proxy header")
 }
+
+func TestIncomingWebhookRateLimiter(t *testing.T) {
+	rl := newIncomingWebhookRateLimiter()
+	hookID := model.NewId()
+
+	for i := 0; i < 3; i++ {
+		limited, _, err := rl.RateLimit(hookID, 3)
+		require.NoError(t, err)
+		require.False(t, limited)
+	}
+
+	limited, retryAfter, err := rl.RateLimit(hookID, 3)
+	require.NoError(t, err)
+	require.True(t, limited)
+	require.Greater(t, retryAfter, time.Duration(0))
+
+	limited, _, err = rl.RateLimit(model.NewId(), 3)
+	require.NoError(t, err)
+	require.False(t, limited, "webhooks should be limited independently")
+}
@@ -90,6 +90,15 @@ func (a *App) SaveReactionForPost(c request.CTX, reaction *model.Reaction) (*mod
 		}, plugin.ReactionHasBeenAddedID)
 	})
 
+	a.triggerAutomationRules(c, &model.AutomationEvent{
+		Type:      model.AutomationTriggerReactionAdded,
+		TeamId:    channel.TeamId,
+		ChannelId: channel.Id,
+		UserId:    reaction.UserId,
+		PostId:    post.Id,
+		EmojiName: reaction.EmojiName,
+	})
+
 	a.sendReactionEvent(c, model.WebsocketEventReactionAdded, reaction, post)
 
 	return reaction, nil
@@ -0,0 +1,134 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"time"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+)
+
+// replyByEmailMACLength is the number of bytes of the signature kept in the reply-by-email
+// tokens, which must fit in the local part of an email address.
+const replyByEmailMACLength = 8
+
+func (a *App) replyByEmailEnabled() bool {
+	return *a.Config().EmailSettings.EnableReplyByEmail && *a.Config().EmailSettings.ReplyByEmailAddress != ""
+}
+
+// replyByEmailToken returns the token identifying the replies of the user to the post. The token
+// is made of the post id and a signature of the post and the user ids, so that it's only valid
+// when the reply is sent by that user.
+func (a *App) replyByEmailToken(userID, postID string) string {
+	mac := hmac.New(sha256.New, a.PostActionCookieSecret())
+	mac.Write([]byte("reply_by_email:" + userID + ":" + postID))
+	return postID + hex.EncodeToString(mac.Sum(nil)[:replyByEmailMACLength])
+}
+
+// getReplyByEmailAddress returns the reply-to address of the notification email of the post sent to
+// the user, or an empty string when replying by email is disabled.
+func (a *App) getReplyByEmailAddress(userID, postID string) string {
+	if !a.replyByEmailEnabled() || postID == "" {
+		return ""
+	}
+
+	return model.ReplyByEmailAddress(*a.Config().EmailSettings.ReplyByEmailAddress, a.replyByEmailToken(userID, postID))
+}
+
+// ProcessInboundEmail posts a reply to a notification email in the thread of the post the email
+// notified about, as the user the notification was sent to.
+func (a *App) ProcessInboundEmail(c request.CTX, email *model.InboundEmail) (*model.Post, *model.AppError) {
+	if !a.replyByEmailEnabled() {
+		return nil, model.NewAppError("ProcessInboundEmail", "app.inbound_email.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	token := model.ReplyByEmailTokenFromAddresses(*a.Config().EmailSettings.ReplyByEmailAddress, email.To)
+	if len(token) != 26+2*replyByEmailMACLength || !model.IsValidId(token[:26]) {
+		return nil, model.NewAppError("ProcessInboundEmail", "app.inbound_email.invalid_token.app_error", nil, "", http.StatusBadRequest)
+	}
+	postID := token[:26]
+
+	user, appErr := a.GetUserByEmail(email.FromAddress())
+	if appErr != nil || user.DeleteAt != 0 || user.IsBot {
+		return nil, model.NewAppError("ProcessInboundEmail", "app.inbound_email.invalid_sender.app_error", nil, "", http.StatusForbidden)
+	}
+	if *a.Config().EmailSettings.RequireEmailVerification && !user.EmailVerified {
+		return nil, model.NewAppError("ProcessInboundEmail", "app.inbound_email.invalid_sender.app_error", nil, "user_id="+user.Id, http.StatusForbidden)
+	}
+	if !hmac.Equal([]byte(token), []byte(a.replyByEmailToken(user.Id, postID))) {
+		return nil, model.NewAppError("ProcessInboundEmail", "app.inbound_email.invalid_token.app_error", nil, "user_id="+user.Id, http.StatusForbidden)
+	}
+
+	post, appErr := a.GetSinglePost(c, postID, false)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	channel, appErr := a.GetChannel(c, post.ChannelId)
+	if appErr != nil {
+		return nil, appErr
+	}
+	if channel.DeleteAt != 0 {
+		return nil, model.NewAppError("ProcessInboundEmail", "app.inbound_email.archived_channel.app_error", nil, "channel_id="+channel.Id, http.StatusBadRequest)
+	}
+
+	if !a.HasPermissionToChannel(c, user.Id, channel.Id, model.PermissionCreatePost) {
+		return nil, model.NewAppError("ProcessInboundEmail", "app.inbound_email.permissions.app_error", nil, "user_id="+user.Id+", channel_id="+channel.Id, http.StatusForbidden)
+	}
+
+	fileIDs := a.uploadInboundEmailAttachments(c, user.Id, channel, email.Attachments)
+
+	message := model.ExtractEmailReplyText(email.Text)
+	if message == "" && len(fileIDs) == 0 {
+		return nil, model.NewAppError("ProcessInboundEmail", "app.inbound_email.empty.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	rootID := post.RootId
+	if rootID == "" {
+		rootID = post.Id
+	}
+
+	reply := &model.Post{
+		UserId:    user.Id,
+		ChannelId: channel.Id,
+		RootId:    rootID,
+		Message:   message,
+		FileIds:   fileIDs,
+	}
+
+	return a.CreatePost(c, reply, channel, true, false)
+}
+
+func (a *App) uploadInboundEmailAttachments(c request.CTX, userID string, channel *model.Channel, attachments []*model.InboundEmailAttachment) model.StringArray {
+	if !*a.Config().FileSettings.EnableFileAttachments {
+		return nil
+	}
+
+	var fileIDs model.StringArray
+	now := time.Now()
+	for _, attachment := range attachments {
+		if len(fileIDs) >= model.InboundEmailMaxAttachments {
+			break
+		}
+		if int64(len(attachment.Data)) > *a.Config().FileSettings.MaxFileSize {
+			c.Logger().Info("Skipping inbound email attachment larger than the maximum file size", mlog.String("name", attachment.Name), mlog.Int("size", len(attachment.Data)))
+			continue
+		}
+
+		info, appErr := a.DoUploadFile(c, now, channel.TeamId, channel.Id, userID, attachment.Name, attachment.Data, true)
+		if appErr != nil {
+			c.Logger().Warn("Failed to upload inbound email attachment", mlog.String("name", attachment.Name), mlog.Err(appErr))
+			continue
+		}
+		fileIDs = append(fileIDs, info.Id)
+	}
+
+	return fileIDs
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+func TestReplyByEmail(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.EmailSettings.EnableReplyByEmail = true
+		*cfg.EmailSettings.ReplyByEmailAddress = "reply@example.com"
+		*cfg.EmailSettings.InboundEmailSecret = model.NewId()
+	})
+
+	rootPost := th.CreatePost(th.BasicChannel)
+	address := th.App.getReplyByEmailAddress(th.BasicUser.Id, rootPost.Id)
+	require.NotEmpty(t, address)
+	assert.LessOrEqual(t, len(address[:len(address)-len("@example.com")]), 64, "the local part of an address is limited to 64 characters")
+
+	reply := func(from, to, text string) (*model.Post, *model.AppError) {
+		return th.App.ProcessInboundEmail(th.Context, &model.InboundEmail{
+			From: from,
+			To:   []string{to},
+			Text: text,
+		})
+	}
+
+	t.Run("posts the reply in the thread", func(t *testing.T) {
+		post, appErr := reply(th.BasicUser.Email, address, "Sounds good\n\nOn Tue, Jan 2, 2024 Mattermost wrote:\n> Are you coming?")
+		require.Nil(t, appErr)
+		assert.Equal(t, th.BasicUser.Id, post.UserId)
+		assert.Equal(t, rootPost.Id, post.RootId)
+		assert.Equal(t, "Sounds good", post.Message)
+	})
+
+	t.Run("replies to a reply are posted in the same thread", func(t *testing.T) {
+		threadReply, appErr := th.App.CreatePost(th.Context, &model.Post{
+			UserId:    th.BasicUser2.Id,
+			ChannelId: th.BasicChannel.Id,
+			RootId:    rootPost.Id,
+			Message:   "Are you coming?",
+		}, th.BasicChannel, false, true)
+		require.Nil(t, appErr)
+
+		post, appErr := reply(th.BasicUser.Email, th.App.getReplyByEmailAddress(th.BasicUser.Id, threadReply.Id), "Me too")
+		require.Nil(t, appErr)
+		assert.Equal(t, rootPost.Id, post.RootId)
+	})
+
+	t.Run("the token is only valid for the user it was sent to", func(t *testing.T) {
+		_, appErr := reply(th.BasicUser2.Email, address, "Sounds good")
+		require.NotNil(t, appErr)
+		assert.Equal(t, http.StatusForbidden, appErr.StatusCode)
+	})
+
+	t.Run("unknown sender", func(t *testing.T) {
+		_, appErr := reply("unknown@example.com", address, "Sounds good")
+		require.NotNil(t, appErr)
+		assert.Equal(t, http.StatusForbidden, appErr.StatusCode)
+	})
+
+	t.Run("not a reply", func(t *testing.T) {
+		_, appErr := reply(th.BasicUser.Email, "reply@example.com", "Sounds good")
+		require.NotNil(t, appErr)
+		assert.Equal(t, http.StatusBadRequest, appErr.StatusCode)
+	})
+
+	t.Run("empty reply", func(t *testing.T) {
+		_, appErr := reply(th.BasicUser.Email, address, "> Are you coming?")
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.inbound_email.empty.app_error", appErr.Id)
+	})
+
+	t.Run("attachments", func(t *testing.T) {
+		post, appErr := th.App.ProcessInboundEmail(th.Context, &model.InboundEmail{
+			From: th.BasicUser.Email,
+			To:   []string{address},
+			Attachments: []*model.InboundEmailAttachment{
+				{Name: "notes.txt", ContentType: "text/plain", Data: []byte("some notes")},
+			},
+		})
+		require.Nil(t, appErr)
+		require.Len(t, post.FileIds, 1)
+	})
+
+	t.Run("user removed from the channel", func(t *testing.T) {
+		privateChannel := th.CreatePrivateChannel(th.Context, th.BasicTeam)
+		th.AddUserToChannel(th.BasicUser2, privateChannel)
+		privatePost := th.CreatePost(privateChannel)
+		privateAddress := th.App.getReplyByEmailAddress(th.BasicUser.Id, privatePost.Id)
+		require.Nil(t, th.App.RemoveUserFromChannel(th.Context, th.BasicUser.Id, th.SystemAdminUser.Id, privateChannel))
+
+		_, appErr := reply(th.BasicUser.Email, privateAddress, "Sounds good")
+		require.NotNil(t, appErr)
+		assert.Equal(t, http.StatusForbidden, appErr.StatusCode)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.EmailSettings.EnableReplyByEmail = false })
+		defer th.App.UpdateConfig(func(cfg *model.Config) { *cfg.EmailSettings.EnableReplyByEmail = true })
+
+		assert.Empty(t, th.App.getReplyByEmailAddress(th.BasicUser.Id, rootPost.Id))
+		_, appErr := reply(th.BasicUser.Email, address, "Sounds good")
+		require.NotNil(t, appErr)
+		assert.Equal(t, http.StatusNotImplemented, appErr.StatusCode)
+	})
+}
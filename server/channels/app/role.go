@@ -134,6 +134,12 @@ func (a *App) PatchRole(role *model.Role, patch *model.RolePatch) (*model.Role,
 	}
 
 	role.Patch(patch)
+	if role.IsCustom() {
+		if appErr := role.IsValidCustomRolePermissions(); appErr != nil {
+			return nil, appErr
+		}
+	}
+
 	role, err := a.UpdateRole(role)
 	if err != nil {
 		return nil, err
@@ -169,6 +175,65 @@ func (a *App) CreateRole(role *model.Role) (*model.Role, *model.AppError) {
 	return role, nil
 }
 
+// CreateCustomRole creates a role with an arbitrary set of team and channel permissions, which can
+// then be assigned to team and channel members as an explicit role.
+func (a *App) CreateCustomRole(role *model.Role) (*model.Role, *model.AppError) {
+	if appErr := role.IsValidCustomRolePermissions(); appErr != nil {
+		return nil, appErr
+	}
+
+	if _, err := a.Srv().Store().Role().GetByName(context.Background(), role.Name); err == nil {
+		return nil, model.NewAppError("CreateCustomRole", "app.role.create_custom.name_exists.app_error", nil, "role="+role.Name, http.StatusBadRequest)
+	} else {
+		var nfErr *store.ErrNotFound
+		if !errors.As(err, &nfErr) {
+			return nil, model.NewAppError("CreateCustomRole", "app.role.get_by_name.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	role.Permissions = model.RemoveDuplicateStrings(role.Permissions)
+	role, appErr := a.CreateRole(role)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if appErr := a.sendUpdatedRoleEvent(role); appErr != nil {
+		return nil, appErr
+	}
+
+	return role, nil
+}
+
+// DeleteCustomRole deletes a role created with CreateCustomRole. Members who were assigned the role
+// keep it in their explicit roles, but a deleted role doesn't grant any permission.
+func (a *App) DeleteCustomRole(roleID string) (*model.Role, *model.AppError) {
+	role, appErr := a.GetRole(roleID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if !role.IsCustom() {
+		return nil, model.NewAppError("DeleteCustomRole", "app.role.delete_custom.not_custom.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	role, err := a.Srv().Store().Role().Delete(roleID)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("DeleteCustomRole", "app.role.get.app_error", nil, "", http.StatusNotFound).Wrap(err)
+		default:
+			return nil, model.NewAppError("DeleteCustomRole", "app.role.delete.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	if appErr := a.sendUpdatedRoleEvent(role); appErr != nil {
+		return nil, appErr
+	}
+
+	return role, nil
+}
+
 func (a *App) UpdateRole(role *model.Role) (*model.Role, *model.AppError) {
 	savedRole, err := a.Srv().Store().Role().Save(role)
 	if err != nil {
@@ -241,3 +241,66 @@ func testPermissionInheritance(t *testing.T, testCallback func(t *testing.T, th
 	// test 24 combinations where the higher-scoped scheme is a TEAM scheme
 	test(teamScheme.DefaultChannelGuestRole, teamScheme.DefaultChannelUserRole, teamScheme.DefaultChannelAdminRole)
 }
+
+func TestCustomRole(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	role, appErr := th.App.CreateCustomRole(&model.Role{
+		Name:        "moderator",
+		DisplayName: "Moderator",
+		Permissions: []string{
+			model.PermissionReadChannel.Id,
+			model.PermissionDeleteOthersPosts.Id,
+			model.PermissionDeletePost.Id,
+			model.PermissionDeletePost.Id,
+		},
+	})
+	require.Nil(t, appErr)
+	require.True(t, role.IsCustom())
+	require.Len(t, role.Permissions, 3)
+
+	t.Run("names are unique", func(t *testing.T) {
+		_, appErr := th.App.CreateCustomRole(&model.Role{Name: "moderator", DisplayName: "Moderator"})
+		require.NotNil(t, appErr)
+		require.Equal(t, "app.role.create_custom.name_exists.app_error", appErr.Id)
+	})
+
+	t.Run("dependencies are validated", func(t *testing.T) {
+		_, appErr := th.App.CreateCustomRole(&model.Role{
+			Name:        "cleaner",
+			DisplayName: "Cleaner",
+			Permissions: []string{model.PermissionDeleteOthersPosts.Id},
+		})
+		require.NotNil(t, appErr)
+		require.Equal(t, "model.role.custom.permission_dependency.app_error", appErr.Id)
+
+		patch := &model.RolePatch{Permissions: &[]string{model.PermissionManageSystem.Id}}
+		_, appErr = th.App.PatchRole(role, patch)
+		require.NotNil(t, appErr)
+		require.Equal(t, "model.role.custom.permission_scope.app_error", appErr.Id)
+	})
+
+	t.Run("assigned per channel", func(t *testing.T) {
+		_, appErr := th.App.UpdateChannelMemberRoles(th.Context, th.BasicChannel.Id, th.BasicUser2.Id, "channel_user moderator")
+		require.Nil(t, appErr)
+		require.True(t, th.App.SessionHasPermissionToChannel(th.Context, model.Session{UserId: th.BasicUser2.Id, Roles: model.SystemUserRoleId}, th.BasicChannel.Id, model.PermissionDeleteOthersPosts))
+	})
+
+	t.Run("builtin roles can't be deleted", func(t *testing.T) {
+		channelUser, appErr := th.App.GetRoleByName(context.Background(), model.ChannelUserRoleId)
+		require.Nil(t, appErr)
+		_, appErr = th.App.DeleteCustomRole(channelUser.Id)
+		require.NotNil(t, appErr)
+		require.Equal(t, "app.role.delete_custom.not_custom.app_error", appErr.Id)
+	})
+
+	t.Run("deleted roles can't be assigned", func(t *testing.T) {
+		_, appErr := th.App.DeleteCustomRole(role.Id)
+		require.Nil(t, appErr)
+
+		_, appErr = th.App.UpdateTeamMemberRoles(th.Context, th.BasicTeam.Id, th.BasicUser.Id, "team_user moderator")
+		require.NotNil(t, appErr)
+		require.Equal(t, "api.team.update_team_member_roles.deleted_role.app_error", appErr.Id)
+	})
+}
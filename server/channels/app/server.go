@@ -42,13 +42,16 @@ import (
 	"github.com/mattermost/mattermost/server/v8/channels/jobs/cleanup_desktop_tokens"
 	"github.com/mattermost/mattermost/server/v8/channels/jobs/delete_empty_drafts_migration"
 	"github.com/mattermost/mattermost/server/v8/channels/jobs/delete_orphan_drafts_migration"
+	"github.com/mattermost/mattermost/server/v8/channels/jobs/email_digest"
 	"github.com/mattermost/mattermost/server/v8/channels/jobs/expirynotify"
 	"github.com/mattermost/mattermost/server/v8/channels/jobs/export_delete"
 	"github.com/mattermost/mattermost/server/v8/channels/jobs/export_process"
 	"github.com/mattermost/mattermost/server/v8/channels/jobs/export_users_to_csv"
 	"github.com/mattermost/mattermost/server/v8/channels/jobs/extract_content"
+	"github.com/mattermost/mattermost/server/v8/channels/jobs/file_content_hash_backfill"
 	"github.com/mattermost/mattermost/server/v8/channels/jobs/hosted_purchase_screening"
 	"github.com/mattermost/mattermost/server/v8/channels/jobs/import_delete"
+	"github.com/mattermost/mattermost/server/v8/channels/jobs/import_merge"
 	"github.com/mattermost/mattermost/server/v8/channels/jobs/import_process"
 	"github.com/mattermost/mattermost/server/v8/channels/jobs/last_accessible_file"
 	"github.com/mattermost/mattermost/server/v8/channels/jobs/last_accessible_post"
@@ -60,6 +63,7 @@ import (
 	"github.com/mattermost/mattermost/server/v8/channels/jobs/refresh_post_stats"
 	"github.com/mattermost/mattermost/server/v8/channels/jobs/resend_invitation_email"
 	"github.com/mattermost/mattermost/server/v8/channels/jobs/s3_path_migration"
+	"github.com/mattermost/mattermost/server/v8/channels/jobs/teams_import"
 	"github.com/mattermost/mattermost/server/v8/channels/store"
 	"github.com/mattermost/mattermost/server/v8/channels/utils"
 	"github.com/mattermost/mattermost/server/v8/config"
@@ -113,6 +117,15 @@ type Server struct {
 	pushNotificationClient *http.Client // TODO: move this to it's own package
 	outgoingWebhookClient  *http.Client
 
+	incomingWebhookRateLimiter *incomingWebhookRateLimiter
+	// dynamicListCache keeps the items of slash command dynamic list arguments
+	// for as long as the integrations allow.
+	dynamicListCache cache.Cache
+	// automationRuleCache keeps the enabled automation rules by team and trigger type.
+	automationRuleCache cache.Cache
+	// customProfileAttributeFieldsCache keeps the custom profile attribute fields.
+	customProfileAttributeFieldsCache cache.Cache
+
 	runEssentialJobs bool
 	Jobs             *jobs.JobServer
 
@@ -147,6 +160,10 @@ type Server struct {
 
 	tracer *tracing.Tracer
 
+	dataSeedMut    sync.Mutex
+	dataSeedStatus *model.DataSeedStatus
+	dataSeedCancel context.CancelFunc
+
 	ch *Channels
 }
 
@@ -293,10 +310,31 @@ func NewServer(options ...Option) (*Server, error) {
 			return nil, err2
 		}
 		s.tracer = tracer
+	} else if *s.platform.Config().TracingSettings.Enable {
+		tracer, err2 := tracing.NewOTLP(s.platform.Config().TracingSettings)
+		if err2 != nil {
+			return nil, err2
+		}
+		s.tracer = tracer
 	}
 
 	s.pushNotificationClient = s.httpService.MakeClient(true)
 	s.outgoingWebhookClient = s.httpService.MakeClient(false)
+	s.incomingWebhookRateLimiter = newIncomingWebhookRateLimiter()
+	s.dynamicListCache = cache.NewLRU(cache.LRUOptions{
+		Name: "DynamicListArguments",
+		Size: dynamicListCacheSize,
+	})
+	s.automationRuleCache = cache.NewLRU(cache.LRUOptions{
+		Name:          "AutomationRules",
+		Size:          automationRuleCacheSize,
+		DefaultExpiry: automationRuleCacheExpiry,
+	})
+	s.customProfileAttributeFieldsCache = cache.NewLRU(cache.LRUOptions{
+		Name:          "CustomProfileAttributeFields",
+		Size:          1,
+		DefaultExpiry: customProfileAttributeFieldsCacheExpiry,
+	})
 
 	if err2 := utils.TranslationsPreInit(); err2 != nil {
 		return nil, errors.Wrapf(err2, "unable to load Mattermost translation files")
@@ -334,6 +372,11 @@ func NewServer(options ...Option) (*Server, error) {
 		}
 	})
 	s.htmlTemplateWatcher = htmlTemplateWatcher
+	if overridesDir := *s.platform.Config().EmailSettings.EmailTemplateOverridesDirectory; overridesDir != "" {
+		if err2 := htmlTemplateWatcher.SetOverridesDirectory(overridesDir); err2 != nil {
+			mlog.Warn("Unable to load the email template overrides", mlog.String("directory", overridesDir), mlog.Err(err2))
+		}
+	}
 
 	s.telemetryService, err = telemetry.New(New(ServerConnector(s.Channels())), s.Store(), s.platform.SearchEngine, s.Log(), *s.Config().LogSettings.VerboseDiagnostics)
 	if err != nil {
@@ -391,6 +434,16 @@ func NewServer(options ...Option) (*Server, error) {
 		s.EmailService.InitEmailBatching()
 	})
 
+	s.platform.AddConfigListener(func(oldCfg, newCfg *model.Config) {
+		overridesDir := *newCfg.EmailSettings.EmailTemplateOverridesDirectory
+		if *oldCfg.EmailSettings.EmailTemplateOverridesDirectory == overridesDir {
+			return
+		}
+		if err := s.htmlTemplateWatcher.SetOverridesDirectory(overridesDir); err != nil {
+			mlog.Warn("Unable to load the email template overrides", mlog.String("directory", overridesDir), mlog.Err(err))
+		}
+	})
+
 	isTrial := false
 	if licence := s.License(); licence != nil {
 		isTrial = licence.IsTrial
@@ -529,9 +582,18 @@ func (s *Server) runJobs() {
 	s.Go(func() {
 		runConfigCleanupJob(s)
 	})
+	s.Go(func() {
+		runNotificationDeliveryCleanupJob(s)
+	})
+	s.Go(func() {
+		runIntegrationDeliveryCleanupJob(s)
+	})
 
 	if complianceI := s.Channels().Compliance; complianceI != nil {
 		go complianceI.StartComplianceDailyJob()
+		s.Go(func() {
+			New(ServerConnector(s.Channels())).ResumeIncrementalComplianceJobs(request.EmptyContext(s.Log()))
+		})
 	}
 
 	if *s.platform.Config().JobSettings.RunJobs && s.Jobs != nil {
@@ -691,6 +753,7 @@ func (s *Server) Shutdown() {
 
 	s.StopHTTPServer()
 	s.stopLocalModeServer()
+	s.stopDataSeed()
 	// Push notification hub needs to be shutdown after HTTP server
 	// to prevent stray requests from generating a push notification after it's shut down.
 	s.StopPushNotificationsHubWorkers()
@@ -1197,6 +1260,20 @@ func runJobsCleanupJob(s *Server) {
 	}, time.Hour*24)
 }
 
+func runNotificationDeliveryCleanupJob(s *Server) {
+	doNotificationDeliveryCleanup(s)
+	model.CreateRecurringTask("Notification Delivery Cleanup", func() {
+		doNotificationDeliveryCleanup(s)
+	}, time.Hour*1)
+}
+
+func runIntegrationDeliveryCleanupJob(s *Server) {
+	doIntegrationDeliveryCleanup(s)
+	model.CreateRecurringTask("Integration Delivery Cleanup", func() {
+		doIntegrationDeliveryCleanup(s)
+	}, time.Hour*1)
+}
+
 func runConfigCleanupJob(s *Server) {
 	doConfigCleanup(s)
 	model.CreateRecurringTask("Configuration Cleanup", func() {
@@ -1246,8 +1323,10 @@ func doCommandWebhookCleanup(s *Server) {
 }
 
 const (
-	sessionsCleanupBatchSize = 1000
-	jobsCleanupBatchSize     = 1000
+	sessionsCleanupBatchSize               = 1000
+	jobsCleanupBatchSize                   = 1000
+	notificationDeliveriesCleanupBatchSize = 1000
+	integrationDeliveriesCleanupBatchSize  = 1000
 )
 
 func doSessionCleanup(s *Server) {
@@ -1272,6 +1351,28 @@ func doJobsCleanup(s *Server) {
 	}
 }
 
+func doNotificationDeliveryCleanup(s *Server) {
+	mlog.Debug("Cleaning up notification delivery store.")
+
+	dur := time.Duration(*s.platform.Config().NotificationLogSettings.DeliveryHistoryRetentionDays) * time.Hour * 24
+	expiry := model.GetMillisForTime(time.Now().Add(-dur))
+	err := s.Store().NotificationDelivery().Cleanup(expiry, notificationDeliveriesCleanupBatchSize)
+	if err != nil {
+		mlog.Warn("Error while cleaning up notification deliveries", mlog.Err(err))
+	}
+}
+
+func doIntegrationDeliveryCleanup(s *Server) {
+	mlog.Debug("Cleaning up integration delivery store.")
+
+	dur := time.Duration(*s.platform.Config().ServiceSettings.IntegrationDeliveryLogRetentionDays) * time.Hour * 24
+	expiry := model.GetMillisForTime(time.Now().Add(-dur))
+	err := s.Store().IntegrationDelivery().Cleanup(expiry, integrationDeliveriesCleanupBatchSize)
+	if err != nil {
+		mlog.Warn("Error while cleaning up integration deliveries", mlog.Err(err))
+	}
+}
+
 func doConfigCleanup(s *Server) {
 	if *s.platform.Config().JobSettings.CleanupConfigThresholdDays < 0 || !config.IsDatabaseDSN(s.platform.DescribeConfig()) {
 		return
@@ -1476,6 +1577,12 @@ func (s *Server) initJobs() {
 		expirynotify.MakeScheduler(s.Jobs),
 	)
 
+	s.Jobs.RegisterJobType(
+		model.JobTypeEmailDigest,
+		email_digest.MakeWorker(s.Jobs, New(ServerConnector(s.Channels())).SendEmailDigests),
+		email_digest.MakeScheduler(s.Jobs),
+	)
+
 	s.Jobs.RegisterJobType(
 		model.JobTypeProductNotices,
 		product_notices.MakeWorker(s.Jobs, New(ServerConnector(s.Channels()))),
@@ -1488,6 +1595,18 @@ func (s *Server) initJobs() {
 		nil,
 	)
 
+	s.Jobs.RegisterJobType(
+		model.JobTypeImportMerge,
+		import_merge.MakeWorker(s.Jobs, New(ServerConnector(s.Channels()))),
+		nil,
+	)
+
+	s.Jobs.RegisterJobType(
+		model.JobTypeTeamsImport,
+		teams_import.MakeWorker(s.Jobs, New(ServerConnector(s.Channels()))),
+		nil,
+	)
+
 	s.Jobs.RegisterJobType(
 		model.JobTypeImportDelete,
 		import_delete.MakeWorker(s.Jobs, New(ServerConnector(s.Channels())), s.Store()),
@@ -1539,6 +1658,12 @@ func (s *Server) initJobs() {
 		nil,
 	)
 
+	s.Jobs.RegisterJobType(
+		model.JobTypeFileContentHashBackfill,
+		file_content_hash_backfill.MakeWorker(s.Jobs, New(ServerConnector(s.Channels())), s.Store()),
+		nil,
+	)
+
 	s.Jobs.RegisterJobType(
 		model.JobTypeLastAccessiblePost,
 		last_accessible_post.MakeWorker(s.Jobs, s.License(), New(ServerConnector(s.Channels()))),
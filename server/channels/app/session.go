@@ -374,6 +374,10 @@ func (a *App) CreateUserAccessToken(rctx request.CTX, token *model.UserAccessTok
 		return nil, model.NewAppError("CreateUserAccessToken", "app.user_access_token.disabled", nil, "", http.StatusNotImplemented)
 	}
 
+	if token.IsExpired() {
+		return nil, model.NewAppError("CreateUserAccessToken", "app.user_access_token.expires_at.app_error", nil, "", http.StatusBadRequest)
+	}
+
 	token.Token = model.NewId()
 
 	token, nErr = a.Srv().Store().UserAccessToken().Save(token)
@@ -407,6 +411,10 @@ func (a *App) createSessionForUserAccessToken(c request.CTX, tokenString string)
 		return nil, model.NewAppError("createSessionForUserAccessToken", "app.user_access_token.invalid_or_missing", nil, "inactive_token", http.StatusUnauthorized)
 	}
 
+	if token.IsExpired() {
+		return nil, model.NewAppError("createSessionForUserAccessToken", "app.user_access_token.invalid_or_missing", nil, "expired_token", http.StatusUnauthorized)
+	}
+
 	user, nErr := a.Srv().Store().User().Get(c.Context(), token.UserId)
 	if nErr != nil {
 		var nfErr *store.ErrNotFound
@@ -441,6 +449,18 @@ func (a *App) createSessionForUserAccessToken(c request.CTX, tokenString string)
 	session.AddProp(model.SessionPropType, model.SessionTypeUserAccessToken)
 	if user.IsBot {
 		session.AddProp(model.SessionPropIsBot, model.SessionPropIsBotValue)
+
+		bot, appErr := a.GetBot(c, user.Id, false)
+		if appErr != nil {
+			return nil, appErr
+		}
+		if bot.Scope != nil {
+			scope, err := bot.Scope.Value()
+			if err != nil {
+				return nil, model.NewAppError("createSessionForUserAccessToken", "app.bot.scope.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+			}
+			session.AddProp(model.SessionPropBotScope, scope.(string))
+		}
 	}
 	if user.IsGuest() {
 		session.AddProp(model.SessionPropIsGuest, "true")
@@ -448,6 +468,9 @@ func (a *App) createSessionForUserAccessToken(c request.CTX, tokenString string)
 		session.AddProp(model.SessionPropIsGuest, "false")
 	}
 	a.ch.srv.platform.SetSessionExpireInHours(session, model.SessionUserAccessTokenExpiryHours)
+	if token.ExpiresAt > 0 && token.ExpiresAt < session.ExpiresAt {
+		session.ExpiresAt = token.ExpiresAt
+	}
 
 	session, nErr = a.Srv().Store().Session().Save(c, session)
 	if nErr != nil {
@@ -511,6 +534,51 @@ func (a *App) EnableUserAccessToken(c request.CTX, token *model.UserAccessToken)
 	return nil
 }
 
+// RotateUserAccessToken issues a new token for the user of the given one, with the same description,
+// and lets the given token expire at the end of the grace period configured for rotations.
+func (a *App) RotateUserAccessToken(c request.CTX, token *model.UserAccessToken, expiresAt int64) (*model.UserAccessToken, *model.AppError) {
+	if !token.IsActive || token.IsExpired() {
+		return nil, model.NewAppError("RotateUserAccessToken", "app.user_access_token.rotate.inactive.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	newToken, appErr := a.CreateUserAccessToken(c, &model.UserAccessToken{
+		UserId:      token.UserId,
+		Description: token.Description,
+		ExpiresAt:   expiresAt,
+	})
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	graceEnd := model.GetMillis() + int64(*a.Config().ServiceSettings.UserAccessTokenGracePeriodMinutes)*60*1000
+	if token.ExpiresAt == 0 || token.ExpiresAt > graceEnd {
+		if err := a.Srv().Store().UserAccessToken().UpdateExpiresAt(token.Id, graceEnd); err != nil {
+			return nil, model.NewAppError("RotateUserAccessToken", "app.user_access_token.update_expires_at.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+
+		// The sessions created with the rotated token are cached with their previous expiry.
+		a.ch.srv.platform.ClearUserSessionCache(token.UserId)
+	}
+
+	return newToken, nil
+}
+
+// GetExpiringUserAccessTokens returns the active tokens expiring within the given number of days,
+// including the ones already expired.
+func (a *App) GetExpiringUserAccessTokens(withinDays, page, perPage int) ([]*model.UserAccessToken, *model.AppError) {
+	before := model.GetMillis() + int64(withinDays)*24*60*60*1000
+	tokens, err := a.Srv().Store().UserAccessToken().GetExpiring(before, page*perPage, perPage)
+	if err != nil {
+		return nil, model.NewAppError("GetExpiringUserAccessTokens", "app.user_access_token.get_expiring.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	for _, token := range tokens {
+		token.Token = ""
+	}
+
+	return tokens, nil
+}
+
 func (a *App) GetUserAccessTokens(page, perPage int) ([]*model.UserAccessToken, *model.AppError) {
 	tokens, err := a.Srv().Store().UserAccessToken().GetAll(page*perPage, perPage)
 	if err != nil {
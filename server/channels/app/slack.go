@@ -8,11 +8,15 @@ import (
 	"context"
 	"fmt"
 	"image"
+	"io"
 	"mime/multipart"
+	"net/http"
 	"regexp"
 	"strings"
 	"time"
 
+	"github.com/pkg/errors"
+
 	"github.com/mattermost/mattermost/server/public/model"
 	"github.com/mattermost/mattermost/server/public/shared/request"
 	"github.com/mattermost/mattermost/server/v8/channels/store"
@@ -47,12 +51,42 @@ func (a *App) SlackImport(c request.CTX, fileData multipart.File, fileSize int64
 			}
 			return img, imgType, release, err
 		},
+		DownloadFile: a.downloadSlackImportFile,
 	}
 
 	importer := slackimport.New(a.Srv().Store(), actions, a.Config())
 	return importer.SlackImport(c, fileData, fileSize, teamID)
 }
 
+// downloadSlackImportFile downloads a file linked from a Slack export. It doesn't retry, the
+// importer takes care of that.
+func (a *App) downloadSlackImportFile(downloadURL string) ([]byte, error) {
+	if !model.IsValidHTTPURL(downloadURL) {
+		return nil, errors.Errorf("invalid url %s", downloadURL)
+	}
+
+	resp, err := a.HTTPService().MakeClient(false).Get(downloadURL)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to download the file")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("failed to download the file: status code %d", resp.StatusCode)
+	}
+
+	maxFileSize := *a.Config().FileSettings.MaxFileSize
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxFileSize+1))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read the file")
+	}
+	if int64(len(data)) > maxFileSize {
+		return nil, errors.Errorf("the file is larger than the maximum file size of %d bytes", maxFileSize)
+	}
+
+	return data, nil
+}
+
 func (a *App) ProcessSlackText(text string) string {
 	text = expandAnnouncement(text)
 	text = replaceUserIds(a.Srv().Store().User(), text)
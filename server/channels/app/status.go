@@ -6,6 +6,7 @@ package app
 import (
 	"encoding/json"
 	"net/http"
+	"time"
 
 	"github.com/mattermost/mattermost/server/public/model"
 	"github.com/mattermost/mattermost/server/public/shared/mlog"
@@ -42,6 +43,64 @@ func (a *App) SetStatusDoNotDisturbTimed(userId string, endtime int64) {
 	a.Srv().Platform().SetStatusDoNotDisturbTimed(userId, endtime)
 }
 
+// SetTimedStatuses sets the time-boxed statuses reported by a calendar provider. The previous status of each
+// user is restored once the end time of their timed status is reached, see UpdateDNDStatusOfUsers.
+func (a *App) SetTimedStatuses(c request.CTX, statuses []*model.TimedStatus) *model.AppError {
+	if !*a.Config().ServiceSettings.EnableUserStatuses {
+		return model.NewAppError("SetTimedStatuses", "app.status.timed.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	if len(statuses) > model.TimedStatusesMaxBulkSize {
+		return model.NewAppError("SetTimedStatuses", "app.status.timed.too_many.app_error", map[string]any{"Max": model.TimedStatusesMaxBulkSize}, "", http.StatusBadRequest)
+	}
+
+	// Validate the whole batch and resolve its users first, so that a provider never gets a partially
+	// applied batch for a bad input.
+	users := make([]*model.User, len(statuses))
+	for i, status := range statuses {
+		if appErr := status.IsValid(); appErr != nil {
+			return appErr
+		}
+
+		user, appErr := a.GetUser(status.UserId)
+		if appErr != nil {
+			return appErr
+		}
+		users[i] = user
+
+		if status.CustomStatus == nil {
+			continue
+		}
+		if !*a.Config().TeamSettings.EnableCustomUserStatuses {
+			return model.NewAppError("SetTimedStatuses", "api.custom_status.disabled", nil, "", http.StatusNotImplemented)
+		}
+		if status.CustomStatus.Emoji != "" {
+			if err := a.confirmEmojiExists(c, status.CustomStatus.Emoji); err != nil {
+				return model.NewAppError("SetTimedStatuses", "api.custom_status.set_custom_statuses.emoji_not_found", nil, "", http.StatusBadRequest).Wrap(err)
+			}
+		}
+	}
+
+	for i, status := range statuses {
+		user := users[i]
+		a.Srv().Platform().SetStatusTimed(user.Id, status.Status, status.EndTime)
+
+		if status.CustomStatus != nil {
+			cs := *status.CustomStatus
+			cs.Duration = "date_and_time"
+			cs.ExpiresAt = time.Unix(status.EndTime, 0).UTC()
+			cs.PreSave()
+
+			user.SetCustomStatus(&cs)
+			if _, appErr := a.UpdateUser(c, user, true); appErr != nil {
+				return appErr
+			}
+		}
+	}
+
+	return nil
+}
+
 func (a *App) SetStatusDoNotDisturb(userID string) {
 	a.Srv().Platform().SetStatusDoNotDisturb(userID)
 }
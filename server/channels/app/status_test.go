@@ -5,6 +5,7 @@ package app
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -197,3 +198,64 @@ func TestSetCustomStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestSetTimedStatuses(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	endTime := time.Now().Add(time.Hour).Unix()
+
+	t.Run("back to back statuses restore the original status", func(t *testing.T) {
+		th.App.SetStatusAwayIfNeeded(th.BasicUser.Id, true)
+
+		appErr := th.App.SetTimedStatuses(th.Context, []*model.TimedStatus{{UserId: th.BasicUser.Id, Status: model.StatusDnd, EndTime: endTime}})
+		require.Nil(t, appErr)
+		appErr = th.App.SetTimedStatuses(th.Context, []*model.TimedStatus{{UserId: th.BasicUser.Id, Status: model.StatusOutOfOffice, EndTime: endTime + 60}})
+		require.Nil(t, appErr)
+
+		status, appErr := th.App.GetStatus(th.BasicUser.Id)
+		require.Nil(t, appErr)
+		assert.Equal(t, model.StatusOutOfOffice, status.Status)
+		assert.Equal(t, model.StatusAway, status.PrevStatus)
+		assert.Equal(t, endTime+60, status.DNDEndTime)
+	})
+
+	t.Run("custom status expires with the status", func(t *testing.T) {
+		appErr := th.App.SetTimedStatuses(th.Context, []*model.TimedStatus{{
+			UserId:       th.BasicUser2.Id,
+			Status:       model.StatusDnd,
+			EndTime:      endTime,
+			CustomStatus: &model.CustomStatus{Emoji: "calendar", Text: "In a meeting"},
+		}})
+		require.Nil(t, appErr)
+
+		cs, appErr := th.App.GetCustomStatus(th.BasicUser2.Id)
+		require.Nil(t, appErr)
+		assert.Equal(t, "In a meeting", cs.Text)
+		assert.Equal(t, endTime, cs.ExpiresAt.Unix())
+	})
+
+	t.Run("unknown user applies nothing", func(t *testing.T) {
+		user := th.CreateUser()
+
+		appErr := th.App.SetTimedStatuses(th.Context, []*model.TimedStatus{
+			{UserId: user.Id, Status: model.StatusDnd, EndTime: endTime},
+			{UserId: model.NewId(), Status: model.StatusDnd, EndTime: endTime},
+		})
+		require.NotNil(t, appErr)
+
+		status, appErr := th.App.GetStatus(user.Id)
+		if appErr == nil {
+			assert.NotEqual(t, model.StatusDnd, status.Status)
+		}
+	})
+
+	t.Run("user statuses disabled", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableUserStatuses = false })
+		defer th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableUserStatuses = true })
+
+		appErr := th.App.SetTimedStatuses(th.Context, []*model.TimedStatus{{UserId: th.BasicUser.Id, Status: model.StatusDnd, EndTime: endTime}})
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.status.timed.disabled.app_error", appErr.Id)
+	})
+}
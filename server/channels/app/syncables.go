@@ -26,6 +26,10 @@ func (a *App) createDefaultChannelMemberships(rctx request.CTX, params model.Cre
 	}
 
 	var multiErr *multierror.Error
+	// Added members are announced to each channel once the sync is done, rather
+	// than with one user_added event per member.
+	addedChannels := map[string]*model.Channel{}
+	addedUserIDs := map[string][]string{}
 	for _, userChannel := range channelMembers {
 		if params.ScopedUserID != nil && *params.ScopedUserID != userChannel.UserID {
 			continue
@@ -67,6 +71,7 @@ func (a *App) createDefaultChannelMemberships(rctx request.CTX, params model.Cre
 
 		_, err = a.AddChannelMember(rctx, userChannel.UserID, channel, ChannelMemberOpts{
 			SkipTeamMemberIntegrityCheck: true,
+			SkipChannelEvent:             true,
 		})
 		if err != nil {
 			if err.Id == "api.channel.add_user.to.channel.failed.deleted.app_error" {
@@ -77,9 +82,16 @@ func (a *App) createDefaultChannelMemberships(rctx request.CTX, params model.Cre
 			continue
 		}
 
+		addedChannels[channel.Id] = channel
+		addedUserIDs[channel.Id] = append(addedUserIDs[channel.Id], userChannel.UserID)
+
 		logger.Info("Added channel member for default channel membership")
 	}
 
+	for channelID, userIDs := range addedUserIDs {
+		a.publishChannelMembersAdded(addedChannels[channelID], userIDs)
+	}
+
 	return multiErr.ErrorOrNil()
 }
 
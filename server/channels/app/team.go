@@ -427,6 +427,9 @@ func (a *App) UpdateTeamMemberRoles(c request.CTX, teamID string, userID string,
 			err.StatusCode = http.StatusBadRequest
 			return nil, err
 		}
+		if role.DeleteAt != 0 {
+			return nil, model.NewAppError("UpdateTeamMemberRoles", "api.team.update_team_member_roles.deleted_role.app_error", nil, "role_name="+roleName, http.StatusBadRequest)
+		}
 		if !role.SchemeManaged {
 			// The role is not scheme-managed, so it's OK to apply it to the explicit roles field.
 			newExplicitRoles = append(newExplicitRoles, roleName)
@@ -1011,6 +1014,12 @@ func (a *App) GetTeamMembers(teamID string, offset int, limit int, teamMembersGe
 	return teamMembers, nil
 }
 
+// GetTeamMembersEtag returns the etag of the members of the team. It covers the users of the members too, since
+// the deactivated users may be left out of the list.
+func (a *App) GetTeamMembersEtag(teamID string) string {
+	return fmt.Sprintf("%v.%v", a.Srv().Store().Team().GetEtagForMembers(teamID), a.Srv().Store().User().GetEtagForProfiles(teamID))
+}
+
 func (a *App) GetTeamMembersByIds(teamID string, userIDs []string, restrictions *model.ViewUsersRestrictions) ([]*model.TeamMember, *model.AppError) {
 	teamMembers, err := a.Srv().Store().Team().GetMembersByIds(teamID, userIDs, restrictions)
 	if err != nil {
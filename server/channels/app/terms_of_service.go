@@ -6,15 +6,23 @@ package app
 import (
 	"errors"
 	"net/http"
+	"time"
 
 	"github.com/mattermost/mattermost/server/public/model"
 	"github.com/mattermost/mattermost/server/v8/channels/store"
 )
 
 func (a *App) CreateTermsOfService(text, userID string) (*model.TermsOfService, *model.AppError) {
+	return a.CreateTermsOfServiceForAudience(text, userID, model.TermsOfServiceAudienceAll)
+}
+
+// CreateTermsOfServiceForAudience creates a new version of the terms of service. Only the users in
+// the audience are asked to accept it again if they already accepted a previous version.
+func (a *App) CreateTermsOfServiceForAudience(text, userID, audience string) (*model.TermsOfService, *model.AppError) {
 	termsOfService := &model.TermsOfService{
-		Text:   text,
-		UserId: userID,
+		Text:     text,
+		UserId:   userID,
+		Audience: audience,
 	}
 
 	if _, appErr := a.GetUser(userID); appErr != nil {
@@ -65,3 +73,98 @@ func (a *App) GetTermsOfService(id string) (*model.TermsOfService, *model.AppErr
 	}
 	return termsOfService, nil
 }
+
+func (a *App) GetTermsOfServiceVersions(page, perPage int) ([]*model.TermsOfService, *model.AppError) {
+	versions, err := a.Srv().Store().TermsOfService().GetAll(page*perPage, perPage)
+	if err != nil {
+		return nil, model.NewAppError("GetTermsOfServiceVersions", "app.terms_of_service.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return versions, nil
+}
+
+// GetTermsOfServiceStats returns how many of the active users targeted by the given version of the
+// terms of service have accepted it.
+func (a *App) GetTermsOfServiceStats(termsOfServiceID string) (*model.TermsOfServiceStats, *model.AppError) {
+	termsOfService, appErr := a.GetTermsOfService(termsOfServiceID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	targeted, err := a.Srv().Store().User().Count(model.UserCountOptions{})
+	if err != nil {
+		return nil, model.NewAppError("GetTermsOfServiceStats", "app.user.get_total_users_count.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	if termsOfService.Audience != model.TermsOfServiceAudienceAll {
+		guests, err := a.Srv().Store().User().Count(model.UserCountOptions{Roles: []string{model.SystemGuestRoleId}})
+		if err != nil {
+			return nil, model.NewAppError("GetTermsOfServiceStats", "app.user.get_total_users_count.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+		if termsOfService.Audience == model.TermsOfServiceAudienceGuests {
+			targeted = guests
+		} else {
+			targeted -= guests
+		}
+	}
+
+	accepted, err := a.Srv().Store().UserTermsOfService().CountByTermsOfService(termsOfService.Id, termsOfService.Audience)
+	if err != nil {
+		return nil, model.NewAppError("GetTermsOfServiceStats", "app.user_terms_of_service.count.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return &model.TermsOfServiceStats{
+		TermsOfServiceId: termsOfService.Id,
+		Version:          termsOfService.Version,
+		TargetedUsers:    targeted,
+		AcceptedUsers:    accepted,
+		GracePeriodEndAt: a.termsOfServiceGracePeriodEndAt(termsOfService),
+	}, nil
+}
+
+// termsOfServiceGracePeriodEndAt returns when users who haven't accepted the given terms of service
+// lose access, or 0 if access is never blocked.
+func (a *App) termsOfServiceGracePeriodEndAt(termsOfService *model.TermsOfService) int64 {
+	gracePeriod := *a.Config().SupportSettings.CustomTermsOfServiceGracePeriod
+	if gracePeriod <= 0 {
+		return 0
+	}
+	return termsOfService.CreateAt + int64(gracePeriod)*int64(24*time.Hour/time.Millisecond)
+}
+
+// IsTermsOfServiceAcceptanceOverdue returns true if the user must accept the latest terms of
+// service and its grace period has ended. Bots never have to accept them.
+func (a *App) IsTermsOfServiceAcceptanceOverdue(userID string) (bool, *model.AppError) {
+	if license := a.Channels().License(); license == nil || !*license.Features.CustomTermsOfService || !*a.Config().SupportSettings.CustomTermsOfServiceEnabled {
+		return false, nil
+	}
+
+	termsOfService, appErr := a.GetLatestTermsOfService()
+	if appErr != nil {
+		if appErr.Id == ErrorTermsOfServiceNoRowsFound {
+			return false, nil
+		}
+		return false, appErr
+	}
+
+	gracePeriodEndAt := a.termsOfServiceGracePeriodEndAt(termsOfService)
+	if gracePeriodEndAt == 0 || model.GetMillis() < gracePeriodEndAt {
+		return false, nil
+	}
+
+	user, appErr := a.GetUser(userID)
+	if appErr != nil {
+		return false, appErr
+	}
+	if user.IsBot {
+		return false, nil
+	}
+
+	userTermsOfService, appErr := a.GetUserTermsOfService(user.Id)
+	if appErr != nil {
+		if appErr.StatusCode == http.StatusNotFound {
+			return true, nil
+		}
+		return false, appErr
+	}
+
+	return userTermsOfService.TermsOfServiceId != termsOfService.Id && termsOfService.IncludesUser(user), nil
+}
@@ -0,0 +1,75 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+func TestIsTermsOfServiceAcceptanceOverdue(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.Srv().SetLicense(model.NewTestLicense("EnableCustomTermsOfService"))
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.SupportSettings.CustomTermsOfServiceEnabled = true
+		*cfg.SupportSettings.CustomTermsOfServiceGracePeriod = 7
+	})
+
+	guest := th.CreateGuest()
+
+	first, appErr := th.App.CreateTermsOfService("first", th.BasicUser.Id)
+	require.Nil(t, appErr)
+	for _, userID := range []string{th.BasicUser.Id, guest.Id} {
+		require.Nil(t, th.App.SaveUserTermsOfService(userID, first.Id, true))
+	}
+
+	second, appErr := th.App.CreateTermsOfServiceForAudience("second", th.BasicUser.Id, model.TermsOfServiceAudienceGuests)
+	require.Nil(t, appErr)
+
+	t.Run("within the grace period", func(t *testing.T) {
+		overdue, appErr := th.App.IsTermsOfServiceAcceptanceOverdue(guest.Id)
+		require.Nil(t, appErr)
+		assert.False(t, overdue)
+	})
+
+	// Move the second version past its grace period.
+	_, err := th.GetSqlStore().GetMasterX().Exec("UPDATE TermsOfService SET CreateAt = ? WHERE Id = ?", model.GetMillis()-8*24*60*60*1000, second.Id)
+	require.NoError(t, err)
+
+	t.Run("after the grace period", func(t *testing.T) {
+		overdue, appErr := th.App.IsTermsOfServiceAcceptanceOverdue(guest.Id)
+		require.Nil(t, appErr)
+		assert.True(t, overdue)
+
+		overdue, appErr = th.App.IsTermsOfServiceAcceptanceOverdue(th.BasicUser.Id)
+		require.Nil(t, appErr)
+		assert.False(t, overdue, "members aren't targeted by the second version")
+
+		overdue, appErr = th.App.IsTermsOfServiceAcceptanceOverdue(th.BasicUser2.Id)
+		require.Nil(t, appErr)
+		assert.True(t, overdue, "users must accept some version")
+	})
+
+	t.Run("accepting the latest version", func(t *testing.T) {
+		require.Nil(t, th.App.SaveUserTermsOfService(guest.Id, second.Id, true))
+
+		overdue, appErr := th.App.IsTermsOfServiceAcceptanceOverdue(guest.Id)
+		require.Nil(t, appErr)
+		assert.False(t, overdue)
+	})
+
+	t.Run("stats", func(t *testing.T) {
+		stats, appErr := th.App.GetTermsOfServiceStats(second.Id)
+		require.Nil(t, appErr)
+		assert.Equal(t, int64(1), stats.TargetedUsers)
+		assert.Equal(t, int64(1), stats.AcceptedUsers)
+		assert.NotZero(t, stats.GracePeriodEndAt)
+	})
+}
@@ -0,0 +1,83 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+type typingKey struct {
+	channelID string
+	parentID  string
+}
+
+// typingAggregator collects the users typing in a channel, or a thread of it, during a window,
+// so that a single event is broadcast per window instead of one per typing user.
+type typingAggregator struct {
+	mut     sync.Mutex
+	stopped bool
+	pending map[typingKey]map[string]bool
+	timers  map[typingKey]*time.Timer
+}
+
+func newTypingAggregator() *typingAggregator {
+	return &typingAggregator{
+		pending: map[typingKey]map[string]bool{},
+		timers:  map[typingKey]*time.Timer{},
+	}
+}
+
+// add records that the user is typing. The window of the channel starts with its first typing
+// user, and flush is called with all the users who typed during it when it ends.
+func (ta *typingAggregator) add(channelID, parentID, userID string, window time.Duration, flush func(channelID, parentID string, userIDs []string)) {
+	ta.mut.Lock()
+	defer ta.mut.Unlock()
+
+	if ta.stopped {
+		return
+	}
+
+	key := typingKey{channelID: channelID, parentID: parentID}
+	users, ok := ta.pending[key]
+	if !ok {
+		users = map[string]bool{}
+		ta.pending[key] = users
+		ta.timers[key] = time.AfterFunc(window, func() {
+			if userIDs := ta.take(key); len(userIDs) > 0 {
+				flush(channelID, parentID, userIDs)
+			}
+		})
+	}
+	users[userID] = true
+}
+
+func (ta *typingAggregator) take(key typingKey) []string {
+	ta.mut.Lock()
+	users := ta.pending[key]
+	delete(ta.pending, key)
+	delete(ta.timers, key)
+	ta.mut.Unlock()
+
+	userIDs := make([]string, 0, len(users))
+	for userID := range users {
+		userIDs = append(userIDs, userID)
+	}
+	sort.Strings(userIDs)
+	return userIDs
+}
+
+// stop drops the pending windows without flushing them.
+func (ta *typingAggregator) stop() {
+	ta.mut.Lock()
+	defer ta.mut.Unlock()
+
+	ta.stopped = true
+	for key, timer := range ta.timers {
+		timer.Stop()
+		delete(ta.timers, key)
+		delete(ta.pending, key)
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+func TestTypingAggregator(t *testing.T) {
+	type flushed struct {
+		channelID string
+		parentID  string
+		userIDs   []string
+	}
+
+	t.Run("users typing during a window are flushed together", func(t *testing.T) {
+		ta := newTypingAggregator()
+		defer ta.stop()
+
+		var mut sync.Mutex
+		var flushes []flushed
+		flush := func(channelID, parentID string, userIDs []string) {
+			mut.Lock()
+			defer mut.Unlock()
+			flushes = append(flushes, flushed{channelID, parentID, userIDs})
+		}
+
+		channelID := model.NewId()
+		ta.add(channelID, "", "user2", 50*time.Millisecond, flush)
+		ta.add(channelID, "", "user1", 50*time.Millisecond, flush)
+		ta.add(channelID, "", "user2", 50*time.Millisecond, flush)
+		ta.add(channelID, "root", "user3", 50*time.Millisecond, flush)
+
+		require.Eventually(t, func() bool {
+			mut.Lock()
+			defer mut.Unlock()
+			return len(flushes) == 2
+		}, time.Second, 10*time.Millisecond)
+
+		mut.Lock()
+		defer mut.Unlock()
+		assert.ElementsMatch(t, []flushed{
+			{channelID, "", []string{"user1", "user2"}},
+			{channelID, "root", []string{"user3"}},
+		}, flushes)
+	})
+
+	t.Run("stopped aggregator doesn't flush", func(t *testing.T) {
+		ta := newTypingAggregator()
+
+		var mut sync.Mutex
+		calls := 0
+		flush := func(channelID, parentID string, userIDs []string) {
+			mut.Lock()
+			defer mut.Unlock()
+			calls++
+		}
+
+		ta.add(model.NewId(), "", "user1", 20*time.Millisecond, flush)
+		ta.stop()
+		ta.add(model.NewId(), "", "user1", 20*time.Millisecond, flush)
+
+		time.Sleep(100 * time.Millisecond)
+		mut.Lock()
+		defer mut.Unlock()
+		assert.Zero(t, calls)
+	})
+}
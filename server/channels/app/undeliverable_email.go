@@ -0,0 +1,108 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+	"github.com/mattermost/mattermost/server/v8/platform/shared/mail"
+)
+
+// ProcessEmailEvents marks the addresses reported by the email provider as undeliverable, so that
+// no further email is sent to them.
+func (a *App) ProcessEmailEvents(c request.CTX, events *mail.EmailEvents) *model.AppError {
+	if !*a.Config().EmailSettings.EnableBounceHandling {
+		return model.NewAppError("ProcessEmailEvents", "app.email_events.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	if events.SubscribeURL != "" {
+		if appErr := a.confirmEmailEventsSubscription(c, events.SubscribeURL); appErr != nil {
+			return appErr
+		}
+	}
+
+	for _, undeliverable := range events.Undeliverable {
+		if _, err := a.Srv().Store().UndeliverableEmail().Save(undeliverable); err != nil {
+			var appErr *model.AppError
+			if errors.As(err, &appErr) {
+				c.Logger().Warn("Ignoring invalid undeliverable email address", mlog.String("reason", undeliverable.Reason), mlog.Err(err))
+				continue
+			}
+			return model.NewAppError("ProcessEmailEvents", "app.email_events.save.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+
+		c.Logger().Info("Email address marked as undeliverable", mlog.String("reason", undeliverable.Reason))
+	}
+
+	return nil
+}
+
+// confirmEmailEventsSubscription confirms the subscription of the server to the Amazon SNS topic
+// Amazon SES publishes the bounces and complaints to.
+func (a *App) confirmEmailEventsSubscription(c request.CTX, subscribeURL string) *model.AppError {
+	u, err := url.Parse(subscribeURL)
+	if err != nil || u.Scheme != "https" || !strings.HasPrefix(u.Hostname(), "sns.") || !strings.HasSuffix(u.Hostname(), ".amazonaws.com") {
+		return model.NewAppError("ProcessEmailEvents", "app.email_events.subscribe_url.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	resp, err := a.HTTPService().MakeClient(false).Get(u.String())
+	if err != nil {
+		return model.NewAppError("ProcessEmailEvents", "app.email_events.subscribe.app_error", nil, "", http.StatusBadGateway).Wrap(err)
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		return model.NewAppError("ProcessEmailEvents", "app.email_events.subscribe.app_error", nil, "status="+resp.Status, http.StatusBadGateway)
+	}
+
+	c.Logger().Info("Confirmed the subscription to the email events topic", mlog.String("host", u.Hostname()))
+	return nil
+}
+
+// GetUserEmailStatus returns whether the email address of the user was reported as undeliverable.
+func (a *App) GetUserEmailStatus(userID string) (*model.UserEmailStatus, *model.AppError) {
+	user, appErr := a.GetUser(userID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	status := &model.UserEmailStatus{UserId: user.Id}
+	undeliverable, err := a.Srv().Store().UndeliverableEmail().Get(user.Email)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		if errors.As(err, &nfErr) {
+			return status, nil
+		}
+		return nil, model.NewAppError("GetUserEmailStatus", "app.user.get_email_status.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	status.Undeliverable = true
+	status.Reason = undeliverable.Reason
+	status.Detail = undeliverable.Detail
+	status.UpdateAt = undeliverable.UpdateAt
+	return status, nil
+}
+
+// ClearUserEmailUndeliverable resumes sending emails to the email address of the user.
+func (a *App) ClearUserEmailUndeliverable(userID string) *model.AppError {
+	user, appErr := a.GetUser(userID)
+	if appErr != nil {
+		return appErr
+	}
+
+	if err := a.Srv().Store().UndeliverableEmail().Delete(user.Email); err != nil {
+		return model.NewAppError("ClearUserEmailUndeliverable", "app.user.clear_email_undeliverable.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return nil
+}
@@ -109,6 +109,8 @@ func (a *App) runPluginsHook(c request.CTX, info *model.FileInfo, file io.Reader
 
 	if written > 0 {
 		info.Size = written
+		// The plugin rewrote the file so any previously computed hash is stale.
+		info.ContentHash = ""
 		if fileErr := a.MoveFile(tmpPath, info.Path); fileErr != nil {
 			return model.NewAppError("runPluginsHook", "app.upload.run_plugins_hook.move_fail",
 				nil, "", http.StatusInternalServerError).Wrap(fileErr)
@@ -303,6 +305,13 @@ func (a *App) UploadData(c request.CTX, us *model.UploadSession, rd io.Reader) (
 		if err := a.MoveFile(uploadPath, us.Path); err != nil {
 			return nil, model.NewAppError("UploadData", "app.upload.upload_data.move_file.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
 		}
+	} else {
+		if hash, hashErr := a.hashFileContent(info.Path); hashErr != nil {
+			c.Logger().Warn("Failed to compute file content hash", mlog.String("path", info.Path), mlog.Err(hashErr))
+		} else {
+			info.ContentHash = hash
+			a.deduplicateFile(c, info, true)
+		}
 	}
 
 	var storeErr error
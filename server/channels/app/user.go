@@ -14,6 +14,7 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -1756,6 +1757,10 @@ func (a *App) PermanentDeleteUser(c request.CTX, user *model.User) *model.AppErr
 		return model.NewAppError("PermanentDeleteUser", "app.preference.permanent_delete_by_user.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
 	}
 
+	if err := a.Srv().Store().CustomProfileAttribute().PermanentDeleteValuesByUser(user.Id); err != nil {
+		return model.NewAppError("PermanentDeleteUser", "app.user.permanentdeleteuser.internal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
 	if err := a.Srv().Store().Channel().PermanentDeleteMembersByUser(c, user.Id); err != nil {
 		return model.NewAppError("PermanentDeleteUser", "app.channel.permanent_delete_members_by_user.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
 	}
@@ -1787,32 +1792,7 @@ func (a *App) PermanentDeleteUser(c request.CTX, user *model.User) *model.AppErr
 		c.Logger().Warn("Error getting file list for user from FileInfoStore", mlog.Err(err))
 	}
 
-	for _, info := range infos {
-		res, err := a.FileExists(info.Path)
-		if err != nil {
-			c.Logger().Warn(
-				"Error checking existence of file",
-				mlog.String("path", info.Path),
-				mlog.Err(err),
-			)
-			continue
-		}
-
-		if !res {
-			c.Logger().Warn("File not found", mlog.String("path", info.Path))
-			continue
-		}
-
-		err = a.RemoveFile(info.Path)
-
-		if err != nil {
-			c.Logger().Warn(
-				"Unable to remove file",
-				mlog.String("path", info.Path),
-				mlog.Err(err),
-			)
-		}
-	}
+	a.RemoveFileInfoBlobs(c, infos)
 
 	// delete directory containing user's profile image
 	profileImageDirectory := getProfileImageDirectory(user.Id)
@@ -2472,6 +2452,11 @@ func (a *App) DemoteUserToGuest(c request.CTX, user *model.User) *model.AppError
 }
 
 func (a *App) PublishUserTyping(userID, channelID, parentId string) *model.AppError {
+	if window := *a.Config().ServiceSettings.TypingAggregationWindowMilliseconds; window > 0 {
+		a.ch.typingAggregator.add(channelID, parentId, userID, time.Duration(window)*time.Millisecond, a.publishUsersTyping)
+		return nil
+	}
+
 	omitUsers := make(map[string]bool, 1)
 	omitUsers[userID] = true
 
@@ -2483,6 +2468,20 @@ func (a *App) PublishUserTyping(userID, channelID, parentId string) *model.AppEr
 	return nil
 }
 
+// publishUsersTyping broadcasts the users who started typing in a channel, or a thread of it,
+// during an aggregation window.
+func (a *App) publishUsersTyping(channelID, parentId string, userIDs []string) {
+	var omitUsers map[string]bool
+	if len(userIDs) == 1 {
+		omitUsers = map[string]bool{userIDs[0]: true}
+	}
+
+	event := model.NewWebSocketEvent(model.WebsocketEventUsersTyping, "", channelID, "", omitUsers, "")
+	event.Add("parent_id", parentId)
+	event.Add("user_ids", userIDs)
+	a.Publish(event)
+}
+
 // invalidateUserCacheAndPublish Invalidates cache for a user and publishes user updated event
 func (a *App) invalidateUserCacheAndPublish(rctx request.CTX, userID string) {
 	a.InvalidateCacheForUser(userID)
@@ -0,0 +1,237 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"errors"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+)
+
+const (
+	// maxReportingChainDepth bounds the walk up the manager relationships so that
+	// deep or inconsistent org charts can't turn a lookup into an unbounded loop.
+	maxReportingChainDepth = 20
+
+	// maxTeamOfMentionSize is the maximum number of direct reports notified by a
+	// single @team-of: mention.
+	maxTeamOfMentionSize = 200
+)
+
+var teamOfMentionRegexp = regexp.MustCompile(`(?:^|[^\w@])@team-of:([a-z0-9.\-_]+)`)
+
+// SetUserManager sets the manager of a user, or clears it when managerID is empty.
+func (a *App) SetUserManager(rctx request.CTX, userID, managerID string) (*model.User, *model.AppError) {
+	user, appErr := a.GetUser(userID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if managerID != "" {
+		if managerID == userID {
+			return nil, model.NewAppError("SetUserManager", "app.user.set_manager.self.app_error", nil, "", http.StatusBadRequest)
+		}
+
+		manager, appErr := a.GetUser(managerID)
+		if appErr != nil {
+			if appErr.StatusCode == http.StatusNotFound {
+				return nil, model.NewAppError("SetUserManager", "app.user.set_manager.not_found.app_error", nil, "", http.StatusBadRequest).Wrap(appErr)
+			}
+			return nil, appErr
+		}
+		if manager.DeleteAt != 0 || manager.IsBot {
+			return nil, model.NewAppError("SetUserManager", "app.user.set_manager.not_found.app_error", nil, "", http.StatusBadRequest)
+		}
+
+		chain, appErr := a.GetReportingChain(managerID)
+		if appErr != nil {
+			return nil, appErr
+		}
+		for _, u := range chain {
+			if u.Id == userID {
+				return nil, model.NewAppError("SetUserManager", "app.user.set_manager.cycle.app_error", nil, "", http.StatusBadRequest)
+			}
+		}
+	}
+
+	if user.ManagerId == managerID {
+		return user, nil
+	}
+
+	if err := a.Srv().Store().User().UpdateManager(userID, managerID); err != nil {
+		return nil, model.NewAppError("SetUserManager", "app.user.update_manager.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	a.InvalidateCacheForUser(userID)
+
+	user, appErr = a.GetUser(userID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	a.sendUpdatedUserEvent(*user)
+
+	return user, nil
+}
+
+// GetReportingChain returns the managers of a user, starting with the direct manager
+// and ending with the top of the org chart.
+func (a *App) GetReportingChain(userID string) ([]*model.User, *model.AppError) {
+	user, appErr := a.GetUser(userID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	chain := []*model.User{}
+	seen := map[string]bool{userID: true}
+	for managerID := user.ManagerId; managerID != "" && !seen[managerID] && len(chain) < maxReportingChainDepth; {
+		seen[managerID] = true
+
+		manager, appErr := a.GetUser(managerID)
+		if appErr != nil {
+			if appErr.StatusCode == http.StatusNotFound {
+				break
+			}
+			return nil, appErr
+		}
+
+		chain = append(chain, manager)
+		managerID = manager.ManagerId
+	}
+
+	return chain, nil
+}
+
+// GetDirectReports returns the active users who report to the given user.
+func (a *App) GetDirectReports(userID string, page, perPage int) ([]*model.User, *model.AppError) {
+	users, err := a.Srv().Store().User().GetDirectReports(userID, page*perPage, perPage)
+	if err != nil {
+		return nil, model.NewAppError("GetDirectReports", "app.user.get_direct_reports.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return users, nil
+}
+
+// syncManagerFromLdap updates the manager of a user from the attribute configured in
+// LdapSettings.ManagerAttribute. The attribute may hold either the id attribute of the
+// manager or their distinguished name, in which case the first RDN is matched against
+// the id attribute and the username of the manager.
+func (a *App) syncManagerFromLdap(rctx request.CTX, user *model.User) {
+	attribute := *a.Config().LdapSettings.ManagerAttribute
+	if a.Ldap() == nil || user.AuthData == nil || attribute == "" {
+		return
+	}
+
+	values, appErr := a.Ldap().GetUserAttributes(rctx, *user.AuthData, []string{attribute})
+	if appErr != nil {
+		rctx.Logger().Warn("Failed to get the LDAP manager attribute of the user", mlog.String("user_id", user.Id), mlog.Err(appErr))
+		return
+	}
+
+	managerID := ""
+	if value := values[attribute]; value != "" {
+		manager, err := a.findLdapManager(value)
+		if err != nil {
+			rctx.Logger().Warn("Failed to find the LDAP manager of the user", mlog.String("user_id", user.Id), mlog.Err(err))
+			return
+		}
+		if manager.Id != user.Id {
+			managerID = manager.Id
+		}
+	}
+
+	if managerID == user.ManagerId {
+		return
+	}
+
+	if err := a.Srv().Store().User().UpdateManager(user.Id, managerID); err != nil {
+		rctx.Logger().Warn("Failed to save the manager synchronized from LDAP", mlog.String("user_id", user.Id), mlog.Err(err))
+		return
+	}
+
+	a.InvalidateCacheForUser(user.Id)
+}
+
+func (a *App) findLdapManager(value string) (*model.User, error) {
+	candidates := []string{value}
+	rdn, _, _ := strings.Cut(value, ",")
+	if _, rdnValue, isDN := strings.Cut(rdn, "="); isDN && rdnValue != "" {
+		candidates = append(candidates, rdnValue)
+	}
+
+	var nfErr *store.ErrNotFound
+	for _, candidate := range candidates {
+		manager, err := a.Srv().Store().User().GetByAuth(model.NewString(candidate), model.UserAuthServiceLdap)
+		if err == nil {
+			return manager, nil
+		} else if !errors.As(err, &nfErr) {
+			return nil, err
+		}
+	}
+
+	return a.Srv().Store().User().GetByUsername(strings.ToLower(candidates[len(candidates)-1]))
+}
+
+// insertTeamOfMentions expands every @team-of:username mention in the post into the direct
+// reports of that user. Reports who are members of the channel are added to Mentions, the
+// others are added to OtherPotentialMentions. Like group mentions, they are ignored unless the
+// sender has the use_group_mentions permission in the channel.
+func (a *App) insertTeamOfMentions(c request.CTX, senderID string, post *model.Post, profileMap map[string]*model.User, mentions *MentionResults) *model.AppError {
+	matches := teamOfMentionRegexp.FindAllStringSubmatch(strings.ToLower(post.Message), -1)
+	if len(matches) == 0 {
+		return nil
+	}
+
+	if !a.HasPermissionToChannel(c, senderID, post.ChannelId, model.PermissionUseGroupMentions) {
+		return nil
+	}
+
+	if mentions.Mentions == nil {
+		mentions.Mentions = make(map[string]MentionType)
+	}
+
+	seen := map[string]bool{}
+	for _, match := range matches {
+		username := strings.TrimRight(match[1], ".-_")
+		if seen[username] {
+			continue
+		}
+		seen[username] = true
+
+		manager, err := a.Srv().Store().User().GetByUsername(username)
+		if err != nil {
+			var nfErr *store.ErrNotFound
+			if errors.As(err, &nfErr) {
+				continue
+			}
+			return model.NewAppError("insertTeamOfMentions", "app.user.get_by_username.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+
+		reports, appErr := a.GetDirectReports(manager.Id, 0, maxTeamOfMentionSize)
+		if appErr != nil {
+			return appErr
+		}
+
+		for _, report := range reports {
+			if report.Id == senderID {
+				continue
+			}
+			if _, ok := profileMap[report.Id]; ok {
+				if _, mentioned := mentions.Mentions[report.Id]; !mentioned {
+					mentions.Mentions[report.Id] = GroupMention
+				}
+			} else {
+				mentions.OtherPotentialMentions = append(mentions.OtherPotentialMentions, report.Username)
+			}
+		}
+	}
+
+	return nil
+}
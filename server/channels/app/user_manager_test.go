@@ -0,0 +1,127 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+func TestSetUserManager(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	ceo := th.CreateUser()
+	vp := th.CreateUser()
+	engineer := th.CreateUser()
+
+	_, appErr := th.App.SetUserManager(th.Context, vp.Id, ceo.Id)
+	require.Nil(t, appErr)
+	user, appErr := th.App.SetUserManager(th.Context, engineer.Id, vp.Id)
+	require.Nil(t, appErr)
+	assert.Equal(t, vp.Id, user.ManagerId)
+
+	t.Run("reporting chain", func(t *testing.T) {
+		chain, appErr := th.App.GetReportingChain(engineer.Id)
+		require.Nil(t, appErr)
+		require.Len(t, chain, 2)
+		assert.Equal(t, vp.Id, chain[0].Id)
+		assert.Equal(t, ceo.Id, chain[1].Id)
+
+		chain, appErr = th.App.GetReportingChain(ceo.Id)
+		require.Nil(t, appErr)
+		assert.Empty(t, chain)
+	})
+
+	t.Run("direct reports", func(t *testing.T) {
+		reports, appErr := th.App.GetDirectReports(ceo.Id, 0, 10)
+		require.Nil(t, appErr)
+		require.Len(t, reports, 1)
+		assert.Equal(t, vp.Id, reports[0].Id)
+	})
+
+	t.Run("cycles are rejected", func(t *testing.T) {
+		_, appErr := th.App.SetUserManager(th.Context, ceo.Id, engineer.Id)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.user.set_manager.cycle.app_error", appErr.Id)
+
+		_, appErr = th.App.SetUserManager(th.Context, ceo.Id, ceo.Id)
+		require.NotNil(t, appErr)
+		assert.Equal(t, http.StatusBadRequest, appErr.StatusCode)
+	})
+
+	t.Run("the manager must be active", func(t *testing.T) {
+		inactive := th.CreateUser()
+		_, appErr := th.App.UpdateActive(th.Context, inactive, false)
+		require.Nil(t, appErr)
+
+		_, appErr = th.App.SetUserManager(th.Context, engineer.Id, inactive.Id)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.user.set_manager.not_found.app_error", appErr.Id)
+
+		_, appErr = th.App.SetUserManager(th.Context, engineer.Id, model.NewId())
+		require.NotNil(t, appErr)
+		assert.Equal(t, http.StatusBadRequest, appErr.StatusCode)
+	})
+
+	t.Run("clear the manager", func(t *testing.T) {
+		user, appErr := th.App.SetUserManager(th.Context, engineer.Id, "")
+		require.Nil(t, appErr)
+		assert.Empty(t, user.ManagerId)
+
+		reports, appErr := th.App.GetDirectReports(vp.Id, 0, 10)
+		require.Nil(t, appErr)
+		assert.Empty(t, reports)
+	})
+}
+
+func TestInsertTeamOfMentions(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	manager := th.CreateUser()
+	inChannel := th.CreateUser()
+	outOfChannel := th.CreateUser()
+	for _, user := range []*model.User{inChannel, outOfChannel, th.BasicUser} {
+		_, appErr := th.App.SetUserManager(th.Context, user.Id, manager.Id)
+		require.Nil(t, appErr)
+	}
+
+	profileMap := map[string]*model.User{inChannel.Id: inChannel, th.BasicUser.Id: th.BasicUser}
+
+	t.Run("direct reports are mentioned", func(t *testing.T) {
+		mentions := &MentionResults{}
+		post := &model.Post{ChannelId: th.BasicChannel.Id, Message: "cc @team-of:" + manager.Username + "."}
+		require.Nil(t, th.App.insertTeamOfMentions(th.Context, th.BasicUser.Id, post, profileMap, mentions))
+
+		assert.Equal(t, map[string]MentionType{inChannel.Id: GroupMention}, mentions.Mentions)
+		assert.Equal(t, []string{outOfChannel.Username}, mentions.OtherPotentialMentions)
+	})
+
+	t.Run("unknown users and plain mentions are ignored", func(t *testing.T) {
+		mentions := &MentionResults{}
+		post := &model.Post{ChannelId: th.BasicChannel.Id, Message: "@team-of:nobody and @" + manager.Username + " and email@team-of:" + manager.Username}
+		require.Nil(t, th.App.insertTeamOfMentions(th.Context, th.BasicUser.Id, post, profileMap, mentions))
+
+		assert.Empty(t, mentions.Mentions)
+		assert.Empty(t, mentions.OtherPotentialMentions)
+	})
+
+	t.Run("requires the group mentions permission", func(t *testing.T) {
+		th.RemovePermissionFromRole(model.PermissionUseGroupMentions.Id, model.ChannelUserRoleId)
+		defer th.AddPermissionToRole(model.PermissionUseGroupMentions.Id, model.ChannelUserRoleId)
+
+		mentions := &MentionResults{}
+		post := &model.Post{ChannelId: th.BasicChannel.Id, Message: "cc @team-of:" + manager.Username}
+		require.Nil(t, th.App.insertTeamOfMentions(th.Context, th.BasicUser.Id, post, profileMap, mentions))
+
+		assert.Empty(t, mentions.Mentions)
+		assert.Empty(t, mentions.OtherPotentialMentions)
+	})
+}
@@ -247,3 +247,46 @@ func TestWebConnShouldSendEvent(t *testing.T) {
 	event3 := model.NewWebSocketEvent(model.WebsocketEventUpdateTeam, "wrongId", "", "", nil, "")
 	assert.False(t, basicUserWc.ShouldSendEvent(event3))
 }
+
+func TestWebConnShouldSendEventBotScope(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	scope, err := model.BotScope{ChannelIds: []string{th.BasicChannel.Id}}.Value()
+	require.NoError(t, err)
+	session, appErr := th.App.CreateSession(th.Context, &model.Session{
+		UserId: th.BasicUser.Id,
+		Roles:  th.BasicUser.GetRawRoles(),
+		Props:  model.StringMap{model.SessionPropBotScope: scope.(string)},
+	})
+	require.Nil(t, appErr)
+
+	wc := &platform.WebConn{
+		Platform: th.Server.Platform(),
+		Suite:    th.App,
+		UserId:   th.BasicUser.Id,
+		T:        i18n.T,
+	}
+	wc.SetConnectionID(model.NewId())
+	wc.SetSession(session)
+	wc.SetSessionToken(session.Token)
+	wc.SetSessionExpiresAt(session.ExpiresAt)
+
+	// BasicUser is a member of both channels, but only the first one is in the scope.
+	outOfScope := th.CreateChannel(th.Context, th.BasicTeam)
+
+	event := model.NewWebSocketEvent(model.WebsocketEventPosted, "", th.BasicChannel.Id, "", nil, "")
+	assert.True(t, wc.ShouldSendEvent(event), "should send the events of channels in the scope")
+
+	event = model.NewWebSocketEvent(model.WebsocketEventPosted, "", outOfScope.Id, "", nil, "")
+	assert.False(t, wc.ShouldSendEvent(event), "should not send the events of channels out of the scope")
+
+	event = model.NewWebSocketEvent(model.WebsocketEventChannelViewed, "", outOfScope.Id, th.BasicUser.Id, nil, "")
+	assert.False(t, wc.ShouldSendEvent(event), "should not send the events of channels out of the scope to the bot itself")
+
+	event = model.NewWebSocketEvent(model.WebsocketEventUpdateTeam, th.BasicTeam.Id, "", "", nil, "")
+	assert.False(t, wc.ShouldSendEvent(event), "should not send the events of teams out of the scope")
+
+	event = model.NewWebSocketEvent(model.WebsocketEventStatusChange, "", "", "", nil, "")
+	assert.True(t, wc.ShouldSendEvent(event), "should send the events of no team or channel")
+}
@@ -0,0 +1,236 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/platform/services/webpush"
+)
+
+const (
+	webPushTTL            = time.Hour
+	webPushRequestTimeout = 10 * time.Second
+)
+
+func (a *App) canSendWebPushNotifications() bool {
+	return *a.Config().EmailSettings.EnableWebPushNotifications
+}
+
+// GetWebPushVAPIDKey returns the public key browsers need to subscribe to the Web Push
+// notifications of the server. The asymmetric signing key of the server is used as VAPID key.
+func (a *App) GetWebPushVAPIDKey() (*model.WebPushVAPIDKey, *model.AppError) {
+	if !a.canSendWebPushNotifications() {
+		return nil, model.NewAppError("GetWebPushVAPIDKey", "api.web_push.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	publicKey, err := webpush.PublicKey(a.AsymmetricSigningKey())
+	if err != nil {
+		return nil, model.NewAppError("GetWebPushVAPIDKey", "api.web_push.vapid_key.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return &model.WebPushVAPIDKey{PublicKey: publicKey}, nil
+}
+
+// AttachWebPushSubscription stores the Web Push subscription of the browser of the session,
+// replacing any previous one.
+func (a *App) AttachWebPushSubscription(c request.CTX, session *model.Session, subscription *model.WebPushSubscription) *model.AppError {
+	if !a.canSendWebPushNotifications() {
+		return model.NewAppError("AttachWebPushSubscription", "api.web_push.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	if appErr := subscription.IsValid(); appErr != nil {
+		return appErr
+	}
+
+	value, err := json.Marshal(subscription)
+	if err != nil {
+		return model.NewAppError("AttachWebPushSubscription", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return a.updateWebPushSubscription(session, string(value))
+}
+
+// DetachWebPushSubscription removes the Web Push subscription of the session, if any.
+func (a *App) DetachWebPushSubscription(c request.CTX, session *model.Session) *model.AppError {
+	return a.updateWebPushSubscription(session, "")
+}
+
+func (a *App) updateWebPushSubscription(session *model.Session, value string) *model.AppError {
+	session = session.DeepCopy()
+	if value == "" {
+		delete(session.Props, model.SessionPropWebPushSubscription)
+	} else {
+		session.AddProp(model.SessionPropWebPushSubscription, value)
+	}
+
+	if err := a.Srv().Store().Session().UpdateProps(session); err != nil {
+		return model.NewAppError("updateWebPushSubscription", "app.session.update_props.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	a.ClearSessionCacheForUser(session.UserId)
+
+	return nil
+}
+
+func (a *App) getWebPushSessions(c request.CTX, userID string) ([]*model.Session, *model.AppError) {
+	sessions, appErr := a.GetSessions(c, userID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	webPushSessions := make([]*model.Session, 0, len(sessions))
+	for _, session := range sessions {
+		if session.IsExpired() || session.Props[model.SessionPropWebPushSubscription] == "" {
+			continue
+		}
+		webPushSessions = append(webPushSessions, session)
+	}
+
+	return webPushSessions, nil
+}
+
+func (a *App) webPushSubject() string {
+	siteURL := a.GetSiteURL()
+	if strings.HasPrefix(siteURL, "https://") {
+		return siteURL
+	}
+	if feedbackEmail := *a.Config().EmailSettings.FeedbackEmail; feedbackEmail != "" {
+		return "mailto:" + feedbackEmail
+	}
+	return siteURL
+}
+
+// buildWebPushPayload returns the notification shown by the service worker of the web app,
+// shortening its body so that it fits in a single push message.
+func (a *App) buildWebPushPayload(msg *model.PushNotification) ([]byte, error) {
+	notification := &model.WebPushNotification{
+		Title:     msg.ChannelName,
+		Body:      msg.Message,
+		PostId:    msg.PostId,
+		ChannelId: msg.ChannelId,
+		RootId:    msg.RootId,
+		TeamId:    msg.TeamId,
+		SenderId:  msg.SenderId,
+	}
+	if notification.Title == "" {
+		notification.Title = *a.Config().TeamSettings.SiteName
+	}
+
+	for {
+		payload, err := json.Marshal(notification)
+		if err != nil {
+			return nil, err
+		}
+		excess := len(payload) - webpush.MaxPayloadSize
+		if excess <= 0 {
+			return payload, nil
+		}
+		if notification.Body == "" {
+			return nil, errors.New("notification too large")
+		}
+
+		body := strings.TrimSuffix(notification.Body, "…")
+		if size := len(body) - excess - len("…"); size > 0 {
+			body = body[:size]
+		} else {
+			body = ""
+		}
+		for !utf8.ValidString(body) {
+			body = body[:len(body)-1]
+		}
+		if body != "" {
+			body += "…"
+		}
+		notification.Body = body
+	}
+}
+
+// sendWebPushNotifications sends the notification to the browsers subscribed to the Web Push
+// notifications of the user. Subscriptions reported as expired by their push service are removed.
+func (a *App) sendWebPushNotifications(c request.CTX, msg *model.PushNotification, userID string, skipSessionId string) *model.AppError {
+	sessions, appErr := a.getWebPushSessions(c, userID)
+	if appErr != nil {
+		return appErr
+	}
+	if len(sessions) == 0 {
+		return nil
+	}
+
+	payload, err := a.buildWebPushPayload(msg)
+	if err != nil {
+		return model.NewAppError("sendWebPushNotifications", "api.web_push.payload.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	// Endpoints are provided by the browsers, so they are called with the untrusted client.
+	client := a.HTTPService().MakeClient(false)
+	options := webpush.Options{
+		Subject: a.webPushSubject(),
+		TTL:     webPushTTL,
+		Urgency: "high",
+		Topic:   msg.ChannelId,
+	}
+
+	for _, session := range sessions {
+		if skipSessionId != "" && skipSessionId == session.Id {
+			continue
+		}
+
+		subscription := model.WebPushSubscriptionFromSession(session)
+		if subscription == nil {
+			continue
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), webPushRequestTimeout)
+		err := webpush.Send(ctx, client, &webpush.Subscription{
+			Endpoint: subscription.Endpoint,
+			P256dh:   subscription.Keys.P256dh,
+			Auth:     subscription.Keys.Auth,
+		}, payload, a.AsymmetricSigningKey(), options)
+		cancel()
+
+		if errors.Is(err, webpush.ErrSubscriptionExpired) {
+			a.NotificationsLog().Debug("Web push subscription expired",
+				mlog.String("type", model.NotificationTypePush),
+				mlog.String("status", model.NotificationStatusNotSent),
+				mlog.String("user_id", session.UserId),
+				mlog.String("session_id", session.Id),
+			)
+			if appErr := a.DetachWebPushSubscription(c, session); appErr != nil {
+				c.Logger().Warn("Failed to remove expired web push subscription", mlog.String("session_id", session.Id), mlog.Err(appErr))
+			}
+			a.RecordNotificationDelivery(session.UserId, msg.PostId, msg.ChannelId, model.NotificationTypeWebPush, model.NotificationStatusNotSent, model.NotificationReasonSessionExpired, err.Error())
+			continue
+		} else if err != nil {
+			a.NotificationsLog().Error("Failed to send web push notification",
+				mlog.String("type", model.NotificationTypePush),
+				mlog.String("status", model.NotificationStatusNotSent),
+				mlog.String("user_id", session.UserId),
+				mlog.String("session_id", session.Id),
+				mlog.Err(err),
+			)
+			a.RecordNotificationDelivery(session.UserId, msg.PostId, msg.ChannelId, model.NotificationTypeWebPush, model.NotificationStatusError, "", err.Error())
+			continue
+		}
+
+		a.NotificationsLog().Trace("Web push notification sent",
+			mlog.String("type", model.NotificationTypePush),
+			mlog.String("post_id", msg.PostId),
+			mlog.String("user_id", session.UserId),
+			mlog.String("session_id", session.Id),
+			mlog.String("status", model.PushSendSuccess),
+		)
+		a.RecordNotificationDelivery(session.UserId, msg.PostId, msg.ChannelId, model.NotificationTypeWebPush, model.NotificationStatusSuccess, "", "")
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/v8/platform/services/webpush"
+)
+
+func TestBuildWebPushPayload(t *testing.T) {
+	th := Setup(t)
+	defer th.TearDown()
+
+	msg := &model.PushNotification{
+		Type:        model.PushTypeMessage,
+		ChannelId:   model.NewId(),
+		PostId:      model.NewId(),
+		ChannelName: "Town Square",
+		Message:     "@sender: hello",
+	}
+
+	t.Run("short message", func(t *testing.T) {
+		payload, err := th.App.buildWebPushPayload(msg)
+		require.NoError(t, err)
+
+		var notification model.WebPushNotification
+		require.NoError(t, json.Unmarshal(payload, &notification))
+		assert.Equal(t, "Town Square", notification.Title)
+		assert.Equal(t, "@sender: hello", notification.Body)
+		assert.Equal(t, msg.PostId, notification.PostId)
+	})
+
+	t.Run("id loaded message uses the site name", func(t *testing.T) {
+		idLoaded := *msg
+		idLoaded.ChannelName = ""
+		payload, err := th.App.buildWebPushPayload(&idLoaded)
+		require.NoError(t, err)
+
+		var notification model.WebPushNotification
+		require.NoError(t, json.Unmarshal(payload, &notification))
+		assert.Equal(t, *th.App.Config().TeamSettings.SiteName, notification.Title)
+	})
+
+	t.Run("long message is shortened", func(t *testing.T) {
+		long := *msg
+		long.Message = strings.Repeat("héllo \"world\" ", 1000)
+		payload, err := th.App.buildWebPushPayload(&long)
+		require.NoError(t, err)
+		assert.LessOrEqual(t, len(payload), webpush.MaxPayloadSize)
+
+		var notification model.WebPushNotification
+		require.NoError(t, json.Unmarshal(payload, &notification))
+		assert.True(t, utf8.ValidString(notification.Body))
+		assert.True(t, strings.HasSuffix(notification.Body, "…"))
+		assert.True(t, strings.HasPrefix(long.Message, strings.TrimSuffix(notification.Body, "…")))
+	})
+}
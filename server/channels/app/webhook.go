@@ -9,8 +9,10 @@ import (
 	"encoding/json"
 	"errors"
 	"io"
+	"math"
 	"net/http"
 	"regexp"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -95,28 +97,15 @@ func (a *App) handleWebhookEvents(c request.CTX, post *model.Post, team *model.T
 }
 
 func (a *App) TriggerWebhook(c request.CTX, payload *model.OutgoingWebhookPayload, hook *model.OutgoingWebhook, post *model.Post, channel *model.Channel) {
-	var jsonBytes []byte
-	var err error
-
-	contentType := "application/x-www-form-urlencoded"
-	if hook.ContentType == "application/json" {
-		contentType = "application/json"
-		jsonBytes, err = json.Marshal(payload)
-		if err != nil {
-			c.Logger().Warn("Failed to encode to JSON", mlog.Err(err))
-			return
-		}
+	contentType, body, err := encodeOutgoingWebhookPayload(hook, payload)
+	if err != nil {
+		c.Logger().Warn("Failed to encode to JSON", mlog.Err(err))
+		return
 	}
 
 	var wg sync.WaitGroup
 
 	for i := range hook.CallbackURLs {
-		var body io.Reader
-		if hook.ContentType == "application/json" {
-			body = bytes.NewReader(jsonBytes)
-		} else {
-			body = strings.NewReader(payload.ToFormValues())
-		}
 		wg.Add(1)
 
 		// Get the callback URL by index to properly capture it for the go func
@@ -125,102 +114,171 @@ func (a *App) TriggerWebhook(c request.CTX, payload *model.OutgoingWebhookPayloa
 		go func() {
 			defer wg.Done()
 
-			var accessToken *model.OutgoingOAuthConnectionToken
+			a.deliverOutgoingWebhook(c, hook, url, contentType, body, post, channel, "")
+		}()
+	}
+	wg.Wait()
+}
+
+func encodeOutgoingWebhookPayload(hook *model.OutgoingWebhook, payload *model.OutgoingWebhookPayload) (string, string, error) {
+	if hook.ContentType == "application/json" {
+		jsonBytes, err := json.Marshal(payload)
+		if err != nil {
+			return "", "", err
+		}
+		return "application/json", string(jsonBytes), nil
+	}
 
-			// Retrieve an access token from a connection if one exists to use for the webhook request
-			if a.Config().ServiceSettings.EnableOutgoingOAuthConnections != nil && *a.Config().ServiceSettings.EnableOutgoingOAuthConnections && a.OutgoingOAuthConnections() != nil {
-				connection, err := a.OutgoingOAuthConnections().GetConnectionForAudience(c, url)
-				if err != nil {
-					c.Logger().Error("Failed to find an outgoing oauth connection for the webhook", mlog.Err(err))
-					return
-				}
+	return "application/x-www-form-urlencoded", payload.ToFormValues(), nil
+}
 
-				if connection != nil {
-					accessToken, err = a.OutgoingOAuthConnections().RetrieveTokenForConnection(c, connection)
-					if err != nil {
-						c.Logger().Error("Failed to retrieve token for outgoing oauth connection", mlog.Err(err))
-						return
-					}
-				}
-			}
+// deliverOutgoingWebhook sends the payload to a callback URL of the outgoing webhook and posts
+// the response of the callback in the channel. The delivery is recorded if the integration
+// delivery log is enabled.
+func (a *App) deliverOutgoingWebhook(c request.CTX, hook *model.OutgoingWebhook, url, contentType, body string, post *model.Post, channel *model.Channel, replayOf string) *model.IntegrationDelivery {
+	delivery := &model.IntegrationDelivery{
+		HookId:      hook.Id,
+		Type:        model.IntegrationDeliveryTypeOutgoingWebhook,
+		URL:         url,
+		ContentType: contentType,
+		Payload:     body,
+		UserId:      post.UserId,
+		ChannelId:   channel.Id,
+		PostId:      post.Id,
+		ReplayOf:    replayOf,
+	}
+	defer a.recordIntegrationDelivery(c, delivery)
+
+	var accessToken *model.OutgoingOAuthConnectionToken
+
+	// Retrieve an access token from a connection if one exists to use for the webhook request
+	if a.Config().ServiceSettings.EnableOutgoingOAuthConnections != nil && *a.Config().ServiceSettings.EnableOutgoingOAuthConnections && a.OutgoingOAuthConnections() != nil {
+		connection, err := a.OutgoingOAuthConnections().GetConnectionForAudience(c, url)
+		if err != nil {
+			c.Logger().Error("Failed to find an outgoing oauth connection for the webhook", mlog.Err(err))
+			delivery.Error = err.Error()
+			return delivery
+		}
 
-			webhookResp, err := a.doOutgoingWebhookRequest(url, body, contentType, accessToken)
+		if connection != nil {
+			accessToken, err = a.OutgoingOAuthConnections().RetrieveTokenForConnection(c, connection)
 			if err != nil {
-				if errors.Is(err, context.DeadlineExceeded) {
-					c.Logger().Error("Outgoing Webhook POST timed out. Consider increasing ServiceSettings.OutgoingIntegrationRequestsTimeout.", mlog.Err(err))
-				} else {
-					c.Logger().Error("Outgoing Webhook POST failed", mlog.Err(err))
-				}
-				return
+				c.Logger().Error("Failed to retrieve token for outgoing oauth connection", mlog.Err(err))
+				delivery.Error = err.Error()
+				return delivery
 			}
+		}
+	}
 
-			if webhookResp != nil && (webhookResp.Text != nil || len(webhookResp.Attachments) > 0) {
-				postRootId := ""
-				if webhookResp.ResponseType == model.OutgoingHookResponseTypeComment {
-					postRootId = post.Id
-				}
-				if len(webhookResp.Props) == 0 {
-					webhookResp.Props = make(model.StringInterface)
-				}
-				webhookResp.Props["webhook_display_name"] = hook.DisplayName
+	webhookResp, err := a.doOutgoingWebhookRequest(url, body, contentType, hook.SigningSecret, accessToken, delivery)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			c.Logger().Error("Outgoing Webhook POST timed out. Consider increasing ServiceSettings.OutgoingIntegrationRequestsTimeout.", mlog.Err(err))
+		} else {
+			c.Logger().Error("Outgoing Webhook POST failed", mlog.Err(err))
+		}
+		return delivery
+	}
 
-				text := ""
-				if webhookResp.Text != nil {
-					text = a.ProcessSlackText(*webhookResp.Text)
-				}
-				webhookResp.Attachments = a.ProcessSlackAttachments(webhookResp.Attachments)
-				// attachments is in here for slack compatibility
-				if len(webhookResp.Attachments) > 0 {
-					webhookResp.Props["attachments"] = webhookResp.Attachments
-				}
-				if *a.Config().ServiceSettings.EnablePostUsernameOverride && hook.Username != "" && webhookResp.Username == "" {
-					webhookResp.Username = hook.Username
-				}
+	if webhookResp != nil && (webhookResp.Text != nil || len(webhookResp.Attachments) > 0) {
+		postRootId := ""
+		if webhookResp.ResponseType == model.OutgoingHookResponseTypeComment {
+			postRootId = post.Id
+		}
+		if len(webhookResp.Props) == 0 {
+			webhookResp.Props = make(model.StringInterface)
+		}
+		webhookResp.Props["webhook_display_name"] = hook.DisplayName
 
-				if *a.Config().ServiceSettings.EnablePostIconOverride && hook.IconURL != "" && webhookResp.IconURL == "" {
-					webhookResp.IconURL = hook.IconURL
-				}
-				if _, err := a.CreateWebhookPost(c, hook.CreatorId, channel, text, webhookResp.Username, webhookResp.IconURL, "", webhookResp.Props, webhookResp.Type, postRootId, webhookResp.Priority); err != nil {
-					c.Logger().Error("Failed to create response post.", mlog.Err(err))
-				}
-			}
-		}()
+		text := ""
+		if webhookResp.Text != nil {
+			text = a.ProcessSlackText(*webhookResp.Text)
+		}
+		webhookResp.Attachments = a.ProcessSlackAttachments(webhookResp.Attachments)
+		// attachments is in here for slack compatibility
+		if len(webhookResp.Attachments) > 0 {
+			webhookResp.Props["attachments"] = webhookResp.Attachments
+		}
+		if *a.Config().ServiceSettings.EnablePostUsernameOverride && hook.Username != "" && webhookResp.Username == "" {
+			webhookResp.Username = hook.Username
+		}
+
+		if *a.Config().ServiceSettings.EnablePostIconOverride && hook.IconURL != "" && webhookResp.IconURL == "" {
+			webhookResp.IconURL = hook.IconURL
+		}
+		if _, err := a.CreateWebhookPost(c, hook.CreatorId, channel, text, webhookResp.Username, webhookResp.IconURL, "", webhookResp.Props, webhookResp.Type, postRootId, webhookResp.Priority); err != nil {
+			c.Logger().Error("Failed to create response post.", mlog.Err(err))
+		}
 	}
-	wg.Wait()
+
+	return delivery
 }
 
-func (a *App) doOutgoingWebhookRequest(url string, body io.Reader, contentType string, accessToken *model.OutgoingOAuthConnectionToken) (*model.OutgoingWebhookResponse, error) {
+// signIntegrationRequest adds the X-Mattermost-Signature and X-Mattermost-Request-Timestamp
+// headers to a request of an outgoing webhook or slash command, unless it has no signing secret.
+func signIntegrationRequest(req *http.Request, signingSecret string, body []byte) {
+	if signingSecret == "" {
+		return
+	}
+
+	timestamp := time.Now().Unix()
+	req.Header.Set(model.HeaderIntegrationTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(model.HeaderIntegrationSignature, model.SignIntegrationRequest(signingSecret, timestamp, body))
+}
+
+// doOutgoingWebhookRequest sends a request to the callback URL of an outgoing webhook, and
+// fills the delivery, if not nil, with the outcome of the request.
+func (a *App) doOutgoingWebhookRequest(url string, body string, contentType string, signingSecret string, accessToken *model.OutgoingOAuthConnectionToken, delivery *model.IntegrationDelivery) (hookResp *model.OutgoingWebhookResponse, err error) {
+	if delivery == nil {
+		delivery = &model.IntegrationDelivery{}
+	}
+	defer func() {
+		if err != nil {
+			delivery.Error = err.Error()
+		}
+	}()
+
 	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(*a.Config().ServiceSettings.OutgoingIntegrationRequestsTimeout)*time.Second)
 	defer cancel()
 
-	req, err := http.NewRequestWithContext(ctx, "POST", url, body)
+	req, err := http.NewRequestWithContext(ctx, "POST", url, strings.NewReader(body))
 	if err != nil {
 		return nil, err
 	}
 
 	req.Header.Set("Content-Type", contentType)
 	req.Header.Set("Accept", "application/json")
+	signIntegrationRequest(req, signingSecret, []byte(body))
 
 	if accessToken != nil {
 		req.Header.Add("Authorization", accessToken.AsHeaderValue())
 	}
 
+	start := time.Now()
 	resp, err := a.Srv().outgoingWebhookClient.Do(req)
 	if err != nil {
+		delivery.Latency = time.Since(start).Milliseconds()
 		return nil, err
 	}
 
 	defer resp.Body.Close()
 
-	var hookResp model.OutgoingWebhookResponse
-	if jsonErr := json.NewDecoder(io.LimitReader(resp.Body, MaxIntegrationResponseSize)).Decode(&hookResp); jsonErr != nil {
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, MaxIntegrationResponseSize))
+	delivery.Latency = time.Since(start).Milliseconds()
+	delivery.StatusCode = resp.StatusCode
+	delivery.Response = string(respBody)
+	if err != nil {
+		return nil, err
+	}
+
+	if jsonErr := json.NewDecoder(bytes.NewReader(respBody)).Decode(&hookResp); jsonErr != nil {
 		if jsonErr == io.EOF {
 			return nil, nil
 		}
 		return nil, model.NewAppError("doOutgoingWebhookRequest", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(jsonErr)
 	}
 
-	return &hookResp, nil
+	return hookResp, nil
 }
 
 func SplitWebhookPost(post *model.Post, maxPostSize int) ([]*model.Post, *model.AppError) {
@@ -605,6 +663,7 @@ func (a *App) UpdateOutgoingWebhook(c request.CTX, oldHook, updatedHook *model.O
 	updatedHook.CreateAt = oldHook.CreateAt
 	updatedHook.DeleteAt = oldHook.DeleteAt
 	updatedHook.TeamId = oldHook.TeamId
+	updatedHook.SigningSecret = oldHook.SigningSecret
 	updatedHook.UpdateAt = model.GetMillis()
 
 	webhook, err := a.Srv().Store().Webhook().UpdateOutgoing(updatedHook)
@@ -690,6 +749,10 @@ func (a *App) DeleteOutgoingWebhook(hookID string) *model.AppError {
 		return model.NewAppError("DeleteOutgoingWebhook", "app.webhooks.delete_outgoing.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
 	}
 
+	if err := a.Srv().Store().IntegrationDelivery().PermanentDeleteByHook(hookID); err != nil {
+		a.Log().Warn("Failed to delete the integration deliveries of the outgoing webhook", mlog.String("hook_id", hookID), mlog.Err(err))
+	}
+
 	return nil
 }
 
@@ -708,6 +771,30 @@ func (a *App) RegenOutgoingWebhookToken(hook *model.OutgoingWebhook) (*model.Out
 	return webhook, nil
 }
 
+func (a *App) RegenOutgoingWebhookSigningSecret(hook *model.OutgoingWebhook) (*model.OutgoingWebhook, *model.AppError) {
+	if !*a.Config().ServiceSettings.EnableOutgoingWebhooks {
+		return nil, model.NewAppError("RegenOutgoingWebhookSigningSecret", "api.outgoing_webhook.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	hook.SigningSecret = model.NewIntegrationSigningSecret()
+
+	webhook, err := a.Srv().Store().Webhook().UpdateOutgoing(hook)
+	if err != nil {
+		return nil, model.NewAppError("RegenOutgoingWebhookSigningSecret", "app.webhooks.update_outgoing.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return webhook, nil
+}
+
+// incomingWebhookRateLimit returns the number of posts per minute allowed for an incoming
+// webhook, or zero if unlimited. The limit of the webhook can only lower the server limit.
+func incomingWebhookRateLimit(serverLimit, hookLimit int) int {
+	if hookLimit > 0 && (serverLimit == 0 || hookLimit < serverLimit) {
+		return hookLimit
+	}
+	return serverLimit
+}
+
 func (a *App) HandleIncomingWebhook(c request.CTX, hookID string, req *model.IncomingWebhookRequest) *model.AppError {
 	if !*a.Config().ServiceSettings.EnableIncomingWebhooks {
 		return model.NewAppError("HandleIncomingWebhook", "web.incoming_webhook.disabled.app_error", nil, "", http.StatusNotImplemented)
@@ -739,6 +826,25 @@ func (a *App) HandleIncomingWebhook(c request.CTX, hookID string, req *model.Inc
 	}
 	hook = result.Data
 
+	if perMinute := incomingWebhookRateLimit(*a.Config().ServiceSettings.IncomingWebhookRateLimitPerMinute, hook.RateLimitPerMinute); perMinute > 0 {
+		limited, retryAfter, err := a.Srv().incomingWebhookRateLimiter.RateLimit(hook.Id, perMinute)
+		if err != nil {
+			c.Logger().Warn("Failed to rate limit incoming webhook", mlog.String("webhook_id", hook.Id), mlog.Err(err))
+		} else if limited {
+			return model.NewAppError("HandleIncomingWebhook", "web.incoming_webhook.rate_limited.app_error", map[string]any{"Limit": perMinute, "RetryAfter": int(math.Ceil(retryAfter.Seconds()))}, "", http.StatusTooManyRequests)
+		}
+	}
+
+	if *a.Config().ServiceSettings.ValidateIncomingWebhookAttachments {
+		if errs := model.ValidateSlackAttachments(req.Attachments); len(errs) > 0 {
+			messages := make([]string, len(errs))
+			for i, err := range errs {
+				messages[i] = err.Error()
+			}
+			return model.NewAppError("HandleIncomingWebhook", "web.incoming_webhook.attachments.app_error", map[string]any{"Errors": strings.Join(messages, "; ")}, "", http.StatusBadRequest)
+		}
+	}
+
 	uchan := make(chan store.StoreResult[*model.User], 1)
 	go func() {
 		user, err := a.Srv().Store().User().Get(context.Background(), hook.UserId)
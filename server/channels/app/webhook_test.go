@@ -285,6 +285,14 @@ func TestUpdateIncomingWebhook(t *testing.T) {
 	}
 }
 
+func TestIncomingWebhookRateLimit(t *testing.T) {
+	assert.Equal(t, 0, incomingWebhookRateLimit(0, 0), "unlimited by default")
+	assert.Equal(t, 60, incomingWebhookRateLimit(60, 0), "server limit applies to webhooks without one")
+	assert.Equal(t, 10, incomingWebhookRateLimit(0, 10), "webhook limit applies when the server has none")
+	assert.Equal(t, 10, incomingWebhookRateLimit(60, 10), "webhook limit can lower the server limit")
+	assert.Equal(t, 60, incomingWebhookRateLimit(60, 100), "webhook limit can't raise the server limit")
+}
+
 func TestCreateWebhookPost(t *testing.T) {
 	testCluster := &testlib.FakeClusterInterface{}
 	th := SetupWithClusterMock(t, testCluster).InitBasic()
@@ -927,7 +935,7 @@ func TestDoOutgoingWebhookRequest(t *testing.T) {
 		}))
 		defer server.Close()
 
-		resp, err := th.App.doOutgoingWebhookRequest(server.URL, strings.NewReader(""), "application/json", nil)
+		resp, err := th.App.doOutgoingWebhookRequest(server.URL, "", "application/json", "", nil, nil)
 		require.NoError(t, err)
 
 		require.NotNil(t, resp)
@@ -935,13 +943,41 @@ func TestDoOutgoingWebhookRequest(t *testing.T) {
 		assert.Equal(t, "Hello, World!", *resp.Text)
 	})
 
+	t.Run("with a signing secret", func(t *testing.T) {
+		secret := model.NewIntegrationSigningSecret()
+		body := `{"text": "signed"}`
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			received, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			assert.True(t, model.VerifyIntegrationSignature(secret, r.Header.Get(model.HeaderIntegrationTimestamp), received, r.Header.Get(model.HeaderIntegrationSignature)))
+			io.Copy(w, strings.NewReader(`{"text": "Hello, World!"}`))
+		}))
+		defer server.Close()
+
+		_, err := th.App.doOutgoingWebhookRequest(server.URL, body, "application/json", secret, nil, nil)
+		require.NoError(t, err)
+	})
+
+	t.Run("without a signing secret", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Empty(t, r.Header.Get(model.HeaderIntegrationSignature))
+			assert.Empty(t, r.Header.Get(model.HeaderIntegrationTimestamp))
+			io.Copy(w, strings.NewReader(`{"text": "Hello, World!"}`))
+		}))
+		defer server.Close()
+
+		_, err := th.App.doOutgoingWebhookRequest(server.URL, "", "application/json", "", nil, nil)
+		require.NoError(t, err)
+	})
+
 	t.Run("with an invalid response", func(t *testing.T) {
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			io.Copy(w, strings.NewReader("aaaaaaaa"))
 		}))
 		defer server.Close()
 
-		_, err := th.App.doOutgoingWebhookRequest(server.URL, strings.NewReader(""), "application/json", nil)
+		_, err := th.App.doOutgoingWebhookRequest(server.URL, "", "application/json", "", nil, nil)
 		require.Error(t, err)
 		require.Equal(t, "api.unmarshal_error", err.(*model.AppError).Id)
 	})
@@ -952,7 +988,7 @@ func TestDoOutgoingWebhookRequest(t *testing.T) {
 		}))
 		defer server.Close()
 
-		_, err := th.App.doOutgoingWebhookRequest(server.URL, strings.NewReader(""), "application/json", nil)
+		_, err := th.App.doOutgoingWebhookRequest(server.URL, "", "application/json", "", nil, nil)
 		require.Error(t, err)
 		require.Equal(t, "api.unmarshal_error", err.(*model.AppError).Id)
 	})
@@ -963,7 +999,7 @@ func TestDoOutgoingWebhookRequest(t *testing.T) {
 		}))
 		defer server.Close()
 
-		_, err := th.App.doOutgoingWebhookRequest(server.URL, strings.NewReader(""), "application/json", nil)
+		_, err := th.App.doOutgoingWebhookRequest(server.URL, "", "application/json", "", nil, nil)
 		require.Error(t, err)
 		require.Equal(t, "api.unmarshal_error", err.(*model.AppError).Id)
 	})
@@ -982,7 +1018,7 @@ func TestDoOutgoingWebhookRequest(t *testing.T) {
 			cfg.ServiceSettings.OutgoingIntegrationRequestsTimeout = model.NewInt64(1)
 		})
 
-		_, err := th.App.doOutgoingWebhookRequest(server.URL, strings.NewReader(""), "application/json", nil)
+		_, err := th.App.doOutgoingWebhookRequest(server.URL, "", "application/json", "", nil, nil)
 		require.Error(t, err)
 		require.IsType(t, &url.Error{}, err)
 	})
@@ -999,7 +1035,7 @@ func TestDoOutgoingWebhookRequest(t *testing.T) {
 			cfg.ServiceSettings.OutgoingIntegrationRequestsTimeout = model.NewInt64(2)
 		})
 
-		resp, err := th.App.doOutgoingWebhookRequest(server.URL, strings.NewReader(""), "application/json", nil)
+		resp, err := th.App.doOutgoingWebhookRequest(server.URL, "", "application/json", "", nil, nil)
 		require.NoError(t, err)
 		require.NotNil(t, resp)
 		assert.NotNil(t, resp.Text)
@@ -1011,7 +1047,7 @@ func TestDoOutgoingWebhookRequest(t *testing.T) {
 		}))
 		defer server.Close()
 
-		resp, err := th.App.doOutgoingWebhookRequest(server.URL, strings.NewReader(""), "application/json", nil)
+		resp, err := th.App.doOutgoingWebhookRequest(server.URL, "", "application/json", "", nil, nil)
 		require.NoError(t, err)
 		require.Nil(t, resp)
 	})
@@ -1022,10 +1058,10 @@ func TestDoOutgoingWebhookRequest(t *testing.T) {
 		}))
 		defer server.Close()
 
-		resp, err := th.App.doOutgoingWebhookRequest(server.URL, strings.NewReader(""), "application/json", &model.OutgoingOAuthConnectionToken{
+		resp, err := th.App.doOutgoingWebhookRequest(server.URL, "", "application/json", "", &model.OutgoingOAuthConnectionToken{
 			AccessToken: "test",
 			TokenType:   "Bearer",
-		})
+		}, nil)
 		require.NoError(t, err)
 		require.Equal(t, `Bearer test`, *resp.Text)
 	})
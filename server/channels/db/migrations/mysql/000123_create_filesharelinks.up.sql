@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS FileShareLinks (
+    Id varchar(26) NOT NULL,
+    FileId varchar(26) NOT NULL,
+    CreatorId varchar(26) NOT NULL,
+    CreateAt bigint(20) NOT NULL DEFAULT 0,
+    ExpireAt bigint(20) NOT NULL DEFAULT 0,
+    DeleteAt bigint(20) NOT NULL DEFAULT 0,
+    Password varchar(128) NOT NULL DEFAULT '',
+    MaxDownloads bigint(20) NOT NULL DEFAULT 0,
+    DownloadCount bigint(20) NOT NULL DEFAULT 0,
+    PRIMARY KEY (Id),
+    KEY idx_filesharelinks_file_id (FileId),
+    KEY idx_filesharelinks_expire_at (ExpireAt)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,44 @@
+SET @preparedStatement = (SELECT IF(
+    EXISTS(
+        SELECT 1 FROM INFORMATION_SCHEMA.COLUMNS
+        WHERE table_name = 'Compliances'
+        AND table_schema = DATABASE()
+        AND column_name = 'Incremental'
+    ) > 0,
+    'ALTER TABLE Compliances DROP COLUMN Incremental;',
+    'SELECT 1;'
+));
+
+PREPARE removeColumnIfExists FROM @preparedStatement;
+EXECUTE removeColumnIfExists;
+DEALLOCATE PREPARE removeColumnIfExists;
+
+SET @preparedStatement = (SELECT IF(
+    EXISTS(
+        SELECT 1 FROM INFORMATION_SCHEMA.COLUMNS
+        WHERE table_name = 'Compliances'
+        AND table_schema = DATABASE()
+        AND column_name = 'LastPostUpdateAt'
+    ) > 0,
+    'ALTER TABLE Compliances DROP COLUMN LastPostUpdateAt;',
+    'SELECT 1;'
+));
+
+PREPARE removeColumnIfExists FROM @preparedStatement;
+EXECUTE removeColumnIfExists;
+DEALLOCATE PREPARE removeColumnIfExists;
+
+SET @preparedStatement = (SELECT IF(
+    EXISTS(
+        SELECT 1 FROM INFORMATION_SCHEMA.COLUMNS
+        WHERE table_name = 'Compliances'
+        AND table_schema = DATABASE()
+        AND column_name = 'LastPostId'
+    ) > 0,
+    'ALTER TABLE Compliances DROP COLUMN LastPostId;',
+    'SELECT 1;'
+));
+
+PREPARE removeColumnIfExists FROM @preparedStatement;
+EXECUTE removeColumnIfExists;
+DEALLOCATE PREPARE removeColumnIfExists;
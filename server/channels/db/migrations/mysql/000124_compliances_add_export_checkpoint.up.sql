@@ -0,0 +1,44 @@
+SET @preparedStatement = (SELECT IF(
+    NOT EXISTS(
+        SELECT 1 FROM INFORMATION_SCHEMA.COLUMNS
+        WHERE table_name = 'Compliances'
+        AND table_schema = DATABASE()
+        AND column_name = 'Incremental'
+    ),
+    'ALTER TABLE Compliances ADD COLUMN Incremental tinyint(1) NOT NULL DEFAULT 0;',
+    'SELECT 1;'
+));
+
+PREPARE addColumnIfNotExists FROM @preparedStatement;
+EXECUTE addColumnIfNotExists;
+DEALLOCATE PREPARE addColumnIfNotExists;
+
+SET @preparedStatement = (SELECT IF(
+    NOT EXISTS(
+        SELECT 1 FROM INFORMATION_SCHEMA.COLUMNS
+        WHERE table_name = 'Compliances'
+        AND table_schema = DATABASE()
+        AND column_name = 'LastPostUpdateAt'
+    ),
+    'ALTER TABLE Compliances ADD COLUMN LastPostUpdateAt bigint NOT NULL DEFAULT 0;',
+    'SELECT 1;'
+));
+
+PREPARE addColumnIfNotExists FROM @preparedStatement;
+EXECUTE addColumnIfNotExists;
+DEALLOCATE PREPARE addColumnIfNotExists;
+
+SET @preparedStatement = (SELECT IF(
+    NOT EXISTS(
+        SELECT 1 FROM INFORMATION_SCHEMA.COLUMNS
+        WHERE table_name = 'Compliances'
+        AND table_schema = DATABASE()
+        AND column_name = 'LastPostId'
+    ),
+    'ALTER TABLE Compliances ADD COLUMN LastPostId varchar(26) NOT NULL DEFAULT \'\';',
+    'SELECT 1;'
+));
+
+PREPARE addColumnIfNotExists FROM @preparedStatement;
+EXECUTE addColumnIfNotExists;
+DEALLOCATE PREPARE addColumnIfNotExists;
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS NotificationDeliveries (
+    Id varchar(26) NOT NULL,
+    UserId varchar(26) NOT NULL,
+    PostId varchar(26) NOT NULL DEFAULT '',
+    ChannelId varchar(26) NOT NULL DEFAULT '',
+    Type varchar(32) NOT NULL,
+    Status varchar(32) NOT NULL,
+    Reason varchar(64) NOT NULL DEFAULT '',
+    Detail varchar(512) NOT NULL DEFAULT '',
+    CreateAt bigint(20) NOT NULL DEFAULT 0,
+    PRIMARY KEY (Id),
+    KEY idx_notificationdeliveries_user_id_create_at (UserId, CreateAt),
+    KEY idx_notificationdeliveries_create_at (CreateAt)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
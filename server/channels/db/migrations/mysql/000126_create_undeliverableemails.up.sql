@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS UndeliverableEmails (
+    Email varchar(128) NOT NULL,
+    Reason varchar(32) NOT NULL,
+    Detail varchar(512) NOT NULL DEFAULT '',
+    CreateAt bigint(20) NOT NULL DEFAULT 0,
+    UpdateAt bigint(20) NOT NULL DEFAULT 0,
+    PRIMARY KEY (Email)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
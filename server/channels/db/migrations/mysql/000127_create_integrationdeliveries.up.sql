@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS IntegrationDeliveries (
+    Id varchar(26) NOT NULL,
+    HookId varchar(26) NOT NULL,
+    Type varchar(32) NOT NULL,
+    URL varchar(1024) NOT NULL,
+    ContentType varchar(128) NOT NULL DEFAULT '',
+    Payload mediumtext NOT NULL,
+    UserId varchar(26) NOT NULL DEFAULT '',
+    ChannelId varchar(26) NOT NULL DEFAULT '',
+    PostId varchar(26) NOT NULL DEFAULT '',
+    StatusCode int NOT NULL DEFAULT 0,
+    Latency bigint(20) NOT NULL DEFAULT 0,
+    Response text NOT NULL,
+    Error varchar(1024) NOT NULL DEFAULT '',
+    ReplayOf varchar(26) NOT NULL DEFAULT '',
+    CreateAt bigint(20) NOT NULL DEFAULT 0,
+    PRIMARY KEY (Id),
+    KEY idx_integrationdeliveries_hook_id_create_at (HookId, CreateAt),
+    KEY idx_integrationdeliveries_create_at (CreateAt)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
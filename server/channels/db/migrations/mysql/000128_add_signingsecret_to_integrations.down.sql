@@ -0,0 +1,29 @@
+SET @preparedStatement = (SELECT IF(
+    EXISTS(
+        SELECT 1 FROM INFORMATION_SCHEMA.COLUMNS
+        WHERE table_name = 'OutgoingWebhooks'
+        AND table_schema = DATABASE()
+        AND column_name = 'SigningSecret'
+    ) > 0,
+    'ALTER TABLE OutgoingWebhooks DROP COLUMN SigningSecret;',
+    'SELECT 1;'
+));
+
+PREPARE removeColumnIfExists FROM @preparedStatement;
+EXECUTE removeColumnIfExists;
+DEALLOCATE PREPARE removeColumnIfExists;
+
+SET @preparedStatement = (SELECT IF(
+    EXISTS(
+        SELECT 1 FROM INFORMATION_SCHEMA.COLUMNS
+        WHERE table_name = 'Commands'
+        AND table_schema = DATABASE()
+        AND column_name = 'SigningSecret'
+    ) > 0,
+    'ALTER TABLE Commands DROP COLUMN SigningSecret;',
+    'SELECT 1;'
+));
+
+PREPARE removeColumnIfExists FROM @preparedStatement;
+EXECUTE removeColumnIfExists;
+DEALLOCATE PREPARE removeColumnIfExists;
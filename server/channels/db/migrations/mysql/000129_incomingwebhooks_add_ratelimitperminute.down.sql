@@ -0,0 +1,14 @@
+SET @preparedStatement = (SELECT IF(
+    EXISTS(
+        SELECT 1 FROM INFORMATION_SCHEMA.COLUMNS
+        WHERE table_name = 'IncomingWebhooks'
+        AND table_schema = DATABASE()
+        AND column_name = 'RateLimitPerMinute'
+    ) > 0,
+    'ALTER TABLE IncomingWebhooks DROP COLUMN RateLimitPerMinute;',
+    'SELECT 1;'
+));
+
+PREPARE removeColumnIfExists FROM @preparedStatement;
+EXECUTE removeColumnIfExists;
+DEALLOCATE PREPARE removeColumnIfExists;
@@ -0,0 +1,14 @@
+SET @preparedStatement = (SELECT IF(
+    NOT EXISTS(
+        SELECT 1 FROM INFORMATION_SCHEMA.COLUMNS
+        WHERE table_name = 'IncomingWebhooks'
+        AND table_schema = DATABASE()
+        AND column_name = 'RateLimitPerMinute'
+    ),
+    'ALTER TABLE IncomingWebhooks ADD COLUMN RateLimitPerMinute int NOT NULL DEFAULT 0;',
+    'SELECT 1;'
+));
+
+PREPARE addColumnIfNotExists FROM @preparedStatement;
+EXECUTE addColumnIfNotExists;
+DEALLOCATE PREPARE addColumnIfNotExists;
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS AutomationRules (
+    Id varchar(26) NOT NULL,
+    TeamId varchar(26) NOT NULL,
+    CreatorId varchar(26) NOT NULL,
+    DisplayName varchar(64) NOT NULL,
+    Enabled tinyint(1) NOT NULL DEFAULT 1,
+    TriggerType varchar(32) NOT NULL,
+    `Trigger` text NOT NULL,
+    Actions text NOT NULL,
+    CreateAt bigint(20) NOT NULL DEFAULT 0,
+    UpdateAt bigint(20) NOT NULL DEFAULT 0,
+    DeleteAt bigint(20) NOT NULL DEFAULT 0,
+    PRIMARY KEY (Id),
+    KEY idx_automationrules_team_id_trigger_type (TeamId, TriggerType)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,14 @@
+SET @preparedStatement = (SELECT IF(
+    EXISTS(
+        SELECT 1 FROM INFORMATION_SCHEMA.COLUMNS
+        WHERE table_name = 'UserAccessTokens'
+        AND table_schema = DATABASE()
+        AND column_name = 'ExpiresAt'
+    ) > 0,
+    'ALTER TABLE UserAccessTokens DROP COLUMN ExpiresAt;',
+    'SELECT 1;'
+));
+
+PREPARE removeColumnIfExists FROM @preparedStatement;
+EXECUTE removeColumnIfExists;
+DEALLOCATE PREPARE removeColumnIfExists;
@@ -0,0 +1,14 @@
+SET @preparedStatement = (SELECT IF(
+    NOT EXISTS(
+        SELECT 1 FROM INFORMATION_SCHEMA.COLUMNS
+        WHERE table_name = 'UserAccessTokens'
+        AND table_schema = DATABASE()
+        AND column_name = 'ExpiresAt'
+    ),
+    'ALTER TABLE UserAccessTokens ADD COLUMN ExpiresAt bigint DEFAULT 0;',
+    'SELECT 1;'
+));
+
+PREPARE addColumnIfNotExists FROM @preparedStatement;
+EXECUTE addColumnIfNotExists;
+DEALLOCATE PREPARE addColumnIfNotExists;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS CustomProfileAttributeValues;
+DROP TABLE IF EXISTS CustomProfileAttributeFields;
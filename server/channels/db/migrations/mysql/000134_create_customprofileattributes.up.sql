@@ -0,0 +1,24 @@
+CREATE TABLE IF NOT EXISTS CustomProfileAttributeFields (
+    Id varchar(26) NOT NULL,
+    Name varchar(64) NOT NULL,
+    Type varchar(32) NOT NULL,
+    Options text,
+    ValidationRegex varchar(256) NOT NULL DEFAULT '',
+    Visibility varchar(32) NOT NULL,
+    LdapAttribute varchar(128) NOT NULL DEFAULT '',
+    SamlAttribute varchar(128) NOT NULL DEFAULT '',
+    SortOrder int NOT NULL DEFAULT 0,
+    CreateAt bigint(20) NOT NULL DEFAULT 0,
+    UpdateAt bigint(20) NOT NULL DEFAULT 0,
+    DeleteAt bigint(20) NOT NULL DEFAULT 0,
+    PRIMARY KEY (Id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS CustomProfileAttributeValues (
+    FieldId varchar(26) NOT NULL,
+    UserId varchar(26) NOT NULL,
+    Value varchar(256) NOT NULL,
+    UpdateAt bigint(20) NOT NULL DEFAULT 0,
+    PRIMARY KEY (UserId, FieldId),
+    KEY idx_customprofileattributevalues_field_id_value (FieldId, Value)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,59 @@
+SET @preparedStatement = (SELECT IF(
+    EXISTS(
+        SELECT 1 FROM INFORMATION_SCHEMA.COLUMNS
+        WHERE table_name = 'UserGroups'
+        AND table_schema = DATABASE()
+        AND column_name = 'LastMentionAt'
+    ) > 0,
+    'ALTER TABLE UserGroups DROP COLUMN LastMentionAt;',
+    'SELECT 1;'
+));
+
+PREPARE removeColumnIfExists FROM @preparedStatement;
+EXECUTE removeColumnIfExists;
+DEALLOCATE PREPARE removeColumnIfExists;
+
+SET @preparedStatement = (SELECT IF(
+    EXISTS(
+        SELECT 1 FROM INFORMATION_SCHEMA.COLUMNS
+        WHERE table_name = 'UserGroups'
+        AND table_schema = DATABASE()
+        AND column_name = 'MentionMemberLimit'
+    ) > 0,
+    'ALTER TABLE UserGroups DROP COLUMN MentionMemberLimit;',
+    'SELECT 1;'
+));
+
+PREPARE removeColumnIfExists FROM @preparedStatement;
+EXECUTE removeColumnIfExists;
+DEALLOCATE PREPARE removeColumnIfExists;
+
+SET @preparedStatement = (SELECT IF(
+    EXISTS(
+        SELECT 1 FROM INFORMATION_SCHEMA.COLUMNS
+        WHERE table_name = 'UserGroups'
+        AND table_schema = DATABASE()
+        AND column_name = 'MentionCooldownSeconds'
+    ) > 0,
+    'ALTER TABLE UserGroups DROP COLUMN MentionCooldownSeconds;',
+    'SELECT 1;'
+));
+
+PREPARE removeColumnIfExists FROM @preparedStatement;
+EXECUTE removeColumnIfExists;
+DEALLOCATE PREPARE removeColumnIfExists;
+
+SET @preparedStatement = (SELECT IF(
+    EXISTS(
+        SELECT 1 FROM INFORMATION_SCHEMA.COLUMNS
+        WHERE table_name = 'UserGroups'
+        AND table_schema = DATABASE()
+        AND column_name = 'MentionPolicy'
+    ) > 0,
+    'ALTER TABLE UserGroups DROP COLUMN MentionPolicy;',
+    'SELECT 1;'
+));
+
+PREPARE removeColumnIfExists FROM @preparedStatement;
+EXECUTE removeColumnIfExists;
+DEALLOCATE PREPARE removeColumnIfExists;
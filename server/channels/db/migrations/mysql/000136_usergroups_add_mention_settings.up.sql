@@ -0,0 +1,59 @@
+SET @preparedStatement = (SELECT IF(
+    NOT EXISTS(
+        SELECT 1 FROM INFORMATION_SCHEMA.COLUMNS
+        WHERE table_name = 'UserGroups'
+        AND table_schema = DATABASE()
+        AND column_name = 'MentionPolicy'
+    ),
+    'ALTER TABLE UserGroups ADD COLUMN MentionPolicy varchar(16) NOT NULL DEFAULT \'\';',
+    'SELECT 1;'
+));
+
+PREPARE addColumnIfNotExists FROM @preparedStatement;
+EXECUTE addColumnIfNotExists;
+DEALLOCATE PREPARE addColumnIfNotExists;
+
+SET @preparedStatement = (SELECT IF(
+    NOT EXISTS(
+        SELECT 1 FROM INFORMATION_SCHEMA.COLUMNS
+        WHERE table_name = 'UserGroups'
+        AND table_schema = DATABASE()
+        AND column_name = 'MentionCooldownSeconds'
+    ),
+    'ALTER TABLE UserGroups ADD COLUMN MentionCooldownSeconds int NOT NULL DEFAULT 0;',
+    'SELECT 1;'
+));
+
+PREPARE addColumnIfNotExists FROM @preparedStatement;
+EXECUTE addColumnIfNotExists;
+DEALLOCATE PREPARE addColumnIfNotExists;
+
+SET @preparedStatement = (SELECT IF(
+    NOT EXISTS(
+        SELECT 1 FROM INFORMATION_SCHEMA.COLUMNS
+        WHERE table_name = 'UserGroups'
+        AND table_schema = DATABASE()
+        AND column_name = 'MentionMemberLimit'
+    ),
+    'ALTER TABLE UserGroups ADD COLUMN MentionMemberLimit int NOT NULL DEFAULT 0;',
+    'SELECT 1;'
+));
+
+PREPARE addColumnIfNotExists FROM @preparedStatement;
+EXECUTE addColumnIfNotExists;
+DEALLOCATE PREPARE addColumnIfNotExists;
+
+SET @preparedStatement = (SELECT IF(
+    NOT EXISTS(
+        SELECT 1 FROM INFORMATION_SCHEMA.COLUMNS
+        WHERE table_name = 'UserGroups'
+        AND table_schema = DATABASE()
+        AND column_name = 'LastMentionAt'
+    ),
+    'ALTER TABLE UserGroups ADD COLUMN LastMentionAt bigint NOT NULL DEFAULT 0;',
+    'SELECT 1;'
+));
+
+PREPARE addColumnIfNotExists FROM @preparedStatement;
+EXECUTE addColumnIfNotExists;
+DEALLOCATE PREPARE addColumnIfNotExists;
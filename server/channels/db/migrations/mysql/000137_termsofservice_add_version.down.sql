@@ -0,0 +1,29 @@
+SET @preparedStatement = (SELECT IF(
+    EXISTS(
+        SELECT 1 FROM INFORMATION_SCHEMA.COLUMNS
+        WHERE table_name = 'TermsOfService'
+        AND table_schema = DATABASE()
+        AND column_name = 'Audience'
+    ) > 0,
+    'ALTER TABLE TermsOfService DROP COLUMN Audience;',
+    'SELECT 1;'
+));
+
+PREPARE removeColumnIfExists FROM @preparedStatement;
+EXECUTE removeColumnIfExists;
+DEALLOCATE PREPARE removeColumnIfExists;
+
+SET @preparedStatement = (SELECT IF(
+    EXISTS(
+        SELECT 1 FROM INFORMATION_SCHEMA.COLUMNS
+        WHERE table_name = 'TermsOfService'
+        AND table_schema = DATABASE()
+        AND column_name = 'Version'
+    ) > 0,
+    'ALTER TABLE TermsOfService DROP COLUMN Version;',
+    'SELECT 1;'
+));
+
+PREPARE removeColumnIfExists FROM @preparedStatement;
+EXECUTE removeColumnIfExists;
+DEALLOCATE PREPARE removeColumnIfExists;
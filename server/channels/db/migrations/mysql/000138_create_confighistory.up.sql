@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS ConfigHistory (
+    Id varchar(26) NOT NULL,
+    Version bigint(20) NOT NULL,
+    UserId varchar(26) NOT NULL DEFAULT '',
+    CreateAt bigint(20) NOT NULL DEFAULT 0,
+    Diff mediumtext NOT NULL,
+    Config mediumtext NOT NULL,
+    PRIMARY KEY (Id),
+    UNIQUE KEY idx_confighistory_version (Version)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_fileinfo_content_hash;
+ALTER TABLE fileinfo DROP COLUMN IF EXISTS contenthash;
@@ -0,0 +1,2 @@
+ALTER TABLE fileinfo ADD COLUMN IF NOT EXISTS contenthash varchar(64) NOT NULL DEFAULT '';
+CREATE INDEX IF NOT EXISTS idx_fileinfo_content_hash ON fileinfo(contenthash);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_filesharelinks_file_id;
+DROP INDEX IF EXISTS idx_filesharelinks_expire_at;
+
+DROP TABLE IF EXISTS filesharelinks;
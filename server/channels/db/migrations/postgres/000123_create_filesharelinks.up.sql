@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS filesharelinks (
+    id varchar(26) PRIMARY KEY,
+    fileid varchar(26) NOT NULL,
+    creatorid varchar(26) NOT NULL,
+    createat bigint NOT NULL DEFAULT 0,
+    expireat bigint NOT NULL DEFAULT 0,
+    deleteat bigint NOT NULL DEFAULT 0,
+    password varchar(128) NOT NULL DEFAULT '',
+    maxdownloads bigint NOT NULL DEFAULT 0,
+    downloadcount bigint NOT NULL DEFAULT 0
+);
+
+CREATE INDEX IF NOT EXISTS idx_filesharelinks_file_id ON filesharelinks (fileid);
+CREATE INDEX IF NOT EXISTS idx_filesharelinks_expire_at ON filesharelinks (expireat);
@@ -0,0 +1,3 @@
+ALTER TABLE compliances DROP COLUMN IF EXISTS incremental;
+ALTER TABLE compliances DROP COLUMN IF EXISTS lastpostupdateat;
+ALTER TABLE compliances DROP COLUMN IF EXISTS lastpostid;
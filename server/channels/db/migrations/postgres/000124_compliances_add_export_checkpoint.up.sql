@@ -0,0 +1,3 @@
+ALTER TABLE compliances ADD COLUMN IF NOT EXISTS incremental boolean NOT NULL DEFAULT false;
+ALTER TABLE compliances ADD COLUMN IF NOT EXISTS lastpostupdateat bigint NOT NULL DEFAULT 0;
+ALTER TABLE compliances ADD COLUMN IF NOT EXISTS lastpostid varchar(26) NOT NULL DEFAULT '';
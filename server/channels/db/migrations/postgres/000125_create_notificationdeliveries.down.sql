@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_notificationdeliveries_user_id_create_at;
+DROP INDEX IF EXISTS idx_notificationdeliveries_create_at;
+
+DROP TABLE IF EXISTS notificationdeliveries;
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS notificationdeliveries (
+    id varchar(26) PRIMARY KEY,
+    userid varchar(26) NOT NULL,
+    postid varchar(26) NOT NULL DEFAULT '',
+    channelid varchar(26) NOT NULL DEFAULT '',
+    type varchar(32) NOT NULL,
+    status varchar(32) NOT NULL,
+    reason varchar(64) NOT NULL DEFAULT '',
+    detail varchar(512) NOT NULL DEFAULT '',
+    createat bigint NOT NULL DEFAULT 0
+);
+
+CREATE INDEX IF NOT EXISTS idx_notificationdeliveries_user_id_create_at ON notificationdeliveries (userid, createat);
+CREATE INDEX IF NOT EXISTS idx_notificationdeliveries_create_at ON notificationdeliveries (createat);
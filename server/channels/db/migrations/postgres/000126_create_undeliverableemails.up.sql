@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS undeliverableemails (
+    email varchar(128) PRIMARY KEY,
+    reason varchar(32) NOT NULL,
+    detail varchar(512) NOT NULL DEFAULT '',
+    createat bigint NOT NULL DEFAULT 0,
+    updateat bigint NOT NULL DEFAULT 0
+);
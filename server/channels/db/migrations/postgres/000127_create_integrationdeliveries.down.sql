@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_integrationdeliveries_hook_id_create_at;
+DROP INDEX IF EXISTS idx_integrationdeliveries_create_at;
+
+DROP TABLE IF EXISTS integrationdeliveries;
@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS integrationdeliveries (
+    id varchar(26) PRIMARY KEY,
+    hookid varchar(26) NOT NULL,
+    type varchar(32) NOT NULL,
+    url varchar(1024) NOT NULL,
+    contenttype varchar(128) NOT NULL DEFAULT '',
+    payload text NOT NULL DEFAULT '',
+    userid varchar(26) NOT NULL DEFAULT '',
+    channelid varchar(26) NOT NULL DEFAULT '',
+    postid varchar(26) NOT NULL DEFAULT '',
+    statuscode integer NOT NULL DEFAULT 0,
+    latency bigint NOT NULL DEFAULT 0,
+    response text NOT NULL DEFAULT '',
+    error varchar(1024) NOT NULL DEFAULT '',
+    replayof varchar(26) NOT NULL DEFAULT '',
+    createat bigint NOT NULL DEFAULT 0
+);
+
+CREATE INDEX IF NOT EXISTS idx_integrationdeliveries_hook_id_create_at ON integrationdeliveries (hookid, createat);
+CREATE INDEX IF NOT EXISTS idx_integrationdeliveries_create_at ON integrationdeliveries (createat);
@@ -0,0 +1 @@
+ALTER TABLE incomingwebhooks ADD COLUMN IF NOT EXISTS ratelimitperminute integer NOT NULL DEFAULT 0;
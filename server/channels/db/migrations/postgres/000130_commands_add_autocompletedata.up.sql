@@ -0,0 +1 @@
+ALTER TABLE commands ADD COLUMN IF NOT EXISTS autocompletedata text;
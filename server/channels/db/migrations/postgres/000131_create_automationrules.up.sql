@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS automationrules (
+    id varchar(26) PRIMARY KEY,
+    teamid varchar(26) NOT NULL,
+    creatorid varchar(26) NOT NULL,
+    displayname varchar(64) NOT NULL,
+    enabled boolean NOT NULL DEFAULT true,
+    triggertype varchar(32) NOT NULL,
+    trigger text NOT NULL,
+    actions text NOT NULL,
+    createat bigint NOT NULL DEFAULT 0,
+    updateat bigint NOT NULL DEFAULT 0,
+    deleteat bigint NOT NULL DEFAULT 0
+);
+
+CREATE INDEX IF NOT EXISTS idx_automationrules_team_id_trigger_type ON automationrules (teamid, triggertype);
@@ -0,0 +1 @@
+ALTER TABLE useraccesstokens DROP COLUMN IF EXISTS expiresat;
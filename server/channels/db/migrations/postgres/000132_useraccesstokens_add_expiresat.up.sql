@@ -0,0 +1 @@
+ALTER TABLE useraccesstokens ADD COLUMN IF NOT EXISTS expiresat bigint DEFAULT 0;
@@ -0,0 +1 @@
+ALTER TABLE bots ADD COLUMN IF NOT EXISTS scope text;
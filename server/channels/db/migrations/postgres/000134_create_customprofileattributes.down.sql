@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS customprofileattributevalues;
+DROP TABLE IF EXISTS customprofileattributefields;
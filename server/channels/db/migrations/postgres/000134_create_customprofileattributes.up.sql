@@ -0,0 +1,24 @@
+CREATE TABLE IF NOT EXISTS customprofileattributefields (
+    id varchar(26) PRIMARY KEY,
+    name varchar(64) NOT NULL,
+    type varchar(32) NOT NULL,
+    options text,
+    validationregex varchar(256) NOT NULL DEFAULT '',
+    visibility varchar(32) NOT NULL,
+    ldapattribute varchar(128) NOT NULL DEFAULT '',
+    samlattribute varchar(128) NOT NULL DEFAULT '',
+    sortorder integer NOT NULL DEFAULT 0,
+    createat bigint NOT NULL DEFAULT 0,
+    updateat bigint NOT NULL DEFAULT 0,
+    deleteat bigint NOT NULL DEFAULT 0
+);
+
+CREATE TABLE IF NOT EXISTS customprofileattributevalues (
+    fieldid varchar(26) NOT NULL,
+    userid varchar(26) NOT NULL,
+    value varchar(256) NOT NULL,
+    updateat bigint NOT NULL DEFAULT 0,
+    PRIMARY KEY (userid, fieldid)
+);
+
+CREATE INDEX IF NOT EXISTS idx_customprofileattributevalues_field_id_value ON customprofileattributevalues (fieldid, value);
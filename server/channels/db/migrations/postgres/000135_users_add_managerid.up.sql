@@ -0,0 +1,2 @@
+ALTER TABLE users ADD COLUMN IF NOT EXISTS managerid varchar(26) NOT NULL DEFAULT '';
+CREATE INDEX IF NOT EXISTS idx_users_manager_id ON users(managerid);
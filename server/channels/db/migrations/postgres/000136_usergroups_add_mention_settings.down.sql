@@ -0,0 +1,4 @@
+ALTER TABLE usergroups DROP COLUMN IF EXISTS lastmentionat;
+ALTER TABLE usergroups DROP COLUMN IF EXISTS mentionmemberlimit;
+ALTER TABLE usergroups DROP COLUMN IF EXISTS mentioncooldownseconds;
+ALTER TABLE usergroups DROP COLUMN IF EXISTS mentionpolicy;
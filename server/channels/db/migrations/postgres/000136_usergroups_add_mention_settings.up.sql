@@ -0,0 +1,4 @@
+ALTER TABLE usergroups ADD COLUMN IF NOT EXISTS mentionpolicy varchar(16) NOT NULL DEFAULT '';
+ALTER TABLE usergroups ADD COLUMN IF NOT EXISTS mentioncooldownseconds integer NOT NULL DEFAULT 0;
+ALTER TABLE usergroups ADD COLUMN IF NOT EXISTS mentionmemberlimit integer NOT NULL DEFAULT 0;
+ALTER TABLE usergroups ADD COLUMN IF NOT EXISTS lastmentionat bigint NOT NULL DEFAULT 0;
@@ -0,0 +1,2 @@
+ALTER TABLE termsofservice DROP COLUMN IF EXISTS audience;
+ALTER TABLE termsofservice DROP COLUMN IF EXISTS version;
@@ -0,0 +1,2 @@
+ALTER TABLE termsofservice ADD COLUMN IF NOT EXISTS version integer NOT NULL DEFAULT 0;
+ALTER TABLE termsofservice ADD COLUMN IF NOT EXISTS audience varchar(16) NOT NULL DEFAULT '';
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS confighistory (
+    id varchar(26) PRIMARY KEY,
+    version bigint NOT NULL,
+    userid varchar(26) NOT NULL DEFAULT '',
+    createat bigint NOT NULL DEFAULT 0,
+    diff text NOT NULL,
+    config text NOT NULL,
+    UNIQUE (version)
+);
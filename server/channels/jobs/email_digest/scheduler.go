@@ -0,0 +1,23 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package email_digest
+
+import (
+	"time"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/v8/channels/jobs"
+)
+
+// Digests are scheduled on the hour in the timezone of each user, so checking
+// more often than that keeps them close to the chosen time even for timezones
+// with a partial hour offset.
+const schedFreq = 15 * time.Minute
+
+func MakeScheduler(jobServer *jobs.JobServer) *jobs.PeriodicScheduler {
+	isEnabled := func(cfg *model.Config) bool {
+		return *cfg.EmailSettings.SendEmailNotifications
+	}
+	return jobs.NewPeriodicScheduler(jobServer, model.JobTypeEmailDigest, schedFreq, isEnabled)
+}
@@ -0,0 +1,24 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package email_digest
+
+import (
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/v8/channels/jobs"
+)
+
+func MakeWorker(jobServer *jobs.JobServer, sendEmailDigests func() error) *jobs.SimpleWorker {
+	const workerName = "EmailDigest"
+
+	isEnabled := func(cfg *model.Config) bool {
+		return *cfg.EmailSettings.SendEmailNotifications
+	}
+	execute := func(logger mlog.LoggerIFace, job *model.Job) error {
+		defer jobServer.HandleJobPanic(logger, job)
+
+		return sendEmailDigests()
+	}
+	return jobs.NewSimpleWorker(workerName, jobServer, execute, isEnabled)
+}
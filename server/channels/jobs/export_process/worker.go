@@ -7,6 +7,10 @@ import (
 	"context"
 	"io"
 	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
 
 	"github.com/mattermost/mattermost/server/public/model"
 	"github.com/mattermost/mattermost/server/public/shared/mlog"
@@ -53,6 +57,38 @@ func MakeWorker(jobServer *jobs.JobServer, app AppIface) *jobs.SimpleWorker {
 			opts.IncludeRolesAndSchemes = true
 		}
 
+		if startTime, ok := job.Data["start_time"]; ok && startTime != "" {
+			parsed, err := strconv.ParseInt(startTime, 10, 64)
+			if err != nil {
+				return errors.Wrap(err, "invalid start_time")
+			}
+			opts.StartTime = parsed
+		}
+
+		if endTime, ok := job.Data["end_time"]; ok && endTime != "" {
+			parsed, err := strconv.ParseInt(endTime, 10, 64)
+			if err != nil {
+				return errors.Wrap(err, "invalid end_time")
+			}
+			opts.EndTime = parsed
+		}
+
+		if teamIDs, ok := job.Data["team_ids"]; ok && teamIDs != "" {
+			opts.TeamIds = strings.Split(teamIDs, ",")
+		}
+
+		if channelIDs, ok := job.Data["channel_ids"]; ok && channelIDs != "" {
+			opts.ChannelIds = strings.Split(channelIDs, ",")
+		}
+
+		if chunkSize, ok := job.Data["chunk_size"]; ok && chunkSize != "" {
+			parsed, err := strconv.Atoi(chunkSize)
+			if err != nil || parsed < 0 {
+				return errors.Errorf("invalid chunk_size %q", chunkSize)
+			}
+			opts.ChunkSize = parsed
+		}
+
 		outPath := *app.Config().ExportSettings.Directory
 		exportFilename := job.Id + "_export.zip"
 
@@ -0,0 +1,89 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package file_content_hash_backfill
+
+import (
+	"strconv"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/jobs"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+)
+
+const batchSize = 1000
+
+type AppIface interface {
+	ComputeFileInfoContentHash(rctx request.CTX, fileInfo *model.FileInfo) error
+}
+
+// MakeWorker creates a worker that computes the content hash of files uploaded before
+// hashing was introduced, so that they can take part in file deduplication.
+func MakeWorker(jobServer *jobs.JobServer, app AppIface, store store.Store) *jobs.SimpleWorker {
+	const workerName = "FileContentHashBackfill"
+
+	isEnabled := func(cfg *model.Config) bool {
+		return true
+	}
+	execute := func(logger mlog.LoggerIFace, job *model.Job) error {
+		defer jobServer.HandleJobPanic(logger, job)
+
+		if job.Data == nil {
+			job.Data = make(model.StringMap)
+		}
+
+		var createAt int64
+		fileID := job.Data["last_file_id"]
+		if lastCreateAt, ok := job.Data["last_create_at"]; ok {
+			var err error
+			if createAt, err = strconv.ParseInt(lastCreateAt, 10, 64); err != nil {
+				return err
+			}
+		}
+
+		var nFiles int
+		var nErrs int
+		for {
+			fileInfos, err := store.FileInfo().GetBatchWithoutContentHash(createAt, fileID, batchSize)
+			if err != nil {
+				return err
+			}
+			if len(fileInfos) == 0 {
+				break
+			}
+
+			for _, fileInfo := range fileInfos {
+				if err := app.ComputeFileInfoContentHash(request.EmptyContext(logger), fileInfo); err != nil {
+					logger.Warn("Failed to compute file content hash", mlog.Err(err), mlog.String("file_info_id", fileInfo.Id))
+					nErrs++
+					continue
+				}
+				nFiles++
+			}
+
+			lastFileInfo := fileInfos[len(fileInfos)-1]
+			createAt, fileID = lastFileInfo.CreateAt, lastFileInfo.Id
+
+			// Save a checkpoint so that a restarted job resumes where this one stopped.
+			job.Data["last_create_at"] = strconv.FormatInt(createAt, 10)
+			job.Data["last_file_id"] = fileID
+			job.Data["errors"] = strconv.Itoa(nErrs)
+			job.Data["processed"] = strconv.Itoa(nFiles)
+			if err := jobServer.UpdateInProgressJobData(job); err != nil {
+				logger.Error("Worker: Failed to update job data", mlog.Err(err))
+			}
+		}
+
+		job.Data["errors"] = strconv.Itoa(nErrs)
+		job.Data["processed"] = strconv.Itoa(nFiles)
+
+		if err := jobServer.UpdateInProgressJobData(job); err != nil {
+			logger.Error("Worker: Failed to update job data", mlog.Err(err))
+		}
+		return nil
+	}
+	worker := jobs.NewSimpleWorker(workerName, jobServer, execute, isEnabled)
+	return worker
+}
@@ -12,6 +12,7 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -29,7 +30,8 @@ type AppIface interface {
 	FileExists(path string) (bool, *model.AppError)
 	FileSize(path string) (int64, *model.AppError)
 	FileReader(path string) (filestore.ReadCloseSeeker, *model.AppError)
-	BulkImportWithPath(c request.CTX, jsonlReader io.Reader, attachmentsReader *zip.Reader, dryRun, extractContent bool, workers int, importPath string) (*model.AppError, int)
+	BulkImportWithOpts(c request.CTX, jsonlReader io.Reader, attachmentsReader *zip.Reader, job *model.Job, opts model.BulkImportOpts) (*model.AppError, int)
+	ValidateBulkImport(c request.CTX, jsonlReader io.Reader) (*model.AppError, int)
 	Log() *mlog.Logger
 }
 
@@ -99,8 +101,9 @@ func MakeWorker(jobServer *jobs.JobServer, app AppIface) *jobs.SimpleWorker {
 			return model.NewAppError("ImportProcessWorker", "import_process.worker.do_job.open_file", nil, "", http.StatusInternalServerError).Wrap(err)
 		}
 
-		// find JSONL import file.
-		var jsonFile io.ReadCloser
+		// find JSONL import files. Chunked exports split the data across several files
+		// which have to be imported in order.
+		var jsonFiles []*zip.File
 		for _, f := range importZipReader.File {
 			if filepath.Ext(f.Name) != ".jsonl" {
 				continue
@@ -110,25 +113,90 @@ func MakeWorker(jobServer *jobs.JobServer, app AppIface) *jobs.SimpleWorker {
 				return model.NewAppError("ImportProcessWorker", "import_process.worker.do_job.open_file", nil, "jsonFilePath contains path traversal", http.StatusForbidden)
 			}
 
-			jsonFile, err = f.Open()
+			jsonFiles = append(jsonFiles, f)
+		}
+
+		if len(jsonFiles) == 0 {
+			return model.NewAppError("ImportProcessWorker", "import_process.worker.do_job.missing_jsonl", nil, "jsonFile was nil", http.StatusBadRequest)
+		}
+
+		sort.Slice(jsonFiles, func(i, j int) bool {
+			return jsonFiles[i].Name < jsonFiles[j].Name
+		})
+
+		// Validate the whole import first so that an invalid file fails before anything
+		// gets imported. This also counts the lines to report the progress of the import.
+		totalLines := 0
+		linesPerFile := make([]int, len(jsonFiles))
+		for i, f := range jsonFiles {
+			jsonFile, err := f.Open()
 			if err != nil {
 				return model.NewAppError("ImportProcessWorker", "import_process.worker.do_job.open_file", nil, "", http.StatusInternalServerError).Wrap(err)
 			}
 
-			defer jsonFile.Close()
-			break
-		}
+			appErr, lines := app.ValidateBulkImport(appContext, jsonFile)
+			jsonFile.Close()
+			if appErr != nil {
+				job.Data["line_number"] = strconv.Itoa(lines)
+				if len(jsonFiles) > 1 {
+					job.Data["import_chunk"] = f.Name
+				}
+				return appErr
+			}
 
-		if jsonFile == nil {
-			return model.NewAppError("ImportProcessWorker", "import_process.worker.do_job.missing_jsonl", nil, "jsonFile was nil", http.StatusBadRequest)
+			linesPerFile[i] = lines
+			totalLines += lines
 		}
 
+		// A job created with the checkpoint of a previous run resumes from it.
+		resumeFromFile := job.Data["resume_from_file"]
+		resumeFromLine, _ := strconv.Atoi(job.Data["resume_from_line"])
+		resuming := resumeFromFile != ""
+
 		extractContent := job.Data["extract_content"] == "true"
-		// do the actual import.
-		appErr, lineNumber := app.BulkImportWithPath(appContext, jsonFile, importZipReader, false, extractContent, runtime.NumCPU(), model.ExportDataDir)
-		if appErr != nil {
-			job.Data["line_number"] = strconv.Itoa(lineNumber)
-			return appErr
+		previousLines := 0
+		for i, f := range jsonFiles {
+			if resuming && f.Name != resumeFromFile {
+				previousLines += linesPerFile[i]
+				continue
+			}
+
+			opts := model.BulkImportOpts{
+				ExtractContent: extractContent,
+				Workers:        runtime.NumCPU(),
+				ImportPath:     model.ExportDataDir,
+				TotalLines:     totalLines,
+				PreviousLines:  previousLines,
+			}
+			if resuming {
+				opts.ResumeFromLine = resumeFromLine
+				resuming = false
+			}
+
+			job.Data["resume_from_file"] = f.Name
+			job.Data["resume_from_line"] = strconv.Itoa(opts.ResumeFromLine)
+
+			jsonFile, err := f.Open()
+			if err != nil {
+				return model.NewAppError("ImportProcessWorker", "import_process.worker.do_job.open_file", nil, "", http.StatusInternalServerError).Wrap(err)
+			}
+
+			// do the actual import.
+			appErr, lineNumber := app.BulkImportWithOpts(appContext, jsonFile, importZipReader, job, opts)
+			jsonFile.Close()
+			if appErr != nil {
+				job.Data["line_number"] = strconv.Itoa(lineNumber)
+				if len(jsonFiles) > 1 {
+					job.Data["import_chunk"] = f.Name
+				}
+				return appErr
+			}
+
+			previousLines += linesPerFile[i]
+		}
+
+		if resuming {
+			return model.NewAppError("ImportProcessWorker", "import_process.worker.do_job.missing_resume_file", map[string]any{"File": resumeFromFile}, "", http.StatusBadRequest)
 		}
 
 		// No need to remove the file in local mode.
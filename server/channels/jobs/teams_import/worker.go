@@ -0,0 +1,161 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package teams_import
+
+import (
+	"archive/zip"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/jobs"
+	"github.com/mattermost/mattermost/server/v8/platform/services/configservice"
+	"github.com/mattermost/mattermost/server/v8/platform/services/teamsimport"
+	"github.com/mattermost/mattermost/server/v8/platform/shared/filestore"
+)
+
+type AppIface interface {
+	configservice.ConfigService
+	RemoveFile(path string) *model.AppError
+	FileExists(path string) (bool, *model.AppError)
+	FileSize(path string) (int64, *model.AppError)
+	FileReader(path string) (filestore.ReadCloseSeeker, *model.AppError)
+	BulkImportWithOpts(c request.CTX, jsonlReader io.Reader, attachmentsReader *zip.Reader, job *model.Job, opts model.BulkImportOpts) (*model.AppError, int)
+	ValidateBulkImport(c request.CTX, jsonlReader io.Reader) (*model.AppError, int)
+	MaxPostSize() int
+	Log() *mlog.Logger
+}
+
+func MakeWorker(jobServer *jobs.JobServer, app AppIface) *jobs.SimpleWorker {
+	const workerName = "TeamsImport"
+
+	appContext := request.EmptyContext(jobServer.Logger())
+	isEnabled := func(cfg *model.Config) bool {
+		return true
+	}
+	execute := func(logger mlog.LoggerIFace, job *model.Job) error {
+		defer jobServer.HandleJobPanic(logger, job)
+
+		importFileName, ok := job.Data["import_file"]
+		if !ok {
+			return model.NewAppError("TeamsImportWorker", "teams_import.worker.do_job.missing_file", nil, "", http.StatusBadRequest)
+		}
+
+		var importFilePath string
+		var importFileSize int64
+		var importFile filestore.ReadCloseSeeker
+		if job.Data["local_mode"] == "true" {
+			// We simply read the file from the local filesystem.
+			info, err := os.Stat(importFileName)
+			if errors.Is(err, os.ErrNotExist) {
+				return fmt.Errorf("file %s doesn't exist.", importFileName)
+			}
+
+			importFileSize = info.Size()
+
+			importFile, err = os.Open(importFileName)
+			if err != nil {
+				return err
+			}
+			defer importFile.Close()
+		} else {
+			importFilePath = filepath.Join(*app.Config().ImportSettings.Directory, importFileName)
+			if ok, err := app.FileExists(importFilePath); err != nil {
+				return err
+			} else if !ok {
+				return model.NewAppError("TeamsImportWorker", "teams_import.worker.do_job.file_exists", nil, "", http.StatusBadRequest)
+			}
+
+			var appErr *model.AppError
+			importFileSize, appErr = app.FileSize(importFilePath)
+			if appErr != nil {
+				return appErr
+			}
+
+			importFile, appErr = app.FileReader(importFilePath)
+			if appErr != nil {
+				return appErr
+			}
+			defer importFile.Close()
+
+			// The import is a long running operation, try to cancel any timeouts attached to the reader.
+			type TimeoutCanceler interface{ CancelTimeout() bool }
+			if tc, ok := importFile.(TimeoutCanceler); ok {
+				if !tc.CancelTimeout() {
+					appContext.Logger().Warn("Could not cancel the timeout for the file reader. The import may fail due to a timeout.")
+				}
+			}
+		}
+
+		exportZipReader, err := zip.NewReader(importFile.(io.ReaderAt), importFileSize)
+		if err != nil {
+			return model.NewAppError("TeamsImportWorker", "teams_import.worker.do_job.open_file", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+
+		// Convert the export into a bulk import file, whose attachments refer to the files
+		// of the export.
+		jsonlFile, err := os.CreateTemp("", "teams-import")
+		if err != nil {
+			return model.NewAppError("TeamsImportWorker", "teams_import.worker.do_job.create_temp", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+		defer os.Remove(jsonlFile.Name())
+		defer jsonlFile.Close()
+
+		stats, err := teamsimport.Transform(logger, exportZipReader, jsonlFile, app.MaxPostSize())
+		if err != nil {
+			return model.NewAppError("TeamsImportWorker", "teams_import.worker.do_job.transform", nil, "", http.StatusBadRequest).Wrap(err)
+		}
+
+		job.Data["teams"] = strconv.Itoa(stats.Teams)
+		job.Data["channels"] = strconv.Itoa(stats.Channels)
+		job.Data["users"] = strconv.Itoa(stats.Users)
+		job.Data["posts"] = strconv.Itoa(stats.Posts)
+		job.Data["direct_channels"] = strconv.Itoa(stats.DirectChannels)
+		job.Data["direct_posts"] = strconv.Itoa(stats.DirectPosts)
+		job.Data["skipped_items"] = strconv.Itoa(len(stats.Skipped))
+
+		if _, err = jsonlFile.Seek(0, io.SeekStart); err != nil {
+			return model.NewAppError("TeamsImportWorker", "teams_import.worker.do_job.open_file", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+
+		appErr, lines := app.ValidateBulkImport(appContext, jsonlFile)
+		if appErr != nil {
+			job.Data["line_number"] = strconv.Itoa(lines)
+			return appErr
+		}
+
+		if _, err = jsonlFile.Seek(0, io.SeekStart); err != nil {
+			return model.NewAppError("TeamsImportWorker", "teams_import.worker.do_job.open_file", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+
+		appErr, lineNumber := app.BulkImportWithOpts(appContext, jsonlFile, exportZipReader, job, model.BulkImportOpts{
+			ExtractContent: job.Data["extract_content"] == "true",
+			Workers:        runtime.NumCPU(),
+			TotalLines:     lines,
+		})
+		if appErr != nil {
+			job.Data["line_number"] = strconv.Itoa(lineNumber)
+			return appErr
+		}
+
+		// No need to remove the file in local mode.
+		if job.Data["local_mode"] != "true" {
+			// remove import file when done.
+			if appErr := app.RemoveFile(importFilePath); appErr != nil {
+				return appErr
+			}
+		}
+		return nil
+	}
+	worker := jobs.NewSimpleWorker(workerName, jobServer, execute, isEnabled)
+	return worker
+}
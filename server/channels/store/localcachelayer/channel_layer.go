@@ -489,3 +489,9 @@ func (s LocalCacheChannelStore) RemoveMembers(rctx request.CTX, channelId string
 	s.InvalidateMemberCount(channelId)
 	return nil
 }
+
+func (s LocalCacheChannelStore) UpdateSidebarCategories(userId, teamId string, categories []*model.SidebarCategoryWithChannels) ([]*model.SidebarCategoryWithChannels, []*model.SidebarCategoryWithChannels, error) {
+	// Moving channels in or out of the favorites category updates the favorite channel preferences of the user.
+	defer s.rootStore.preference.invalidateVersion(userId)
+	return s.ChannelStore.UpdateSidebarCategories(userId, teamId, categories)
+}
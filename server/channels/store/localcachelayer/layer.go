@@ -67,6 +67,12 @@ const (
 	TeamCacheSize = 20000
 	TeamCacheSec  = 30 * 60
 
+	TeamMembersVersionCacheSize = 20000
+	TeamMembersVersionCacheSec  = 30 * 60
+
+	PreferencesVersionCacheSize = model.SessionCacheSize
+	PreferencesVersionCacheSec  = 30 * 60
+
 	ChannelCacheSec = 15 * 60 // 15 mins
 )
 
@@ -118,6 +124,12 @@ type LocalCacheStore struct {
 
 	team                       LocalCacheTeamStore
 	teamAllTeamIdsForUserCache cache.Cache
+	teamMembersVersionCache    cache.Cache
+
+	preference              LocalCachePreferenceStore
+	preferencesVersionCache cache.Cache
+
+	oauth LocalCacheOAuthStore
 
 	termsOfService      LocalCacheTermsOfServiceStore
 	termsOfServiceCache cache.Cache
@@ -357,8 +369,30 @@ func NewLocalCacheLayer(baseStore store.Store, metrics einterfaces.MetricsInterf
 	}); err != nil {
 		return
 	}
+	if localCacheStore.teamMembersVersionCache, err = cacheProvider.NewCache(&cache.CacheOptions{
+		Size:                   TeamMembersVersionCacheSize,
+		Name:                   "TeamMembersVersion",
+		DefaultExpiry:          TeamMembersVersionCacheSec * time.Second,
+		InvalidateClusterEvent: model.ClusterEventInvalidateCacheForTeamMembersVersion,
+	}); err != nil {
+		return
+	}
 	localCacheStore.team = LocalCacheTeamStore{TeamStore: baseStore.Team(), rootStore: &localCacheStore}
 
+	// Preferences
+	if localCacheStore.preferencesVersionCache, err = cacheProvider.NewCache(&cache.CacheOptions{
+		Size:                   PreferencesVersionCacheSize,
+		Name:                   "PreferencesVersion",
+		DefaultExpiry:          PreferencesVersionCacheSec * time.Second,
+		InvalidateClusterEvent: model.ClusterEventInvalidateCacheForPreferencesVersion,
+	}); err != nil {
+		return
+	}
+	localCacheStore.preference = LocalCachePreferenceStore{PreferenceStore: baseStore.Preference(), rootStore: &localCacheStore}
+
+	// OAuth
+	localCacheStore.oauth = LocalCacheOAuthStore{OAuthStore: baseStore.OAuth(), rootStore: &localCacheStore}
+
 	if cluster != nil {
 		cluster.RegisterClusterMessageHandler(model.ClusterEventInvalidateCacheForReactions, localCacheStore.reaction.handleClusterInvalidateReaction)
 		cluster.RegisterClusterMessageHandler(model.ClusterEventInvalidateCacheForRoles, localCacheStore.role.handleClusterInvalidateRole)
@@ -383,6 +417,8 @@ func NewLocalCacheLayer(baseStore store.Store, metrics einterfaces.MetricsInterf
 		cluster.RegisterClusterMessageHandler(model.ClusterEventInvalidateCacheForProfileInChannel, localCacheStore.user.handleClusterInvalidateProfilesInChannel)
 		cluster.RegisterClusterMessageHandler(model.ClusterEventInvalidateCacheForAllProfiles, localCacheStore.user.handleClusterInvalidateAllProfiles)
 		cluster.RegisterClusterMessageHandler(model.ClusterEventInvalidateCacheForTeams, localCacheStore.team.handleClusterInvalidateTeam)
+		cluster.RegisterClusterMessageHandler(model.ClusterEventInvalidateCacheForTeamMembersVersion, localCacheStore.team.handleClusterInvalidateMembersVersion)
+		cluster.RegisterClusterMessageHandler(model.ClusterEventInvalidateCacheForPreferencesVersion, localCacheStore.preference.handleClusterInvalidatePreferencesVersion)
 	}
 	return
 }
@@ -438,6 +474,14 @@ func (s LocalCacheStore) Team() store.TeamStore {
 	return s.team
 }
 
+func (s LocalCacheStore) Preference() store.PreferenceStore {
+	return s.preference
+}
+
+func (s LocalCacheStore) OAuth() store.OAuthStore {
+	return s.oauth
+}
+
 func (s LocalCacheStore) DropAllTables() {
 	s.Invalidate()
 	s.Store.DropAllTables()
@@ -476,6 +520,20 @@ func (s *LocalCacheStore) doStandardReadCache(cache cache.Cache, key string, val
 	return err
 }
 
+// getVersion returns the version of the key, starting a new one when the key was invalidated or evicted. The
+// versions are random rather than counted, so that a version is never handed out again for a different state of
+// the data, whether after a restart or by another node of the cluster.
+func (s *LocalCacheStore) getVersion(cache cache.Cache, key string) string {
+	var version string
+	if err := s.doStandardReadCache(cache, key, &version); err == nil {
+		return version
+	}
+
+	version = model.NewId()
+	s.doStandardAddToCache(cache, key, version)
+	return version
+}
+
 func (s *LocalCacheStore) doClearCacheCluster(cache cache.Cache) {
 	cache.Purge()
 	if s.cluster != nil {
@@ -510,5 +568,7 @@ func (s *LocalCacheStore) Invalidate() {
 	s.doClearCacheCluster(s.allUserCache)
 	s.doClearCacheCluster(s.profilesInChannelCache)
 	s.doClearCacheCluster(s.teamAllTeamIdsForUserCache)
+	s.doClearCacheCluster(s.teamMembersVersionCache)
+	s.doClearCacheCluster(s.preferencesVersionCache)
 	s.doClearCacheCluster(s.rolePermissionsCache)
 }
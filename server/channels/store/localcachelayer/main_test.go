@@ -176,8 +176,18 @@ func getMockStore(t *testing.T) *mocks.Store {
 	mockTeamStore := mocks.TeamStore{}
 	mockTeamStore.On("GetUserTeamIds", "123", true).Return(fakeUserTeamIds, nil)
 	mockTeamStore.On("GetUserTeamIds", "123", false).Return(fakeUserTeamIds, nil)
+	mockTeamStore.On("SaveMember", mock.Anything, mock.AnythingOfType("*model.TeamMember"), mock.AnythingOfType("int")).Return(&model.TeamMember{}, nil)
 	mockStore.On("Team").Return(&mockTeamStore)
 
+	mockPreferenceStore := mocks.PreferenceStore{}
+	mockPreferenceStore.On("Save", mock.AnythingOfType("model.Preferences")).Return(nil)
+	mockPreferenceStore.On("Delete", "123", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(nil)
+	mockStore.On("Preference").Return(&mockPreferenceStore)
+
+	mockOAuthStore := mocks.OAuthStore{}
+	mockOAuthStore.On("DeleteApp", "123").Return(nil)
+	mockStore.On("OAuth").Return(&mockOAuthStore)
+
 	return &mockStore
 }
 
@@ -0,0 +1,19 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package localcachelayer
+
+import (
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+)
+
+type LocalCacheOAuthStore struct {
+	store.OAuthStore
+	rootStore *LocalCacheStore
+}
+
+func (s LocalCacheOAuthStore) DeleteApp(id string) error {
+	// Deleting an app removes the authorized app preferences of every user that authorized it.
+	defer s.rootStore.preference.clearVersions()
+	return s.OAuthStore.DeleteApp(id)
+}
@@ -0,0 +1,93 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package localcachelayer
+
+import (
+	"bytes"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+)
+
+type LocalCachePreferenceStore struct {
+	store.PreferenceStore
+	rootStore *LocalCacheStore
+}
+
+func (s *LocalCachePreferenceStore) handleClusterInvalidatePreferencesVersion(msg *model.ClusterMessage) {
+	if bytes.Equal(msg.Data, clearCacheMessageData) {
+		s.rootStore.preferencesVersionCache.Purge()
+	} else {
+		s.rootStore.preferencesVersionCache.Remove(string(msg.Data))
+	}
+}
+
+func (s LocalCachePreferenceStore) invalidateVersion(userId string) {
+	s.rootStore.doInvalidateCacheCluster(s.rootStore.preferencesVersionCache, userId, nil)
+	if s.rootStore.metrics != nil {
+		s.rootStore.metrics.IncrementMemCacheInvalidationCounter(s.rootStore.preferencesVersionCache.Name())
+	}
+}
+
+func (s LocalCachePreferenceStore) clearVersions() {
+	s.rootStore.doClearCacheCluster(s.rootStore.preferencesVersionCache)
+	if s.rootStore.metrics != nil {
+		s.rootStore.metrics.IncrementMemCacheInvalidationCounter(s.rootStore.preferencesVersionCache.Name())
+	}
+}
+
+func (s LocalCachePreferenceStore) GetEtag(userId string) string {
+	return model.CurrentVersion + "." + s.rootStore.getVersion(s.rootStore.preferencesVersionCache, userId)
+}
+
+func (s LocalCachePreferenceStore) Save(preferences model.Preferences) error {
+	// The version is invalidated even when the save fails, since a part of the preferences may have been saved.
+	defer func() {
+		invalidated := make(map[string]bool)
+		for _, preference := range preferences {
+			if !invalidated[preference.UserId] {
+				s.invalidateVersion(preference.UserId)
+				invalidated[preference.UserId] = true
+			}
+		}
+	}()
+
+	return s.PreferenceStore.Save(preferences)
+}
+
+func (s LocalCachePreferenceStore) Delete(userId, category, name string) error {
+	defer s.invalidateVersion(userId)
+	return s.PreferenceStore.Delete(userId, category, name)
+}
+
+func (s LocalCachePreferenceStore) DeleteCategory(userId string, category string) error {
+	defer s.invalidateVersion(userId)
+	return s.PreferenceStore.DeleteCategory(userId, category)
+}
+
+func (s LocalCachePreferenceStore) DeleteCategoryAndName(category string, name string) error {
+	defer s.clearVersions()
+	return s.PreferenceStore.DeleteCategoryAndName(category, name)
+}
+
+func (s LocalCachePreferenceStore) PermanentDeleteByUser(userId string) error {
+	defer s.invalidateVersion(userId)
+	return s.PreferenceStore.PermanentDeleteByUser(userId)
+}
+
+func (s LocalCachePreferenceStore) DeleteOrphanedRows(limit int) (int64, error) {
+	deleted, err := s.PreferenceStore.DeleteOrphanedRows(limit)
+	if deleted > 0 {
+		s.clearVersions()
+	}
+	return deleted, err
+}
+
+func (s LocalCachePreferenceStore) CleanupFlagsBatch(limit int64) (int64, error) {
+	deleted, err := s.PreferenceStore.CleanupFlagsBatch(limit)
+	if deleted > 0 {
+		s.clearVersions()
+	}
+	return deleted, err
+}
@@ -0,0 +1,63 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package localcachelayer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/v8/channels/store/storetest"
+)
+
+func TestPreferenceStore(t *testing.T) {
+	StoreTest(t, storetest.TestPreferenceStore)
+}
+
+func TestPreferenceStoreEtag(t *testing.T) {
+	t.Run("the etag is the same until the preferences change", func(t *testing.T) {
+		mockStore := getMockStore(t)
+		mockCacheProvider := getMockCacheProvider()
+		cachedStore, err := NewLocalCacheLayer(mockStore, nil, nil, mockCacheProvider)
+		require.NoError(t, err)
+
+		etag := cachedStore.Preference().GetEtag("123")
+		assert.Equal(t, etag, cachedStore.Preference().GetEtag("123"))
+		assert.NotEqual(t, etag, cachedStore.Preference().GetEtag("456"))
+
+		err = cachedStore.Preference().Save(model.Preferences{{UserId: "123", Category: "category", Name: "name", Value: "value"}})
+		require.NoError(t, err)
+		savedEtag := cachedStore.Preference().GetEtag("123")
+		assert.NotEqual(t, etag, savedEtag)
+
+		err = cachedStore.Preference().Delete("123", "category", "name")
+		require.NoError(t, err)
+		assert.NotEqual(t, savedEtag, cachedStore.Preference().GetEtag("123"))
+	})
+
+	t.Run("deleting an oauth app changes the etags", func(t *testing.T) {
+		mockStore := getMockStore(t)
+		mockCacheProvider := getMockCacheProvider()
+		cachedStore, err := NewLocalCacheLayer(mockStore, nil, nil, mockCacheProvider)
+		require.NoError(t, err)
+
+		etag := cachedStore.Preference().GetEtag("123")
+		err = cachedStore.OAuth().DeleteApp("123")
+		require.NoError(t, err)
+		assert.NotEqual(t, etag, cachedStore.Preference().GetEtag("123"))
+	})
+
+	t.Run("clearing the caches changes the etags", func(t *testing.T) {
+		mockStore := getMockStore(t)
+		mockCacheProvider := getMockCacheProvider()
+		cachedStore, err := NewLocalCacheLayer(mockStore, nil, nil, mockCacheProvider)
+		require.NoError(t, err)
+
+		etag := cachedStore.Preference().GetEtag("123")
+		cachedStore.Invalidate()
+		assert.NotEqual(t, etag, cachedStore.Preference().GetEtag("123"))
+	})
+}
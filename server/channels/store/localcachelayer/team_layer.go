@@ -7,6 +7,7 @@ import (
 	"bytes"
 
 	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/request"
 	"github.com/mattermost/mattermost/server/v8/channels/store"
 )
 
@@ -23,6 +24,14 @@ func (s *LocalCacheTeamStore) handleClusterInvalidateTeam(msg *model.ClusterMess
 	}
 }
 
+func (s *LocalCacheTeamStore) handleClusterInvalidateMembersVersion(msg *model.ClusterMessage) {
+	if bytes.Equal(msg.Data, clearCacheMessageData) {
+		s.rootStore.teamMembersVersionCache.Purge()
+	} else {
+		s.rootStore.teamMembersVersionCache.Remove(string(msg.Data))
+	}
+}
+
 func (s LocalCacheTeamStore) ClearCaches() {
 	s.rootStore.teamAllTeamIdsForUserCache.Purge()
 	if s.rootStore.metrics != nil {
@@ -74,6 +83,8 @@ func (s LocalCacheTeamStore) Update(team *model.Team) (*model.Team, error) {
 		return nil, err
 	}
 	defer s.rootStore.doClearCacheCluster(s.rootStore.rolePermissionsCache)
+	// The roles of the members depend on the scheme of the team.
+	defer s.invalidateMembersVersion(team.Id)
 
 	if oldTeam != nil && oldTeam.DeleteAt == 0 {
 		s.rootStore.doClearCacheCluster(s.rootStore.teamAllTeamIdsForUserCache)
@@ -81,3 +92,96 @@ func (s LocalCacheTeamStore) Update(team *model.Team) (*model.Team, error) {
 
 	return tm, err
 }
+
+func (s LocalCacheTeamStore) invalidateMembersVersion(teamId string) {
+	s.rootStore.doInvalidateCacheCluster(s.rootStore.teamMembersVersionCache, teamId, nil)
+	if s.rootStore.metrics != nil {
+		s.rootStore.metrics.IncrementMemCacheInvalidationCounter(s.rootStore.teamMembersVersionCache.Name())
+	}
+}
+
+func (s LocalCacheTeamStore) invalidateMembersVersions(members []*model.TeamMember) {
+	invalidated := make(map[string]bool)
+	for _, member := range members {
+		if !invalidated[member.TeamId] {
+			s.invalidateMembersVersion(member.TeamId)
+			invalidated[member.TeamId] = true
+		}
+	}
+}
+
+func (s LocalCacheTeamStore) clearMembersVersions() {
+	s.rootStore.doClearCacheCluster(s.rootStore.teamMembersVersionCache)
+	if s.rootStore.metrics != nil {
+		s.rootStore.metrics.IncrementMemCacheInvalidationCounter(s.rootStore.teamMembersVersionCache.Name())
+	}
+}
+
+func (s LocalCacheTeamStore) GetEtagForMembers(teamId string) string {
+	return model.CurrentVersion + "." + s.rootStore.getVersion(s.rootStore.teamMembersVersionCache, teamId)
+}
+
+func (s LocalCacheTeamStore) SaveMember(rctx request.CTX, member *model.TeamMember, maxUsersPerTeam int) (*model.TeamMember, error) {
+	defer s.invalidateMembersVersion(member.TeamId)
+	return s.TeamStore.SaveMember(rctx, member, maxUsersPerTeam)
+}
+
+func (s LocalCacheTeamStore) SaveMultipleMembers(members []*model.TeamMember, maxUsersPerTeam int) ([]*model.TeamMember, error) {
+	defer s.invalidateMembersVersions(members)
+	return s.TeamStore.SaveMultipleMembers(members, maxUsersPerTeam)
+}
+
+func (s LocalCacheTeamStore) UpdateMember(rctx request.CTX, member *model.TeamMember) (*model.TeamMember, error) {
+	defer s.invalidateMembersVersion(member.TeamId)
+	return s.TeamStore.UpdateMember(rctx, member)
+}
+
+func (s LocalCacheTeamStore) UpdateMultipleMembers(members []*model.TeamMember) ([]*model.TeamMember, error) {
+	defer s.invalidateMembersVersions(members)
+	return s.TeamStore.UpdateMultipleMembers(members)
+}
+
+func (s LocalCacheTeamStore) UpdateMembersRole(teamId string, userIds []string) error {
+	defer s.invalidateMembersVersion(teamId)
+	return s.TeamStore.UpdateMembersRole(teamId, userIds)
+}
+
+func (s LocalCacheTeamStore) RemoveMember(rctx request.CTX, teamId string, userId string) error {
+	defer s.invalidateMembersVersion(teamId)
+	return s.TeamStore.RemoveMember(rctx, teamId, userId)
+}
+
+func (s LocalCacheTeamStore) RemoveMembers(rctx request.CTX, teamId string, userIds []string) error {
+	defer s.invalidateMembersVersion(teamId)
+	return s.TeamStore.RemoveMembers(rctx, teamId, userIds)
+}
+
+func (s LocalCacheTeamStore) RemoveAllMembersByTeam(teamId string) error {
+	defer s.invalidateMembersVersion(teamId)
+	return s.TeamStore.RemoveAllMembersByTeam(teamId)
+}
+
+func (s LocalCacheTeamStore) RemoveAllMembersByUser(rctx request.CTX, userId string) error {
+	defer s.clearMembersVersions()
+	return s.TeamStore.RemoveAllMembersByUser(rctx, userId)
+}
+
+func (s LocalCacheTeamStore) MigrateTeamMembers(fromTeamId string, fromUserId string) (map[string]string, error) {
+	defer s.clearMembersVersions()
+	return s.TeamStore.MigrateTeamMembers(fromTeamId, fromUserId)
+}
+
+func (s LocalCacheTeamStore) ResetAllTeamSchemes() error {
+	defer s.clearMembersVersions()
+	return s.TeamStore.ResetAllTeamSchemes()
+}
+
+func (s LocalCacheTeamStore) ClearAllCustomRoleAssignments() error {
+	defer s.clearMembersVersions()
+	return s.TeamStore.ClearAllCustomRoleAssignments()
+}
+
+func (s LocalCacheTeamStore) PermanentDelete(teamId string) error {
+	defer s.invalidateMembersVersion(teamId)
+	return s.TeamStore.PermanentDelete(teamId)
+}
@@ -9,6 +9,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/request"
 	"github.com/mattermost/mattermost/server/v8/channels/store/storetest"
 	"github.com/mattermost/mattermost/server/v8/channels/store/storetest/mocks"
 )
@@ -74,3 +76,22 @@ func TestTeamStoreCache(t *testing.T) {
 		mockStore.Team().(*mocks.TeamStore).AssertNumberOfCalls(t, "GetUserTeamIds", 2)
 	})
 }
+
+func TestTeamStoreMembersEtag(t *testing.T) {
+	t.Run("the etag is the same until the members change", func(t *testing.T) {
+		mockStore := getMockStore(t)
+		mockCacheProvider := getMockCacheProvider()
+		cachedStore, err := NewLocalCacheLayer(mockStore, nil, nil, mockCacheProvider)
+		require.NoError(t, err)
+
+		etag := cachedStore.Team().GetEtagForMembers("team1")
+		assert.Equal(t, etag, cachedStore.Team().GetEtagForMembers("team1"))
+		otherEtag := cachedStore.Team().GetEtagForMembers("team2")
+		assert.NotEqual(t, etag, otherEtag)
+
+		_, err = cachedStore.Team().SaveMember(request.TestContext(t), &model.TeamMember{TeamId: "team1", UserId: "123"}, 10)
+		require.NoError(t, err)
+		assert.NotEqual(t, etag, cachedStore.Team().GetEtagForMembers("team1"))
+		assert.Equal(t, otherEtag, cachedStore.Team().GetEtagForMembers("team2"))
+	})
+}
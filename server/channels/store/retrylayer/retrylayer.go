@@ -24,6 +24,7 @@ const mySQLDeadlockCode = uint16(1213)
 type RetryLayer struct {
 	store.Store
 	AuditStore                      store.AuditStore
+	AutomationRuleStore             store.AutomationRuleStore
 	BotStore                        store.BotStore
 	ChannelStore                    store.ChannelStore
 	ChannelBookmarkStore            store.ChannelBookmarkStore
@@ -32,14 +33,19 @@ type RetryLayer struct {
 	CommandStore                    store.CommandStore
 	CommandWebhookStore             store.CommandWebhookStore
 	ComplianceStore                 store.ComplianceStore
+	ConfigHistoryStore              store.ConfigHistoryStore
+	CustomProfileAttributeStore     store.CustomProfileAttributeStore
 	DesktopTokensStore              store.DesktopTokensStore
 	DraftStore                      store.DraftStore
 	EmojiStore                      store.EmojiStore
 	FileInfoStore                   store.FileInfoStore
+	FileShareLinkStore              store.FileShareLinkStore
 	GroupStore                      store.GroupStore
+	IntegrationDeliveryStore        store.IntegrationDeliveryStore
 	JobStore                        store.JobStore
 	LicenseStore                    store.LicenseStore
 	LinkMetadataStore               store.LinkMetadataStore
+	NotificationDeliveryStore       store.NotificationDeliveryStore
 	NotifyAdminStore                store.NotifyAdminStore
 	OAuthStore                      store.OAuthStore
 	OutgoingOAuthConnectionStore    store.OutgoingOAuthConnectionStore
@@ -63,6 +69,7 @@ type RetryLayer struct {
 	TermsOfServiceStore             store.TermsOfServiceStore
 	ThreadStore                     store.ThreadStore
 	TokenStore                      store.TokenStore
+	UndeliverableEmailStore         store.UndeliverableEmailStore
 	UploadSessionStore              store.UploadSessionStore
 	UserStore                       store.UserStore
 	UserAccessTokenStore            store.UserAccessTokenStore
@@ -74,6 +81,10 @@ func (s *RetryLayer) Audit() store.AuditStore {
 	return s.AuditStore
 }
 
+func (s *RetryLayer) AutomationRule() store.AutomationRuleStore {
+	return s.AutomationRuleStore
+}
+
 func (s *RetryLayer) Bot() store.BotStore {
 	return s.BotStore
 }
@@ -106,6 +117,14 @@ func (s *RetryLayer) Compliance() store.ComplianceStore {
 	return s.ComplianceStore
 }
 
+func (s *RetryLayer) ConfigHistory() store.ConfigHistoryStore {
+	return s.ConfigHistoryStore
+}
+
+func (s *RetryLayer) CustomProfileAttribute() store.CustomProfileAttributeStore {
+	return s.CustomProfileAttributeStore
+}
+
 func (s *RetryLayer) DesktopTokens() store.DesktopTokensStore {
 	return s.DesktopTokensStore
 }
@@ -122,10 +141,18 @@ func (s *RetryLayer) FileInfo() store.FileInfoStore {
 	return s.FileInfoStore
 }
 
+func (s *RetryLayer) FileShareLink() store.FileShareLinkStore {
+	return s.FileShareLinkStore
+}
+
 func (s *RetryLayer) Group() store.GroupStore {
 	return s.GroupStore
 }
 
+func (s *RetryLayer) IntegrationDelivery() store.IntegrationDeliveryStore {
+	return s.IntegrationDeliveryStore
+}
+
 func (s *RetryLayer) Job() store.JobStore {
 	return s.JobStore
 }
@@ -138,6 +165,10 @@ func (s *RetryLayer) LinkMetadata() store.LinkMetadataStore {
 	return s.LinkMetadataStore
 }
 
+func (s *RetryLayer) NotificationDelivery() store.NotificationDeliveryStore {
+	return s.NotificationDeliveryStore
+}
+
 func (s *RetryLayer) NotifyAdmin() store.NotifyAdminStore {
 	return s.NotifyAdminStore
 }
@@ -230,6 +261,10 @@ func (s *RetryLayer) Token() store.TokenStore {
 	return s.TokenStore
 }
 
+func (s *RetryLayer) UndeliverableEmail() store.UndeliverableEmailStore {
+	return s.UndeliverableEmailStore
+}
+
 func (s *RetryLayer) UploadSession() store.UploadSessionStore {
 	return s.UploadSessionStore
 }
@@ -255,6 +290,11 @@ type RetryLayerAuditStore struct {
 	Root *RetryLayer
 }
 
+type RetryLayerAutomationRuleStore struct {
+	store.AutomationRuleStore
+	Root *RetryLayer
+}
+
 type RetryLayerBotStore struct {
 	store.BotStore
 	Root *RetryLayer
@@ -295,6 +335,16 @@ type RetryLayerComplianceStore struct {
 	Root *RetryLayer
 }
 
+type RetryLayerConfigHistoryStore struct {
+	store.ConfigHistoryStore
+	Root *RetryLayer
+}
+
+type RetryLayerCustomProfileAttributeStore struct {
+	store.CustomProfileAttributeStore
+	Root *RetryLayer
+}
+
 type RetryLayerDesktopTokensStore struct {
 	store.DesktopTokensStore
 	Root *RetryLayer
@@ -315,11 +365,21 @@ type RetryLayerFileInfoStore struct {
 	Root *RetryLayer
 }
 
+type RetryLayerFileShareLinkStore struct {
+	store.FileShareLinkStore
+	Root *RetryLayer
+}
+
 type RetryLayerGroupStore struct {
 	store.GroupStore
 	Root *RetryLayer
 }
 
+type RetryLayerIntegrationDeliveryStore struct {
+	store.IntegrationDeliveryStore
+	Root *RetryLayer
+}
+
 type RetryLayerJobStore struct {
 	store.JobStore
 	Root *RetryLayer
@@ -335,6 +395,11 @@ type RetryLayerLinkMetadataStore struct {
 	Root *RetryLayer
 }
 
+type RetryLayerNotificationDeliveryStore struct {
+	store.NotificationDeliveryStore
+	Root *RetryLayer
+}
+
 type RetryLayerNotifyAdminStore struct {
 	store.NotifyAdminStore
 	Root *RetryLayer
@@ -450,6 +515,11 @@ type RetryLayerTokenStore struct {
 	Root *RetryLayer
 }
 
+type RetryLayerUndeliverableEmailStore struct {
+	store.UndeliverableEmailStore
+	Root *RetryLayer
+}
+
 type RetryLayerUploadSessionStore struct {
 	store.UploadSessionStore
 	Root *RetryLayer
@@ -554,6 +624,132 @@ func (s *RetryLayerAuditStore) Save(audit *model.Audit) error {
 
 }
 
+func (s *RetryLayerAutomationRuleStore) Delete(id string, deleteAt int64) error {
+
+	tries := 0
+	for {
+		err := s.AutomationRuleStore.Delete(id, deleteAt)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerAutomationRuleStore) Get(id string) (*model.AutomationRule, error) {
+
+	tries := 0
+	for {
+		result, err := s.AutomationRuleStore.Get(id)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerAutomationRuleStore) GetEnabledForTrigger(teamID string, triggerType string) ([]*model.AutomationRule, error) {
+
+	tries := 0
+	for {
+		result, err := s.AutomationRuleStore.GetEnabledForTrigger(teamID, triggerType)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerAutomationRuleStore) GetForTeam(teamID string, page int, perPage int) ([]*model.AutomationRule, error) {
+
+	tries := 0
+	for {
+		result, err := s.AutomationRuleStore.GetForTeam(teamID, page, perPage)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerAutomationRuleStore) Save(rule *model.AutomationRule) (*model.AutomationRule, error) {
+
+	tries := 0
+	for {
+		result, err := s.AutomationRuleStore.Save(rule)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerAutomationRuleStore) Update(rule *model.AutomationRule) (*model.AutomationRule, error) {
+
+	tries := 0
+	for {
+		result, err := s.AutomationRuleStore.Update(rule)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
 func (s *RetryLayerBotStore) Get(userID string, includeDeleted bool) (*model.Bot, error) {
 
 	tries := 0
@@ -1574,6 +1770,12 @@ func (s *RetryLayerChannelStore) GetDeletedByName(team_id string, name string) (
 
 }
 
+func (s *RetryLayerChannelStore) GetEtagForUserChannels(userID string, teamID string) string {
+
+	return s.ChannelStore.GetEtagForUserChannels(userID, teamID)
+
+}
+
 func (s *RetryLayerChannelStore) GetFileCount(channelID string) (int64, error) {
 
 	tries := 0
@@ -1790,6 +1992,27 @@ func (s *RetryLayerChannelStore) GetMembers(channelID string, offset int, limit
 
 }
 
+func (s *RetryLayerChannelStore) GetMembersAfter(channelID string, opts *model.ChannelMembersGetOptions) (model.ChannelMembers, error) {
+
+	tries := 0
+	for {
+		result, err := s.ChannelStore.GetMembersAfter(channelID, opts)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
 func (s *RetryLayerChannelStore) GetMembersByChannelIds(channelIds []string, userID string) (model.ChannelMembers, error) {
 
 	tries := 0
@@ -2105,6 +2328,27 @@ func (s *RetryLayerChannelStore) GetSidebarCategoryOrder(userID string, teamID s
 
 }
 
+func (s *RetryLayerChannelStore) GetSidebarCategoryUnreads(userID string) ([]*model.SidebarCategoryUnread, error) {
+
+	tries := 0
+	for {
+		result, err := s.ChannelStore.GetSidebarCategoryUnreads(userID)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
 func (s *RetryLayerChannelStore) GetTeamChannels(teamID string) (model.ChannelList, error) {
 
 	tries := 0
@@ -3686,6 +3930,27 @@ func (s *RetryLayerComplianceStore) ComplianceExport(compliance *model.Complianc
 
 }
 
+func (s *RetryLayerComplianceStore) ComplianceExportIncremental(compliance *model.Compliance, cursor model.ComplianceIncrementalExportCursor, limit int) ([]*model.CompliancePost, model.ComplianceIncrementalExportCursor, error) {
+
+	tries := 0
+	for {
+		result, resultVar1, err := s.ComplianceStore.ComplianceExportIncremental(compliance, cursor, limit)
+		if err == nil {
+			return result, resultVar1, nil
+		}
+		if !isRepeatableError(err) {
+			return result, resultVar1, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, resultVar1, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
 func (s *RetryLayerComplianceStore) Get(id string) (*model.Compliance, error) {
 
 	tries := 0
@@ -3728,6 +3993,27 @@ func (s *RetryLayerComplianceStore) GetAll(offset int, limit int) (model.Complia
 
 }
 
+func (s *RetryLayerComplianceStore) GetLastSuccessfulIncremental() (*model.Compliance, error) {
+
+	tries := 0
+	for {
+		result, err := s.ComplianceStore.GetLastSuccessfulIncremental()
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
 func (s *RetryLayerComplianceStore) MessageExport(c request.CTX, cursor model.MessageExportCursor, limit int) ([]*model.MessageExport, model.MessageExportCursor, error) {
 
 	tries := 0
@@ -3791,76 +4077,328 @@ func (s *RetryLayerComplianceStore) Update(compliance *model.Compliance) (*model
 
 }
 
-func (s *RetryLayerDesktopTokensStore) Delete(token string) error {
+func (s *RetryLayerConfigHistoryStore) GetAll(offset int, limit int) ([]*model.ConfigHistory, error) {
 
 	tries := 0
 	for {
-		err := s.DesktopTokensStore.Delete(token)
+		result, err := s.ConfigHistoryStore.GetAll(offset, limit)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerDesktopTokensStore) DeleteByUserId(userId string) error {
+func (s *RetryLayerConfigHistoryStore) GetByVersion(version int64) (*model.ConfigHistory, error) {
 
 	tries := 0
 	for {
-		err := s.DesktopTokensStore.DeleteByUserId(userId)
+		result, err := s.ConfigHistoryStore.GetByVersion(version)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerDesktopTokensStore) DeleteOlderThan(minCreatedAt int64) error {
+func (s *RetryLayerConfigHistoryStore) Save(history *model.ConfigHistory) (*model.ConfigHistory, error) {
 
 	tries := 0
 	for {
-		err := s.DesktopTokensStore.DeleteOlderThan(minCreatedAt)
+		result, err := s.ConfigHistoryStore.Save(history)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerDesktopTokensStore) GetUserId(token string, minCreatedAt int64) (*string, error) {
+func (s *RetryLayerCustomProfileAttributeStore) DeleteField(id string, deleteAt int64) error {
 
 	tries := 0
 	for {
-		result, err := s.DesktopTokensStore.GetUserId(token, minCreatedAt)
+		err := s.CustomProfileAttributeStore.DeleteField(id, deleteAt)
 		if err == nil {
-			return result, nil
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerCustomProfileAttributeStore) DeleteValues(userID string, fieldIDs []string) error {
+
+	tries := 0
+	for {
+		err := s.CustomProfileAttributeStore.DeleteValues(userID, fieldIDs)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerCustomProfileAttributeStore) GetField(id string) (*model.CustomProfileAttributeField, error) {
+
+	tries := 0
+	for {
+		result, err := s.CustomProfileAttributeStore.GetField(id)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerCustomProfileAttributeStore) GetFields() ([]*model.CustomProfileAttributeField, error) {
+
+	tries := 0
+	for {
+		result, err := s.CustomProfileAttributeStore.GetFields()
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerCustomProfileAttributeStore) GetValuesForUsers(userIDs []string) ([]*model.CustomProfileAttributeValue, error) {
+
+	tries := 0
+	for {
+		result, err := s.CustomProfileAttributeStore.GetValuesForUsers(userIDs)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerCustomProfileAttributeStore) PermanentDeleteValuesByUser(userID string) error {
+
+	tries := 0
+	for {
+		err := s.CustomProfileAttributeStore.PermanentDeleteValuesByUser(userID)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerCustomProfileAttributeStore) SaveField(field *model.CustomProfileAttributeField) (*model.CustomProfileAttributeField, error) {
+
+	tries := 0
+	for {
+		result, err := s.CustomProfileAttributeStore.SaveField(field)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerCustomProfileAttributeStore) UpdateField(field *model.CustomProfileAttributeField) (*model.CustomProfileAttributeField, error) {
+
+	tries := 0
+	for {
+		result, err := s.CustomProfileAttributeStore.UpdateField(field)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerCustomProfileAttributeStore) UpsertValues(values []*model.CustomProfileAttributeValue) error {
+
+	tries := 0
+	for {
+		err := s.CustomProfileAttributeStore.UpsertValues(values)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerDesktopTokensStore) Delete(token string) error {
+
+	tries := 0
+	for {
+		err := s.DesktopTokensStore.Delete(token)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerDesktopTokensStore) DeleteByUserId(userId string) error {
+
+	tries := 0
+	for {
+		err := s.DesktopTokensStore.DeleteByUserId(userId)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerDesktopTokensStore) DeleteOlderThan(minCreatedAt int64) error {
+
+	tries := 0
+	for {
+		err := s.DesktopTokensStore.DeleteOlderThan(minCreatedAt)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerDesktopTokensStore) GetUserId(token string, minCreatedAt int64) (*string, error) {
+
+	tries := 0
+	for {
+		result, err := s.DesktopTokensStore.GetUserId(token, minCreatedAt)
+		if err == nil {
+			return result, nil
 		}
 		if !isRepeatableError(err) {
 			return result, err
@@ -4127,11 +4665,206 @@ func (s *RetryLayerEmojiStore) GetByName(c request.CTX, name string, allowFromCa
 
 }
 
-func (s *RetryLayerEmojiStore) GetList(offset int, limit int, sort string) ([]*model.Emoji, error) {
+func (s *RetryLayerEmojiStore) GetList(offset int, limit int, sort string) ([]*model.Emoji, error) {
+
+	tries := 0
+	for {
+		result, err := s.EmojiStore.GetList(offset, limit, sort)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerEmojiStore) GetMultipleByName(c request.CTX, names []string) ([]*model.Emoji, error) {
+
+	tries := 0
+	for {
+		result, err := s.EmojiStore.GetMultipleByName(c, names)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerEmojiStore) Save(emoji *model.Emoji) (*model.Emoji, error) {
+
+	tries := 0
+	for {
+		result, err := s.EmojiStore.Save(emoji)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerEmojiStore) Search(name string, prefixOnly bool, limit int) ([]*model.Emoji, error) {
+
+	tries := 0
+	for {
+		result, err := s.EmojiStore.Search(name, prefixOnly, limit)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerFileInfoStore) AttachToPost(c request.CTX, fileID string, postID string, channelID string, creatorID string) error {
+
+	tries := 0
+	for {
+		err := s.FileInfoStore.AttachToPost(c, fileID, postID, channelID, creatorID)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerFileInfoStore) ClearCaches() {
+
+	s.FileInfoStore.ClearCaches()
+
+}
+
+func (s *RetryLayerFileInfoStore) CountAll() (int64, error) {
+
+	tries := 0
+	for {
+		result, err := s.FileInfoStore.CountAll()
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerFileInfoStore) CountByPath(path string) (int64, error) {
+
+	tries := 0
+	for {
+		result, err := s.FileInfoStore.CountByPath(path)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerFileInfoStore) DeleteForPost(c request.CTX, postID string) (string, error) {
+
+	tries := 0
+	for {
+		result, err := s.FileInfoStore.DeleteForPost(c, postID)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerFileInfoStore) Get(id string) (*model.FileInfo, error) {
+
+	tries := 0
+	for {
+		result, err := s.FileInfoStore.Get(id)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerFileInfoStore) GetBatchWithoutContentHash(startTime int64, startFileID string, limit int) ([]*model.FileInfo, error) {
 
 	tries := 0
 	for {
-		result, err := s.EmojiStore.GetList(offset, limit, sort)
+		result, err := s.FileInfoStore.GetBatchWithoutContentHash(startTime, startFileID, limit)
 		if err == nil {
 			return result, nil
 		}
@@ -4148,11 +4881,11 @@ func (s *RetryLayerEmojiStore) GetList(offset int, limit int, sort string) ([]*m
 
 }
 
-func (s *RetryLayerEmojiStore) GetMultipleByName(c request.CTX, names []string) ([]*model.Emoji, error) {
+func (s *RetryLayerFileInfoStore) GetByContentHash(hash string, size int64) (*model.FileInfo, error) {
 
 	tries := 0
 	for {
-		result, err := s.EmojiStore.GetMultipleByName(c, names)
+		result, err := s.FileInfoStore.GetByContentHash(hash, size)
 		if err == nil {
 			return result, nil
 		}
@@ -4169,11 +4902,11 @@ func (s *RetryLayerEmojiStore) GetMultipleByName(c request.CTX, names []string)
 
 }
 
-func (s *RetryLayerEmojiStore) Save(emoji *model.Emoji) (*model.Emoji, error) {
+func (s *RetryLayerFileInfoStore) GetByIds(ids []string) ([]*model.FileInfo, error) {
 
 	tries := 0
 	for {
-		result, err := s.EmojiStore.Save(emoji)
+		result, err := s.FileInfoStore.GetByIds(ids)
 		if err == nil {
 			return result, nil
 		}
@@ -4190,11 +4923,11 @@ func (s *RetryLayerEmojiStore) Save(emoji *model.Emoji) (*model.Emoji, error) {
 
 }
 
-func (s *RetryLayerEmojiStore) Search(name string, prefixOnly bool, limit int) ([]*model.Emoji, error) {
+func (s *RetryLayerFileInfoStore) GetByPath(path string) (*model.FileInfo, error) {
 
 	tries := 0
 	for {
-		result, err := s.EmojiStore.Search(name, prefixOnly, limit)
+		result, err := s.FileInfoStore.GetByPath(path)
 		if err == nil {
 			return result, nil
 		}
@@ -4211,38 +4944,32 @@ func (s *RetryLayerEmojiStore) Search(name string, prefixOnly bool, limit int) (
 
 }
 
-func (s *RetryLayerFileInfoStore) AttachToPost(c request.CTX, fileID string, postID string, channelID string, creatorID string) error {
+func (s *RetryLayerFileInfoStore) GetFilesBatchForIndexing(startTime int64, startFileID string, includeDeleted bool, limit int) ([]*model.FileForIndexing, error) {
 
 	tries := 0
 	for {
-		err := s.FileInfoStore.AttachToPost(c, fileID, postID, channelID, creatorID)
+		result, err := s.FileInfoStore.GetFilesBatchForIndexing(startTime, startFileID, includeDeleted, limit)
 		if err == nil {
-			return nil
+			return result, nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerFileInfoStore) ClearCaches() {
-
-	s.FileInfoStore.ClearCaches()
-
-}
-
-func (s *RetryLayerFileInfoStore) CountAll() (int64, error) {
+func (s *RetryLayerFileInfoStore) GetForPost(postID string, readFromMaster bool, includeDeleted bool, allowFromCache bool) ([]*model.FileInfo, error) {
 
 	tries := 0
 	for {
-		result, err := s.FileInfoStore.CountAll()
+		result, err := s.FileInfoStore.GetForPost(postID, readFromMaster, includeDeleted, allowFromCache)
 		if err == nil {
 			return result, nil
 		}
@@ -4259,11 +4986,11 @@ func (s *RetryLayerFileInfoStore) CountAll() (int64, error) {
 
 }
 
-func (s *RetryLayerFileInfoStore) DeleteForPost(c request.CTX, postID string) (string, error) {
+func (s *RetryLayerFileInfoStore) GetForUser(userID string) ([]*model.FileInfo, error) {
 
 	tries := 0
 	for {
-		result, err := s.FileInfoStore.DeleteForPost(c, postID)
+		result, err := s.FileInfoStore.GetForUser(userID)
 		if err == nil {
 			return result, nil
 		}
@@ -4280,11 +5007,11 @@ func (s *RetryLayerFileInfoStore) DeleteForPost(c request.CTX, postID string) (s
 
 }
 
-func (s *RetryLayerFileInfoStore) Get(id string) (*model.FileInfo, error) {
+func (s *RetryLayerFileInfoStore) GetFromMaster(id string) (*model.FileInfo, error) {
 
 	tries := 0
 	for {
-		result, err := s.FileInfoStore.Get(id)
+		result, err := s.FileInfoStore.GetFromMaster(id)
 		if err == nil {
 			return result, nil
 		}
@@ -4301,11 +5028,11 @@ func (s *RetryLayerFileInfoStore) Get(id string) (*model.FileInfo, error) {
 
 }
 
-func (s *RetryLayerFileInfoStore) GetByIds(ids []string) ([]*model.FileInfo, error) {
+func (s *RetryLayerFileInfoStore) GetStorageUsage(allowFromCache bool, includeDeleted bool) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.FileInfoStore.GetByIds(ids)
+		result, err := s.FileInfoStore.GetStorageUsage(allowFromCache, includeDeleted)
 		if err == nil {
 			return result, nil
 		}
@@ -4322,11 +5049,11 @@ func (s *RetryLayerFileInfoStore) GetByIds(ids []string) ([]*model.FileInfo, err
 
 }
 
-func (s *RetryLayerFileInfoStore) GetByPath(path string) (*model.FileInfo, error) {
+func (s *RetryLayerFileInfoStore) GetUptoNSizeFileTime(n int64) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.FileInfoStore.GetByPath(path)
+		result, err := s.FileInfoStore.GetUptoNSizeFileTime(n)
 		if err == nil {
 			return result, nil
 		}
@@ -4343,11 +5070,11 @@ func (s *RetryLayerFileInfoStore) GetByPath(path string) (*model.FileInfo, error
 
 }
 
-func (s *RetryLayerFileInfoStore) GetFilesBatchForIndexing(startTime int64, startFileID string, includeDeleted bool, limit int) ([]*model.FileForIndexing, error) {
+func (s *RetryLayerFileInfoStore) GetWithOptions(page int, perPage int, opt *model.GetFileInfosOptions) ([]*model.FileInfo, error) {
 
 	tries := 0
 	for {
-		result, err := s.FileInfoStore.GetFilesBatchForIndexing(startTime, startFileID, includeDeleted, limit)
+		result, err := s.FileInfoStore.GetWithOptions(page, perPage, opt)
 		if err == nil {
 			return result, nil
 		}
@@ -4364,32 +5091,38 @@ func (s *RetryLayerFileInfoStore) GetFilesBatchForIndexing(startTime int64, star
 
 }
 
-func (s *RetryLayerFileInfoStore) GetForPost(postID string, readFromMaster bool, includeDeleted bool, allowFromCache bool) ([]*model.FileInfo, error) {
+func (s *RetryLayerFileInfoStore) InvalidateFileInfosForPostCache(postID string, deleted bool) {
+
+	s.FileInfoStore.InvalidateFileInfosForPostCache(postID, deleted)
+
+}
+
+func (s *RetryLayerFileInfoStore) PermanentDelete(c request.CTX, fileID string) error {
 
 	tries := 0
 	for {
-		result, err := s.FileInfoStore.GetForPost(postID, readFromMaster, includeDeleted, allowFromCache)
+		err := s.FileInfoStore.PermanentDelete(c, fileID)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerFileInfoStore) GetForUser(userID string) ([]*model.FileInfo, error) {
+func (s *RetryLayerFileInfoStore) PermanentDeleteBatch(ctx request.CTX, endTime int64, limit int64) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.FileInfoStore.GetForUser(userID)
+		result, err := s.FileInfoStore.PermanentDeleteBatch(ctx, endTime, limit)
 		if err == nil {
 			return result, nil
 		}
@@ -4406,11 +5139,11 @@ func (s *RetryLayerFileInfoStore) GetForUser(userID string) ([]*model.FileInfo,
 
 }
 
-func (s *RetryLayerFileInfoStore) GetFromMaster(id string) (*model.FileInfo, error) {
+func (s *RetryLayerFileInfoStore) PermanentDeleteByUser(ctx request.CTX, userID string) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.FileInfoStore.GetFromMaster(id)
+		result, err := s.FileInfoStore.PermanentDeleteByUser(ctx, userID)
 		if err == nil {
 			return result, nil
 		}
@@ -4427,11 +5160,11 @@ func (s *RetryLayerFileInfoStore) GetFromMaster(id string) (*model.FileInfo, err
 
 }
 
-func (s *RetryLayerFileInfoStore) GetStorageUsage(allowFromCache bool, includeDeleted bool) (int64, error) {
+func (s *RetryLayerFileInfoStore) Save(ctx request.CTX, info *model.FileInfo) (*model.FileInfo, error) {
 
 	tries := 0
 	for {
-		result, err := s.FileInfoStore.GetStorageUsage(allowFromCache, includeDeleted)
+		result, err := s.FileInfoStore.Save(ctx, info)
 		if err == nil {
 			return result, nil
 		}
@@ -4448,11 +5181,11 @@ func (s *RetryLayerFileInfoStore) GetStorageUsage(allowFromCache bool, includeDe
 
 }
 
-func (s *RetryLayerFileInfoStore) GetUptoNSizeFileTime(n int64) (int64, error) {
+func (s *RetryLayerFileInfoStore) Search(ctx request.CTX, paramsList []*model.SearchParams, userID string, teamID string, page int, perPage int) (*model.FileInfoList, error) {
 
 	tries := 0
 	for {
-		result, err := s.FileInfoStore.GetUptoNSizeFileTime(n)
+		result, err := s.FileInfoStore.Search(ctx, paramsList, userID, teamID, page, perPage)
 		if err == nil {
 			return result, nil
 		}
@@ -4469,38 +5202,32 @@ func (s *RetryLayerFileInfoStore) GetUptoNSizeFileTime(n int64) (int64, error) {
 
 }
 
-func (s *RetryLayerFileInfoStore) GetWithOptions(page int, perPage int, opt *model.GetFileInfosOptions) ([]*model.FileInfo, error) {
+func (s *RetryLayerFileInfoStore) SetContent(ctx request.CTX, fileID string, content string) error {
 
 	tries := 0
 	for {
-		result, err := s.FileInfoStore.GetWithOptions(page, perPage, opt)
+		err := s.FileInfoStore.SetContent(ctx, fileID, content)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerFileInfoStore) InvalidateFileInfosForPostCache(postID string, deleted bool) {
-
-	s.FileInfoStore.InvalidateFileInfosForPostCache(postID, deleted)
-
-}
-
-func (s *RetryLayerFileInfoStore) PermanentDelete(c request.CTX, fileID string) error {
+func (s *RetryLayerFileInfoStore) SetContentHash(ctx request.CTX, fileID string, hash string) error {
 
 	tries := 0
 	for {
-		err := s.FileInfoStore.PermanentDelete(c, fileID)
+		err := s.FileInfoStore.SetContentHash(ctx, fileID, hash)
 		if err == nil {
 			return nil
 		}
@@ -4517,11 +5244,11 @@ func (s *RetryLayerFileInfoStore) PermanentDelete(c request.CTX, fileID string)
 
 }
 
-func (s *RetryLayerFileInfoStore) PermanentDeleteBatch(ctx request.CTX, endTime int64, limit int64) (int64, error) {
+func (s *RetryLayerFileInfoStore) Upsert(rctx request.CTX, info *model.FileInfo) (*model.FileInfo, error) {
 
 	tries := 0
 	for {
-		result, err := s.FileInfoStore.PermanentDeleteBatch(ctx, endTime, limit)
+		result, err := s.FileInfoStore.Upsert(rctx, info)
 		if err == nil {
 			return result, nil
 		}
@@ -4538,11 +5265,11 @@ func (s *RetryLayerFileInfoStore) PermanentDeleteBatch(ctx request.CTX, endTime
 
 }
 
-func (s *RetryLayerFileInfoStore) PermanentDeleteByUser(ctx request.CTX, userID string) (int64, error) {
+func (s *RetryLayerFileShareLinkStore) Get(id string) (*model.FileShareLink, error) {
 
 	tries := 0
 	for {
-		result, err := s.FileInfoStore.PermanentDeleteByUser(ctx, userID)
+		result, err := s.FileShareLinkStore.Get(id)
 		if err == nil {
 			return result, nil
 		}
@@ -4559,11 +5286,11 @@ func (s *RetryLayerFileInfoStore) PermanentDeleteByUser(ctx request.CTX, userID
 
 }
 
-func (s *RetryLayerFileInfoStore) Save(ctx request.CTX, info *model.FileInfo) (*model.FileInfo, error) {
+func (s *RetryLayerFileShareLinkStore) GetForFile(fileID string) ([]*model.FileShareLink, error) {
 
 	tries := 0
 	for {
-		result, err := s.FileInfoStore.Save(ctx, info)
+		result, err := s.FileShareLinkStore.GetForFile(fileID)
 		if err == nil {
 			return result, nil
 		}
@@ -4580,32 +5307,32 @@ func (s *RetryLayerFileInfoStore) Save(ctx request.CTX, info *model.FileInfo) (*
 
 }
 
-func (s *RetryLayerFileInfoStore) Search(ctx request.CTX, paramsList []*model.SearchParams, userID string, teamID string, page int, perPage int) (*model.FileInfoList, error) {
+func (s *RetryLayerFileShareLinkStore) IncrementDownloadCount(id string, now int64) error {
 
 	tries := 0
 	for {
-		result, err := s.FileInfoStore.Search(ctx, paramsList, userID, teamID, page, perPage)
+		err := s.FileShareLinkStore.IncrementDownloadCount(id, now)
 		if err == nil {
-			return result, nil
+			return nil
 		}
 		if !isRepeatableError(err) {
-			return result, err
+			return err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return result, err
+			return err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerFileInfoStore) SetContent(ctx request.CTX, fileID string, content string) error {
+func (s *RetryLayerFileShareLinkStore) Revoke(id string, deleteAt int64) error {
 
 	tries := 0
 	for {
-		err := s.FileInfoStore.SetContent(ctx, fileID, content)
+		err := s.FileShareLinkStore.Revoke(id, deleteAt)
 		if err == nil {
 			return nil
 		}
@@ -4622,11 +5349,11 @@ func (s *RetryLayerFileInfoStore) SetContent(ctx request.CTX, fileID string, con
 
 }
 
-func (s *RetryLayerFileInfoStore) Upsert(rctx request.CTX, info *model.FileInfo) (*model.FileInfo, error) {
+func (s *RetryLayerFileShareLinkStore) Save(link *model.FileShareLink) (*model.FileShareLink, error) {
 
 	tries := 0
 	for {
-		result, err := s.FileInfoStore.Upsert(rctx, info)
+		result, err := s.FileShareLinkStore.Save(link)
 		if err == nil {
 			return result, nil
 		}
@@ -5735,6 +6462,27 @@ func (s *RetryLayerGroupStore) UpdateGroupSyncable(groupSyncable *model.GroupSyn
 
 }
 
+func (s *RetryLayerGroupStore) UpdateLastMentionAt(groupID string, mentionAt int64, cooldown int64) (bool, error) {
+
+	tries := 0
+	for {
+		result, err := s.GroupStore.UpdateLastMentionAt(groupID, mentionAt, cooldown)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
 func (s *RetryLayerGroupStore) UpsertMember(groupID string, userID string) (*model.GroupMember, error) {
 
 	tries := 0
@@ -5760,7 +6508,133 @@ func (s *RetryLayerGroupStore) UpsertMembers(groupID string, userIDs []string) (
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.UpsertMembers(groupID, userIDs)
+		result, err := s.GroupStore.UpsertMembers(groupID, userIDs)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerIntegrationDeliveryStore) Cleanup(expiryTime int64, batchSize int) error {
+
+	tries := 0
+	for {
+		err := s.IntegrationDeliveryStore.Cleanup(expiryTime, batchSize)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerIntegrationDeliveryStore) Get(id string) (*model.IntegrationDelivery, error) {
+
+	tries := 0
+	for {
+		result, err := s.IntegrationDeliveryStore.Get(id)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerIntegrationDeliveryStore) GetForHook(hookID string, page int, perPage int) ([]*model.IntegrationDelivery, error) {
+
+	tries := 0
+	for {
+		result, err := s.IntegrationDeliveryStore.GetForHook(hookID, page, perPage)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerIntegrationDeliveryStore) PermanentDeleteByHook(hookID string) error {
+
+	tries := 0
+	for {
+		err := s.IntegrationDeliveryStore.PermanentDeleteByHook(hookID)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerIntegrationDeliveryStore) PruneForHook(hookID string, keep int) error {
+
+	tries := 0
+	for {
+		err := s.IntegrationDeliveryStore.PruneForHook(hookID, keep)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerIntegrationDeliveryStore) Save(delivery *model.IntegrationDelivery) (*model.IntegrationDelivery, error) {
+
+	tries := 0
+	for {
+		result, err := s.IntegrationDeliveryStore.Save(delivery)
 		if err == nil {
 			return result, nil
 		}
@@ -6218,6 +7092,69 @@ func (s *RetryLayerLinkMetadataStore) Save(linkMetadata *model.LinkMetadata) (*m
 
 }
 
+func (s *RetryLayerNotificationDeliveryStore) Cleanup(expiryTime int64, batchSize int) error {
+
+	tries := 0
+	for {
+		err := s.NotificationDeliveryStore.Cleanup(expiryTime, batchSize)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerNotificationDeliveryStore) GetForUser(userID string, opts model.NotificationDeliveryGetOptions) ([]*model.NotificationDelivery, error) {
+
+	tries := 0
+	for {
+		result, err := s.NotificationDeliveryStore.GetForUser(userID, opts)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerNotificationDeliveryStore) Save(delivery *model.NotificationDelivery) (*model.NotificationDelivery, error) {
+
+	tries := 0
+	for {
+		result, err := s.NotificationDeliveryStore.Save(delivery)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
 func (s *RetryLayerNotifyAdminStore) DeleteBefore(trial bool, now int64) error {
 
 	tries := 0
@@ -8468,6 +9405,12 @@ func (s *RetryLayerPreferenceStore) GetCategoryAndName(category string, nane str
 
 }
 
+func (s *RetryLayerPreferenceStore) GetEtag(userID string) string {
+
+	return s.PreferenceStore.GetEtag(userID)
+
+}
+
 func (s *RetryLayerPreferenceStore) PermanentDeleteByUser(userID string) error {
 
 	tries := 0
@@ -11330,6 +12273,27 @@ func (s *RetryLayerTeamStore) GetChannelUnreadsForTeam(teamID string, userID str
 
 }
 
+func (s *RetryLayerTeamStore) GetChannelUnreadsSince(userID string, since int64, limit int) ([]*model.ChannelUnread, error) {
+
+	tries := 0
+	for {
+		result, err := s.TeamStore.GetChannelUnreadsSince(userID, since, limit)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
 func (s *RetryLayerTeamStore) GetCommonTeamIDsForMultipleUsers(userIDs []string) ([]string, error) {
 
 	tries := 0
@@ -11372,6 +12336,12 @@ func (s *RetryLayerTeamStore) GetCommonTeamIDsForTwoUsers(userID string, otherUs
 
 }
 
+func (s *RetryLayerTeamStore) GetEtagForMembers(teamID string) string {
+
+	return s.TeamStore.GetEtagForMembers(teamID)
+
+}
+
 func (s *RetryLayerTeamStore) GetMany(ids []string) ([]*model.Team, error) {
 
 	tries := 0
@@ -12071,6 +13041,27 @@ func (s *RetryLayerTermsOfServiceStore) Get(id string, allowFromCache bool) (*mo
 
 }
 
+func (s *RetryLayerTermsOfServiceStore) GetAll(offset int, limit int) ([]*model.TermsOfService, error) {
+
+	tries := 0
+	for {
+		result, err := s.TermsOfServiceStore.GetAll(offset, limit)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
 func (s *RetryLayerTermsOfServiceStore) GetLatest(allowFromCache bool) (*model.TermsOfService, error) {
 
 	tries := 0
@@ -12707,6 +13698,69 @@ func (s *RetryLayerTokenStore) Save(recovery *model.Token) error {
 
 }
 
+func (s *RetryLayerUndeliverableEmailStore) Delete(email string) error {
+
+	tries := 0
+	for {
+		err := s.UndeliverableEmailStore.Delete(email)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerUndeliverableEmailStore) Get(email string) (*model.UndeliverableEmail, error) {
+
+	tries := 0
+	for {
+		result, err := s.UndeliverableEmailStore.Get(email)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerUndeliverableEmailStore) Save(email *model.UndeliverableEmail) (*model.UndeliverableEmail, error) {
+
+	tries := 0
+	for {
+		result, err := s.UndeliverableEmailStore.Save(email)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
 func (s *RetryLayerUploadSessionStore) Delete(id string) error {
 
 	tries := 0
@@ -13322,6 +14376,27 @@ func (s *RetryLayerUserStore) GetChannelGroupUsers(channelID string) ([]*model.U
 
 }
 
+func (s *RetryLayerUserStore) GetDirectReports(managerID string, offset int, limit int) ([]*model.User, error) {
+
+	tries := 0
+	for {
+		result, err := s.UserStore.GetDirectReports(managerID, offset, limit)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
 func (s *RetryLayerUserStore) GetEtagForAllProfiles() string {
 
 	return s.UserStore.GetEtagForAllProfiles()
@@ -14282,6 +15357,27 @@ func (s *RetryLayerUserStore) UpdateLastPictureUpdate(userID string) error {
 
 }
 
+func (s *RetryLayerUserStore) UpdateManager(userID string, managerID string) error {
+
+	tries := 0
+	for {
+		err := s.UserStore.UpdateManager(userID, managerID)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
 func (s *RetryLayerUserStore) UpdateMfaActive(userID string, active bool) error {
 
 	tries := 0
@@ -14534,6 +15630,27 @@ func (s *RetryLayerUserAccessTokenStore) GetByUser(userID string, page int, perP
 
 }
 
+func (s *RetryLayerUserAccessTokenStore) GetExpiring(before int64, offset int, limit int) ([]*model.UserAccessToken, error) {
+
+	tries := 0
+	for {
+		result, err := s.UserAccessTokenStore.GetExpiring(before, offset, limit)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
 func (s *RetryLayerUserAccessTokenStore) Save(token *model.UserAccessToken) (*model.UserAccessToken, error) {
 
 	tries := 0
@@ -14576,6 +15693,27 @@ func (s *RetryLayerUserAccessTokenStore) Search(term string) ([]*model.UserAcces
 
 }
 
+func (s *RetryLayerUserAccessTokenStore) UpdateExpiresAt(tokenID string, expiresAt int64) error {
+
+	tries := 0
+	for {
+		err := s.UserAccessTokenStore.UpdateExpiresAt(tokenID, expiresAt)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
 func (s *RetryLayerUserAccessTokenStore) UpdateTokenDisable(tokenID string) error {
 
 	tries := 0
@@ -14618,6 +15756,27 @@ func (s *RetryLayerUserAccessTokenStore) UpdateTokenEnable(tokenID string) error
 
 }
 
+func (s *RetryLayerUserTermsOfServiceStore) CountByTermsOfService(termsOfServiceId string, audience string) (int64, error) {
+
+	tries := 0
+	for {
+		result, err := s.UserTermsOfServiceStore.CountByTermsOfService(termsOfServiceId, audience)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
 func (s *RetryLayerUserTermsOfServiceStore) Delete(userID string, termsOfServiceId string) error {
 
 	tries := 0
@@ -15260,6 +16419,7 @@ func New(childStore store.Store) *RetryLayer {
 	}
 
 	newStore.AuditStore = &RetryLayerAuditStore{AuditStore: childStore.Audit(), Root: &newStore}
+	newStore.AutomationRuleStore = &RetryLayerAutomationRuleStore{AutomationRuleStore: childStore.AutomationRule(), Root: &newStore}
 	newStore.BotStore = &RetryLayerBotStore{BotStore: childStore.Bot(), Root: &newStore}
 	newStore.ChannelStore = &RetryLayerChannelStore{ChannelStore: childStore.Channel(), Root: &newStore}
 	newStore.ChannelBookmarkStore = &RetryLayerChannelBookmarkStore{ChannelBookmarkStore: childStore.ChannelBookmark(), Root: &newStore}
@@ -15268,14 +16428,19 @@ func New(childStore store.Store) *RetryLayer {
 	newStore.CommandStore = &RetryLayerCommandStore{CommandStore: childStore.Command(), Root: &newStore}
 	newStore.CommandWebhookStore = &RetryLayerCommandWebhookStore{CommandWebhookStore: childStore.CommandWebhook(), Root: &newStore}
 	newStore.ComplianceStore = &RetryLayerComplianceStore{ComplianceStore: childStore.Compliance(), Root: &newStore}
+	newStore.ConfigHistoryStore = &RetryLayerConfigHistoryStore{ConfigHistoryStore: childStore.ConfigHistory(), Root: &newStore}
+	newStore.CustomProfileAttributeStore = &RetryLayerCustomProfileAttributeStore{CustomProfileAttributeStore: childStore.CustomProfileAttribute(), Root: &newStore}
 	newStore.DesktopTokensStore = &RetryLayerDesktopTokensStore{DesktopTokensStore: childStore.DesktopTokens(), Root: &newStore}
 	newStore.DraftStore = &RetryLayerDraftStore{DraftStore: childStore.Draft(), Root: &newStore}
 	newStore.EmojiStore = &RetryLayerEmojiStore{EmojiStore: childStore.Emoji(), Root: &newStore}
 	newStore.FileInfoStore = &RetryLayerFileInfoStore{FileInfoStore: childStore.FileInfo(), Root: &newStore}
+	newStore.FileShareLinkStore = &RetryLayerFileShareLinkStore{FileShareLinkStore: childStore.FileShareLink(), Root: &newStore}
 	newStore.GroupStore = &RetryLayerGroupStore{GroupStore: childStore.Group(), Root: &newStore}
+	newStore.IntegrationDeliveryStore = &RetryLayerIntegrationDeliveryStore{IntegrationDeliveryStore: childStore.IntegrationDelivery(), Root: &newStore}
 	newStore.JobStore = &RetryLayerJobStore{JobStore: childStore.Job(), Root: &newStore}
 	newStore.LicenseStore = &RetryLayerLicenseStore{LicenseStore: childStore.License(), Root: &newStore}
 	newStore.LinkMetadataStore = &RetryLayerLinkMetadataStore{LinkMetadataStore: childStore.LinkMetadata(), Root: &newStore}
+	newStore.NotificationDeliveryStore = &RetryLayerNotificationDeliveryStore{NotificationDeliveryStore: childStore.NotificationDelivery(), Root: &newStore}
 	newStore.NotifyAdminStore = &RetryLayerNotifyAdminStore{NotifyAdminStore: childStore.NotifyAdmin(), Root: &newStore}
 	newStore.OAuthStore = &RetryLayerOAuthStore{OAuthStore: childStore.OAuth(), Root: &newStore}
 	newStore.OutgoingOAuthConnectionStore = &RetryLayerOutgoingOAuthConnectionStore{OutgoingOAuthConnectionStore: childStore.OutgoingOAuthConnection(), Root: &newStore}
@@ -15299,6 +16464,7 @@ func New(childStore store.Store) *RetryLayer {
 	newStore.TermsOfServiceStore = &RetryLayerTermsOfServiceStore{TermsOfServiceStore: childStore.TermsOfService(), Root: &newStore}
 	newStore.ThreadStore = &RetryLayerThreadStore{ThreadStore: childStore.Thread(), Root: &newStore}
 	newStore.TokenStore = &RetryLayerTokenStore{TokenStore: childStore.Token(), Root: &newStore}
+	newStore.UndeliverableEmailStore = &RetryLayerUndeliverableEmailStore{UndeliverableEmailStore: childStore.UndeliverableEmail(), Root: &newStore}
 	newStore.UploadSessionStore = &RetryLayerUploadSessionStore{UploadSessionStore: childStore.UploadSession(), Root: &newStore}
 	newStore.UserStore = &RetryLayerUserStore{UserStore: childStore.User(), Root: &newStore}
 	newStore.UserAccessTokenStore = &RetryLayerUserAccessTokenStore{UserAccessTokenStore: childStore.UserAccessToken(), Root: &newStore}
@@ -0,0 +1,159 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+
+	sq "github.com/mattermost/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+)
+
+type SqlAutomationRuleStore struct {
+	*SqlStore
+
+	tableSelectQuery sq.SelectBuilder
+}
+
+func newSqlAutomationRuleStore(sqlStore *SqlStore) store.AutomationRuleStore {
+	s := &SqlAutomationRuleStore{
+		SqlStore: sqlStore,
+	}
+
+	s.tableSelectQuery = s.getQueryBuilder().
+		Select(
+			"Id",
+			"TeamId",
+			"CreatorId",
+			"DisplayName",
+			"Enabled",
+			// Trigger is a keyword
+			s.toReserveCase("trigger"),
+			"Actions",
+			"CreateAt",
+			"UpdateAt",
+			"DeleteAt",
+		).
+		From("AutomationRules")
+
+	return s
+}
+
+func (s *SqlAutomationRuleStore) Save(rule *model.AutomationRule) (*model.AutomationRule, error) {
+	rule.PreSave()
+	if err := rule.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query := s.getQueryBuilder().
+		Insert("AutomationRules").
+		Columns("Id", "TeamId", "CreatorId", "DisplayName", "Enabled", "TriggerType", s.toReserveCase("trigger"), "Actions", "CreateAt", "UpdateAt", "DeleteAt").
+		Values(rule.Id, rule.TeamId, rule.CreatorId, rule.DisplayName, rule.Enabled, rule.Trigger.Type, rule.Trigger, rule.Actions, rule.CreateAt, rule.UpdateAt, rule.DeleteAt)
+
+	if _, err := s.GetMasterX().ExecBuilder(query); err != nil {
+		return nil, errors.Wrapf(err, "failed to save AutomationRule with id=%s", rule.Id)
+	}
+
+	return rule, nil
+}
+
+func (s *SqlAutomationRuleStore) Update(rule *model.AutomationRule) (*model.AutomationRule, error) {
+	rule.PreUpdate()
+	if err := rule.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query := s.getQueryBuilder().
+		Update("AutomationRules").
+		Set("DisplayName", rule.DisplayName).
+		Set("Enabled", rule.Enabled).
+		Set("TriggerType", rule.Trigger.Type).
+		Set(s.toReserveCase("trigger"), rule.Trigger).
+		Set("Actions", rule.Actions).
+		Set("UpdateAt", rule.UpdateAt).
+		Where(sq.Eq{"Id": rule.Id, "DeleteAt": 0})
+
+	res, err := s.GetMasterX().ExecBuilder(query)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to update AutomationRule with id=%s", rule.Id)
+	}
+
+	count, err := res.RowsAffected()
+	if err != nil {
+		return nil, errors.Wrap(err, "error while getting rows_affected")
+	}
+	if count == 0 {
+		return nil, store.NewErrNotFound("AutomationRule", rule.Id)
+	}
+
+	return rule, nil
+}
+
+func (s *SqlAutomationRuleStore) Get(id string) (*model.AutomationRule, error) {
+	query := s.tableSelectQuery.Where(sq.Eq{"Id": id, "DeleteAt": 0})
+
+	var rule model.AutomationRule
+	if err := s.GetReplicaX().GetBuilder(&rule, query); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("AutomationRule", id)
+		}
+		return nil, errors.Wrapf(err, "failed to get AutomationRule with id=%s", id)
+	}
+
+	return &rule, nil
+}
+
+func (s *SqlAutomationRuleStore) GetForTeam(teamID string, page, perPage int) ([]*model.AutomationRule, error) {
+	query := s.tableSelectQuery.
+		Where(sq.Eq{"TeamId": teamID, "DeleteAt": 0}).
+		OrderBy("CreateAt ASC", "Id ASC").
+		Limit(uint64(perPage)).
+		Offset(uint64(page * perPage))
+
+	rules := []*model.AutomationRule{}
+	if err := s.GetReplicaX().SelectBuilder(&rules, query); err != nil {
+		return nil, errors.Wrapf(err, "failed to find AutomationRules for teamId=%s", teamID)
+	}
+
+	return rules, nil
+}
+
+func (s *SqlAutomationRuleStore) GetEnabledForTrigger(teamID, triggerType string) ([]*model.AutomationRule, error) {
+	query := s.tableSelectQuery.
+		Where(sq.Eq{"TeamId": teamID, "TriggerType": triggerType, "Enabled": true, "DeleteAt": 0}).
+		OrderBy("CreateAt ASC", "Id ASC")
+
+	rules := []*model.AutomationRule{}
+	if err := s.GetReplicaX().SelectBuilder(&rules, query); err != nil {
+		return nil, errors.Wrapf(err, "failed to find AutomationRules for teamId=%s and triggerType=%s", teamID, triggerType)
+	}
+
+	return rules, nil
+}
+
+func (s *SqlAutomationRuleStore) Delete(id string, deleteAt int64) error {
+	query := s.getQueryBuilder().
+		Update("AutomationRules").
+		Set("DeleteAt", deleteAt).
+		Set("UpdateAt", deleteAt).
+		Where(sq.Eq{"Id": id, "DeleteAt": 0})
+
+	res, err := s.GetMasterX().ExecBuilder(query)
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete AutomationRule with id=%s", id)
+	}
+
+	count, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "error while getting rows_affected")
+	}
+	if count == 0 {
+		return store.NewErrNotFound("AutomationRule", id)
+	}
+
+	return nil
+}
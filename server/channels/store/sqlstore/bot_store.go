@@ -17,13 +17,14 @@ import (
 
 // bot is a subset of the model.Bot type, omitting the model.User fields.
 type bot struct {
-	UserId         string `json:"user_id"`
-	Description    string `json:"description"`
-	OwnerId        string `json:"owner_id"`
-	LastIconUpdate int64  `json:"last_icon_update"`
-	CreateAt       int64  `json:"create_at"`
-	UpdateAt       int64  `json:"update_at"`
-	DeleteAt       int64  `json:"delete_at"`
+	UserId         string          `json:"user_id"`
+	Description    string          `json:"description"`
+	OwnerId        string          `json:"owner_id"`
+	LastIconUpdate int64           `json:"last_icon_update"`
+	CreateAt       int64           `json:"create_at"`
+	UpdateAt       int64           `json:"update_at"`
+	DeleteAt       int64           `json:"delete_at"`
+	Scope          *model.BotScope `json:"scope"`
 }
 
 func botFromModel(b *model.Bot) *bot {
@@ -35,6 +36,7 @@ func botFromModel(b *model.Bot) *bot {
 		CreateAt:       b.CreateAt,
 		UpdateAt:       b.UpdateAt,
 		DeleteAt:       b.DeleteAt,
+		Scope:          b.Scope,
 	}
 }
 
@@ -71,7 +73,8 @@ func (us SqlBotStore) Get(botUserId string, includeDeleted bool) (*model.Bot, er
 			COALESCE(b.LastIconUpdate, 0) AS LastIconUpdate,
 			b.CreateAt,
 			b.UpdateAt,
-			b.DeleteAt
+			b.DeleteAt,
+			b.Scope
 		FROM
 			Bots b
 		JOIN
@@ -124,7 +127,8 @@ func (us SqlBotStore) GetAll(options *model.BotGetOptions) ([]*model.Bot, error)
 			    COALESCE(b.LastIconUpdate, 0) AS LastIconUpdate,
 			    b.CreateAt,
 			    b.UpdateAt,
-			    b.DeleteAt
+			    b.DeleteAt,
+			    b.Scope
 			FROM
 			    Bots b
 			JOIN
@@ -161,9 +165,9 @@ func (us SqlBotStore) Save(bot *model.Bot) (*model.Bot, error) {
 	}
 
 	if _, err := us.GetMasterX().NamedExec(`INSERT INTO Bots
-		(UserId, Description, OwnerId, LastIconUpdate, CreateAt, UpdateAt, DeleteAt)
+		(UserId, Description, OwnerId, LastIconUpdate, CreateAt, UpdateAt, DeleteAt, Scope)
 		VALUES
-		(:UserId, :Description, :OwnerId, :LastIconUpdate, :CreateAt, :UpdateAt, :DeleteAt)`, botFromModel(bot)); err != nil {
+		(:UserId, :Description, :OwnerId, :LastIconUpdate, :CreateAt, :UpdateAt, :DeleteAt, :Scope)`, botFromModel(bot)); err != nil {
 		return nil, errors.Wrapf(err, "insert: user_id=%s", bot.UserId)
 	}
 
@@ -190,11 +194,12 @@ func (us SqlBotStore) Update(bot *model.Bot) (*model.Bot, error) {
 	oldBot.LastIconUpdate = bot.LastIconUpdate
 	oldBot.UpdateAt = bot.UpdateAt
 	oldBot.DeleteAt = bot.DeleteAt
+	oldBot.Scope = bot.Scope
 	bot = oldBot
 
 	res, err := us.GetMasterX().NamedExec(`UPDATE Bots
 		SET Description=:Description, OwnerId=:OwnerId, LastIconUpdate=:LastIconUpdate,
-			UpdateAt=:UpdateAt, DeleteAt=:DeleteAt
+			UpdateAt=:UpdateAt, DeleteAt=:DeleteAt, Scope=:Scope
 		WHERE UserId=:UserId`, botFromModel(bot))
 	if err != nil {
 		return nil, errors.Wrapf(err, "update: user_id=%s", bot.UserId)
@@ -1090,6 +1090,30 @@ func (s SqlChannelStore) GetChannelsByUser(userId string, includeDeleted bool, l
 	return channels, nil
 }
 
+func (s SqlChannelStore) GetEtagForUserChannels(userId, teamId string) string {
+	// The last update of the memberships changes when the user joins a channel, so that leaving a channel
+	// and joining another one changes the etag even though the count stays the same.
+	query := s.getQueryBuilder().
+		Select("CONCAT(COUNT(ch.Id), '.', COALESCE(MAX(ch.UpdateAt), 0), '.', COALESCE(MAX(ch.LastPostAt), 0), '.', COALESCE(MAX(cm.LastUpdateAt), 0))").
+		From("Channels ch").
+		Join("ChannelMembers cm ON cm.ChannelId = ch.Id").
+		Where(sq.Eq{"cm.UserId": userId})
+
+	if teamId != "" {
+		query = query.Where(sq.Or{
+			sq.Eq{"ch.TeamId": teamId},
+			sq.Eq{"ch.TeamId": ""},
+		})
+	}
+
+	var etag string
+	if err := s.GetReplicaX().GetBuilder(&etag, query); err != nil {
+		return fmt.Sprintf("%v.%v", model.CurrentVersion, model.GetMillis())
+	}
+
+	return fmt.Sprintf("%v.%v", model.CurrentVersion, etag)
+}
+
 func (s SqlChannelStore) GetAllChannelMemberIdsByChannelId(channelID string) ([]string, error) {
 	userIDs := []string{}
 	err := s.GetReplicaX().Select(&userIDs, `SELECT UserId
@@ -1941,6 +1965,54 @@ func (s SqlChannelStore) GetMembers(channelID string, offset, limit int) (model.
 	return dbMembers.ToModel(), nil
 }
 
+func (s SqlChannelStore) GetMembersAfter(channelID string, opts *model.ChannelMembersGetOptions) (model.ChannelMembers, error) {
+	query := s.channelMembersForTeamWithSchemeSelectQuery.
+		Where(sq.Eq{"ChannelMembers.ChannelId": channelID}).
+		Where(sq.Gt{"ChannelMembers.UserId": opts.AfterUserId}).
+		OrderBy("ChannelMembers.UserId").
+		Limit(uint64(opts.Limit))
+
+	switch opts.Role {
+	case model.ChannelAdminRoleId:
+		query = query.Where(sq.Eq{"ChannelMembers.SchemeAdmin": true})
+	case model.ChannelUserRoleId:
+		query = query.Where(sq.Eq{"ChannelMembers.SchemeUser": true, "ChannelMembers.SchemeAdmin": false})
+	case model.ChannelGuestRoleId:
+		query = query.Where(sq.Eq{"ChannelMembers.SchemeGuest": true})
+	}
+
+	if term := strings.TrimSpace(opts.Term); term != "" {
+		pattern := fmt.Sprintf("%s%%", sanitizeSearchTerm(term, "\\"))
+		operatorKeyword := "ILIKE"
+		if s.DriverName() == model.DatabaseDriverMysql {
+			operatorKeyword = "LIKE"
+		}
+		fields := []string{"Users.Username", "Users.Nickname"}
+		if opts.AllowFullNames {
+			fields = append(fields, "Users.FirstName", "Users.LastName")
+		}
+		termFilter := sq.Or{}
+		for _, field := range fields {
+			termFilter = append(termFilter, sq.Expr(fmt.Sprintf("%s %s ?", field, operatorKeyword), pattern))
+		}
+		query = query.
+			InnerJoin("Users ON Users.Id = ChannelMembers.UserId").
+			Where(termFilter)
+	}
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, errors.Wrapf(err, "GetMembersAfter_ToSql ChannelID=%s", channelID)
+	}
+
+	dbMembers := channelMemberWithSchemeRolesList{}
+	if err := s.GetReplicaX().Select(&dbMembers, sql, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to get ChannelMembers with channelId=%s", channelID)
+	}
+
+	return dbMembers.ToModel(), nil
+}
+
 func (s SqlChannelStore) GetChannelMembersTimezones(channelId string) ([]model.StringMap, error) {
 	dbMembersTimezone := []model.StringMap{}
 	err := s.GetReplicaX().Select(&dbMembersTimezone, `
@@ -1144,3 +1144,64 @@ func (s SqlChannelStore) DeleteAllSidebarChannelForChannel(channelID string) err
 	_, err = s.GetMasterX().Exec(query, args...)
 	return err
 }
+
+// GetSidebarCategoryUnreads sums the unread counters of the channel members of the user by sidebar
+// category. The channels that have no sidebar entry on a team belong to its Channels or Direct
+// Messages category, as in completePopulatingCategoryChannelsT.
+func (s SqlChannelStore) GetSidebarCategoryUnreads(userID string) ([]*model.SidebarCategoryUnread, error) {
+	mutedExpr := "JSON_UNQUOTE(JSON_EXTRACT(ChannelMembers.NotifyProps, '$.mark_unread')) = 'mention'"
+	if s.DriverName() == model.DatabaseDriverPostgres {
+		mutedExpr = "ChannelMembers.NotifyProps->>'mark_unread' = 'mention'"
+	}
+
+	// True if the channel has no sidebar entry in any category of the user on the team of the category
+	notInSidebar := sq.Expr(`NOT EXISTS (
+		SELECT 1 FROM SidebarChannels
+		JOIN SidebarCategories OtherCategories ON SidebarChannels.CategoryId = OtherCategories.Id
+		WHERE SidebarChannels.ChannelId = ChannelMembers.ChannelId
+			AND OtherCategories.UserId = SidebarCategories.UserId
+			AND OtherCategories.TeamId = SidebarCategories.TeamId
+	)`)
+
+	query := s.getQueryBuilder().
+		Select(
+			"SidebarCategories.Id AS CategoryId",
+			"SidebarCategories.TeamId",
+			"COALESCE(SUM(CASE WHEN "+mutedExpr+" THEN 0 ELSE Channels.TotalMsgCount - ChannelMembers.MsgCount END), 0) AS MsgCount",
+			"COALESCE(SUM(CASE WHEN "+mutedExpr+" THEN 0 ELSE Channels.TotalMsgCountRoot - ChannelMembers.MsgCountRoot END), 0) AS MsgCountRoot",
+			"COALESCE(SUM(ChannelMembers.MentionCount), 0) AS MentionCount",
+			"COALESCE(SUM(ChannelMembers.MentionCountRoot), 0) AS MentionCountRoot",
+			"COALESCE(SUM(ChannelMembers.UrgentMentionCount), 0) AS UrgentMentionCount",
+		).
+		From("SidebarCategories").
+		Join("ChannelMembers ON ChannelMembers.UserId = SidebarCategories.UserId").
+		Join("Channels ON Channels.Id = ChannelMembers.ChannelId").
+		LeftJoin("SidebarChannels ON SidebarChannels.CategoryId = SidebarCategories.Id AND SidebarChannels.ChannelId = ChannelMembers.ChannelId").
+		Where(sq.And{
+			sq.Eq{"SidebarCategories.UserId": userID},
+			sq.Eq{"Channels.DeleteAt": 0},
+			sq.Or{
+				sq.NotEq{"SidebarChannels.ChannelId": nil},
+				sq.And{
+					sq.Eq{"SidebarCategories.Type": model.SidebarCategoryChannels},
+					sq.Eq{"Channels.Type": []model.ChannelType{model.ChannelTypeOpen, model.ChannelTypePrivate}},
+					sq.Expr("Channels.TeamId = SidebarCategories.TeamId"),
+					notInSidebar,
+				},
+				sq.And{
+					sq.Eq{"SidebarCategories.Type": model.SidebarCategoryDirectMessages},
+					sq.Eq{"Channels.Type": []model.ChannelType{model.ChannelTypeDirect, model.ChannelTypeGroup}},
+					notInSidebar,
+				},
+			},
+		}).
+		GroupBy("SidebarCategories.Id", "SidebarCategories.TeamId").
+		OrderBy("SidebarCategories.TeamId", "SidebarCategories.Id")
+
+	unreads := []*model.SidebarCategoryUnread{}
+	if err := s.GetReplicaX().SelectBuilder(&unreads, query); err != nil {
+		return nil, errors.Wrapf(err, "failed to get sidebar category unreads for userId=%s", userID)
+	}
+
+	return unreads, nil
+}
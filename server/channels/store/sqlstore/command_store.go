@@ -45,10 +45,10 @@ func (s SqlCommandStore) Save(command *model.Command) (*model.Command, error) {
 	if _, err := s.GetMasterX().NamedExec(`INSERT INTO Commands (Id, Token, CreateAt,
 		UpdateAt, DeleteAt, CreatorId, TeamId, `+trigger+`, Method, Username,
 		IconURL, AutoComplete, AutoCompleteDesc, AutoCompleteHint, DisplayName, Description,
-		URL, PluginId)
+		URL, PluginId, SigningSecret, AutocompleteData)
 	VALUES (:Id, :Token, :CreateAt, :UpdateAt, :DeleteAt, :CreatorId, :TeamId, :Trigger, :Method,
 		:Username, :IconURL, :AutoComplete, :AutoCompleteDesc, :AutoCompleteHint, :DisplayName,
-		:Description, :URL, :PluginId)`, command); err != nil {
+		:Description, :URL, :PluginId, :SigningSecret, :AutocompleteData)`, command); err != nil {
 		return nil, errors.Wrapf(err, "insert: command_id=%s", command.Id)
 	}
 
@@ -182,6 +182,8 @@ func (s SqlCommandStore) Update(cmd *model.Command) (*model.Command, error) {
 		Set("Description", cmd.Description).
 		Set("URL", cmd.URL).
 		Set("PluginId", cmd.PluginId).
+		Set("SigningSecret", cmd.SigningSecret).
+		Set("AutocompleteData", cmd.AutocompleteData).
 		Where(sq.Eq{"Id": cmd.Id})
 
 	// Trigger is a keyword
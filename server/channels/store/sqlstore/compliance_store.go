@@ -33,9 +33,9 @@ func (s SqlComplianceStore) Save(compliance *model.Compliance) (*model.Complianc
 	// DESC is a keyword
 	desc := s.toReserveCase("desc")
 
-	query := `INSERT INTO Compliances (Id, CreateAt, UserId, Status, Count, ` + desc + `, Type, StartAt, EndAt, Keywords, Emails)
+	query := `INSERT INTO Compliances (Id, CreateAt, UserId, Status, Count, ` + desc + `, Type, StartAt, EndAt, Keywords, Emails, Incremental, LastPostUpdateAt, LastPostId)
 	VALUES
-	(:Id, :CreateAt, :UserId, :Status, :Count, :Desc, :Type, :StartAt, :EndAt, :Keywords, :Emails)`
+	(:Id, :CreateAt, :UserId, :Status, :Count, :Desc, :Type, :StartAt, :EndAt, :Keywords, :Emails, :Incremental, :LastPostUpdateAt, :LastPostId)`
 	if _, err := s.GetMasterX().NamedExec(query, compliance); err != nil {
 		return nil, errors.Wrap(err, "failed to save Compliance")
 	}
@@ -58,6 +58,9 @@ func (s SqlComplianceStore) Update(compliance *model.Compliance) (*model.Complia
 		Set("EndAt", compliance.EndAt).
 		Set("Keywords", compliance.Keywords).
 		Set("Emails", compliance.Emails).
+		Set("Incremental", compliance.Incremental).
+		Set("LastPostUpdateAt", compliance.LastPostUpdateAt).
+		Set("LastPostId", compliance.LastPostId).
 		Where(sq.Eq{"Id": compliance.Id})
 
 	// DESC is a keyword
@@ -105,6 +108,28 @@ func (s SqlComplianceStore) Get(id string) (*model.Compliance, error) {
 	return &compliance, nil
 }
 
+// GetLastSuccessfulIncremental returns the most recent incremental export that finished.
+func (s SqlComplianceStore) GetLastSuccessfulIncremental() (*model.Compliance, error) {
+	query := s.getQueryBuilder().
+		Select("*").
+		From("Compliances").
+		Where(sq.Eq{
+			"Incremental": true,
+			"Status":      model.ComplianceStatusFinished,
+		}).
+		OrderBy("EndAt DESC").
+		Limit(1)
+
+	var compliance model.Compliance
+	if err := s.GetReplicaX().GetBuilder(&compliance, query); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("Compliance", "incremental")
+		}
+		return nil, errors.Wrap(err, "failed to get last successful incremental Compliance")
+	}
+	return &compliance, nil
+}
+
 func (s SqlComplianceStore) ComplianceExport(job *model.Compliance, cursor model.ComplianceExportCursor, limit int) ([]*model.CompliancePost, model.ComplianceExportCursor, error) {
 	keywordQuery := ""
 	var argsKeywords []any
@@ -271,6 +296,84 @@ func (s SqlComplianceStore) ComplianceExport(job *model.Compliance, cursor model
 	return append(channelPosts, directMessagePosts...), cursor, nil
 }
 
+// ComplianceExportIncremental returns the posts of a compliance export ordered by UpdateAt,
+// so that a single cursor can be used to stream an export of any size and so that posts
+// edited or deleted after a previous export are included in the following one.
+func (s SqlComplianceStore) ComplianceExportIncremental(job *model.Compliance, cursor model.ComplianceIncrementalExportCursor, limit int) ([]*model.CompliancePost, model.ComplianceIncrementalExportCursor, error) {
+	if cursor.LastPostUpdateAt == 0 {
+		cursor.LastPostUpdateAt = job.StartAt
+	}
+
+	query := s.getQueryBuilder().
+		Select(
+			"CASE WHEN Channels.TeamId = '' THEN 'direct-messages' ELSE Teams.Name END AS TeamName",
+			"CASE WHEN Channels.TeamId = '' THEN 'Direct Messages' ELSE Teams.DisplayName END AS TeamDisplayName",
+			"Channels.Name AS ChannelName",
+			"Channels.DisplayName AS ChannelDisplayName",
+			"Channels.Type AS ChannelType",
+			"Users.Username AS UserUsername",
+			"Users.Email AS UserEmail",
+			"Users.Nickname AS UserNickname",
+			"Posts.Id AS PostId",
+			"Posts.CreateAt AS PostCreateAt",
+			"Posts.UpdateAt AS PostUpdateAt",
+			"Posts.DeleteAt AS PostDeleteAt",
+			"Posts.RootId AS PostRootId",
+			"Posts.OriginalId AS PostOriginalId",
+			"Posts.Message AS PostMessage",
+			"Posts.Type AS PostType",
+			"Posts.Props AS PostProps",
+			"Posts.Hashtags AS PostHashtags",
+			"Posts.FileIds AS PostFileIds",
+			"Bots.UserId IS NOT NULL AS IsBot",
+		).
+		From("Posts").
+		Join("Channels ON Posts.ChannelId = Channels.Id").
+		Join("Users ON Posts.UserId = Users.Id").
+		LeftJoin("Teams ON Channels.TeamId = Teams.Id").
+		LeftJoin("Bots ON Bots.UserId = Posts.UserId").
+		Where(sq.Or{
+			sq.Gt{"Posts.UpdateAt": cursor.LastPostUpdateAt},
+			sq.And{
+				sq.Eq{"Posts.UpdateAt": cursor.LastPostUpdateAt},
+				sq.Gt{"Posts.Id": cursor.LastPostId},
+			},
+		}).
+		Where(sq.Lt{"Posts.UpdateAt": job.EndAt}).
+		Where(sq.Or{
+			sq.Eq{"Channels.TeamId": ""},
+			sq.NotEq{"Teams.Id": nil},
+		}).
+		OrderBy("Posts.UpdateAt", "Posts.Id").
+		Limit(uint64(limit))
+
+	emails := strings.Fields(strings.TrimSpace(strings.ToLower(strings.Replace(job.Emails, ",", " ", -1))))
+	if len(emails) > 0 {
+		query = query.Where(sq.Eq{"Users.Email": emails})
+	}
+
+	keywords := strings.Fields(strings.TrimSpace(strings.ToLower(strings.Replace(job.Keywords, ",", " ", -1))))
+	if len(keywords) > 0 {
+		clauses := sq.Or{}
+		for _, keyword := range keywords {
+			keyword = sanitizeSearchTerm(keyword, "\\")
+			clauses = append(clauses, sq.Expr("LOWER(Posts.Message) LIKE ?", "%"+keyword+"%"))
+		}
+		query = query.Where(clauses)
+	}
+
+	cposts := []*model.CompliancePost{}
+	if err := s.GetReplicaX().SelectBuilder(&cposts, query); err != nil {
+		return nil, cursor, errors.Wrap(err, "unable to export compliance")
+	}
+	if len(cposts) > 0 {
+		cursor.LastPostUpdateAt = cposts[len(cposts)-1].PostUpdateAt
+		cursor.LastPostId = cposts[len(cposts)-1].PostId
+	}
+
+	return cposts, cursor, nil
+}
+
 func (s SqlComplianceStore) MessageExport(c request.CTX, cursor model.MessageExportCursor, limit int) ([]*model.MessageExport, model.MessageExportCursor, error) {
 	var args []any
 	args = append(args, model.ChannelTypeDirect, model.ChannelTypeGroup, cursor.LastPostUpdateAt, cursor.LastPostUpdateAt, cursor.LastPostId, limit)
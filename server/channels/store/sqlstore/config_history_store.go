@@ -0,0 +1,90 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+
+	sq "github.com/mattermost/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+)
+
+type SqlConfigHistoryStore struct {
+	*SqlStore
+}
+
+func newSqlConfigHistoryStore(sqlStore *SqlStore) store.ConfigHistoryStore {
+	return &SqlConfigHistoryStore{sqlStore}
+}
+
+func (s *SqlConfigHistoryStore) Save(history *model.ConfigHistory) (*model.ConfigHistory, error) {
+	history.PreSave()
+
+	transaction, err := s.GetMasterX().Beginx()
+	if err != nil {
+		return nil, errors.Wrap(err, "begin_transaction")
+	}
+	defer finalizeTransactionX(transaction, &err)
+
+	var latestVersion int64
+	if err = transaction.Get(&latestVersion, "SELECT COALESCE(MAX(Version), 0) FROM ConfigHistory"); err != nil {
+		return nil, errors.Wrap(err, "failed to get the latest ConfigHistory version")
+	}
+	history.Version = latestVersion + 1
+
+	if appErr := history.IsValid(); appErr != nil {
+		return nil, appErr
+	}
+
+	query := s.getQueryBuilder().
+		Insert("ConfigHistory").
+		Columns("Id", "Version", "UserId", "CreateAt", "Diff", "Config").
+		Values(history.Id, history.Version, history.UserId, history.CreateAt, history.Diff, history.Config)
+
+	if _, err = transaction.ExecBuilder(query); err != nil {
+		return nil, errors.Wrapf(err, "failed to save ConfigHistory with version=%d", history.Version)
+	}
+
+	if err = transaction.Commit(); err != nil {
+		return nil, errors.Wrap(err, "commit_transaction")
+	}
+
+	return history, nil
+}
+
+func (s *SqlConfigHistoryStore) GetByVersion(version int64) (*model.ConfigHistory, error) {
+	query := s.getQueryBuilder().
+		Select("Id", "Version", "UserId", "CreateAt", "Diff", "Config").
+		From("ConfigHistory").
+		Where(sq.Eq{"Version": version})
+
+	var history model.ConfigHistory
+	if err := s.GetReplicaX().GetBuilder(&history, query); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("ConfigHistory", "version")
+		}
+		return nil, errors.Wrapf(err, "failed to get ConfigHistory with version=%d", version)
+	}
+
+	return &history, nil
+}
+
+func (s *SqlConfigHistoryStore) GetAll(offset, limit int) ([]*model.ConfigHistory, error) {
+	query := s.getQueryBuilder().
+		Select("Id", "Version", "UserId", "CreateAt", "Diff").
+		From("ConfigHistory").
+		OrderBy("Version DESC").
+		Limit(uint64(limit)).
+		Offset(uint64(offset))
+
+	history := []*model.ConfigHistory{}
+	if err := s.GetReplicaX().SelectBuilder(&history, query); err != nil {
+		return nil, errors.Wrap(err, "failed to find ConfigHistory")
+	}
+
+	return history, nil
+}
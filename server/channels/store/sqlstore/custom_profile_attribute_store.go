@@ -0,0 +1,246 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+
+	sq "github.com/mattermost/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+)
+
+type SqlCustomProfileAttributeStore struct {
+	*SqlStore
+
+	fieldsSelectQuery sq.SelectBuilder
+}
+
+func newSqlCustomProfileAttributeStore(sqlStore *SqlStore) store.CustomProfileAttributeStore {
+	s := &SqlCustomProfileAttributeStore{
+		SqlStore: sqlStore,
+	}
+
+	s.fieldsSelectQuery = s.getQueryBuilder().
+		Select(
+			"Id",
+			"Name",
+			"Type",
+			"Options",
+			"ValidationRegex",
+			"Visibility",
+			"LdapAttribute",
+			"SamlAttribute",
+			"SortOrder",
+			"CreateAt",
+			"UpdateAt",
+			"DeleteAt",
+		).
+		From("CustomProfileAttributeFields")
+
+	return s
+}
+
+func (s *SqlCustomProfileAttributeStore) SaveField(field *model.CustomProfileAttributeField) (*model.CustomProfileAttributeField, error) {
+	field.PreSave()
+	if err := field.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query := s.getQueryBuilder().
+		Insert("CustomProfileAttributeFields").
+		Columns("Id", "Name", "Type", "Options", "ValidationRegex", "Visibility", "LdapAttribute", "SamlAttribute", "SortOrder", "CreateAt", "UpdateAt", "DeleteAt").
+		Values(field.Id, field.Name, field.Type, field.Options, field.ValidationRegex, field.Visibility, field.LdapAttribute, field.SamlAttribute, field.SortOrder, field.CreateAt, field.UpdateAt, field.DeleteAt)
+
+	if _, err := s.GetMasterX().ExecBuilder(query); err != nil {
+		return nil, errors.Wrapf(err, "failed to save CustomProfileAttributeField with id=%s", field.Id)
+	}
+
+	return field, nil
+}
+
+func (s *SqlCustomProfileAttributeStore) UpdateField(field *model.CustomProfileAttributeField) (*model.CustomProfileAttributeField, error) {
+	field.PreUpdate()
+	if err := field.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query := s.getQueryBuilder().
+		Update("CustomProfileAttributeFields").
+		Set("Name", field.Name).
+		Set("Options", field.Options).
+		Set("ValidationRegex", field.ValidationRegex).
+		Set("Visibility", field.Visibility).
+		Set("LdapAttribute", field.LdapAttribute).
+		Set("SamlAttribute", field.SamlAttribute).
+		Set("SortOrder", field.SortOrder).
+		Set("UpdateAt", field.UpdateAt).
+		Where(sq.Eq{"Id": field.Id, "DeleteAt": 0})
+
+	res, err := s.GetMasterX().ExecBuilder(query)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to update CustomProfileAttributeField with id=%s", field.Id)
+	}
+
+	count, err := res.RowsAffected()
+	if err != nil {
+		return nil, errors.Wrap(err, "error while getting rows_affected")
+	}
+	if count == 0 {
+		return nil, store.NewErrNotFound("CustomProfileAttributeField", field.Id)
+	}
+
+	return field, nil
+}
+
+func (s *SqlCustomProfileAttributeStore) GetField(id string) (*model.CustomProfileAttributeField, error) {
+	query := s.fieldsSelectQuery.Where(sq.Eq{"Id": id, "DeleteAt": 0})
+
+	var field model.CustomProfileAttributeField
+	if err := s.GetReplicaX().GetBuilder(&field, query); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("CustomProfileAttributeField", id)
+		}
+		return nil, errors.Wrapf(err, "failed to get CustomProfileAttributeField with id=%s", id)
+	}
+
+	return &field, nil
+}
+
+func (s *SqlCustomProfileAttributeStore) GetFields() ([]*model.CustomProfileAttributeField, error) {
+	query := s.fieldsSelectQuery.
+		Where(sq.Eq{"DeleteAt": 0}).
+		OrderBy("SortOrder ASC", "Name ASC")
+
+	fields := []*model.CustomProfileAttributeField{}
+	if err := s.GetReplicaX().SelectBuilder(&fields, query); err != nil {
+		return nil, errors.Wrap(err, "failed to find CustomProfileAttributeFields")
+	}
+
+	return fields, nil
+}
+
+func (s *SqlCustomProfileAttributeStore) DeleteField(id string, deleteAt int64) (err error) {
+	transaction, err := s.GetMasterX().Beginx()
+	if err != nil {
+		return errors.Wrap(err, "begin_transaction")
+	}
+	defer finalizeTransactionX(transaction, &err)
+
+	query := s.getQueryBuilder().
+		Update("CustomProfileAttributeFields").
+		Set("DeleteAt", deleteAt).
+		Set("UpdateAt", deleteAt).
+		Where(sq.Eq{"Id": id, "DeleteAt": 0})
+
+	res, err := transaction.ExecBuilder(query)
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete CustomProfileAttributeField with id=%s", id)
+	}
+
+	count, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "error while getting rows_affected")
+	}
+	if count == 0 {
+		return store.NewErrNotFound("CustomProfileAttributeField", id)
+	}
+
+	deleteValues := s.getQueryBuilder().
+		Delete("CustomProfileAttributeValues").
+		Where(sq.Eq{"FieldId": id})
+
+	if _, err = transaction.ExecBuilder(deleteValues); err != nil {
+		return errors.Wrapf(err, "failed to delete CustomProfileAttributeValues with fieldId=%s", id)
+	}
+
+	if err = transaction.Commit(); err != nil {
+		return errors.Wrap(err, "commit_transaction")
+	}
+
+	return nil
+}
+
+func (s *SqlCustomProfileAttributeStore) GetValuesForUsers(userIDs []string) ([]*model.CustomProfileAttributeValue, error) {
+	values := []*model.CustomProfileAttributeValue{}
+	if len(userIDs) == 0 {
+		return values, nil
+	}
+
+	query := s.getQueryBuilder().
+		Select("FieldId", "UserId", "Value", "UpdateAt").
+		From("CustomProfileAttributeValues").
+		Where(sq.Eq{"UserId": userIDs})
+
+	if err := s.GetReplicaX().SelectBuilder(&values, query); err != nil {
+		return nil, errors.Wrap(err, "failed to find CustomProfileAttributeValues")
+	}
+
+	return values, nil
+}
+
+func (s *SqlCustomProfileAttributeStore) UpsertValues(values []*model.CustomProfileAttributeValue) (err error) {
+	if len(values) == 0 {
+		return nil
+	}
+
+	transaction, err := s.GetMasterX().Beginx()
+	if err != nil {
+		return errors.Wrap(err, "begin_transaction")
+	}
+	defer finalizeTransactionX(transaction, &err)
+
+	for _, value := range values {
+		query := s.getQueryBuilder().
+			Insert("CustomProfileAttributeValues").
+			Columns("FieldId", "UserId", "Value", "UpdateAt").
+			Values(value.FieldId, value.UserId, value.Value, value.UpdateAt)
+
+		if s.DriverName() == model.DatabaseDriverMysql {
+			query = query.SuffixExpr(sq.Expr("ON DUPLICATE KEY UPDATE Value = ?, UpdateAt = ?", value.Value, value.UpdateAt))
+		} else {
+			query = query.SuffixExpr(sq.Expr("ON CONFLICT (userid, fieldid) DO UPDATE SET Value = ?, UpdateAt = ?", value.Value, value.UpdateAt))
+		}
+
+		if _, err = transaction.ExecBuilder(query); err != nil {
+			return errors.Wrapf(err, "failed to save CustomProfileAttributeValue with userId=%s and fieldId=%s", value.UserId, value.FieldId)
+		}
+	}
+
+	if err = transaction.Commit(); err != nil {
+		return errors.Wrap(err, "commit_transaction")
+	}
+
+	return nil
+}
+
+func (s *SqlCustomProfileAttributeStore) DeleteValues(userID string, fieldIDs []string) error {
+	if len(fieldIDs) == 0 {
+		return nil
+	}
+
+	query := s.getQueryBuilder().
+		Delete("CustomProfileAttributeValues").
+		Where(sq.Eq{"UserId": userID, "FieldId": fieldIDs})
+
+	if _, err := s.GetMasterX().ExecBuilder(query); err != nil {
+		return errors.Wrapf(err, "failed to delete CustomProfileAttributeValues with userId=%s", userID)
+	}
+
+	return nil
+}
+
+func (s *SqlCustomProfileAttributeStore) PermanentDeleteValuesByUser(userID string) error {
+	query := s.getQueryBuilder().
+		Delete("CustomProfileAttributeValues").
+		Where(sq.Eq{"UserId": userID})
+
+	if _, err := s.GetMasterX().ExecBuilder(query); err != nil {
+		return errors.Wrapf(err, "failed to delete CustomProfileAttributeValues with userId=%s", userID)
+	}
+
+	return nil
+}
@@ -42,6 +42,7 @@ type fileInfoWithChannelID struct {
 	Content         string
 	RemoteId        *string
 	Archived        bool
+	ContentHash     string
 }
 
 func (fi fileInfoWithChannelID) ToModel() *model.FileInfo {
@@ -66,6 +67,7 @@ func (fi fileInfoWithChannelID) ToModel() *model.FileInfo {
 		MiniPreview:     fi.MiniPreview,
 		Content:         fi.Content,
 		RemoteId:        fi.RemoteId,
+		ContentHash:     fi.ContentHash,
 	}
 }
 
@@ -106,6 +108,7 @@ func newSqlFileInfoStore(sqlStore *SqlStore, metrics einterfaces.MetricsInterfac
 		"Coalesce(FileInfo.Content, '') AS Content",
 		"Coalesce(FileInfo.RemoteId, '') AS RemoteId",
 		"FileInfo.Archived",
+		"FileInfo.ContentHash",
 	}
 
 	return s
@@ -120,10 +123,10 @@ func (fs SqlFileInfoStore) Save(rctx request.CTX, info *model.FileInfo) (*model.
 	query := `
 		INSERT INTO FileInfo
 		(Id, CreatorId, PostId, ChannelId, CreateAt, UpdateAt, DeleteAt, Path, ThumbnailPath, PreviewPath,
-			Name, Extension, Size, MimeType, Width, Height, HasPreviewImage, MiniPreview, Content, RemoteId)
+			Name, Extension, Size, MimeType, Width, Height, HasPreviewImage, MiniPreview, Content, RemoteId, ContentHash)
 		VALUES
 		(:Id, :CreatorId, :PostId, :ChannelId, :CreateAt, :UpdateAt, :DeleteAt, :Path, :ThumbnailPath, :PreviewPath,
-			:Name, :Extension, :Size, :MimeType, :Width, :Height, :HasPreviewImage, :MiniPreview, :Content, :RemoteId)
+			:Name, :Extension, :Size, :MimeType, :Width, :Height, :HasPreviewImage, :MiniPreview, :Content, :RemoteId, :ContentHash)
 	`
 
 	if _, err := fs.GetMasterX().NamedExec(query, info); err != nil {
@@ -186,6 +189,7 @@ func (fs SqlFileInfoStore) Upsert(rctx request.CTX, info *model.FileInfo) (*mode
 			"MiniPreview":     info.MiniPreview,
 			"Content":         info.Content,
 			"RemoteId":        info.RemoteId,
+			"ContentHash":     info.ContentHash,
 		}).
 		Where(sq.Eq{"Id": info.Id}).
 		ToSql()
@@ -335,6 +339,87 @@ func (fs SqlFileInfoStore) GetByPath(path string) (*model.FileInfo, error) {
 	return info, nil
 }
 
+// GetByContentHash returns a file whose stored content matches the given hash and size, if any.
+func (fs SqlFileInfoStore) GetByContentHash(hash string, size int64) (*model.FileInfo, error) {
+	info := &model.FileInfo{}
+
+	query := fs.getQueryBuilder().
+		Select(fs.queryFields...).
+		From("FileInfo").
+		Where(sq.Eq{"ContentHash": hash}).
+		Where(sq.Eq{"Size": size}).
+		Where(sq.NotEq{"Path": ""}).
+		OrderBy("CreateAt ASC").
+		Limit(1)
+
+	queryString, args, err := query.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "file_info_tosql")
+	}
+
+	if err := fs.GetMasterX().Get(info, queryString, args...); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("FileInfo", fmt.Sprintf("content_hash=%s", hash))
+		}
+
+		return nil, errors.Wrapf(err, "failed to get FileInfo with content_hash=%s", hash)
+	}
+	return info, nil
+}
+
+// CountByPath returns the number of FileInfos, including deleted ones, referencing the
+// given stored path. Deduplicated files share a path, so it acts as a reference count.
+func (fs SqlFileInfoStore) CountByPath(path string) (int64, error) {
+	query := fs.getQueryBuilder().
+		Select("COUNT(*)").
+		From("FileInfo").
+		Where(sq.Eq{"Path": path})
+
+	var count int64
+	if err := fs.GetMasterX().GetBuilder(&count, query); err != nil {
+		return 0, errors.Wrapf(err, "failed to count FileInfos with path=%s", path)
+	}
+	return count, nil
+}
+
+// GetBatchWithoutContentHash returns up to limit files that have no content hash yet,
+// ordered by (CreateAt, Id) and starting after the given cursor.
+func (fs SqlFileInfoStore) GetBatchWithoutContentHash(startTime int64, startFileID string, limit int) ([]*model.FileInfo, error) {
+	infos := []*model.FileInfo{}
+
+	query := fs.getQueryBuilder().
+		Select(fs.queryFields...).
+		From("FileInfo").
+		Where(sq.Eq{"FileInfo.ContentHash": ""}).
+		Where(sq.NotEq{"FileInfo.Path": ""}).
+		Where(sq.Or{
+			sq.Gt{"FileInfo.CreateAt": startTime},
+			sq.And{
+				sq.Eq{"FileInfo.CreateAt": startTime},
+				sq.Gt{"FileInfo.Id": startFileID},
+			},
+		}).
+		OrderBy("FileInfo.CreateAt ASC, FileInfo.Id ASC").
+		Limit(uint64(limit))
+
+	if err := fs.GetReplicaX().SelectBuilder(&infos, query); err != nil {
+		return nil, errors.Wrap(err, "failed to find FileInfos without content hash")
+	}
+	return infos, nil
+}
+
+func (fs SqlFileInfoStore) SetContentHash(rctx request.CTX, fileID, hash string) error {
+	query := fs.getQueryBuilder().
+		Update("FileInfo").
+		Set("ContentHash", hash).
+		Where(sq.Eq{"Id": fileID})
+
+	if _, err := fs.GetMasterX().ExecBuilder(query); err != nil {
+		return errors.Wrapf(err, "failed to update FileInfo content hash with id=%s", fileID)
+	}
+	return nil
+}
+
 func (fs SqlFileInfoStore) InvalidateFileInfosForPostCache(postId string, deleted bool) {
 }
 
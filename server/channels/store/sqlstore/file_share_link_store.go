@@ -0,0 +1,145 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+
+	sq "github.com/mattermost/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+)
+
+type SqlFileShareLinkStore struct {
+	*SqlStore
+
+	tableSelectQuery sq.SelectBuilder
+}
+
+func newSqlFileShareLinkStore(sqlStore *SqlStore) store.FileShareLinkStore {
+	s := &SqlFileShareLinkStore{
+		SqlStore: sqlStore,
+	}
+
+	s.tableSelectQuery = s.getQueryBuilder().
+		Select(
+			"Id",
+			"FileId",
+			"CreatorId",
+			"CreateAt",
+			"ExpireAt",
+			"DeleteAt",
+			"Password",
+			"MaxDownloads",
+			"DownloadCount",
+		).
+		From("FileShareLinks")
+
+	return s
+}
+
+func (s *SqlFileShareLinkStore) Save(link *model.FileShareLink) (*model.FileShareLink, error) {
+	link.PreSave()
+	if err := link.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query := s.getQueryBuilder().
+		Insert("FileShareLinks").
+		Columns("Id", "FileId", "CreatorId", "CreateAt", "ExpireAt", "DeleteAt", "Password", "MaxDownloads", "DownloadCount").
+		Values(link.Id, link.FileId, link.CreatorId, link.CreateAt, link.ExpireAt, link.DeleteAt, link.Password, link.MaxDownloads, link.DownloadCount)
+
+	if _, err := s.GetMasterX().ExecBuilder(query); err != nil {
+		return nil, errors.Wrapf(err, "failed to save FileShareLink with id=%s", link.Id)
+	}
+
+	return link, nil
+}
+
+func (s *SqlFileShareLinkStore) Get(id string) (*model.FileShareLink, error) {
+	query := s.tableSelectQuery.Where(sq.Eq{"Id": id})
+
+	var link model.FileShareLink
+	if err := s.GetReplicaX().GetBuilder(&link, query); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("FileShareLink", id)
+		}
+		return nil, errors.Wrapf(err, "failed to find FileShareLink with id=%s", id)
+	}
+
+	return &link, nil
+}
+
+func (s *SqlFileShareLinkStore) GetForFile(fileID string) ([]*model.FileShareLink, error) {
+	query := s.tableSelectQuery.
+		Where(sq.Eq{
+			"FileId":   fileID,
+			"DeleteAt": 0,
+		}).
+		OrderBy("CreateAt ASC")
+
+	links := []*model.FileShareLink{}
+	if err := s.GetReplicaX().SelectBuilder(&links, query); err != nil {
+		return nil, errors.Wrapf(err, "failed to find FileShareLinks for fileId=%s", fileID)
+	}
+
+	return links, nil
+}
+
+func (s *SqlFileShareLinkStore) IncrementDownloadCount(id string, now int64) error {
+	query := s.getQueryBuilder().
+		Update("FileShareLinks").
+		Set("DownloadCount", sq.Expr("DownloadCount + 1")).
+		Where(sq.And{
+			sq.Eq{"Id": id},
+			sq.Eq{"DeleteAt": 0},
+			sq.Gt{"ExpireAt": now},
+			sq.Or{
+				sq.Eq{"MaxDownloads": 0},
+				sq.Expr("DownloadCount < MaxDownloads"),
+			},
+		})
+
+	result, err := s.GetMasterX().ExecBuilder(query)
+	if err != nil {
+		return errors.Wrapf(err, "failed to increment download count for FileShareLink with id=%s", id)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "unable to get rows affected")
+	}
+	if rowsAffected == 0 {
+		return store.NewErrNotFound("FileShareLink", id)
+	}
+
+	return nil
+}
+
+func (s *SqlFileShareLinkStore) Revoke(id string, deleteAt int64) error {
+	query := s.getQueryBuilder().
+		Update("FileShareLinks").
+		Set("DeleteAt", deleteAt).
+		Where(sq.Eq{
+			"Id":       id,
+			"DeleteAt": 0,
+		})
+
+	result, err := s.GetMasterX().ExecBuilder(query)
+	if err != nil {
+		return errors.Wrapf(err, "failed to revoke FileShareLink with id=%s", id)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "unable to get rows affected")
+	}
+	if rowsAffected == 0 {
+		return store.NewErrNotFound("FileShareLink", id)
+	}
+
+	return nil
+}
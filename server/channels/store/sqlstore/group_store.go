@@ -67,11 +67,14 @@ func (s *SqlGroupStore) Create(group *model.Group) (*model.Group, error) {
 	group.Id = model.NewId()
 	group.CreateAt = model.GetMillis()
 	group.UpdateAt = group.CreateAt
+	group.LastMentionAt = 0
 
 	if _, err := s.GetMasterX().NamedExec(`INSERT INTO UserGroups
-		(Id, Name, DisplayName, Description, Source, RemoteId, CreateAt, UpdateAt, DeleteAt, AllowReference)
+		(Id, Name, DisplayName, Description, Source, RemoteId, CreateAt, UpdateAt, DeleteAt, AllowReference,
+			MentionPolicy, MentionCooldownSeconds, MentionMemberLimit)
 		VALUES
-		(:Id, :Name, :DisplayName, :Description, :Source, :RemoteId, :CreateAt, :UpdateAt, :DeleteAt, :AllowReference)`, group); err != nil {
+		(:Id, :Name, :DisplayName, :Description, :Source, :RemoteId, :CreateAt, :UpdateAt, :DeleteAt, :AllowReference,
+			:MentionPolicy, :MentionCooldownSeconds, :MentionMemberLimit)`, group); err != nil {
 		if IsUniqueConstraintError(err, []string{"Name", "groups_name_key"}) {
 			return nil, errors.Wrapf(err, "Group with name %s already exists", *group.Name)
 		}
@@ -102,8 +105,10 @@ func (s *SqlGroupStore) CreateWithUserIds(g *model.GroupWithUserIds) (_ *model.G
 
 	groupInsertQuery, groupInsertArgs, err := s.getQueryBuilder().
 		Insert("UserGroups").
-		Columns("Id", "Name", "DisplayName", "Description", "Source", "RemoteId", "CreateAt", "UpdateAt", "DeleteAt", "AllowReference").
-		Values(g.Id, g.Name, g.DisplayName, g.Description, g.Source, g.RemoteId, g.CreateAt, g.UpdateAt, 0, g.AllowReference).
+		Columns("Id", "Name", "DisplayName", "Description", "Source", "RemoteId", "CreateAt", "UpdateAt", "DeleteAt", "AllowReference",
+			"MentionPolicy", "MentionCooldownSeconds", "MentionMemberLimit").
+		Values(g.Id, g.Name, g.DisplayName, g.Description, g.Source, g.RemoteId, g.CreateAt, g.UpdateAt, 0, g.AllowReference,
+			g.MentionPolicy, g.MentionCooldownSeconds, g.MentionMemberLimit).
 		ToSql()
 	if err != nil {
 		return nil, err
@@ -342,6 +347,7 @@ func (s *SqlGroupStore) Update(group *model.Group) (*model.Group, error) {
 	// Reset these properties, don't update them based on input
 	group.CreateAt = retrievedGroup.CreateAt
 	group.UpdateAt = model.GetMillis()
+	group.LastMentionAt = retrievedGroup.LastMentionAt
 
 	if err := group.IsValidForUpdate(); err != nil {
 		return nil, err
@@ -349,7 +355,8 @@ func (s *SqlGroupStore) Update(group *model.Group) (*model.Group, error) {
 
 	res, err := s.GetMasterX().NamedExec(`UPDATE UserGroups
 		SET Name=:Name, DisplayName=:DisplayName, Description=:Description, Source=:Source,
-		RemoteId=:RemoteId, CreateAt=:CreateAt, UpdateAt=:UpdateAt, DeleteAt=:DeleteAt, AllowReference=:AllowReference
+		RemoteId=:RemoteId, CreateAt=:CreateAt, UpdateAt=:UpdateAt, DeleteAt=:DeleteAt, AllowReference=:AllowReference,
+		MentionPolicy=:MentionPolicy, MentionCooldownSeconds=:MentionCooldownSeconds, MentionMemberLimit=:MentionMemberLimit
 		WHERE Id=:Id`, group)
 	if err != nil {
 		if IsUniqueConstraintError(err, []string{"Name", "groups_name_key"}) {
@@ -365,6 +372,29 @@ func (s *SqlGroupStore) Update(group *model.Group) (*model.Group, error) {
 	return group, nil
 }
 
+// UpdateLastMentionAt records that the group was mentioned at mentionAt, unless it was already
+// mentioned less than cooldown milliseconds before. It returns whether the mention was recorded,
+// so that concurrent mentions of the group can't both get past the cooldown.
+func (s *SqlGroupStore) UpdateLastMentionAt(groupID string, mentionAt int64, cooldown int64) (bool, error) {
+	query := s.getQueryBuilder().
+		Update("UserGroups").
+		Set("LastMentionAt", mentionAt).
+		Where(sq.Eq{"Id": groupID}).
+		Where(sq.LtOrEq{"LastMentionAt": mentionAt - cooldown})
+
+	res, err := s.GetMasterX().ExecBuilder(query)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to update the last mention of Group with id=%s", groupID)
+	}
+
+	rowsChanged, err := res.RowsAffected()
+	if err != nil {
+		return false, errors.Wrap(err, "failed to get the number of updated Groups")
+	}
+
+	return rowsChanged == 1, nil
+}
+
 func (s *SqlGroupStore) Delete(groupID string) (*model.Group, error) {
 	var group model.Group
 	builder := s.getQueryBuilder().
@@ -1119,6 +1149,10 @@ type group struct {
 	AllowReference              bool
 	ChannelMemberCount          *int
 	ChannelMemberTimezonesCount *int
+	MentionPolicy               model.GroupMentionPolicy
+	MentionCooldownSeconds      int
+	MentionMemberLimit          int
+	LastMentionAt               int64
 }
 
 func (g group) ToModel() *model.Group {
@@ -1137,6 +1171,10 @@ func (g group) ToModel() *model.Group {
 		MemberCount:                 g.MemberCount,
 		ChannelMemberCount:          g.ChannelMemberCount,
 		ChannelMemberTimezonesCount: g.ChannelMemberTimezonesCount,
+		MentionPolicy:               g.MentionPolicy,
+		MentionCooldownSeconds:      g.MentionCooldownSeconds,
+		MentionMemberLimit:          g.MentionMemberLimit,
+		LastMentionAt:               g.LastMentionAt,
 	}
 }
 
@@ -0,0 +1,164 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+	"time"
+
+	sq "github.com/mattermost/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+)
+
+const integrationDeliveriesCleanupDelay = 100 * time.Millisecond
+
+type SqlIntegrationDeliveryStore struct {
+	*SqlStore
+
+	tableSelectQuery sq.SelectBuilder
+}
+
+func newSqlIntegrationDeliveryStore(sqlStore *SqlStore) store.IntegrationDeliveryStore {
+	s := &SqlIntegrationDeliveryStore{
+		SqlStore: sqlStore,
+	}
+
+	s.tableSelectQuery = s.getQueryBuilder().
+		Select(
+			"Id",
+			"HookId",
+			"Type",
+			"URL",
+			"ContentType",
+			"Payload",
+			"UserId",
+			"ChannelId",
+			"PostId",
+			"StatusCode",
+			"Latency",
+			"Response",
+			"Error",
+			"ReplayOf",
+			"CreateAt",
+		).
+		From("IntegrationDeliveries")
+
+	return s
+}
+
+func (s *SqlIntegrationDeliveryStore) Save(delivery *model.IntegrationDelivery) (*model.IntegrationDelivery, error) {
+	delivery.PreSave()
+	if err := delivery.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query := s.getQueryBuilder().
+		Insert("IntegrationDeliveries").
+		Columns("Id", "HookId", "Type", "URL", "ContentType", "Payload", "UserId", "ChannelId", "PostId", "StatusCode", "Latency", "Response", "Error", "ReplayOf", "CreateAt").
+		Values(delivery.Id, delivery.HookId, delivery.Type, delivery.URL, delivery.ContentType, delivery.Payload, delivery.UserId, delivery.ChannelId, delivery.PostId, delivery.StatusCode, delivery.Latency, delivery.Response, delivery.Error, delivery.ReplayOf, delivery.CreateAt)
+
+	if _, err := s.GetMasterX().ExecBuilder(query); err != nil {
+		return nil, errors.Wrapf(err, "failed to save IntegrationDelivery with id=%s", delivery.Id)
+	}
+
+	return delivery, nil
+}
+
+func (s *SqlIntegrationDeliveryStore) Get(id string) (*model.IntegrationDelivery, error) {
+	query := s.tableSelectQuery.Where(sq.Eq{"Id": id})
+
+	var delivery model.IntegrationDelivery
+	if err := s.GetReplicaX().GetBuilder(&delivery, query); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("IntegrationDelivery", id)
+		}
+		return nil, errors.Wrapf(err, "failed to get IntegrationDelivery with id=%s", id)
+	}
+
+	return &delivery, nil
+}
+
+func (s *SqlIntegrationDeliveryStore) GetForHook(hookID string, page, perPage int) ([]*model.IntegrationDelivery, error) {
+	query := s.tableSelectQuery.
+		Where(sq.Eq{"HookId": hookID}).
+		OrderBy("CreateAt DESC", "Id DESC").
+		Limit(uint64(perPage)).
+		Offset(uint64(page * perPage))
+
+	deliveries := []*model.IntegrationDelivery{}
+	if err := s.GetReplicaX().SelectBuilder(&deliveries, query); err != nil {
+		return nil, errors.Wrapf(err, "failed to find IntegrationDeliveries for hookId=%s", hookID)
+	}
+
+	return deliveries, nil
+}
+
+func (s *SqlIntegrationDeliveryStore) PruneForHook(hookID string, keep int) error {
+	query := s.getQueryBuilder().
+		Select("CreateAt").
+		From("IntegrationDeliveries").
+		Where(sq.Eq{"HookId": hookID}).
+		OrderBy("CreateAt DESC", "Id DESC").
+		Limit(1).
+		Offset(uint64(keep - 1))
+
+	var oldestKept int64
+	if err := s.GetMasterX().GetBuilder(&oldestKept, query); err != nil {
+		if err == sql.ErrNoRows {
+			return nil
+		}
+		return errors.Wrapf(err, "failed to find the oldest IntegrationDelivery to keep for hookId=%s", hookID)
+	}
+
+	deleteQuery := s.getQueryBuilder().
+		Delete("IntegrationDeliveries").
+		Where(sq.Eq{"HookId": hookID}).
+		Where(sq.Lt{"CreateAt": oldestKept})
+
+	if _, err := s.GetMasterX().ExecBuilder(deleteQuery); err != nil {
+		return errors.Wrapf(err, "failed to prune IntegrationDeliveries for hookId=%s", hookID)
+	}
+
+	return nil
+}
+
+func (s *SqlIntegrationDeliveryStore) PermanentDeleteByHook(hookID string) error {
+	query := s.getQueryBuilder().
+		Delete("IntegrationDeliveries").
+		Where(sq.Eq{"HookId": hookID})
+
+	if _, err := s.GetMasterX().ExecBuilder(query); err != nil {
+		return errors.Wrapf(err, "failed to delete IntegrationDeliveries for hookId=%s", hookID)
+	}
+
+	return nil
+}
+
+func (s *SqlIntegrationDeliveryStore) Cleanup(expiryTime int64, batchSize int) error {
+	var query string
+	if s.DriverName() == model.DatabaseDriverPostgres {
+		query = "DELETE FROM IntegrationDeliveries WHERE Id IN (SELECT Id FROM IntegrationDeliveries WHERE CreateAt < ? LIMIT ?)"
+	} else {
+		query = "DELETE FROM IntegrationDeliveries WHERE CreateAt < ? LIMIT ?"
+	}
+
+	var rowsAffected int64 = 1
+	for rowsAffected > 0 {
+		result, err := s.GetMasterX().Exec(query, expiryTime, batchSize)
+		if err != nil {
+			return errors.Wrap(err, "unable to delete IntegrationDeliveries")
+		}
+		rowsAffected, err = result.RowsAffected()
+		if err != nil {
+			return errors.Wrap(err, "unable to get rows affected")
+		}
+
+		time.Sleep(integrationDeliveriesCleanupDelay)
+	}
+
+	return nil
+}
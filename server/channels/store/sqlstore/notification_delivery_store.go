@@ -0,0 +1,109 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"time"
+
+	sq "github.com/mattermost/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+)
+
+const notificationDeliveriesCleanupDelay = 100 * time.Millisecond
+
+type SqlNotificationDeliveryStore struct {
+	*SqlStore
+
+	tableSelectQuery sq.SelectBuilder
+}
+
+func newSqlNotificationDeliveryStore(sqlStore *SqlStore) store.NotificationDeliveryStore {
+	s := &SqlNotificationDeliveryStore{
+		SqlStore: sqlStore,
+	}
+
+	s.tableSelectQuery = s.getQueryBuilder().
+		Select(
+			"Id",
+			"UserId",
+			"PostId",
+			"ChannelId",
+			"Type",
+			"Status",
+			"Reason",
+			"Detail",
+			"CreateAt",
+		).
+		From("NotificationDeliveries")
+
+	return s
+}
+
+func (s *SqlNotificationDeliveryStore) Save(delivery *model.NotificationDelivery) (*model.NotificationDelivery, error) {
+	delivery.PreSave()
+	if err := delivery.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query := s.getQueryBuilder().
+		Insert("NotificationDeliveries").
+		Columns("Id", "UserId", "PostId", "ChannelId", "Type", "Status", "Reason", "Detail", "CreateAt").
+		Values(delivery.Id, delivery.UserId, delivery.PostId, delivery.ChannelId, delivery.Type, delivery.Status, delivery.Reason, delivery.Detail, delivery.CreateAt)
+
+	if _, err := s.GetMasterX().ExecBuilder(query); err != nil {
+		return nil, errors.Wrapf(err, "failed to save NotificationDelivery with id=%s", delivery.Id)
+	}
+
+	return delivery, nil
+}
+
+func (s *SqlNotificationDeliveryStore) GetForUser(userID string, opts model.NotificationDeliveryGetOptions) ([]*model.NotificationDelivery, error) {
+	query := s.tableSelectQuery.
+		Where(sq.Eq{"UserId": userID}).
+		OrderBy("CreateAt DESC", "Id DESC").
+		Limit(uint64(opts.PerPage)).
+		Offset(uint64(opts.Page * opts.PerPage))
+
+	if opts.Since > 0 {
+		query = query.Where(sq.Gt{"CreateAt": opts.Since})
+	}
+	if opts.PostId != "" {
+		query = query.Where(sq.Eq{"PostId": opts.PostId})
+	}
+
+	deliveries := []*model.NotificationDelivery{}
+	if err := s.GetReplicaX().SelectBuilder(&deliveries, query); err != nil {
+		return nil, errors.Wrapf(err, "failed to find NotificationDeliveries for userId=%s", userID)
+	}
+
+	return deliveries, nil
+}
+
+func (s *SqlNotificationDeliveryStore) Cleanup(expiryTime int64, batchSize int) error {
+	var query string
+	if s.DriverName() == model.DatabaseDriverPostgres {
+		query = "DELETE FROM NotificationDeliveries WHERE Id IN (SELECT Id FROM NotificationDeliveries WHERE CreateAt < ? LIMIT ?)"
+	} else {
+		query = "DELETE FROM NotificationDeliveries WHERE CreateAt < ? LIMIT ?"
+	}
+
+	var rowsAffected int64 = 1
+	for rowsAffected > 0 {
+		result, err := s.GetMasterX().Exec(query, expiryTime, batchSize)
+		if err != nil {
+			return errors.Wrap(err, "unable to delete NotificationDeliveries")
+		}
+		rowsAffected, err = result.RowsAffected()
+		if err != nil {
+			return errors.Wrap(err, "unable to get rows affected")
+		}
+
+		time.Sleep(notificationDeliveriesCleanupDelay)
+	}
+
+	return nil
+}
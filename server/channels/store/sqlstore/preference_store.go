@@ -4,6 +4,8 @@
 package sqlstore
 
 import (
+	"fmt"
+
 	sq "github.com/mattermost/squirrel"
 	"github.com/pkg/errors"
 
@@ -189,6 +191,12 @@ func (s SqlPreferenceStore) GetAll(userId string) (model.Preferences, error) {
 	return preferences, nil
 }
 
+// GetEtag returns a new etag on every call, as nothing in the Preferences table records when a preference
+// was last updated. The local cache layer versions the preferences instead.
+func (s SqlPreferenceStore) GetEtag(userId string) string {
+	return fmt.Sprintf("%v.%v", model.CurrentVersion, model.NewId())
+}
+
 func (s SqlPreferenceStore) PermanentDeleteByUser(userId string) error {
 	sql, args, err := s.getQueryBuilder().
 		Delete("Preferences").
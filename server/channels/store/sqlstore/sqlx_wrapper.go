@@ -20,6 +20,7 @@ import (
 	"github.com/mattermost/mattermost/server/public/model"
 	"github.com/mattermost/mattermost/server/public/shared/mlog"
 	"github.com/mattermost/mattermost/server/v8/channels/store/storetest"
+	"github.com/mattermost/mattermost/server/v8/platform/services/slowops"
 )
 
 type StoreTestWrapper struct {
@@ -70,14 +71,17 @@ type sqlxDBWrapper struct {
 	queryTimeout time.Duration
 	trace        bool
 	isOnline     *atomic.Bool
+	// slowOps is shared with the store, which sets it once the server is initialized.
+	slowOps *atomic.Pointer[slowops.Recorder]
 }
 
-func newSqlxDBWrapper(db *sqlx.DB, timeout time.Duration, trace bool) *sqlxDBWrapper {
+func newSqlxDBWrapper(db *sqlx.DB, timeout time.Duration, trace bool, slowOps *atomic.Pointer[slowops.Recorder]) *sqlxDBWrapper {
 	w := &sqlxDBWrapper{
 		DB:           db,
 		queryTimeout: timeout,
 		trace:        trace,
 		isOnline:     &atomic.Bool{},
+		slowOps:      slowOps,
 	}
 	w.isOnline.Store(true)
 	return w
@@ -110,11 +114,7 @@ func (w *sqlxDBWrapper) Get(dest any, query string, args ...any) error {
 	ctx, cancel := context.WithTimeout(context.Background(), w.queryTimeout)
 	defer cancel()
 
-	if w.trace {
-		defer func(then time.Time) {
-			printArgs(query, time.Since(then), args)
-		}(time.Now())
-	}
+	defer w.trackQuery(query, time.Now(), args)
 
 	return w.checkErr(w.DB.GetContext(ctx, dest, query, args...))
 }
@@ -135,11 +135,7 @@ func (w *sqlxDBWrapper) NamedExec(query string, arg any) (sql.Result, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), w.queryTimeout)
 	defer cancel()
 
-	if w.trace {
-		defer func(then time.Time) {
-			printArgs(query, time.Since(then), arg)
-		}(time.Now())
-	}
+	defer w.trackQuery(query, time.Now(), arg)
 
 	return w.checkErrWithResult(w.DB.NamedExecContext(ctx, query, arg))
 }
@@ -162,11 +158,7 @@ func (w *sqlxDBWrapper) ExecBuilder(builder Builder) (sql.Result, error) {
 func (w *sqlxDBWrapper) ExecNoTimeout(query string, args ...any) (sql.Result, error) {
 	query = w.DB.Rebind(query)
 
-	if w.trace {
-		defer func(then time.Time) {
-			printArgs(query, time.Since(then), args)
-		}(time.Now())
-	}
+	defer w.trackQuery(query, time.Now(), args)
 
 	return w.checkErrWithResult(w.DB.ExecContext(context.Background(), query, args...))
 }
@@ -177,11 +169,7 @@ func (w *sqlxDBWrapper) ExecRaw(query string, args ...any) (sql.Result, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), w.queryTimeout)
 	defer cancel()
 
-	if w.trace {
-		defer func(then time.Time) {
-			printArgs(query, time.Since(then), args)
-		}(time.Now())
-	}
+	defer w.trackQuery(query, time.Now(), args)
 
 	return w.checkErrWithResult(w.DB.ExecContext(ctx, query, args...))
 }
@@ -193,11 +181,7 @@ func (w *sqlxDBWrapper) NamedQuery(query string, arg any) (*sqlx.Rows, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), w.queryTimeout)
 	defer cancel()
 
-	if w.trace {
-		defer func(then time.Time) {
-			printArgs(query, time.Since(then), arg)
-		}(time.Now())
-	}
+	defer w.trackQuery(query, time.Now(), arg)
 
 	return w.checkErrWithRows(w.DB.NamedQueryContext(ctx, query, arg))
 }
@@ -207,11 +191,7 @@ func (w *sqlxDBWrapper) QueryRowX(query string, args ...any) *sqlx.Row {
 	ctx, cancel := context.WithTimeout(context.Background(), w.queryTimeout)
 	defer cancel()
 
-	if w.trace {
-		defer func(then time.Time) {
-			printArgs(query, time.Since(then), args)
-		}(time.Now())
-	}
+	defer w.trackQuery(query, time.Now(), args)
 
 	return w.DB.QueryRowxContext(ctx, query, args...)
 }
@@ -221,11 +201,7 @@ func (w *sqlxDBWrapper) QueryX(query string, args ...any) (*sqlx.Rows, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), w.queryTimeout)
 	defer cancel()
 
-	if w.trace {
-		defer func(then time.Time) {
-			printArgs(query, time.Since(then), args)
-		}(time.Now())
-	}
+	defer w.trackQuery(query, time.Now(), args)
 
 	return w.checkErrWithRows(w.DB.QueryxContext(ctx, query, args))
 }
@@ -239,11 +215,7 @@ func (w *sqlxDBWrapper) SelectCtx(ctx context.Context, dest any, query string, a
 	ctx, cancel := context.WithTimeout(ctx, w.queryTimeout)
 	defer cancel()
 
-	if w.trace {
-		defer func(then time.Time) {
-			printArgs(query, time.Since(then), args)
-		}(time.Now())
-	}
+	defer w.trackQuery(query, time.Now(), args)
 
 	return w.checkErr(w.DB.SelectContext(ctx, dest, query, args...))
 }
@@ -278,11 +250,7 @@ func (w *sqlxTxWrapper) Get(dest any, query string, args ...any) error {
 	ctx, cancel := context.WithTimeout(context.Background(), w.queryTimeout)
 	defer cancel()
 
-	if w.trace {
-		defer func(then time.Time) {
-			printArgs(query, time.Since(then), args)
-		}(time.Now())
-	}
+	defer w.trackQuery(query, time.Now(), args)
 
 	return w.dbw.checkErr(w.Tx.GetContext(ctx, dest, query, args...))
 }
@@ -305,11 +273,7 @@ func (w *sqlxTxWrapper) Exec(query string, args ...any) (sql.Result, error) {
 func (w *sqlxTxWrapper) ExecNoTimeout(query string, args ...any) (sql.Result, error) {
 	query = w.Tx.Rebind(query)
 
-	if w.trace {
-		defer func(then time.Time) {
-			printArgs(query, time.Since(then), args)
-		}(time.Now())
-	}
+	defer w.trackQuery(query, time.Now(), args)
 
 	return w.dbw.checkErrWithResult(w.Tx.ExecContext(context.Background(), query, args...))
 }
@@ -329,11 +293,7 @@ func (w *sqlxTxWrapper) ExecRaw(query string, args ...any) (sql.Result, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), w.queryTimeout)
 	defer cancel()
 
-	if w.trace {
-		defer func(then time.Time) {
-			printArgs(query, time.Since(then), args)
-		}(time.Now())
-	}
+	defer w.trackQuery(query, time.Now(), args)
 
 	return w.dbw.checkErrWithResult(w.Tx.ExecContext(ctx, query, args...))
 }
@@ -345,11 +305,7 @@ func (w *sqlxTxWrapper) NamedExec(query string, arg any) (sql.Result, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), w.queryTimeout)
 	defer cancel()
 
-	if w.trace {
-		defer func(then time.Time) {
-			printArgs(query, time.Since(then), arg)
-		}(time.Now())
-	}
+	defer w.trackQuery(query, time.Now(), arg)
 
 	return w.dbw.checkErrWithResult(w.Tx.NamedExecContext(ctx, query, arg))
 }
@@ -361,11 +317,7 @@ func (w *sqlxTxWrapper) NamedQuery(query string, arg any) (*sqlx.Rows, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), w.queryTimeout)
 	defer cancel()
 
-	if w.trace {
-		defer func(then time.Time) {
-			printArgs(query, time.Since(then), arg)
-		}(time.Now())
-	}
+	defer w.trackQuery(query, time.Now(), arg)
 
 	// There is no tx.NamedQueryContext support in the sqlx API. (https://github.com/jmoiron/sqlx/issues/447)
 	// So we need to implement this ourselves.
@@ -403,11 +355,7 @@ func (w *sqlxTxWrapper) QueryRowX(query string, args ...any) *sqlx.Row {
 	ctx, cancel := context.WithTimeout(context.Background(), w.queryTimeout)
 	defer cancel()
 
-	if w.trace {
-		defer func(then time.Time) {
-			printArgs(query, time.Since(then), args)
-		}(time.Now())
-	}
+	defer w.trackQuery(query, time.Now(), args)
 
 	return w.Tx.QueryRowxContext(ctx, query, args...)
 }
@@ -417,11 +365,7 @@ func (w *sqlxTxWrapper) QueryX(query string, args ...any) (*sqlx.Rows, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), w.queryTimeout)
 	defer cancel()
 
-	if w.trace {
-		defer func(then time.Time) {
-			printArgs(query, time.Since(then), args)
-		}(time.Now())
-	}
+	defer w.trackQuery(query, time.Now(), args)
 
 	return w.dbw.checkErrWithRows(w.Tx.QueryxContext(ctx, query, args))
 }
@@ -431,11 +375,7 @@ func (w *sqlxTxWrapper) Select(dest any, query string, args ...any) error {
 	ctx, cancel := context.WithTimeout(context.Background(), w.queryTimeout)
 	defer cancel()
 
-	if w.trace {
-		defer func(then time.Time) {
-			printArgs(query, time.Since(then), args)
-		}(time.Now())
-	}
+	defer w.trackQuery(query, time.Now(), args)
 
 	return w.dbw.checkErr(w.Tx.SelectContext(ctx, dest, query, args...))
 }
@@ -469,6 +409,24 @@ func printArgs(query string, dur time.Duration, args ...any) {
 	mlog.Debug(query, fields...)
 }
 
+// trackQuery traces the query if enabled and records it if it was slow. The values of the
+// parameters are only ever logged by the trace.
+func (w *sqlxDBWrapper) trackQuery(query string, then time.Time, args any) {
+	dur := time.Since(then)
+	if w.trace {
+		printArgs(query, dur, args)
+	}
+	w.slowOps.Load().RecordQuery(query, dur)
+}
+
+func (w *sqlxTxWrapper) trackQuery(query string, then time.Time, args any) {
+	dur := time.Since(then)
+	if w.trace {
+		printArgs(query, dur, args)
+	}
+	w.dbw.slowOps.Load().RecordQuery(query, dur)
+}
+
 func (w *sqlxDBWrapper) checkErrWithResult(res sql.Result, err error) (sql.Result, error) {
 	return res, w.checkErr(err)
 }
@@ -90,16 +90,18 @@ func (s SqlStatusStore) GetByIds(userIds []string) ([]*model.Status, error) {
 	return statuses, nil
 }
 
+// timedStatuses are the statuses restored back to the previous status of the user after their end time.
+var timedStatuses = []string{model.StatusDnd, model.StatusOutOfOffice}
+
 // MySQL doesn't have support for RETURNING clause, so we use a transaction to get the updated rows.
-func (s SqlStatusStore) updateExpiredStatuses(t *sqlxTxWrapper) ([]*model.Status, error) {
+func (s SqlStatusStore) updateExpiredStatuses(t *sqlxTxWrapper, status string, currUnixTime int64) ([]*model.Status, error) {
 	statuses := []*model.Status{}
-	currUnixTime := time.Now().UTC().Unix()
 	selectQuery, selectParams, err := s.getQueryBuilder().
 		Select("*").
 		From("Status").
 		Where(
 			sq.And{
-				sq.Eq{"Status": model.StatusDnd},
+				sq.Eq{"Status": status},
 				sq.Gt{"DNDEndTime": 0},
 				sq.LtOrEq{"DNDEndTime": currUnixTime},
 			},
@@ -109,19 +111,19 @@ func (s SqlStatusStore) updateExpiredStatuses(t *sqlxTxWrapper) ([]*model.Status
 	}
 	err = t.Select(&statuses, selectQuery, selectParams...)
 	if err != nil {
-		return nil, errors.Wrap(err, "updateExpiredStatusesT: failed to get expired dnd statuses")
+		return nil, errors.Wrap(err, "updateExpiredStatusesT: failed to get expired timed statuses")
 	}
 	updateQuery, args, err := s.getQueryBuilder().
 		Update("Status").
 		Where(
 			sq.And{
-				sq.Eq{"Status": model.StatusDnd},
+				sq.Eq{"Status": status},
 				sq.Gt{"DNDEndTime": 0},
 				sq.LtOrEq{"DNDEndTime": currUnixTime},
 			},
 		).
 		Set("Status", sq.Expr("PrevStatus")).
-		Set("PrevStatus", model.StatusDnd).
+		Set("PrevStatus", status).
 		Set("DNDEndTime", 0).
 		Set("Manual", false).
 		ToSql()
@@ -134,45 +136,28 @@ func (s SqlStatusStore) updateExpiredStatuses(t *sqlxTxWrapper) ([]*model.Status
 		return nil, errors.Wrapf(err, "updateExpiredStatusesT: failed to update statuses")
 	}
 
+	for _, st := range statuses {
+		st.Status = st.PrevStatus
+		st.PrevStatus = status
+		st.DNDEndTime = 0
+		st.Manual = false
+	}
+
 	return statuses, nil
 }
 
-func (s SqlStatusStore) UpdateExpiredDNDStatuses() (_ []*model.Status, err error) {
-	if s.DriverName() == model.DatabaseDriverMysql {
-		transaction, terr := s.GetMasterX().Beginx()
-		if terr != nil {
-			return nil, errors.Wrap(terr, "UpdateExpiredDNDStatuses: begin_transaction")
-		}
-		defer finalizeTransactionX(transaction, &terr)
-		statuses, terr := s.updateExpiredStatuses(transaction)
-		if terr != nil {
-			return nil, errors.Wrap(terr, "UpdateExpiredDNDStatuses: updateExpiredDNDStatusesT")
-		}
-		if terr = transaction.Commit(); terr != nil {
-			return nil, errors.Wrap(terr, "UpdateExpiredDNDStatuses: commit_transaction")
-		}
-
-		for _, status := range statuses {
-			status.Status = status.PrevStatus
-			status.PrevStatus = model.StatusDnd
-			status.DNDEndTime = 0
-			status.Manual = false
-		}
-
-		return statuses, nil
-	}
-
+func (s SqlStatusStore) updateExpiredStatusesReturning(status string, currUnixTime int64) ([]*model.Status, error) {
 	queryString, args, err := s.getQueryBuilder().
 		Update("Status").
 		Where(
 			sq.And{
-				sq.Eq{"Status": model.StatusDnd},
+				sq.Eq{"Status": status},
 				sq.Gt{"DNDEndTime": 0},
-				sq.LtOrEq{"DNDEndTime": time.Now().UTC().Unix()},
+				sq.LtOrEq{"DNDEndTime": currUnixTime},
 			},
 		).
 		Set("Status", sq.Expr("PrevStatus")).
-		Set("PrevStatus", model.StatusDnd).
+		Set("PrevStatus", status).
 		Set("DNDEndTime", 0).
 		Set("Manual", false).
 		Suffix("RETURNING *").
@@ -189,12 +174,12 @@ func (s SqlStatusStore) UpdateExpiredDNDStatuses() (_ []*model.Status, err error
 	defer rows.Close()
 	statuses := []*model.Status{}
 	for rows.Next() {
-		var status model.Status
-		if err = rows.Scan(&status.UserId, &status.Status, &status.Manual, &status.LastActivityAt,
-			&status.DNDEndTime, &status.PrevStatus); err != nil {
+		var st model.Status
+		if err = rows.Scan(&st.UserId, &st.Status, &st.Manual, &st.LastActivityAt,
+			&st.DNDEndTime, &st.PrevStatus); err != nil {
 			return nil, errors.Wrap(err, "unable to scan from rows")
 		}
-		statuses = append(statuses, &status)
+		statuses = append(statuses, &st)
 	}
 	if err = rows.Err(); err != nil {
 		return nil, errors.Wrap(err, "failed while iterating over rows")
@@ -203,6 +188,45 @@ func (s SqlStatusStore) UpdateExpiredDNDStatuses() (_ []*model.Status, err error
 	return statuses, nil
 }
 
+// UpdateExpiredDNDStatuses restores the previous status of the users whose timed dnd or out of office
+// status has ended, returning the restored statuses.
+func (s SqlStatusStore) UpdateExpiredDNDStatuses() (_ []*model.Status, err error) {
+	currUnixTime := time.Now().UTC().Unix()
+
+	if s.DriverName() == model.DatabaseDriverMysql {
+		transaction, terr := s.GetMasterX().Beginx()
+		if terr != nil {
+			return nil, errors.Wrap(terr, "UpdateExpiredDNDStatuses: begin_transaction")
+		}
+		defer finalizeTransactionX(transaction, &terr)
+
+		statuses := []*model.Status{}
+		for _, status := range timedStatuses {
+			expired, terr := s.updateExpiredStatuses(transaction, status, currUnixTime)
+			if terr != nil {
+				return nil, errors.Wrap(terr, "UpdateExpiredDNDStatuses: updateExpiredDNDStatusesT")
+			}
+			statuses = append(statuses, expired...)
+		}
+		if terr = transaction.Commit(); terr != nil {
+			return nil, errors.Wrap(terr, "UpdateExpiredDNDStatuses: commit_transaction")
+		}
+
+		return statuses, nil
+	}
+
+	statuses := []*model.Status{}
+	for _, status := range timedStatuses {
+		expired, err := s.updateExpiredStatusesReturning(status, currUnixTime)
+		if err != nil {
+			return nil, err
+		}
+		statuses = append(statuses, expired...)
+	}
+
+	return statuses, nil
+}
+
 func (s SqlStatusStore) ResetAll() error {
 	if _, err := s.GetMasterX().Exec("UPDATE Status SET Status = ? WHERE Manual = false", model.StatusOffline); err != nil {
 		return errors.Wrap(err, "failed to update Statuses")
@@ -31,6 +31,7 @@ import (
 	"github.com/mattermost/mattermost/server/v8/channels/db"
 	"github.com/mattermost/mattermost/server/v8/channels/store"
 	"github.com/mattermost/mattermost/server/v8/einterfaces"
+	"github.com/mattermost/mattermost/server/v8/platform/services/slowops"
 )
 
 type migrationDirection string
@@ -111,6 +112,13 @@ type SqlStoreStores struct {
 	postPersistentNotification store.PostPersistentNotificationStore
 	desktopTokens              store.DesktopTokensStore
 	channelBookmarks           store.ChannelBookmarkStore
+	fileShareLinks             store.FileShareLinkStore
+	notificationDelivery       store.NotificationDeliveryStore
+	undeliverableEmail         store.UndeliverableEmailStore
+	integrationDelivery        store.IntegrationDeliveryStore
+	automationRules            store.AutomationRuleStore
+	customProfileAttributes    store.CustomProfileAttributeStore
+	configHistory              store.ConfigHistoryStore
 }
 
 type SqlStore struct {
@@ -134,6 +142,7 @@ type SqlStore struct {
 	licenseMutex      sync.RWMutex
 	logger            mlog.LoggerIFace
 	metrics           einterfaces.MetricsInterface
+	slowOps           atomic.Pointer[slowops.Recorder]
 
 	isBinaryParam             bool
 	pgDefaultTextSearchConfig string
@@ -236,6 +245,13 @@ func New(settings model.SqlSettings, logger mlog.LoggerIFace, metrics einterface
 	store.stores.postPersistentNotification = newSqlPostPersistentNotificationStore(store)
 	store.stores.desktopTokens = newSqlDesktopTokensStore(store, metrics)
 	store.stores.channelBookmarks = newSqlChannelBookmarkStore(store)
+	store.stores.fileShareLinks = newSqlFileShareLinkStore(store)
+	store.stores.notificationDelivery = newSqlNotificationDeliveryStore(store)
+	store.stores.undeliverableEmail = newSqlUndeliverableEmailStore(store)
+	store.stores.integrationDelivery = newSqlIntegrationDeliveryStore(store)
+	store.stores.automationRules = newSqlAutomationRuleStore(store)
+	store.stores.customProfileAttributes = newSqlCustomProfileAttributeStore(store)
+	store.stores.configHistory = newSqlConfigHistoryStore(store)
 
 	store.stores.preference.(*SqlPreferenceStore).deleteUnusedFeatures()
 
@@ -254,6 +270,11 @@ func (ss *SqlStore) Logger() mlog.LoggerIFace {
 	return ss.logger
 }
 
+// SetSlowOperationRecorder sets the recorder capturing the slow queries of all the connections.
+func (ss *SqlStore) SetSlowOperationRecorder(recorder *slowops.Recorder) {
+	ss.slowOps.Store(recorder)
+}
+
 func noOpMapper(s string) string { return s }
 
 func (ss *SqlStore) initConnection() error {
@@ -275,7 +296,8 @@ func (ss *SqlStore) initConnection() error {
 	}
 	ss.masterX = newSqlxDBWrapper(sqlx.NewDb(handle, ss.DriverName()),
 		time.Duration(*ss.settings.QueryTimeout)*time.Second,
-		*ss.settings.Trace)
+		*ss.settings.Trace,
+		&ss.slowOps)
 	if ss.DriverName() == model.DatabaseDriverMysql {
 		ss.masterX.MapperFunc(noOpMapper)
 	}
@@ -412,7 +434,8 @@ func (ss *SqlStore) GetMasterX() *sqlxDBWrapper {
 func (ss *SqlStore) SetMasterX(db *sql.DB) {
 	ss.masterX = newSqlxDBWrapper(sqlx.NewDb(db, ss.DriverName()),
 		time.Duration(*ss.settings.QueryTimeout)*time.Second,
-		*ss.settings.Trace)
+		*ss.settings.Trace,
+		&ss.slowOps)
 	if ss.DriverName() == model.DatabaseDriverMysql {
 		ss.masterX.MapperFunc(noOpMapper)
 	}
@@ -498,7 +521,8 @@ func (ss *SqlStore) monitorReplicas() {
 func (ss *SqlStore) setDB(replica *atomic.Pointer[sqlxDBWrapper], handle *dbsql.DB, name string) {
 	replica.Store(newSqlxDBWrapper(sqlx.NewDb(handle, ss.DriverName()),
 		time.Duration(*ss.settings.QueryTimeout)*time.Second,
-		*ss.settings.Trace))
+		*ss.settings.Trace,
+		&ss.slowOps))
 	if ss.DriverName() == model.DatabaseDriverMysql {
 		replica.Load().MapperFunc(noOpMapper)
 	}
@@ -516,6 +540,26 @@ func (ss *SqlStore) GetInternalReplicaDB() *sql.DB {
 	return ss.ReplicaXs[rrNum].Load().DB.DB
 }
 
+// DatabaseHealth pings the master database and each of the replicas.
+func (ss *SqlStore) DatabaseHealth(ctx context.Context) []*model.DependencyHealth {
+	ping := func(name string, db *sqlxDBWrapper) *model.DependencyHealth {
+		start := time.Now()
+		if db == nil || db.DB == nil || !db.Online() {
+			return model.NewDependencyHealth(name, start, errors.New("the database is offline"))
+		}
+		return model.NewDependencyHealth(name, start, db.PingContext(ctx))
+	}
+
+	health := []*model.DependencyHealth{ping("database_primary", ss.GetMasterX())}
+	for i, replica := range ss.ReplicaXs {
+		health = append(health, ping("database_replica_"+strconv.Itoa(i), replica.Load()))
+	}
+	for i, replica := range ss.searchReplicaXs {
+		health = append(health, ping("database_search_replica_"+strconv.Itoa(i), replica.Load()))
+	}
+	return health
+}
+
 func (ss *SqlStore) TotalMasterDbConnections() int {
 	return ss.GetMasterX().Stats().OpenConnections
 }
@@ -1039,6 +1083,34 @@ func (ss *SqlStore) ChannelBookmark() store.ChannelBookmarkStore {
 	return ss.stores.channelBookmarks
 }
 
+func (ss *SqlStore) FileShareLink() store.FileShareLinkStore {
+	return ss.stores.fileShareLinks
+}
+
+func (ss *SqlStore) NotificationDelivery() store.NotificationDeliveryStore {
+	return ss.stores.notificationDelivery
+}
+
+func (ss *SqlStore) UndeliverableEmail() store.UndeliverableEmailStore {
+	return ss.stores.undeliverableEmail
+}
+
+func (ss *SqlStore) IntegrationDelivery() store.IntegrationDeliveryStore {
+	return ss.stores.integrationDelivery
+}
+
+func (ss *SqlStore) AutomationRule() store.AutomationRuleStore {
+	return ss.stores.automationRules
+}
+
+func (ss *SqlStore) CustomProfileAttribute() store.CustomProfileAttributeStore {
+	return ss.stores.customProfileAttributes
+}
+
+func (ss *SqlStore) ConfigHistory() store.ConfigHistoryStore {
+	return ss.stores.configHistory
+}
+
 func (ss *SqlStore) DropAllTables() {
 	if ss.DriverName() == model.DatabaseDriverPostgres {
 		ss.masterX.Exec(`DO
@@ -1091,6 +1091,12 @@ func (s SqlTeamStore) GetMembersByIds(teamId string, userIds []string, restricti
 	return dbMembers.ToModel(), nil
 }
 
+// GetEtagForMembers returns a new etag on every call, as nothing in the TeamMembers table records when a
+// member was last updated. The local cache layer versions the members instead.
+func (s SqlTeamStore) GetEtagForMembers(teamId string) string {
+	return fmt.Sprintf("%v.%v", model.CurrentVersion, model.NewId())
+}
+
 // GetTeamsForUser returns a list of teams that the user is a member of. Expects userId to be passed as a parameter. It can also negative the teamID passed.
 func (s SqlTeamStore) GetTeamsForUser(ctx request.CTX, userId, excludeTeamID string, includeDeleted bool) ([]*model.TeamMember, error) {
 	query := s.getTeamMembersWithSchemeSelectQuery().
@@ -1184,6 +1190,37 @@ func (s SqlTeamStore) GetChannelUnreadsForTeam(teamId, userId string) ([]*model.
 	return channels, nil
 }
 
+// GetChannelUnreadsSince returns the unread msg and mention counts of the channels of the user,
+// including direct and group messages, with posts the user hasn't seen since the given time.
+// Channels with mentions come first, then the most recently active ones.
+func (s SqlTeamStore) GetChannelUnreadsSince(userId string, since int64, limit int) ([]*model.ChannelUnread, error) {
+	query, args, err := s.getQueryBuilder().
+		Select("Channels.TeamId TeamId", "Channels.Id ChannelId", "(Channels.TotalMsgCount - ChannelMembers.MsgCount) MsgCount", "(Channels.TotalMsgCountRoot - ChannelMembers.MsgCountRoot) MsgCountRoot", "ChannelMembers.MentionCount MentionCount", "ChannelMembers.MentionCountRoot MentionCountRoot", "ChannelMembers.NotifyProps NotifyProps").
+		From("Channels").
+		Join("ChannelMembers ON Id = ChannelId").
+		Where(sq.Eq{"UserId": userId, "DeleteAt": 0}).
+		Where(sq.Gt{"Channels.LastPostAt": since}).
+		Where("Channels.LastPostAt > ChannelMembers.LastViewedAt").
+		Where(sq.Or{
+			sq.Gt{"ChannelMembers.MentionCount": 0},
+			sq.Expr("Channels.TotalMsgCount > ChannelMembers.MsgCount"),
+		}).
+		OrderBy("ChannelMembers.MentionCount DESC", "Channels.LastPostAt DESC").
+		Limit(uint64(limit)).ToSql()
+
+	if err != nil {
+		return nil, errors.Wrap(err, "team_tosql")
+	}
+
+	channels := []*model.ChannelUnread{}
+	err = s.GetReplicaX().Select(&channels, query, args...)
+
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to find Channels with userId=%s and since=%d", userId, since)
+	}
+	return channels, nil
+}
+
 func (s SqlTeamStore) RemoveMembers(rctx request.CTX, teamId string, userIds []string) error {
 	builder := s.getQueryBuilder().
 		Delete("TeamMembers").
@@ -29,13 +29,19 @@ func (s SqlTermsOfServiceStore) Save(termsOfService *model.TermsOfService) (*mod
 
 	termsOfService.PreSave()
 
+	var latestVersion int
+	if err := s.GetMasterX().Get(&latestVersion, "SELECT COALESCE(MAX(Version), 0) FROM TermsOfService"); err != nil {
+		return nil, errors.Wrap(err, "could not get the latest TermsOfService version")
+	}
+	termsOfService.Version = latestVersion + 1
+
 	if err := termsOfService.IsValid(); err != nil {
 		return nil, err
 	}
 	query := `INSERT INTO TermsOfService
-				(Id, CreateAt, UserId, Text)
+				(Id, CreateAt, UserId, Text, Version, Audience)
 				VALUES
-				(:Id, :CreateAt, :UserId, :Text)
+				(:Id, :CreateAt, :UserId, :Text, :Version, :Audience)
 				`
 
 	if _, err := s.GetMasterX().NamedExec(query, termsOfService); err != nil {
@@ -90,3 +96,25 @@ func (s SqlTermsOfServiceStore) Get(id string, allowFromCache bool) (*model.Term
 	}
 	return &termsOfService, nil
 }
+
+func (s SqlTermsOfServiceStore) GetAll(offset, limit int) ([]*model.TermsOfService, error) {
+	termsOfService := []*model.TermsOfService{}
+
+	query := s.getQueryBuilder().
+		Select("*").
+		From("TermsOfService").
+		OrderBy("CreateAt DESC").
+		Limit(uint64(limit)).
+		Offset(uint64(offset))
+
+	queryString, args, err := query.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not build sql query to get TOS versions")
+	}
+
+	if err := s.GetReplicaX().Select(&termsOfService, queryString, args...); err != nil {
+		return nil, errors.Wrap(err, "could not find TermsOfService versions")
+	}
+
+	return termsOfService, nil
+}
@@ -0,0 +1,77 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+
+	sq "github.com/mattermost/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+)
+
+type SqlUndeliverableEmailStore struct {
+	*SqlStore
+}
+
+func newSqlUndeliverableEmailStore(sqlStore *SqlStore) store.UndeliverableEmailStore {
+	return &SqlUndeliverableEmailStore{
+		SqlStore: sqlStore,
+	}
+}
+
+func (s *SqlUndeliverableEmailStore) Save(email *model.UndeliverableEmail) (*model.UndeliverableEmail, error) {
+	email.PreSave()
+	if err := email.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query := s.getQueryBuilder().
+		Insert("UndeliverableEmails").
+		Columns("Email", "Reason", "Detail", "CreateAt", "UpdateAt").
+		Values(email.Email, email.Reason, email.Detail, email.CreateAt, email.UpdateAt)
+
+	if s.DriverName() == model.DatabaseDriverMysql {
+		query = query.SuffixExpr(sq.Expr("ON DUPLICATE KEY UPDATE Reason = ?, Detail = ?, UpdateAt = ?", email.Reason, email.Detail, email.UpdateAt))
+	} else {
+		query = query.SuffixExpr(sq.Expr("ON CONFLICT (Email) DO UPDATE SET Reason = ?, Detail = ?, UpdateAt = ?", email.Reason, email.Detail, email.UpdateAt))
+	}
+
+	if _, err := s.GetMasterX().ExecBuilder(query); err != nil {
+		return nil, errors.Wrap(err, "failed to save UndeliverableEmail")
+	}
+
+	return email, nil
+}
+
+func (s *SqlUndeliverableEmailStore) Get(email string) (*model.UndeliverableEmail, error) {
+	query := s.getQueryBuilder().
+		Select("Email", "Reason", "Detail", "CreateAt", "UpdateAt").
+		From("UndeliverableEmails").
+		Where(sq.Eq{"Email": model.NormalizeUndeliverableEmail(email)})
+
+	var undeliverable model.UndeliverableEmail
+	if err := s.GetReplicaX().GetBuilder(&undeliverable, query); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("UndeliverableEmail", email)
+		}
+		return nil, errors.Wrap(err, "failed to get UndeliverableEmail")
+	}
+
+	return &undeliverable, nil
+}
+
+func (s *SqlUndeliverableEmailStore) Delete(email string) error {
+	query := s.getQueryBuilder().
+		Delete("UndeliverableEmails").
+		Where(sq.Eq{"Email": model.NormalizeUndeliverableEmail(email)})
+
+	if _, err := s.GetMasterX().ExecBuilder(query); err != nil {
+		return errors.Wrap(err, "failed to delete UndeliverableEmail")
+	}
+
+	return nil
+}
@@ -29,8 +29,8 @@ func (s SqlUserAccessTokenStore) Save(token *model.UserAccessToken) (*model.User
 	}
 
 	query, args, err := s.getQueryBuilder().Insert("UserAccessTokens").
-		Columns("Id", "Token", "UserId", "Description", "IsActive").
-		Values(token.Id, token.Token, token.UserId, token.Description, token.IsActive).
+		Columns("Id", "Token", "UserId", "Description", "IsActive", "ExpiresAt").
+		Values(token.Id, token.Token, token.UserId, token.Description, token.IsActive, token.ExpiresAt).
 		ToSql()
 	if err != nil {
 		return nil, errors.Wrap(err, "UserAccessToken_tosql")
@@ -233,3 +233,49 @@ func (s SqlUserAccessTokenStore) updateTokenDisable(transaction *sqlxTxWrapper,
 
 	return nil
 }
+
+func (s SqlUserAccessTokenStore) UpdateExpiresAt(tokenId string, expiresAt int64) (err error) {
+	transaction, err := s.GetMasterX().Beginx()
+	if err != nil {
+		return errors.Wrap(err, "begin_transaction")
+	}
+	defer finalizeTransactionX(transaction, &err)
+
+	query := ""
+	if s.DriverName() == model.DatabaseDriverPostgres {
+		query = "UPDATE Sessions s SET ExpiresAt = ? FROM UserAccessTokens o WHERE o.Token = s.Token AND o.Id = ?"
+	} else if s.DriverName() == model.DatabaseDriverMysql {
+		query = "UPDATE Sessions s INNER JOIN UserAccessTokens o ON o.Token = s.Token SET s.ExpiresAt = ? WHERE o.Id = ?"
+	}
+
+	if _, err := transaction.Exec(query, expiresAt, tokenId); err != nil {
+		return errors.Wrapf(err, "failed to update Sessions with UserAccessToken id=%s", tokenId)
+	}
+
+	if _, err := transaction.Exec("UPDATE UserAccessTokens SET ExpiresAt = ? WHERE Id = ?", expiresAt, tokenId); err != nil {
+		return errors.Wrapf(err, "failed to update UserAccessToken with id=%s", tokenId)
+	}
+
+	if err := transaction.Commit(); err != nil {
+		return errors.Wrap(err, "commit_transaction")
+	}
+	return nil
+}
+
+func (s SqlUserAccessTokenStore) GetExpiring(before int64, offset, limit int) ([]*model.UserAccessToken, error) {
+	tokens := []*model.UserAccessToken{}
+
+	query := `
+		SELECT
+			*
+		FROM UserAccessTokens
+		WHERE IsActive = TRUE AND ExpiresAt > 0 AND ExpiresAt <= ?
+		ORDER BY ExpiresAt, Id
+		LIMIT ? OFFSET ?`
+
+	if err := s.GetReplicaX().Select(&tokens, query, before, limit, offset); err != nil {
+		return nil, errors.Wrap(err, "failed to find expiring UserAccessTokens")
+	}
+
+	return tokens, nil
+}
@@ -56,7 +56,7 @@ func newSqlUserStore(sqlStore *SqlStore, metrics einterfaces.MetricsInterface) s
 	// note: we are providing field names explicitly here to maintain order of columns (needed when using raw queries)
 	us.usersQuery = us.getQueryBuilder().
 		Select("u.Id", "u.CreateAt", "u.UpdateAt", "u.DeleteAt", "u.Username", "u.Password", "u.AuthData", "u.AuthService", "u.Email", "u.EmailVerified", "u.Nickname", "u.FirstName", "u.LastName", "u.Position", "u.Roles", "u.AllowMarketing", "u.Props", "u.NotifyProps", "u.LastPasswordUpdate", "u.LastPictureUpdate", "u.FailedAttempts", "u.Locale", "u.Timezone", "u.MfaActive", "u.MfaSecret",
-			"b.UserId IS NOT NULL AS IsBot", "COALESCE(b.Description, '') AS BotDescription", "COALESCE(b.LastIconUpdate, 0) AS BotLastIconUpdate", "u.RemoteId", "u.LastLogin").
+			"b.UserId IS NOT NULL AS IsBot", "COALESCE(b.Description, '') AS BotDescription", "COALESCE(b.LastIconUpdate, 0) AS BotLastIconUpdate", "u.RemoteId", "u.LastLogin", "u.ManagerId").
 		From("Users u").
 		LeftJoin("Bots b ON ( b.UserId = u.Id )")
 
@@ -85,12 +85,12 @@ func (us SqlUserStore) insert(user *model.User) (sql.Result, error) {
 		(Id, CreateAt, UpdateAt, DeleteAt, Username, Password, AuthData, AuthService,
 			Email, EmailVerified, Nickname, FirstName, LastName, Position, Roles, AllowMarketing,
 			Props, NotifyProps, LastPasswordUpdate, LastPictureUpdate, FailedAttempts,
-			Locale, Timezone, MfaActive, MfaSecret, RemoteId)
+			Locale, Timezone, MfaActive, MfaSecret, RemoteId, ManagerId)
 		VALUES
 		(:Id, :CreateAt, :UpdateAt, :DeleteAt, :Username, :Password, :AuthData, :AuthService,
 			:Email, :EmailVerified, :Nickname, :FirstName, :LastName, :Position, :Roles, :AllowMarketing,
 			:Props, :NotifyProps, :LastPasswordUpdate, :LastPictureUpdate, :FailedAttempts,
-			:Locale, :Timezone, :MfaActive, :MfaSecret, :RemoteId)`
+			:Locale, :Timezone, :MfaActive, :MfaSecret, :RemoteId, :ManagerId)`
 
 	user.Props = wrapBinaryParamStringMap(us.IsBinaryParamEnabled(), user.Props)
 	return us.GetMasterX().NamedExec(query, user)
@@ -196,6 +196,7 @@ func (us SqlUserStore) Update(rctx request.CTX, user *model.User, trustedUpdateD
 	user.MfaSecret = oldUser.MfaSecret
 	user.MfaActive = oldUser.MfaActive
 	user.LastLogin = oldUser.LastLogin
+	user.ManagerId = oldUser.ManagerId
 
 	if !trustedUpdateData {
 		user.Roles = oldUser.Roles
@@ -379,6 +380,50 @@ func (us SqlUserStore) UpdateLastLogin(userId string, lastLogin int64) error {
 	return nil
 }
 
+func (us SqlUserStore) UpdateManager(userId string, managerId string) error {
+	updateQuery := us.getQueryBuilder().
+		Update("Users").
+		Set("ManagerId", managerId).
+		Set("UpdateAt", model.GetMillis()).
+		Where(sq.Eq{"Id": userId})
+
+	queryString, args, err := updateQuery.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "update_manager_tosql")
+	}
+
+	if _, err := us.GetMasterX().Exec(queryString, args...); err != nil {
+		return errors.Wrapf(err, "failed to update User with userId=%s", userId)
+	}
+
+	return nil
+}
+
+// GetDirectReports returns the active users whose manager is managerId, ordered by username.
+func (us SqlUserStore) GetDirectReports(managerId string, offset, limit int) ([]*model.User, error) {
+	query := us.usersQuery.
+		Where(sq.Eq{"u.ManagerId": managerId, "u.DeleteAt": 0}).
+		OrderBy("u.Username ASC").
+		Offset(uint64(offset)).
+		Limit(uint64(limit))
+
+	queryString, args, err := query.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "get_direct_reports_tosql")
+	}
+
+	users := []*model.User{}
+	if err := us.GetReplicaX().Select(&users, queryString, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to get direct reports for managerId=%s", managerId)
+	}
+
+	for _, u := range users {
+		u.Sanitize(map[string]bool{})
+	}
+
+	return users, nil
+}
+
 // ResetAuthDataToEmailForUsers resets the AuthData of users whose AuthService
 // is |service| to their Email. If userIDs is non-empty, only the users whose
 // IDs are in userIDs will be affected. If dryRun is true, only the number
@@ -473,7 +518,7 @@ func (us SqlUserStore) Get(ctx context.Context, id string) (*model.User, error)
 		&user.Nickname, &user.FirstName, &user.LastName, &user.Position, &user.Roles,
 		&user.AllowMarketing, &props, &notifyProps, &user.LastPasswordUpdate, &user.LastPictureUpdate,
 		&user.FailedAttempts, &user.Locale, &timezone, &user.MfaActive, &user.MfaSecret,
-		&user.IsBot, &user.BotDescription, &user.BotLastIconUpdate, &user.RemoteId, &user.LastLogin)
+		&user.IsBot, &user.BotDescription, &user.BotLastIconUpdate, &user.RemoteId, &user.LastLogin, &user.ManagerId)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, store.NewErrNotFound("User", id)
@@ -546,6 +591,7 @@ func (us SqlUserStore) GetAllProfiles(options *model.UserGetOptions) ([]*model.U
 
 	query = applyRoleFilter(query, options.Role, isPostgreSQL)
 	query = applyMultiRoleFilters(query, options.Roles, []string{}, []string{}, isPostgreSQL)
+	query = applyCustomProfileAttributesFilter(query, options.CustomProfileAttributes)
 
 	if options.Inactive {
 		query = query.Where("u.DeleteAt != 0")
@@ -585,6 +631,22 @@ func applyRoleFilter(query sq.SelectBuilder, role string, isPostgreSQL bool) sq.
 	return query.Where("u.Roles LIKE ? ESCAPE '*'", roleParam)
 }
 
+// applyCustomProfileAttributesFilter restricts the query to the users having all the given values,
+// keyed by custom profile field id.
+func applyCustomProfileAttributesFilter(query sq.SelectBuilder, attributes map[string]string) sq.SelectBuilder {
+	fieldIDs := make([]string, 0, len(attributes))
+	for fieldID := range attributes {
+		fieldIDs = append(fieldIDs, fieldID)
+	}
+	sort.Strings(fieldIDs)
+
+	for _, fieldID := range fieldIDs {
+		query = query.Where("u.Id IN (SELECT UserId FROM CustomProfileAttributeValues WHERE FieldId = ? AND Value = ?)", fieldID, attributes[fieldID])
+	}
+
+	return query
+}
+
 func applyMultiRoleFilters(query sq.SelectBuilder, systemRoles []string, teamRoles []string, channelRoles []string, isPostgreSQL bool) sq.SelectBuilder {
 	sqOr := sq.Or{}
 
@@ -723,6 +785,7 @@ func (us SqlUserStore) GetProfiles(options *model.UserGetOptions) ([]*model.User
 
 	query = applyRoleFilter(query, options.Role, isPostgreSQL)
 	query = applyMultiRoleFilters(query, options.Roles, options.TeamRoles, options.ChannelRoles, isPostgreSQL)
+	query = applyCustomProfileAttributesFilter(query, options.CustomProfileAttributes)
 
 	if options.Inactive {
 		query = query.Where("u.DeleteAt != 0")
@@ -875,7 +938,7 @@ func (us SqlUserStore) GetAllProfilesInChannel(ctx context.Context, channelID st
 	for rows.Next() {
 		var user model.User
 		var props, notifyProps, timezone []byte
-		if err = rows.Scan(&user.Id, &user.CreateAt, &user.UpdateAt, &user.DeleteAt, &user.Username, &user.Password, &user.AuthData, &user.AuthService, &user.Email, &user.EmailVerified, &user.Nickname, &user.FirstName, &user.LastName, &user.Position, &user.Roles, &user.AllowMarketing, &props, &notifyProps, &user.LastPasswordUpdate, &user.LastPictureUpdate, &user.FailedAttempts, &user.Locale, &timezone, &user.MfaActive, &user.MfaSecret, &user.IsBot, &user.BotDescription, &user.BotLastIconUpdate, &user.RemoteId, &user.LastLogin); err != nil {
+		if err = rows.Scan(&user.Id, &user.CreateAt, &user.UpdateAt, &user.DeleteAt, &user.Username, &user.Password, &user.AuthData, &user.AuthService, &user.Email, &user.EmailVerified, &user.Nickname, &user.FirstName, &user.LastName, &user.Position, &user.Roles, &user.AllowMarketing, &props, &notifyProps, &user.LastPasswordUpdate, &user.LastPictureUpdate, &user.FailedAttempts, &user.Locale, &timezone, &user.MfaActive, &user.MfaSecret, &user.IsBot, &user.BotDescription, &user.BotLastIconUpdate, &user.RemoteId, &user.LastLogin, &user.ManagerId); err != nil {
 			return nil, errors.Wrap(err, "failed to scan values from rows into User entity")
 		}
 		if err = json.Unmarshal(props, &user.Props); err != nil {
@@ -1643,6 +1706,7 @@ func (us SqlUserStore) performSearch(query sq.SelectBuilder, term string, option
 
 	query = applyRoleFilter(query, options.Role, isPostgreSQL)
 	query = applyMultiRoleFilters(query, options.Roles, options.TeamRoles, options.ChannelRoles, isPostgreSQL)
+	query = applyCustomProfileAttributesFilter(query, options.CustomProfileAttributes)
 
 	if !options.AllowInactive {
 		query = query.Where("u.DeleteAt = 0")
@@ -6,6 +6,7 @@ package sqlstore
 import (
 	"database/sql"
 
+	sq "github.com/mattermost/squirrel"
 	"github.com/pkg/errors"
 
 	"github.com/mattermost/mattermost/server/public/model"
@@ -85,3 +86,36 @@ func (s SqlUserTermsOfServiceStore) Delete(userId, termsOfServiceId string) erro
 
 	return nil
 }
+
+// CountByTermsOfService counts the active users who accepted the given terms of service, restricted
+// to the users included in the audience.
+func (s SqlUserTermsOfServiceStore) CountByTermsOfService(termsOfServiceId, audience string) (int64, error) {
+	query := s.getQueryBuilder().
+		Select("COUNT(*)").
+		From("UserTermsOfService").
+		Join("Users ON Users.Id = UserTermsOfService.UserId").
+		Where(sq.Eq{
+			"UserTermsOfService.TermsOfServiceId": termsOfServiceId,
+			"Users.DeleteAt":                      0,
+		})
+
+	guestRole := "%" + model.SystemGuestRoleId + "%"
+	switch audience {
+	case model.TermsOfServiceAudienceGuests:
+		query = query.Where(sq.Like{"Users.Roles": guestRole})
+	case model.TermsOfServiceAudienceMembers:
+		query = query.Where(sq.NotLike{"Users.Roles": guestRole})
+	}
+
+	queryString, args, err := query.ToSql()
+	if err != nil {
+		return 0, errors.Wrap(err, "user_terms_of_service_count_tosql")
+	}
+
+	var count int64
+	if err := s.GetReplicaX().Get(&count, queryString, args...); err != nil {
+		return 0, errors.Wrapf(err, "failed to count UserTermsOfService with termsOfServiceId=%s", termsOfServiceId)
+	}
+
+	return count, nil
+}
@@ -91,6 +91,13 @@ type Store interface {
 	PostPersistentNotification() PostPersistentNotificationStore
 	DesktopTokens() DesktopTokensStore
 	ChannelBookmark() ChannelBookmarkStore
+	FileShareLink() FileShareLinkStore
+	NotificationDelivery() NotificationDeliveryStore
+	UndeliverableEmail() UndeliverableEmailStore
+	IntegrationDelivery() IntegrationDeliveryStore
+	AutomationRule() AutomationRuleStore
+	CustomProfileAttribute() CustomProfileAttributeStore
+	ConfigHistory() ConfigHistoryStore
 }
 
 type RetentionPolicyStore interface {
@@ -143,12 +150,17 @@ type TeamStore interface {
 	GetMember(c request.CTX, teamID string, userID string) (*model.TeamMember, error)
 	GetMembers(teamID string, offset int, limit int, teamMembersGetOptions *model.TeamMembersGetOptions) ([]*model.TeamMember, error)
 	GetMembersByIds(teamID string, userIds []string, restrictions *model.ViewUsersRestrictions) ([]*model.TeamMember, error)
+	// GetEtagForMembers returns an etag which changes whenever the members of the team change. The team members
+	// carry no timestamp of their updates, so the etag only stays the same between calls when the store is
+	// wrapped by the local cache layer, which keeps a version of the members of each team.
+	GetEtagForMembers(teamID string) string
 	GetTotalMemberCount(teamID string, restrictions *model.ViewUsersRestrictions) (int64, error)
 	GetActiveMemberCount(teamID string, restrictions *model.ViewUsersRestrictions) (int64, error)
 	GetTeamsForUser(c request.CTX, userID, excludeTeamID string, includeDeleted bool) ([]*model.TeamMember, error)
 	GetTeamsForUserWithPagination(userID string, page, perPage int) ([]*model.TeamMember, error)
 	GetChannelUnreadsForAllTeams(excludeTeamID, userID string) ([]*model.ChannelUnread, error)
 	GetChannelUnreadsForTeam(teamID, userID string) ([]*model.ChannelUnread, error)
+	GetChannelUnreadsSince(userID string, since int64, limit int) ([]*model.ChannelUnread, error)
 	RemoveMember(rctx request.CTX, teamID string, userID string) error
 	RemoveMembers(rctx request.CTX, teamID string, userIds []string) error
 	RemoveAllMembersByTeam(teamID string) error
@@ -204,6 +216,9 @@ type ChannelStore interface {
 	GetDeleted(team_id string, offset int, limit int, userID string) (model.ChannelList, error)
 	GetChannels(teamID, userID string, opts *model.ChannelSearchOpts) (model.ChannelList, error)
 	GetChannelsByUser(userID string, includeDeleted bool, lastDeleteAt, pageSize int, fromChannelID string) (model.ChannelList, error)
+	// GetEtagForUserChannels returns an etag which changes whenever the channels of the user change, including
+	// the archived ones. The channels of all the teams are covered when the team is empty.
+	GetEtagForUserChannels(userID, teamID string) string
 	GetAllChannelMemberIdsByChannelId(id string) ([]string, error)
 	GetAllChannels(page, perPage int, opts ChannelSearchOpts) (model.ChannelListWithTeamData, error)
 	GetAllChannelsCount(opts ChannelSearchOpts) (int64, error)
@@ -226,6 +241,7 @@ type ChannelStore interface {
 	UpdateMemberNotifyProps(channelID, userID string, props map[string]string) (*model.ChannelMember, error)
 	PatchMultipleMembersNotifyProps(members []*model.ChannelMemberIdentifier, notifyProps map[string]string) ([]*model.ChannelMember, error)
 	GetMembers(channelID string, offset, limit int) (model.ChannelMembers, error)
+	GetMembersAfter(channelID string, opts *model.ChannelMembersGetOptions) (model.ChannelMembers, error)
 	GetMember(ctx context.Context, channelID string, userID string) (*model.ChannelMember, error)
 	GetMemberLastViewedAt(ctx context.Context, channelID string, userID string) (int64, error)
 	GetChannelMembersTimezones(channelID string) ([]model.StringMap, error)
@@ -284,6 +300,7 @@ type ChannelStore interface {
 	GetSidebarCategories(userID string, opts *SidebarCategorySearchOpts) (*model.OrderedSidebarCategories, error)
 	GetSidebarCategory(categoryID string) (*model.SidebarCategoryWithChannels, error)
 	GetSidebarCategoryOrder(userID, teamID string) ([]string, error)
+	GetSidebarCategoryUnreads(userID string) ([]*model.SidebarCategoryUnread, error)
 	CreateSidebarCategory(userID, teamID string, newCategory *model.SidebarCategoryWithChannels) (*model.SidebarCategoryWithChannels, error)
 	UpdateSidebarCategoryOrder(userID, teamID string, categoryOrder []string) error
 	UpdateSidebarCategories(userID, teamID string, categories []*model.SidebarCategoryWithChannels) ([]*model.SidebarCategoryWithChannels, []*model.SidebarCategoryWithChannels, error)
@@ -410,6 +427,8 @@ type UserStore interface {
 	UpdateUpdateAt(userID string) (int64, error)
 	UpdateAuthData(userID string, service string, authData *string, email string, resetMfa bool) (string, error)
 	UpdateLastLogin(userID string, lastLogin int64) error
+	UpdateManager(userID string, managerID string) error
+	GetDirectReports(managerID string, offset, limit int) ([]*model.User, error)
 	ResetAuthDataToEmailForUsers(service string, userIDs []string, includeDeleted bool, dryRun bool) (int, error)
 	UpdateMfaSecret(userID, secret string) error
 	UpdateMfaActive(userID string, active bool) error
@@ -543,7 +562,9 @@ type ComplianceStore interface {
 	Update(compliance *model.Compliance) (*model.Compliance, error)
 	Get(id string) (*model.Compliance, error)
 	GetAll(offset, limit int) (model.Compliances, error)
+	GetLastSuccessfulIncremental() (*model.Compliance, error)
 	ComplianceExport(compliance *model.Compliance, cursor model.ComplianceExportCursor, limit int) ([]*model.CompliancePost, model.ComplianceExportCursor, error)
+	ComplianceExportIncremental(compliance *model.Compliance, cursor model.ComplianceIncrementalExportCursor, limit int) ([]*model.CompliancePost, model.ComplianceIncrementalExportCursor, error)
 	MessageExport(c request.CTX, cursor model.MessageExportCursor, limit int) ([]*model.MessageExport, model.MessageExportCursor, error)
 }
 
@@ -646,6 +667,9 @@ type PreferenceStore interface {
 	GetCategoryAndName(category string, nane string) (model.Preferences, error)
 	Get(userID string, category string, name string) (*model.Preference, error)
 	GetAll(userID string) (model.Preferences, error)
+	// GetEtag returns an etag which changes whenever the preferences of the user change. As for the team members,
+	// the etag only stays the same between calls when the store is wrapped by the local cache layer.
+	GetEtag(userID string) string
 	Delete(userID, category, name string) error
 	DeleteCategory(userID string, category string) error
 	DeleteCategoryAndName(category string, name string) error
@@ -704,6 +728,10 @@ type FileInfoStore interface {
 	GetFromMaster(id string) (*model.FileInfo, error)
 	GetByIds(ids []string) ([]*model.FileInfo, error)
 	GetByPath(path string) (*model.FileInfo, error)
+	GetByContentHash(hash string, size int64) (*model.FileInfo, error)
+	// CountByPath returns the number of FileInfos referencing the given stored path.
+	CountByPath(path string) (int64, error)
+	GetBatchWithoutContentHash(startTime int64, startFileID string, limit int) ([]*model.FileInfo, error)
 	GetForPost(postID string, readFromMaster, includeDeleted, allowFromCache bool) ([]*model.FileInfo, error)
 	GetForUser(userID string) ([]*model.FileInfo, error)
 	GetWithOptions(page, perPage int, opt *model.GetFileInfosOptions) ([]*model.FileInfo, error)
@@ -714,6 +742,7 @@ type FileInfoStore interface {
 	PermanentDeleteBatch(ctx request.CTX, endTime int64, limit int64) (int64, error)
 	PermanentDeleteByUser(ctx request.CTX, userID string) (int64, error)
 	SetContent(ctx request.CTX, fileID, content string) error
+	SetContentHash(ctx request.CTX, fileID, hash string) error
 	Search(ctx request.CTX, paramsList []*model.SearchParams, userID, teamID string, page, perPage int) (*model.FileInfoList, error)
 	CountAll() (int64, error)
 	GetFilesBatchForIndexing(startTime int64, startFileID string, includeDeleted bool, limit int) ([]*model.FileForIndexing, error)
@@ -723,6 +752,67 @@ type FileInfoStore interface {
 	GetUptoNSizeFileTime(n int64) (int64, error)
 }
 
+type FileShareLinkStore interface {
+	Save(link *model.FileShareLink) (*model.FileShareLink, error)
+	Get(id string) (*model.FileShareLink, error)
+	GetForFile(fileID string) ([]*model.FileShareLink, error)
+	// IncrementDownloadCount records a download of the link. It fails with ErrNotFound when
+	// the link is revoked, expired or has reached its download limit.
+	IncrementDownloadCount(id string, now int64) error
+	Revoke(id string, deleteAt int64) error
+}
+
+type NotificationDeliveryStore interface {
+	Save(delivery *model.NotificationDelivery) (*model.NotificationDelivery, error)
+	// GetForUser returns the deliveries of the user, most recent first.
+	GetForUser(userID string, opts model.NotificationDeliveryGetOptions) ([]*model.NotificationDelivery, error)
+	// Cleanup deletes the deliveries recorded before expiryTime, in batches of batchSize.
+	Cleanup(expiryTime int64, batchSize int) error
+}
+
+type UndeliverableEmailStore interface {
+	// Save marks the email address as undeliverable, updating the reason if it already was.
+	Save(email *model.UndeliverableEmail) (*model.UndeliverableEmail, error)
+	Get(email string) (*model.UndeliverableEmail, error)
+	Delete(email string) error
+}
+
+type IntegrationDeliveryStore interface {
+	Save(delivery *model.IntegrationDelivery) (*model.IntegrationDelivery, error)
+	Get(id string) (*model.IntegrationDelivery, error)
+	// GetForHook returns the deliveries of the outgoing webhook or the slash command, most recent first.
+	GetForHook(hookID string, page, perPage int) ([]*model.IntegrationDelivery, error)
+	// PruneForHook deletes the deliveries of the outgoing webhook or the slash command but the keep most recent ones.
+	PruneForHook(hookID string, keep int) error
+	PermanentDeleteByHook(hookID string) error
+	// Cleanup deletes the deliveries recorded before expiryTime, in batches of batchSize.
+	Cleanup(expiryTime int64, batchSize int) error
+}
+
+type AutomationRuleStore interface {
+	Save(rule *model.AutomationRule) (*model.AutomationRule, error)
+	Update(rule *model.AutomationRule) (*model.AutomationRule, error)
+	Get(id string) (*model.AutomationRule, error)
+	GetForTeam(teamID string, page, perPage int) ([]*model.AutomationRule, error)
+	// GetEnabledForTrigger returns the enabled rules of the team with a trigger of the given type.
+	GetEnabledForTrigger(teamID, triggerType string) ([]*model.AutomationRule, error)
+	Delete(id string, deleteAt int64) error
+}
+
+type CustomProfileAttributeStore interface {
+	SaveField(field *model.CustomProfileAttributeField) (*model.CustomProfileAttributeField, error)
+	UpdateField(field *model.CustomProfileAttributeField) (*model.CustomProfileAttributeField, error)
+	GetField(id string) (*model.CustomProfileAttributeField, error)
+	// GetFields returns the fields that are not deleted, by sort order.
+	GetFields() ([]*model.CustomProfileAttributeField, error)
+	// DeleteField soft deletes the field and removes the values the users have for it.
+	DeleteField(id string, deleteAt int64) error
+	GetValuesForUsers(userIDs []string) ([]*model.CustomProfileAttributeValue, error)
+	UpsertValues(values []*model.CustomProfileAttributeValue) error
+	DeleteValues(userID string, fieldIDs []string) error
+	PermanentDeleteValuesByUser(userID string) error
+}
+
 type UploadSessionStore interface {
 	Save(session *model.UploadSession) (*model.UploadSession, error)
 	Update(session *model.UploadSession) error
@@ -778,6 +868,10 @@ type UserAccessTokenStore interface {
 	Search(term string) ([]*model.UserAccessToken, error)
 	UpdateTokenEnable(tokenID string) error
 	UpdateTokenDisable(tokenID string) error
+	// UpdateExpiresAt sets the expiry of the token and of the sessions created with it.
+	UpdateExpiresAt(tokenID string, expiresAt int64) error
+	// GetExpiring returns the active tokens expiring before the given time, soonest first.
+	GetExpiring(before int64, offset, limit int) ([]*model.UserAccessToken, error)
 }
 
 type PluginStore interface {
@@ -828,6 +922,7 @@ type TermsOfServiceStore interface {
 	Save(termsOfService *model.TermsOfService) (*model.TermsOfService, error)
 	GetLatest(allowFromCache bool) (*model.TermsOfService, error)
 	Get(id string, allowFromCache bool) (*model.TermsOfService, error)
+	GetAll(offset, limit int) ([]*model.TermsOfService, error)
 }
 
 type ProductNoticesStore interface {
@@ -837,10 +932,19 @@ type ProductNoticesStore interface {
 	GetViews(userID string) ([]model.ProductNoticeViewState, error)
 }
 
+type ConfigHistoryStore interface {
+	// Save stores the change with the next version number.
+	Save(history *model.ConfigHistory) (*model.ConfigHistory, error)
+	GetByVersion(version int64) (*model.ConfigHistory, error)
+	// GetAll returns the changes without their config, newest first.
+	GetAll(offset, limit int) ([]*model.ConfigHistory, error)
+}
+
 type UserTermsOfServiceStore interface {
 	GetByUser(userID string) (*model.UserTermsOfService, error)
 	Save(userTermsOfService *model.UserTermsOfService) (*model.UserTermsOfService, error)
 	Delete(userID, termsOfServiceId string) error
+	CountByTermsOfService(termsOfServiceId, audience string) (int64, error)
 }
 
 type GroupStore interface {
@@ -853,6 +957,7 @@ type GroupStore interface {
 	GetAllBySource(groupSource model.GroupSource) ([]*model.Group, error)
 	GetByUser(userID string) ([]*model.Group, error)
 	Update(group *model.Group) (*model.Group, error)
+	UpdateLastMentionAt(groupID string, mentionAt int64, cooldown int64) (bool, error)
 	Delete(groupID string) (*model.Group, error)
 	Restore(groupID string) (*model.Group, error)
 
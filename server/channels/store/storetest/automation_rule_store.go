@@ -0,0 +1,140 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+)
+
+func TestAutomationRuleStore(t *testing.T, rctx request.CTX, ss store.Store, s SqlStore) {
+	t.Run("SaveAndGet", func(t *testing.T) { testAutomationRuleSaveAndGet(t, rctx, ss) })
+	t.Run("Update", func(t *testing.T) { testAutomationRuleUpdate(t, rctx, ss) })
+	t.Run("GetForTeam", func(t *testing.T) { testAutomationRuleGetForTeam(t, rctx, ss) })
+	t.Run("GetEnabledForTrigger", func(t *testing.T) { testAutomationRuleGetEnabledForTrigger(t, rctx, ss) })
+	t.Run("Delete", func(t *testing.T) { testAutomationRuleDelete(t, rctx, ss) })
+}
+
+func newTestAutomationRule(teamID, triggerType string) *model.AutomationRule {
+	rule := &model.AutomationRule{
+		TeamId:      teamID,
+		CreatorId:   model.NewId(),
+		DisplayName: "Rule",
+		Enabled:     true,
+		Trigger: model.AutomationTrigger{
+			Type: triggerType,
+		},
+		Actions: model.AutomationActions{
+			{Type: model.AutomationActionPostMessage, Message: "Hello", ChannelId: model.NewId()},
+			{Type: model.AutomationActionCallWebhook, URL: "https://example.com/hook"},
+		},
+	}
+	if triggerType == model.AutomationTriggerKeywordPosted {
+		rule.Trigger.Keywords = []string{"help", "outage"}
+	}
+	return rule
+}
+
+func testAutomationRuleSaveAndGet(t *testing.T, rctx request.CTX, ss store.Store) {
+	_, err := ss.AutomationRule().Save(&model.AutomationRule{TeamId: model.NewId()})
+	require.Error(t, err)
+
+	saved, err := ss.AutomationRule().Save(newTestAutomationRule(model.NewId(), model.AutomationTriggerKeywordPosted))
+	require.NoError(t, err)
+	require.NotEmpty(t, saved.Id)
+
+	got, err := ss.AutomationRule().Get(saved.Id)
+	require.NoError(t, err)
+	assert.Equal(t, saved, got)
+
+	_, err = ss.AutomationRule().Get(model.NewId())
+	var nfErr *store.ErrNotFound
+	assert.True(t, errors.As(err, &nfErr))
+}
+
+func testAutomationRuleUpdate(t *testing.T, rctx request.CTX, ss store.Store) {
+	saved, err := ss.AutomationRule().Save(newTestAutomationRule(model.NewId(), model.AutomationTriggerKeywordPosted))
+	require.NoError(t, err)
+
+	saved.DisplayName = "Updated"
+	saved.Enabled = false
+	saved.Trigger = model.AutomationTrigger{Type: model.AutomationTriggerReactionAdded, EmojiName: "+1"}
+	saved.Actions = model.AutomationActions{{Type: model.AutomationActionAddToChannel, ChannelId: model.NewId()}}
+	updated, err := ss.AutomationRule().Update(saved)
+	require.NoError(t, err)
+
+	got, err := ss.AutomationRule().Get(saved.Id)
+	require.NoError(t, err)
+	assert.Equal(t, updated, got)
+
+	updated.Id = model.NewId()
+	_, err = ss.AutomationRule().Update(updated)
+	var nfErr *store.ErrNotFound
+	assert.True(t, errors.As(err, &nfErr))
+}
+
+func testAutomationRuleGetForTeam(t *testing.T, rctx request.CTX, ss store.Store) {
+	teamID := model.NewId()
+	first, err := ss.AutomationRule().Save(newTestAutomationRule(teamID, model.AutomationTriggerKeywordPosted))
+	require.NoError(t, err)
+	second, err := ss.AutomationRule().Save(newTestAutomationRule(teamID, model.AutomationTriggerUserJoinedChannel))
+	require.NoError(t, err)
+	_, err = ss.AutomationRule().Save(newTestAutomationRule(model.NewId(), model.AutomationTriggerUserJoinedChannel))
+	require.NoError(t, err)
+
+	rules, err := ss.AutomationRule().GetForTeam(teamID, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, rules, 2)
+	assert.ElementsMatch(t, []string{first.Id, second.Id}, []string{rules[0].Id, rules[1].Id})
+
+	rules, err = ss.AutomationRule().GetForTeam(teamID, 1, 1)
+	require.NoError(t, err)
+	require.Len(t, rules, 1)
+}
+
+func testAutomationRuleGetEnabledForTrigger(t *testing.T, rctx request.CTX, ss store.Store) {
+	teamID := model.NewId()
+	enabled, err := ss.AutomationRule().Save(newTestAutomationRule(teamID, model.AutomationTriggerKeywordPosted))
+	require.NoError(t, err)
+
+	disabled := newTestAutomationRule(teamID, model.AutomationTriggerKeywordPosted)
+	disabled.Enabled = false
+	_, err = ss.AutomationRule().Save(disabled)
+	require.NoError(t, err)
+
+	_, err = ss.AutomationRule().Save(newTestAutomationRule(teamID, model.AutomationTriggerReactionAdded))
+	require.NoError(t, err)
+
+	rules, err := ss.AutomationRule().GetEnabledForTrigger(teamID, model.AutomationTriggerKeywordPosted)
+	require.NoError(t, err)
+	require.Len(t, rules, 1)
+	assert.Equal(t, enabled.Id, rules[0].Id)
+	assert.Equal(t, enabled.Trigger.Keywords, rules[0].Trigger.Keywords)
+}
+
+func testAutomationRuleDelete(t *testing.T, rctx request.CTX, ss store.Store) {
+	teamID := model.NewId()
+	saved, err := ss.AutomationRule().Save(newTestAutomationRule(teamID, model.AutomationTriggerUserJoinedChannel))
+	require.NoError(t, err)
+
+	require.NoError(t, ss.AutomationRule().Delete(saved.Id, model.GetMillis()))
+
+	var nfErr *store.ErrNotFound
+	_, err = ss.AutomationRule().Get(saved.Id)
+	assert.True(t, errors.As(err, &nfErr))
+
+	rules, err := ss.AutomationRule().GetEnabledForTrigger(teamID, model.AutomationTriggerUserJoinedChannel)
+	require.NoError(t, err)
+	assert.Empty(t, rules)
+
+	err = ss.AutomationRule().Delete(saved.Id, model.GetMillis())
+	assert.True(t, errors.As(err, &nfErr))
+}
@@ -439,6 +439,36 @@ func testBotStoreUpdate(t *testing.T, rctx request.CTX, ss store.Store) {
 		require.NoError(t, err)
 		require.Equal(t, bot, actualBot)
 	})
+
+	t.Run("scope should update and clear", func(t *testing.T) {
+		existingBot, _ := makeBotWithUser(t, rctx, ss, &model.Bot{
+			Username: "existing_bot",
+			OwnerId:  model.NewId(),
+		})
+		defer func() { require.NoError(t, ss.Bot().PermanentDelete(existingBot.UserId)) }()
+		defer func() { require.NoError(t, ss.User().PermanentDelete(rctx, existingBot.UserId)) }()
+		require.Nil(t, existingBot.Scope)
+
+		bot := existingBot.Clone()
+		bot.Scope = &model.BotScope{
+			TeamIds:    []string{model.NewId()},
+			ChannelIds: []string{model.NewId(), model.NewId()},
+		}
+		_, err := ss.Bot().Update(bot)
+		require.NoError(t, err)
+
+		actualBot, err := ss.Bot().Get(bot.UserId, false)
+		require.NoError(t, err)
+		require.Equal(t, bot.Scope, actualBot.Scope)
+
+		actualBot.Scope = nil
+		_, err = ss.Bot().Update(actualBot)
+		require.NoError(t, err)
+
+		actualBot, err = ss.Bot().Get(bot.UserId, false)
+		require.NoError(t, err)
+		require.Nil(t, actualBot.Scope)
+	})
 }
 
 func testBotStorePermanentDelete(t *testing.T, rctx request.CTX, ss store.Store) {
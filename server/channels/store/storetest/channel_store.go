@@ -97,6 +97,7 @@ func TestChannelStore(t *testing.T, rctx request.CTX, ss store.Store, s SqlStore
 	t.Run("ChannelDeleteMemberStore", func(t *testing.T) { testChannelDeleteMemberStore(t, rctx, ss) })
 	t.Run("GetChannels", func(t *testing.T) { testChannelStoreGetChannels(t, rctx, ss) })
 	t.Run("GetChannelsByUser", func(t *testing.T) { testChannelStoreGetChannelsByUser(t, rctx, ss) })
+	t.Run("GetEtagForUserChannels", func(t *testing.T) { testChannelStoreGetEtagForUserChannels(t, rctx, ss) })
 	t.Run("GetAllChannels", func(t *testing.T) { testChannelStoreGetAllChannels(t, rctx, ss, s) })
 	t.Run("GetMoreChannels", func(t *testing.T) { testChannelStoreGetMoreChannels(t, rctx, ss) })
 	t.Run("GetPrivateChannelsForTeam", func(t *testing.T) { testChannelStoreGetPrivateChannelsForTeam(t, rctx, ss) })
@@ -123,6 +124,7 @@ func TestChannelStore(t *testing.T, rctx request.CTX, ss store.Store, s SqlStore
 	t.Run("SearchForUserInTeam", func(t *testing.T) { testChannelStoreSearchForUserInTeam(t, rctx, ss) })
 	t.Run("SearchAllChannels", func(t *testing.T) { testChannelStoreSearchAllChannels(t, rctx, ss) })
 	t.Run("GetMembersByIds", func(t *testing.T) { testChannelStoreGetMembersByIds(t, rctx, ss) })
+	t.Run("GetMembersAfter", func(t *testing.T) { testChannelStoreGetMembersAfter(t, rctx, ss) })
 	t.Run("GetMembersByChannelIds", func(t *testing.T) { testChannelStoreGetMembersByChannelIds(t, rctx, ss) })
 	t.Run("GetMembersInfoByChannelIds", func(t *testing.T) { testChannelStoreGetMembersInfoByChannelIds(t, rctx, ss) })
 	t.Run("SearchGroupChannels", func(t *testing.T) { testChannelStoreSearchGroupChannels(t, rctx, ss) })
@@ -3739,6 +3741,62 @@ func testChannelStoreGetChannels(t *testing.T, rctx request.CTX, ss store.Store)
 	ss.Channel().InvalidateAllChannelMembersForUser(m1.UserId)
 }
 
+func testChannelStoreGetEtagForUserChannels(t *testing.T, rctx request.CTX, ss store.Store) {
+	teamId := model.NewId()
+	userId := model.NewId()
+
+	saveChannel := func(teamId string) *model.Channel {
+		channel, err := ss.Channel().Save(rctx, &model.Channel{
+			TeamId:      teamId,
+			DisplayName: "Channel",
+			Name:        NewTestId(),
+			Type:        model.ChannelTypeOpen,
+		}, -1)
+		require.NoError(t, err)
+		return channel
+	}
+	saveMember := func(channelId string) {
+		_, err := ss.Channel().SaveMember(rctx, &model.ChannelMember{
+			ChannelId:   channelId,
+			UserId:      userId,
+			NotifyProps: model.GetDefaultChannelNotifyProps(),
+		})
+		require.NoError(t, err)
+	}
+
+	c1 := saveChannel(teamId)
+	saveMember(c1.Id)
+
+	etag := ss.Channel().GetEtagForUserChannels(userId, teamId)
+	require.Equal(t, etag, ss.Channel().GetEtagForUserChannels(userId, teamId))
+
+	t.Run("joining a channel of another team only changes the etag of all the teams", func(t *testing.T) {
+		allEtag := ss.Channel().GetEtagForUserChannels(userId, "")
+
+		c2 := saveChannel(model.NewId())
+		saveMember(c2.Id)
+
+		require.Equal(t, etag, ss.Channel().GetEtagForUserChannels(userId, teamId))
+		require.NotEqual(t, allEtag, ss.Channel().GetEtagForUserChannels(userId, ""))
+	})
+
+	t.Run("updating a channel changes the etag", func(t *testing.T) {
+		time.Sleep(time.Millisecond)
+		c1.DisplayName = "Renamed"
+		_, err := ss.Channel().Update(rctx, c1)
+		require.NoError(t, err)
+
+		updatedEtag := ss.Channel().GetEtagForUserChannels(userId, teamId)
+		require.NotEqual(t, etag, updatedEtag)
+		etag = updatedEtag
+	})
+
+	t.Run("leaving a channel changes the etag", func(t *testing.T) {
+		require.NoError(t, ss.Channel().RemoveMember(rctx, c1.Id, userId))
+		require.NotEqual(t, etag, ss.Channel().GetEtagForUserChannels(userId, teamId))
+	})
+}
+
 func testChannelStoreGetChannelsByUser(t *testing.T, rctx request.CTX, ss store.Store) {
 	team := model.NewId()
 	team2 := model.NewId()
@@ -6587,6 +6645,91 @@ func testChannelStoreGetMembersByIds(t *testing.T, rctx request.CTX, ss store.St
 	require.Len(t, members, 0)
 }
 
+func testChannelStoreGetMembersAfter(t *testing.T, rctx request.CTX, ss store.Store) {
+	channel, nErr := ss.Channel().Save(rctx, &model.Channel{
+		TeamId:      model.NewId(),
+		DisplayName: "ChannelA",
+		Name:        NewTestId(),
+		Type:        model.ChannelTypeOpen,
+	}, -1)
+	require.NoError(t, nErr)
+
+	prefix := "ma" + strings.ToLower(model.NewId()[:8])
+	var userIDs []string
+	for i := 0; i < 5; i++ {
+		u := &model.User{
+			Email:    MakeEmail(),
+			Username: fmt.Sprintf("%s%d", prefix, i),
+		}
+		if i == 4 {
+			u.Username = "other" + model.NewId()
+			u.FirstName = prefix + "first"
+		}
+		_, err := ss.User().Save(rctx, u)
+		require.NoError(t, err)
+
+		member := &model.ChannelMember{
+			ChannelId:   channel.Id,
+			UserId:      u.Id,
+			NotifyProps: model.GetDefaultChannelNotifyProps(),
+			SchemeUser:  true,
+			SchemeAdmin: i == 0,
+		}
+		_, err = ss.Channel().SaveMember(rctx, member)
+		require.NoError(t, err)
+		userIDs = append(userIDs, u.Id)
+	}
+	sort.Strings(userIDs)
+
+	t.Run("pages in user id order", func(t *testing.T) {
+		var got []string
+		after := ""
+		for {
+			members, err := ss.Channel().GetMembersAfter(channel.Id, &model.ChannelMembersGetOptions{AfterUserId: after, Limit: 2})
+			require.NoError(t, err)
+			if len(members) == 0 {
+				break
+			}
+			require.LessOrEqual(t, len(members), 2)
+			for _, m := range members {
+				got = append(got, m.UserId)
+			}
+			after = members[len(members)-1].UserId
+		}
+		require.Equal(t, userIDs, got)
+	})
+
+	t.Run("filters by term", func(t *testing.T) {
+		members, err := ss.Channel().GetMembersAfter(channel.Id, &model.ChannelMembersGetOptions{Term: prefix, Limit: 100})
+		require.NoError(t, err)
+		require.Len(t, members, 4)
+	})
+
+	t.Run("filters by full name only if allowed", func(t *testing.T) {
+		members, err := ss.Channel().GetMembersAfter(channel.Id, &model.ChannelMembersGetOptions{Term: prefix + "first", Limit: 100})
+		require.NoError(t, err)
+		require.Empty(t, members)
+
+		members, err = ss.Channel().GetMembersAfter(channel.Id, &model.ChannelMembersGetOptions{Term: prefix + "first", AllowFullNames: true, Limit: 100})
+		require.NoError(t, err)
+		require.Len(t, members, 1)
+	})
+
+	t.Run("filters by role", func(t *testing.T) {
+		members, err := ss.Channel().GetMembersAfter(channel.Id, &model.ChannelMembersGetOptions{Role: model.ChannelAdminRoleId, Limit: 100})
+		require.NoError(t, err)
+		require.Len(t, members, 1)
+
+		members, err = ss.Channel().GetMembersAfter(channel.Id, &model.ChannelMembersGetOptions{Role: model.ChannelUserRoleId, Limit: 100})
+		require.NoError(t, err)
+		require.Len(t, members, 4)
+
+		members, err = ss.Channel().GetMembersAfter(channel.Id, &model.ChannelMembersGetOptions{Role: model.ChannelGuestRoleId, Limit: 100})
+		require.NoError(t, err)
+		require.Empty(t, members)
+	})
+}
+
 func testChannelStoreGetMembersByChannelIds(t *testing.T, rctx request.CTX, ss store.Store) {
 	userId := model.NewId()
 
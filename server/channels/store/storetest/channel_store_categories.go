@@ -26,6 +26,7 @@ func TestChannelStoreCategories(t *testing.T, rctx request.CTX, ss store.Store,
 	t.Run("ClearSidebarOnTeamLeave", func(t *testing.T) { testClearSidebarOnTeamLeave(t, rctx, ss, s) })
 	t.Run("DeleteSidebarCategory", func(t *testing.T) { testDeleteSidebarCategory(t, rctx, ss, s) })
 	t.Run("UpdateSidebarChannelsByPreferences", func(t *testing.T) { testUpdateSidebarChannelsByPreferences(t, rctx, ss) })
+	t.Run("GetSidebarCategoryUnreads", func(t *testing.T) { testGetSidebarCategoryUnreads(t, rctx, ss) })
 	t.Run("SidebarCategoryDeadlock", func(t *testing.T) { testSidebarCategoryDeadlock(t, rctx, ss) })
 }
 
@@ -2413,3 +2414,104 @@ func testSidebarCategoryDeadlock(t *testing.T, rctx request.CTX, ss store.Store)
 
 	wg.Wait()
 }
+
+func testGetSidebarCategoryUnreads(t *testing.T, rctx request.CTX, ss store.Store) {
+	userId := model.NewId()
+	team := setupTeam(t, rctx, ss, userId)
+
+	res, err := ss.Channel().CreateInitialSidebarCategories(rctx, userId, &store.SidebarCategorySearchOpts{TeamID: team.Id})
+	require.NoError(t, err)
+	require.NotEmpty(t, res)
+
+	categories, err := ss.Channel().GetSidebarCategoriesForTeamForUser(userId, team.Id)
+	require.NoError(t, err)
+	channelsCategory := categories.Categories[1]
+	require.Equal(t, model.SidebarCategoryChannels, channelsCategory.Type)
+
+	// A channel with no sidebar entry, which belongs to the Channels category
+	channel1, err := ss.Channel().Save(rctx, &model.Channel{
+		Name:              "channel1",
+		DisplayName:       "Channel 1",
+		TeamId:            team.Id,
+		Type:              model.ChannelTypeOpen,
+		TotalMsgCount:     5,
+		TotalMsgCountRoot: 3,
+	}, 10)
+	require.NoError(t, err)
+	_, err = ss.Channel().SaveMember(rctx, &model.ChannelMember{
+		UserId:       userId,
+		ChannelId:    channel1.Id,
+		MsgCount:     2,
+		MentionCount: 1,
+		NotifyProps:  model.GetDefaultChannelNotifyProps(),
+	})
+	require.NoError(t, err)
+
+	// A muted channel in a custom category, which only counts its mentions
+	channel2, err := ss.Channel().Save(rctx, &model.Channel{
+		Name:          "channel2",
+		DisplayName:   "Channel 2",
+		TeamId:        team.Id,
+		Type:          model.ChannelTypePrivate,
+		TotalMsgCount: 4,
+	}, 10)
+	require.NoError(t, err)
+	notifyProps := model.GetDefaultChannelNotifyProps()
+	notifyProps[model.MarkUnreadNotifyProp] = model.ChannelMarkUnreadMention
+	_, err = ss.Channel().SaveMember(rctx, &model.ChannelMember{
+		UserId:             userId,
+		ChannelId:          channel2.Id,
+		MentionCount:       2,
+		MentionCountRoot:   1,
+		UrgentMentionCount: 1,
+		NotifyProps:        notifyProps,
+	})
+	require.NoError(t, err)
+
+	customCategory, err := ss.Channel().CreateSidebarCategory(userId, team.Id, &model.SidebarCategoryWithChannels{
+		SidebarCategory: model.SidebarCategory{
+			UserId:      userId,
+			TeamId:      team.Id,
+			DisplayName: "Custom",
+		},
+		Channels: []string{channel2.Id},
+	})
+	require.NoError(t, err)
+
+	unreads, err := ss.Channel().GetSidebarCategoryUnreads(userId)
+	require.NoError(t, err)
+
+	byCategory := map[string]*model.SidebarCategoryUnread{}
+	for _, unread := range unreads {
+		assert.Equal(t, team.Id, unread.TeamId)
+		byCategory[unread.CategoryId] = unread
+	}
+
+	require.Contains(t, byCategory, channelsCategory.Id)
+	assert.Equal(t, &model.SidebarCategoryUnread{
+		CategoryId:   channelsCategory.Id,
+		TeamId:       team.Id,
+		MsgCount:     3,
+		MsgCountRoot: 3,
+		MentionCount: 1,
+	}, byCategory[channelsCategory.Id])
+
+	require.Contains(t, byCategory, customCategory.Id)
+	assert.Equal(t, &model.SidebarCategoryUnread{
+		CategoryId:         customCategory.Id,
+		TeamId:             team.Id,
+		MentionCount:       2,
+		MentionCountRoot:   1,
+		UrgentMentionCount: 1,
+	}, byCategory[customCategory.Id])
+
+	t.Run("archived channels aren't counted", func(t *testing.T) {
+		require.NoError(t, ss.Channel().Delete(channel1.Id, model.GetMillis()))
+
+		unreads, err := ss.Channel().GetSidebarCategoryUnreads(userId)
+		require.NoError(t, err)
+		for _, unread := range unreads {
+			assert.NotEqual(t, channelsCategory.Id, unread.CategoryId)
+		}
+	})
+}
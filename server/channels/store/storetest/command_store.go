@@ -202,10 +202,18 @@ func testCommandStoreUpdate(t *testing.T, rctx request.CTX, ss store.Store) {
 	require.NoError(t, nErr)
 
 	o1.Token = model.NewId()
+	o1.SigningSecret = model.NewIntegrationSigningSecret()
+	o1.AutocompleteData = model.NewAutocompleteData("trigger", "[project]", "Pick a project")
+	o1.AutocompleteData.AddDynamicListArgument("Project", "https://nowhere.com/projects", true)
 
 	_, nErr = ss.Command().Update(o1)
 	require.NoError(t, nErr)
 
+	o2, nErr := ss.Command().Get(o1.Id)
+	require.NoError(t, nErr)
+	require.Equal(t, o1.SigningSecret, o2.SigningSecret)
+	require.True(t, o1.AutocompleteData.Equals(o2.AutocompleteData))
+
 	o1.URL = "junk"
 
 	_, err := ss.Command().Update(o1)
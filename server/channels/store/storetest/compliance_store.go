@@ -50,6 +50,8 @@ func TestComplianceStore(t *testing.T, rctx request.CTX, ss store.Store) {
 	t.Run("", func(t *testing.T) { testComplianceStore(t, rctx, ss) })
 	t.Run("ComplianceExport", func(t *testing.T) { testComplianceExport(t, rctx, ss) })
 	t.Run("ComplianceExportDirectMessages", func(t *testing.T) { testComplianceExportDirectMessages(t, rctx, ss) })
+	t.Run("ComplianceExportIncremental", func(t *testing.T) { testComplianceExportIncremental(t, rctx, ss) })
+	t.Run("GetLastSuccessfulIncremental", func(t *testing.T) { testComplianceGetLastSuccessfulIncremental(t, rctx, ss) })
 	t.Run("MessageExportPublicChannel", func(t *testing.T) { testMessageExportPublicChannel(t, rctx, ss) })
 	t.Run("MessageExportPrivateChannel", func(t *testing.T) { testMessageExportPrivateChannel(t, rctx, ss) })
 	t.Run("MessageExportDirectMessageChannel", func(t *testing.T) { testMessageExportDirectMessageChannel(t, rctx, ss) })
@@ -229,6 +231,141 @@ func testComplianceExport(t *testing.T, rctx request.CTX, ss store.Store) {
 	})
 }
 
+func testComplianceExportIncremental(t *testing.T, rctx request.CTX, ss store.Store) {
+	t1, err := ss.Team().Save(&model.Team{
+		DisplayName: "DisplayName",
+		Name:        NewTestId(),
+		Email:       MakeEmail(),
+		Type:        model.TeamOpen,
+	})
+	require.NoError(t, err)
+
+	u1, err := ss.User().Save(rctx, &model.User{Email: MakeEmail(), Username: model.NewId()})
+	require.NoError(t, err)
+	u2, err := ss.User().Save(rctx, &model.User{Email: MakeEmail(), Username: model.NewId()})
+	require.NoError(t, err)
+
+	c1, nErr := ss.Channel().Save(rctx, &model.Channel{
+		TeamId:      t1.Id,
+		DisplayName: "Channel1",
+		Name:        NewTestId(),
+		Type:        model.ChannelTypeOpen,
+	}, -1)
+	require.NoError(t, nErr)
+
+	cDM, nErr := ss.Channel().CreateDirectChannel(rctx, u1, u2)
+	require.NoError(t, nErr)
+
+	createAt := model.GetMillis() - 10000
+
+	// o1 was created first but is edited last, so it is exported after the others.
+	o1, nErr := ss.Post().Save(rctx, &model.Post{ChannelId: c1.Id, UserId: u1.Id, CreateAt: createAt, Message: NewTestId()})
+	require.NoError(t, nErr)
+	o2, nErr := ss.Post().Save(rctx, &model.Post{ChannelId: cDM.Id, UserId: u2.Id, CreateAt: createAt + 10, Message: NewTestId()})
+	require.NoError(t, nErr)
+	o3, nErr := ss.Post().Save(rctx, &model.Post{ChannelId: c1.Id, UserId: u2.Id, CreateAt: createAt + 20, Message: NewTestId()})
+	require.NoError(t, nErr)
+
+	editAt := model.GetMillis()
+	o1Edited := o1.Clone()
+	o1Edited.Message = NewTestId()
+	o1Edited.EditAt = editAt
+	o1Edited, nErr = ss.Post().Update(rctx, o1Edited, o1.Clone())
+	require.NoError(t, nErr)
+
+	// Other tests create posts too, so restrict the export to the users of this test.
+	emails := u1.Email + ", " + u2.Email
+	job := &model.Compliance{Desc: "test" + model.NewId(), StartAt: createAt, EndAt: o1Edited.UpdateAt + 1, Emails: emails}
+
+	t.Run("ordered by update time", func(t *testing.T) {
+		cposts, _, err := ss.Compliance().ComplianceExportIncremental(job, model.ComplianceIncrementalExportCursor{}, 100)
+		require.NoError(t, err)
+
+		// The edited post and the copy of its previous version are exported last
+		require.Len(t, cposts, 4)
+		assert.Equal(t, o2.Id, cposts[0].PostId)
+		assert.Equal(t, o3.Id, cposts[1].PostId)
+		assert.Contains(t, []string{cposts[2].PostId, cposts[3].PostId}, o1.Id)
+
+		for _, cpost := range cposts {
+			if cpost.PostId == o2.Id {
+				assert.Equal(t, "direct-messages", cpost.TeamName)
+			} else {
+				assert.Equal(t, t1.Name, cpost.TeamName)
+			}
+		}
+	})
+
+	t.Run("multiple batches", func(t *testing.T) {
+		all, _, err := ss.Compliance().ComplianceExportIncremental(job, model.ComplianceIncrementalExportCursor{}, 100)
+		require.NoError(t, err)
+
+		var streamed []*model.CompliancePost
+		cursor := model.ComplianceIncrementalExportCursor{}
+		for {
+			cposts, nextCursor, err := ss.Compliance().ComplianceExportIncremental(job, cursor, 1)
+			require.NoError(t, err)
+			if len(cposts) == 0 {
+				break
+			}
+			streamed = append(streamed, cposts...)
+			cursor = nextCursor
+		}
+		require.Equal(t, all, streamed)
+	})
+
+	t.Run("filtered by email", func(t *testing.T) {
+		emailJob := &model.Compliance{Desc: "test" + model.NewId(), StartAt: createAt, EndAt: o1Edited.UpdateAt + 1, Emails: u1.Email}
+		cposts, _, err := ss.Compliance().ComplianceExportIncremental(emailJob, model.ComplianceIncrementalExportCursor{}, 100)
+		require.NoError(t, err)
+		require.Len(t, cposts, 2)
+		for _, cpost := range cposts {
+			assert.Equal(t, u1.Email, cpost.UserEmail)
+		}
+	})
+
+	t.Run("since a previous export", func(t *testing.T) {
+		sinceJob := &model.Compliance{Desc: "test" + model.NewId(), StartAt: createAt + 21, EndAt: o1Edited.UpdateAt + 1, Emails: emails}
+		cposts, _, err := ss.Compliance().ComplianceExportIncremental(sinceJob, model.ComplianceIncrementalExportCursor{}, 100)
+		require.NoError(t, err)
+		require.Len(t, cposts, 2)
+		for _, cpost := range cposts {
+			assert.True(t, cpost.PostId == o1.Id || cpost.PostOriginalId == o1.Id)
+		}
+	})
+}
+
+func testComplianceGetLastSuccessfulIncremental(t *testing.T, rctx request.CTX, ss store.Store) {
+	now := model.GetMillis()
+
+	finished := &model.Compliance{Desc: "test", UserId: model.NewId(), Status: model.ComplianceStatusFinished, StartAt: now - 2000, EndAt: now - 1000, Type: model.ComplianceTypeAdhoc, Incremental: true}
+	_, err := ss.Compliance().Save(finished)
+	require.NoError(t, err)
+
+	failed := &model.Compliance{Desc: "test", UserId: model.NewId(), Status: model.ComplianceStatusFailed, StartAt: now - 1000, EndAt: now, Type: model.ComplianceTypeAdhoc, Incremental: true}
+	_, err = ss.Compliance().Save(failed)
+	require.NoError(t, err)
+
+	notIncremental := &model.Compliance{Desc: "test", UserId: model.NewId(), Status: model.ComplianceStatusFinished, StartAt: now - 1000, EndAt: now, Type: model.ComplianceTypeAdhoc}
+	_, err = ss.Compliance().Save(notIncremental)
+	require.NoError(t, err)
+
+	last, err := ss.Compliance().GetLastSuccessfulIncremental()
+	require.NoError(t, err)
+	assert.Equal(t, finished.Id, last.Id)
+	assert.True(t, last.Incremental)
+
+	t.Run("checkpoint is saved", func(t *testing.T) {
+		failed.SetCursor(model.ComplianceIncrementalExportCursor{LastPostUpdateAt: now - 500, LastPostId: model.NewId()})
+		_, err := ss.Compliance().Update(failed)
+		require.NoError(t, err)
+
+		got, err := ss.Compliance().Get(failed.Id)
+		require.NoError(t, err)
+		assert.Equal(t, failed.Cursor(), got.Cursor())
+	})
+}
+
 func testComplianceExportDirectMessages(t *testing.T, rctx request.CTX, ss store.Store) {
 	defer cleanupStoreState(t, rctx, ss)
 
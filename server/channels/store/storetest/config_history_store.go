@@ -0,0 +1,59 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+)
+
+func TestConfigHistoryStore(t *testing.T, rctx request.CTX, ss store.Store) {
+	t.Run("Save", func(t *testing.T) { testConfigHistorySave(t, rctx, ss) })
+}
+
+func testConfigHistorySave(t *testing.T, rctx request.CTX, ss store.Store) {
+	userID := model.NewId()
+
+	first, err := ss.ConfigHistory().Save(&model.ConfigHistory{UserId: userID, Diff: `[{"path":"ServiceSettings.SiteURL"}]`, Config: `{"first":true}`})
+	require.NoError(t, err)
+	second, err := ss.ConfigHistory().Save(&model.ConfigHistory{Diff: "[]", Config: `{"second":true}`})
+	require.NoError(t, err)
+	assert.Equal(t, first.Version+1, second.Version)
+
+	_, err = ss.ConfigHistory().Save(&model.ConfigHistory{UserId: "junk", Config: "{}"})
+	require.Error(t, err)
+
+	t.Run("get by version", func(t *testing.T) {
+		history, err := ss.ConfigHistory().GetByVersion(first.Version)
+		require.NoError(t, err)
+		assert.Equal(t, first.Id, history.Id)
+		assert.Equal(t, userID, history.UserId)
+		assert.Equal(t, `{"first":true}`, history.Config)
+
+		_, err = ss.ConfigHistory().GetByVersion(second.Version + 1)
+		var nfErr *store.ErrNotFound
+		require.True(t, errors.As(err, &nfErr))
+	})
+
+	t.Run("get all", func(t *testing.T) {
+		history, err := ss.ConfigHistory().GetAll(0, 1)
+		require.NoError(t, err)
+		require.Len(t, history, 1)
+		assert.Equal(t, second.Id, history[0].Id)
+		assert.Empty(t, history[0].Config)
+
+		history, err = ss.ConfigHistory().GetAll(1, 10)
+		require.NoError(t, err)
+		require.NotEmpty(t, history)
+		assert.Equal(t, first.Id, history[0].Id)
+		assert.Equal(t, `[{"path":"ServiceSettings.SiteURL"}]`, history[0].Diff)
+	})
+}
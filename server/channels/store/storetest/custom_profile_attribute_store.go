@@ -0,0 +1,179 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+)
+
+func TestCustomProfileAttributeStore(t *testing.T, rctx request.CTX, ss store.Store, s SqlStore) {
+	t.Run("SaveAndGetField", func(t *testing.T) { testCustomProfileAttributeSaveAndGetField(t, rctx, ss) })
+	t.Run("UpdateField", func(t *testing.T) { testCustomProfileAttributeUpdateField(t, rctx, ss) })
+	t.Run("GetFields", func(t *testing.T) { testCustomProfileAttributeGetFields(t, rctx, ss) })
+	t.Run("DeleteField", func(t *testing.T) { testCustomProfileAttributeDeleteField(t, rctx, ss) })
+	t.Run("Values", func(t *testing.T) { testCustomProfileAttributeValues(t, rctx, ss) })
+}
+
+func testCustomProfileAttributeSaveAndGetField(t *testing.T, rctx request.CTX, ss store.Store) {
+	_, err := ss.CustomProfileAttribute().SaveField(&model.CustomProfileAttributeField{Name: "Invalid", Type: "unknown"})
+	require.Error(t, err)
+
+	saved, err := ss.CustomProfileAttribute().SaveField(&model.CustomProfileAttributeField{
+		Name:    "Location",
+		Type:    model.CustomProfileAttributeTypeSelect,
+		Options: model.StringArray{"Berlin", "Toronto"},
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, saved.Id)
+	assert.Equal(t, model.CustomProfileAttributeVisibilityAlways, saved.Visibility)
+
+	got, err := ss.CustomProfileAttribute().GetField(saved.Id)
+	require.NoError(t, err)
+	assert.Equal(t, saved, got)
+
+	_, err = ss.CustomProfileAttribute().GetField(model.NewId())
+	var nfErr *store.ErrNotFound
+	assert.True(t, errors.As(err, &nfErr))
+}
+
+func testCustomProfileAttributeUpdateField(t *testing.T, rctx request.CTX, ss store.Store) {
+	saved, err := ss.CustomProfileAttribute().SaveField(&model.CustomProfileAttributeField{Name: "Cost center", Type: model.CustomProfileAttributeTypeText})
+	require.NoError(t, err)
+
+	saved.Name = "Cost centre"
+	saved.ValidationRegex = "^[0-9]{4}$"
+	saved.Visibility = model.CustomProfileAttributeVisibilityHidden
+	saved.LdapAttribute = "departmentNumber"
+	saved.SortOrder = 3
+	updated, err := ss.CustomProfileAttribute().UpdateField(saved)
+	require.NoError(t, err)
+
+	got, err := ss.CustomProfileAttribute().GetField(saved.Id)
+	require.NoError(t, err)
+	assert.Equal(t, updated, got)
+
+	updated.Id = model.NewId()
+	_, err = ss.CustomProfileAttribute().UpdateField(updated)
+	var nfErr *store.ErrNotFound
+	assert.True(t, errors.As(err, &nfErr))
+}
+
+func testCustomProfileAttributeGetFields(t *testing.T, rctx request.CTX, ss store.Store) {
+	second, err := ss.CustomProfileAttribute().SaveField(&model.CustomProfileAttributeField{Name: "B", Type: model.CustomProfileAttributeTypeText, SortOrder: 1})
+	require.NoError(t, err)
+	first, err := ss.CustomProfileAttribute().SaveField(&model.CustomProfileAttributeField{Name: "A", Type: model.CustomProfileAttributeTypeURL, SortOrder: 1})
+	require.NoError(t, err)
+	deleted, err := ss.CustomProfileAttribute().SaveField(&model.CustomProfileAttributeField{Name: "C", Type: model.CustomProfileAttributeTypeText})
+	require.NoError(t, err)
+	require.NoError(t, ss.CustomProfileAttribute().DeleteField(deleted.Id, model.GetMillis()))
+
+	fields, err := ss.CustomProfileAttribute().GetFields()
+	require.NoError(t, err)
+
+	firstIndex, secondIndex := -1, -1
+	for i, field := range fields {
+		switch field.Id {
+		case first.Id:
+			firstIndex = i
+		case second.Id:
+			secondIndex = i
+		case deleted.Id:
+			require.Fail(t, "deleted field should not be returned")
+		}
+	}
+	require.NotEqual(t, -1, firstIndex)
+	require.NotEqual(t, -1, secondIndex)
+	assert.Less(t, firstIndex, secondIndex)
+}
+
+func testCustomProfileAttributeDeleteField(t *testing.T, rctx request.CTX, ss store.Store) {
+	field, err := ss.CustomProfileAttribute().SaveField(&model.CustomProfileAttributeField{Name: "Team", Type: model.CustomProfileAttributeTypeText})
+	require.NoError(t, err)
+
+	userID := model.NewId()
+	err = ss.CustomProfileAttribute().UpsertValues([]*model.CustomProfileAttributeValue{
+		{FieldId: field.Id, UserId: userID, Value: "Platform", UpdateAt: model.GetMillis()},
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, ss.CustomProfileAttribute().DeleteField(field.Id, model.GetMillis()))
+
+	_, err = ss.CustomProfileAttribute().GetField(field.Id)
+	var nfErr *store.ErrNotFound
+	assert.True(t, errors.As(err, &nfErr))
+
+	values, err := ss.CustomProfileAttribute().GetValuesForUsers([]string{userID})
+	require.NoError(t, err)
+	assert.Empty(t, values)
+
+	err = ss.CustomProfileAttribute().DeleteField(field.Id, model.GetMillis())
+	assert.True(t, errors.As(err, &nfErr))
+}
+
+func testCustomProfileAttributeValues(t *testing.T, rctx request.CTX, ss store.Store) {
+	fieldID1 := model.NewId()
+	fieldID2 := model.NewId()
+	userID1 := model.NewId()
+	userID2 := model.NewId()
+
+	values, err := ss.CustomProfileAttribute().GetValuesForUsers([]string{})
+	require.NoError(t, err)
+	assert.Empty(t, values)
+
+	err = ss.CustomProfileAttribute().UpsertValues([]*model.CustomProfileAttributeValue{
+		{FieldId: fieldID1, UserId: userID1, Value: "one", UpdateAt: 1},
+		{FieldId: fieldID2, UserId: userID1, Value: "two", UpdateAt: 1},
+		{FieldId: fieldID1, UserId: userID2, Value: "three", UpdateAt: 1},
+	})
+	require.NoError(t, err)
+
+	t.Run("upsert updates the existing value", func(t *testing.T) {
+		err = ss.CustomProfileAttribute().UpsertValues([]*model.CustomProfileAttributeValue{
+			{FieldId: fieldID1, UserId: userID1, Value: "updated", UpdateAt: 2},
+		})
+		require.NoError(t, err)
+
+		values, err = ss.CustomProfileAttribute().GetValuesForUsers([]string{userID1})
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []*model.CustomProfileAttributeValue{
+			{FieldId: fieldID1, UserId: userID1, Value: "updated", UpdateAt: 2},
+			{FieldId: fieldID2, UserId: userID1, Value: "two", UpdateAt: 1},
+		}, values)
+	})
+
+	t.Run("get values of several users", func(t *testing.T) {
+		values, err = ss.CustomProfileAttribute().GetValuesForUsers([]string{userID1, userID2})
+		require.NoError(t, err)
+		assert.Len(t, values, 3)
+	})
+
+	t.Run("delete values", func(t *testing.T) {
+		require.NoError(t, ss.CustomProfileAttribute().DeleteValues(userID1, []string{fieldID1}))
+
+		values, err = ss.CustomProfileAttribute().GetValuesForUsers([]string{userID1, userID2})
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []*model.CustomProfileAttributeValue{
+			{FieldId: fieldID2, UserId: userID1, Value: "two", UpdateAt: 1},
+			{FieldId: fieldID1, UserId: userID2, Value: "three", UpdateAt: 1},
+		}, values)
+	})
+
+	t.Run("permanently delete the values of a user", func(t *testing.T) {
+		require.NoError(t, ss.CustomProfileAttribute().PermanentDeleteValuesByUser(userID1))
+
+		values, err = ss.CustomProfileAttribute().GetValuesForUsers([]string{userID1, userID2})
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []*model.CustomProfileAttributeValue{
+			{FieldId: fieldID1, UserId: userID2, Value: "three", UpdateAt: 1},
+		}, values)
+	})
+}
@@ -6,6 +6,7 @@ package storetest
 import (
 	"fmt"
 	"sort"
+	"strings"
 	"testing"
 	"time"
 
@@ -37,6 +38,7 @@ func TestFileInfoStore(t *testing.T, rctx request.CTX, ss store.Store, s SqlStor
 	t.Run("CountAll", func(t *testing.T) { testFileInfoStoreCountAll(t, rctx, ss) })
 	t.Run("GetStorageUsage", func(t *testing.T) { testFileInfoGetStorageUsage(t, rctx, ss) })
 	t.Run("GetUptoNSizeFileTime", func(t *testing.T) { testGetUptoNSizeFileTime(t, rctx, ss, s) })
+	t.Run("FileInfoContentHash", func(t *testing.T) { testFileInfoContentHash(t, rctx, ss) })
 }
 
 func testFileInfoSaveGet(t *testing.T, rctx request.CTX, ss store.Store) {
@@ -941,3 +943,81 @@ func testGetUptoNSizeFileTime(t *testing.T, rctx request.CTX, ss store.Store, s
 	require.NoError(t, err)
 	assert.Equal(t, f2.CreateAt, createAt)
 }
+
+func testFileInfoContentHash(t *testing.T, rctx request.CTX, ss store.Store) {
+	hash := strings.Repeat("a", model.FileInfoContentHashLength)
+	path := fmt.Sprintf("%v/file.txt", model.NewId())
+
+	original, err := ss.FileInfo().Save(rctx, &model.FileInfo{
+		CreatorId:   model.NewId(),
+		Path:        path,
+		Size:        10,
+		ContentHash: hash,
+	})
+	require.NoError(t, err)
+	defer ss.FileInfo().PermanentDelete(rctx, original.Id)
+
+	unhashed, err := ss.FileInfo().Save(rctx, &model.FileInfo{
+		CreatorId: model.NewId(),
+		Path:      fmt.Sprintf("%v/other.txt", model.NewId()),
+		Size:      10,
+	})
+	require.NoError(t, err)
+	defer ss.FileInfo().PermanentDelete(rctx, unhashed.Id)
+
+	t.Run("get by content hash", func(t *testing.T) {
+		info, err := ss.FileInfo().GetByContentHash(hash, 10)
+		require.NoError(t, err)
+		assert.Equal(t, original.Id, info.Id)
+		assert.Equal(t, hash, info.ContentHash)
+
+		_, err = ss.FileInfo().GetByContentHash(hash, 11)
+		var nfErr *store.ErrNotFound
+		require.ErrorAs(t, err, &nfErr)
+	})
+
+	t.Run("count by path", func(t *testing.T) {
+		duplicate, err := ss.FileInfo().Save(rctx, &model.FileInfo{
+			CreatorId:   model.NewId(),
+			Path:        path,
+			Size:        10,
+			ContentHash: hash,
+		})
+		require.NoError(t, err)
+
+		count, err := ss.FileInfo().CountByPath(path)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), count)
+
+		require.NoError(t, ss.FileInfo().PermanentDelete(rctx, duplicate.Id))
+
+		count, err = ss.FileInfo().CountByPath(path)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), count)
+	})
+
+	t.Run("backfill batch", func(t *testing.T) {
+		ids := func(infos []*model.FileInfo) []string {
+			result := make([]string, 0, len(infos))
+			for _, info := range infos {
+				result = append(result, info.Id)
+			}
+			return result
+		}
+
+		infos, err := ss.FileInfo().GetBatchWithoutContentHash(0, "", 100)
+		require.NoError(t, err)
+		assert.Contains(t, ids(infos), unhashed.Id)
+		assert.NotContains(t, ids(infos), original.Id)
+
+		require.NoError(t, ss.FileInfo().SetContentHash(rctx, unhashed.Id, hash))
+
+		infos, err = ss.FileInfo().GetBatchWithoutContentHash(0, "", 100)
+		require.NoError(t, err)
+		assert.NotContains(t, ids(infos), unhashed.Id)
+
+		info, err := ss.FileInfo().Get(unhashed.Id)
+		require.NoError(t, err)
+		assert.Equal(t, hash, info.ContentHash)
+	})
+}
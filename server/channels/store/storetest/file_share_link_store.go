@@ -0,0 +1,143 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+)
+
+func TestFileShareLinkStore(t *testing.T, rctx request.CTX, ss store.Store, s SqlStore) {
+	t.Run("SaveAndGet", func(t *testing.T) { testFileShareLinkSaveAndGet(t, rctx, ss) })
+	t.Run("GetForFile", func(t *testing.T) { testFileShareLinkGetForFile(t, rctx, ss) })
+	t.Run("IncrementDownloadCount", func(t *testing.T) { testFileShareLinkIncrementDownloadCount(t, rctx, ss) })
+	t.Run("Revoke", func(t *testing.T) { testFileShareLinkRevoke(t, rctx, ss) })
+}
+
+func newTestFileShareLink(fileID string) *model.FileShareLink {
+	return &model.FileShareLink{
+		FileId:    fileID,
+		CreatorId: model.NewId(),
+		ExpireAt:  model.GetMillis() + 60*60*1000,
+	}
+}
+
+func testFileShareLinkSaveAndGet(t *testing.T, rctx request.CTX, ss store.Store) {
+	link, err := ss.FileShareLink().Save(newTestFileShareLink(model.NewId()))
+	require.NoError(t, err)
+	require.NotEmpty(t, link.Id)
+	require.NotZero(t, link.CreateAt)
+
+	got, err := ss.FileShareLink().Get(link.Id)
+	require.NoError(t, err)
+	assert.Equal(t, link.FileId, got.FileId)
+	assert.Equal(t, link.ExpireAt, got.ExpireAt)
+
+	t.Run("invalid link", func(t *testing.T) {
+		invalid := newTestFileShareLink("")
+		_, err := ss.FileShareLink().Save(invalid)
+		require.Error(t, err)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := ss.FileShareLink().Get(model.NewId())
+		require.Error(t, err)
+		var nfErr *store.ErrNotFound
+		assert.ErrorAs(t, err, &nfErr)
+	})
+}
+
+func testFileShareLinkGetForFile(t *testing.T, rctx request.CTX, ss store.Store) {
+	fileID := model.NewId()
+
+	link1, err := ss.FileShareLink().Save(newTestFileShareLink(fileID))
+	require.NoError(t, err)
+	link2, err := ss.FileShareLink().Save(newTestFileShareLink(fileID))
+	require.NoError(t, err)
+	_, err = ss.FileShareLink().Save(newTestFileShareLink(model.NewId()))
+	require.NoError(t, err)
+
+	links, err := ss.FileShareLink().GetForFile(fileID)
+	require.NoError(t, err)
+	require.Len(t, links, 2)
+
+	err = ss.FileShareLink().Revoke(link1.Id, model.GetMillis())
+	require.NoError(t, err)
+
+	links, err = ss.FileShareLink().GetForFile(fileID)
+	require.NoError(t, err)
+	require.Len(t, links, 1)
+	assert.Equal(t, link2.Id, links[0].Id)
+}
+
+func testFileShareLinkIncrementDownloadCount(t *testing.T, rctx request.CTX, ss store.Store) {
+	t.Run("download limit", func(t *testing.T) {
+		link := newTestFileShareLink(model.NewId())
+		link.MaxDownloads = 2
+		link, err := ss.FileShareLink().Save(link)
+		require.NoError(t, err)
+
+		now := model.GetMillis()
+		require.NoError(t, ss.FileShareLink().IncrementDownloadCount(link.Id, now))
+		require.NoError(t, ss.FileShareLink().IncrementDownloadCount(link.Id, now))
+
+		err = ss.FileShareLink().IncrementDownloadCount(link.Id, now)
+		var nfErr *store.ErrNotFound
+		require.ErrorAs(t, err, &nfErr)
+
+		got, err := ss.FileShareLink().Get(link.Id)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), got.DownloadCount)
+	})
+
+	t.Run("unlimited", func(t *testing.T) {
+		link, err := ss.FileShareLink().Save(newTestFileShareLink(model.NewId()))
+		require.NoError(t, err)
+
+		for i := 0; i < 5; i++ {
+			require.NoError(t, ss.FileShareLink().IncrementDownloadCount(link.Id, model.GetMillis()))
+		}
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		link, err := ss.FileShareLink().Save(newTestFileShareLink(model.NewId()))
+		require.NoError(t, err)
+
+		err = ss.FileShareLink().IncrementDownloadCount(link.Id, link.ExpireAt+1)
+		var nfErr *store.ErrNotFound
+		require.ErrorAs(t, err, &nfErr)
+	})
+
+	t.Run("revoked", func(t *testing.T) {
+		link, err := ss.FileShareLink().Save(newTestFileShareLink(model.NewId()))
+		require.NoError(t, err)
+		require.NoError(t, ss.FileShareLink().Revoke(link.Id, model.GetMillis()))
+
+		err = ss.FileShareLink().IncrementDownloadCount(link.Id, model.GetMillis())
+		var nfErr *store.ErrNotFound
+		require.ErrorAs(t, err, &nfErr)
+	})
+}
+
+func testFileShareLinkRevoke(t *testing.T, rctx request.CTX, ss store.Store) {
+	link, err := ss.FileShareLink().Save(newTestFileShareLink(model.NewId()))
+	require.NoError(t, err)
+
+	require.NoError(t, ss.FileShareLink().Revoke(link.Id, model.GetMillis()))
+
+	got, err := ss.FileShareLink().Get(link.Id)
+	require.NoError(t, err)
+	assert.NotZero(t, got.DeleteAt)
+
+	// Revoking twice is reported as not found
+	err = ss.FileShareLink().Revoke(link.Id, model.GetMillis())
+	var nfErr *store.ErrNotFound
+	require.ErrorAs(t, err, &nfErr)
+}
@@ -32,6 +32,7 @@ func TestGroupStore(t *testing.T, rctx request.CTX, ss store.Store) {
 	t.Run("GetAllBySource", func(t *testing.T) { testGroupStoreGetAllByType(t, rctx, ss) })
 	t.Run("GetByUser", func(t *testing.T) { testGroupStoreGetByUser(t, rctx, ss) })
 	t.Run("Update", func(t *testing.T) { testGroupStoreUpdate(t, rctx, ss) })
+	t.Run("UpdateLastMentionAt", func(t *testing.T) { testGroupStoreUpdateLastMentionAt(t, rctx, ss) })
 	t.Run("Delete", func(t *testing.T) { testGroupStoreDelete(t, rctx, ss) })
 	t.Run("Restore", func(t *testing.T) { testGroupStoreRestore(t, rctx, ss) })
 
@@ -602,6 +603,49 @@ func testGroupStoreGetByUser(t *testing.T, rctx request.CTX, ss store.Store) {
 	assert.Equal(t, 0, len(groups))
 }
 
+func testGroupStoreUpdateLastMentionAt(t *testing.T, rctx request.CTX, ss store.Store) {
+	g1, err := ss.Group().Create(&model.Group{
+		Name:                   model.NewString(model.NewId()),
+		DisplayName:            model.NewId(),
+		Source:                 model.GroupSourceCustom,
+		AllowReference:         true,
+		MentionPolicy:          model.GroupMentionPolicyMembers,
+		MentionCooldownSeconds: 60,
+		MentionMemberLimit:     100,
+	})
+	require.NoError(t, err)
+	defer ss.Group().Delete(g1.Id)
+
+	g1, err = ss.Group().Get(g1.Id)
+	require.NoError(t, err)
+	require.Equal(t, model.GroupMentionPolicyMembers, g1.MentionPolicy)
+	require.Equal(t, 60, g1.MentionCooldownSeconds)
+	require.Equal(t, 100, g1.MentionMemberLimit)
+	require.Zero(t, g1.LastMentionAt)
+
+	updated, err := ss.Group().UpdateLastMentionAt(g1.Id, 100000, 60000)
+	require.NoError(t, err)
+	require.True(t, updated)
+
+	// Within the cooldown
+	updated, err = ss.Group().UpdateLastMentionAt(g1.Id, 130000, 60000)
+	require.NoError(t, err)
+	require.False(t, updated)
+
+	updated, err = ss.Group().UpdateLastMentionAt(g1.Id, 160000, 60000)
+	require.NoError(t, err)
+	require.True(t, updated)
+
+	// Updating the group keeps the last mention
+	g1.DisplayName = model.NewId()
+	_, err = ss.Group().Update(g1)
+	require.NoError(t, err)
+
+	g1, err = ss.Group().Get(g1.Id)
+	require.NoError(t, err)
+	require.Equal(t, int64(160000), g1.LastMentionAt)
+}
+
 func testGroupStoreUpdate(t *testing.T, rctx request.CTX, ss store.Store) {
 	// Save a new group
 	g1 := &model.Group{
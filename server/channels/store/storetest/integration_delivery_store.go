@@ -0,0 +1,154 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+)
+
+func TestIntegrationDeliveryStore(t *testing.T, rctx request.CTX, ss store.Store, s SqlStore) {
+	t.Run("SaveAndGet", func(t *testing.T) { testIntegrationDeliverySaveAndGet(t, rctx, ss) })
+	t.Run("GetForHook", func(t *testing.T) { testIntegrationDeliveryGetForHook(t, rctx, ss) })
+	t.Run("PruneForHook", func(t *testing.T) { testIntegrationDeliveryPruneForHook(t, rctx, ss) })
+	t.Run("PermanentDeleteByHook", func(t *testing.T) { testIntegrationDeliveryPermanentDeleteByHook(t, rctx, ss) })
+	t.Run("Cleanup", func(t *testing.T) { testIntegrationDeliveryCleanup(t, rctx, ss) })
+}
+
+func newTestIntegrationDelivery(hookID string, createAt int64) *model.IntegrationDelivery {
+	return &model.IntegrationDelivery{
+		HookId:      hookID,
+		Type:        model.IntegrationDeliveryTypeOutgoingWebhook,
+		URL:         "https://example.com/hook",
+		ContentType: "application/json",
+		Payload:     `{"text":"hello"}`,
+		UserId:      model.NewId(),
+		ChannelId:   model.NewId(),
+		PostId:      model.NewId(),
+		StatusCode:  200,
+		Latency:     42,
+		Response:    `{"text":"world"}`,
+		CreateAt:    createAt,
+	}
+}
+
+func testIntegrationDeliverySaveAndGet(t *testing.T, rctx request.CTX, ss store.Store) {
+	_, err := ss.IntegrationDelivery().Save(&model.IntegrationDelivery{HookId: model.NewId(), Type: "unknown", URL: "https://example.com"})
+	require.Error(t, err)
+
+	delivery := newTestIntegrationDelivery(model.NewId(), 0)
+	delivery.StatusCode = 0
+	delivery.Error = "connection refused"
+	saved, err := ss.IntegrationDelivery().Save(delivery)
+	require.NoError(t, err)
+	require.NotEmpty(t, saved.Id)
+
+	got, err := ss.IntegrationDelivery().Get(saved.Id)
+	require.NoError(t, err)
+	assert.Equal(t, saved, got)
+
+	_, err = ss.IntegrationDelivery().Get(model.NewId())
+	var nfErr *store.ErrNotFound
+	assert.True(t, errors.As(err, &nfErr))
+}
+
+func testIntegrationDeliveryGetForHook(t *testing.T, rctx request.CTX, ss store.Store) {
+	hookID := model.NewId()
+	now := model.GetMillis()
+
+	var saved []*model.IntegrationDelivery
+	for i := 0; i < 3; i++ {
+		delivery, err := ss.IntegrationDelivery().Save(newTestIntegrationDelivery(hookID, now+int64(i)))
+		require.NoError(t, err)
+		saved = append(saved, delivery)
+	}
+	_, err := ss.IntegrationDelivery().Save(newTestIntegrationDelivery(model.NewId(), now))
+	require.NoError(t, err)
+
+	deliveries, err := ss.IntegrationDelivery().GetForHook(hookID, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 3)
+	assert.Equal(t, saved[2].Id, deliveries[0].Id)
+	assert.Equal(t, saved[0].Id, deliveries[2].Id)
+
+	deliveries, err = ss.IntegrationDelivery().GetForHook(hookID, 1, 2)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	assert.Equal(t, saved[0].Id, deliveries[0].Id)
+}
+
+func testIntegrationDeliveryPruneForHook(t *testing.T, rctx request.CTX, ss store.Store) {
+	hookID := model.NewId()
+	otherHookID := model.NewId()
+	now := model.GetMillis()
+
+	for i := 0; i < 5; i++ {
+		_, err := ss.IntegrationDelivery().Save(newTestIntegrationDelivery(hookID, now+int64(i)))
+		require.NoError(t, err)
+		_, err = ss.IntegrationDelivery().Save(newTestIntegrationDelivery(otherHookID, now+int64(i)))
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, ss.IntegrationDelivery().PruneForHook(hookID, 2))
+
+	deliveries, err := ss.IntegrationDelivery().GetForHook(hookID, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 2)
+	assert.Equal(t, now+4, deliveries[0].CreateAt)
+	assert.Equal(t, now+3, deliveries[1].CreateAt)
+
+	deliveries, err = ss.IntegrationDelivery().GetForHook(otherHookID, 0, 10)
+	require.NoError(t, err)
+	assert.Len(t, deliveries, 5)
+
+	// Pruning a hook with fewer deliveries than kept is a no-op.
+	require.NoError(t, ss.IntegrationDelivery().PruneForHook(otherHookID, 10))
+	deliveries, err = ss.IntegrationDelivery().GetForHook(otherHookID, 0, 10)
+	require.NoError(t, err)
+	assert.Len(t, deliveries, 5)
+}
+
+func testIntegrationDeliveryPermanentDeleteByHook(t *testing.T, rctx request.CTX, ss store.Store) {
+	hookID := model.NewId()
+	otherHookID := model.NewId()
+
+	_, err := ss.IntegrationDelivery().Save(newTestIntegrationDelivery(hookID, 0))
+	require.NoError(t, err)
+	_, err = ss.IntegrationDelivery().Save(newTestIntegrationDelivery(otherHookID, 0))
+	require.NoError(t, err)
+
+	require.NoError(t, ss.IntegrationDelivery().PermanentDeleteByHook(hookID))
+
+	deliveries, err := ss.IntegrationDelivery().GetForHook(hookID, 0, 10)
+	require.NoError(t, err)
+	assert.Empty(t, deliveries)
+
+	deliveries, err = ss.IntegrationDelivery().GetForHook(otherHookID, 0, 10)
+	require.NoError(t, err)
+	assert.Len(t, deliveries, 1)
+}
+
+func testIntegrationDeliveryCleanup(t *testing.T, rctx request.CTX, ss store.Store) {
+	hookID := model.NewId()
+	now := model.GetMillis()
+
+	_, err := ss.IntegrationDelivery().Save(newTestIntegrationDelivery(hookID, now-10000))
+	require.NoError(t, err)
+	recent, err := ss.IntegrationDelivery().Save(newTestIntegrationDelivery(hookID, now))
+	require.NoError(t, err)
+
+	require.NoError(t, ss.IntegrationDelivery().Cleanup(now-5000, 1))
+
+	deliveries, err := ss.IntegrationDelivery().GetForHook(hookID, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	assert.Equal(t, recent.Id, deliveries[0].Id)
+}
@@ -0,0 +1,197 @@
+// Code generated by mockery v2.42.2. DO NOT EDIT.
+
+// Regenerate this file using `make store-mocks`.
+
+package mocks
+
+import (
+	model "github.com/mattermost/mattermost/server/public/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AutomationRuleStore is an autogenerated mock type for the AutomationRuleStore type
+type AutomationRuleStore struct {
+	mock.Mock
+}
+
+// Delete provides a mock function with given fields: id, deleteAt
+func (_m *AutomationRuleStore) Delete(id string, deleteAt int64) error {
+	ret := _m.Called(id, deleteAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int64) error); ok {
+		r0 = rf(id, deleteAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Get provides a mock function with given fields: id
+func (_m *AutomationRuleStore) Get(id string) (*model.AutomationRule, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *model.AutomationRule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*model.AutomationRule, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(string) *model.AutomationRule); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.AutomationRule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetEnabledForTrigger provides a mock function with given fields: teamID, triggerType
+func (_m *AutomationRuleStore) GetEnabledForTrigger(teamID string, triggerType string) ([]*model.AutomationRule, error) {
+	ret := _m.Called(teamID, triggerType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEnabledForTrigger")
+	}
+
+	var r0 []*model.AutomationRule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) ([]*model.AutomationRule, error)); ok {
+		return rf(teamID, triggerType)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) []*model.AutomationRule); ok {
+		r0 = rf(teamID, triggerType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.AutomationRule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(teamID, triggerType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetForTeam provides a mock function with given fields: teamID, page, perPage
+func (_m *AutomationRuleStore) GetForTeam(teamID string, page int, perPage int) ([]*model.AutomationRule, error) {
+	ret := _m.Called(teamID, page, perPage)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetForTeam")
+	}
+
+	var r0 []*model.AutomationRule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int, int) ([]*model.AutomationRule, error)); ok {
+		return rf(teamID, page, perPage)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, int) []*model.AutomationRule); ok {
+		r0 = rf(teamID, page, perPage)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.AutomationRule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, int) error); ok {
+		r1 = rf(teamID, page, perPage)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Save provides a mock function with given fields: rule
+func (_m *AutomationRuleStore) Save(rule *model.AutomationRule) (*model.AutomationRule, error) {
+	ret := _m.Called(rule)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Save")
+	}
+
+	var r0 *model.AutomationRule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.AutomationRule) (*model.AutomationRule, error)); ok {
+		return rf(rule)
+	}
+	if rf, ok := ret.Get(0).(func(*model.AutomationRule) *model.AutomationRule); ok {
+		r0 = rf(rule)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.AutomationRule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.AutomationRule) error); ok {
+		r1 = rf(rule)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: rule
+func (_m *AutomationRuleStore) Update(rule *model.AutomationRule) (*model.AutomationRule, error) {
+	ret := _m.Called(rule)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 *model.AutomationRule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.AutomationRule) (*model.AutomationRule, error)); ok {
+		return rf(rule)
+	}
+	if rf, ok := ret.Get(0).(func(*model.AutomationRule) *model.AutomationRule); ok {
+		r0 = rf(rule)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.AutomationRule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.AutomationRule) error); ok {
+		r1 = rf(rule)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewAutomationRuleStore creates a new instance of AutomationRuleStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAutomationRuleStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AutomationRuleStore {
+	mock := &AutomationRuleStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -1270,6 +1270,24 @@ func (_m *ChannelStore) GetDeletedByName(team_id string, name string) (*model.Ch
 	return r0, r1
 }
 
+// GetEtagForUserChannels provides a mock function with given fields: userID, teamID
+func (_m *ChannelStore) GetEtagForUserChannels(userID string, teamID string) string {
+	ret := _m.Called(userID, teamID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEtagForUserChannels")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string, string) string); ok {
+		r0 = rf(userID, teamID)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // GetFileCount provides a mock function with given fields: channelID
 func (_m *ChannelStore) GetFileCount(channelID string) (int64, error) {
 	ret := _m.Called(channelID)
@@ -1580,6 +1598,36 @@ func (_m *ChannelStore) GetMembers(channelID string, offset int, limit int) (mod
 	return r0, r1
 }
 
+// GetMembersAfter provides a mock function with given fields: channelID, opts
+func (_m *ChannelStore) GetMembersAfter(channelID string, opts *model.ChannelMembersGetOptions) (model.ChannelMembers, error) {
+	ret := _m.Called(channelID, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMembersAfter")
+	}
+
+	var r0 model.ChannelMembers
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *model.ChannelMembersGetOptions) (model.ChannelMembers, error)); ok {
+		return rf(channelID, opts)
+	}
+	if rf, ok := ret.Get(0).(func(string, *model.ChannelMembersGetOptions) model.ChannelMembers); ok {
+		r0 = rf(channelID, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.ChannelMembers)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *model.ChannelMembersGetOptions) error); ok {
+		r1 = rf(channelID, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetMembersByChannelIds provides a mock function with given fields: channelIds, userID
 func (_m *ChannelStore) GetMembersByChannelIds(channelIds []string, userID string) (model.ChannelMembers, error) {
 	ret := _m.Called(channelIds, userID)
@@ -2028,6 +2076,36 @@ func (_m *ChannelStore) GetSidebarCategoryOrder(userID string, teamID string) ([
 	return r0, r1
 }
 
+// GetSidebarCategoryUnreads provides a mock function with given fields: userID
+func (_m *ChannelStore) GetSidebarCategoryUnreads(userID string) ([]*model.SidebarCategoryUnread, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSidebarCategoryUnreads")
+	}
+
+	var r0 []*model.SidebarCategoryUnread
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]*model.SidebarCategoryUnread, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []*model.SidebarCategoryUnread); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.SidebarCategoryUnread)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetTeamChannels provides a mock function with given fields: teamID
 func (_m *ChannelStore) GetTeamChannels(teamID string) (model.ChannelList, error) {
 	ret := _m.Called(teamID)
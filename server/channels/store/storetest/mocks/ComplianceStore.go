@@ -52,6 +52,43 @@ func (_m *ComplianceStore) ComplianceExport(compliance *model.Compliance, cursor
 	return r0, r1, r2
 }
 
+// ComplianceExportIncremental provides a mock function with given fields: compliance, cursor, limit
+func (_m *ComplianceStore) ComplianceExportIncremental(compliance *model.Compliance, cursor model.ComplianceIncrementalExportCursor, limit int) ([]*model.CompliancePost, model.ComplianceIncrementalExportCursor, error) {
+	ret := _m.Called(compliance, cursor, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ComplianceExportIncremental")
+	}
+
+	var r0 []*model.CompliancePost
+	var r1 model.ComplianceIncrementalExportCursor
+	var r2 error
+	if rf, ok := ret.Get(0).(func(*model.Compliance, model.ComplianceIncrementalExportCursor, int) ([]*model.CompliancePost, model.ComplianceIncrementalExportCursor, error)); ok {
+		return rf(compliance, cursor, limit)
+	}
+	if rf, ok := ret.Get(0).(func(*model.Compliance, model.ComplianceIncrementalExportCursor, int) []*model.CompliancePost); ok {
+		r0 = rf(compliance, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.CompliancePost)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.Compliance, model.ComplianceIncrementalExportCursor, int) model.ComplianceIncrementalExportCursor); ok {
+		r1 = rf(compliance, cursor, limit)
+	} else {
+		r1 = ret.Get(1).(model.ComplianceIncrementalExportCursor)
+	}
+
+	if rf, ok := ret.Get(2).(func(*model.Compliance, model.ComplianceIncrementalExportCursor, int) error); ok {
+		r2 = rf(compliance, cursor, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // Get provides a mock function with given fields: id
 func (_m *ComplianceStore) Get(id string) (*model.Compliance, error) {
 	ret := _m.Called(id)
@@ -112,6 +149,36 @@ func (_m *ComplianceStore) GetAll(offset int, limit int) (model.Compliances, err
 	return r0, r1
 }
 
+// GetLastSuccessfulIncremental provides a mock function with given fields:
+func (_m *ComplianceStore) GetLastSuccessfulIncremental() (*model.Compliance, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLastSuccessfulIncremental")
+	}
+
+	var r0 *model.Compliance
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (*model.Compliance, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() *model.Compliance); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Compliance)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // MessageExport provides a mock function with given fields: c, cursor, limit
 func (_m *ComplianceStore) MessageExport(c request.CTX, cursor model.MessageExportCursor, limit int) ([]*model.MessageExport, model.MessageExportCursor, error) {
 	ret := _m.Called(c, cursor, limit)
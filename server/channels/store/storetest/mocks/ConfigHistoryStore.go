@@ -0,0 +1,119 @@
+// Code generated by mockery v2.42.2. DO NOT EDIT.
+
+// Regenerate this file using `make store-mocks`.
+
+package mocks
+
+import (
+	model "github.com/mattermost/mattermost/server/public/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ConfigHistoryStore is an autogenerated mock type for the ConfigHistoryStore type
+type ConfigHistoryStore struct {
+	mock.Mock
+}
+
+// GetAll provides a mock function with given fields: offset, limit
+func (_m *ConfigHistoryStore) GetAll(offset int, limit int) ([]*model.ConfigHistory, error) {
+	ret := _m.Called(offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAll")
+	}
+
+	var r0 []*model.ConfigHistory
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, int) ([]*model.ConfigHistory, error)); ok {
+		return rf(offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(int, int) []*model.ConfigHistory); ok {
+		r0 = rf(offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.ConfigHistory)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int) error); ok {
+		r1 = rf(offset, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByVersion provides a mock function with given fields: version
+func (_m *ConfigHistoryStore) GetByVersion(version int64) (*model.ConfigHistory, error) {
+	ret := _m.Called(version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByVersion")
+	}
+
+	var r0 *model.ConfigHistory
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64) (*model.ConfigHistory, error)); ok {
+		return rf(version)
+	}
+	if rf, ok := ret.Get(0).(func(int64) *model.ConfigHistory); ok {
+		r0 = rf(version)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ConfigHistory)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(version)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Save provides a mock function with given fields: history
+func (_m *ConfigHistoryStore) Save(history *model.ConfigHistory) (*model.ConfigHistory, error) {
+	ret := _m.Called(history)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Save")
+	}
+
+	var r0 *model.ConfigHistory
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.ConfigHistory) (*model.ConfigHistory, error)); ok {
+		return rf(history)
+	}
+	if rf, ok := ret.Get(0).(func(*model.ConfigHistory) *model.ConfigHistory); ok {
+		r0 = rf(history)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ConfigHistory)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.ConfigHistory) error); ok {
+		r1 = rf(history)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewConfigHistoryStore creates a new instance of ConfigHistoryStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewConfigHistoryStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ConfigHistoryStore {
+	mock := &ConfigHistoryStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
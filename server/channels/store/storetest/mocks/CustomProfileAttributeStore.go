@@ -0,0 +1,251 @@
+// Code generated by mockery v2.42.2. DO NOT EDIT.
+
+// Regenerate this file using `make store-mocks`.
+
+package mocks
+
+import (
+	model "github.com/mattermost/mattermost/server/public/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CustomProfileAttributeStore is an autogenerated mock type for the CustomProfileAttributeStore type
+type CustomProfileAttributeStore struct {
+	mock.Mock
+}
+
+// DeleteField provides a mock function with given fields: id, deleteAt
+func (_m *CustomProfileAttributeStore) DeleteField(id string, deleteAt int64) error {
+	ret := _m.Called(id, deleteAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteField")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int64) error); ok {
+		r0 = rf(id, deleteAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteValues provides a mock function with given fields: userID, fieldIDs
+func (_m *CustomProfileAttributeStore) DeleteValues(userID string, fieldIDs []string) error {
+	ret := _m.Called(userID, fieldIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteValues")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, []string) error); ok {
+		r0 = rf(userID, fieldIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetField provides a mock function with given fields: id
+func (_m *CustomProfileAttributeStore) GetField(id string) (*model.CustomProfileAttributeField, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetField")
+	}
+
+	var r0 *model.CustomProfileAttributeField
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*model.CustomProfileAttributeField, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(string) *model.CustomProfileAttributeField); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.CustomProfileAttributeField)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetFields provides a mock function with given fields:
+func (_m *CustomProfileAttributeStore) GetFields() ([]*model.CustomProfileAttributeField, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFields")
+	}
+
+	var r0 []*model.CustomProfileAttributeField
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]*model.CustomProfileAttributeField, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []*model.CustomProfileAttributeField); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.CustomProfileAttributeField)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetValuesForUsers provides a mock function with given fields: userIDs
+func (_m *CustomProfileAttributeStore) GetValuesForUsers(userIDs []string) ([]*model.CustomProfileAttributeValue, error) {
+	ret := _m.Called(userIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetValuesForUsers")
+	}
+
+	var r0 []*model.CustomProfileAttributeValue
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]string) ([]*model.CustomProfileAttributeValue, error)); ok {
+		return rf(userIDs)
+	}
+	if rf, ok := ret.Get(0).(func([]string) []*model.CustomProfileAttributeValue); ok {
+		r0 = rf(userIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.CustomProfileAttributeValue)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]string) error); ok {
+		r1 = rf(userIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PermanentDeleteValuesByUser provides a mock function with given fields: userID
+func (_m *CustomProfileAttributeStore) PermanentDeleteValuesByUser(userID string) error {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PermanentDeleteValuesByUser")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SaveField provides a mock function with given fields: field
+func (_m *CustomProfileAttributeStore) SaveField(field *model.CustomProfileAttributeField) (*model.CustomProfileAttributeField, error) {
+	ret := _m.Called(field)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveField")
+	}
+
+	var r0 *model.CustomProfileAttributeField
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.CustomProfileAttributeField) (*model.CustomProfileAttributeField, error)); ok {
+		return rf(field)
+	}
+	if rf, ok := ret.Get(0).(func(*model.CustomProfileAttributeField) *model.CustomProfileAttributeField); ok {
+		r0 = rf(field)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.CustomProfileAttributeField)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.CustomProfileAttributeField) error); ok {
+		r1 = rf(field)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateField provides a mock function with given fields: field
+func (_m *CustomProfileAttributeStore) UpdateField(field *model.CustomProfileAttributeField) (*model.CustomProfileAttributeField, error) {
+	ret := _m.Called(field)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateField")
+	}
+
+	var r0 *model.CustomProfileAttributeField
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.CustomProfileAttributeField) (*model.CustomProfileAttributeField, error)); ok {
+		return rf(field)
+	}
+	if rf, ok := ret.Get(0).(func(*model.CustomProfileAttributeField) *model.CustomProfileAttributeField); ok {
+		r0 = rf(field)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.CustomProfileAttributeField)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.CustomProfileAttributeField) error); ok {
+		r1 = rf(field)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpsertValues provides a mock function with given fields: values
+func (_m *CustomProfileAttributeStore) UpsertValues(values []*model.CustomProfileAttributeValue) error {
+	ret := _m.Called(values)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertValues")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]*model.CustomProfileAttributeValue) error); ok {
+		r0 = rf(values)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewCustomProfileAttributeStore creates a new instance of CustomProfileAttributeStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCustomProfileAttributeStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CustomProfileAttributeStore {
+	mock := &CustomProfileAttributeStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
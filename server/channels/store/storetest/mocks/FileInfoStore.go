@@ -66,6 +66,34 @@ func (_m *FileInfoStore) CountAll() (int64, error) {
 	return r0, r1
 }
 
+// CountByPath provides a mock function with given fields: path
+func (_m *FileInfoStore) CountByPath(path string) (int64, error) {
+	ret := _m.Called(path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountByPath")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (int64, error)); ok {
+		return rf(path)
+	}
+	if rf, ok := ret.Get(0).(func(string) int64); ok {
+		r0 = rf(path)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(path)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DeleteForPost provides a mock function with given fields: c, postID
 func (_m *FileInfoStore) DeleteForPost(c request.CTX, postID string) (string, error) {
 	ret := _m.Called(c, postID)
@@ -124,6 +152,66 @@ func (_m *FileInfoStore) Get(id string) (*model.FileInfo, error) {
 	return r0, r1
 }
 
+// GetBatchWithoutContentHash provides a mock function with given fields: startTime, startFileID, limit
+func (_m *FileInfoStore) GetBatchWithoutContentHash(startTime int64, startFileID string, limit int) ([]*model.FileInfo, error) {
+	ret := _m.Called(startTime, startFileID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBatchWithoutContentHash")
+	}
+
+	var r0 []*model.FileInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64, string, int) ([]*model.FileInfo, error)); ok {
+		return rf(startTime, startFileID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(int64, string, int) []*model.FileInfo); ok {
+		r0 = rf(startTime, startFileID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.FileInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int64, string, int) error); ok {
+		r1 = rf(startTime, startFileID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByContentHash provides a mock function with given fields: hash, size
+func (_m *FileInfoStore) GetByContentHash(hash string, size int64) (*model.FileInfo, error) {
+	ret := _m.Called(hash, size)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByContentHash")
+	}
+
+	var r0 *model.FileInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int64) (*model.FileInfo, error)); ok {
+		return rf(hash, size)
+	}
+	if rf, ok := ret.Get(0).(func(string, int64) *model.FileInfo); ok {
+		r0 = rf(hash, size)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.FileInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int64) error); ok {
+		r1 = rf(hash, size)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetByIds provides a mock function with given fields: ids
 func (_m *FileInfoStore) GetByIds(ids []string) ([]*model.FileInfo, error) {
 	ret := _m.Called(ids)
@@ -547,6 +635,24 @@ func (_m *FileInfoStore) SetContent(ctx request.CTX, fileID string, content stri
 	return r0
 }
 
+// SetContentHash provides a mock function with given fields: ctx, fileID, hash
+func (_m *FileInfoStore) SetContentHash(ctx request.CTX, fileID string, hash string) error {
+	ret := _m.Called(ctx, fileID, hash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetContentHash")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(request.CTX, string, string) error); ok {
+		r0 = rf(ctx, fileID, hash)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Upsert provides a mock function with given fields: rctx, info
 func (_m *FileInfoStore) Upsert(rctx request.CTX, info *model.FileInfo) (*model.FileInfo, error) {
 	ret := _m.Called(rctx, info)
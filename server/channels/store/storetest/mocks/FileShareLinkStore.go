@@ -0,0 +1,155 @@
+// Code generated by mockery v2.42.2. DO NOT EDIT.
+
+// Regenerate this file using `make store-mocks`.
+
+package mocks
+
+import (
+	model "github.com/mattermost/mattermost/server/public/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// FileShareLinkStore is an autogenerated mock type for the FileShareLinkStore type
+type FileShareLinkStore struct {
+	mock.Mock
+}
+
+// Get provides a mock function with given fields: id
+func (_m *FileShareLinkStore) Get(id string) (*model.FileShareLink, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *model.FileShareLink
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*model.FileShareLink, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(string) *model.FileShareLink); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.FileShareLink)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetForFile provides a mock function with given fields: fileID
+func (_m *FileShareLinkStore) GetForFile(fileID string) ([]*model.FileShareLink, error) {
+	ret := _m.Called(fileID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetForFile")
+	}
+
+	var r0 []*model.FileShareLink
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]*model.FileShareLink, error)); ok {
+		return rf(fileID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []*model.FileShareLink); ok {
+		r0 = rf(fileID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.FileShareLink)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(fileID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IncrementDownloadCount provides a mock function with given fields: id, now
+func (_m *FileShareLinkStore) IncrementDownloadCount(id string, now int64) error {
+	ret := _m.Called(id, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IncrementDownloadCount")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int64) error); ok {
+		r0 = rf(id, now)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Revoke provides a mock function with given fields: id, deleteAt
+func (_m *FileShareLinkStore) Revoke(id string, deleteAt int64) error {
+	ret := _m.Called(id, deleteAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Revoke")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int64) error); ok {
+		r0 = rf(id, deleteAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Save provides a mock function with given fields: link
+func (_m *FileShareLinkStore) Save(link *model.FileShareLink) (*model.FileShareLink, error) {
+	ret := _m.Called(link)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Save")
+	}
+
+	var r0 *model.FileShareLink
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.FileShareLink) (*model.FileShareLink, error)); ok {
+		return rf(link)
+	}
+	if rf, ok := ret.Get(0).(func(*model.FileShareLink) *model.FileShareLink); ok {
+		r0 = rf(link)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.FileShareLink)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.FileShareLink) error); ok {
+		r1 = rf(link)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewFileShareLinkStore creates a new instance of FileShareLinkStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewFileShareLinkStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *FileShareLinkStore {
+	mock := &FileShareLinkStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -1534,6 +1534,34 @@ func (_m *GroupStore) UpdateGroupSyncable(groupSyncable *model.GroupSyncable) (*
 	return r0, r1
 }
 
+// UpdateLastMentionAt provides a mock function with given fields: groupID, mentionAt, cooldown
+func (_m *GroupStore) UpdateLastMentionAt(groupID string, mentionAt int64, cooldown int64) (bool, error) {
+	ret := _m.Called(groupID, mentionAt, cooldown)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateLastMentionAt")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int64, int64) (bool, error)); ok {
+		return rf(groupID, mentionAt, cooldown)
+	}
+	if rf, ok := ret.Get(0).(func(string, int64, int64) bool); ok {
+		r0 = rf(groupID, mentionAt, cooldown)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int64, int64) error); ok {
+		r1 = rf(groupID, mentionAt, cooldown)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpsertMember provides a mock function with given fields: groupID, userID
 func (_m *GroupStore) UpsertMember(groupID string, userID string) (*model.GroupMember, error) {
 	ret := _m.Called(groupID, userID)
@@ -0,0 +1,173 @@
+// Code generated by mockery v2.42.2. DO NOT EDIT.
+
+// Regenerate this file using `make store-mocks`.
+
+package mocks
+
+import (
+	model "github.com/mattermost/mattermost/server/public/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IntegrationDeliveryStore is an autogenerated mock type for the IntegrationDeliveryStore type
+type IntegrationDeliveryStore struct {
+	mock.Mock
+}
+
+// Cleanup provides a mock function with given fields: expiryTime, batchSize
+func (_m *IntegrationDeliveryStore) Cleanup(expiryTime int64, batchSize int) error {
+	ret := _m.Called(expiryTime, batchSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Cleanup")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64, int) error); ok {
+		r0 = rf(expiryTime, batchSize)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Get provides a mock function with given fields: id
+func (_m *IntegrationDeliveryStore) Get(id string) (*model.IntegrationDelivery, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *model.IntegrationDelivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*model.IntegrationDelivery, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(string) *model.IntegrationDelivery); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.IntegrationDelivery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetForHook provides a mock function with given fields: hookID, page, perPage
+func (_m *IntegrationDeliveryStore) GetForHook(hookID string, page int, perPage int) ([]*model.IntegrationDelivery, error) {
+	ret := _m.Called(hookID, page, perPage)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetForHook")
+	}
+
+	var r0 []*model.IntegrationDelivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int, int) ([]*model.IntegrationDelivery, error)); ok {
+		return rf(hookID, page, perPage)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, int) []*model.IntegrationDelivery); ok {
+		r0 = rf(hookID, page, perPage)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.IntegrationDelivery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, int) error); ok {
+		r1 = rf(hookID, page, perPage)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PermanentDeleteByHook provides a mock function with given fields: hookID
+func (_m *IntegrationDeliveryStore) PermanentDeleteByHook(hookID string) error {
+	ret := _m.Called(hookID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PermanentDeleteByHook")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(hookID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PruneForHook provides a mock function with given fields: hookID, keep
+func (_m *IntegrationDeliveryStore) PruneForHook(hookID string, keep int) error {
+	ret := _m.Called(hookID, keep)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PruneForHook")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int) error); ok {
+		r0 = rf(hookID, keep)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Save provides a mock function with given fields: delivery
+func (_m *IntegrationDeliveryStore) Save(delivery *model.IntegrationDelivery) (*model.IntegrationDelivery, error) {
+	ret := _m.Called(delivery)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Save")
+	}
+
+	var r0 *model.IntegrationDelivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.IntegrationDelivery) (*model.IntegrationDelivery, error)); ok {
+		return rf(delivery)
+	}
+	if rf, ok := ret.Get(0).(func(*model.IntegrationDelivery) *model.IntegrationDelivery); ok {
+		r0 = rf(delivery)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.IntegrationDelivery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.IntegrationDelivery) error); ok {
+		r1 = rf(delivery)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewIntegrationDeliveryStore creates a new instance of IntegrationDeliveryStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIntegrationDeliveryStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IntegrationDeliveryStore {
+	mock := &IntegrationDeliveryStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,107 @@
+// Code generated by mockery v2.42.2. DO NOT EDIT.
+
+// Regenerate this file using `make store-mocks`.
+
+package mocks
+
+import (
+	model "github.com/mattermost/mattermost/server/public/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NotificationDeliveryStore is an autogenerated mock type for the NotificationDeliveryStore type
+type NotificationDeliveryStore struct {
+	mock.Mock
+}
+
+// Cleanup provides a mock function with given fields: expiryTime, batchSize
+func (_m *NotificationDeliveryStore) Cleanup(expiryTime int64, batchSize int) error {
+	ret := _m.Called(expiryTime, batchSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Cleanup")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64, int) error); ok {
+		r0 = rf(expiryTime, batchSize)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetForUser provides a mock function with given fields: userID, opts
+func (_m *NotificationDeliveryStore) GetForUser(userID string, opts model.NotificationDeliveryGetOptions) ([]*model.NotificationDelivery, error) {
+	ret := _m.Called(userID, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetForUser")
+	}
+
+	var r0 []*model.NotificationDelivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, model.NotificationDeliveryGetOptions) ([]*model.NotificationDelivery, error)); ok {
+		return rf(userID, opts)
+	}
+	if rf, ok := ret.Get(0).(func(string, model.NotificationDeliveryGetOptions) []*model.NotificationDelivery); ok {
+		r0 = rf(userID, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.NotificationDelivery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, model.NotificationDeliveryGetOptions) error); ok {
+		r1 = rf(userID, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Save provides a mock function with given fields: delivery
+func (_m *NotificationDeliveryStore) Save(delivery *model.NotificationDelivery) (*model.NotificationDelivery, error) {
+	ret := _m.Called(delivery)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Save")
+	}
+
+	var r0 *model.NotificationDelivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.NotificationDelivery) (*model.NotificationDelivery, error)); ok {
+		return rf(delivery)
+	}
+	if rf, ok := ret.Get(0).(func(*model.NotificationDelivery) *model.NotificationDelivery); ok {
+		r0 = rf(delivery)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.NotificationDelivery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.NotificationDelivery) error); ok {
+		r1 = rf(delivery)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewNotificationDeliveryStore creates a new instance of NotificationDeliveryStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewNotificationDeliveryStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *NotificationDeliveryStore {
+	mock := &NotificationDeliveryStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
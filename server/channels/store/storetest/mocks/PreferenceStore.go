@@ -244,6 +244,24 @@ func (_m *PreferenceStore) GetCategoryAndName(category string, nane string) (mod
 	return r0, r1
 }
 
+// GetEtag provides a mock function with given fields: userID
+func (_m *PreferenceStore) GetEtag(userID string) string {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEtag")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(userID)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // PermanentDeleteByUser provides a mock function with given fields: userID
 func (_m *PreferenceStore) PermanentDeleteByUser(userID string) error {
 	ret := _m.Called(userID)
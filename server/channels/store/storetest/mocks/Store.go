@@ -44,6 +44,26 @@ func (_m *Store) Audit() store.AuditStore {
 	return r0
 }
 
+// AutomationRule provides a mock function with given fields:
+func (_m *Store) AutomationRule() store.AutomationRuleStore {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for AutomationRule")
+	}
+
+	var r0 store.AutomationRuleStore
+	if rf, ok := ret.Get(0).(func() store.AutomationRuleStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.AutomationRuleStore)
+		}
+	}
+
+	return r0
+}
+
 // Bot provides a mock function with given fields:
 func (_m *Store) Bot() store.BotStore {
 	ret := _m.Called()
@@ -229,6 +249,26 @@ func (_m *Store) Compliance() store.ComplianceStore {
 	return r0
 }
 
+// ConfigHistory provides a mock function with given fields:
+func (_m *Store) ConfigHistory() store.ConfigHistoryStore {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConfigHistory")
+	}
+
+	var r0 store.ConfigHistoryStore
+	if rf, ok := ret.Get(0).(func() store.ConfigHistoryStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.ConfigHistoryStore)
+		}
+	}
+
+	return r0
+}
+
 // Context provides a mock function with given fields:
 func (_m *Store) Context() context.Context {
 	ret := _m.Called()
@@ -249,6 +289,26 @@ func (_m *Store) Context() context.Context {
 	return r0
 }
 
+// CustomProfileAttribute provides a mock function with given fields:
+func (_m *Store) CustomProfileAttribute() store.CustomProfileAttributeStore {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for CustomProfileAttribute")
+	}
+
+	var r0 store.CustomProfileAttributeStore
+	if rf, ok := ret.Get(0).(func() store.CustomProfileAttributeStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.CustomProfileAttributeStore)
+		}
+	}
+
+	return r0
+}
+
 // DesktopTokens provides a mock function with given fields:
 func (_m *Store) DesktopTokens() store.DesktopTokensStore {
 	ret := _m.Called()
@@ -334,6 +394,26 @@ func (_m *Store) FileInfo() store.FileInfoStore {
 	return r0
 }
 
+// FileShareLink provides a mock function with given fields:
+func (_m *Store) FileShareLink() store.FileShareLinkStore {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for FileShareLink")
+	}
+
+	var r0 store.FileShareLinkStore
+	if rf, ok := ret.Get(0).(func() store.FileShareLinkStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.FileShareLinkStore)
+		}
+	}
+
+	return r0
+}
+
 // GetAppliedMigrations provides a mock function with given fields:
 func (_m *Store) GetAppliedMigrations() ([]model.AppliedMigration, error) {
 	ret := _m.Called()
@@ -508,6 +588,26 @@ func (_m *Store) Group() store.GroupStore {
 	return r0
 }
 
+// IntegrationDelivery provides a mock function with given fields:
+func (_m *Store) IntegrationDelivery() store.IntegrationDeliveryStore {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for IntegrationDelivery")
+	}
+
+	var r0 store.IntegrationDeliveryStore
+	if rf, ok := ret.Get(0).(func() store.IntegrationDeliveryStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.IntegrationDeliveryStore)
+		}
+	}
+
+	return r0
+}
+
 // Job provides a mock function with given fields:
 func (_m *Store) Job() store.JobStore {
 	ret := _m.Called()
@@ -598,6 +698,26 @@ func (_m *Store) MarkSystemRanUnitTests() {
 	_m.Called()
 }
 
+// NotificationDelivery provides a mock function with given fields:
+func (_m *Store) NotificationDelivery() store.NotificationDeliveryStore {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for NotificationDelivery")
+	}
+
+	var r0 store.NotificationDeliveryStore
+	if rf, ok := ret.Get(0).(func() store.NotificationDeliveryStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.NotificationDeliveryStore)
+		}
+	}
+
+	return r0
+}
+
 // NotifyAdmin provides a mock function with given fields:
 func (_m *Store) NotifyAdmin() store.NotifyAdminStore {
 	ret := _m.Called()
@@ -1158,6 +1278,26 @@ func (_m *Store) TotalSearchDbConnections() int {
 	return r0
 }
 
+// UndeliverableEmail provides a mock function with given fields:
+func (_m *Store) UndeliverableEmail() store.UndeliverableEmailStore {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for UndeliverableEmail")
+	}
+
+	var r0 store.UndeliverableEmailStore
+	if rf, ok := ret.Get(0).(func() store.UndeliverableEmailStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.UndeliverableEmailStore)
+		}
+	}
+
+	return r0
+}
+
 // UnlockFromMaster provides a mock function with given fields:
 func (_m *Store) UnlockFromMaster() {
 	_m.Called()
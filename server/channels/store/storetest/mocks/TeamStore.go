@@ -482,6 +482,36 @@ func (_m *TeamStore) GetChannelUnreadsForTeam(teamID string, userID string) ([]*
 	return r0, r1
 }
 
+// GetChannelUnreadsSince provides a mock function with given fields: userID, since, limit
+func (_m *TeamStore) GetChannelUnreadsSince(userID string, since int64, limit int) ([]*model.ChannelUnread, error) {
+	ret := _m.Called(userID, since, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetChannelUnreadsSince")
+	}
+
+	var r0 []*model.ChannelUnread
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int64, int) ([]*model.ChannelUnread, error)); ok {
+		return rf(userID, since, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, int64, int) []*model.ChannelUnread); ok {
+		r0 = rf(userID, since, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.ChannelUnread)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int64, int) error); ok {
+		r1 = rf(userID, since, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetCommonTeamIDsForMultipleUsers provides a mock function with given fields: userIDs
 func (_m *TeamStore) GetCommonTeamIDsForMultipleUsers(userIDs []string) ([]string, error) {
 	ret := _m.Called(userIDs)
@@ -542,6 +572,24 @@ func (_m *TeamStore) GetCommonTeamIDsForTwoUsers(userID string, otherUserID stri
 	return r0, r1
 }
 
+// GetEtagForMembers provides a mock function with given fields: teamID
+func (_m *TeamStore) GetEtagForMembers(teamID string) string {
+	ret := _m.Called(teamID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEtagForMembers")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(teamID)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // GetMany provides a mock function with given fields: ids
 func (_m *TeamStore) GetMany(ids []string) ([]*model.Team, error) {
 	ret := _m.Called(ids)
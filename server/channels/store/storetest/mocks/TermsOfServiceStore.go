@@ -44,6 +44,36 @@ func (_m *TermsOfServiceStore) Get(id string, allowFromCache bool) (*model.Terms
 	return r0, r1
 }
 
+// GetAll provides a mock function with given fields: offset, limit
+func (_m *TermsOfServiceStore) GetAll(offset int, limit int) ([]*model.TermsOfService, error) {
+	ret := _m.Called(offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAll")
+	}
+
+	var r0 []*model.TermsOfService
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, int) ([]*model.TermsOfService, error)); ok {
+		return rf(offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(int, int) []*model.TermsOfService); ok {
+		r0 = rf(offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.TermsOfService)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int) error); ok {
+		r1 = rf(offset, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetLatest provides a mock function with given fields: allowFromCache
 func (_m *TermsOfServiceStore) GetLatest(allowFromCache bool) (*model.TermsOfService, error) {
 	ret := _m.Called(allowFromCache)
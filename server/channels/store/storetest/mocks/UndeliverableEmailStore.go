@@ -0,0 +1,107 @@
+// Code generated by mockery v2.42.2. DO NOT EDIT.
+
+// Regenerate this file using `make store-mocks`.
+
+package mocks
+
+import (
+	model "github.com/mattermost/mattermost/server/public/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// UndeliverableEmailStore is an autogenerated mock type for the UndeliverableEmailStore type
+type UndeliverableEmailStore struct {
+	mock.Mock
+}
+
+// Delete provides a mock function with given fields: email
+func (_m *UndeliverableEmailStore) Delete(email string) error {
+	ret := _m.Called(email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(email)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Get provides a mock function with given fields: email
+func (_m *UndeliverableEmailStore) Get(email string) (*model.UndeliverableEmail, error) {
+	ret := _m.Called(email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *model.UndeliverableEmail
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*model.UndeliverableEmail, error)); ok {
+		return rf(email)
+	}
+	if rf, ok := ret.Get(0).(func(string) *model.UndeliverableEmail); ok {
+		r0 = rf(email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.UndeliverableEmail)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Save provides a mock function with given fields: email
+func (_m *UndeliverableEmailStore) Save(email *model.UndeliverableEmail) (*model.UndeliverableEmail, error) {
+	ret := _m.Called(email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Save")
+	}
+
+	var r0 *model.UndeliverableEmail
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.UndeliverableEmail) (*model.UndeliverableEmail, error)); ok {
+		return rf(email)
+	}
+	if rf, ok := ret.Get(0).(func(*model.UndeliverableEmail) *model.UndeliverableEmail); ok {
+		r0 = rf(email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.UndeliverableEmail)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.UndeliverableEmail) error); ok {
+		r1 = rf(email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewUndeliverableEmailStore creates a new instance of UndeliverableEmailStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUndeliverableEmailStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UndeliverableEmailStore {
+	mock := &UndeliverableEmailStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -170,6 +170,36 @@ func (_m *UserAccessTokenStore) GetByUser(userID string, page int, perPage int)
 	return r0, r1
 }
 
+// GetExpiring provides a mock function with given fields: before, offset, limit
+func (_m *UserAccessTokenStore) GetExpiring(before int64, offset int, limit int) ([]*model.UserAccessToken, error) {
+	ret := _m.Called(before, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetExpiring")
+	}
+
+	var r0 []*model.UserAccessToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64, int, int) ([]*model.UserAccessToken, error)); ok {
+		return rf(before, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(int64, int, int) []*model.UserAccessToken); ok {
+		r0 = rf(before, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.UserAccessToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int64, int, int) error); ok {
+		r1 = rf(before, offset, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Save provides a mock function with given fields: token
 func (_m *UserAccessTokenStore) Save(token *model.UserAccessToken) (*model.UserAccessToken, error) {
 	ret := _m.Called(token)
@@ -230,6 +260,24 @@ func (_m *UserAccessTokenStore) Search(term string) ([]*model.UserAccessToken, e
 	return r0, r1
 }
 
+// UpdateExpiresAt provides a mock function with given fields: tokenID, expiresAt
+func (_m *UserAccessTokenStore) UpdateExpiresAt(tokenID string, expiresAt int64) error {
+	ret := _m.Called(tokenID, expiresAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateExpiresAt")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int64) error); ok {
+		r0 = rf(tokenID, expiresAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateTokenDisable provides a mock function with given fields: tokenID
 func (_m *UserAccessTokenStore) UpdateTokenDisable(tokenID string) error {
 	ret := _m.Called(tokenID)
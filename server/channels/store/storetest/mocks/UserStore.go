@@ -717,6 +717,36 @@ func (_m *UserStore) GetChannelGroupUsers(channelID string) ([]*model.User, erro
 	return r0, r1
 }
 
+// GetDirectReports provides a mock function with given fields: managerID, offset, limit
+func (_m *UserStore) GetDirectReports(managerID string, offset int, limit int) ([]*model.User, error) {
+	ret := _m.Called(managerID, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDirectReports")
+	}
+
+	var r0 []*model.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int, int) ([]*model.User, error)); ok {
+		return rf(managerID, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, int) []*model.User); ok {
+		r0 = rf(managerID, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, int) error); ok {
+		r1 = rf(managerID, offset, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetEtagForAllProfiles provides a mock function with given fields:
 func (_m *UserStore) GetEtagForAllProfiles() string {
 	ret := _m.Called()
@@ -1996,6 +2026,24 @@ func (_m *UserStore) UpdateLastPictureUpdate(userID string) error {
 	return r0
 }
 
+// UpdateManager provides a mock function with given fields: userID, managerID
+func (_m *UserStore) UpdateManager(userID string, managerID string) error {
+	ret := _m.Called(userID, managerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateManager")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(userID, managerID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateMfaActive provides a mock function with given fields: userID, active
 func (_m *UserStore) UpdateMfaActive(userID string, active bool) error {
 	ret := _m.Called(userID, active)
@@ -14,6 +14,34 @@ type UserTermsOfServiceStore struct {
 	mock.Mock
 }
 
+// CountByTermsOfService provides a mock function with given fields: termsOfServiceId, audience
+func (_m *UserTermsOfServiceStore) CountByTermsOfService(termsOfServiceId string, audience string) (int64, error) {
+	ret := _m.Called(termsOfServiceId, audience)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountByTermsOfService")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (int64, error)); ok {
+		return rf(termsOfServiceId, audience)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) int64); ok {
+		r0 = rf(termsOfServiceId, audience)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(termsOfServiceId, audience)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Delete provides a mock function with given fields: userID, termsOfServiceId
 func (_m *UserTermsOfServiceStore) Delete(userID string, termsOfServiceId string) error {
 	ret := _m.Called(userID, termsOfServiceId)
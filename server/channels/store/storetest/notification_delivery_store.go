@@ -0,0 +1,103 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+)
+
+func TestNotificationDeliveryStore(t *testing.T, rctx request.CTX, ss store.Store, s SqlStore) {
+	t.Run("SaveAndGetForUser", func(t *testing.T) { testNotificationDeliverySaveAndGetForUser(t, rctx, ss) })
+	t.Run("Cleanup", func(t *testing.T) { testNotificationDeliveryCleanup(t, rctx, ss) })
+}
+
+func newTestNotificationDelivery(userID string, createAt int64) *model.NotificationDelivery {
+	return &model.NotificationDelivery{
+		UserId:    userID,
+		PostId:    model.NewId(),
+		ChannelId: model.NewId(),
+		Type:      model.NotificationTypePush,
+		Status:    model.NotificationStatusSuccess,
+		CreateAt:  createAt,
+	}
+}
+
+func testNotificationDeliverySaveAndGetForUser(t *testing.T, rctx request.CTX, ss store.Store) {
+	userID := model.NewId()
+	now := model.GetMillis()
+
+	_, err := ss.NotificationDelivery().Save(&model.NotificationDelivery{UserId: userID, Type: "sms", Status: model.NotificationStatusSuccess})
+	require.Error(t, err)
+
+	var saved []*model.NotificationDelivery
+	for i := 0; i < 3; i++ {
+		delivery, err := ss.NotificationDelivery().Save(newTestNotificationDelivery(userID, now+int64(i)))
+		require.NoError(t, err)
+		require.NotEmpty(t, delivery.Id)
+		saved = append(saved, delivery)
+	}
+	failed := newTestNotificationDelivery(userID, now+3)
+	failed.Status = model.NotificationStatusError
+	failed.Reason = model.NotificationReasonPushProxySendError
+	failed.Detail = "device was reported as removed"
+	failed, err = ss.NotificationDelivery().Save(failed)
+	require.NoError(t, err)
+	saved = append(saved, failed)
+
+	_, err = ss.NotificationDelivery().Save(newTestNotificationDelivery(model.NewId(), now))
+	require.NoError(t, err)
+
+	t.Run("most recent first", func(t *testing.T) {
+		deliveries, err := ss.NotificationDelivery().GetForUser(userID, model.NotificationDeliveryGetOptions{PerPage: 10})
+		require.NoError(t, err)
+		require.Len(t, deliveries, 4)
+		assert.Equal(t, failed, deliveries[0])
+		assert.Equal(t, saved[0].Id, deliveries[3].Id)
+	})
+
+	t.Run("paging", func(t *testing.T) {
+		deliveries, err := ss.NotificationDelivery().GetForUser(userID, model.NotificationDeliveryGetOptions{Page: 1, PerPage: 3})
+		require.NoError(t, err)
+		require.Len(t, deliveries, 1)
+		assert.Equal(t, saved[0].Id, deliveries[0].Id)
+	})
+
+	t.Run("since", func(t *testing.T) {
+		deliveries, err := ss.NotificationDelivery().GetForUser(userID, model.NotificationDeliveryGetOptions{Since: now + 1, PerPage: 10})
+		require.NoError(t, err)
+		require.Len(t, deliveries, 2)
+	})
+
+	t.Run("post", func(t *testing.T) {
+		deliveries, err := ss.NotificationDelivery().GetForUser(userID, model.NotificationDeliveryGetOptions{PostId: saved[1].PostId, PerPage: 10})
+		require.NoError(t, err)
+		require.Len(t, deliveries, 1)
+		assert.Equal(t, saved[1].Id, deliveries[0].Id)
+	})
+}
+
+func testNotificationDeliveryCleanup(t *testing.T, rctx request.CTX, ss store.Store) {
+	userID := model.NewId()
+	now := model.GetMillis()
+
+	old, err := ss.NotificationDelivery().Save(newTestNotificationDelivery(userID, now-2*model.DayInMilliseconds))
+	require.NoError(t, err)
+	recent, err := ss.NotificationDelivery().Save(newTestNotificationDelivery(userID, now))
+	require.NoError(t, err)
+
+	require.NoError(t, ss.NotificationDelivery().Cleanup(now-model.DayInMilliseconds, 1))
+
+	deliveries, err := ss.NotificationDelivery().GetForUser(userID, model.NotificationDeliveryGetOptions{PerPage: 10})
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	assert.Equal(t, recent.Id, deliveries[0].Id)
+	assert.NotEqual(t, old.Id, deliveries[0].Id)
+}
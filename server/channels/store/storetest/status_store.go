@@ -67,11 +67,16 @@ func (s ByUserId) Less(i, j int) bool { return s[i].UserId < s[j].UserId }
 
 func testUpdateExpiredDNDStatuses(t *testing.T, rctx request.CTX, ss store.Store) {
 	userID := NewTestId()
+	oooUserID := NewTestId()
 
 	status := &model.Status{UserId: userID, Status: model.StatusDnd, Manual: true,
 		DNDEndTime: time.Now().Add(5 * time.Second).Unix(), PrevStatus: model.StatusOnline}
 	require.NoError(t, ss.Status().SaveOrUpdate(status))
 
+	oooStatus := &model.Status{UserId: oooUserID, Status: model.StatusOutOfOffice, Manual: true,
+		DNDEndTime: time.Now().Add(5 * time.Second).Unix(), PrevStatus: model.StatusAway}
+	require.NoError(t, ss.Status().SaveOrUpdate(oooStatus))
+
 	time.Sleep(2 * time.Second)
 
 	// after 2 seconds no statuses should be expired
@@ -81,15 +86,26 @@ func testUpdateExpiredDNDStatuses(t *testing.T, rctx request.CTX, ss store.Store
 
 	time.Sleep(3 * time.Second)
 
-	// after 3 more seconds test status should be updated
+	// after 3 more seconds test statuses should be updated
 	statuses, err = ss.Status().UpdateExpiredDNDStatuses()
 	require.NoError(t, err)
-	require.Len(t, statuses, 1)
+	require.Len(t, statuses, 2)
+
+	expired := map[string]*model.Status{}
+	for _, s := range statuses {
+		expired[s.UserId] = s
+	}
 
-	updatedStatus := *statuses[0]
+	updatedStatus := *expired[userID]
 	require.Equal(t, updatedStatus.UserId, userID)
 	require.Equal(t, updatedStatus.Status, model.StatusOnline)
 	require.Equal(t, updatedStatus.DNDEndTime, int64(0))
 	require.Equal(t, updatedStatus.PrevStatus, model.StatusDnd)
 	require.Equal(t, updatedStatus.Manual, false)
+
+	updatedStatus = *expired[oooUserID]
+	require.Equal(t, updatedStatus.Status, model.StatusAway)
+	require.Equal(t, updatedStatus.DNDEndTime, int64(0))
+	require.Equal(t, updatedStatus.PrevStatus, model.StatusOutOfOffice)
+	require.Equal(t, updatedStatus.Manual, false)
 }
@@ -65,6 +65,13 @@ type Store struct {
 	PostPersistentNotificationStore mocks.PostPersistentNotificationStore
 	DesktopTokensStore              mocks.DesktopTokensStore
 	ChannelBookmarkStore            mocks.ChannelBookmarkStore
+	FileShareLinkStore              mocks.FileShareLinkStore
+	NotificationDeliveryStore       mocks.NotificationDeliveryStore
+	UndeliverableEmailStore         mocks.UndeliverableEmailStore
+	IntegrationDeliveryStore        mocks.IntegrationDeliveryStore
+	AutomationRuleStore             mocks.AutomationRuleStore
+	CustomProfileAttributeStore     mocks.CustomProfileAttributeStore
+	ConfigHistoryStore              mocks.ConfigHistoryStore
 }
 
 func (s *Store) SetContext(context context.Context)            { s.context = context }
@@ -123,18 +130,31 @@ func (s *Store) PostAcknowledgement() store.PostAcknowledgementStore {
 func (s *Store) PostPersistentNotification() store.PostPersistentNotificationStore {
 	return &s.PostPersistentNotificationStore
 }
-func (s *Store) MarkSystemRanUnitTests()             { /* do nothing */ }
-func (s *Store) Close()                              { /* do nothing */ }
-func (s *Store) LockToMaster()                       { /* do nothing */ }
-func (s *Store) UnlockFromMaster()                   { /* do nothing */ }
-func (s *Store) DropAllTables()                      { /* do nothing */ }
-func (s *Store) GetDbVersion(bool) (string, error)   { return "", nil }
-func (s *Store) GetInternalMasterDB() *sql.DB        { return nil }
-func (s *Store) GetInternalReplicaDB() *sql.DB       { return nil }
-func (s *Store) GetInternalReplicaDBs() []*sql.DB    { return nil }
-func (s *Store) RecycleDBConnections(time.Duration)  {}
-func (s *Store) GetDBSchemaVersion() (int, error)    { return 1, nil }
-func (s *Store) GetLocalSchemaVersion() (int, error) { return 1, nil }
+func (s *Store) FileShareLink() store.FileShareLinkStore { return &s.FileShareLinkStore }
+func (s *Store) NotificationDelivery() store.NotificationDeliveryStore {
+	return &s.NotificationDeliveryStore
+}
+func (s *Store) UndeliverableEmail() store.UndeliverableEmailStore { return &s.UndeliverableEmailStore }
+func (s *Store) IntegrationDelivery() store.IntegrationDeliveryStore {
+	return &s.IntegrationDeliveryStore
+}
+func (s *Store) AutomationRule() store.AutomationRuleStore { return &s.AutomationRuleStore }
+func (s *Store) CustomProfileAttribute() store.CustomProfileAttributeStore {
+	return &s.CustomProfileAttributeStore
+}
+func (s *Store) ConfigHistory() store.ConfigHistoryStore { return &s.ConfigHistoryStore }
+func (s *Store) MarkSystemRanUnitTests()                 { /* do nothing */ }
+func (s *Store) Close()                                  { /* do nothing */ }
+func (s *Store) LockToMaster()                           { /* do nothing */ }
+func (s *Store) UnlockFromMaster()                       { /* do nothing */ }
+func (s *Store) DropAllTables()                          { /* do nothing */ }
+func (s *Store) GetDbVersion(bool) (string, error)       { return "", nil }
+func (s *Store) GetInternalMasterDB() *sql.DB            { return nil }
+func (s *Store) GetInternalReplicaDB() *sql.DB           { return nil }
+func (s *Store) GetInternalReplicaDBs() []*sql.DB        { return nil }
+func (s *Store) RecycleDBConnections(time.Duration)      {}
+func (s *Store) GetDBSchemaVersion() (int, error)        { return 1, nil }
+func (s *Store) GetLocalSchemaVersion() (int, error)     { return 1, nil }
 func (s *Store) GetAppliedMigrations() ([]model.AppliedMigration, error) {
 	return []model.AppliedMigration{}, nil
 }
@@ -188,5 +208,12 @@ func (s *Store) AssertExpectations(t mock.TestingT) bool {
 		&s.PostPersistentNotificationStore,
 		&s.DesktopTokensStore,
 		&s.ChannelBookmarkStore,
+		&s.FileShareLinkStore,
+		&s.NotificationDeliveryStore,
+		&s.UndeliverableEmailStore,
+		&s.IntegrationDeliveryStore,
+		&s.AutomationRuleStore,
+		&s.CustomProfileAttributeStore,
+		&s.ConfigHistoryStore,
 	)
 }
@@ -64,6 +64,7 @@ func TestTeamStore(t *testing.T, rctx request.CTX, ss store.Store) {
 	t.Run("MemberCount", func(t *testing.T) { testTeamStoreMemberCount(t, rctx, ss) })
 	t.Run("GetChannelUnreadsForAllTeams", func(t *testing.T) { testGetChannelUnreadsForAllTeams(t, rctx, ss) })
 	t.Run("GetChannelUnreadsForTeam", func(t *testing.T) { testGetChannelUnreadsForTeam(t, rctx, ss) })
+	t.Run("GetChannelUnreadsSince", func(t *testing.T) { testGetChannelUnreadsSince(t, rctx, ss) })
 	t.Run("UpdateLastTeamIconUpdate", func(t *testing.T) { testUpdateLastTeamIconUpdate(t, rctx, ss) })
 	t.Run("GetTeamsByScheme", func(t *testing.T) { testGetTeamsByScheme(t, rctx, ss) })
 	t.Run("MigrateTeamMembers", func(t *testing.T) { testTeamStoreMigrateTeamMembers(t, rctx, ss) })
@@ -3167,6 +3168,59 @@ func testGetChannelUnreadsForAllTeams(t *testing.T, rctx request.CTX, ss store.S
 	require.NoError(t, nErr)
 }
 
+func testGetChannelUnreadsSince(t *testing.T, rctx request.CTX, ss store.Store) {
+	uid := model.NewId()
+	otherUID := model.NewId()
+	since := model.GetMillis()
+
+	c1 := &model.Channel{TeamId: model.NewId(), Name: model.NewId(), DisplayName: "Unread", Type: model.ChannelTypeOpen, TotalMsgCount: 100, LastPostAt: since + 10}
+	_, nErr := ss.Channel().Save(rctx, c1, -1)
+	require.NoError(t, nErr)
+
+	c2 := &model.Channel{TeamId: model.NewId(), Name: model.NewId(), DisplayName: "Mentioned", Type: model.ChannelTypeOpen, TotalMsgCount: 100, LastPostAt: since + 5}
+	_, nErr = ss.Channel().Save(rctx, c2, -1)
+	require.NoError(t, nErr)
+
+	c3 := &model.Channel{TeamId: model.NewId(), Name: model.NewId(), DisplayName: "Old", Type: model.ChannelTypeOpen, TotalMsgCount: 100, LastPostAt: since - 10}
+	_, nErr = ss.Channel().Save(rctx, c3, -1)
+	require.NoError(t, nErr)
+
+	c4 := &model.Channel{TeamId: model.NewId(), Name: model.NewId(), DisplayName: "Read", Type: model.ChannelTypeOpen, TotalMsgCount: 100, LastPostAt: since + 10}
+	_, nErr = ss.Channel().Save(rctx, c4, -1)
+	require.NoError(t, nErr)
+
+	dm, nErr := ss.Channel().CreateDirectChannel(rctx, &model.User{Id: uid}, &model.User{Id: otherUID})
+	require.NoError(t, nErr)
+	dm.LastPostAt = since + 1
+	dm.TotalMsgCount = 1
+	_, nErr = ss.Channel().Update(rctx, dm)
+	require.NoError(t, nErr)
+
+	for _, cm := range []*model.ChannelMember{
+		{ChannelId: c1.Id, UserId: uid, NotifyProps: model.GetDefaultChannelNotifyProps(), MsgCount: 90},
+		{ChannelId: c2.Id, UserId: uid, NotifyProps: model.GetDefaultChannelNotifyProps(), MsgCount: 99, MentionCount: 1},
+		{ChannelId: c3.Id, UserId: uid, NotifyProps: model.GetDefaultChannelNotifyProps(), MsgCount: 90},
+		{ChannelId: c4.Id, UserId: uid, NotifyProps: model.GetDefaultChannelNotifyProps(), MsgCount: 100, LastViewedAt: since + 10},
+	} {
+		_, err := ss.Channel().SaveMember(rctx, cm)
+		require.NoError(t, err)
+	}
+
+	unreads, err := ss.Team().GetChannelUnreadsSince(uid, since, 10)
+	require.NoError(t, err)
+	require.Len(t, unreads, 3)
+	assert.Equal(t, c2.Id, unreads[0].ChannelId, "channels with mentions should come first")
+	assert.Equal(t, int64(1), unreads[0].MentionCount)
+	assert.Equal(t, c1.Id, unreads[1].ChannelId)
+	assert.Equal(t, int64(10), unreads[1].MsgCount)
+	assert.Equal(t, dm.Id, unreads[2].ChannelId)
+
+	unreads, err = ss.Team().GetChannelUnreadsSince(uid, since, 1)
+	require.NoError(t, err)
+	require.Len(t, unreads, 1)
+	assert.Equal(t, c2.Id, unreads[0].ChannelId)
+}
+
 func testGetChannelUnreadsForTeam(t *testing.T, rctx request.CTX, ss store.Store) {
 	teamId1 := model.NewId()
 
@@ -5,6 +5,7 @@ package storetest
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -18,6 +19,7 @@ func TestTermsOfServiceStore(t *testing.T, rctx request.CTX, ss store.Store) {
 	t.Run("TestSaveTermsOfService", func(t *testing.T) { testSaveTermsOfService(t, rctx, ss) })
 	t.Run("TestGetLatestTermsOfService", func(t *testing.T) { testGetLatestTermsOfService(t, rctx, ss) })
 	t.Run("TestGetTermsOfService", func(t *testing.T) { testGetTermsOfService(t, rctx, ss) })
+	t.Run("TestGetAllTermsOfService", func(t *testing.T) { testGetAllTermsOfService(t, rctx, ss) })
 }
 
 func cleanUpTOS(ss store.Store) {
@@ -91,3 +93,33 @@ func testGetTermsOfService(t *testing.T, rctx request.CTX, ss store.Store) {
 	assert.NoError(t, err)
 	assert.Equal(t, "terms of service", receivedTermsOfService.Text)
 }
+
+func testGetAllTermsOfService(t *testing.T, rctx request.CTX, ss store.Store) {
+	t.Cleanup(func() { cleanUpTOS(ss) })
+
+	u1 := model.User{}
+	u1.Username = model.NewId()
+	u1.Email = MakeEmail()
+	u1.Nickname = model.NewId()
+	_, err := ss.User().Save(rctx, &u1)
+	require.NoError(t, err)
+
+	first, err := ss.TermsOfService().Save(&model.TermsOfService{Text: "first", UserId: u1.Id})
+	require.NoError(t, err)
+	time.Sleep(2 * time.Millisecond)
+	second, err := ss.TermsOfService().Save(&model.TermsOfService{Text: "second", UserId: u1.Id, Audience: model.TermsOfServiceAudienceGuests})
+	require.NoError(t, err)
+	assert.Equal(t, first.Version+1, second.Version)
+
+	versions, err := ss.TermsOfService().GetAll(0, 10)
+	require.NoError(t, err)
+	require.Len(t, versions, 2)
+	assert.Equal(t, second.Id, versions[0].Id)
+	assert.Equal(t, model.TermsOfServiceAudienceGuests, versions[0].Audience)
+	assert.Equal(t, first.Id, versions[1].Id)
+
+	versions, err = ss.TermsOfService().GetAll(1, 10)
+	require.NoError(t, err)
+	require.Len(t, versions, 1)
+	assert.Equal(t, first.Id, versions[0].Id)
+}
@@ -0,0 +1,67 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+)
+
+func TestUndeliverableEmailStore(t *testing.T, rctx request.CTX, ss store.Store, s SqlStore) {
+	t.Run("SaveGetAndDelete", func(t *testing.T) { testUndeliverableEmailSaveGetAndDelete(t, rctx, ss) })
+}
+
+func testUndeliverableEmailSaveGetAndDelete(t *testing.T, rctx request.CTX, ss store.Store) {
+	address := "Bounced-" + model.NewId() + "@example.com"
+
+	_, err := ss.UndeliverableEmail().Get(address)
+	var nfErr *store.ErrNotFound
+	require.ErrorAs(t, err, &nfErr)
+
+	_, err = ss.UndeliverableEmail().Save(&model.UndeliverableEmail{Email: address, Reason: "delay"})
+	require.Error(t, err)
+
+	saved, err := ss.UndeliverableEmail().Save(&model.UndeliverableEmail{
+		Email:    address,
+		Reason:   model.UndeliverableEmailReasonBounce,
+		Detail:   "550 5.1.1 user unknown",
+		CreateAt: 1000,
+	})
+	require.NoError(t, err)
+
+	t.Run("get is case insensitive", func(t *testing.T) {
+		undeliverable, err := ss.UndeliverableEmail().Get(address)
+		require.NoError(t, err)
+		assert.Equal(t, saved, undeliverable)
+	})
+
+	t.Run("save again updates the reason", func(t *testing.T) {
+		_, err := ss.UndeliverableEmail().Save(&model.UndeliverableEmail{
+			Email:    address,
+			Reason:   model.UndeliverableEmailReasonComplaint,
+			CreateAt: 2000,
+		})
+		require.NoError(t, err)
+
+		undeliverable, err := ss.UndeliverableEmail().Get(address)
+		require.NoError(t, err)
+		assert.Equal(t, model.UndeliverableEmailReasonComplaint, undeliverable.Reason)
+		assert.Empty(t, undeliverable.Detail)
+		assert.Equal(t, int64(1000), undeliverable.CreateAt)
+		assert.Equal(t, int64(2000), undeliverable.UpdateAt)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		require.NoError(t, ss.UndeliverableEmail().Delete(address))
+
+		_, err := ss.UndeliverableEmail().Get(address)
+		require.ErrorAs(t, err, &nfErr)
+	})
+}
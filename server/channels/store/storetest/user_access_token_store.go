@@ -17,6 +17,7 @@ func TestUserAccessTokenStore(t *testing.T, rctx request.CTX, ss store.Store) {
 	t.Run("UserAccessTokenSaveGetDelete", func(t *testing.T) { testUserAccessTokenSaveGetDelete(t, rctx, ss) })
 	t.Run("UserAccessTokenDisableEnable", func(t *testing.T) { testUserAccessTokenDisableEnable(t, rctx, ss) })
 	t.Run("UserAccessTokenSearch", func(t *testing.T) { testUserAccessTokenSearch(t, rctx, ss) })
+	t.Run("UserAccessTokenExpiry", func(t *testing.T) { testUserAccessTokenExpiry(t, rctx, ss) })
 }
 
 func testUserAccessTokenSaveGetDelete(t *testing.T, rctx request.CTX, ss store.Store) {
@@ -155,3 +156,49 @@ func testUserAccessTokenSearch(t *testing.T, rctx request.CTX, ss store.Store) {
 	require.NoError(t, nErr)
 	require.Equal(t, 1, len(received), "received incorrect number of tokens after search")
 }
+
+func testUserAccessTokenExpiry(t *testing.T, rctx request.CTX, ss store.Store) {
+	now := model.GetMillis()
+	day := int64(24 * 60 * 60 * 1000)
+
+	newToken := func(expiresAt int64) *model.UserAccessToken {
+		token, err := ss.UserAccessToken().Save(&model.UserAccessToken{
+			Token:       model.NewId(),
+			UserId:      model.NewId(),
+			Description: "testtoken",
+			ExpiresAt:   expiresAt,
+		})
+		require.NoError(t, err)
+		return token
+	}
+
+	soon := newToken(now + day)
+	later := newToken(now + 30*day)
+	never := newToken(0)
+	disabled := newToken(now + day)
+	require.NoError(t, ss.UserAccessToken().UpdateTokenDisable(disabled.Id))
+
+	tokens, err := ss.UserAccessToken().GetExpiring(now+7*day, 0, 100)
+	require.NoError(t, err)
+	ids := make([]string, 0, len(tokens))
+	for _, token := range tokens {
+		ids = append(ids, token.Id)
+	}
+	require.Contains(t, ids, soon.Id)
+	require.NotContains(t, ids, later.Id)
+	require.NotContains(t, ids, never.Id)
+	require.NotContains(t, ids, disabled.Id)
+
+	session, err := ss.Session().Save(rctx, &model.Session{UserId: never.UserId, Token: never.Token})
+	require.NoError(t, err)
+
+	require.NoError(t, ss.UserAccessToken().UpdateExpiresAt(never.Id, now+day))
+
+	result, err := ss.UserAccessToken().Get(never.Id)
+	require.NoError(t, err)
+	require.Equal(t, now+day, result.ExpiresAt)
+
+	session, err = ss.Session().Get(rctx, session.Id)
+	require.NoError(t, err)
+	require.Equal(t, now+day, session.ExpiresAt)
+}
@@ -98,6 +98,8 @@ func TestUserStore(t *testing.T, rctx request.CTX, ss store.Store, s SqlStore) {
 	t.Run("GetKnownUsers", func(t *testing.T) { testGetKnownUsers(t, rctx, ss) })
 	t.Run("GetUsersWithInvalidEmails", func(t *testing.T) { testGetUsersWithInvalidEmails(t, rctx, ss) })
 	t.Run("UpdateLastLogin", func(t *testing.T) { testUpdateLastLogin(t, rctx, ss) })
+	t.Run("UpdateManager", func(t *testing.T) { testUpdateManager(t, rctx, ss) })
+	t.Run("GetDirectReports", func(t *testing.T) { testGetDirectReports(t, rctx, ss) })
 	t.Run("GetUserReport", func(t *testing.T) { testGetUserReport(t, rctx, ss, s) })
 }
 
@@ -674,6 +676,44 @@ func testUserStoreGetAllProfiles(t *testing.T, rctx request.CTX, ss store.Store)
 			sanitized(u7),
 		}, actual)
 	})
+
+	t.Run("filter by custom profile attributes", func(t *testing.T) {
+		fieldID1 := model.NewId()
+		fieldID2 := model.NewId()
+		err := ss.CustomProfileAttribute().UpsertValues([]*model.CustomProfileAttributeValue{
+			{FieldId: fieldID1, UserId: u1.Id, Value: "Berlin"},
+			{FieldId: fieldID2, UserId: u1.Id, Value: "1234"},
+			{FieldId: fieldID1, UserId: u2.Id, Value: "Berlin"},
+			{FieldId: fieldID1, UserId: u4.Id, Value: "Toronto"},
+		})
+		require.NoError(t, err)
+		defer func() {
+			require.NoError(t, ss.CustomProfileAttribute().DeleteValues(u1.Id, []string{fieldID1, fieldID2}))
+			require.NoError(t, ss.CustomProfileAttribute().DeleteValues(u2.Id, []string{fieldID1}))
+			require.NoError(t, ss.CustomProfileAttribute().DeleteValues(u4.Id, []string{fieldID1}))
+		}()
+
+		actual, userErr := ss.User().GetAllProfiles(&model.UserGetOptions{
+			Page:                    0,
+			PerPage:                 10,
+			CustomProfileAttributes: map[string]string{fieldID1: "Berlin"},
+		})
+		require.NoError(t, userErr)
+		require.Equal(t, []*model.User{
+			sanitized(u1),
+			sanitized(u2),
+		}, actual)
+
+		actual, userErr = ss.User().GetAllProfiles(&model.UserGetOptions{
+			Page:                    0,
+			PerPage:                 10,
+			CustomProfileAttributes: map[string]string{fieldID1: "Berlin", fieldID2: "1234"},
+		})
+		require.NoError(t, userErr)
+		require.Equal(t, []*model.User{
+			sanitized(u1),
+		}, actual)
+	})
 }
 
 func testUserStoreGetProfiles(t *testing.T, rctx request.CTX, ss store.Store) {
@@ -6213,6 +6253,76 @@ func testUpdateLastLogin(t *testing.T, rctx request.CTX, ss store.Store) {
 	require.Equal(t, int64(1234567890), user.LastLogin)
 }
 
+func testUpdateManager(t *testing.T, rctx request.CTX, ss store.Store) {
+	manager, err := ss.User().Save(rctx, &model.User{Email: MakeEmail(), Username: "m" + model.NewId()})
+	require.NoError(t, err)
+	defer func() { require.NoError(t, ss.User().PermanentDelete(rctx, manager.Id)) }()
+
+	u1, err := ss.User().Save(rctx, &model.User{Email: MakeEmail(), Username: "u" + model.NewId()})
+	require.NoError(t, err)
+	defer func() { require.NoError(t, ss.User().PermanentDelete(rctx, u1.Id)) }()
+
+	require.NoError(t, ss.User().UpdateManager(u1.Id, manager.Id))
+
+	user, err := ss.User().Get(context.Background(), u1.Id)
+	require.NoError(t, err)
+	require.Equal(t, manager.Id, user.ManagerId)
+
+	t.Run("is preserved by Update", func(t *testing.T) {
+		user.ManagerId = ""
+		user.Nickname = "nick"
+		_, err = ss.User().Update(rctx, user, false)
+		require.NoError(t, err)
+
+		user, err = ss.User().Get(context.Background(), u1.Id)
+		require.NoError(t, err)
+		require.Equal(t, manager.Id, user.ManagerId)
+	})
+
+	t.Run("can be cleared", func(t *testing.T) {
+		require.NoError(t, ss.User().UpdateManager(u1.Id, ""))
+
+		user, err = ss.User().Get(context.Background(), u1.Id)
+		require.NoError(t, err)
+		require.Empty(t, user.ManagerId)
+	})
+}
+
+func testGetDirectReports(t *testing.T, rctx request.CTX, ss store.Store) {
+	manager, err := ss.User().Save(rctx, &model.User{Email: MakeEmail(), Username: "m" + model.NewId()})
+	require.NoError(t, err)
+	defer func() { require.NoError(t, ss.User().PermanentDelete(rctx, manager.Id)) }()
+
+	reports := make([]*model.User, 3)
+	for i, username := range []string{"b", "a", "c"} {
+		reports[i], err = ss.User().Save(rctx, &model.User{Email: MakeEmail(), Username: username + model.NewId()})
+		require.NoError(t, err)
+		defer func(id string) { require.NoError(t, ss.User().PermanentDelete(rctx, id)) }(reports[i].Id)
+		require.NoError(t, ss.User().UpdateManager(reports[i].Id, manager.Id))
+	}
+
+	reports[2].DeleteAt = model.GetMillis()
+	_, err = ss.User().Update(rctx, reports[2], true)
+	require.NoError(t, err)
+
+	users, err := ss.User().GetDirectReports(manager.Id, 0, 100)
+	require.NoError(t, err)
+	require.Len(t, users, 2)
+	assert.Equal(t, reports[1].Id, users[0].Id)
+	assert.Equal(t, reports[0].Id, users[1].Id)
+	assert.Equal(t, manager.Id, users[0].ManagerId)
+	assert.Empty(t, users[0].Password)
+
+	users, err = ss.User().GetDirectReports(manager.Id, 1, 1)
+	require.NoError(t, err)
+	require.Len(t, users, 1)
+	assert.Equal(t, reports[0].Id, users[0].Id)
+
+	users, err = ss.User().GetDirectReports(reports[0].Id, 0, 100)
+	require.NoError(t, err)
+	assert.Empty(t, users)
+}
+
 func testGetUserReport(t *testing.T, rctx request.CTX, ss store.Store, s SqlStore) {
 	numRegularUsers := 90
 	numSysAdmins := 10
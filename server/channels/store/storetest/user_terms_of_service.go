@@ -19,6 +19,7 @@ func TestUserTermsOfServiceStore(t *testing.T, rctx request.CTX, ss store.Store)
 	t.Run("TestSaveUserTermsOfService", func(t *testing.T) { testSaveUserTermsOfService(t, rctx, ss) })
 	t.Run("TestGetByUserTermsOfService", func(t *testing.T) { testGetByUserTermsOfService(t, rctx, ss) })
 	t.Run("TestDeleteUserTermsOfService", func(t *testing.T) { testDeleteUserTermsOfService(t, rctx, ss) })
+	t.Run("TestCountByTermsOfService", func(t *testing.T) { testCountByTermsOfService(t, rctx, ss) })
 }
 
 func testSaveUserTermsOfService(t *testing.T, rctx request.CTX, ss store.Store) {
@@ -82,3 +83,35 @@ func testDeleteUserTermsOfService(t *testing.T, rctx request.CTX, ss store.Store
 	assert.Error(t, err)
 	assert.True(t, errors.As(err, &nfErr))
 }
+
+func testCountByTermsOfService(t *testing.T, rctx request.CTX, ss store.Store) {
+	termsOfServiceId := model.NewId()
+
+	member, err := ss.User().Save(rctx, &model.User{Email: MakeEmail(), Username: model.NewId(), Roles: model.SystemUserRoleId})
+	require.NoError(t, err)
+	guest, err := ss.User().Save(rctx, &model.User{Email: MakeEmail(), Username: model.NewId(), Roles: model.SystemGuestRoleId})
+	require.NoError(t, err)
+	deleted, err := ss.User().Save(rctx, &model.User{Email: MakeEmail(), Username: model.NewId(), DeleteAt: model.GetMillis()})
+	require.NoError(t, err)
+
+	for _, user := range []*model.User{member, guest, deleted} {
+		_, err = ss.UserTermsOfService().Save(&model.UserTermsOfService{UserId: user.Id, TermsOfServiceId: termsOfServiceId})
+		require.NoError(t, err)
+	}
+
+	count, err := ss.UserTermsOfService().CountByTermsOfService(termsOfServiceId, model.TermsOfServiceAudienceAll)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+
+	count, err = ss.UserTermsOfService().CountByTermsOfService(termsOfServiceId, model.TermsOfServiceAudienceGuests)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	count, err = ss.UserTermsOfService().CountByTermsOfService(termsOfServiceId, model.TermsOfServiceAudienceMembers)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	count, err = ss.UserTermsOfService().CountByTermsOfService(model.NewId(), model.TermsOfServiceAudienceAll)
+	require.NoError(t, err)
+	assert.Zero(t, count)
+}
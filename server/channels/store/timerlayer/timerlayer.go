@@ -20,6 +20,7 @@ type TimerLayer struct {
 	store.Store
 	Metrics                         einterfaces.MetricsInterface
 	AuditStore                      store.AuditStore
+	AutomationRuleStore             store.AutomationRuleStore
 	BotStore                        store.BotStore
 	ChannelStore                    store.ChannelStore
 	ChannelBookmarkStore            store.ChannelBookmarkStore
@@ -28,14 +29,19 @@ type TimerLayer struct {
 	CommandStore                    store.CommandStore
 	CommandWebhookStore             store.CommandWebhookStore
 	ComplianceStore                 store.ComplianceStore
+	ConfigHistoryStore              store.ConfigHistoryStore
+	CustomProfileAttributeStore     store.CustomProfileAttributeStore
 	DesktopTokensStore              store.DesktopTokensStore
 	DraftStore                      store.DraftStore
 	EmojiStore                      store.EmojiStore
 	FileInfoStore                   store.FileInfoStore
+	FileShareLinkStore              store.FileShareLinkStore
 	GroupStore                      store.GroupStore
+	IntegrationDeliveryStore        store.IntegrationDeliveryStore
 	JobStore                        store.JobStore
 	LicenseStore                    store.LicenseStore
 	LinkMetadataStore               store.LinkMetadataStore
+	NotificationDeliveryStore       store.NotificationDeliveryStore
 	NotifyAdminStore                store.NotifyAdminStore
 	OAuthStore                      store.OAuthStore
 	OutgoingOAuthConnectionStore    store.OutgoingOAuthConnectionStore
@@ -59,6 +65,7 @@ type TimerLayer struct {
 	TermsOfServiceStore             store.TermsOfServiceStore
 	ThreadStore                     store.ThreadStore
 	TokenStore                      store.TokenStore
+	UndeliverableEmailStore         store.UndeliverableEmailStore
 	UploadSessionStore              store.UploadSessionStore
 	UserStore                       store.UserStore
 	UserAccessTokenStore            store.UserAccessTokenStore
@@ -70,6 +77,10 @@ func (s *TimerLayer) Audit() store.AuditStore {
 	return s.AuditStore
 }
 
+func (s *TimerLayer) AutomationRule() store.AutomationRuleStore {
+	return s.AutomationRuleStore
+}
+
 func (s *TimerLayer) Bot() store.BotStore {
 	return s.BotStore
 }
@@ -102,6 +113,14 @@ func (s *TimerLayer) Compliance() store.ComplianceStore {
 	return s.ComplianceStore
 }
 
+func (s *TimerLayer) ConfigHistory() store.ConfigHistoryStore {
+	return s.ConfigHistoryStore
+}
+
+func (s *TimerLayer) CustomProfileAttribute() store.CustomProfileAttributeStore {
+	return s.CustomProfileAttributeStore
+}
+
 func (s *TimerLayer) DesktopTokens() store.DesktopTokensStore {
 	return s.DesktopTokensStore
 }
@@ -118,10 +137,18 @@ func (s *TimerLayer) FileInfo() store.FileInfoStore {
 	return s.FileInfoStore
 }
 
+func (s *TimerLayer) FileShareLink() store.FileShareLinkStore {
+	return s.FileShareLinkStore
+}
+
 func (s *TimerLayer) Group() store.GroupStore {
 	return s.GroupStore
 }
 
+func (s *TimerLayer) IntegrationDelivery() store.IntegrationDeliveryStore {
+	return s.IntegrationDeliveryStore
+}
+
 func (s *TimerLayer) Job() store.JobStore {
 	return s.JobStore
 }
@@ -134,6 +161,10 @@ func (s *TimerLayer) LinkMetadata() store.LinkMetadataStore {
 	return s.LinkMetadataStore
 }
 
+func (s *TimerLayer) NotificationDelivery() store.NotificationDeliveryStore {
+	return s.NotificationDeliveryStore
+}
+
 func (s *TimerLayer) NotifyAdmin() store.NotifyAdminStore {
 	return s.NotifyAdminStore
 }
@@ -226,6 +257,10 @@ func (s *TimerLayer) Token() store.TokenStore {
 	return s.TokenStore
 }
 
+func (s *TimerLayer) UndeliverableEmail() store.UndeliverableEmailStore {
+	return s.UndeliverableEmailStore
+}
+
 func (s *TimerLayer) UploadSession() store.UploadSessionStore {
 	return s.UploadSessionStore
 }
@@ -251,6 +286,11 @@ type TimerLayerAuditStore struct {
 	Root *TimerLayer
 }
 
+type TimerLayerAutomationRuleStore struct {
+	store.AutomationRuleStore
+	Root *TimerLayer
+}
+
 type TimerLayerBotStore struct {
 	store.BotStore
 	Root *TimerLayer
@@ -291,6 +331,16 @@ type TimerLayerComplianceStore struct {
 	Root *TimerLayer
 }
 
+type TimerLayerConfigHistoryStore struct {
+	store.ConfigHistoryStore
+	Root *TimerLayer
+}
+
+type TimerLayerCustomProfileAttributeStore struct {
+	store.CustomProfileAttributeStore
+	Root *TimerLayer
+}
+
 type TimerLayerDesktopTokensStore struct {
 	store.DesktopTokensStore
 	Root *TimerLayer
@@ -311,11 +361,21 @@ type TimerLayerFileInfoStore struct {
 	Root *TimerLayer
 }
 
+type TimerLayerFileShareLinkStore struct {
+	store.FileShareLinkStore
+	Root *TimerLayer
+}
+
 type TimerLayerGroupStore struct {
 	store.GroupStore
 	Root *TimerLayer
 }
 
+type TimerLayerIntegrationDeliveryStore struct {
+	store.IntegrationDeliveryStore
+	Root *TimerLayer
+}
+
 type TimerLayerJobStore struct {
 	store.JobStore
 	Root *TimerLayer
@@ -331,6 +391,11 @@ type TimerLayerLinkMetadataStore struct {
 	Root *TimerLayer
 }
 
+type TimerLayerNotificationDeliveryStore struct {
+	store.NotificationDeliveryStore
+	Root *TimerLayer
+}
+
 type TimerLayerNotifyAdminStore struct {
 	store.NotifyAdminStore
 	Root *TimerLayer
@@ -446,6 +511,11 @@ type TimerLayerTokenStore struct {
 	Root *TimerLayer
 }
 
+type TimerLayerUndeliverableEmailStore struct {
+	store.UndeliverableEmailStore
+	Root *TimerLayer
+}
+
 type TimerLayerUploadSessionStore struct {
 	store.UploadSessionStore
 	Root *TimerLayer
@@ -519,6 +589,102 @@ func (s *TimerLayerAuditStore) Save(audit *model.Audit) error {
 	return err
 }
 
+func (s *TimerLayerAutomationRuleStore) Delete(id string, deleteAt int64) error {
+	start := time.Now()
+
+	err := s.AutomationRuleStore.Delete(id, deleteAt)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("AutomationRuleStore.Delete", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerAutomationRuleStore) Get(id string) (*model.AutomationRule, error) {
+	start := time.Now()
+
+	result, err := s.AutomationRuleStore.Get(id)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("AutomationRuleStore.Get", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerAutomationRuleStore) GetEnabledForTrigger(teamID string, triggerType string) ([]*model.AutomationRule, error) {
+	start := time.Now()
+
+	result, err := s.AutomationRuleStore.GetEnabledForTrigger(teamID, triggerType)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("AutomationRuleStore.GetEnabledForTrigger", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerAutomationRuleStore) GetForTeam(teamID string, page int, perPage int) ([]*model.AutomationRule, error) {
+	start := time.Now()
+
+	result, err := s.AutomationRuleStore.GetForTeam(teamID, page, perPage)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("AutomationRuleStore.GetForTeam", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerAutomationRuleStore) Save(rule *model.AutomationRule) (*model.AutomationRule, error) {
+	start := time.Now()
+
+	result, err := s.AutomationRuleStore.Save(rule)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("AutomationRuleStore.Save", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerAutomationRuleStore) Update(rule *model.AutomationRule) (*model.AutomationRule, error) {
+	start := time.Now()
+
+	result, err := s.AutomationRuleStore.Update(rule)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("AutomationRuleStore.Update", success, elapsed)
+	}
+	return result, err
+}
+
 func (s *TimerLayerBotStore) Get(userID string, includeDeleted bool) (*model.Bot, error) {
 	start := time.Now()
 
@@ -1317,6 +1483,22 @@ func (s *TimerLayerChannelStore) GetDeletedByName(team_id string, name string) (
 	return result, err
 }
 
+func (s *TimerLayerChannelStore) GetEtagForUserChannels(userID string, teamID string) string {
+	start := time.Now()
+
+	result := s.ChannelStore.GetEtagForUserChannels(userID, teamID)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if true {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetEtagForUserChannels", success, elapsed)
+	}
+	return result
+}
+
 func (s *TimerLayerChannelStore) GetFileCount(channelID string) (int64, error) {
 	start := time.Now()
 
@@ -1493,6 +1675,22 @@ func (s *TimerLayerChannelStore) GetMembers(channelID string, offset int, limit
 	return result, err
 }
 
+func (s *TimerLayerChannelStore) GetMembersAfter(channelID string, opts *model.ChannelMembersGetOptions) (model.ChannelMembers, error) {
+	start := time.Now()
+
+	result, err := s.ChannelStore.GetMembersAfter(channelID, opts)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetMembersAfter", success, elapsed)
+	}
+	return result, err
+}
+
 func (s *TimerLayerChannelStore) GetMembersByChannelIds(channelIds []string, userID string) (model.ChannelMembers, error) {
 	start := time.Now()
 
@@ -1733,6 +1931,22 @@ func (s *TimerLayerChannelStore) GetSidebarCategoryOrder(userID string, teamID s
 	return result, err
 }
 
+func (s *TimerLayerChannelStore) GetSidebarCategoryUnreads(userID string) ([]*model.SidebarCategoryUnread, error) {
+	start := time.Now()
+
+	result, err := s.ChannelStore.GetSidebarCategoryUnreads(userID)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelStore.GetSidebarCategoryUnreads", success, elapsed)
+	}
+	return result, err
+}
+
 func (s *TimerLayerChannelStore) GetTeamChannels(teamID string) (model.ChannelList, error) {
 	start := time.Now()
 
@@ -3021,6 +3235,22 @@ func (s *TimerLayerComplianceStore) ComplianceExport(compliance *model.Complianc
 	return result, resultVar1, err
 }
 
+func (s *TimerLayerComplianceStore) ComplianceExportIncremental(compliance *model.Compliance, cursor model.ComplianceIncrementalExportCursor, limit int) ([]*model.CompliancePost, model.ComplianceIncrementalExportCursor, error) {
+	start := time.Now()
+
+	result, resultVar1, err := s.ComplianceStore.ComplianceExportIncremental(compliance, cursor, limit)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ComplianceStore.ComplianceExportIncremental", success, elapsed)
+	}
+	return result, resultVar1, err
+}
+
 func (s *TimerLayerComplianceStore) Get(id string) (*model.Compliance, error) {
 	start := time.Now()
 
@@ -3053,6 +3283,22 @@ func (s *TimerLayerComplianceStore) GetAll(offset int, limit int) (model.Complia
 	return result, err
 }
 
+func (s *TimerLayerComplianceStore) GetLastSuccessfulIncremental() (*model.Compliance, error) {
+	start := time.Now()
+
+	result, err := s.ComplianceStore.GetLastSuccessfulIncremental()
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ComplianceStore.GetLastSuccessfulIncremental", success, elapsed)
+	}
+	return result, err
+}
+
 func (s *TimerLayerComplianceStore) MessageExport(c request.CTX, cursor model.MessageExportCursor, limit int) ([]*model.MessageExport, model.MessageExportCursor, error) {
 	start := time.Now()
 
@@ -3101,10 +3347,10 @@ func (s *TimerLayerComplianceStore) Update(compliance *model.Compliance) (*model
 	return result, err
 }
 
-func (s *TimerLayerDesktopTokensStore) Delete(token string) error {
+func (s *TimerLayerConfigHistoryStore) GetAll(offset int, limit int) ([]*model.ConfigHistory, error) {
 	start := time.Now()
 
-	err := s.DesktopTokensStore.Delete(token)
+	result, err := s.ConfigHistoryStore.GetAll(offset, limit)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -3112,15 +3358,15 @@ func (s *TimerLayerDesktopTokensStore) Delete(token string) error {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("DesktopTokensStore.Delete", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ConfigHistoryStore.GetAll", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerDesktopTokensStore) DeleteByUserId(userId string) error {
+func (s *TimerLayerConfigHistoryStore) GetByVersion(version int64) (*model.ConfigHistory, error) {
 	start := time.Now()
 
-	err := s.DesktopTokensStore.DeleteByUserId(userId)
+	result, err := s.ConfigHistoryStore.GetByVersion(version)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -3128,15 +3374,15 @@ func (s *TimerLayerDesktopTokensStore) DeleteByUserId(userId string) error {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("DesktopTokensStore.DeleteByUserId", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ConfigHistoryStore.GetByVersion", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerDesktopTokensStore) DeleteOlderThan(minCreatedAt int64) error {
+func (s *TimerLayerConfigHistoryStore) Save(history *model.ConfigHistory) (*model.ConfigHistory, error) {
 	start := time.Now()
 
-	err := s.DesktopTokensStore.DeleteOlderThan(minCreatedAt)
+	result, err := s.ConfigHistoryStore.Save(history)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -3144,15 +3390,15 @@ func (s *TimerLayerDesktopTokensStore) DeleteOlderThan(minCreatedAt int64) error
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("DesktopTokensStore.DeleteOlderThan", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("ConfigHistoryStore.Save", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerDesktopTokensStore) GetUserId(token string, minCreatedAt int64) (*string, error) {
+func (s *TimerLayerCustomProfileAttributeStore) DeleteField(id string, deleteAt int64) error {
 	start := time.Now()
 
-	result, err := s.DesktopTokensStore.GetUserId(token, minCreatedAt)
+	err := s.CustomProfileAttributeStore.DeleteField(id, deleteAt)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -3160,15 +3406,15 @@ func (s *TimerLayerDesktopTokensStore) GetUserId(token string, minCreatedAt int6
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("DesktopTokensStore.GetUserId", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("CustomProfileAttributeStore.DeleteField", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerDesktopTokensStore) Insert(token string, createAt int64, userId string) error {
+func (s *TimerLayerCustomProfileAttributeStore) DeleteValues(userID string, fieldIDs []string) error {
 	start := time.Now()
 
-	err := s.DesktopTokensStore.Insert(token, createAt, userId)
+	err := s.CustomProfileAttributeStore.DeleteValues(userID, fieldIDs)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -3176,15 +3422,15 @@ func (s *TimerLayerDesktopTokensStore) Insert(token string, createAt int64, user
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("DesktopTokensStore.Insert", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("CustomProfileAttributeStore.DeleteValues", success, elapsed)
 	}
 	return err
 }
 
-func (s *TimerLayerDraftStore) Delete(userID string, channelID string, rootID string) error {
+func (s *TimerLayerCustomProfileAttributeStore) GetField(id string) (*model.CustomProfileAttributeField, error) {
 	start := time.Now()
 
-	err := s.DraftStore.Delete(userID, channelID, rootID)
+	result, err := s.CustomProfileAttributeStore.GetField(id)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -3192,15 +3438,15 @@ func (s *TimerLayerDraftStore) Delete(userID string, channelID string, rootID st
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("DraftStore.Delete", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("CustomProfileAttributeStore.GetField", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerDraftStore) DeleteDraftsAssociatedWithPost(channelID string, rootID string) error {
+func (s *TimerLayerCustomProfileAttributeStore) GetFields() ([]*model.CustomProfileAttributeField, error) {
 	start := time.Now()
 
-	err := s.DraftStore.DeleteDraftsAssociatedWithPost(channelID, rootID)
+	result, err := s.CustomProfileAttributeStore.GetFields()
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -3208,15 +3454,15 @@ func (s *TimerLayerDraftStore) DeleteDraftsAssociatedWithPost(channelID string,
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("DraftStore.DeleteDraftsAssociatedWithPost", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("CustomProfileAttributeStore.GetFields", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerDraftStore) DeleteEmptyDraftsByCreateAtAndUserId(createAt int64, userId string) error {
+func (s *TimerLayerCustomProfileAttributeStore) GetValuesForUsers(userIDs []string) ([]*model.CustomProfileAttributeValue, error) {
 	start := time.Now()
 
-	err := s.DraftStore.DeleteEmptyDraftsByCreateAtAndUserId(createAt, userId)
+	result, err := s.CustomProfileAttributeStore.GetValuesForUsers(userIDs)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -3224,15 +3470,15 @@ func (s *TimerLayerDraftStore) DeleteEmptyDraftsByCreateAtAndUserId(createAt int
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("DraftStore.DeleteEmptyDraftsByCreateAtAndUserId", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("CustomProfileAttributeStore.GetValuesForUsers", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerDraftStore) DeleteOrphanDraftsByCreateAtAndUserId(createAt int64, userId string) error {
+func (s *TimerLayerCustomProfileAttributeStore) PermanentDeleteValuesByUser(userID string) error {
 	start := time.Now()
 
-	err := s.DraftStore.DeleteOrphanDraftsByCreateAtAndUserId(createAt, userId)
+	err := s.CustomProfileAttributeStore.PermanentDeleteValuesByUser(userID)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -3240,15 +3486,15 @@ func (s *TimerLayerDraftStore) DeleteOrphanDraftsByCreateAtAndUserId(createAt in
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("DraftStore.DeleteOrphanDraftsByCreateAtAndUserId", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("CustomProfileAttributeStore.PermanentDeleteValuesByUser", success, elapsed)
 	}
 	return err
 }
 
-func (s *TimerLayerDraftStore) Get(userID string, channelID string, rootID string, includeDeleted bool) (*model.Draft, error) {
+func (s *TimerLayerCustomProfileAttributeStore) SaveField(field *model.CustomProfileAttributeField) (*model.CustomProfileAttributeField, error) {
 	start := time.Now()
 
-	result, err := s.DraftStore.Get(userID, channelID, rootID, includeDeleted)
+	result, err := s.CustomProfileAttributeStore.SaveField(field)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -3256,15 +3502,15 @@ func (s *TimerLayerDraftStore) Get(userID string, channelID string, rootID strin
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("DraftStore.Get", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("CustomProfileAttributeStore.SaveField", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerDraftStore) GetDraftsForUser(userID string, teamID string) ([]*model.Draft, error) {
+func (s *TimerLayerCustomProfileAttributeStore) UpdateField(field *model.CustomProfileAttributeField) (*model.CustomProfileAttributeField, error) {
 	start := time.Now()
 
-	result, err := s.DraftStore.GetDraftsForUser(userID, teamID)
+	result, err := s.CustomProfileAttributeStore.UpdateField(field)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -3272,15 +3518,15 @@ func (s *TimerLayerDraftStore) GetDraftsForUser(userID string, teamID string) ([
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("DraftStore.GetDraftsForUser", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("CustomProfileAttributeStore.UpdateField", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerDraftStore) GetLastCreateAtAndUserIdValuesForEmptyDraftsMigration(createAt int64, userId string) (int64, string, error) {
+func (s *TimerLayerCustomProfileAttributeStore) UpsertValues(values []*model.CustomProfileAttributeValue) error {
 	start := time.Now()
 
-	result, resultVar1, err := s.DraftStore.GetLastCreateAtAndUserIdValuesForEmptyDraftsMigration(createAt, userId)
+	err := s.CustomProfileAttributeStore.UpsertValues(values)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -3288,15 +3534,15 @@ func (s *TimerLayerDraftStore) GetLastCreateAtAndUserIdValuesForEmptyDraftsMigra
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("DraftStore.GetLastCreateAtAndUserIdValuesForEmptyDraftsMigration", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("CustomProfileAttributeStore.UpsertValues", success, elapsed)
 	}
-	return result, resultVar1, err
+	return err
 }
 
-func (s *TimerLayerDraftStore) Upsert(d *model.Draft) (*model.Draft, error) {
+func (s *TimerLayerDesktopTokensStore) Delete(token string) error {
 	start := time.Now()
 
-	result, err := s.DraftStore.Upsert(d)
+	err := s.DesktopTokensStore.Delete(token)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -3304,15 +3550,15 @@ func (s *TimerLayerDraftStore) Upsert(d *model.Draft) (*model.Draft, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("DraftStore.Upsert", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("DesktopTokensStore.Delete", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerEmojiStore) Delete(emoji *model.Emoji, timestamp int64) error {
+func (s *TimerLayerDesktopTokensStore) DeleteByUserId(userId string) error {
 	start := time.Now()
 
-	err := s.EmojiStore.Delete(emoji, timestamp)
+	err := s.DesktopTokensStore.DeleteByUserId(userId)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -3320,15 +3566,15 @@ func (s *TimerLayerEmojiStore) Delete(emoji *model.Emoji, timestamp int64) error
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("EmojiStore.Delete", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("DesktopTokensStore.DeleteByUserId", success, elapsed)
 	}
 	return err
 }
 
-func (s *TimerLayerEmojiStore) Get(c request.CTX, id string, allowFromCache bool) (*model.Emoji, error) {
+func (s *TimerLayerDesktopTokensStore) DeleteOlderThan(minCreatedAt int64) error {
 	start := time.Now()
 
-	result, err := s.EmojiStore.Get(c, id, allowFromCache)
+	err := s.DesktopTokensStore.DeleteOlderThan(minCreatedAt)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -3336,15 +3582,207 @@ func (s *TimerLayerEmojiStore) Get(c request.CTX, id string, allowFromCache bool
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("EmojiStore.Get", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("DesktopTokensStore.DeleteOlderThan", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerEmojiStore) GetByName(c request.CTX, name string, allowFromCache bool) (*model.Emoji, error) {
+func (s *TimerLayerDesktopTokensStore) GetUserId(token string, minCreatedAt int64) (*string, error) {
 	start := time.Now()
 
-	result, err := s.EmojiStore.GetByName(c, name, allowFromCache)
+	result, err := s.DesktopTokensStore.GetUserId(token, minCreatedAt)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("DesktopTokensStore.GetUserId", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerDesktopTokensStore) Insert(token string, createAt int64, userId string) error {
+	start := time.Now()
+
+	err := s.DesktopTokensStore.Insert(token, createAt, userId)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("DesktopTokensStore.Insert", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerDraftStore) Delete(userID string, channelID string, rootID string) error {
+	start := time.Now()
+
+	err := s.DraftStore.Delete(userID, channelID, rootID)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("DraftStore.Delete", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerDraftStore) DeleteDraftsAssociatedWithPost(channelID string, rootID string) error {
+	start := time.Now()
+
+	err := s.DraftStore.DeleteDraftsAssociatedWithPost(channelID, rootID)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("DraftStore.DeleteDraftsAssociatedWithPost", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerDraftStore) DeleteEmptyDraftsByCreateAtAndUserId(createAt int64, userId string) error {
+	start := time.Now()
+
+	err := s.DraftStore.DeleteEmptyDraftsByCreateAtAndUserId(createAt, userId)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("DraftStore.DeleteEmptyDraftsByCreateAtAndUserId", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerDraftStore) DeleteOrphanDraftsByCreateAtAndUserId(createAt int64, userId string) error {
+	start := time.Now()
+
+	err := s.DraftStore.DeleteOrphanDraftsByCreateAtAndUserId(createAt, userId)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("DraftStore.DeleteOrphanDraftsByCreateAtAndUserId", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerDraftStore) Get(userID string, channelID string, rootID string, includeDeleted bool) (*model.Draft, error) {
+	start := time.Now()
+
+	result, err := s.DraftStore.Get(userID, channelID, rootID, includeDeleted)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("DraftStore.Get", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerDraftStore) GetDraftsForUser(userID string, teamID string) ([]*model.Draft, error) {
+	start := time.Now()
+
+	result, err := s.DraftStore.GetDraftsForUser(userID, teamID)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("DraftStore.GetDraftsForUser", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerDraftStore) GetLastCreateAtAndUserIdValuesForEmptyDraftsMigration(createAt int64, userId string) (int64, string, error) {
+	start := time.Now()
+
+	result, resultVar1, err := s.DraftStore.GetLastCreateAtAndUserIdValuesForEmptyDraftsMigration(createAt, userId)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("DraftStore.GetLastCreateAtAndUserIdValuesForEmptyDraftsMigration", success, elapsed)
+	}
+	return result, resultVar1, err
+}
+
+func (s *TimerLayerDraftStore) Upsert(d *model.Draft) (*model.Draft, error) {
+	start := time.Now()
+
+	result, err := s.DraftStore.Upsert(d)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("DraftStore.Upsert", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerEmojiStore) Delete(emoji *model.Emoji, timestamp int64) error {
+	start := time.Now()
+
+	err := s.EmojiStore.Delete(emoji, timestamp)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("EmojiStore.Delete", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerEmojiStore) Get(c request.CTX, id string, allowFromCache bool) (*model.Emoji, error) {
+	start := time.Now()
+
+	result, err := s.EmojiStore.Get(c, id, allowFromCache)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("EmojiStore.Get", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerEmojiStore) GetByName(c request.CTX, name string, allowFromCache bool) (*model.Emoji, error) {
+	start := time.Now()
+
+	result, err := s.EmojiStore.GetByName(c, name, allowFromCache)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -3468,6 +3906,22 @@ func (s *TimerLayerFileInfoStore) CountAll() (int64, error) {
 	return result, err
 }
 
+func (s *TimerLayerFileInfoStore) CountByPath(path string) (int64, error) {
+	start := time.Now()
+
+	result, err := s.FileInfoStore.CountByPath(path)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.CountByPath", success, elapsed)
+	}
+	return result, err
+}
+
 func (s *TimerLayerFileInfoStore) DeleteForPost(c request.CTX, postID string) (string, error) {
 	start := time.Now()
 
@@ -3500,6 +3954,38 @@ func (s *TimerLayerFileInfoStore) Get(id string) (*model.FileInfo, error) {
 	return result, err
 }
 
+func (s *TimerLayerFileInfoStore) GetBatchWithoutContentHash(startTime int64, startFileID string, limit int) ([]*model.FileInfo, error) {
+	start := time.Now()
+
+	result, err := s.FileInfoStore.GetBatchWithoutContentHash(startTime, startFileID, limit)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.GetBatchWithoutContentHash", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerFileInfoStore) GetByContentHash(hash string, size int64) (*model.FileInfo, error) {
+	start := time.Now()
+
+	result, err := s.FileInfoStore.GetByContentHash(hash, size)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.GetByContentHash", success, elapsed)
+	}
+	return result, err
+}
+
 func (s *TimerLayerFileInfoStore) GetByIds(ids []string) ([]*model.FileInfo, error) {
 	start := time.Now()
 
@@ -3755,6 +4241,22 @@ func (s *TimerLayerFileInfoStore) SetContent(ctx request.CTX, fileID string, con
 	return err
 }
 
+func (s *TimerLayerFileInfoStore) SetContentHash(ctx request.CTX, fileID string, hash string) error {
+	start := time.Now()
+
+	err := s.FileInfoStore.SetContentHash(ctx, fileID, hash)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.SetContentHash", success, elapsed)
+	}
+	return err
+}
+
 func (s *TimerLayerFileInfoStore) Upsert(rctx request.CTX, info *model.FileInfo) (*model.FileInfo, error) {
 	start := time.Now()
 
@@ -3771,6 +4273,86 @@ func (s *TimerLayerFileInfoStore) Upsert(rctx request.CTX, info *model.FileInfo)
 	return result, err
 }
 
+func (s *TimerLayerFileShareLinkStore) Get(id string) (*model.FileShareLink, error) {
+	start := time.Now()
+
+	result, err := s.FileShareLinkStore.Get(id)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("FileShareLinkStore.Get", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerFileShareLinkStore) GetForFile(fileID string) ([]*model.FileShareLink, error) {
+	start := time.Now()
+
+	result, err := s.FileShareLinkStore.GetForFile(fileID)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("FileShareLinkStore.GetForFile", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerFileShareLinkStore) IncrementDownloadCount(id string, now int64) error {
+	start := time.Now()
+
+	err := s.FileShareLinkStore.IncrementDownloadCount(id, now)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("FileShareLinkStore.IncrementDownloadCount", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerFileShareLinkStore) Revoke(id string, deleteAt int64) error {
+	start := time.Now()
+
+	err := s.FileShareLinkStore.Revoke(id, deleteAt)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("FileShareLinkStore.Revoke", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerFileShareLinkStore) Save(link *model.FileShareLink) (*model.FileShareLink, error) {
+	start := time.Now()
+
+	result, err := s.FileShareLinkStore.Save(link)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("FileShareLinkStore.Save", success, elapsed)
+	}
+	return result, err
+}
+
 func (s *TimerLayerGroupStore) AdminRoleGroupsForSyncableMember(userID string, syncableID string, syncableType model.GroupSyncableType) ([]string, error) {
 	start := time.Now()
 
@@ -4438,15 +5020,127 @@ func (s *TimerLayerGroupStore) GroupCountWithAllowReference() (int64, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GroupCountWithAllowReference", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GroupCountWithAllowReference", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerGroupStore) GroupMemberCount() (int64, error) {
+	start := time.Now()
+
+	result, err := s.GroupStore.GroupMemberCount()
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GroupMemberCount", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerGroupStore) GroupTeamCount() (int64, error) {
+	start := time.Now()
+
+	result, err := s.GroupStore.GroupTeamCount()
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GroupTeamCount", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerGroupStore) PermanentDeleteMembersByUser(userID string) error {
+	start := time.Now()
+
+	err := s.GroupStore.PermanentDeleteMembersByUser(userID)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.PermanentDeleteMembersByUser", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerGroupStore) PermittedSyncableAdmins(syncableID string, syncableType model.GroupSyncableType) ([]string, error) {
+	start := time.Now()
+
+	result, err := s.GroupStore.PermittedSyncableAdmins(syncableID, syncableType)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.PermittedSyncableAdmins", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerGroupStore) Restore(groupID string) (*model.Group, error) {
+	start := time.Now()
+
+	result, err := s.GroupStore.Restore(groupID)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.Restore", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerGroupStore) TeamMembersMinusGroupMembers(teamID string, groupIDs []string, page int, perPage int) ([]*model.UserWithGroups, error) {
+	start := time.Now()
+
+	result, err := s.GroupStore.TeamMembersMinusGroupMembers(teamID, groupIDs, page, perPage)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.TeamMembersMinusGroupMembers", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerGroupStore) TeamMembersToAdd(since int64, teamID *string, includeRemovedMembers bool) ([]*model.UserTeamIDPair, error) {
+	start := time.Now()
+
+	result, err := s.GroupStore.TeamMembersToAdd(since, teamID, includeRemovedMembers)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.TeamMembersToAdd", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) GroupMemberCount() (int64, error) {
+func (s *TimerLayerGroupStore) TeamMembersToRemove(teamID *string) ([]*model.TeamMember, error) {
 	start := time.Now()
 
-	result, err := s.GroupStore.GroupMemberCount()
+	result, err := s.GroupStore.TeamMembersToRemove(teamID)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -4454,15 +5148,15 @@ func (s *TimerLayerGroupStore) GroupMemberCount() (int64, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GroupMemberCount", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.TeamMembersToRemove", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) GroupTeamCount() (int64, error) {
+func (s *TimerLayerGroupStore) Update(group *model.Group) (*model.Group, error) {
 	start := time.Now()
 
-	result, err := s.GroupStore.GroupTeamCount()
+	result, err := s.GroupStore.Update(group)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -4470,15 +5164,15 @@ func (s *TimerLayerGroupStore) GroupTeamCount() (int64, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.GroupTeamCount", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.Update", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) PermanentDeleteMembersByUser(userID string) error {
+func (s *TimerLayerGroupStore) UpdateGroupSyncable(groupSyncable *model.GroupSyncable) (*model.GroupSyncable, error) {
 	start := time.Now()
 
-	err := s.GroupStore.PermanentDeleteMembersByUser(userID)
+	result, err := s.GroupStore.UpdateGroupSyncable(groupSyncable)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -4486,15 +5180,15 @@ func (s *TimerLayerGroupStore) PermanentDeleteMembersByUser(userID string) error
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.PermanentDeleteMembersByUser", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.UpdateGroupSyncable", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerGroupStore) PermittedSyncableAdmins(syncableID string, syncableType model.GroupSyncableType) ([]string, error) {
+func (s *TimerLayerGroupStore) UpdateLastMentionAt(groupID string, mentionAt int64, cooldown int64) (bool, error) {
 	start := time.Now()
 
-	result, err := s.GroupStore.PermittedSyncableAdmins(syncableID, syncableType)
+	result, err := s.GroupStore.UpdateLastMentionAt(groupID, mentionAt, cooldown)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -4502,15 +5196,15 @@ func (s *TimerLayerGroupStore) PermittedSyncableAdmins(syncableID string, syncab
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.PermittedSyncableAdmins", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.UpdateLastMentionAt", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) Restore(groupID string) (*model.Group, error) {
+func (s *TimerLayerGroupStore) UpsertMember(groupID string, userID string) (*model.GroupMember, error) {
 	start := time.Now()
 
-	result, err := s.GroupStore.Restore(groupID)
+	result, err := s.GroupStore.UpsertMember(groupID, userID)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -4518,15 +5212,15 @@ func (s *TimerLayerGroupStore) Restore(groupID string) (*model.Group, error) {
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.Restore", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.UpsertMember", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) TeamMembersMinusGroupMembers(teamID string, groupIDs []string, page int, perPage int) ([]*model.UserWithGroups, error) {
+func (s *TimerLayerGroupStore) UpsertMembers(groupID string, userIDs []string) ([]*model.GroupMember, error) {
 	start := time.Now()
 
-	result, err := s.GroupStore.TeamMembersMinusGroupMembers(teamID, groupIDs, page, perPage)
+	result, err := s.GroupStore.UpsertMembers(groupID, userIDs)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -4534,15 +5228,15 @@ func (s *TimerLayerGroupStore) TeamMembersMinusGroupMembers(teamID string, group
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.TeamMembersMinusGroupMembers", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.UpsertMembers", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) TeamMembersToAdd(since int64, teamID *string, includeRemovedMembers bool) ([]*model.UserTeamIDPair, error) {
+func (s *TimerLayerIntegrationDeliveryStore) Cleanup(expiryTime int64, batchSize int) error {
 	start := time.Now()
 
-	result, err := s.GroupStore.TeamMembersToAdd(since, teamID, includeRemovedMembers)
+	err := s.IntegrationDeliveryStore.Cleanup(expiryTime, batchSize)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -4550,15 +5244,15 @@ func (s *TimerLayerGroupStore) TeamMembersToAdd(since int64, teamID *string, inc
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.TeamMembersToAdd", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("IntegrationDeliveryStore.Cleanup", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerGroupStore) TeamMembersToRemove(teamID *string) ([]*model.TeamMember, error) {
+func (s *TimerLayerIntegrationDeliveryStore) Get(id string) (*model.IntegrationDelivery, error) {
 	start := time.Now()
 
-	result, err := s.GroupStore.TeamMembersToRemove(teamID)
+	result, err := s.IntegrationDeliveryStore.Get(id)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -4566,15 +5260,15 @@ func (s *TimerLayerGroupStore) TeamMembersToRemove(teamID *string) ([]*model.Tea
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.TeamMembersToRemove", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("IntegrationDeliveryStore.Get", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) Update(group *model.Group) (*model.Group, error) {
+func (s *TimerLayerIntegrationDeliveryStore) GetForHook(hookID string, page int, perPage int) ([]*model.IntegrationDelivery, error) {
 	start := time.Now()
 
-	result, err := s.GroupStore.Update(group)
+	result, err := s.IntegrationDeliveryStore.GetForHook(hookID, page, perPage)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -4582,15 +5276,15 @@ func (s *TimerLayerGroupStore) Update(group *model.Group) (*model.Group, error)
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.Update", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("IntegrationDeliveryStore.GetForHook", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerGroupStore) UpdateGroupSyncable(groupSyncable *model.GroupSyncable) (*model.GroupSyncable, error) {
+func (s *TimerLayerIntegrationDeliveryStore) PermanentDeleteByHook(hookID string) error {
 	start := time.Now()
 
-	result, err := s.GroupStore.UpdateGroupSyncable(groupSyncable)
+	err := s.IntegrationDeliveryStore.PermanentDeleteByHook(hookID)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -4598,15 +5292,15 @@ func (s *TimerLayerGroupStore) UpdateGroupSyncable(groupSyncable *model.GroupSyn
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.UpdateGroupSyncable", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("IntegrationDeliveryStore.PermanentDeleteByHook", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerGroupStore) UpsertMember(groupID string, userID string) (*model.GroupMember, error) {
+func (s *TimerLayerIntegrationDeliveryStore) PruneForHook(hookID string, keep int) error {
 	start := time.Now()
 
-	result, err := s.GroupStore.UpsertMember(groupID, userID)
+	err := s.IntegrationDeliveryStore.PruneForHook(hookID, keep)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -4614,15 +5308,15 @@ func (s *TimerLayerGroupStore) UpsertMember(groupID string, userID string) (*mod
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.UpsertMember", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("IntegrationDeliveryStore.PruneForHook", success, elapsed)
 	}
-	return result, err
+	return err
 }
 
-func (s *TimerLayerGroupStore) UpsertMembers(groupID string, userIDs []string) ([]*model.GroupMember, error) {
+func (s *TimerLayerIntegrationDeliveryStore) Save(delivery *model.IntegrationDelivery) (*model.IntegrationDelivery, error) {
 	start := time.Now()
 
-	result, err := s.GroupStore.UpsertMembers(groupID, userIDs)
+	result, err := s.IntegrationDeliveryStore.Save(delivery)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -4630,7 +5324,7 @@ func (s *TimerLayerGroupStore) UpsertMembers(groupID string, userIDs []string) (
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("GroupStore.UpsertMembers", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("IntegrationDeliveryStore.Save", success, elapsed)
 	}
 	return result, err
 }
@@ -4971,6 +5665,54 @@ func (s *TimerLayerLinkMetadataStore) Save(linkMetadata *model.LinkMetadata) (*m
 	return result, err
 }
 
+func (s *TimerLayerNotificationDeliveryStore) Cleanup(expiryTime int64, batchSize int) error {
+	start := time.Now()
+
+	err := s.NotificationDeliveryStore.Cleanup(expiryTime, batchSize)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("NotificationDeliveryStore.Cleanup", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerNotificationDeliveryStore) GetForUser(userID string, opts model.NotificationDeliveryGetOptions) ([]*model.NotificationDelivery, error) {
+	start := time.Now()
+
+	result, err := s.NotificationDeliveryStore.GetForUser(userID, opts)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("NotificationDeliveryStore.GetForUser", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerNotificationDeliveryStore) Save(delivery *model.NotificationDelivery) (*model.NotificationDelivery, error) {
+	start := time.Now()
+
+	result, err := s.NotificationDeliveryStore.Save(delivery)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("NotificationDeliveryStore.Save", success, elapsed)
+	}
+	return result, err
+}
+
 func (s *TimerLayerNotifyAdminStore) DeleteBefore(trial bool, now int64) error {
 	start := time.Now()
 
@@ -6729,6 +7471,22 @@ func (s *TimerLayerPreferenceStore) GetCategoryAndName(category string, nane str
 	return result, err
 }
 
+func (s *TimerLayerPreferenceStore) GetEtag(userID string) string {
+	start := time.Now()
+
+	result := s.PreferenceStore.GetEtag(userID)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if true {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PreferenceStore.GetEtag", success, elapsed)
+	}
+	return result
+}
+
 func (s *TimerLayerPreferenceStore) PermanentDeleteByUser(userID string) error {
 	start := time.Now()
 
@@ -8920,6 +9678,22 @@ func (s *TimerLayerTeamStore) GetChannelUnreadsForTeam(teamID string, userID str
 	return result, err
 }
 
+func (s *TimerLayerTeamStore) GetChannelUnreadsSince(userID string, since int64, limit int) ([]*model.ChannelUnread, error) {
+	start := time.Now()
+
+	result, err := s.TeamStore.GetChannelUnreadsSince(userID, since, limit)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetChannelUnreadsSince", success, elapsed)
+	}
+	return result, err
+}
+
 func (s *TimerLayerTeamStore) GetCommonTeamIDsForMultipleUsers(userIDs []string) ([]string, error) {
 	start := time.Now()
 
@@ -8952,6 +9726,22 @@ func (s *TimerLayerTeamStore) GetCommonTeamIDsForTwoUsers(userID string, otherUs
 	return result, err
 }
 
+func (s *TimerLayerTeamStore) GetEtagForMembers(teamID string) string {
+	start := time.Now()
+
+	result := s.TeamStore.GetEtagForMembers(teamID)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if true {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("TeamStore.GetEtagForMembers", success, elapsed)
+	}
+	return result
+}
+
 func (s *TimerLayerTeamStore) GetMany(ids []string) ([]*model.Team, error) {
 	start := time.Now()
 
@@ -9495,6 +10285,22 @@ func (s *TimerLayerTermsOfServiceStore) Get(id string, allowFromCache bool) (*mo
 	return result, err
 }
 
+func (s *TimerLayerTermsOfServiceStore) GetAll(offset int, limit int) ([]*model.TermsOfService, error) {
+	start := time.Now()
+
+	result, err := s.TermsOfServiceStore.GetAll(offset, limit)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("TermsOfServiceStore.GetAll", success, elapsed)
+	}
+	return result, err
+}
+
 func (s *TimerLayerTermsOfServiceStore) GetLatest(allowFromCache bool) (*model.TermsOfService, error) {
 	start := time.Now()
 
@@ -9990,6 +10796,54 @@ func (s *TimerLayerTokenStore) Save(recovery *model.Token) error {
 	return err
 }
 
+func (s *TimerLayerUndeliverableEmailStore) Delete(email string) error {
+	start := time.Now()
+
+	err := s.UndeliverableEmailStore.Delete(email)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("UndeliverableEmailStore.Delete", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerUndeliverableEmailStore) Get(email string) (*model.UndeliverableEmail, error) {
+	start := time.Now()
+
+	result, err := s.UndeliverableEmailStore.Get(email)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("UndeliverableEmailStore.Get", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerUndeliverableEmailStore) Save(email *model.UndeliverableEmail) (*model.UndeliverableEmail, error) {
+	start := time.Now()
+
+	result, err := s.UndeliverableEmailStore.Save(email)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("UndeliverableEmailStore.Save", success, elapsed)
+	}
+	return result, err
+}
+
 func (s *TimerLayerUploadSessionStore) Delete(id string) error {
 	start := time.Now()
 
@@ -10469,6 +11323,22 @@ func (s *TimerLayerUserStore) GetChannelGroupUsers(channelID string) ([]*model.U
 	return result, err
 }
 
+func (s *TimerLayerUserStore) GetDirectReports(managerID string, offset int, limit int) ([]*model.User, error) {
+	start := time.Now()
+
+	result, err := s.UserStore.GetDirectReports(managerID, offset, limit)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("UserStore.GetDirectReports", success, elapsed)
+	}
+	return result, err
+}
+
 func (s *TimerLayerUserStore) GetEtagForAllProfiles() string {
 	start := time.Now()
 
@@ -11266,6 +12136,22 @@ func (s *TimerLayerUserStore) UpdateLastPictureUpdate(userID string) error {
 	return err
 }
 
+func (s *TimerLayerUserStore) UpdateManager(userID string, managerID string) error {
+	start := time.Now()
+
+	err := s.UserStore.UpdateManager(userID, managerID)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("UserStore.UpdateManager", success, elapsed)
+	}
+	return err
+}
+
 func (s *TimerLayerUserStore) UpdateMfaActive(userID string, active bool) error {
 	start := time.Now()
 
@@ -11458,6 +12344,22 @@ func (s *TimerLayerUserAccessTokenStore) GetByUser(userID string, page int, perP
 	return result, err
 }
 
+func (s *TimerLayerUserAccessTokenStore) GetExpiring(before int64, offset int, limit int) ([]*model.UserAccessToken, error) {
+	start := time.Now()
+
+	result, err := s.UserAccessTokenStore.GetExpiring(before, offset, limit)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("UserAccessTokenStore.GetExpiring", success, elapsed)
+	}
+	return result, err
+}
+
 func (s *TimerLayerUserAccessTokenStore) Save(token *model.UserAccessToken) (*model.UserAccessToken, error) {
 	start := time.Now()
 
@@ -11490,6 +12392,22 @@ func (s *TimerLayerUserAccessTokenStore) Search(term string) ([]*model.UserAcces
 	return result, err
 }
 
+func (s *TimerLayerUserAccessTokenStore) UpdateExpiresAt(tokenID string, expiresAt int64) error {
+	start := time.Now()
+
+	err := s.UserAccessTokenStore.UpdateExpiresAt(tokenID, expiresAt)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("UserAccessTokenStore.UpdateExpiresAt", success, elapsed)
+	}
+	return err
+}
+
 func (s *TimerLayerUserAccessTokenStore) UpdateTokenDisable(tokenID string) error {
 	start := time.Now()
 
@@ -11522,6 +12440,22 @@ func (s *TimerLayerUserAccessTokenStore) UpdateTokenEnable(tokenID string) error
 	return err
 }
 
+func (s *TimerLayerUserTermsOfServiceStore) CountByTermsOfService(termsOfServiceId string, audience string) (int64, error) {
+	start := time.Now()
+
+	result, err := s.UserTermsOfServiceStore.CountByTermsOfService(termsOfServiceId, audience)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("UserTermsOfServiceStore.CountByTermsOfService", success, elapsed)
+	}
+	return result, err
+}
+
 func (s *TimerLayerUserTermsOfServiceStore) Delete(userID string, termsOfServiceId string) error {
 	start := time.Now()
 
@@ -12043,6 +12977,7 @@ func New(childStore store.Store, metrics einterfaces.MetricsInterface) *TimerLay
 	}
 
 	newStore.AuditStore = &TimerLayerAuditStore{AuditStore: childStore.Audit(), Root: &newStore}
+	newStore.AutomationRuleStore = &TimerLayerAutomationRuleStore{AutomationRuleStore: childStore.AutomationRule(), Root: &newStore}
 	newStore.BotStore = &TimerLayerBotStore{BotStore: childStore.Bot(), Root: &newStore}
 	newStore.ChannelStore = &TimerLayerChannelStore{ChannelStore: childStore.Channel(), Root: &newStore}
 	newStore.ChannelBookmarkStore = &TimerLayerChannelBookmarkStore{ChannelBookmarkStore: childStore.ChannelBookmark(), Root: &newStore}
@@ -12051,14 +12986,19 @@ func New(childStore store.Store, metrics einterfaces.MetricsInterface) *TimerLay
 	newStore.CommandStore = &TimerLayerCommandStore{CommandStore: childStore.Command(), Root: &newStore}
 	newStore.CommandWebhookStore = &TimerLayerCommandWebhookStore{CommandWebhookStore: childStore.CommandWebhook(), Root: &newStore}
 	newStore.ComplianceStore = &TimerLayerComplianceStore{ComplianceStore: childStore.Compliance(), Root: &newStore}
+	newStore.ConfigHistoryStore = &TimerLayerConfigHistoryStore{ConfigHistoryStore: childStore.ConfigHistory(), Root: &newStore}
+	newStore.CustomProfileAttributeStore = &TimerLayerCustomProfileAttributeStore{CustomProfileAttributeStore: childStore.CustomProfileAttribute(), Root: &newStore}
 	newStore.DesktopTokensStore = &TimerLayerDesktopTokensStore{DesktopTokensStore: childStore.DesktopTokens(), Root: &newStore}
 	newStore.DraftStore = &TimerLayerDraftStore{DraftStore: childStore.Draft(), Root: &newStore}
 	newStore.EmojiStore = &TimerLayerEmojiStore{EmojiStore: childStore.Emoji(), Root: &newStore}
 	newStore.FileInfoStore = &TimerLayerFileInfoStore{FileInfoStore: childStore.FileInfo(), Root: &newStore}
+	newStore.FileShareLinkStore = &TimerLayerFileShareLinkStore{FileShareLinkStore: childStore.FileShareLink(), Root: &newStore}
 	newStore.GroupStore = &TimerLayerGroupStore{GroupStore: childStore.Group(), Root: &newStore}
+	newStore.IntegrationDeliveryStore = &TimerLayerIntegrationDeliveryStore{IntegrationDeliveryStore: childStore.IntegrationDelivery(), Root: &newStore}
 	newStore.JobStore = &TimerLayerJobStore{JobStore: childStore.Job(), Root: &newStore}
 	newStore.LicenseStore = &TimerLayerLicenseStore{LicenseStore: childStore.License(), Root: &newStore}
 	newStore.LinkMetadataStore = &TimerLayerLinkMetadataStore{LinkMetadataStore: childStore.LinkMetadata(), Root: &newStore}
+	newStore.NotificationDeliveryStore = &TimerLayerNotificationDeliveryStore{NotificationDeliveryStore: childStore.NotificationDelivery(), Root: &newStore}
 	newStore.NotifyAdminStore = &TimerLayerNotifyAdminStore{NotifyAdminStore: childStore.NotifyAdmin(), Root: &newStore}
 	newStore.OAuthStore = &TimerLayerOAuthStore{OAuthStore: childStore.OAuth(), Root: &newStore}
 	newStore.OutgoingOAuthConnectionStore = &TimerLayerOutgoingOAuthConnectionStore{OutgoingOAuthConnectionStore: childStore.OutgoingOAuthConnection(), Root: &newStore}
@@ -12082,6 +13022,7 @@ func New(childStore store.Store, metrics einterfaces.MetricsInterface) *TimerLay
 	newStore.TermsOfServiceStore = &TimerLayerTermsOfServiceStore{TermsOfServiceStore: childStore.TermsOfService(), Root: &newStore}
 	newStore.ThreadStore = &TimerLayerThreadStore{ThreadStore: childStore.Thread(), Root: &newStore}
 	newStore.TokenStore = &TimerLayerTokenStore{TokenStore: childStore.Token(), Root: &newStore}
+	newStore.UndeliverableEmailStore = &TimerLayerUndeliverableEmailStore{UndeliverableEmailStore: childStore.UndeliverableEmail(), Root: &newStore}
 	newStore.UploadSessionStore = &TimerLayerUploadSessionStore{UploadSessionStore: childStore.UploadSession(), Root: &newStore}
 	newStore.UserStore = &TimerLayerUserStore{UserStore: childStore.User(), Root: &newStore}
 	newStore.UserAccessTokenStore = &TimerLayerUserAccessTokenStore{UserAccessTokenStore: childStore.UserAccessToken(), Root: &newStore}
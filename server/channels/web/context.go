@@ -25,6 +25,9 @@ type Context struct {
 	Params        *Params
 	Err           *model.AppError
 	siteURLHeader string
+
+	// retryAfterSeconds tells the client when to retry a request rejected with Err.
+	retryAfterSeconds int
 }
 
 // LogAuditRec logs an audit record using default LevelAPI.
@@ -192,6 +195,79 @@ func (c *Context) MfaRequired() {
 	}
 }
 
+// TermsOfServiceRequired blocks users who haven't accepted the latest terms of service once its
+// grace period has ended, except for the requests needed to read and accept them.
+func (c *Context) TermsOfServiceRequired() {
+	session := c.AppContext.Session()
+	if session.UserId == "" || session.IsOAuth {
+		return
+	}
+
+	subpath, _ := utils.GetSubpathFromConfig(c.App.Config())
+	switch c.AppContext.Path() {
+	case path.Join(subpath, "/api/v4/users/me"),
+		path.Join(subpath, "/api/v4/users/logout"),
+		path.Join(subpath, "/api/v4/terms_of_service"),
+		path.Join(subpath, "/api/v4/users/me/terms_of_service"),
+		path.Join(subpath, "/api/v4/users", session.UserId, "terms_of_service"):
+		return
+	}
+
+	overdue, appErr := c.App.IsTermsOfServiceAcceptanceOverdue(session.UserId)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	if overdue {
+		c.Err = model.NewAppError("TermsOfServiceRequired", "api.context.terms_of_service_required.app_error", nil, "", http.StatusForbidden)
+	}
+}
+
+// maintenanceModeAllowedPaths are the API paths accepting write requests during maintenance mode:
+// the ones needed to sign in and out, to turn off the maintenance mode or drain a server, and
+// the searches and batches of reads that are sent as POST requests.
+var maintenanceModeAllowedPaths = []string{
+	"/api/v4/users/login",
+	"/api/v4/users/logout",
+	"/api/v4/maintenance_mode",
+	"/api/v4/system/drain",
+	"/api/v4/users/ids",
+	"/api/v4/users/usernames",
+	"/api/v4/users/status/ids",
+	"/api/v4/users/search",
+	"/api/v4/teams/search",
+	"/api/v4/batch",
+}
+
+// MaintenanceModeWritesRejected rejects the write requests to the API and the webhooks while the
+// server is in maintenance mode.
+func (c *Context) MaintenanceModeWritesRejected(r *http.Request) {
+	switch r.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return
+	}
+
+	if !IsAPICall(c.App, r) && !IsWebhookCall(c.App, r) {
+		return
+	}
+
+	mode := c.App.Srv().Platform().MaintenanceMode()
+	if !mode.Enabled {
+		return
+	}
+
+	subpath, _ := utils.GetSubpathFromConfig(c.App.Config())
+	for _, allowedPath := range maintenanceModeAllowedPaths {
+		if c.AppContext.Path() == path.Join(subpath, allowedPath) {
+			return
+		}
+	}
+
+	c.Err = model.NewAppError("MaintenanceModeWritesRejected", "api.context.maintenance_mode.app_error", map[string]any{"Message": mode.Message}, "", http.StatusServiceUnavailable)
+	c.retryAfterSeconds = mode.RetryAfterSeconds
+}
+
 // ExtendSessionExpiryIfNeeded will update Session.ExpiresAt based on session lengths in config.
 // Session cookies will be resent to the client with updated max age.
 func (c *Context) ExtendSessionExpiryIfNeeded(w http.ResponseWriter, r *http.Request) {
@@ -632,6 +708,18 @@ func (c *Context) RequireHookId() *Context {
 	return c
 }
 
+func (c *Context) RequireDeliveryId() *Context {
+	if c.Err != nil {
+		return c
+	}
+
+	if !model.IsValidId(c.Params.DeliveryId) {
+		c.SetInvalidURLParam("delivery_id")
+	}
+
+	return c
+}
+
 func (c *Context) RequireCommandId() *Context {
 	if c.Err != nil {
 		return c
@@ -643,6 +731,28 @@ func (c *Context) RequireCommandId() *Context {
 	return c
 }
 
+func (c *Context) RequireAutomationRuleId() *Context {
+	if c.Err != nil {
+		return c
+	}
+
+	if !model.IsValidId(c.Params.AutomationRuleId) {
+		c.SetInvalidURLParam("rule_id")
+	}
+	return c
+}
+
+func (c *Context) RequireFieldId() *Context {
+	if c.Err != nil {
+		return c
+	}
+
+	if !model.IsValidId(c.Params.FieldId) {
+		c.SetInvalidURLParam("field_id")
+	}
+	return c
+}
+
 func (c *Context) RequireJobId() *Context {
 	if c.Err != nil {
 		return c
@@ -665,6 +775,39 @@ func (c *Context) RequireJobType() *Context {
 	return c
 }
 
+func (c *Context) RequireConfigVersion() *Context {
+	if c.Err != nil {
+		return c
+	}
+
+	if c.Params.ConfigVersion == 0 {
+		c.SetInvalidURLParam("config_version")
+	}
+	return c
+}
+
+func (c *Context) RequireFeatureFlagName() *Context {
+	if c.Err != nil {
+		return c
+	}
+
+	if c.Params.FeatureFlagName == "" {
+		c.SetInvalidURLParam("flag_name")
+	}
+	return c
+}
+
+func (c *Context) RequireTermsOfServiceId() *Context {
+	if c.Err != nil {
+		return c
+	}
+
+	if !model.IsValidId(c.Params.TermsOfServiceId) {
+		c.SetInvalidURLParam("terms_of_service_id")
+	}
+	return c
+}
+
 func (c *Context) RequireRoleId() *Context {
 	if c.Err != nil {
 		return c
@@ -754,6 +897,17 @@ func (c *Context) RequireBotUserId() *Context {
 	return c
 }
 
+func (c *Context) RequireFileShareLinkId() *Context {
+	if c.Err != nil {
+		return c
+	}
+
+	if !model.IsValidId(c.Params.FileShareLinkId) {
+		c.SetInvalidURLParam("link_id")
+	}
+	return c
+}
+
 func (c *Context) RequireInvoiceId() *Context {
 	if c.Err != nil {
 		return c
@@ -6,6 +6,7 @@ package web
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"reflect"
@@ -14,6 +15,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/gorilla/mux"
 	"github.com/klauspost/compress/gzhttp"
 	"github.com/opentracing/opentracing-go"
 	"github.com/opentracing/opentracing-go/ext"
@@ -202,8 +204,8 @@ func (h Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	c.Params = ParamsFromRequest(r)
 	c.Logger = c.App.Log()
 
-	if *c.App.Config().ServiceSettings.EnableOpenTracing {
-		span, ctx := tracing.StartRootSpanByContext(context.Background(), "web:ServeHTTP")
+	if tracing.IsEnabled(c.App.Config()) {
+		span, ctx := tracing.StartSpanFromRequest(r, "web:ServeHTTP")
 		carrier := opentracing.HTTPHeadersCarrier(r.Header)
 		_ = opentracing.GlobalTracer().Inject(span.Context(), opentracing.HTTPHeaders, carrier)
 		ext.HTTPMethod.Set(span, r.Method)
@@ -349,10 +351,18 @@ func (h Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		c.MfaRequired()
 	}
 
+	if c.Err == nil && h.RequireSession {
+		c.TermsOfServiceRequired()
+	}
+
 	if c.Err == nil && h.DisableWhenBusy && c.App.Srv().Platform().Busy.IsBusy() {
 		c.SetServerBusyError()
 	}
 
+	if c.Err == nil && !h.IsLocal {
+		c.MaintenanceModeWritesRejected(r)
+	}
+
 	if c.Err == nil && h.RequireCloudKey {
 		c.CloudKeyRequired()
 	}
@@ -391,8 +401,8 @@ func (h Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			c.Err.WipeDetailed()
 		}
 
-		// Sanitize all 5xx error messages in hardened mode
-		if *c.App.Config().ServiceSettings.ExperimentalEnableHardenedMode && c.Err.StatusCode >= 500 {
+		// Sanitize all 5xx error messages in hardened mode, except the ones the client must retry
+		if *c.App.Config().ServiceSettings.ExperimentalEnableHardenedMode && c.Err.StatusCode >= 500 && c.retryAfterSeconds == 0 {
 			c.Err.Id = ""
 			c.Err.Message = "Internal Server Error"
 			c.Err.WipeDetailed()
@@ -400,7 +410,13 @@ func (h Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			c.Err.Where = ""
 		}
 
-		if IsAPICall(c.App, r) || IsWebhookCall(c.App, r) || IsOAuthAPICall(c.App, r) || r.Header.Get("X-Mobile-App") != "" {
+		if c.retryAfterSeconds > 0 {
+			w.Header().Set("Retry-After", strconv.Itoa(c.retryAfterSeconds))
+			w.WriteHeader(c.Err.StatusCode)
+			if err := json.NewEncoder(w).Encode(&model.RetryableAppError{AppError: c.Err, RetryAfterSeconds: c.retryAfterSeconds}); err != nil {
+				c.Logger.Warn("Error while writing response", mlog.Err(err))
+			}
+		} else if IsAPICall(c.App, r) || IsWebhookCall(c.App, r) || IsOAuthAPICall(c.App, r) || r.Header.Get("X-Mobile-App") != "" {
 			w.WriteHeader(c.Err.StatusCode)
 			w.Write([]byte(c.Err.ToJSON()))
 		} else {
@@ -413,6 +429,10 @@ func (h Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 
 	statusCode = strconv.Itoa(w.(*responseWriterWrapper).StatusCode())
+	if r.URL.Path != model.APIURLSuffix+"/websocket" {
+		c.App.Srv().Platform().SlowOperations().RecordRequest(r.Method, GetRouteTemplate(r), statusCode, time.Since(now))
+	}
+
 	if c.App.Metrics() != nil {
 		c.App.Metrics().IncrementHTTPRequest()
 
@@ -425,6 +445,10 @@ func (h Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			}
 
 			c.App.Metrics().ObserveAPIEndpointDuration(h.HandlerName, r.Method, statusCode, string(GetOriginClient(r)), pageLoadContext, elapsed)
+
+			route := GetRouteTemplate(r)
+			c.App.Metrics().ObserveAPIRouteDuration(route, r.Method, elapsed)
+			c.App.Metrics().IncrementAPIRouteResponse(route, r.Method, statusCode)
 		}
 	}
 }
@@ -471,6 +495,60 @@ func GetOriginClient(r *http.Request) OriginClient {
 	return OriginClientWeb
 }
 
+const routeTemplateUnknown = "unknown"
+
+// GetRouteTemplate returns the path template of the route matching the request, without the
+// patterns of its variables, e.g. /api/v4/users/{user_id}/teams. It is used to label the
+// metrics by route rather than by path, which would be unbounded.
+func GetRouteTemplate(r *http.Request) string {
+	route := mux.CurrentRoute(r)
+	if route == nil {
+		return routeTemplateUnknown
+	}
+
+	template, err := route.GetPathTemplate()
+	if err != nil {
+		return routeTemplateUnknown
+	}
+
+	return stripRouteVariablePatterns(template)
+}
+
+// stripRouteVariablePatterns removes the regular expressions of the route variables, which may
+// themselves contain braces, e.g. {post_id:[a-z0-9]{26}} becomes {post_id}.
+func stripRouteVariablePatterns(template string) string {
+	var sb strings.Builder
+	depth := 0
+	inPattern := false
+	for _, c := range template {
+		switch c {
+		case '{':
+			depth++
+			if depth == 1 {
+				sb.WriteRune(c)
+				continue
+			}
+		case '}':
+			depth--
+			if depth == 0 {
+				inPattern = false
+				sb.WriteRune(c)
+				continue
+			}
+		case ':':
+			if depth == 1 {
+				inPattern = true
+			}
+		}
+
+		if !inPattern {
+			sb.WriteRune(c)
+		}
+	}
+
+	return sb.String()
+}
+
 // checkCSRFToken performs a CSRF check on the provided request with the given CSRF token. Returns whether or not
 // a CSRF check occurred and whether or not it succeeded.
 func (h *Handler) checkCSRFToken(c *Context, r *http.Request, token string, tokenLocation app.TokenLocation, session *model.Session) (checked bool, passed bool) {
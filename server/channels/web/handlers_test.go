@@ -8,6 +8,7 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"github.com/gorilla/mux"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -927,3 +928,50 @@ func TestGetOriginClient(t *testing.T) {
 		require.Equal(t, tc.expectedClient, actualClient)
 	}
 }
+
+func TestGetRouteTemplate(t *testing.T) {
+	var route string
+	recordRoute := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		route = GetRouteTemplate(r)
+	})
+
+	router := mux.NewRouter()
+	users := router.PathPrefix("/api/v4/users").Subrouter()
+	users.Handle("/{user_id:[A-Za-z0-9]+}/teams/{team_id:[a-z0-9]{26}}", recordRoute).Methods(http.MethodGet)
+	users.Handle("/username/{username:[A-Za-z0-9\\_\\-\\.]+}", recordRoute).Methods(http.MethodGet)
+	users.Handle("/me", recordRoute).Methods(http.MethodGet)
+
+	testCases := []struct {
+		name     string
+		path     string
+		expected string
+	}{
+		{
+			name:     "route without variables",
+			path:     "/api/v4/users/me",
+			expected: "/api/v4/users/me",
+		},
+		{
+			name:     "variables with braces in their patterns",
+			path:     "/api/v4/users/" + model.NewId() + "/teams/" + model.NewId(),
+			expected: "/api/v4/users/{user_id}/teams/{team_id}",
+		},
+		{
+			name:     "variable with escaped characters in its pattern",
+			path:     "/api/v4/users/username/john.doe",
+			expected: "/api/v4/users/username/{username}",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			route = ""
+			router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, tc.path, nil))
+			assert.Equal(t, tc.expected, route)
+		})
+	}
+
+	t.Run("request without a route", func(t *testing.T) {
+		assert.Equal(t, routeTemplateUnknown, GetRouteTemplate(httptest.NewRequest(http.MethodGet, "/api/v4/users/me", nil)))
+	})
+}
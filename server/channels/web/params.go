@@ -31,6 +31,7 @@ type Params struct {
 	TokenId                   string
 	ThreadId                  string
 	Timestamp                 int64
+	ConfigVersion             int64
 	TimeRange                 string
 	ChannelId                 string
 	PostId                    string
@@ -41,6 +42,9 @@ type Params struct {
 	PluginId                  string
 	CommandId                 string
 	HookId                    string
+	AutomationRuleId          string
+	FieldId                   string
+	DeliveryId                string
 	ReportId                  string
 	EmojiId                   string
 	AppId                     string
@@ -57,6 +61,8 @@ type Params struct {
 	ActionId                  string
 	RoleId                    string
 	RoleName                  string
+	FeatureFlagName           string
+	TermsOfServiceId          string
 	SchemeId                  string
 	Scope                     string
 	GroupId                   string
@@ -88,6 +94,8 @@ type Params struct {
 	FilterParentTeamPermitted bool
 	CategoryId                string
 	ExportName                string
+	ImportName                string
+	ProfileName               string
 	ExcludePolicyConstrained  bool
 	GroupSource               model.GroupSource
 	FilterHasMember           string
@@ -98,6 +106,8 @@ type Params struct {
 	ChannelBookmarkId string
 	BookmarksSince    int64
 
+	FileShareLinkId string
+
 	// Cloud
 	InvoiceId string
 }
@@ -129,6 +139,9 @@ func ParamsFromRequest(r *http.Request) *Params {
 	params.PluginId = props["plugin_id"]
 	params.CommandId = props["command_id"]
 	params.HookId = props["hook_id"]
+	params.AutomationRuleId = props["rule_id"]
+	params.FieldId = props["field_id"]
+	params.DeliveryId = props["delivery_id"]
 	params.ReportId = props["report_id"]
 	params.EmojiId = props["emoji_id"]
 	params.AppId = props["app_id"]
@@ -145,12 +158,15 @@ func ParamsFromRequest(r *http.Request) *Params {
 	params.ActionId = props["action_id"]
 	params.RoleId = props["role_id"]
 	params.RoleName = props["role_name"]
+	params.FeatureFlagName = props["flag_name"]
+	params.TermsOfServiceId = props["terms_of_service_id"]
 	params.SchemeId = props["scheme_id"]
 	params.GroupId = props["group_id"]
 	params.RemoteId = props["remote_id"]
 	params.InvoiceId = props["invoice_id"]
 	params.OutgoingOAuthConnectionID = props["outgoing_oauth_connection_id"]
 	params.ChannelBookmarkId = props["bookmark_id"]
+	params.FileShareLinkId = props["link_id"]
 	params.Scope = query.Get("scope")
 
 	if val, err := strconv.Atoi(query.Get("page")); err != nil || val < 0 {
@@ -165,6 +181,10 @@ func ParamsFromRequest(r *http.Request) *Params {
 		params.Timestamp = val
 	}
 
+	if val, err := strconv.ParseInt(props["config_version"], 10, 64); err == nil && val > 0 {
+		params.ConfigVersion = val
+	}
+
 	params.TimeRange = query.Get("time_range")
 	params.Permanent, _ = strconv.ParseBool(query.Get("permanent"))
 	params.PerPage = getPerPageFromQuery(query)
@@ -232,6 +252,8 @@ func ParamsFromRequest(r *http.Request) *Params {
 	params.IncludeTotalCount, _ = strconv.ParseBool(query.Get("include_total_count"))
 	params.IncludeDeleted, _ = strconv.ParseBool(query.Get("include_deleted"))
 	params.ExportName = props["export_name"]
+	params.ImportName = props["import_name"]
+	params.ProfileName = props["profile_name"]
 	params.ExcludePolicyConstrained, _ = strconv.ParseBool(query.Get("exclude_policy_constrained"))
 
 	if val := query.Get("group_source"); val != "" {
@@ -229,6 +229,46 @@ func TestIncomingWebhook(t *testing.T) {
 		assert.True(t, resp.StatusCode == http.StatusForbidden)
 	})
 
+	t.Run("RateLimitedWebhook", func(t *testing.T) {
+		hook, err := th.App.CreateIncomingWebhookForChannel(th.BasicUser.Id, th.BasicChannel, &model.IncomingWebhook{ChannelId: th.BasicChannel.Id, RateLimitPerMinute: 2})
+		require.Nil(t, err)
+
+		apiHookURL := apiClient.URL + "/hooks/" + hook.Id
+		for i := 0; i < 2; i++ {
+			resp, err2 := http.Post(apiHookURL, "application/json", strings.NewReader("{\"text\":\"this is a test\"}"))
+			require.NoError(t, err2)
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+		}
+
+		resp, err2 := http.Post(apiHookURL, "application/json", strings.NewReader("{\"text\":\"this is a test\"}"))
+		require.NoError(t, err2)
+		assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+
+		// Other webhooks aren't affected
+		resp, err2 = http.Post(url, "application/json", strings.NewReader("{\"text\":\"this is a test\"}"))
+		require.NoError(t, err2)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("ValidatedAttachments", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.ValidateIncomingWebhookAttachments = true })
+		defer th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.ValidateIncomingWebhookAttachments = false })
+
+		resp, err := http.Post(url, "application/json", strings.NewReader(`{"attachments": [{"text": "ok", "color": "good"}]}`))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		resp, err = http.Post(url, "application/json", strings.NewReader(`{"attachments": [{"text": "bad", "color": "not a color", "fields": [{"title": "t", "value": {"nested": true}}]}]}`))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+		var appErr *model.AppError
+		require.ErrorAs(t, model.AppErrorFromJSON(resp.Body), &appErr)
+		assert.Equal(t, "web.incoming_webhook.attachments.app_error", appErr.Id)
+		assert.Contains(t, appErr.Message, "attachments[0].color")
+		assert.Contains(t, appErr.Message, "attachments[0].fields[0].value")
+	})
+
 	t.Run("DisableWebhooks", func(t *testing.T) {
 		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableIncomingWebhooks = false })
 		resp, err := http.Post(url, "application/json", strings.NewReader("{\"text\":\"this is a test\"}"))
@@ -59,5 +59,9 @@ func (api *API) websocketNotificationAck(req *model.WebSocketRequest) (map[strin
 		notificationReason,
 	)
 
+	if postID, ok := req.Data["post_id"].(string); ok && model.IsValidId(postID) {
+		api.App.RecordNotificationDelivery(req.Session.UserId, postID, "", model.NotificationTypeWebsocket, notificationStatus, notificationReason, "")
+	}
+
 	return nil, nil
 }
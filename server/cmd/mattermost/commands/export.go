@@ -57,6 +57,14 @@ var GlobalRelayZipExportCmd = &cobra.Command{
 	RunE:    buildExportCmdF("globalrelay-zip"),
 }
 
+var JsonlExportCmd = &cobra.Command{
+	Use:     "jsonl",
+	Short:   "Export data from Mattermost in line-delimited JSON format",
+	Long:    "Export data from Mattermost in line-delimited JSON format, with a manifest of the attached files and their hashes.",
+	Example: "export jsonl --exportFrom=12345",
+	RunE:    buildExportCmdF(model.ComplianceExportTypeJsonl),
+}
+
 var BulkExportCmd = &cobra.Command{
 	Use:     "bulk [file]",
 	Short:   "Export bulk data.",
@@ -80,6 +88,9 @@ func init() {
 	GlobalRelayZipExportCmd.Flags().Int64("exportFrom", -1, "The timestamp of the earliest post to export, expressed in seconds since the unix epoch.")
 	GlobalRelayZipExportCmd.Flags().Int("limit", -1, "The number of posts to export. The default of -1 means no limit.")
 
+	JsonlExportCmd.Flags().Int64("exportFrom", -1, "The timestamp of the earliest post to export, expressed in seconds since the unix epoch.")
+	JsonlExportCmd.Flags().Int("limit", -1, "The number of posts to export. The default of -1 means no limit.")
+
 	BulkExportCmd.Flags().Bool("all-teams", true, "Export all teams from the server.")
 	BulkExportCmd.Flags().Bool("with-archived-channels", false, "Also exports archived channels.")
 	BulkExportCmd.Flags().Bool("with-profile-pictures", false, "Also exports profile pictures.")
@@ -90,6 +101,7 @@ func init() {
 	ExportCmd.AddCommand(CsvExportCmd)
 	ExportCmd.AddCommand(ActianceExportCmd)
 	ExportCmd.AddCommand(GlobalRelayZipExportCmd)
+	ExportCmd.AddCommand(JsonlExportCmd)
 	ExportCmd.AddCommand(BulkExportCmd)
 
 	RootCmd.AddCommand(ExportCmd)
@@ -182,18 +194,32 @@ func buildExportCmdF(format string) func(command *cobra.Command, args []string)
 			return errors.New("limit flag error")
 		}
 
-		if a.MessageExport() == nil || license == nil || !*license.Features.MessageExport {
+		if license == nil || !*license.Features.MessageExport {
 			return errors.New("message export feature not available")
 		}
 
-		warningsCount, appErr := a.MessageExport().RunExport(rctx, format, startTime, limit)
+		var warningsCount int64
+		var appErr *model.AppError
+		if format == model.ComplianceExportTypeJsonl {
+			// The JSONL format doesn't depend on the message export implementation.
+			var exportDir string
+			exportDir, warningsCount, appErr = a.RunJsonlMessageExport(rctx, startTime, limit)
+			if appErr == nil {
+				CommandPrettyPrintln("Exported to " + exportDir)
+			}
+		} else {
+			if a.MessageExport() == nil {
+				return errors.New("message export feature not available")
+			}
+			warningsCount, appErr = a.MessageExport().RunExport(rctx, format, startTime, limit)
+		}
 		if appErr != nil {
 			return appErr
 		}
 		if warningsCount == 0 {
 			CommandPrettyPrintln("SUCCESS: Your data was exported.")
 		} else {
-			if format == model.ComplianceExportTypeGlobalrelay || format == model.ComplianceExportTypeGlobalrelayZip {
+			if format == model.ComplianceExportTypeGlobalrelay || format == model.ComplianceExportTypeGlobalrelayZip || format == model.ComplianceExportTypeJsonl {
 				CommandPrettyPrintln(fmt.Sprintf("WARNING: %d warnings encountered, see logs for details.", warningsCount))
 			} else {
 				CommandPrettyPrintln(fmt.Sprintf("WARNING: %d warnings encountered, see warning.txt for details.", warningsCount))
@@ -54,6 +54,7 @@ func serverCmdF(command *cobra.Command, args []string) error {
 		return errors.Wrap(err, "failed to load configuration")
 	}
 	defer configStore.Close()
+	configStore.StartSecretsRefresh()
 
 	return runServer(configStore, interruptChan)
 }
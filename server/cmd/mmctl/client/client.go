@@ -127,6 +127,7 @@ type Client interface {
 	GetUploadsForUser(ctx context.Context, userID string) ([]*model.UploadSession, *model.Response, error)
 	UploadData(ctx context.Context, uploadID string, data io.Reader) (*model.FileInfo, *model.Response, error)
 	ListImports(ctx context.Context) ([]string, *model.Response, error)
+	MergeImport(ctx context.Context, name string, mapping *model.ImportMergeMapping, extractContent bool) (*model.Job, *model.Response, error)
 	GetJob(ctx context.Context, id string) (*model.Job, *model.Response, error)
 	GetJobs(ctx context.Context, page int, perPage int) ([]*model.Job, *model.Response, error)
 	GetJobsByType(ctx context.Context, jobType string, page int, perPage int) ([]*model.Job, *model.Response, error)
@@ -5,9 +5,13 @@ package commands
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/mattermost/mattermost/server/v8/cmd/mmctl/client"
 	"github.com/mattermost/mattermost/server/v8/cmd/mmctl/printer"
@@ -103,6 +107,11 @@ func init() {
 	ExportCreateCmd.Flags().Bool("include-archived-channels", false, "Include archived channels in the export file.")
 	ExportCreateCmd.Flags().Bool("include-profile-pictures", false, "Include profile pictures in the export file.")
 	ExportCreateCmd.Flags().Bool("no-roles-and-schemes", false, "Exclude roles and custom permission schemes from the export file.")
+	ExportCreateCmd.Flags().String("start-time", "", "Only export threads started at or after this time, in ISO 8601 format (e.g. 2023-01-02T15:04:05-07:00).")
+	ExportCreateCmd.Flags().String("end-time", "", "Only export threads started before this time, in ISO 8601 format (e.g. 2023-01-02T15:04:05-07:00).")
+	ExportCreateCmd.Flags().StringSlice("teams", nil, "Only export the given teams. Direct and group messages are excluded.")
+	ExportCreateCmd.Flags().StringSlice("channels", nil, "Only export the given channels, in team:channel format. Direct and group messages are excluded.")
+	ExportCreateCmd.Flags().Int("chunk-size", 0, "Split the export data into files of at most this many lines, not counting the version line each file starts with. 0 disables chunking.")
 
 	ExportDownloadCmd.Flags().Bool("resume", false, "Set to true to resume an export download.")
 	_ = ExportDownloadCmd.Flags().MarkHidden("resume")
@@ -154,6 +163,50 @@ func exportCreateCmdF(c client.Client, command *cobra.Command, args []string) er
 		data["include_profile_pictures"] = "true"
 	}
 
+	for _, flag := range []string{"start-time", "end-time"} {
+		value, _ := command.Flags().GetString(flag)
+		if value == "" {
+			continue
+		}
+		t, err := time.Parse(ISO8601Layout, value)
+		if err != nil {
+			return fmt.Errorf("invalid %s '%s'", flag, value)
+		}
+		data[strings.Replace(flag, "-", "_", 1)] = strconv.FormatInt(model.GetMillisForTime(t), 10)
+	}
+
+	teamArgs, _ := command.Flags().GetStringSlice("teams")
+	if len(teamArgs) > 0 {
+		teamIDs := make([]string, 0, len(teamArgs))
+		for i, team := range getTeamsFromTeamArgs(c, teamArgs) {
+			if team == nil {
+				return fmt.Errorf("unable to find team '%s'", teamArgs[i])
+			}
+			teamIDs = append(teamIDs, team.Id)
+		}
+		data["team_ids"] = strings.Join(teamIDs, ",")
+	}
+
+	channelArgs, _ := command.Flags().GetStringSlice("channels")
+	if len(channelArgs) > 0 {
+		channelIDs := make([]string, 0, len(channelArgs))
+		for i, channel := range getChannelsFromChannelArgs(c, channelArgs) {
+			if channel == nil {
+				return fmt.Errorf("unable to find channel '%s'", channelArgs[i])
+			}
+			channelIDs = append(channelIDs, channel.Id)
+		}
+		data["channel_ids"] = strings.Join(channelIDs, ",")
+	}
+
+	chunkSize, _ := command.Flags().GetInt("chunk-size")
+	if chunkSize < 0 {
+		return errors.New("chunk-size must not be negative")
+	}
+	if chunkSize > 0 {
+		data["chunk_size"] = strconv.Itoa(chunkSize)
+	}
+
 	job, _, err := c.CreateJob(context.TODO(), &model.Job{
 		Type: model.JobTypeExportProcess,
 		Data: data,
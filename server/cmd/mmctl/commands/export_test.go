@@ -5,6 +5,7 @@ package commands
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 
@@ -87,6 +88,89 @@ func (s *MmctlUnitTestSuite) TestExportCreateCmdF() {
 		s.Empty(printer.GetErrorLines())
 		s.Equal(mockJob, printer.GetLines()[0].(*model.Job))
 	})
+
+	s.Run("create export with filters", func() {
+		printer.Clean()
+		teamID := model.NewId()
+		channelID := model.NewId()
+		mockJob := &model.Job{
+			Type: model.JobTypeExportProcess,
+			Data: map[string]string{
+				"include_attachments":       "true",
+				"include_roles_and_schemes": "true",
+				"start_time":                "1672610645000",
+				"end_time":                  "1704146645000",
+				"team_ids":                  teamID,
+				"channel_ids":               channelID,
+				"chunk_size":                "1000",
+			},
+		}
+
+		s.client.
+			EXPECT().
+			GetTeam(context.TODO(), teamID, "").
+			Return(&model.Team{Id: teamID}, &model.Response{}, nil).
+			Times(1)
+
+		s.client.
+			EXPECT().
+			GetChannel(context.TODO(), channelID, "").
+			Return(&model.Channel{Id: channelID}, &model.Response{}, nil).
+			Times(1)
+
+		s.client.
+			EXPECT().
+			CreateJob(context.TODO(), mockJob).
+			Return(mockJob, &model.Response{}, nil).
+			Times(1)
+
+		cmd := &cobra.Command{}
+		cmd.Flags().String("start-time", "2023-01-01T22:04:05+00:00", "")
+		cmd.Flags().String("end-time", "2024-01-01T22:04:05+00:00", "")
+		cmd.Flags().StringSlice("teams", []string{teamID}, "")
+		cmd.Flags().StringSlice("channels", []string{channelID}, "")
+		cmd.Flags().Int("chunk-size", 1000, "")
+
+		err := exportCreateCmdF(s.client, cmd, nil)
+		s.Require().Nil(err)
+		s.Len(printer.GetLines(), 1)
+		s.Empty(printer.GetErrorLines())
+		s.Equal(mockJob, printer.GetLines()[0].(*model.Job))
+	})
+
+	s.Run("create export with an invalid start time", func() {
+		printer.Clean()
+
+		cmd := &cobra.Command{}
+		cmd.Flags().String("start-time", "yesterday", "")
+
+		err := exportCreateCmdF(s.client, cmd, nil)
+		s.Require().EqualError(err, "invalid start-time 'yesterday'")
+		s.Empty(printer.GetLines())
+	})
+
+	s.Run("create export with an unknown team", func() {
+		printer.Clean()
+
+		s.client.
+			EXPECT().
+			GetTeam(context.TODO(), "unknown", "").
+			Return(nil, &model.Response{}, errors.New("not found")).
+			Times(1)
+
+		s.client.
+			EXPECT().
+			GetTeamByName(context.TODO(), "unknown", "").
+			Return(nil, &model.Response{}, errors.New("not found")).
+			Times(1)
+
+		cmd := &cobra.Command{}
+		cmd.Flags().StringSlice("teams", []string{"unknown"}, "")
+
+		err := exportCreateCmdF(s.client, cmd, nil)
+		s.Require().EqualError(err, "unable to find team 'unknown'")
+		s.Empty(printer.GetLines())
+	})
 }
 
 func (s *MmctlUnitTestSuite) TestExportDeleteCmdF() {
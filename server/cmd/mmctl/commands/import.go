@@ -5,6 +5,7 @@ package commands
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -54,7 +55,7 @@ var ImportListAvailableCmd = &cobra.Command{
 
 var ImportJobCmd = &cobra.Command{
 	Use:   "job",
-	Short: "List and show import jobs",
+	Short: "List, show and resume import jobs",
 }
 
 var ImportListIncompleteCmd = &cobra.Command{
@@ -82,6 +83,15 @@ var ImportJobShowCmd = &cobra.Command{
 	RunE:    withClient(importJobShowCmdF),
 }
 
+var ImportJobResumeCmd = &cobra.Command{
+	Use:     "resume [importJobID]",
+	Example: " import job resume f3d68qkkm7n8xgsfxwuo498rah",
+	Short:   "Resume a failed or canceled import job",
+	Long:    "Start a new import job that resumes a failed or canceled import job from its last checkpoint.",
+	Args:    cobra.ExactArgs(1),
+	RunE:    withClient(importJobResumeCmdF),
+}
+
 var ImportProcessCmd = &cobra.Command{
 	Use:     "process [importname]",
 	Example: "  import process 35uy6cwrqfnhdx3genrhqqznxc_import.zip",
@@ -90,6 +100,30 @@ var ImportProcessCmd = &cobra.Command{
 	RunE:    withClient(importProcessCmdF),
 }
 
+var ImportTeamsCmd = &cobra.Command{
+	Use:     "teams [importname]",
+	Example: "  import teams 35uy6cwrqfnhdx3genrhqqznxc_teams_export.zip",
+	Short:   "Start a Microsoft Teams import job",
+	Long:    "Start a job that imports the teams, channels, chats, messages and files of a Microsoft Teams export made of Microsoft Graph API resources.",
+	Args:    cobra.ExactArgs(1),
+	RunE:    withClient(importTeamsCmdF),
+}
+
+var ImportMergeCmd = &cobra.Command{
+	Use:     "merge [importname]",
+	Example: "  import merge 35uy6cwrqfnhdx3genrhqqznxc_export.zip --mapping mapping.json",
+	Short:   "Start a job merging an export of another instance",
+	Long: `Start a job that imports an export of another Mattermost instance, renaming its users and teams according to a mapping file so that they don't collide with the existing ones. Permalinks to the other instance are translated to the imported posts. The mapping file has the following format:
+
+  {
+    "users": {"john": "john.acme"},
+    "teams": {"engineering": "acme-engineering"},
+    "source_site_url": "https://chat.acme.com"
+  }`,
+	Args: cobra.ExactArgs(1),
+	RunE: withClient(importMergeCmdF),
+}
+
 var ImportValidateCmd = &cobra.Command{
 	Use:     "validate [filepath]",
 	Example: "  import validate import_file.zip --team myteam --team myotherteam",
@@ -116,6 +150,12 @@ func init() {
 	ImportProcessCmd.Flags().Bool("bypass-upload", false, "If this is set, the file is not processed from the server, but rather directly read from the filesystem. Works only in --local mode.")
 	ImportProcessCmd.Flags().Bool("extract-content", true, "If this is set, document attachments will be extracted and indexed during the import process. It is advised to disable it to improve performance.")
 
+	ImportTeamsCmd.Flags().Bool("bypass-upload", false, "If this is set, the file is not processed from the server, but rather directly read from the filesystem. Works only in --local mode.")
+	ImportTeamsCmd.Flags().Bool("extract-content", true, "If this is set, document attachments will be extracted and indexed during the import process. It is advised to disable it to improve performance.")
+
+	ImportMergeCmd.Flags().String("mapping", "", "Path to the JSON file mapping the names of the users and teams of the export to new ones.")
+	ImportMergeCmd.Flags().Bool("extract-content", true, "If this is set, document attachments will be extracted and indexed during the import process. It is advised to disable it to improve performance.")
+
 	ImportListCmd.AddCommand(
 		ImportListAvailableCmd,
 		ImportListIncompleteCmd,
@@ -123,11 +163,14 @@ func init() {
 	ImportJobCmd.AddCommand(
 		ImportJobListCmd,
 		ImportJobShowCmd,
+		ImportJobResumeCmd,
 	)
 	ImportCmd.AddCommand(
 		ImportUploadCmd,
 		ImportListCmd,
 		ImportProcessCmd,
+		ImportTeamsCmd,
+		ImportMergeCmd,
 		ImportJobCmd,
 		ImportValidateCmd,
 	)
@@ -250,9 +293,9 @@ func importUploadCmdF(c client.Client, command *cobra.Command, args []string) er
 	return nil
 }
 
-func importProcessCmdF(c client.Client, command *cobra.Command, args []string) error {
-	importFile := args[0]
-
+// resolveImportFile returns the name of the import file the job has to process, and
+// whether the server has to read it directly from its filesystem.
+func resolveImportFile(c client.Client, command *cobra.Command, importFile string) (string, bool, error) {
 	isLocal, _ := command.Flags().GetBool("local")
 	bypassUpload, _ := command.Flags().GetBool("bypass-upload")
 	if bypassUpload {
@@ -260,28 +303,28 @@ func importProcessCmdF(c client.Client, command *cobra.Command, args []string) e
 			// First, we validate whether the server is in HA.
 			config, _, err := c.GetOldClientConfig(context.TODO(), "")
 			if err != nil {
-				return err
+				return "", false, err
 			}
 
 			enableCluster, err := strconv.ParseBool(config["EnableCluster"])
 			if err != nil {
-				return fmt.Errorf("failed to parse EnableCluster: %w", err)
+				return "", false, fmt.Errorf("failed to parse EnableCluster: %w", err)
 			}
 
 			if enableCluster {
-				return errors.New("--bypass-upload flag doesn't work if the server is in HA. Because the file has to be present locally on the server where the job request hits. Please disable HA and try again.")
+				return "", false, errors.New("--bypass-upload flag doesn't work if the server is in HA. Because the file has to be present locally on the server where the job request hits. Please disable HA and try again.")
 			}
 
 			// in local mode, we tell the server to directly read from this file.
 			if _, err := os.Stat(importFile); errors.Is(err, os.ErrNotExist) {
-				return fmt.Errorf("file %s doesn't exist. NOTE: If this file was uploaded to the server via mmctl import upload, please omit the --bypass-upload flag to revert to old behavior.", importFile)
+				return "", false, fmt.Errorf("file %s doesn't exist. NOTE: If this file was uploaded to the server via mmctl import upload, please omit the --bypass-upload flag to revert to old behavior.", importFile)
 			}
 			// If it's not an absolute path, then we make it
 			if !path.IsAbs(importFile) {
 				var err2 error
 				importFile, err2 = filepath.Abs(importFile)
 				if err2 != nil {
-					return fmt.Errorf("error is getting the absolute path to %s: %w", importFile, err2)
+					return "", false, fmt.Errorf("error is getting the absolute path to %s: %w", importFile, err2)
 				}
 			}
 		} else {
@@ -289,13 +332,22 @@ func importProcessCmdF(c client.Client, command *cobra.Command, args []string) e
 		}
 	}
 
+	return importFile, isLocal && bypassUpload, nil
+}
+
+func importProcessCmdF(c client.Client, command *cobra.Command, args []string) error {
+	importFile, localMode, err := resolveImportFile(c, command, args[0])
+	if err != nil {
+		return err
+	}
+
 	extractContent, _ := command.Flags().GetBool("extract-content")
 
 	job, _, err := c.CreateJob(context.TODO(), &model.Job{
 		Type: model.JobTypeImportProcess,
 		Data: map[string]string{
 			"import_file":     importFile,
-			"local_mode":      strconv.FormatBool(isLocal && bypassUpload),
+			"local_mode":      strconv.FormatBool(localMode),
 			"extract_content": strconv.FormatBool(extractContent),
 		},
 	})
@@ -308,6 +360,59 @@ func importProcessCmdF(c client.Client, command *cobra.Command, args []string) e
 	return nil
 }
 
+func importTeamsCmdF(c client.Client, command *cobra.Command, args []string) error {
+	importFile, localMode, err := resolveImportFile(c, command, args[0])
+	if err != nil {
+		return err
+	}
+
+	extractContent, _ := command.Flags().GetBool("extract-content")
+
+	job, _, err := c.CreateJob(context.TODO(), &model.Job{
+		Type: model.JobTypeTeamsImport,
+		Data: map[string]string{
+			"import_file":     importFile,
+			"local_mode":      strconv.FormatBool(localMode),
+			"extract_content": strconv.FormatBool(extractContent),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create Microsoft Teams import job: %w", err)
+	}
+
+	printer.PrintT("Microsoft Teams import job successfully created, ID: {{.Id}}", job)
+
+	return nil
+}
+
+func importMergeCmdF(c client.Client, command *cobra.Command, args []string) error {
+	var mapping model.ImportMergeMapping
+	if mappingFile, _ := command.Flags().GetString("mapping"); mappingFile != "" {
+		data, err := os.ReadFile(mappingFile)
+		if err != nil {
+			return fmt.Errorf("failed to read mapping file: %w", err)
+		}
+		if err := json.Unmarshal(data, &mapping); err != nil {
+			return fmt.Errorf("failed to parse mapping file: %w", err)
+		}
+	}
+
+	if appErr := mapping.IsValid(); appErr != nil {
+		return fmt.Errorf("invalid mapping: %w", appErr)
+	}
+
+	extractContent, _ := command.Flags().GetBool("extract-content")
+
+	job, _, err := c.MergeImport(context.TODO(), args[0], &mapping, extractContent)
+	if err != nil {
+		return fmt.Errorf("failed to create import merge job: %w", err)
+	}
+
+	printer.PrintT("Import merge job successfully created, ID: {{.Id}}", job)
+
+	return nil
+}
+
 func printJob(job *model.Job) {
 	if job.StartAt > 0 {
 		printer.PrintT(fmt.Sprintf(`  ID: {{.Id}}
@@ -337,6 +442,40 @@ func importJobShowCmdF(c client.Client, command *cobra.Command, args []string) e
 	return nil
 }
 
+func importJobResumeCmdF(c client.Client, command *cobra.Command, args []string) error {
+	job, _, err := c.GetJob(context.TODO(), args[0])
+	if err != nil {
+		return fmt.Errorf("failed to get import job: %w", err)
+	}
+
+	if job.Type != model.JobTypeImportProcess {
+		return fmt.Errorf("job %s is not an import job", job.Id)
+	}
+
+	if job.Status != model.JobStatusError && job.Status != model.JobStatusCanceled {
+		return fmt.Errorf("only failed or canceled import jobs can be resumed, job %s is %s", job.Id, job.Status)
+	}
+
+	data := map[string]string{}
+	for _, key := range []string{"import_file", "local_mode", "extract_content", "resume_from_file", "resume_from_line"} {
+		if value, ok := job.Data[key]; ok {
+			data[key] = value
+		}
+	}
+
+	newJob, _, err := c.CreateJob(context.TODO(), &model.Job{
+		Type: model.JobTypeImportProcess,
+		Data: data,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create import process job: %w", err)
+	}
+
+	printer.PrintT("Import process job successfully created, ID: {{.Id}}", newJob)
+
+	return nil
+}
+
 func jobListCmdF(c client.Client, command *cobra.Command, jobType string) error {
 	page, err := command.Flags().GetInt("page")
 	if err != nil {
@@ -6,6 +6,7 @@ package commands
 import (
 	"archive/zip"
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"os"
@@ -150,6 +151,91 @@ func (s *MmctlUnitTestSuite) TestImportJobShowCmdF() {
 	})
 }
 
+func (s *MmctlUnitTestSuite) TestImportJobResumeCmdF() {
+	s.Run("resume failed job", func() {
+		printer.Clean()
+		failedJob := &model.Job{
+			Id:     model.NewId(),
+			Type:   model.JobTypeImportProcess,
+			Status: model.JobStatusError,
+			Data: map[string]string{
+				"import_file":      "import.zip",
+				"local_mode":       "false",
+				"extract_content":  "true",
+				"resume_from_file": "import_0002.jsonl",
+				"resume_from_line": "1234",
+				"line_number":      "1300",
+			},
+		}
+		newJob := &model.Job{
+			Type: model.JobTypeImportProcess,
+			Data: map[string]string{
+				"import_file":      "import.zip",
+				"local_mode":       "false",
+				"extract_content":  "true",
+				"resume_from_file": "import_0002.jsonl",
+				"resume_from_line": "1234",
+			},
+		}
+
+		s.client.
+			EXPECT().
+			GetJob(context.TODO(), failedJob.Id).
+			Return(failedJob, &model.Response{}, nil).
+			Times(1)
+
+		s.client.
+			EXPECT().
+			CreateJob(context.TODO(), newJob).
+			Return(newJob, &model.Response{}, nil).
+			Times(1)
+
+		err := importJobResumeCmdF(s.client, &cobra.Command{}, []string{failedJob.Id})
+		s.Require().Nil(err)
+		s.Len(printer.GetLines(), 1)
+		s.Empty(printer.GetErrorLines())
+		s.Equal(newJob, printer.GetLines()[0].(*model.Job))
+	})
+
+	s.Run("job still running", func() {
+		printer.Clean()
+		mockJob := &model.Job{
+			Id:     model.NewId(),
+			Type:   model.JobTypeImportProcess,
+			Status: model.JobStatusInProgress,
+		}
+
+		s.client.
+			EXPECT().
+			GetJob(context.TODO(), mockJob.Id).
+			Return(mockJob, &model.Response{}, nil).
+			Times(1)
+
+		err := importJobResumeCmdF(s.client, &cobra.Command{}, []string{mockJob.Id})
+		s.Require().NotNil(err)
+		s.Empty(printer.GetLines())
+	})
+
+	s.Run("not an import job", func() {
+		printer.Clean()
+		mockJob := &model.Job{
+			Id:     model.NewId(),
+			Type:   model.JobTypeExportProcess,
+			Status: model.JobStatusError,
+		}
+
+		s.client.
+			EXPECT().
+			GetJob(context.TODO(), mockJob.Id).
+			Return(mockJob, &model.Response{}, nil).
+			Times(1)
+
+		err := importJobResumeCmdF(s.client, &cobra.Command{}, []string{mockJob.Id})
+		s.Require().NotNil(err)
+		s.Empty(printer.GetLines())
+	})
+}
+
 func (s *MmctlUnitTestSuite) TestImportJobListCmdF() {
 	s.Run("no import jobs", func() {
 		printer.Clean()
@@ -235,6 +321,78 @@ func (s *MmctlUnitTestSuite) TestImportProcessCmdF() {
 	s.Equal(mockJob, printer.GetLines()[0].(*model.Job))
 }
 
+func (s *MmctlUnitTestSuite) TestImportTeamsCmdF() {
+	printer.Clean()
+	importFile := "teams_export.zip"
+	mockJob := &model.Job{
+		Type: model.JobTypeTeamsImport,
+		Data: map[string]string{
+			"import_file":     importFile,
+			"local_mode":      "false",
+			"extract_content": "false",
+		},
+	}
+
+	s.client.
+		EXPECT().
+		CreateJob(context.TODO(), mockJob).
+		Return(mockJob, &model.Response{}, nil).
+		Times(1)
+
+	err := importTeamsCmdF(s.client, &cobra.Command{}, []string{importFile})
+	s.Require().Nil(err)
+	s.Len(printer.GetLines(), 1)
+	s.Empty(printer.GetErrorLines())
+	s.Equal(mockJob, printer.GetLines()[0].(*model.Job))
+}
+
+func (s *MmctlUnitTestSuite) TestImportMergeCmdF() {
+	importFile := "export.zip"
+	mappingFile := filepath.Join(s.T().TempDir(), "mapping.json")
+	mapping := &model.ImportMergeMapping{
+		Users:         map[string]string{"john": "john.acme"},
+		Teams:         map[string]string{"engineering": "acme-engineering"},
+		SourceSiteURL: "https://chat.acme.com",
+	}
+	data, err := json.Marshal(mapping)
+	s.Require().NoError(err)
+	s.Require().NoError(os.WriteFile(mappingFile, data, 0600))
+
+	s.Run("merge", func() {
+		printer.Clean()
+		mockJob := &model.Job{Id: model.NewId(), Type: model.JobTypeImportMerge}
+
+		s.client.
+			EXPECT().
+			MergeImport(context.TODO(), importFile, mapping, true).
+			Return(mockJob, &model.Response{}, nil).
+			Times(1)
+
+		cmd := &cobra.Command{}
+		cmd.Flags().String("mapping", mappingFile, "")
+		cmd.Flags().Bool("extract-content", true, "")
+
+		err := importMergeCmdF(s.client, cmd, []string{importFile})
+		s.Require().Nil(err)
+		s.Len(printer.GetLines(), 1)
+		s.Empty(printer.GetErrorLines())
+		s.Equal(mockJob, printer.GetLines()[0].(*model.Job))
+	})
+
+	s.Run("invalid mapping", func() {
+		printer.Clean()
+		invalidFile := filepath.Join(s.T().TempDir(), "invalid.json")
+		s.Require().NoError(os.WriteFile(invalidFile, []byte(`{"users":{"john":"John Doe"}}`), 0600))
+
+		cmd := &cobra.Command{}
+		cmd.Flags().String("mapping", invalidFile, "")
+
+		err := importMergeCmdF(s.client, cmd, []string{importFile})
+		s.Require().Error(err)
+		s.Empty(printer.GetLines())
+	})
+}
+
 func (s *MmctlUnitTestSuite) TestImportValidateCmdF() {
 	importFilePath := filepath.Join(os.TempDir(), "import.zip")
 
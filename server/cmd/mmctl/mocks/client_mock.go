@@ -1549,6 +1549,22 @@ func (mr *MockClientMockRecorder) ListImports(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListImports", reflect.TypeOf((*MockClient)(nil).ListImports), arg0)
 }
 
+// MergeImport mocks base method.
+func (m *MockClient) MergeImport(arg0 context.Context, arg1 string, arg2 *model.ImportMergeMapping, arg3 bool) (*model.Job, *model.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeImport", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*model.Job)
+	ret1, _ := ret[1].(*model.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// MergeImport indicates an expected call of MergeImport.
+func (mr *MockClientMockRecorder) MergeImport(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeImport", reflect.TypeOf((*MockClient)(nil).MergeImport), arg0, arg1, arg2, arg3)
+}
+
 // MigrateAuthToLdap mocks base method.
 func (m *MockClient) MigrateAuthToLdap(arg0 context.Context, arg1, arg2 string, arg3 bool) (*model.Response, error) {
 	m.ctrl.T.Helper()
@@ -63,6 +63,7 @@ func GenerateClientConfig(c *model.Config, telemetryID string, license *model.Li
 
 	props["SendEmailNotifications"] = strconv.FormatBool(*c.EmailSettings.SendEmailNotifications)
 	props["SendPushNotifications"] = strconv.FormatBool(*c.EmailSettings.SendPushNotifications)
+	props["EnableWebPushNotifications"] = strconv.FormatBool(*c.EmailSettings.EnableWebPushNotifications)
 	props["RequireEmailVerification"] = strconv.FormatBool(*c.EmailSettings.RequireEmailVerification)
 	props["EnableEmailBatching"] = strconv.FormatBool(*c.EmailSettings.EnableEmailBatching)
 	props["EnablePreviewModeBanner"] = strconv.FormatBool(*c.EmailSettings.EnablePreviewModeBanner)
@@ -144,6 +145,8 @@ func GenerateClientConfig(c *model.Config, telemetryID string, license *model.Li
 	props["AllowSyncedDrafts"] = strconv.FormatBool(*c.ServiceSettings.AllowSyncedDrafts)
 	props["DelayChannelAutocomplete"] = strconv.FormatBool(*c.ExperimentalSettings.DelayChannelAutocomplete)
 	props["UniqueEmojiReactionLimitPerPost"] = strconv.FormatInt(int64(*c.ServiceSettings.UniqueEmojiReactionLimitPerPost), 10)
+	props["EnableMaintenanceMode"] = strconv.FormatBool(*c.ServiceSettings.EnableMaintenanceMode)
+	props["MaintenanceModeMessage"] = *c.ServiceSettings.MaintenanceModeMessage
 
 	props["WranglerPermittedWranglerRoles"] = strings.Join(c.WranglerSettings.PermittedWranglerRoles, ",")
 	props["WranglerAllowedEmailDomain"] = strings.Join(c.WranglerSettings.AllowedEmailDomain, ",")
@@ -359,6 +362,7 @@ func GenerateLimitedClientConfig(c *model.Config, telemetryID string, license *m
 		if *license.Features.CustomTermsOfService {
 			props["EnableCustomTermsOfService"] = strconv.FormatBool(*c.SupportSettings.CustomTermsOfServiceEnabled)
 			props["CustomTermsOfServiceReAcceptancePeriod"] = strconv.FormatInt(int64(*c.SupportSettings.CustomTermsOfServiceReAcceptancePeriod), 10)
+			props["CustomTermsOfServiceGracePeriod"] = strconv.FormatInt(int64(*c.SupportSettings.CustomTermsOfServiceGracePeriod), 10)
 		}
 
 		if *license.Features.MFA {
@@ -45,6 +45,8 @@ var configSensitivePaths = map[string]bool{
 	"SqlSettings.DataSourceReplicas":                         true,
 	"SqlSettings.DataSourceSearchReplicas":                   true,
 	"EmailSettings.SMTPPassword":                             true,
+	"EmailSettings.InboundEmailSecret":                       true,
+	"EmailSettings.EmailEventsSecret":                        true,
 	"GitLabSettings.Secret":                                  true,
 	"GoogleSettings.Secret":                                  true,
 	"Office365Settings.Secret":                               true,
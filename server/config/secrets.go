@@ -0,0 +1,355 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+// SecretsRefreshInterval is how often the secrets referenced by the config are resolved again, so
+// rotated credentials are picked up without a restart.
+var SecretsRefreshInterval = 10 * time.Minute
+
+// SecretsFileDirsEnv names the environment variable listing the directories, separated like
+// PATH, that the file secrets provider may read from. Without it, file references are rejected.
+const SecretsFileDirsEnv = "MM_SECRETS_FILE_DIRS"
+
+// SecretsProvider resolves the secrets referenced by config values. A config string value is a
+// secret reference when it is a URI whose scheme has a registered provider, e.g.
+// vault://secret/data/mattermost#smtp_password. Only the sensitive settings, those masked by
+// model.Config.Sanitize, may hold a secret reference; other settings are used as they are.
+type SecretsProvider interface {
+	Resolve(uri *url.URL) (string, error)
+}
+
+var (
+	secretsProvidersLock sync.RWMutex
+	secretsProviders     = map[string]SecretsProvider{
+		"file":  fileSecretsProvider{},
+		"vault": vaultSecretsProvider{},
+		"awssm": awsSecretsManagerProvider{},
+	}
+)
+
+// RegisterSecretsProvider registers the provider resolving the secret references with the given
+// URI scheme, replacing any existing one.
+func RegisterSecretsProvider(scheme string, provider SecretsProvider) {
+	secretsProvidersLock.Lock()
+	defer secretsProvidersLock.Unlock()
+	secretsProviders[scheme] = provider
+}
+
+// parseSecretReference returns the parsed URI and its provider if the value is a secret reference.
+func parseSecretReference(value string) (*url.URL, SecretsProvider, bool) {
+	scheme, _, found := strings.Cut(value, "://")
+	if !found {
+		return nil, nil, false
+	}
+
+	secretsProvidersLock.RLock()
+	provider, ok := secretsProviders[scheme]
+	secretsProvidersLock.RUnlock()
+	if !ok {
+		return nil, nil, false
+	}
+
+	uri, err := url.Parse(value)
+	if err != nil {
+		return nil, nil, false
+	}
+
+	return uri, provider, true
+}
+
+// secretSettings returns the paths of the sensitive string settings, found by sanitizing a config
+// whose string settings are all set.
+var secretSettings = sync.OnceValue(func() map[string]bool {
+	cfg := &model.Config{}
+	cfg.SetDefaults()
+	walkStringFields(cfg, func(_ string, value *string) {
+		*value = "secret"
+	})
+	cfg.Sanitize()
+
+	paths := map[string]bool{}
+	walkStringFields(cfg, func(path string, value *string) {
+		if *value == model.FakeSetting {
+			paths[path] = true
+		}
+	})
+	return paths
+})
+
+// walkSecretReferences calls fn with the path, a pointer to the value and the parsed reference of
+// every sensitive setting of the config holding a secret reference.
+func walkSecretReferences(cfg *model.Config, fn func(path string, value *string, uri *url.URL, provider SecretsProvider)) {
+	settings := secretSettings()
+	walkStringFields(cfg, func(path string, value *string) {
+		if !settings[path] {
+			return
+		}
+		if uri, provider, ok := parseSecretReference(*value); ok {
+			fn(path, value, uri, provider)
+		}
+	})
+}
+
+// NewSecretReferences returns the sensitive settings of cfg holding a secret reference that the
+// persisted config doesn't already hold, which callers use to keep references from being
+// introduced through the API rather than the config file or the environment.
+func NewSecretReferences(cfg, persistedCfg *model.Config) []string {
+	persisted := map[string]string{}
+	walkSecretReferences(persistedCfg, func(path string, value *string, _ *url.URL, _ SecretsProvider) {
+		persisted[path] = *value
+	})
+
+	var paths []string
+	walkSecretReferences(cfg, func(path string, value *string, _ *url.URL, _ SecretsProvider) {
+		if persisted[path] != *value {
+			paths = append(paths, path)
+		}
+	})
+	return paths
+}
+
+// walkStringFields calls fn with the path and a pointer to every string setting of the config.
+// Maps and slices, such as the plugin settings, are skipped.
+func walkStringFields(cfg *model.Config, fn func(path string, value *string)) {
+	var walk func(v reflect.Value, path string)
+	walk = func(v reflect.Value, path string) {
+		switch v.Kind() {
+		case reflect.Ptr:
+			if !v.IsNil() {
+				walk(v.Elem(), path)
+			}
+		case reflect.Struct:
+			for i := 0; i < v.NumField(); i++ {
+				field := v.Type().Field(i)
+				if !field.IsExported() {
+					continue
+				}
+				fieldPath := field.Name
+				if path != "" {
+					fieldPath = path + "." + field.Name
+				}
+				walk(v.Field(i), fieldPath)
+			}
+		case reflect.String:
+			if v.CanSet() {
+				fn(path, v.Addr().Interface().(*string))
+			}
+		}
+	}
+	walk(reflect.ValueOf(cfg), "")
+}
+
+func hasSecretReferences(cfg *model.Config) bool {
+	found := false
+	walkSecretReferences(cfg, func(_ string, _ *string, _ *url.URL, _ SecretsProvider) {
+		found = true
+	})
+	return found
+}
+
+// resolveSecrets returns a copy of the config with the secret references replaced by the secrets.
+func resolveSecrets(cfg *model.Config) (*model.Config, error) {
+	if !hasSecretReferences(cfg) {
+		return cfg, nil
+	}
+
+	resolved := cfg.Clone()
+	var resolveErr error
+	walkSecretReferences(resolved, func(path string, value *string, uri *url.URL, provider SecretsProvider) {
+		if resolveErr != nil {
+			return
+		}
+
+		secret, err := provider.Resolve(uri)
+		if err != nil {
+			resolveErr = errors.Wrapf(err, "failed to resolve the secret of %s", path)
+			return
+		}
+		*value = secret
+	})
+	if resolveErr != nil {
+		return nil, resolveErr
+	}
+
+	return resolved, nil
+}
+
+// restoreSecretReferences puts back the secret references of the old config into the settings
+// of the new config that still hold the resolved secret, so they are never persisted.
+func restoreSecretReferences(cfg, oldCfgNoSecrets, oldCfg *model.Config) *model.Config {
+	references := map[string]string{}
+	walkSecretReferences(oldCfgNoSecrets, func(path string, value *string, _ *url.URL, _ SecretsProvider) {
+		references[path] = *value
+	})
+	if len(references) == 0 {
+		return cfg
+	}
+
+	secrets := map[string]string{}
+	walkStringFields(oldCfg, func(path string, value *string) {
+		if _, ok := references[path]; ok {
+			secrets[path] = *value
+		}
+	})
+
+	restored := cfg.Clone()
+	walkStringFields(restored, func(path string, value *string) {
+		if reference, ok := references[path]; ok && *value == secrets[path] {
+			*value = reference
+		}
+	})
+
+	return restored
+}
+
+// fileSecretsProvider reads secrets from files, e.g. file:///run/secrets/smtp_password, which is
+// how orchestrators such as Docker and Kubernetes usually mount them. The files must be within
+// one of the directories listed by SecretsFileDirsEnv.
+type fileSecretsProvider struct{}
+
+func (fileSecretsProvider) Resolve(uri *url.URL) (string, error) {
+	path, err := filepath.EvalSymlinks(uri.Path)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to read the secret file")
+	}
+
+	allowed := false
+	for _, dir := range filepath.SplitList(os.Getenv(SecretsFileDirsEnv)) {
+		if dir == "" {
+			continue
+		}
+		dir, err = filepath.EvalSymlinks(dir)
+		if err != nil {
+			continue
+		}
+		if rel, err := filepath.Rel(dir, path); err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			allowed = true
+			break
+		}
+	}
+	if !allowed {
+		return "", fmt.Errorf("the secret file is not within a directory listed by %s", SecretsFileDirsEnv)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to read the secret file")
+	}
+	return strings.TrimRight(string(data), "\r\n"), nil
+}
+
+// vaultSecretsProvider reads secrets from the HashiCorp Vault KV secrets engine, e.g.
+// vault://secret/data/mattermost#smtp_password. The Vault address and token are read from the
+// VAULT_ADDR and VAULT_TOKEN environment variables.
+type vaultSecretsProvider struct{}
+
+func (vaultSecretsProvider) Resolve(uri *url.URL) (string, error) {
+	addr := os.Getenv("VAULT_ADDR")
+	if addr == "" {
+		return "", errors.New("VAULT_ADDR is not set")
+	}
+	if uri.Fragment == "" {
+		return "", errors.New("the key of the secret is missing")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, strings.TrimRight(addr, "/")+"/v1/"+uri.Host+uri.Path, nil)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to create the Vault request")
+	}
+	req.Header.Set("X-Vault-Token", os.Getenv("VAULT_TOKEN"))
+
+	client := &http.Client{Timeout: 10 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to request the secret from Vault")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		io.Copy(io.Discard, resp.Body)
+		return "", fmt.Errorf("unexpected status code %d from Vault", resp.StatusCode)
+	}
+
+	var body struct {
+		Data map[string]any `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return "", errors.Wrap(err, "failed to decode the Vault response")
+	}
+
+	// Version 2 of the KV engine nests the secret in another data object.
+	data := body.Data
+	if nested, ok := data["data"].(map[string]any); ok {
+		data = nested
+	}
+
+	secret, ok := data[uri.Fragment].(string)
+	if !ok {
+		return "", fmt.Errorf("key %q not found in the Vault secret", uri.Fragment)
+	}
+	return secret, nil
+}
+
+// awsSecretsManagerProvider reads secrets from AWS Secrets Manager, e.g.
+// awssm://mattermost/smtp?region=us-east-1#password. Without a key, the whole secret string is
+// used. The AWS credentials are read from the default credential chain.
+type awsSecretsManagerProvider struct{}
+
+func (awsSecretsManagerProvider) Resolve(uri *url.URL) (string, error) {
+	awsConfig := aws.NewConfig()
+	if region := uri.Query().Get("region"); region != "" {
+		awsConfig = awsConfig.WithRegion(region)
+	}
+
+	sess, err := session.NewSessionWithOptions(session.Options{
+		Config:            *awsConfig,
+		SharedConfigState: session.SharedConfigEnable,
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to create the AWS session")
+	}
+
+	output, err := secretsmanager.New(sess).GetSecretValue(&secretsmanager.GetSecretValueInput{
+		SecretId: aws.String(uri.Host + uri.Path),
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to get the secret from AWS Secrets Manager")
+	}
+
+	secret := aws.StringValue(output.SecretString)
+	if uri.Fragment == "" {
+		return secret, nil
+	}
+
+	var values map[string]any
+	if err := json.Unmarshal([]byte(secret), &values); err != nil {
+		return "", errors.Wrap(err, "failed to decode the secret as JSON")
+	}
+	value, ok := values[uri.Fragment].(string)
+	if !ok {
+		return "", fmt.Errorf("key %q not found in the AWS secret", uri.Fragment)
+	}
+	return value, nil
+}
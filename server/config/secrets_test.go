@@ -0,0 +1,155 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package config
+
+import (
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+type testSecretsProvider struct {
+	secrets map[string]string
+}
+
+func (p *testSecretsProvider) Resolve(uri *url.URL) (string, error) {
+	secret, ok := p.secrets[uri.Host]
+	if !ok {
+		return "", os.ErrNotExist
+	}
+	return secret, nil
+}
+
+func TestSecretsFileProvider(t *testing.T) {
+	root := t.TempDir()
+	dir := filepath.Join(root, "secrets")
+	require.NoError(t, os.Mkdir(dir, 0700))
+	t.Setenv(SecretsFileDirsEnv, dir)
+	path := filepath.Join(dir, "smtp_password")
+	require.NoError(t, os.WriteFile(path, []byte("s3cr3t\n"), 0600))
+
+	cfg := &model.Config{}
+	cfg.SetDefaults()
+	cfg.EmailSettings.SMTPPassword = model.NewString("file://" + path)
+
+	resolved, err := resolveSecrets(cfg)
+	require.NoError(t, err)
+	assert.Equal(t, "s3cr3t", *resolved.EmailSettings.SMTPPassword)
+	assert.Equal(t, "file://"+path, *cfg.EmailSettings.SMTPPassword, "the input config must not be modified")
+
+	cfg.EmailSettings.SMTPPassword = model.NewString("file:///does/not/exist")
+	_, err = resolveSecrets(cfg)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "EmailSettings.SMTPPassword")
+
+	t.Run("files outside the allowed directories are rejected", func(t *testing.T) {
+		outside := filepath.Join(root, "smtp_password")
+		require.NoError(t, os.WriteFile(outside, []byte("s3cr3t"), 0600))
+
+		cfg.EmailSettings.SMTPPassword = model.NewString("file://" + outside)
+		_, err = resolveSecrets(cfg)
+		require.Error(t, err)
+
+		cfg.EmailSettings.SMTPPassword = model.NewString("file://" + dir + "/../smtp_password")
+		_, err = resolveSecrets(cfg)
+		require.Error(t, err)
+
+		t.Setenv(SecretsFileDirsEnv, "")
+		cfg.EmailSettings.SMTPPassword = model.NewString("file://" + path)
+		_, err = resolveSecrets(cfg)
+		require.Error(t, err)
+	})
+
+	t.Run("only sensitive settings are resolved", func(t *testing.T) {
+		cfg := &model.Config{}
+		cfg.SetDefaults()
+		cfg.TeamSettings.SiteName = model.NewString("file://" + path)
+
+		resolved, err := resolveSecrets(cfg)
+		require.NoError(t, err)
+		assert.Equal(t, "file://"+path, *resolved.TeamSettings.SiteName)
+	})
+}
+
+func TestNewSecretReferences(t *testing.T) {
+	persisted := &model.Config{}
+	persisted.SetDefaults()
+	persisted.EmailSettings.SMTPPassword = model.NewString("vault://secret/data/mattermost#smtp")
+
+	cfg := persisted.Clone()
+	assert.Empty(t, NewSecretReferences(cfg, persisted))
+
+	cfg.TeamSettings.SiteName = model.NewString("file:///proc/self/environ")
+	assert.Empty(t, NewSecretReferences(cfg, persisted), "non-sensitive settings are never resolved")
+
+	cfg.FileSettings.AmazonS3SecretAccessKey = model.NewString("awssm://mattermost/s3")
+	cfg.EmailSettings.SMTPPassword = model.NewString("vault://secret/data/other#smtp")
+	assert.ElementsMatch(t, []string{"FileSettings.AmazonS3SecretAccessKey", "EmailSettings.SMTPPassword"}, NewSecretReferences(cfg, persisted))
+}
+
+func TestSecretsStore(t *testing.T) {
+	setupConfigMemory(t)
+
+	provider := &testSecretsProvider{secrets: map[string]string{"smtp": "first"}}
+	RegisterSecretsProvider("test", provider)
+	defer func() {
+		secretsProvidersLock.Lock()
+		delete(secretsProviders, "test")
+		secretsProvidersLock.Unlock()
+	}()
+
+	initialCfg := &model.Config{}
+	initialCfg.SetDefaults()
+	initialCfg.EmailSettings.SMTPPassword = model.NewString("test://smtp")
+
+	ms, err := NewMemoryStoreWithOptions(&MemoryStoreOptions{InitialConfig: initialCfg})
+	require.NoError(t, err)
+	store, err := NewStoreFromBacking(ms, nil, false)
+	require.NoError(t, err)
+	defer store.Close()
+
+	t.Run("load resolves the secrets", func(t *testing.T) {
+		assert.Equal(t, "first", *store.Get().EmailSettings.SMTPPassword)
+		assert.Equal(t, "test://smtp", *store.GetNoEnv().EmailSettings.SMTPPassword)
+	})
+
+	t.Run("set keeps the references of unchanged secrets", func(t *testing.T) {
+		newCfg := store.Get()
+		newCfg.EmailSettings.SMTPUsername = model.NewString("mattermost")
+
+		_, _, err := store.Set(newCfg)
+		require.NoError(t, err)
+		assert.Equal(t, "first", *store.Get().EmailSettings.SMTPPassword)
+		assert.Equal(t, "test://smtp", *store.GetNoEnv().EmailSettings.SMTPPassword)
+		assert.Equal(t, "test://smtp", *ms.savedConfig.EmailSettings.SMTPPassword)
+	})
+
+	t.Run("refresh picks up rotated secrets", func(t *testing.T) {
+		var notified bool
+		listenerID := store.AddListener(func(oldCfg, newCfg *model.Config) {
+			notified = true
+			assert.Equal(t, "first", *oldCfg.EmailSettings.SMTPPassword)
+			assert.Equal(t, "second", *newCfg.EmailSettings.SMTPPassword)
+		})
+		defer store.RemoveListener(listenerID)
+
+		provider.secrets["smtp"] = "second"
+		require.NoError(t, store.RefreshSecrets())
+		assert.True(t, notified)
+		assert.Equal(t, "second", *store.Get().EmailSettings.SMTPPassword)
+		assert.Equal(t, "test://smtp", *store.GetNoEnv().EmailSettings.SMTPPassword)
+	})
+
+	t.Run("refresh keeps the previous secrets on failure", func(t *testing.T) {
+		delete(provider.secrets, "smtp")
+		require.Error(t, store.RefreshSecrets())
+		assert.Equal(t, "second", *store.Get().EmailSettings.SMTPPassword)
+	})
+}
@@ -13,6 +13,7 @@ import (
 
 	"github.com/mattermost/mattermost/server/public/model"
 	"github.com/mattermost/mattermost/server/public/shared/i18n"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
 	"github.com/mattermost/mattermost/server/public/utils"
 )
 
@@ -35,6 +36,9 @@ type Store struct {
 
 	readOnly   bool
 	readOnlyFF bool
+
+	secretsRefreshStop     chan struct{}
+	secretsRefreshStopOnce sync.Once
 }
 
 // BackingStore defines the behaviour exposed by the underlying store
@@ -74,6 +78,7 @@ func NewStoreFromBacking(backingStore BackingStore, customDefaults *model.Config
 		configCustomDefaults: customDefaults,
 		readOnly:             readOnly,
 		readOnlyFF:           true,
+		secretsRefreshStop:   make(chan struct{}),
 	}
 
 	if err := store.Load(); err != nil {
@@ -182,20 +187,29 @@ func (s *Store) Set(newCfg *model.Config) (*model.Config, *model.Config, error)
 
 	// Sometimes the config is received with "fake" data in sensitive fields. Apply the real
 	// data from the existing config as necessary.
-	desanitize(oldCfg, newCfg)
+	Desanitize(oldCfg, newCfg)
 
 	// We apply back environment overrides since the input config may or
 	// may not have them applied.
 	newCfg = applyEnvironmentMap(newCfg, GetEnvironment())
 	fixConfig(newCfg)
 
+	// We attempt to remove any environment override that may be present in the input config.
+	newCfgNoEnv := removeEnvOverrides(newCfg, oldCfgNoEnv, s.GetEnvironmentOverrides())
+
+	// The input config holds the resolved secrets of the settings that weren't changed, which
+	// must be persisted as references.
+	newCfgNoEnv = restoreSecretReferences(newCfgNoEnv, oldCfgNoEnv, oldCfg)
+
+	newCfg, err := resolveSecrets(newCfg)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to resolve secrets")
+	}
+
 	if err := newCfg.IsValid(); err != nil {
 		return nil, nil, errors.Wrap(err, "new configuration is invalid")
 	}
 
-	// We attempt to remove any environment override that may be present in the input config.
-	newCfgNoEnv := removeEnvOverrides(newCfg, oldCfgNoEnv, s.GetEnvironmentOverrides())
-
 	// Don't store feature flags unless we are on MM cloud
 	// MM cloud uses config in the DB as a cache of the feature flag
 	// settings in case the management system is down when a pod starts.
@@ -291,6 +305,10 @@ func (s *Store) Load() error {
 
 	loadedCfg = applyEnvironmentMap(loadedCfg, GetEnvironment())
 	fixConfig(loadedCfg)
+	loadedCfg, err = resolveSecrets(loadedCfg)
+	if err != nil {
+		return errors.Wrap(err, "failed to resolve secrets")
+	}
 	if appErr := loadedCfg.IsValid(); appErr != nil {
 		// Translating the error before displaying it in the console.
 		// Defaulting to english for server side language.
@@ -385,8 +403,71 @@ func (s *Store) String() string {
 	return s.backingStore.String()
 }
 
+// RefreshSecrets resolves the secrets referenced by the config again, notifying the listeners if
+// any of them changed.
+func (s *Store) RefreshSecrets() error {
+	s.configLock.Lock()
+
+	cfg := applyEnvironmentMap(s.configNoEnv, GetEnvironment())
+	fixConfig(cfg)
+	if !hasSecretReferences(cfg) {
+		s.configLock.Unlock()
+		return nil
+	}
+
+	newCfg, err := resolveSecrets(cfg)
+	if err != nil {
+		s.configLock.Unlock()
+		return err
+	}
+	newCfg.FeatureFlags = s.config.FeatureFlags
+
+	hasChanged, err := equal(s.config, newCfg)
+	if err != nil {
+		s.configLock.Unlock()
+		return errors.Wrap(err, "failed to compare configs")
+	}
+	if !hasChanged {
+		s.configLock.Unlock()
+		return nil
+	}
+
+	oldCfg := s.config
+	s.config = newCfg
+	newCfgCopy := newCfg.Clone()
+	s.configLock.Unlock()
+
+	s.invokeConfigListeners(oldCfg, newCfgCopy)
+
+	return nil
+}
+
+// StartSecretsRefresh resolves the secrets referenced by the config again every
+// SecretsRefreshInterval until the store is closed. Only the store of a running server needs it.
+func (s *Store) StartSecretsRefresh() {
+	go s.refreshSecretsLoop()
+}
+
+func (s *Store) refreshSecretsLoop() {
+	ticker := time.NewTicker(SecretsRefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := s.RefreshSecrets(); err != nil {
+				mlog.Warn("Failed to refresh the config secrets, keeping the previous values", mlog.Err(err))
+			}
+		case <-s.secretsRefreshStop:
+			return
+		}
+	}
+}
+
 // Close cleans up resources associated with the store.
 func (s *Store) Close() error {
+	s.secretsRefreshStopOnce.Do(func() { close(s.secretsRefreshStop) })
+
 	s.configLock.Lock()
 	defer s.configLock.Unlock()
 	return s.backingStore.Close()
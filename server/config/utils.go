@@ -21,8 +21,8 @@ func marshalConfig(cfg *model.Config) ([]byte, error) {
 	return json.MarshalIndent(cfg, "", "    ")
 }
 
-// desanitize replaces fake settings with their actual values.
-func desanitize(actual, target *model.Config) {
+// Desanitize replaces fake settings with their actual values.
+func Desanitize(actual, target *model.Config) {
 	if target.LdapSettings.BindPassword != nil && *target.LdapSettings.BindPassword == model.FakeSetting {
 		*target.LdapSettings.BindPassword = *actual.LdapSettings.BindPassword
 	}
@@ -38,6 +38,14 @@ func desanitize(actual, target *model.Config) {
 		target.EmailSettings.SMTPPassword = actual.EmailSettings.SMTPPassword
 	}
 
+	if *target.EmailSettings.InboundEmailSecret == model.FakeSetting {
+		target.EmailSettings.InboundEmailSecret = actual.EmailSettings.InboundEmailSecret
+	}
+
+	if *target.EmailSettings.EmailEventsSecret == model.FakeSetting {
+		target.EmailSettings.EmailEventsSecret = actual.EmailSettings.EmailEventsSecret
+	}
+
 	if *target.GitLabSettings.Secret == model.FakeSetting {
 		target.GitLabSettings.Secret = actual.GitLabSettings.Secret
 	}
@@ -26,6 +26,8 @@ func TestDesanitize(t *testing.T) {
 	actual.FileSettings.PublicLinkSalt = model.NewString("public_link_salt")
 	actual.FileSettings.AmazonS3SecretAccessKey = model.NewString("amazon_s3_secret_access_key")
 	actual.EmailSettings.SMTPPassword = model.NewString("smtp_password")
+	actual.EmailSettings.InboundEmailSecret = model.NewString("inbound_email_secret")
+	actual.EmailSettings.EmailEventsSecret = model.NewString("email_events_secret")
 	actual.GitLabSettings.Secret = model.NewString("secret")
 	actual.OpenIdSettings.Secret = model.NewString("secret")
 	actual.SqlSettings.DataSource = model.NewString("data_source")
@@ -48,6 +50,8 @@ func TestDesanitize(t *testing.T) {
 	target.FileSettings.PublicLinkSalt = model.NewString(model.FakeSetting)
 	target.FileSettings.AmazonS3SecretAccessKey = model.NewString(model.FakeSetting)
 	target.EmailSettings.SMTPPassword = model.NewString(model.FakeSetting)
+	target.EmailSettings.InboundEmailSecret = model.NewString(model.FakeSetting)
+	target.EmailSettings.EmailEventsSecret = model.NewString(model.FakeSetting)
 	target.GitLabSettings.Secret = model.NewString(model.FakeSetting)
 	target.OpenIdSettings.Secret = model.NewString(model.FakeSetting)
 	target.SqlSettings.DataSource = model.NewString(model.FakeSetting)
@@ -57,7 +61,7 @@ func TestDesanitize(t *testing.T) {
 	target.SqlSettings.DataSourceSearchReplicas = []string{model.FakeSetting, model.FakeSetting}
 
 	actualClone := actual.Clone()
-	desanitize(actual, target)
+	Desanitize(actual, target)
 	assert.Equal(t, actualClone, actual, "actual should not have been changed")
 
 	// Verify the settings that should have been left untouched in target
@@ -69,6 +73,8 @@ func TestDesanitize(t *testing.T) {
 	assert.Equal(t, *actual.FileSettings.PublicLinkSalt, *target.FileSettings.PublicLinkSalt)
 	assert.Equal(t, *actual.FileSettings.AmazonS3SecretAccessKey, *target.FileSettings.AmazonS3SecretAccessKey)
 	assert.Equal(t, *actual.EmailSettings.SMTPPassword, *target.EmailSettings.SMTPPassword)
+	assert.Equal(t, *actual.EmailSettings.InboundEmailSecret, *target.EmailSettings.InboundEmailSecret)
+	assert.Equal(t, *actual.EmailSettings.EmailEventsSecret, *target.EmailSettings.EmailEventsSecret)
 	assert.Equal(t, *actual.GitLabSettings.Secret, *target.GitLabSettings.Secret)
 	assert.Equal(t, *actual.OpenIdSettings.Secret, *target.OpenIdSettings.Secret)
 	assert.Equal(t, *actual.SqlSettings.DataSource, *target.SqlSettings.DataSource)
@@ -44,6 +44,7 @@ type MetricsInterface interface {
 
 	IncrementWebsocketEvent(eventType model.WebsocketEventType)
 	IncrementWebSocketBroadcast(eventType model.WebsocketEventType)
+	ObserveWebSocketBroadcastDuration(eventType model.WebsocketEventType, elapsed float64)
 	IncrementWebSocketBroadcastBufferSize(hub string, amount float64)
 	DecrementWebSocketBroadcastBufferSize(hub string, amount float64)
 	IncrementWebSocketBroadcastUsersRegistered(hub string, amount float64)
@@ -62,6 +63,9 @@ type MetricsInterface interface {
 	ObserveFilesSearchDuration(elapsed float64)
 	ObserveStoreMethodDuration(method, success string, elapsed float64)
 	ObserveAPIEndpointDuration(endpoint, method, statusCode, originClient, pageLoadContext string, elapsed float64)
+	ObserveAPIRouteDuration(route, method string, elapsed float64)
+	IncrementAPIRouteResponse(route, method, statusCode string)
+	ObserveSlowOperation(kind string, elapsed float64)
 	IncrementPostIndexCounter()
 	IncrementFileIndexCounter()
 	IncrementUserIndexCounter()
@@ -68,6 +68,11 @@ func (_m *MetricsInterface) GetLoggerMetricsCollector() logr.MetricsCollector {
 	return r0
 }
 
+// IncrementAPIRouteResponse provides a mock function with given fields: route, method, statusCode
+func (_m *MetricsInterface) IncrementAPIRouteResponse(route string, method string, statusCode string) {
+	_m.Called(route, method, statusCode)
+}
+
 // IncrementChannelIndexCounter provides a mock function with given fields:
 func (_m *MetricsInterface) IncrementChannelIndexCounter() {
 	_m.Called()
@@ -298,6 +303,11 @@ func (_m *MetricsInterface) ObserveAPIEndpointDuration(endpoint string, method s
 	_m.Called(endpoint, method, statusCode, originClient, pageLoadContext, elapsed)
 }
 
+// ObserveAPIRouteDuration provides a mock function with given fields: route, method, elapsed
+func (_m *MetricsInterface) ObserveAPIRouteDuration(route string, method string, elapsed float64) {
+	_m.Called(route, method, elapsed)
+}
+
 // ObserveClientChannelSwitchDuration provides a mock function with given fields: platform, agent, elapsed
 func (_m *MetricsInterface) ObserveClientChannelSwitchDuration(platform string, agent string, elapsed float64) {
 	_m.Called(platform, agent, elapsed)
@@ -428,11 +438,21 @@ func (_m *MetricsInterface) ObserveSharedChannelsTaskInQueueDuration(elapsed flo
 	_m.Called(elapsed)
 }
 
+// ObserveSlowOperation provides a mock function with given fields: kind, elapsed
+func (_m *MetricsInterface) ObserveSlowOperation(kind string, elapsed float64) {
+	_m.Called(kind, elapsed)
+}
+
 // ObserveStoreMethodDuration provides a mock function with given fields: method, success, elapsed
 func (_m *MetricsInterface) ObserveStoreMethodDuration(method string, success string, elapsed float64) {
 	_m.Called(method, success, elapsed)
 }
 
+// ObserveWebSocketBroadcastDuration provides a mock function with given fields: eventType, elapsed
+func (_m *MetricsInterface) ObserveWebSocketBroadcastDuration(eventType model.WebsocketEventType, elapsed float64) {
+	_m.Called(eventType, elapsed)
+}
+
 // Register provides a mock function with given fields:
 func (_m *MetricsInterface) Register() {
 	_m.Called()
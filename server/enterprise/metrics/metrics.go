@@ -159,6 +159,7 @@ type MetricsInterfaceImpl struct {
 
 	WebSocketBroadcastOther                      prometheus.Counter
 	WebSocketBroadcastBufferGauge                *prometheus.GaugeVec
+	WebSocketBroadcastTimesHistograms            *prometheus.HistogramVec
 	WebSocketBroadcastBufferUsersRegisteredGauge *prometheus.GaugeVec
 	WebSocketReconnectCounter                    *prometheus.CounterVec
 
@@ -168,6 +169,9 @@ type MetricsInterfaceImpl struct {
 	SearchFileSearchesDuration prometheus.Histogram
 	StoreTimesHistograms       *prometheus.HistogramVec
 	APITimesHistograms         *prometheus.HistogramVec
+	APIRouteTimesHistograms    *prometheus.HistogramVec
+	APIRouteResponseCounters   *prometheus.CounterVec
+	SlowOperationSummaries     *prometheus.SummaryVec
 	SearchPostIndexCounter     prometheus.Counter
 	SearchFileIndexCounter     prometheus.Counter
 	SearchUserIndexCounter     prometheus.Counter
@@ -646,6 +650,19 @@ func New(ps *platform.PlatformService, driver, dataSource string) *MetricsInterf
 	)
 	m.Registry.MustRegister(m.WebSocketBroadcastBufferGauge)
 
+	m.WebSocketBroadcastTimesHistograms = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace:   MetricsNamespace,
+			Subsystem:   MetricsSubsystemWebsocket,
+			Name:        "broadcast_duration_seconds",
+			Help:        "Time from queuing a websocket event for broadcast until it is sent to the connections",
+			Buckets:     []float64{0.001, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5},
+			ConstLabels: additionalLabels,
+		},
+		[]string{"type"},
+	)
+	m.Registry.MustRegister(m.WebSocketBroadcastTimesHistograms)
+
 	m.WebSocketBroadcastBufferUsersRegisteredGauge = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Namespace:   MetricsNamespace,
@@ -741,6 +758,43 @@ func New(ps *platform.PlatformService, driver, dataSource string) *MetricsInterf
 	)
 	m.Registry.MustRegister(m.APITimesHistograms)
 
+	m.APIRouteTimesHistograms = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace:   MetricsNamespace,
+			Subsystem:   MetricsSubsystemAPI,
+			Name:        "route_duration_seconds",
+			Help:        "Time to serve the requests of an API route",
+			ConstLabels: additionalLabels,
+		},
+		[]string{"route", "method"},
+	)
+	m.Registry.MustRegister(m.APIRouteTimesHistograms)
+
+	m.APIRouteResponseCounters = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace:   MetricsNamespace,
+			Subsystem:   MetricsSubsystemAPI,
+			Name:        "route_responses_total",
+			Help:        "Total number of responses of an API route by status code",
+			ConstLabels: additionalLabels,
+		},
+		[]string{"route", "method", "status_code"},
+	)
+	m.Registry.MustRegister(m.APIRouteResponseCounters)
+
+	m.SlowOperationSummaries = prometheus.NewSummaryVec(
+		prometheus.SummaryOpts{
+			Namespace:   MetricsNamespace,
+			Subsystem:   MetricsSubsystemSystem,
+			Name:        "slow_operation_duration_seconds",
+			Help:        "Time taken by the store queries and API requests exceeding the slow operation thresholds",
+			ConstLabels: additionalLabels,
+			Objectives:  map[float64]float64{0.5: 0.05, 0.9: 0.01, 0.99: 0.001},
+		},
+		[]string{"kind"},
+	)
+	m.Registry.MustRegister(m.SlowOperationSummaries)
+
 	m.SearchPostIndexCounter = prometheus.NewCounter(prometheus.CounterOpts{
 		Namespace:   MetricsNamespace,
 		Subsystem:   MetricsSubsystemSearch,
@@ -1439,6 +1493,18 @@ func (mi *MetricsInterfaceImpl) ObserveAPIEndpointDuration(handler, method, stat
 	mi.APITimesHistograms.With(prometheus.Labels{"handler": handler, "method": method, "status_code": statusCode, "origin_client": originClient, "page_load_context": pageLoadContext}).Observe(elapsed)
 }
 
+func (mi *MetricsInterfaceImpl) ObserveAPIRouteDuration(route, method string, elapsed float64) {
+	mi.APIRouteTimesHistograms.With(prometheus.Labels{"route": route, "method": method}).Observe(elapsed)
+}
+
+func (mi *MetricsInterfaceImpl) IncrementAPIRouteResponse(route, method, statusCode string) {
+	mi.APIRouteResponseCounters.With(prometheus.Labels{"route": route, "method": method, "status_code": statusCode}).Inc()
+}
+
+func (mi *MetricsInterfaceImpl) ObserveSlowOperation(kind string, elapsed float64) {
+	mi.SlowOperationSummaries.With(prometheus.Labels{"kind": kind}).Observe(elapsed)
+}
+
 func (mi *MetricsInterfaceImpl) IncrementClusterEventType(eventType model.ClusterEvent) {
 	switch eventType {
 	case model.ClusterEventPublish:
@@ -1518,6 +1584,15 @@ func (mi *MetricsInterfaceImpl) IncrementWebsocketEvent(eventType model.Websocke
 	mi.WebsocketEventCounters.With(prometheus.Labels{"type": string(eventType)}).Inc()
 }
 
+// ObserveWebSocketBroadcastDuration records the broadcast latency of a websocket event. The events
+// sent by plugins are recorded under a single type to bound the cardinality of the metric.
+func (mi *MetricsInterfaceImpl) ObserveWebSocketBroadcastDuration(eventType model.WebsocketEventType, elapsed float64) {
+	if strings.HasPrefix(string(eventType), "custom_") {
+		eventType = "custom"
+	}
+	mi.WebSocketBroadcastTimesHistograms.With(prometheus.Labels{"type": string(eventType)}).Observe(elapsed)
+}
+
 func (mi *MetricsInterfaceImpl) IncrementWebsocketReconnectEvent(eventType string) {
 	mi.WebSocketReconnectCounter.With(prometheus.Labels{"type": eventType}).Inc()
 }
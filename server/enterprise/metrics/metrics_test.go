@@ -176,6 +176,52 @@ func TestPluginMetrics(t *testing.T) {
 	})
 }
 
+func TestAPIRouteMetrics(t *testing.T) {
+	th := api4.SetupEnterprise(t, app.StartMetrics)
+	defer th.TearDown()
+
+	configureMetrics(th)
+	mi := th.App.Metrics()
+
+	miImpl, ok := mi.(*MetricsInterfaceImpl)
+	require.True(t, ok, fmt.Sprintf("App.Metrics is not *MetricsInterfaceImpl, but %T", mi))
+
+	t.Run("test ObserveAPIRouteDuration", func(t *testing.T) {
+		route := "/api/v4/users/{user_id}"
+		elapsed := 0.5
+		m := &prometheusModels.Metric{}
+
+		mi.ObserveAPIRouteDuration(route, "GET", elapsed)
+		actualMetric, err := miImpl.APIRouteTimesHistograms.GetMetricWith(prometheus.Labels{"route": route, "method": "GET"})
+		require.NoError(t, err)
+		require.NoError(t, actualMetric.(prometheus.Histogram).Write(m))
+		require.Equal(t, uint64(1), m.Histogram.GetSampleCount())
+		require.InDelta(t, elapsed, m.Histogram.GetSampleSum(), 0.001)
+	})
+
+	t.Run("test IncrementAPIRouteResponse", func(t *testing.T) {
+		route := "/api/v4/users/{user_id}"
+		m := &prometheusModels.Metric{}
+
+		mi.IncrementAPIRouteResponse(route, "GET", "404")
+		mi.IncrementAPIRouteResponse(route, "GET", "404")
+		mi.IncrementAPIRouteResponse(route, "GET", "200")
+		require.NoError(t, miImpl.APIRouteResponseCounters.With(prometheus.Labels{"route": route, "method": "GET", "status_code": "404"}).Write(m))
+		require.Equal(t, 2.0, m.Counter.GetValue())
+	})
+
+	t.Run("test ObserveWebSocketBroadcastDuration", func(t *testing.T) {
+		m := &prometheusModels.Metric{}
+
+		mi.ObserveWebSocketBroadcastDuration("custom_com.mattermost.demo_event", 0.1)
+		mi.ObserveWebSocketBroadcastDuration("custom_com.mattermost.other_event", 0.1)
+		actualMetric, err := miImpl.WebSocketBroadcastTimesHistograms.GetMetricWith(prometheus.Labels{"type": "custom"})
+		require.NoError(t, err)
+		require.NoError(t, actualMetric.(prometheus.Histogram).Write(m))
+		require.Equal(t, uint64(2), m.Histogram.GetSampleCount())
+	})
+}
+
 func TestExtractDBCluster(t *testing.T) {
 	testCases := []struct {
 		description         string
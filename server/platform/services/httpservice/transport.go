@@ -5,6 +5,9 @@ package httpservice
 
 import (
 	"net/http"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/ext"
 )
 
 // MattermostTransport is an implementation of http.RoundTripper that ensures each request contains a custom user agent
@@ -17,5 +20,27 @@ type MattermostTransport struct {
 func (t *MattermostTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 	req.Header.Set("User-Agent", defaultUserAgent)
 
-	return t.Transport.RoundTrip(req)
+	// When the request is made while tracing a request to the server, it is traced as well and
+	// the trace context is propagated to the remote server.
+	parent := opentracing.SpanFromContext(req.Context())
+	if parent == nil {
+		return t.Transport.RoundTrip(req)
+	}
+
+	span := opentracing.StartSpan("http:"+req.Method, opentracing.ChildOf(parent.Context()), ext.SpanKindRPCClient)
+	defer span.Finish()
+	ext.HTTPMethod.Set(span, req.Method)
+	ext.HTTPUrl.Set(span, req.URL.Scheme+"://"+req.URL.Host+req.URL.Path)
+	_ = opentracing.GlobalTracer().Inject(span.Context(), opentracing.HTTPHeaders, opentracing.HTTPHeadersCarrier(req.Header))
+
+	resp, err := t.Transport.RoundTrip(req)
+	if err != nil {
+		ext.LogError(span, err)
+		return resp, err
+	}
+	ext.HTTPStatusCode.Set(span, uint16(resp.StatusCode))
+	if resp.StatusCode >= http.StatusInternalServerError {
+		ext.Error.Set(span, true)
+	}
+	return resp, nil
 }
@@ -0,0 +1,251 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+// Package jsonlexport implements the line-delimited JSON compliance export format.
+//
+// An export consists of two files. Posts are written to PostsFileName, one event per line,
+// and the files attached to those posts are listed in AttachmentsFileName together with
+// their SHA-256 hash so that archiving systems can verify the copies they ingest.
+package jsonlexport
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/platform/shared/filestore"
+)
+
+const (
+	PostsFileName       = "posts.jsonl"
+	AttachmentsFileName = "attachments.jsonl"
+
+	// EventPost is the current version of a post.
+	EventPost = "post"
+	// EventEdit is a tombstone for a previous version of an edited post.
+	EventEdit = "edit"
+	// EventDelete is a tombstone for a deleted post.
+	EventDelete = "delete"
+)
+
+type Team struct {
+	Id          string `json:"id"`
+	Name        string `json:"name"`
+	DisplayName string `json:"display_name"`
+}
+
+type Channel struct {
+	Id          string            `json:"id"`
+	Name        string            `json:"name"`
+	DisplayName string            `json:"display_name"`
+	Type        model.ChannelType `json:"type"`
+}
+
+type User struct {
+	Id       string `json:"id"`
+	Username string `json:"username"`
+	Email    string `json:"email"`
+	IsBot    bool   `json:"is_bot"`
+}
+
+// Post is a line of the posts file. Id is always the stable id of the post, so all the
+// events of a post share it. For edit tombstones VersionId identifies the previous version.
+type Post struct {
+	Event     string   `json:"event"`
+	Id        string   `json:"id"`
+	VersionId string   `json:"version_id,omitempty"`
+	RootId    string   `json:"root_id,omitempty"`
+	CreateAt  int64    `json:"create_at"`
+	UpdateAt  int64    `json:"update_at"`
+	DeleteAt  int64    `json:"delete_at,omitempty"`
+	Team      *Team    `json:"team,omitempty"`
+	Channel   Channel  `json:"channel"`
+	User      User     `json:"user"`
+	Type      string   `json:"type,omitempty"`
+	Message   string   `json:"message,omitempty"`
+	Props     string   `json:"props,omitempty"`
+	FileIds   []string `json:"file_ids,omitempty"`
+}
+
+// Attachment is a line of the attachments manifest.
+type Attachment struct {
+	PostId   string `json:"post_id"`
+	FileId   string `json:"file_id"`
+	Name     string `json:"name"`
+	Path     string `json:"path"`
+	Size     int64  `json:"size"`
+	MimeType string `json:"mime_type,omitempty"`
+	SHA256   string `json:"sha256,omitempty"`
+	DeleteAt int64  `json:"delete_at,omitempty"`
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func int64Value(i *int64) int64 {
+	if i == nil {
+		return 0
+	}
+	return *i
+}
+
+// NewPost converts a row of the message export into a line of the posts file.
+func NewPost(m *model.MessageExport) *Post {
+	post := &Post{
+		Event:    EventPost,
+		Id:       stringValue(m.PostId),
+		RootId:   stringValue(m.PostRootId),
+		CreateAt: int64Value(m.PostCreateAt),
+		UpdateAt: int64Value(m.PostUpdateAt),
+		DeleteAt: int64Value(m.PostDeleteAt),
+		Channel: Channel{
+			Id:          stringValue(m.ChannelId),
+			Name:        stringValue(m.ChannelName),
+			DisplayName: stringValue(m.ChannelDisplayName),
+		},
+		User: User{
+			Id:       stringValue(m.UserId),
+			Username: stringValue(m.Username),
+			Email:    stringValue(m.UserEmail),
+			IsBot:    m.IsBot,
+		},
+		Type:    stringValue(m.PostType),
+		Message: stringValue(m.PostMessage),
+		Props:   stringValue(m.PostProps),
+		FileIds: m.PostFileIds,
+	}
+
+	if m.ChannelType != nil {
+		post.Channel.Type = *m.ChannelType
+	}
+
+	if m.TeamId != nil && *m.TeamId != "" {
+		post.Team = &Team{
+			Id:          *m.TeamId,
+			Name:        stringValue(m.TeamName),
+			DisplayName: stringValue(m.TeamDisplayName),
+		}
+	}
+
+	if post.DeleteAt > 0 {
+		if originalID := stringValue(m.PostOriginalId); originalID != "" {
+			// Editing a post keeps its id and archives the previous version as a deleted
+			// copy pointing at the original, so the copy is the tombstone of the edit.
+			post.Event = EventEdit
+			post.VersionId = post.Id
+			post.Id = originalID
+		} else {
+			// Deleted posts don't carry their content anymore.
+			post.Event = EventDelete
+			post.Message = ""
+			post.Props = ""
+			post.FileIds = nil
+		}
+	}
+
+	return post
+}
+
+// Writer writes an export to the posts file and the attachments manifest.
+type Writer struct {
+	posts       *json.Encoder
+	attachments *json.Encoder
+}
+
+func NewWriter(posts, attachments io.Writer) *Writer {
+	postsEncoder := json.NewEncoder(posts)
+	postsEncoder.SetEscapeHTML(false)
+	attachmentsEncoder := json.NewEncoder(attachments)
+	attachmentsEncoder.SetEscapeHTML(false)
+
+	return &Writer{
+		posts:       postsEncoder,
+		attachments: attachmentsEncoder,
+	}
+}
+
+func (w *Writer) WritePost(post *Post) error {
+	return errors.Wrap(w.posts.Encode(post), "failed to write post")
+}
+
+func (w *Writer) WriteAttachment(attachment *Attachment) error {
+	return errors.Wrap(w.attachments.Encode(attachment), "failed to write attachment")
+}
+
+// FileInfoGetter returns the files attached to a post, including deleted ones.
+type FileInfoGetter func(postID string) ([]*model.FileInfo, error)
+
+// Export writes a batch of the message export. The hash of an attachment is taken from its
+// file info when known, or computed from the file backend otherwise. Attachments that can't
+// be read are still listed without a hash and counted as warnings.
+func Export(rctx request.CTX, w *Writer, posts []*model.MessageExport, getFileInfos FileInfoGetter, backend filestore.FileBackend) (int64, error) {
+	var warnings int64
+	for _, m := range posts {
+		post := NewPost(m)
+		if err := w.WritePost(post); err != nil {
+			return warnings, err
+		}
+
+		if post.Event != EventPost || len(post.FileIds) == 0 {
+			continue
+		}
+
+		fileInfos, err := getFileInfos(post.Id)
+		if err != nil {
+			return warnings, errors.Wrapf(err, "failed to get attachments of post %s", post.Id)
+		}
+
+		for _, info := range fileInfos {
+			attachment := &Attachment{
+				PostId:   post.Id,
+				FileId:   info.Id,
+				Name:     info.Name,
+				Path:     info.Path,
+				Size:     info.Size,
+				MimeType: info.MimeType,
+				SHA256:   info.ContentHash,
+				DeleteAt: info.DeleteAt,
+			}
+
+			if attachment.SHA256 == "" {
+				hash, hashErr := hashFile(backend, info.Path)
+				if hashErr != nil {
+					rctx.Logger().Warn("Unable to hash attachment for export", mlog.String("post_id", post.Id), mlog.String("file_id", info.Id), mlog.Err(hashErr))
+					warnings++
+				}
+				attachment.SHA256 = hash
+			}
+
+			if err := w.WriteAttachment(attachment); err != nil {
+				return warnings, err
+			}
+		}
+	}
+
+	return warnings, nil
+}
+
+func hashFile(backend filestore.FileBackend, path string) (string, error) {
+	reader, err := backend.Reader(path)
+	if err != nil {
+		return "", err
+	}
+	defer reader.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, reader); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
@@ -0,0 +1,169 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package jsonlexport
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/platform/shared/filestore"
+)
+
+func newMessageExport(postID string) *model.MessageExport {
+	channelType := model.ChannelTypeOpen
+	return &model.MessageExport{
+		TeamId:             model.NewString(model.NewId()),
+		TeamName:           model.NewString("team"),
+		TeamDisplayName:    model.NewString("Team"),
+		ChannelId:          model.NewString(model.NewId()),
+		ChannelName:        model.NewString("channel"),
+		ChannelDisplayName: model.NewString("Channel"),
+		ChannelType:        &channelType,
+		UserId:             model.NewString(model.NewId()),
+		UserEmail:          model.NewString("user@example.com"),
+		Username:           model.NewString("user"),
+		PostId:             model.NewString(postID),
+		PostCreateAt:       model.NewInt64(1),
+		PostUpdateAt:       model.NewInt64(2),
+		PostDeleteAt:       model.NewInt64(0),
+		PostMessage:        model.NewString("message"),
+		PostType:           model.NewString(""),
+		PostRootId:         model.NewString(""),
+		PostProps:          model.NewString("{}"),
+		PostOriginalId:     model.NewString(""),
+	}
+}
+
+func readLines[T any](t *testing.T, data []byte) []*T {
+	var lines []*T
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		var line T
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &line))
+		lines = append(lines, &line)
+	}
+	require.NoError(t, scanner.Err())
+	return lines
+}
+
+func TestNewPost(t *testing.T) {
+	t.Run("post", func(t *testing.T) {
+		m := newMessageExport(model.NewId())
+		post := NewPost(m)
+
+		assert.Equal(t, EventPost, post.Event)
+		assert.Equal(t, *m.PostId, post.Id)
+		assert.Empty(t, post.VersionId)
+		assert.Equal(t, "message", post.Message)
+		require.NotNil(t, post.Team)
+		assert.Equal(t, "team", post.Team.Name)
+		assert.Equal(t, model.ChannelTypeOpen, post.Channel.Type)
+	})
+
+	t.Run("direct message", func(t *testing.T) {
+		m := newMessageExport(model.NewId())
+		m.TeamId = nil
+
+		post := NewPost(m)
+		assert.Nil(t, post.Team)
+	})
+
+	t.Run("edit tombstone", func(t *testing.T) {
+		originalID := model.NewId()
+		m := newMessageExport(model.NewId())
+		m.PostOriginalId = model.NewString(originalID)
+		m.PostDeleteAt = model.NewInt64(3)
+
+		post := NewPost(m)
+		assert.Equal(t, EventEdit, post.Event)
+		assert.Equal(t, originalID, post.Id)
+		assert.Equal(t, *m.PostId, post.VersionId)
+		assert.Equal(t, "message", post.Message, "the previous version keeps its content")
+	})
+
+	t.Run("delete tombstone", func(t *testing.T) {
+		m := newMessageExport(model.NewId())
+		m.PostDeleteAt = model.NewInt64(3)
+		m.PostFileIds = model.StringArray{model.NewId()}
+
+		post := NewPost(m)
+		assert.Equal(t, EventDelete, post.Event)
+		assert.Equal(t, *m.PostId, post.Id)
+		assert.Equal(t, int64(3), post.DeleteAt)
+		assert.Empty(t, post.Message)
+		assert.Empty(t, post.FileIds)
+	})
+}
+
+func TestExport(t *testing.T) {
+	rctx := request.TestContext(t)
+
+	backend, err := filestore.NewFileBackend(filestore.FileBackendSettings{
+		DriverName: model.ImageDriverLocal,
+		Directory:  t.TempDir(),
+	})
+	require.NoError(t, err)
+
+	content := []byte("attachment content")
+	_, err = backend.WriteFile(bytes.NewReader(content), "data/file.txt")
+	require.NoError(t, err)
+	sum := sha256.Sum256(content)
+	contentHash := hex.EncodeToString(sum[:])
+
+	withAttachments := newMessageExport(model.NewId())
+	withAttachments.PostFileIds = model.StringArray{model.NewId(), model.NewId(), model.NewId()}
+	deleted := newMessageExport(model.NewId())
+	deleted.PostDeleteAt = model.NewInt64(3)
+	deleted.PostFileIds = model.StringArray{model.NewId()}
+
+	fileInfos := map[string][]*model.FileInfo{
+		*withAttachments.PostId: {
+			{Id: withAttachments.PostFileIds[0], Name: "hashed.txt", Path: "data/hashed.txt", ContentHash: "known"},
+			{Id: withAttachments.PostFileIds[1], Name: "file.txt", Path: "data/file.txt", Size: int64(len(content))},
+			{Id: withAttachments.PostFileIds[2], Name: "missing.txt", Path: "data/missing.txt"},
+		},
+	}
+	getFileInfos := func(postID string) ([]*model.FileInfo, error) {
+		return fileInfos[postID], nil
+	}
+
+	var posts, attachments bytes.Buffer
+	warnings, err := Export(rctx, NewWriter(&posts, &attachments), []*model.MessageExport{
+		newMessageExport(model.NewId()),
+		withAttachments,
+		deleted,
+	}, getFileInfos, backend)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), warnings, "the missing file should be reported")
+
+	postLines := readLines[Post](t, posts.Bytes())
+	require.Len(t, postLines, 3)
+	assert.Equal(t, EventDelete, postLines[2].Event)
+
+	attachmentLines := readLines[Attachment](t, attachments.Bytes())
+	require.Len(t, attachmentLines, 3)
+	assert.Equal(t, "known", attachmentLines[0].SHA256)
+	assert.Equal(t, contentHash, attachmentLines[1].SHA256)
+	assert.Empty(t, attachmentLines[2].SHA256)
+	for _, attachment := range attachmentLines {
+		assert.Equal(t, *withAttachments.PostId, attachment.PostId)
+	}
+
+	t.Run("file info error", func(t *testing.T) {
+		_, err := Export(rctx, NewWriter(&posts, &attachments), []*model.MessageExport{withAttachments}, func(string) ([]*model.FileInfo, error) {
+			return nil, errors.New("error")
+		}, backend)
+		require.Error(t, err)
+	})
+}
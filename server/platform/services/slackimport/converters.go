@@ -148,3 +148,9 @@ func slackConvertPostsMarkup(posts map[string][]slackPost) map[string][]slackPos
 
 	return posts
 }
+
+// slackConvertEmojiName converts the name of a Slack reaction to a Mattermost emoji name.
+// Skin tone modifiers, as in "thumbsup::skin-tone-2", are dropped.
+func slackConvertEmojiName(name string) string {
+	return strings.SplitN(name, "::", 2)[0]
+}
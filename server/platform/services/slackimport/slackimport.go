@@ -7,6 +7,7 @@ import (
 	"archive/zip"
 	"bytes"
 	"errors"
+	"fmt"
 	"image"
 	"io"
 	"mime/multipart"
@@ -53,8 +54,15 @@ type slackUser struct {
 }
 
 type slackFile struct {
-	Id    string `json:"id"`
-	Title string `json:"title"`
+	Id                 string `json:"id"`
+	Title              string `json:"title"`
+	Name               string `json:"name"`
+	URLPrivateDownload string `json:"url_private_download"`
+}
+
+type slackReaction struct {
+	Name  string   `json:"name"`
+	Users []string `json:"users"`
 }
 
 type slackPost struct {
@@ -71,12 +79,23 @@ type slackPost struct {
 	File        *slackFile               `json:"file"`
 	Files       []*slackFile             `json:"files"`
 	Attachments []*model.SlackAttachment `json:"attachments"`
+	Reactions   []*slackReaction         `json:"reactions"`
+	PinnedTo    []string                 `json:"pinned_to"`
 }
 
 var isValidChannelNameCharacters = regexp.MustCompile(`^[a-zA-Z0-9\-_]+$`).MatchString
 
 const slackImportMaxFileSize = 1024 * 1024 * 70
 
+// slackImportDownloadBackoff are the delays between the attempts to download a file linked
+// from a Slack export.
+var slackImportDownloadBackoff = []time.Duration{time.Second, 5 * time.Second}
+
+// slackImportDownloadRetryBudget bounds the total time an import waits before retrying
+// downloads, since the import runs within the request that uploaded the export. Once it is
+// used up, each download is only attempted once.
+var slackImportDownloadRetryBudget = 30 * time.Second
+
 type slackComment struct {
 	User    string `json:"user"`
 	Comment string `json:"comment"`
@@ -96,6 +115,7 @@ type Actions struct {
 	InvalidateAllCaches    func()
 	MaxPostSize            func() int
 	PrepareImage           func(fileData []byte) (image.Image, string, func(), error)
+	DownloadFile           func(url string) ([]byte, error)
 }
 
 // SlackImporter is a service that allows to import slack dumps into mattermost
@@ -103,6 +123,13 @@ type SlackImporter struct {
 	store   store.Store
 	actions Actions
 	config  *model.Config
+
+	// skippedItems lists the parts of the export that couldn't be imported, to be
+	// reported at the end of the import log.
+	skippedItems []string
+
+	// downloadRetryWait is the time waited so far before retrying downloads.
+	downloadRetryWait time.Duration
 }
 
 // New creates a new SlackImporter service instance. It receive a store, a set of actions and the current config.
@@ -210,6 +237,14 @@ func (si *SlackImporter) SlackImport(rctx request.CTX, fileData multipart.File,
 
 	si.actions.InvalidateAllCaches()
 
+	if len(si.skippedItems) > 0 {
+		log.WriteString(i18n.T("api.slackimport.slack_import.skipped"))
+		log.WriteString("=============\r\n\r\n")
+		for _, item := range si.skippedItems {
+			log.WriteString(item)
+		}
+	}
+
 	log.WriteString(i18n.T("api.slackimport.slack_import.notes"))
 	log.WriteString("=======\r\n\r\n")
 
@@ -321,59 +356,47 @@ func (si *SlackImporter) slackAddPosts(rctx request.CTX, teamId string, channel
 	})
 	threads := make(map[string]string)
 	for _, sPost := range posts {
+		var postId string
 		switch {
-		case sPost.Type == "message" && (sPost.SubType == "" || sPost.SubType == "file_share"):
-			if sPost.User == "" {
-				rctx.Logger().Debug("Slack Import: Unable to import the message as the user field is missing.")
-				continue
-			}
-			if users[sPost.User] == nil {
-				rctx.Logger().Debug("Slack Import: Unable to add the message as the Slack user does not exist in Mattermost.", mlog.String("user", sPost.User))
+		case sPost.Type == "message" && (sPost.SubType == "" || sPost.SubType == "file_share" || sPost.SubType == "thread_broadcast"):
+			user := si.slackPostUser(rctx, channel, sPost, sPost.User, users)
+			if user == nil {
 				continue
 			}
 			newPost := model.Post{
-				UserId:    users[sPost.User].Id,
+				UserId:    user.Id,
 				ChannelId: channel.Id,
 				Message:   sPost.Text,
 				CreateAt:  slackConvertTimeStamp(sPost.TimeStamp),
+				IsPinned:  len(sPost.PinnedTo) > 0,
 			}
-			if sPost.Upload {
+			if sPost.Upload || len(sPost.Files) > 0 {
 				if sPost.File != nil {
-					if fileInfo, ok := si.slackUploadFile(rctx, sPost.File, uploads, teamId, newPost.ChannelId, newPost.UserId, sPost.TimeStamp); ok {
+					if fileInfo, ok := si.slackUploadFile(rctx, channel, sPost, sPost.File, uploads, teamId, newPost.UserId); ok {
 						newPost.FileIds = append(newPost.FileIds, fileInfo.Id)
 					}
 				} else if sPost.Files != nil {
 					for _, file := range sPost.Files {
-						if fileInfo, ok := si.slackUploadFile(rctx, file, uploads, teamId, newPost.ChannelId, newPost.UserId, sPost.TimeStamp); ok {
+						if fileInfo, ok := si.slackUploadFile(rctx, channel, sPost, file, uploads, teamId, newPost.UserId); ok {
 							newPost.FileIds = append(newPost.FileIds, fileInfo.Id)
 						}
 					}
 				}
 			}
-			// If post in thread
-			if sPost.ThreadTS != "" && sPost.ThreadTS != sPost.TimeStamp {
-				newPost.RootId = threads[sPost.ThreadTS]
-			}
-			postId := si.oldImportPost(rctx, &newPost)
-			// If post is thread starter
-			if sPost.ThreadTS == sPost.TimeStamp {
-				threads[sPost.ThreadTS] = postId
-			}
+			newPost.RootId = slackThreadRootId(rctx, sPost, threads)
+			postId = si.oldImportPost(rctx, &newPost)
 		case sPost.Type == "message" && sPost.SubType == "file_comment":
 			if sPost.Comment == nil {
 				rctx.Logger().Debug("Slack Import: Unable to import the message as it has no comments.")
+				si.skipPost(channel, sPost, "api.slackimport.slack_add_posts.no_comment", nil)
 				continue
 			}
-			if sPost.Comment.User == "" {
-				rctx.Logger().Debug("Slack Import: Unable to import the message as the user field is missing.")
-				continue
-			}
-			if users[sPost.Comment.User] == nil {
-				rctx.Logger().Debug("Slack Import: Unable to add the message as the Slack user does not exist in Mattermost.", mlog.String("user", sPost.User))
+			user := si.slackPostUser(rctx, channel, sPost, sPost.Comment.User, users)
+			if user == nil {
 				continue
 			}
 			newPost := model.Post{
-				UserId:    users[sPost.Comment.User].Id,
+				UserId:    user.Id,
 				ChannelId: channel.Id,
 				Message:   sPost.Comment.Comment,
 				CreateAt:  slackConvertTimeStamp(sPost.TimeStamp),
@@ -382,10 +405,12 @@ func (si *SlackImporter) slackAddPosts(rctx request.CTX, teamId string, channel
 		case sPost.Type == "message" && sPost.SubType == "bot_message":
 			if botUser == nil {
 				rctx.Logger().Warn("Slack Import: Unable to import the bot message as the bot user does not exist.")
+				si.skipPost(channel, sPost, "api.slackimport.slack_add_posts.no_bot_user", nil)
 				continue
 			}
 			if sPost.BotId == "" {
 				rctx.Logger().Warn("Slack Import: Unable to import bot message as the BotId field is missing.")
+				si.skipPost(channel, sPost, "api.slackimport.slack_add_posts.missing_bot_id", nil)
 				continue
 			}
 
@@ -401,20 +426,14 @@ func (si *SlackImporter) slackAddPosts(rctx request.CTX, teamId string, channel
 				CreateAt:  slackConvertTimeStamp(sPost.TimeStamp),
 				Message:   sPost.Text,
 				Type:      model.PostTypeSlackAttachment,
+				IsPinned:  len(sPost.PinnedTo) > 0,
+				RootId:    slackThreadRootId(rctx, sPost, threads),
 			}
 
-			postId := si.oldImportIncomingWebhookPost(rctx, post, props)
-			// If post is thread starter
-			if sPost.ThreadTS == sPost.TimeStamp {
-				threads[sPost.ThreadTS] = postId
-			}
+			postId = si.oldImportIncomingWebhookPost(rctx, post, props)
 		case sPost.Type == "message" && (sPost.SubType == "channel_join" || sPost.SubType == "channel_leave"):
-			if sPost.User == "" {
-				rctx.Logger().Debug("Slack Import: Unable to import the message as the user field is missing.")
-				continue
-			}
-			if users[sPost.User] == nil {
-				rctx.Logger().Debug("Slack Import: Unable to add the message as the Slack user does not exist in Mattermost.", mlog.String("user", sPost.User))
+			user := si.slackPostUser(rctx, channel, sPost, sPost.User, users)
+			if user == nil {
 				continue
 			}
 
@@ -426,47 +445,37 @@ func (si *SlackImporter) slackAddPosts(rctx request.CTX, teamId string, channel
 			}
 
 			newPost := model.Post{
-				UserId:    users[sPost.User].Id,
+				UserId:    user.Id,
 				ChannelId: channel.Id,
 				Message:   sPost.Text,
 				CreateAt:  slackConvertTimeStamp(sPost.TimeStamp),
 				Type:      postType,
 				Props: model.StringInterface{
-					"username": users[sPost.User].Username,
+					"username": user.Username,
 				},
 			}
 			si.oldImportPost(rctx, &newPost)
 		case sPost.Type == "message" && sPost.SubType == "me_message":
-			if sPost.User == "" {
-				rctx.Logger().Debug("Slack Import: Unable to import the message as the user field is missing.")
-				continue
-			}
-			if users[sPost.User] == nil {
-				rctx.Logger().Debug("Slack Import: Unable to add the message as the Slack user does not exist in Mattermost.", mlog.String("user", sPost.User))
+			user := si.slackPostUser(rctx, channel, sPost, sPost.User, users)
+			if user == nil {
 				continue
 			}
 			newPost := model.Post{
-				UserId:    users[sPost.User].Id,
+				UserId:    user.Id,
 				ChannelId: channel.Id,
 				Message:   "*" + sPost.Text + "*",
 				CreateAt:  slackConvertTimeStamp(sPost.TimeStamp),
+				IsPinned:  len(sPost.PinnedTo) > 0,
+				RootId:    slackThreadRootId(rctx, sPost, threads),
 			}
-			postId := si.oldImportPost(rctx, &newPost)
-			// If post is thread starter
-			if sPost.ThreadTS == sPost.TimeStamp {
-				threads[sPost.ThreadTS] = postId
-			}
+			postId = si.oldImportPost(rctx, &newPost)
 		case sPost.Type == "message" && sPost.SubType == "channel_topic":
-			if sPost.User == "" {
-				rctx.Logger().Debug("Slack Import: Unable to import the message as the user field is missing.")
-				continue
-			}
-			if users[sPost.User] == nil {
-				rctx.Logger().Debug("Slack Import: Unable to add the message as the Slack user does not exist in Mattermost.", mlog.String("user", sPost.User))
+			user := si.slackPostUser(rctx, channel, sPost, sPost.User, users)
+			if user == nil {
 				continue
 			}
 			newPost := model.Post{
-				UserId:    users[sPost.User].Id,
+				UserId:    user.Id,
 				ChannelId: channel.Id,
 				Message:   sPost.Text,
 				CreateAt:  slackConvertTimeStamp(sPost.TimeStamp),
@@ -474,16 +483,12 @@ func (si *SlackImporter) slackAddPosts(rctx request.CTX, teamId string, channel
 			}
 			si.oldImportPost(rctx, &newPost)
 		case sPost.Type == "message" && sPost.SubType == "channel_purpose":
-			if sPost.User == "" {
-				rctx.Logger().Debug("Slack Import: Unable to import the message as the user field is missing.")
-				continue
-			}
-			if users[sPost.User] == nil {
-				rctx.Logger().Debug("Slack Import: Unable to add the message as the Slack user does not exist in Mattermost.", mlog.String("user", sPost.User))
+			user := si.slackPostUser(rctx, channel, sPost, sPost.User, users)
+			if user == nil {
 				continue
 			}
 			newPost := model.Post{
-				UserId:    users[sPost.User].Id,
+				UserId:    user.Id,
 				ChannelId: channel.Id,
 				Message:   sPost.Text,
 				CreateAt:  slackConvertTimeStamp(sPost.TimeStamp),
@@ -491,16 +496,12 @@ func (si *SlackImporter) slackAddPosts(rctx request.CTX, teamId string, channel
 			}
 			si.oldImportPost(rctx, &newPost)
 		case sPost.Type == "message" && sPost.SubType == "channel_name":
-			if sPost.User == "" {
-				rctx.Logger().Debug("Slack Import: Unable to import the message as the user field is missing.")
-				continue
-			}
-			if users[sPost.User] == nil {
-				rctx.Logger().Debug("Slack Import: Unable to add the message as the Slack user does not exist in Mattermost.", mlog.String("user", sPost.User))
+			user := si.slackPostUser(rctx, channel, sPost, sPost.User, users)
+			if user == nil {
 				continue
 			}
 			newPost := model.Post{
-				UserId:    users[sPost.User].Id,
+				UserId:    user.Id,
 				ChannelId: channel.Id,
 				Message:   sPost.Text,
 				CreateAt:  slackConvertTimeStamp(sPost.TimeStamp),
@@ -513,37 +514,190 @@ func (si *SlackImporter) slackAddPosts(rctx request.CTX, teamId string, channel
 				mlog.String("post_type", sPost.Type),
 				mlog.String("post_subtype", sPost.SubType),
 			)
+			si.skipPost(channel, sPost, "api.slackimport.slack_add_posts.unsupported_type", map[string]any{"Type": sPost.Type, "SubType": sPost.SubType})
+		}
+
+		if postId == "" {
+			continue
+		}
+
+		// If post is thread starter
+		if sPost.ThreadTS == sPost.TimeStamp {
+			threads[sPost.ThreadTS] = postId
 		}
+
+		si.slackAddReactions(rctx, channel, sPost, postId, users)
 	}
 }
 
-func (si *SlackImporter) slackUploadFile(rctx request.CTX, slackPostFile *slackFile, uploads map[string]*zip.File, teamId string, channelId string, userId string, slackTimestamp string) (*model.FileInfo, bool) {
-	if slackPostFile == nil {
-		rctx.Logger().Warn("Slack Import: Unable to attach the file to the post as the latter has no file section present in Slack export.")
-		return nil, false
+// slackThreadRootId returns the id of the imported root post of a Slack thread reply, or an
+// empty string if the message isn't a reply. Replies to a root that wasn't imported are
+// imported as root posts.
+func slackThreadRootId(rctx request.CTX, sPost slackPost, threads map[string]string) string {
+	if sPost.ThreadTS == "" || sPost.ThreadTS == sPost.TimeStamp {
+		return ""
 	}
-	file, ok := uploads[slackPostFile.Id]
+
+	rootId, ok := threads[sPost.ThreadTS]
 	if !ok {
-		rctx.Logger().Warn("Slack Import: Unable to import file as the file is missing from the Slack export zip file.", mlog.String("file_id", slackPostFile.Id))
+		rctx.Logger().Warn("Slack Import: Unable to find the root of the thread reply. It will be imported as a root post.", mlog.String("thread_ts", sPost.ThreadTS), mlog.String("ts", sPost.TimeStamp))
+	}
+	return rootId
+}
+
+// slackPostUser returns the Mattermost user a Slack message was posted by. If the message
+// can't be attributed to an imported user, it's recorded as skipped and nil is returned.
+func (si *SlackImporter) slackPostUser(rctx request.CTX, channel *model.Channel, sPost slackPost, slackUserId string, users map[string]*model.User) *model.User {
+	if slackUserId == "" {
+		rctx.Logger().Debug("Slack Import: Unable to import the message as the user field is missing.")
+		si.skipPost(channel, sPost, "api.slackimport.slack_add_posts.missing_user", nil)
+		return nil
+	}
+
+	user := users[slackUserId]
+	if user == nil {
+		rctx.Logger().Debug("Slack Import: Unable to add the message as the Slack user does not exist in Mattermost.", mlog.String("user", slackUserId))
+		si.skipPost(channel, sPost, "api.slackimport.slack_add_posts.unknown_user", map[string]any{"User": slackUserId})
+		return nil
+	}
+
+	return user
+}
+
+func (si *SlackImporter) slackAddReactions(rctx request.CTX, channel *model.Channel, sPost slackPost, postId string, users map[string]*model.User) {
+	for _, sReaction := range sPost.Reactions {
+		emojiName := slackConvertEmojiName(sReaction.Name)
+		for _, slackUserId := range sReaction.Users {
+			user := users[slackUserId]
+			if user == nil {
+				si.skipReaction(channel, sPost, sReaction.Name, i18n.T("api.slackimport.slack_add_posts.unknown_user", map[string]any{"User": slackUserId}))
+				continue
+			}
+
+			reaction := &model.Reaction{
+				UserId:    user.Id,
+				PostId:    postId,
+				ChannelId: channel.Id,
+				EmojiName: emojiName,
+				CreateAt:  slackConvertTimeStamp(sPost.TimeStamp),
+			}
+			if _, err := si.store.Reaction().Save(reaction); err != nil {
+				rctx.Logger().Warn("Slack Import: Unable to import the reaction.", mlog.String("post_id", postId), mlog.String("emoji_name", emojiName), mlog.Err(err))
+				si.skipReaction(channel, sPost, sReaction.Name, err.Error())
+			}
+		}
+	}
+}
+
+func (si *SlackImporter) slackUploadFile(rctx request.CTX, channel *model.Channel, sPost slackPost, slackPostFile *slackFile, uploads map[string]*zip.File, teamId string, userId string) (*model.FileInfo, bool) {
+	if slackPostFile == nil {
+		rctx.Logger().Warn("Slack Import: Unable to attach the file to the post as the latter has no file section present in Slack export.")
+		si.skipPost(channel, sPost, "api.slackimport.slack_add_posts.missing_file_section", nil)
 		return nil, false
 	}
-	openFile, err := file.Open()
+
+	data, fileName, err := si.slackFileData(rctx, slackPostFile, uploads)
 	if err != nil {
-		rctx.Logger().Warn("Slack Import: Unable to open the file from the Slack export.", mlog.String("file_id", slackPostFile.Id), mlog.Err(err))
+		rctx.Logger().Warn("Slack Import: Unable to import file.", mlog.String("file_id", slackPostFile.Id), mlog.Err(err))
+		si.skipFile(channel, sPost, slackPostFile, err.Error())
 		return nil, false
 	}
-	defer openFile.Close()
 
-	timestamp := utils.TimeFromMillis(slackConvertTimeStamp(slackTimestamp))
-	uploadedFile, err := si.oldImportFile(rctx, timestamp, openFile, teamId, channelId, userId, filepath.Base(file.Name))
+	timestamp := utils.TimeFromMillis(slackConvertTimeStamp(sPost.TimeStamp))
+	uploadedFile, err := si.oldImportFile(rctx, timestamp, bytes.NewReader(data), teamId, channel.Id, userId, fileName)
 	if err != nil {
 		rctx.Logger().Warn("Slack Import: An error occurred when uploading file.", mlog.String("file_id", slackPostFile.Id), mlog.Err(err))
+		si.skipFile(channel, sPost, slackPostFile, err.Error())
 		return nil, false
 	}
 
 	return uploadedFile, true
 }
 
+// slackFileData returns the content and name of a file attached to a Slack message. Files
+// are read from the export, or downloaded if the export only links to them. Downloads are
+// retried since Slack rate limits them, within the retry budget of the import.
+func (si *SlackImporter) slackFileData(rctx request.CTX, slackPostFile *slackFile, uploads map[string]*zip.File) ([]byte, string, error) {
+	if file, ok := uploads[slackPostFile.Id]; ok {
+		data, err := readZipFile(file, *si.config.FileSettings.MaxFileSize)
+		if err == nil {
+			return data, filepath.Base(file.Name), nil
+		}
+		if errors.Is(err, utils.ErrSizeLimitExceeded) {
+			return nil, "", fmt.Errorf("the file from the Slack export is larger than the maximum file size: %w", err)
+		}
+		if slackPostFile.URLPrivateDownload == "" {
+			return nil, "", fmt.Errorf("unable to open the file from the Slack export: %w", err)
+		}
+		rctx.Logger().Warn("Slack Import: Unable to open the file from the Slack export. Downloading it instead.", mlog.String("file_id", slackPostFile.Id), mlog.Err(err))
+	}
+
+	if slackPostFile.URLPrivateDownload == "" {
+		return nil, "", errors.New("the file is missing from the Slack export")
+	}
+	if si.actions.DownloadFile == nil {
+		return nil, "", errors.New("the file is missing from the Slack export and can't be downloaded")
+	}
+
+	data, err := si.actions.DownloadFile(slackPostFile.URLPrivateDownload)
+	for _, wait := range slackImportDownloadBackoff {
+		if err == nil || si.downloadRetryWait+wait > slackImportDownloadRetryBudget {
+			break
+		}
+		si.downloadRetryWait += wait
+		time.Sleep(wait)
+		data, err = si.actions.DownloadFile(slackPostFile.URLPrivateDownload)
+	}
+	if err != nil {
+		return nil, "", fmt.Errorf("unable to download the file: %w", err)
+	}
+
+	fileName := slackPostFile.Name
+	if fileName == "" {
+		fileName = slackPostFile.Id
+	}
+
+	return data, filepath.Base(fileName), nil
+}
+
+// readZipFile reads a file of the export, failing with utils.ErrSizeLimitExceeded if it is
+// larger than maxSize.
+func readZipFile(file *zip.File, maxSize int64) ([]byte, error) {
+	reader, err := file.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	return io.ReadAll(utils.NewLimitedReaderWithError(reader, maxSize))
+}
+
+func (si *SlackImporter) skipPost(channel *model.Channel, sPost slackPost, reasonId string, reasonParams map[string]any) {
+	si.skippedItems = append(si.skippedItems, i18n.T("api.slackimport.slack_import.skipped_post", map[string]any{
+		"Channel":   channel.DisplayName,
+		"Timestamp": sPost.TimeStamp,
+		"Reason":    i18n.T(reasonId, reasonParams),
+	}))
+}
+
+func (si *SlackImporter) skipFile(channel *model.Channel, sPost slackPost, slackPostFile *slackFile, reason string) {
+	si.skippedItems = append(si.skippedItems, i18n.T("api.slackimport.slack_import.skipped_file", map[string]any{
+		"Channel":   channel.DisplayName,
+		"Timestamp": sPost.TimeStamp,
+		"File":      slackPostFile.Id,
+		"Reason":    reason,
+	}))
+}
+
+func (si *SlackImporter) skipReaction(channel *model.Channel, sPost slackPost, emojiName string, reason string) {
+	si.skippedItems = append(si.skippedItems, i18n.T("api.slackimport.slack_import.skipped_reaction", map[string]any{
+		"Channel":   channel.DisplayName,
+		"Timestamp": sPost.TimeStamp,
+		"EmojiName": emojiName,
+		"Reason":    reason,
+	}))
+}
+
 func (si *SlackImporter) deactivateSlackBotUser(rctx request.CTX, user *model.User) {
 	if _, err := si.actions.UpdateActive(user, false); err != nil {
 		rctx.Logger().Warn("Slack Import: Unable to deactivate the user account used for the bot.")
@@ -691,6 +845,7 @@ func (si *SlackImporter) oldImportPost(rctx request.CTX, post *model.Post) strin
 				}
 			}
 			post.FileIds = nil
+			post.IsPinned = false
 		}
 
 		post.Id = ""
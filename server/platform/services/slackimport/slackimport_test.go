@@ -4,17 +4,24 @@
 package slackimport
 
 import (
+	"archive/zip"
+	"bytes"
+	"errors"
 	"os"
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/i18n"
 	"github.com/mattermost/mattermost/server/public/shared/request"
 	"github.com/mattermost/mattermost/server/v8/channels/store/storetest/mocks"
+	"github.com/mattermost/mattermost/server/v8/channels/utils"
 )
 
 func TestSlackConvertTimeStamp(t *testing.T) {
@@ -402,3 +409,225 @@ func TestOldImportChannel(t *testing.T) {
 		_ = importer.oldImportChannel(rctx, ch, sCh, users)
 	})
 }
+
+func TestSlackConvertEmojiName(t *testing.T) {
+	assert.Equal(t, "thumbsup", slackConvertEmojiName("thumbsup"))
+	assert.Equal(t, "thumbsup", slackConvertEmojiName("thumbsup::skin-tone-2"))
+	assert.Equal(t, "+1", slackConvertEmojiName("+1"))
+}
+
+func TestSlackAddPosts(t *testing.T) {
+	i18n.T = i18n.IdentityTfunc()
+	rctx := request.TestContext(t)
+	config := &model.Config{}
+	config.SetDefaults()
+
+	prevBackoff := slackImportDownloadBackoff
+	slackImportDownloadBackoff = []time.Duration{0, 0}
+	defer func() {
+		slackImportDownloadBackoff = prevBackoff
+	}()
+
+	u1 := &model.User{Id: model.NewId(), Username: "test-user-1"}
+	u2 := &model.User{Id: model.NewId(), Username: "test-user-2"}
+	users := map[string]*model.User{
+		"U1": u1,
+		"U2": u2,
+	}
+	channel := &model.Channel{Id: model.NewId(), DisplayName: "Test Channel"}
+
+	setupStore := func() (*mocks.Store, *[]*model.Post, *[]*model.Reaction) {
+		var savedPosts []*model.Post
+		var savedReactions []*model.Reaction
+
+		postStore := &mocks.PostStore{}
+		postStore.On("Save", mock.Anything, mock.AnythingOfType("*model.Post")).Run(func(args mock.Arguments) {
+			post := args.Get(1).(*model.Post)
+			post.Id = model.NewId()
+			saved := post.Clone()
+			savedPosts = append(savedPosts, saved)
+		}).Return(nil, nil)
+
+		reactionStore := &mocks.ReactionStore{}
+		reactionStore.On("Save", mock.AnythingOfType("*model.Reaction")).Run(func(args mock.Arguments) {
+			savedReactions = append(savedReactions, args.Get(0).(*model.Reaction))
+		}).Return(nil, nil)
+
+		fileInfoStore := &mocks.FileInfoStore{}
+		fileInfoStore.On("AttachToPost", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+		store := &mocks.Store{}
+		store.On("Post").Return(postStore)
+		store.On("Reaction").Return(reactionStore)
+		store.On("FileInfo").Return(fileInfoStore)
+
+		return store, &savedPosts, &savedReactions
+	}
+
+	actions := Actions{
+		MaxPostSize: func() int { return model.PostMessageMaxRunesV2 },
+		DoUploadFile: func(_ time.Time, _, _, _, fileName string, _ []byte) (*model.FileInfo, *model.AppError) {
+			return &model.FileInfo{Id: model.NewId(), Name: fileName, Extension: "txt"}, nil
+		},
+	}
+
+	t.Run("threads, reactions and pins", func(t *testing.T) {
+		store, savedPosts, savedReactions := setupStore()
+		importer := New(store, actions, config)
+
+		importer.slackAddPosts(rctx, model.NewId(), channel, []slackPost{
+			{
+				User:      "U2",
+				Type:      "message",
+				Text:      "reply",
+				TimeStamp: "1469785420.000002",
+				ThreadTS:  "1469785419.000001",
+			},
+			{
+				User:      "U1",
+				Type:      "message",
+				Text:      "root",
+				TimeStamp: "1469785419.000001",
+				ThreadTS:  "1469785419.000001",
+				PinnedTo:  []string{"C1"},
+				Reactions: []*slackReaction{
+					{Name: "thumbsup::skin-tone-2", Users: []string{"U1", "U2", "U3"}},
+				},
+			},
+			{
+				User:      "U2",
+				Type:      "message",
+				SubType:   "thread_broadcast",
+				Text:      "broadcast reply",
+				TimeStamp: "1469785421.000003",
+				ThreadTS:  "1469785419.000001",
+			},
+		}, users, nil, nil)
+
+		require.Len(t, *savedPosts, 3)
+		root := (*savedPosts)[0]
+		assert.Equal(t, "root", root.Message)
+		assert.True(t, root.IsPinned)
+		assert.Empty(t, root.RootId)
+		for _, reply := range (*savedPosts)[1:] {
+			assert.Equal(t, root.Id, reply.RootId)
+			assert.False(t, reply.IsPinned)
+		}
+
+		require.Len(t, *savedReactions, 2)
+		for _, reaction := range *savedReactions {
+			assert.Equal(t, root.Id, reaction.PostId)
+			assert.Equal(t, "thumbsup", reaction.EmojiName)
+		}
+
+		require.Len(t, importer.skippedItems, 1, "the reaction of the unknown user should be reported")
+	})
+
+	t.Run("skipped messages are reported", func(t *testing.T) {
+		store, savedPosts, _ := setupStore()
+		importer := New(store, actions, config)
+
+		importer.slackAddPosts(rctx, model.NewId(), channel, []slackPost{
+			{Type: "message", Text: "no user", TimeStamp: "1469785419.000001"},
+			{User: "U3", Type: "message", Text: "unknown user", TimeStamp: "1469785420.000001"},
+			{User: "U1", Type: "message", SubType: "unknown_subtype", TimeStamp: "1469785421.000001"},
+		}, users, nil, nil)
+
+		assert.Empty(t, *savedPosts)
+		assert.Len(t, importer.skippedItems, 3)
+	})
+
+	t.Run("file downloads are retried", func(t *testing.T) {
+		store, savedPosts, _ := setupStore()
+		attempts := 0
+		downloadActions := actions
+		downloadActions.DownloadFile = func(url string) ([]byte, error) {
+			attempts++
+			if attempts < 3 {
+				return nil, errors.New("rate limited")
+			}
+			return []byte("content"), nil
+		}
+		importer := New(store, downloadActions, config)
+
+		importer.slackAddPosts(rctx, model.NewId(), channel, []slackPost{
+			{
+				User:      "U1",
+				Type:      "message",
+				SubType:   "file_share",
+				TimeStamp: "1469785419.000001",
+				Upload:    true,
+				Files: []*slackFile{
+					{Id: "F1", Name: "file.txt", URLPrivateDownload: "https://files.slack.com/F1/file.txt"},
+					{Id: "F2", Name: "missing.txt"},
+				},
+			},
+		}, users, nil, nil)
+
+		assert.Equal(t, 3, attempts)
+		require.Len(t, *savedPosts, 1)
+		assert.Len(t, (*savedPosts)[0].FileIds, 1)
+		require.Len(t, importer.skippedItems, 1, "the file missing from the export should be reported")
+	})
+
+	t.Run("file downloads aren't retried once the retry budget is used", func(t *testing.T) {
+		prevBackoff := slackImportDownloadBackoff
+		slackImportDownloadBackoff = []time.Duration{time.Millisecond, time.Millisecond}
+		prevBudget := slackImportDownloadRetryBudget
+		slackImportDownloadRetryBudget = time.Millisecond
+		defer func() {
+			slackImportDownloadBackoff = prevBackoff
+			slackImportDownloadRetryBudget = prevBudget
+		}()
+
+		store, savedPosts, _ := setupStore()
+		attempts := 0
+		downloadActions := actions
+		downloadActions.DownloadFile = func(url string) ([]byte, error) {
+			attempts++
+			return nil, errors.New("rate limited")
+		}
+		importer := New(store, downloadActions, config)
+
+		importer.slackAddPosts(rctx, model.NewId(), channel, []slackPost{
+			{
+				User:      "U1",
+				Type:      "message",
+				SubType:   "file_share",
+				TimeStamp: "1469785419.000001",
+				Upload:    true,
+				Files: []*slackFile{
+					{Id: "F1", Name: "first.txt", URLPrivateDownload: "https://files.slack.com/F1/first.txt"},
+					{Id: "F2", Name: "second.txt", URLPrivateDownload: "https://files.slack.com/F2/second.txt"},
+				},
+			},
+		}, users, nil, nil)
+
+		// The first download is retried once, using up the budget, the second isn't retried
+		assert.Equal(t, 3, attempts)
+		assert.Len(t, importer.skippedItems, 2)
+		require.Len(t, *savedPosts, 1)
+		assert.Empty(t, (*savedPosts)[0].FileIds)
+	})
+}
+
+func TestReadZipFile(t *testing.T) {
+	var buf bytes.Buffer
+	zipWriter := zip.NewWriter(&buf)
+	entry, err := zipWriter.Create("file.txt")
+	require.NoError(t, err)
+	_, err = entry.Write([]byte("0123456789"))
+	require.NoError(t, err)
+	require.NoError(t, zipWriter.Close())
+
+	zipReader, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	require.Len(t, zipReader.File, 1)
+
+	data, err := readZipFile(zipReader.File[0], 10)
+	require.NoError(t, err)
+	assert.Equal(t, "0123456789", string(data))
+
+	_, err = readZipFile(zipReader.File[0], 5)
+	require.ErrorIs(t, err, utils.ErrSizeLimitExceeded)
+}
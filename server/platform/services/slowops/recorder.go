@@ -0,0 +1,235 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package slowops
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/v8/einterfaces"
+)
+
+// maxQueryLength is the length the captured queries are truncated to, as the queries built with
+// long lists of parameters would otherwise take most of the memory of the capture.
+const maxQueryLength = 2048
+
+type interval struct {
+	startAt  time.Time
+	queries  []*model.SlowOperation
+	requests []*model.SlowOperation
+}
+
+// Recorder captures the slowest store queries and API requests of the server over fixed
+// intervals. The operations faster than the thresholds return right away without taking any
+// lock, so that the recorder can be called on every query and every request.
+type Recorder struct {
+	// The thresholds are zero while the capture is disabled.
+	queryThreshold   atomic.Int64
+	requestThreshold atomic.Int64
+
+	metrics einterfaces.MetricsInterface
+	now     func() time.Time
+
+	mut        sync.Mutex
+	maxEntries int
+	interval   time.Duration
+	current    *interval
+	previous   *model.SlowOperationsInterval
+}
+
+// New creates a recorder which is disabled until configured. The metrics are optional.
+func New(metrics einterfaces.MetricsInterface) *Recorder {
+	r := &Recorder{
+		metrics: metrics,
+		now:     time.Now,
+	}
+	r.current = &interval{startAt: r.now()}
+	return r
+}
+
+// Configure applies the settings to the recorder. The operations captured so far are dropped
+// when the capture is disabled, or when the number of entries or the interval change.
+func (r *Recorder) Configure(settings model.SlowOperationSettings) {
+	r.mut.Lock()
+	defer r.mut.Unlock()
+
+	maxEntries := *settings.MaxEntries
+	intervalDuration := time.Duration(*settings.IntervalMinutes) * time.Minute
+	if !*settings.Enable || maxEntries != r.maxEntries || intervalDuration != r.interval {
+		r.current = &interval{startAt: r.now()}
+		r.previous = nil
+	}
+	r.maxEntries = maxEntries
+	r.interval = intervalDuration
+
+	if !*settings.Enable {
+		r.queryThreshold.Store(0)
+		r.requestThreshold.Store(0)
+		return
+	}
+
+	r.queryThreshold.Store(int64(time.Duration(*settings.QueryThresholdMilliseconds) * time.Millisecond))
+	r.requestThreshold.Store(int64(time.Duration(*settings.RequestThresholdMilliseconds) * time.Millisecond))
+}
+
+// RecordQuery captures a store query if it exceeded the threshold. Only the text of the query is
+// kept: the values of its bind parameters are never passed to the recorder, and the string
+// literals of the query are redacted.
+func (r *Recorder) RecordQuery(query string, elapsed time.Duration) {
+	if r == nil || !exceeds(r.queryThreshold.Load(), elapsed) {
+		return
+	}
+
+	r.record(&model.SlowOperation{
+		Kind:  model.SlowOperationKindQuery,
+		Query: redactQuery(query),
+	}, elapsed)
+}
+
+// RecordRequest captures an API request if it exceeded the threshold. The request is identified
+// by the template of its route, so that the identifiers in its path aren't captured.
+func (r *Recorder) RecordRequest(method, route, statusCode string, elapsed time.Duration) {
+	if r == nil || !exceeds(r.requestThreshold.Load(), elapsed) {
+		return
+	}
+
+	r.record(&model.SlowOperation{
+		Kind:       model.SlowOperationKindRequest,
+		Method:     method,
+		Route:      route,
+		StatusCode: statusCode,
+	}, elapsed)
+}
+
+func exceeds(threshold int64, elapsed time.Duration) bool {
+	return threshold > 0 && int64(elapsed) >= threshold
+}
+
+func (r *Recorder) record(op *model.SlowOperation, elapsed time.Duration) {
+	now := r.now()
+	op.Elapsed = elapsed.Milliseconds()
+	op.CreateAt = model.GetMillisForTime(now)
+
+	r.mut.Lock()
+	r.rotate(now)
+	if op.Kind == model.SlowOperationKindQuery {
+		r.current.queries = insertSlowest(r.current.queries, op, r.maxEntries)
+	} else {
+		r.current.requests = insertSlowest(r.current.requests, op, r.maxEntries)
+	}
+	r.mut.Unlock()
+
+	if r.metrics != nil {
+		r.metrics.ObserveSlowOperation(op.Kind, elapsed.Seconds())
+	}
+}
+
+// rotate starts a new interval when the current one is over. The intervals are contiguous, so
+// the previous interval is empty when no operation was captured during a whole interval.
+func (r *Recorder) rotate(now time.Time) {
+	if r.interval <= 0 {
+		return
+	}
+
+	elapsedIntervals := now.Sub(r.current.startAt) / r.interval
+	if elapsedIntervals < 1 {
+		return
+	}
+
+	startAt := r.current.startAt.Add(elapsedIntervals * r.interval)
+	if elapsedIntervals == 1 {
+		r.previous = r.current.snapshot(startAt)
+	} else {
+		r.previous = (&interval{startAt: startAt.Add(-r.interval)}).snapshot(startAt)
+	}
+	r.current = &interval{startAt: startAt}
+}
+
+// insertSlowest inserts the operation into the list sorted from the slowest, keeping at most
+// maxEntries of them.
+func insertSlowest(ops []*model.SlowOperation, op *model.SlowOperation, maxEntries int) []*model.SlowOperation {
+	i := sort.Search(len(ops), func(i int) bool {
+		return ops[i].Elapsed < op.Elapsed
+	})
+	if i >= maxEntries {
+		return ops
+	}
+
+	if len(ops) < maxEntries {
+		ops = append(ops, nil)
+	}
+	copy(ops[i+1:], ops[i:])
+	ops[i] = op
+	return ops
+}
+
+func (i *interval) snapshot(endAt time.Time) *model.SlowOperationsInterval {
+	s := &model.SlowOperationsInterval{
+		StartAt:  model.GetMillisForTime(i.startAt),
+		Queries:  make([]*model.SlowOperation, len(i.queries)),
+		Requests: make([]*model.SlowOperation, len(i.requests)),
+	}
+	if !endAt.IsZero() {
+		s.EndAt = model.GetMillisForTime(endAt)
+	}
+	copy(s.Queries, i.queries)
+	copy(s.Requests, i.requests)
+	return s
+}
+
+// SlowOperations returns the operations captured during the current interval and the previous
+// one.
+func (r *Recorder) SlowOperations() *model.SlowOperations {
+	r.mut.Lock()
+	defer r.mut.Unlock()
+
+	r.rotate(r.now())
+	return &model.SlowOperations{
+		Enabled:  r.queryThreshold.Load() > 0,
+		Current:  r.current.snapshot(time.Time{}),
+		Previous: r.previous,
+	}
+}
+
+// redactQuery collapses the whitespace of the query and replaces its string literals, which may
+// hold values inlined into the query instead of being bound, with placeholders.
+func redactQuery(query string) string {
+	var b strings.Builder
+	b.Grow(min(len(query), maxQueryLength))
+
+	inLiteral := false
+	lastSpace := true
+	for i := 0; i < len(query) && b.Len() < maxQueryLength; i++ {
+		c := query[i]
+		switch {
+		case inLiteral:
+			if c == '\'' {
+				// A doubled quote escapes a quote inside the literal.
+				if i+1 < len(query) && query[i+1] == '\'' {
+					i++
+					continue
+				}
+				inLiteral = false
+			}
+		case c == '\'':
+			inLiteral = true
+			b.WriteString("'?'")
+			lastSpace = false
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			if !lastSpace {
+				b.WriteByte(' ')
+				lastSpace = true
+			}
+		default:
+			b.WriteByte(c)
+			lastSpace = false
+		}
+	}
+
+	return strings.TrimSpace(b.String())
+}
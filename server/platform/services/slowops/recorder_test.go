@@ -0,0 +1,160 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package slowops
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/v8/einterfaces"
+	"github.com/mattermost/mattermost/server/v8/einterfaces/mocks"
+)
+
+func newTestRecorder(t *testing.T, metrics einterfaces.MetricsInterface, maxEntries int) (*Recorder, *time.Time) {
+	t.Helper()
+
+	now := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	r := New(metrics)
+	r.now = func() time.Time { return now }
+
+	settings := model.SlowOperationSettings{}
+	settings.SetDefaults()
+	settings.Enable = model.NewBool(true)
+	settings.QueryThresholdMilliseconds = model.NewInt(100)
+	settings.RequestThresholdMilliseconds = model.NewInt(200)
+	settings.MaxEntries = model.NewInt(maxEntries)
+	settings.IntervalMinutes = model.NewInt(10)
+	r.Configure(settings)
+
+	return r, &now
+}
+
+func TestRecorder(t *testing.T) {
+	t.Run("the operations faster than the thresholds are ignored", func(t *testing.T) {
+		r, _ := newTestRecorder(t, nil, 5)
+
+		r.RecordQuery("SELECT 1", 99*time.Millisecond)
+		r.RecordRequest("GET", "/api/v4/users/{user_id}", "200", 199*time.Millisecond)
+
+		ops := r.SlowOperations()
+		assert.True(t, ops.Enabled)
+		assert.Empty(t, ops.Current.Queries)
+		assert.Empty(t, ops.Current.Requests)
+	})
+
+	t.Run("only the slowest operations are kept, slowest first", func(t *testing.T) {
+		metrics := &mocks.MetricsInterface{}
+		metrics.On("ObserveSlowOperation", model.SlowOperationKindQuery, mock.AnythingOfType("float64")).Times(4)
+		metrics.On("ObserveSlowOperation", model.SlowOperationKindRequest, mock.AnythingOfType("float64")).Once()
+		r, _ := newTestRecorder(t, metrics, 2)
+
+		r.RecordQuery("SELECT 1", 150*time.Millisecond)
+		r.RecordQuery("SELECT 2", 300*time.Millisecond)
+		r.RecordQuery("SELECT 3", 100*time.Millisecond)
+		r.RecordQuery("SELECT 4", 200*time.Millisecond)
+		r.RecordRequest("GET", "/api/v4/users/{user_id}", "200", 250*time.Millisecond)
+
+		ops := r.SlowOperations()
+		require.Len(t, ops.Current.Queries, 2)
+		assert.Equal(t, "SELECT 2", ops.Current.Queries[0].Query)
+		assert.Equal(t, int64(300), ops.Current.Queries[0].Elapsed)
+		assert.Equal(t, "SELECT 4", ops.Current.Queries[1].Query)
+
+		require.Len(t, ops.Current.Requests, 1)
+		assert.Equal(t, &model.SlowOperation{
+			Kind:       model.SlowOperationKindRequest,
+			Method:     "GET",
+			Route:      "/api/v4/users/{user_id}",
+			StatusCode: "200",
+			Elapsed:    250,
+			CreateAt:   ops.Current.Requests[0].CreateAt,
+		}, ops.Current.Requests[0])
+
+		metrics.AssertExpectations(t)
+	})
+
+	t.Run("the intervals are rotated", func(t *testing.T) {
+		r, now := newTestRecorder(t, nil, 5)
+		startAt := *now
+
+		r.RecordQuery("SELECT 1", time.Second)
+
+		*now = startAt.Add(15 * time.Minute)
+		ops := r.SlowOperations()
+		assert.Empty(t, ops.Current.Queries)
+		assert.Equal(t, model.GetMillisForTime(startAt.Add(10*time.Minute)), ops.Current.StartAt)
+		assert.Zero(t, ops.Current.EndAt)
+		require.NotNil(t, ops.Previous)
+		require.Len(t, ops.Previous.Queries, 1)
+		assert.Equal(t, model.GetMillisForTime(startAt), ops.Previous.StartAt)
+		assert.Equal(t, model.GetMillisForTime(startAt.Add(10*time.Minute)), ops.Previous.EndAt)
+
+		*now = startAt.Add(45 * time.Minute)
+		ops = r.SlowOperations()
+		assert.Equal(t, model.GetMillisForTime(startAt.Add(40*time.Minute)), ops.Current.StartAt)
+		require.NotNil(t, ops.Previous)
+		assert.Empty(t, ops.Previous.Queries)
+		assert.Equal(t, model.GetMillisForTime(startAt.Add(30*time.Minute)), ops.Previous.StartAt)
+	})
+
+	t.Run("disabling the capture drops the operations", func(t *testing.T) {
+		r, _ := newTestRecorder(t, nil, 5)
+		r.RecordQuery("SELECT 1", time.Second)
+
+		settings := model.SlowOperationSettings{}
+		settings.SetDefaults()
+		r.Configure(settings)
+
+		r.RecordQuery("SELECT 2", time.Minute)
+
+		ops := r.SlowOperations()
+		assert.False(t, ops.Enabled)
+		assert.Empty(t, ops.Current.Queries)
+	})
+
+	t.Run("a nil recorder ignores the operations", func(t *testing.T) {
+		var r *Recorder
+		assert.NotPanics(t, func() {
+			r.RecordQuery("SELECT 1", time.Second)
+			r.RecordRequest("GET", "/api/v4/users/me", "200", time.Second)
+		})
+	})
+}
+
+func TestRedactQuery(t *testing.T) {
+	for name, tc := range map[string]struct {
+		query    string
+		expected string
+	}{
+		"bind parameters are kept as is": {
+			query:    "SELECT * FROM Users WHERE Id = $1 AND DeleteAt = ?",
+			expected: "SELECT * FROM Users WHERE Id = $1 AND DeleteAt = ?",
+		},
+		"whitespace is collapsed": {
+			query:    "\n\tSELECT *\n\t\tFROM   Users\n",
+			expected: "SELECT * FROM Users",
+		},
+		"string literals are redacted": {
+			query:    "SELECT * FROM Users WHERE Email = 'user@example.com' AND Username = 'o''brien'",
+			expected: "SELECT * FROM Users WHERE Email = '?' AND Username = '?'",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, redactQuery(tc.query))
+		})
+	}
+
+	t.Run("long queries are truncated", func(t *testing.T) {
+		query := "SELECT * FROM Posts WHERE Id IN ($1"
+		for len(query) < 2*maxQueryLength {
+			query += ", $1"
+		}
+		assert.Len(t, redactQuery(query+")"), maxQueryLength)
+	})
+}
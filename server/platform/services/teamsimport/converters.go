@@ -0,0 +1,215 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package teamsimport
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+var teamsReactionEmojis = map[string]string{
+	"like":      "+1",
+	"heart":     "heart",
+	"laugh":     "laughing",
+	"surprised": "open_mouth",
+	"sad":       "cry",
+	"angry":     "angry",
+}
+
+// teamsConvertReaction returns the name of the emoji matching a Teams reaction, or an empty
+// string if there is none.
+func teamsConvertReaction(reactionType string) string {
+	return teamsReactionEmojis[strings.ToLower(reactionType)]
+}
+
+var teamsInvalidNameChars = regexp.MustCompile(`[^a-z0-9_-]+`)
+
+// teamsConvertName turns a display name into a team or channel name, falling back to a name
+// derived from the id of the object when there is nothing left of the display name.
+func teamsConvertName(displayName, id string) string {
+	name := strings.ToLower(strings.TrimSpace(displayName))
+	name = teamsInvalidNameChars.ReplaceAllString(strings.ReplaceAll(name, " ", "-"), "")
+	name = strings.Trim(name, "_-")
+	if len(name) > model.ChannelNameMaxLength {
+		name = strings.Trim(name[:model.ChannelNameMaxLength], "_-")
+	}
+
+	if len(name) < 2 {
+		sum := sha256.Sum256([]byte(id))
+		name = "teams-" + hex.EncodeToString(sum[:])[:20]
+	}
+	return name
+}
+
+// teamsUniqueName returns the given name, suffixed if needed so that it isn't in use, and
+// records it.
+func teamsUniqueName(name string, used map[string]bool) string {
+	unique := name
+	for i := 2; used[unique]; i++ {
+		suffix := "-" + strconv.Itoa(i)
+		if len(name)+len(suffix) > model.ChannelNameMaxLength {
+			unique = name[:model.ChannelNameMaxLength-len(suffix)] + suffix
+		} else {
+			unique = name + suffix
+		}
+	}
+	used[unique] = true
+	return unique
+}
+
+// teamsConvertBody converts the body of a Teams message to markdown. Mentions, which Teams
+// stores as <at id="n"> elements, are replaced by the username of the mentioned user.
+func teamsConvertBody(body teamsMessageBody, mentions map[int]string) string {
+	if !strings.EqualFold(body.ContentType, "html") {
+		return strings.TrimSpace(body.Content)
+	}
+
+	nodes, err := html.ParseFragment(strings.NewReader(body.Content), &html.Node{
+		Type:     html.ElementNode,
+		Data:     "body",
+		DataAtom: atom.Body,
+	})
+	if err != nil {
+		return strings.TrimSpace(body.Content)
+	}
+
+	c := &teamsHTMLConverter{mentions: mentions}
+	for _, node := range nodes {
+		c.convert(node)
+	}
+	return strings.TrimSpace(teamsBlankLines.ReplaceAllString(c.b.String(), "\n\n"))
+}
+
+var teamsBlankLines = regexp.MustCompile(`\n{3,}`)
+
+type teamsHTMLConverter struct {
+	b        strings.Builder
+	mentions map[int]string
+	list     []string
+}
+
+func (c *teamsHTMLConverter) children(n *html.Node) {
+	for child := n.FirstChild; child != nil; child = child.NextSibling {
+		c.convert(child)
+	}
+}
+
+func (c *teamsHTMLConverter) wrap(n *html.Node, marker string) {
+	c.b.WriteString(marker)
+	c.children(n)
+	c.b.WriteString(marker)
+}
+
+func (c *teamsHTMLConverter) convert(n *html.Node) {
+	switch n.Type {
+	case html.TextNode:
+		c.b.WriteString(n.Data)
+		return
+	case html.ElementNode:
+	default:
+		c.children(n)
+		return
+	}
+
+	switch n.Data {
+	case "br":
+		c.b.WriteString("\n")
+	case "p", "div":
+		c.children(n)
+		c.b.WriteString("\n\n")
+	case "b", "strong":
+		c.wrap(n, "**")
+	case "i", "em":
+		c.wrap(n, "_")
+	case "s", "strike", "del":
+		c.wrap(n, "~~")
+	case "code":
+		c.wrap(n, "`")
+	case "pre":
+		c.b.WriteString("```\n")
+		c.b.WriteString(strings.Trim(teamsNodeText(n), "\n"))
+		c.b.WriteString("\n```\n\n")
+	case "blockquote":
+		text := strings.TrimSpace(teamsNodeText(n))
+		for _, line := range strings.Split(text, "\n") {
+			c.b.WriteString("> " + line + "\n")
+		}
+		c.b.WriteString("\n")
+	case "a":
+		href := teamsAttr(n, "href")
+		text := teamsNodeText(n)
+		if href == "" || href == text {
+			c.b.WriteString(text)
+		} else {
+			c.b.WriteString("[" + text + "](" + href + ")")
+		}
+	case "ul", "ol":
+		c.list = append(c.list, n.Data)
+		c.children(n)
+		c.list = c.list[:len(c.list)-1]
+		c.b.WriteString("\n")
+	case "li":
+		marker := "- "
+		if len(c.list) > 0 && c.list[len(c.list)-1] == "ol" {
+			marker = "1. "
+		}
+		if len(c.list) > 1 {
+			c.b.WriteString(strings.Repeat("  ", len(c.list)-1))
+		}
+		c.b.WriteString(marker)
+		c.children(n)
+		c.b.WriteString("\n")
+	case "at":
+		id, err := strconv.Atoi(teamsAttr(n, "id"))
+		if username, ok := c.mentions[id]; err == nil && ok {
+			c.b.WriteString("@" + username)
+		} else {
+			c.children(n)
+		}
+	case "emoji", "img":
+		c.b.WriteString(teamsAttr(n, "alt"))
+	case "attachment":
+		// Attachments are imported as files.
+	default:
+		c.children(n)
+	}
+}
+
+func teamsAttr(n *html.Node, key string) string {
+	for _, attr := range n.Attr {
+		if attr.Key == key {
+			return attr.Val
+		}
+	}
+	return ""
+}
+
+func teamsNodeText(n *html.Node) string {
+	var b strings.Builder
+	var walk func(*html.Node)
+	walk = func(n *html.Node) {
+		switch {
+		case n.Type == html.TextNode:
+			b.WriteString(n.Data)
+		case n.Type == html.ElementNode && n.Data == "br":
+			b.WriteString("\n")
+		}
+		for child := n.FirstChild; child != nil; child = child.NextSibling {
+			walk(child)
+		}
+		if n.Type == html.ElementNode && (n.Data == "p" || n.Data == "div") {
+			b.WriteString("\n")
+		}
+	}
+	walk(n)
+	return b.String()
+}
@@ -0,0 +1,157 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package teamsimport
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+)
+
+// The types below mirror the resources of the Microsoft Graph API, limited to the fields
+// used by the import.
+
+type teamsTeam struct {
+	Id          string `json:"id"`
+	DisplayName string `json:"displayName"`
+	Description string `json:"description"`
+	Visibility  string `json:"visibility"`
+}
+
+type teamsUser struct {
+	Id                string `json:"id"`
+	DisplayName       string `json:"displayName"`
+	GivenName         string `json:"givenName"`
+	Surname           string `json:"surname"`
+	JobTitle          string `json:"jobTitle"`
+	Mail              string `json:"mail"`
+	UserPrincipalName string `json:"userPrincipalName"`
+}
+
+type teamsChannel struct {
+	Id             string `json:"id"`
+	DisplayName    string `json:"displayName"`
+	Description    string `json:"description"`
+	MembershipType string `json:"membershipType"`
+}
+
+type teamsMember struct {
+	UserId string   `json:"userId"`
+	Roles  []string `json:"roles"`
+}
+
+type teamsChat struct {
+	Id       string `json:"id"`
+	Topic    string `json:"topic"`
+	ChatType string `json:"chatType"`
+}
+
+type teamsIdentity struct {
+	Id          string `json:"id"`
+	DisplayName string `json:"displayName"`
+}
+
+type teamsIdentitySet struct {
+	User        *teamsIdentity `json:"user"`
+	Application *teamsIdentity `json:"application"`
+}
+
+type teamsMessageBody struct {
+	ContentType string `json:"contentType"`
+	Content     string `json:"content"`
+}
+
+type teamsAttachment struct {
+	Id          string `json:"id"`
+	ContentType string `json:"contentType"`
+	Name        string `json:"name"`
+}
+
+type teamsMention struct {
+	Id          int              `json:"id"`
+	MentionText string           `json:"mentionText"`
+	Mentioned   teamsIdentitySet `json:"mentioned"`
+}
+
+type teamsReaction struct {
+	ReactionType    string           `json:"reactionType"`
+	CreatedDateTime string           `json:"createdDateTime"`
+	User            teamsIdentitySet `json:"user"`
+}
+
+type teamsMessage struct {
+	Id                 string            `json:"id"`
+	ReplyToId          string            `json:"replyToId"`
+	MessageType        string            `json:"messageType"`
+	CreatedDateTime    string            `json:"createdDateTime"`
+	LastEditedDateTime string            `json:"lastEditedDateTime"`
+	DeletedDateTime    string            `json:"deletedDateTime"`
+	From               *teamsIdentitySet `json:"from"`
+	Body               teamsMessageBody  `json:"body"`
+	Attachments        []teamsAttachment `json:"attachments"`
+	Mentions           []teamsMention    `json:"mentions"`
+	Reactions          []teamsReaction   `json:"reactions"`
+}
+
+// teamsParseList parses a list of Graph API resources, which is either a plain array or a
+// page of results with the resources under the "value" key.
+func teamsParseList[T any](data io.Reader) ([]T, error) {
+	b, err := io.ReadAll(data)
+	if err != nil {
+		return nil, err
+	}
+
+	b = bytes.TrimSpace(b)
+	if len(b) > 0 && b[0] == '[' {
+		var list []T
+		if err := json.Unmarshal(b, &list); err != nil {
+			return nil, err
+		}
+		return list, nil
+	}
+
+	var page struct {
+		Value []T `json:"value"`
+	}
+	if err := json.Unmarshal(b, &page); err != nil {
+		return nil, err
+	}
+	return page.Value, nil
+}
+
+// teamsReadList parses the list stored at the given path of the export. A missing file is
+// an empty list.
+func teamsReadList[T any](files map[string]*zip.File, name string) ([]T, error) {
+	file, ok := files[name]
+	if !ok {
+		return nil, nil
+	}
+
+	reader, err := file.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	list, err := teamsParseList[T](reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", name, err)
+	}
+	return list, nil
+}
+
+func teamsParseTime(value string) int64 {
+	if value == "" {
+		return 0
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return 0
+	}
+	return t.UnixMilli()
+}
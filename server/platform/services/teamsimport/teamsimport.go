@@ -0,0 +1,593 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+// Package teamsimport converts an export of Microsoft Teams into a bulk import file.
+//
+// The export is a zip archive of Microsoft Graph API resources, as produced by the Graph API
+// export scripts or the Teams export bundle:
+//
+//	users.json
+//	teams.json
+//	teams/{team id}/members.json
+//	teams/{team id}/channels.json
+//	teams/{team id}/channels/{channel id}/members.json     (private and shared channels)
+//	teams/{team id}/channels/{channel id}/messages.json
+//	teams/{team id}/channels/{channel id}/files/{attachment id}/{file name}
+//	chats.json
+//	chats/{chat id}/members.json
+//	chats/{chat id}/messages.json
+//	chats/{chat id}/files/{attachment id}/{file name}
+//
+// Every JSON file is either an array of resources or a page of results of the Graph API.
+package teamsimport
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/v8/channels/app/imports"
+)
+
+const (
+	teamsGeneralChannel    = "General"
+	teamsAttachmentsDir    = "files"
+	teamsMessageTypeNormal = "message"
+	teamsChatTypeOneOnOne  = "oneOnOne"
+)
+
+// Stats holds the number of objects of each kind written to the import file, along with a
+// description of the items of the export that couldn't be imported.
+type Stats struct {
+	Teams          int
+	Channels       int
+	Users          int
+	Posts          int
+	DirectChannels int
+	DirectPosts    int
+	Skipped        []string
+}
+
+type teamsChannelData struct {
+	team    string
+	channel teamsChannel
+	name    string
+	dir     string
+}
+
+type teamsTransformer struct {
+	logger      mlog.LoggerIFace
+	files       map[string]*zip.File
+	attachments map[string]string
+	encoder     *json.Encoder
+	maxPostSize int
+	usernames   map[string]string
+	stats       *Stats
+}
+
+// Transform reads a Microsoft Teams export and writes the matching bulk import lines to
+// output. The attachments of the import refer to the files of the export, which therefore
+// has to be used as the attachments archive of the import.
+func Transform(logger mlog.LoggerIFace, export *zip.Reader, output io.Writer, maxPostSize int) (*Stats, error) {
+	t := &teamsTransformer{
+		logger:      logger,
+		files:       make(map[string]*zip.File, len(export.File)),
+		attachments: make(map[string]string),
+		encoder:     json.NewEncoder(output),
+		maxPostSize: maxPostSize,
+		usernames:   make(map[string]string),
+		stats:       &Stats{},
+	}
+
+	for _, file := range export.File {
+		t.files[file.Name] = file
+
+		// Index the files by the directory of their attachment.
+		dir := path.Dir(file.Name)
+		if path.Base(path.Dir(dir)) == teamsAttachmentsDir && !file.FileInfo().IsDir() {
+			t.attachments[dir] = file.Name
+		}
+	}
+
+	if err := t.writeLine(&imports.LineImportData{Type: "version", Version: model.NewInt(1)}); err != nil {
+		return nil, err
+	}
+
+	users, err := teamsReadList[teamsUser](t.files, "users.json")
+	if err != nil {
+		return nil, err
+	}
+	userLines := t.convertUsers(users)
+
+	teams, err := teamsReadList[teamsTeam](t.files, "teams.json")
+	if err != nil {
+		return nil, err
+	}
+
+	var channels []*teamsChannelData
+	teamNames := make(map[string]bool)
+	for _, team := range teams {
+		teamName := teamsUniqueName(teamsConvertName(team.DisplayName, team.Id), teamNames)
+		teamType := model.TeamInvite
+		if strings.EqualFold(team.Visibility, "public") {
+			teamType = model.TeamOpen
+		}
+		if err := t.writeLine(&imports.LineImportData{
+			Type: "team",
+			Team: &imports.TeamImportData{
+				Name:            model.NewString(teamName),
+				DisplayName:     model.NewString(teamsDisplayName(team.DisplayName, teamName, model.TeamDisplayNameMaxRunes)),
+				Type:            model.NewString(teamType),
+				Description:     model.NewString(teamsTruncate(team.Description, model.TeamDescriptionMaxLength)),
+				AllowOpenInvite: model.NewBool(teamType == model.TeamOpen),
+			},
+		}); err != nil {
+			return nil, err
+		}
+		t.stats.Teams++
+
+		teamChannels, err := t.convertTeam(team, teamName, userLines)
+		if err != nil {
+			return nil, err
+		}
+		channels = append(channels, teamChannels...)
+	}
+
+	for _, channel := range channels {
+		if channel.name == model.DefaultChannelName {
+			continue
+		}
+		channelType := model.ChannelTypeOpen
+		if !strings.EqualFold(channel.channel.MembershipType, "standard") && channel.channel.MembershipType != "" {
+			channelType = model.ChannelTypePrivate
+		}
+		if err := t.writeLine(&imports.LineImportData{
+			Type: "channel",
+			Channel: &imports.ChannelImportData{
+				Team:        model.NewString(channel.team),
+				Name:        model.NewString(channel.name),
+				DisplayName: model.NewString(teamsDisplayName(channel.channel.DisplayName, channel.name, model.ChannelDisplayNameMaxRunes)),
+				Type:        &channelType,
+				Purpose:     model.NewString(teamsTruncate(channel.channel.Description, model.ChannelPurposeMaxRunes)),
+			},
+		}); err != nil {
+			return nil, err
+		}
+		t.stats.Channels++
+	}
+
+	for _, user := range users {
+		line, ok := userLines[user.Id]
+		if !ok {
+			continue
+		}
+		if err := t.writeLine(line); err != nil {
+			return nil, err
+		}
+		t.stats.Users++
+	}
+
+	for _, channel := range channels {
+		if err := t.convertChannelMessages(channel); err != nil {
+			return nil, err
+		}
+	}
+
+	chats, err := teamsReadList[teamsChat](t.files, "chats.json")
+	if err != nil {
+		return nil, err
+	}
+	for _, chat := range chats {
+		if err := t.convertChat(chat); err != nil {
+			return nil, err
+		}
+	}
+
+	return t.stats, nil
+}
+
+func (t *teamsTransformer) writeLine(line *imports.LineImportData) error {
+	if err := t.encoder.Encode(line); err != nil {
+		return fmt.Errorf("failed to write the import line: %w", err)
+	}
+	return nil
+}
+
+func (t *teamsTransformer) skip(format string, args ...any) {
+	item := fmt.Sprintf(format, args...)
+	t.logger.Warn("Teams Import: Skipping item of the export.", mlog.String("item", item))
+	t.stats.Skipped = append(t.stats.Skipped, item)
+}
+
+func (t *teamsTransformer) convertUsers(users []teamsUser) map[string]*imports.LineImportData {
+	lines := make(map[string]*imports.LineImportData, len(users))
+	usernames := make(map[string]bool, len(users))
+	for _, user := range users {
+		email := user.Mail
+		if email == "" {
+			email = user.UserPrincipalName
+		}
+		if !strings.Contains(email, "@") {
+			t.skip("user %s (%s): no email address", user.Id, user.DisplayName)
+			continue
+		}
+
+		localPart := strings.Split(user.UserPrincipalName, "@")[0]
+		if localPart == "" {
+			localPart = strings.Split(email, "@")[0]
+		}
+		username := model.CleanUsername(t.logger, localPart)
+		for i := 2; usernames[username]; i++ {
+			username = model.CleanUsername(t.logger, fmt.Sprintf("%s%d", localPart, i))
+		}
+		usernames[username] = true
+		t.usernames[user.Id] = username
+
+		lines[user.Id] = &imports.LineImportData{
+			Type: "user",
+			User: &imports.UserImportData{
+				Username:  model.NewString(username),
+				Email:     model.NewString(strings.ToLower(email)),
+				FirstName: model.NewString(user.GivenName),
+				LastName:  model.NewString(user.Surname),
+				Position:  model.NewString(teamsTruncate(user.JobTitle, model.UserPositionMaxRunes)),
+				Roles:     model.NewString(model.SystemUserRoleId),
+				Teams:     &[]imports.UserTeamImportData{},
+			},
+		}
+	}
+	return lines
+}
+
+// convertTeam adds the memberships of the team and its channels to the users, and returns
+// the channels of the team.
+func (t *teamsTransformer) convertTeam(team teamsTeam, teamName string, userLines map[string]*imports.LineImportData) ([]*teamsChannelData, error) {
+	teamDir := path.Join("teams", team.Id)
+	teamChannels, err := teamsReadList[teamsChannel](t.files, path.Join(teamDir, "channels.json"))
+	if err != nil {
+		return nil, err
+	}
+	members, err := teamsReadList[teamsMember](t.files, path.Join(teamDir, "members.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	memberships := make(map[string]*imports.UserTeamImportData)
+	for _, member := range members {
+		if _, ok := userLines[member.UserId]; !ok {
+			continue
+		}
+		roles := model.TeamUserRoleId
+		if teamsIsOwner(member) {
+			roles = model.TeamAdminRoleId + " " + model.TeamUserRoleId
+		}
+		memberships[member.UserId] = &imports.UserTeamImportData{
+			Name:     model.NewString(teamName),
+			Roles:    model.NewString(roles),
+			Channels: &[]imports.UserChannelImportData{},
+		}
+	}
+
+	var channels []*teamsChannelData
+	channelNames := map[string]bool{model.DefaultChannelName: true}
+	for _, channel := range teamChannels {
+		name := model.DefaultChannelName
+		if channel.DisplayName != teamsGeneralChannel {
+			name = teamsUniqueName(teamsConvertName(channel.DisplayName, channel.Id), channelNames)
+		}
+		data := &teamsChannelData{
+			team:    teamName,
+			channel: channel,
+			name:    name,
+			dir:     path.Join(teamDir, "channels", channel.Id),
+		}
+		channels = append(channels, data)
+
+		// Standard channels are open to all the members of the team, the other ones have
+		// their own members.
+		channelMembers := members
+		if channel.MembershipType != "" && !strings.EqualFold(channel.MembershipType, "standard") {
+			channelMembers, err = teamsReadList[teamsMember](t.files, path.Join(data.dir, "members.json"))
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		for _, member := range channelMembers {
+			membership, ok := memberships[member.UserId]
+			if !ok {
+				continue
+			}
+			roles := model.ChannelUserRoleId
+			if teamsIsOwner(member) && name != model.DefaultChannelName {
+				roles = model.ChannelAdminRoleId + " " + model.ChannelUserRoleId
+			}
+			*membership.Channels = append(*membership.Channels, imports.UserChannelImportData{
+				Name:  model.NewString(name),
+				Roles: model.NewString(roles),
+			})
+		}
+	}
+
+	for _, member := range members {
+		if membership, ok := memberships[member.UserId]; ok {
+			userTeams := userLines[member.UserId].User.Teams
+			*userTeams = append(*userTeams, *membership)
+			delete(memberships, member.UserId)
+		}
+	}
+
+	return channels, nil
+}
+
+func (t *teamsTransformer) convertChannelMessages(channel *teamsChannelData) error {
+	messages, err := teamsReadList[teamsMessage](t.files, path.Join(channel.dir, "messages.json"))
+	if err != nil {
+		return err
+	}
+
+	location := channel.team + "/" + channel.name
+	for _, thread := range t.convertThreads(messages, channel.dir, location) {
+		if err := t.writeLine(&imports.LineImportData{
+			Type: "post",
+			Post: &imports.PostImportData{
+				Team:        model.NewString(channel.team),
+				Channel:     model.NewString(channel.name),
+				User:        thread.User,
+				Message:     thread.Message,
+				CreateAt:    thread.CreateAt,
+				EditAt:      thread.EditAt,
+				Reactions:   thread.Reactions,
+				Replies:     thread.replies,
+				Attachments: thread.Attachments,
+			},
+		}); err != nil {
+			return err
+		}
+		t.stats.Posts++
+	}
+	return nil
+}
+
+func (t *teamsTransformer) convertChat(chat teamsChat) error {
+	chatDir := path.Join("chats", chat.Id)
+	members, err := teamsReadList[teamsMember](t.files, path.Join(chatDir, "members.json"))
+	if err != nil {
+		return err
+	}
+
+	var usernames []string
+	for _, member := range members {
+		if username, ok := t.usernames[member.UserId]; ok {
+			usernames = append(usernames, username)
+		}
+	}
+	sort.Strings(usernames)
+
+	if chat.ChatType == teamsChatTypeOneOnOne && len(usernames) != 2 ||
+		chat.ChatType != teamsChatTypeOneOnOne && (len(usernames) < model.ChannelGroupMinUsers || len(usernames) > model.ChannelGroupMaxUsers) {
+		t.skip("chat %s: %d known members", chat.Id, len(usernames))
+		return nil
+	}
+
+	if err := t.writeLine(&imports.LineImportData{
+		Type: "direct_channel",
+		DirectChannel: &imports.DirectChannelImportData{
+			Members: &usernames,
+			Header:  model.NewString(teamsTruncate(chat.Topic, model.ChannelHeaderMaxRunes)),
+		},
+	}); err != nil {
+		return err
+	}
+	t.stats.DirectChannels++
+
+	messages, err := teamsReadList[teamsMessage](t.files, path.Join(chatDir, "messages.json"))
+	if err != nil {
+		return err
+	}
+
+	for _, thread := range t.convertThreads(messages, chatDir, "chat "+chat.Id) {
+		if err := t.writeLine(&imports.LineImportData{
+			Type: "direct_post",
+			DirectPost: &imports.DirectPostImportData{
+				ChannelMembers: &usernames,
+				User:           thread.User,
+				Message:        thread.Message,
+				CreateAt:       thread.CreateAt,
+				EditAt:         thread.EditAt,
+				Reactions:      thread.Reactions,
+				Replies:        thread.replies,
+				Attachments:    thread.Attachments,
+			},
+		}); err != nil {
+			return err
+		}
+		t.stats.DirectPosts++
+	}
+	return nil
+}
+
+type teamsThread struct {
+	imports.ReplyImportData
+	id      string
+	replies *[]imports.ReplyImportData
+}
+
+// convertThreads converts the messages of a channel or chat, nesting the replies under the
+// message they reply to.
+func (t *teamsTransformer) convertThreads(messages []teamsMessage, dir, location string) []*teamsThread {
+	sort.SliceStable(messages, func(i, j int) bool {
+		return teamsParseTime(messages[i].CreatedDateTime) < teamsParseTime(messages[j].CreatedDateTime)
+	})
+
+	var threads []*teamsThread
+	roots := make(map[string]*teamsThread)
+	for _, message := range messages {
+		if message.ReplyToId != "" {
+			continue
+		}
+		post, ok := t.convertMessage(message, dir, location)
+		if !ok {
+			continue
+		}
+		thread := &teamsThread{ReplyImportData: *post, id: message.Id}
+		threads = append(threads, thread)
+		roots[message.Id] = thread
+	}
+
+	for _, message := range messages {
+		if message.ReplyToId == "" {
+			continue
+		}
+		root, ok := roots[message.ReplyToId]
+		if !ok {
+			if message.DeletedDateTime == "" && message.MessageType == teamsMessageTypeNormal {
+				t.skip("message %s in %s: reply to a missing message", message.Id, location)
+			}
+			continue
+		}
+		reply, ok := t.convertMessage(message, dir, location)
+		if !ok {
+			continue
+		}
+		if *reply.CreateAt < *root.CreateAt {
+			reply.CreateAt = root.CreateAt
+		}
+		if root.replies == nil {
+			root.replies = &[]imports.ReplyImportData{}
+		}
+		*root.replies = append(*root.replies, *reply)
+	}
+
+	return threads
+}
+
+func (t *teamsTransformer) convertMessage(message teamsMessage, dir, location string) (*imports.ReplyImportData, bool) {
+	// System events and deleted messages have no content to import.
+	if message.MessageType != teamsMessageTypeNormal || message.DeletedDateTime != "" {
+		return nil, false
+	}
+
+	if message.From == nil || message.From.User == nil {
+		t.skip("message %s in %s: not sent by a user", message.Id, location)
+		return nil, false
+	}
+	username, ok := t.usernames[message.From.User.Id]
+	if !ok {
+		t.skip("message %s in %s: unknown user %s", message.Id, location, message.From.User.Id)
+		return nil, false
+	}
+
+	mentions := make(map[int]string, len(message.Mentions))
+	for _, mention := range message.Mentions {
+		if mention.Mentioned.User == nil {
+			continue
+		}
+		if mentioned, ok := t.usernames[mention.Mentioned.User.Id]; ok {
+			mentions[mention.Id] = mentioned
+		}
+	}
+
+	text := teamsConvertBody(message.Body, mentions)
+	if utf8.RuneCountInString(text) > t.maxPostSize {
+		t.skip("message %s in %s: message too long", message.Id, location)
+		return nil, false
+	}
+
+	var attachments []imports.AttachmentImportData
+	for _, attachment := range message.Attachments {
+		if attachment.ContentType != "reference" {
+			continue
+		}
+		filePath, ok := t.attachments[path.Join(dir, teamsAttachmentsDir, attachment.Id)]
+		if !ok {
+			t.skip("file %s of message %s in %s: not found in the export", attachment.Name, message.Id, location)
+			continue
+		}
+		attachments = append(attachments, imports.AttachmentImportData{Path: model.NewString(filePath)})
+	}
+
+	if text == "" && len(attachments) == 0 {
+		t.skip("message %s in %s: no content", message.Id, location)
+		return nil, false
+	}
+
+	createAt := teamsParseTime(message.CreatedDateTime)
+	if createAt == 0 {
+		t.skip("message %s in %s: invalid creation time", message.Id, location)
+		return nil, false
+	}
+
+	post := &imports.ReplyImportData{
+		User:     model.NewString(username),
+		Message:  model.NewString(text),
+		CreateAt: model.NewInt64(createAt),
+	}
+	if editAt := teamsParseTime(message.LastEditedDateTime); editAt > createAt {
+		post.EditAt = model.NewInt64(editAt)
+	}
+	if len(attachments) > 0 {
+		post.Attachments = &attachments
+	}
+
+	var reactions []imports.ReactionImportData
+	for _, reaction := range message.Reactions {
+		emojiName := teamsConvertReaction(reaction.ReactionType)
+		if emojiName == "" {
+			t.skip("reaction %s on message %s in %s: unsupported reaction", reaction.ReactionType, message.Id, location)
+			continue
+		}
+		if reaction.User.User == nil {
+			continue
+		}
+		reactionUser, ok := t.usernames[reaction.User.User.Id]
+		if !ok {
+			continue
+		}
+		reactionAt := teamsParseTime(reaction.CreatedDateTime)
+		if reactionAt < createAt {
+			reactionAt = createAt
+		}
+		reactions = append(reactions, imports.ReactionImportData{
+			User:      model.NewString(reactionUser),
+			EmojiName: model.NewString(emojiName),
+			CreateAt:  model.NewInt64(reactionAt),
+		})
+	}
+	if len(reactions) > 0 {
+		post.Reactions = &reactions
+	}
+
+	return post, true
+}
+
+func teamsIsOwner(member teamsMember) bool {
+	for _, role := range member.Roles {
+		if strings.EqualFold(role, "owner") {
+			return true
+		}
+	}
+	return false
+}
+
+func teamsDisplayName(displayName, name string, maxRunes int) string {
+	displayName = strings.TrimSpace(displayName)
+	if displayName == "" {
+		return name
+	}
+	return teamsTruncate(displayName, maxRunes)
+}
+
+func teamsTruncate(s string, maxRunes int) string {
+	if utf8.RuneCountInString(s) <= maxRunes {
+		return s
+	}
+	return string([]rune(s)[:maxRunes])
+}
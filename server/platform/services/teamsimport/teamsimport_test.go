@@ -0,0 +1,265 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package teamsimport
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/v8/channels/app/imports"
+)
+
+func TestTeamsConvertBody(t *testing.T) {
+	for name, tc := range map[string]struct {
+		body     teamsMessageBody
+		expected string
+	}{
+		"text": {
+			body:     teamsMessageBody{ContentType: "text", Content: " plain <b>text</b> "},
+			expected: "plain <b>text</b>",
+		},
+		"paragraphs": {
+			body:     teamsMessageBody{ContentType: "html", Content: "<p>first</p><p>second<br>line</p>"},
+			expected: "first\n\nsecond\nline",
+		},
+		"formatting": {
+			body:     teamsMessageBody{ContentType: "html", Content: "<div><b>bold</b> <i>italic</i> <s>strike</s> <code>code</code></div>"},
+			expected: "**bold** _italic_ ~~strike~~ `code`",
+		},
+		"link": {
+			body:     teamsMessageBody{ContentType: "html", Content: `<a href="https://example.com">example</a> <a href="https://example.com">https://example.com</a>`},
+			expected: "[example](https://example.com) https://example.com",
+		},
+		"list": {
+			body:     teamsMessageBody{ContentType: "html", Content: "<ul><li>one</li><li>two</li></ul>"},
+			expected: "- one\n- two",
+		},
+		"mentions": {
+			body:     teamsMessageBody{ContentType: "html", Content: `<div>hi <at id="0">Jane Doe</at> and <at id="1">Unknown</at></div>`},
+			expected: "hi @jane.doe and Unknown",
+		},
+		"emoji and entities": {
+			body:     teamsMessageBody{ContentType: "html", Content: `<p>a &amp; b <emoji alt="😀"></emoji></p>`},
+			expected: "a & b 😀",
+		},
+		"attachment": {
+			body:     teamsMessageBody{ContentType: "html", Content: `<p>see file</p><attachment id="abc"></attachment>`},
+			expected: "see file",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, teamsConvertBody(tc.body, map[int]string{0: "jane.doe"}))
+		})
+	}
+}
+
+func TestTeamsConvertName(t *testing.T) {
+	assert.Equal(t, "project-x", teamsConvertName("Project X!", "id"))
+	assert.Equal(t, teamsConvertName("???", "id"), teamsConvertName("", "id"))
+	assert.True(t, strings.HasPrefix(teamsConvertName("???", "id"), "teams-"))
+	assert.NotEqual(t, teamsConvertName("", "id"), teamsConvertName("", "other"))
+	assert.Len(t, teamsConvertName(strings.Repeat("a", 100), "id"), model.ChannelNameMaxLength)
+
+	used := map[string]bool{}
+	assert.Equal(t, "name", teamsUniqueName("name", used))
+	assert.Equal(t, "name-2", teamsUniqueName("name", used))
+	assert.Equal(t, "name-3", teamsUniqueName("name", used))
+}
+
+func TestTeamsParseList(t *testing.T) {
+	list, err := teamsParseList[teamsTeam](strings.NewReader(`[{"id":"1"}]`))
+	require.NoError(t, err)
+	assert.Equal(t, []teamsTeam{{Id: "1"}}, list)
+
+	list, err = teamsParseList[teamsTeam](strings.NewReader(`{"@odata.context":"ctx","value":[{"id":"1"},{"id":"2"}]}`))
+	require.NoError(t, err)
+	assert.Len(t, list, 2)
+
+	_, err = teamsParseList[teamsTeam](strings.NewReader(`invalid`))
+	require.Error(t, err)
+}
+
+func createTeamsExport(t *testing.T, files map[string]any) *zip.Reader {
+	t.Helper()
+
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for name, content := range files {
+		f, err := w.Create(name)
+		require.NoError(t, err)
+		if s, ok := content.(string); ok {
+			_, err = f.Write([]byte(s))
+		} else {
+			err = json.NewEncoder(f).Encode(content)
+		}
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+
+	r, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	return r
+}
+
+func TestTransform(t *testing.T) {
+	user := func(id, upn, name string) map[string]any {
+		return map[string]any{"id": id, "userPrincipalName": upn, "displayName": name, "givenName": name}
+	}
+	from := func(id string) map[string]any {
+		return map[string]any{"user": map[string]any{"id": id}}
+	}
+
+	export := createTeamsExport(t, map[string]any{
+		"users.json": map[string]any{"value": []any{
+			user("u1", "jane.doe@example.com", "Jane"),
+			user("u2", "john@example.com", "John"),
+			user("u3", "bob@example.com", "Bob"),
+			map[string]any{"id": "u4", "displayName": "No Email"},
+		}},
+		"teams.json": []any{
+			map[string]any{"id": "t1", "displayName": "Engineering", "description": "Eng", "visibility": "public"},
+		},
+		"teams/t1/members.json": []any{
+			map[string]any{"userId": "u1", "roles": []string{"owner"}},
+			map[string]any{"userId": "u2"},
+			map[string]any{"userId": "u4"},
+		},
+		"teams/t1/channels.json": []any{
+			map[string]any{"id": "c1", "displayName": "General", "membershipType": "standard"},
+			map[string]any{"id": "c2", "displayName": "Secret Plans", "membershipType": "private"},
+		},
+		"teams/t1/channels/c2/members.json": []any{
+			map[string]any{"userId": "u1", "roles": []string{"owner"}},
+		},
+		"teams/t1/channels/c1/messages.json": []any{
+			map[string]any{
+				"id": "m2", "replyToId": "m1", "messageType": "message", "createdDateTime": "2023-01-01T10:05:00Z",
+				"from": from("u2"), "body": map[string]any{"contentType": "text", "content": "reply"},
+			},
+			map[string]any{
+				"id": "m1", "messageType": "message", "createdDateTime": "2023-01-01T10:00:00Z", "lastEditedDateTime": "2023-01-01T10:01:00Z",
+				"from": from("u1"),
+				"body": map[string]any{"contentType": "html", "content": `<p>hello <at id="0">John</at></p>`},
+				"mentions": []any{
+					map[string]any{"id": 0, "mentionText": "John", "mentioned": from("u2")},
+				},
+				"reactions": []any{
+					map[string]any{"reactionType": "like", "createdDateTime": "2023-01-01T10:02:00Z", "user": from("u2")},
+					map[string]any{"reactionType": "custom", "createdDateTime": "2023-01-01T10:02:00Z", "user": from("u2")},
+				},
+				"attachments": []any{
+					map[string]any{"id": "a1", "contentType": "reference", "name": "doc.txt"},
+					map[string]any{"id": "a2", "contentType": "reference", "name": "missing.txt"},
+				},
+			},
+			map[string]any{"id": "m3", "messageType": "systemEventMessage", "createdDateTime": "2023-01-01T10:00:00Z"},
+			map[string]any{"id": "m4", "messageType": "message", "createdDateTime": "2023-01-01T10:00:00Z", "deletedDateTime": "2023-01-01T11:00:00Z", "from": from("u1")},
+			map[string]any{
+				"id": "m5", "messageType": "message", "createdDateTime": "2023-01-01T10:00:00Z",
+				"from": from("unknown"), "body": map[string]any{"contentType": "text", "content": "ghost"},
+			},
+		},
+		"teams/t1/channels/c1/files/a1/doc.txt": "content",
+		"chats.json": []any{
+			map[string]any{"id": "chat1", "chatType": "oneOnOne"},
+			map[string]any{"id": "chat2", "chatType": "group", "topic": "Too small"},
+		},
+		"chats/chat1/members.json": []any{
+			map[string]any{"userId": "u1"},
+			map[string]any{"userId": "u3"},
+		},
+		"chats/chat1/messages.json": []any{
+			map[string]any{
+				"id": "d1", "messageType": "message", "createdDateTime": "2023-01-02T10:00:00Z",
+				"from": from("u3"), "body": map[string]any{"contentType": "text", "content": "direct"},
+			},
+		},
+		"chats/chat2/members.json": []any{
+			map[string]any{"userId": "u1"},
+			map[string]any{"userId": "u2"},
+		},
+	})
+
+	var output bytes.Buffer
+	stats, err := Transform(mlog.CreateConsoleTestLogger(t), export, &output, model.PostMessageMaxRunesV2)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, stats.Teams)
+	assert.Equal(t, 1, stats.Channels, "the General channel maps to the default channel")
+	assert.Equal(t, 3, stats.Users)
+	assert.Equal(t, 1, stats.Posts)
+	assert.Equal(t, 1, stats.DirectChannels)
+	assert.Equal(t, 1, stats.DirectPosts)
+	assert.Len(t, stats.Skipped, 5, "user without email, unknown author, missing file, custom reaction and small group chat")
+
+	var lines []*imports.LineImportData
+	scanner := bufio.NewScanner(&output)
+	for scanner.Scan() {
+		var line imports.LineImportData
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &line))
+		lines = append(lines, &line)
+	}
+	require.NoError(t, scanner.Err())
+
+	validator := imports.NewLineOrderValidator()
+	var types []string
+	for _, line := range lines[1:] {
+		require.Nil(t, imports.ValidateLine(line, model.PostMessageMaxRunesV2))
+		require.Nil(t, validator.Validate(line.Type))
+		types = append(types, line.Type)
+	}
+	assert.Equal(t, "version", lines[0].Type)
+	assert.Equal(t, []string{"team", "channel", "user", "user", "user", "post", "direct_channel", "direct_post"}, types)
+
+	team := lines[1].Team
+	assert.Equal(t, "engineering", *team.Name)
+	assert.Equal(t, model.TeamOpen, *team.Type)
+
+	channel := lines[2].Channel
+	assert.Equal(t, "secret-plans", *channel.Name)
+	assert.Equal(t, model.ChannelTypePrivate, *channel.Type)
+
+	jane := lines[3].User
+	assert.Equal(t, "jane.doe", *jane.Username)
+	require.Len(t, *jane.Teams, 1)
+	assert.Equal(t, "team_admin team_user", *(*jane.Teams)[0].Roles)
+	require.Len(t, *(*jane.Teams)[0].Channels, 2)
+	assert.Equal(t, model.DefaultChannelName, *(*(*jane.Teams)[0].Channels)[0].Name)
+	assert.Equal(t, "secret-plans", *(*(*jane.Teams)[0].Channels)[1].Name)
+
+	john := lines[4].User
+	require.Len(t, *john.Teams, 1)
+	assert.Len(t, *(*john.Teams)[0].Channels, 1, "john isn't a member of the private channel")
+
+	bob := lines[5].User
+	assert.Empty(t, *bob.Teams)
+
+	post := lines[6].Post
+	assert.Equal(t, model.DefaultChannelName, *post.Channel)
+	assert.Equal(t, "jane.doe", *post.User)
+	assert.Equal(t, "hello @john", *post.Message)
+	assert.Equal(t, int64(1672567200000), *post.CreateAt)
+	assert.Equal(t, int64(1672567260000), *post.EditAt)
+	require.NotNil(t, post.Reactions)
+	require.Len(t, *post.Reactions, 1)
+	assert.Equal(t, "+1", *(*post.Reactions)[0].EmojiName)
+	require.NotNil(t, post.Attachments)
+	require.Len(t, *post.Attachments, 1)
+	assert.Equal(t, "teams/t1/channels/c1/files/a1/doc.txt", *(*post.Attachments)[0].Path)
+	require.NotNil(t, post.Replies)
+	require.Len(t, *post.Replies, 1)
+	assert.Equal(t, "reply", *(*post.Replies)[0].Message)
+
+	assert.Equal(t, []string{"bob", "jane.doe"}, *lines[7].DirectChannel.Members)
+	assert.Equal(t, "direct", *lines[8].DirectPost.Message)
+}
@@ -77,6 +77,8 @@ const (
 	TrackConfigBleve             = "config_bleve"
 	TrackConfigExport            = "config_export"
 	TrackConfigWrangler          = "config_wrangler"
+	TrackConfigTracing           = "config_tracing"
+	TrackConfigSlowOperation     = "config_slow_operation"
 	TrackFeatureFlags            = "config_feature_flags"
 	TrackPermissionsGeneral      = "permissions_general"
 	TrackPermissionsSystemScheme = "permissions_system_scheme"
@@ -410,9 +412,15 @@ func (ts *TelemetryService) trackConfig() {
 		"enable_outgoing_oauth_connections":                       cfg.ServiceSettings.EnableOutgoingOAuthConnections,
 		"enable_commands":                                         *cfg.ServiceSettings.EnableCommands,
 		"outgoing_integrations_requests_timeout":                  cfg.ServiceSettings.OutgoingIntegrationRequestsTimeout,
+		"enable_integration_delivery_log":                         *cfg.ServiceSettings.EnableIntegrationDeliveryLog,
+		"integration_delivery_log_retention_days":                 *cfg.ServiceSettings.IntegrationDeliveryLogRetentionDays,
+		"incoming_webhook_rate_limit_per_minute":                  *cfg.ServiceSettings.IncomingWebhookRateLimitPerMinute,
+		"validate_incoming_webhook_attachments":                   *cfg.ServiceSettings.ValidateIncomingWebhookAttachments,
+		"enable_automation_rules":                                 *cfg.ServiceSettings.EnableAutomationRules,
 		"enable_post_username_override":                           cfg.ServiceSettings.EnablePostUsernameOverride,
 		"enable_post_icon_override":                               cfg.ServiceSettings.EnablePostIconOverride,
 		"enable_user_access_tokens":                               *cfg.ServiceSettings.EnableUserAccessTokens,
+		"user_access_token_grace_period_minutes":                  *cfg.ServiceSettings.UserAccessTokenGracePeriodMinutes,
 		"enable_custom_emoji":                                     *cfg.ServiceSettings.EnableCustomEmoji,
 		"enable_emoji_picker":                                     *cfg.ServiceSettings.EnableEmojiPicker,
 		"enable_gif_picker":                                       *cfg.ServiceSettings.EnableGifPicker,
@@ -451,6 +459,7 @@ func (ts *TelemetryService) trackConfig() {
 		"enable_user_typing_messages":                             *cfg.ServiceSettings.EnableUserTypingMessages,
 		"enable_channel_viewed_messages":                          *cfg.ServiceSettings.EnableChannelViewedMessages,
 		"time_between_user_typing_updates_milliseconds":           *cfg.ServiceSettings.TimeBetweenUserTypingUpdatesMilliseconds,
+		"typing_aggregation_window_milliseconds":                  *cfg.ServiceSettings.TypingAggregationWindowMilliseconds,
 		"cluster_log_timeout_milliseconds":                        *cfg.ServiceSettings.ClusterLogTimeoutMilliseconds,
 		"enable_post_search":                                      *cfg.ServiceSettings.EnablePostSearch,
 		"minimum_hashtag_length":                                  *cfg.ServiceSettings.MinimumHashtagLength,
@@ -466,6 +475,7 @@ func (ts *TelemetryService) trackConfig() {
 		"enable_api_team_deletion":                                *cfg.ServiceSettings.EnableAPITeamDeletion,
 		"enable_api_trigger_admin_notification":                   *cfg.ServiceSettings.EnableAPITriggerAdminNotifications,
 		"enable_api_user_deletion":                                *cfg.ServiceSettings.EnableAPIUserDeletion,
+		"enable_api_data_seeding":                                 *cfg.ServiceSettings.EnableAPIDataSeeding,
 		"enable_api_channel_deletion":                             *cfg.ServiceSettings.EnableAPIChannelDeletion,
 		"experimental_enable_hardened_mode":                       *cfg.ServiceSettings.ExperimentalEnableHardenedMode,
 		"experimental_strict_csrf_enforcement":                    *cfg.ServiceSettings.ExperimentalStrictCSRFEnforcement,
@@ -493,6 +503,8 @@ func (ts *TelemetryService) trackConfig() {
 		"allow_synced_drafts":                                     *cfg.ServiceSettings.AllowSyncedDrafts,
 		"refresh_post_stats_run_time":                             *cfg.ServiceSettings.RefreshPostStatsRunTime,
 		"maximum_payload_size":                                    *cfg.ServiceSettings.MaximumPayloadSizeBytes,
+		"enable_maintenance_mode":                                 *cfg.ServiceSettings.EnableMaintenanceMode,
+		"maintenance_mode_retry_after_seconds":                    *cfg.ServiceSettings.MaintenanceModeRetryAfterSeconds,
 	})
 
 	ts.SendTelemetry(TrackConfigTeam, map[string]any{
@@ -566,15 +578,17 @@ func (ts *TelemetryService) trackConfig() {
 	})
 
 	ts.SendTelemetry(TrackConfigNotificationLog, map[string]any{
-		"enable_console":          *cfg.NotificationLogSettings.EnableConsole,
-		"console_level":           *cfg.NotificationLogSettings.ConsoleLevel,
-		"console_json":            *cfg.NotificationLogSettings.ConsoleJson,
-		"enable_file":             *cfg.NotificationLogSettings.EnableFile,
-		"file_level":              *cfg.NotificationLogSettings.FileLevel,
-		"file_json":               *cfg.NotificationLogSettings.FileJson,
-		"isdefault_file_location": isDefault(*cfg.NotificationLogSettings.FileLocation, ""),
-		"advanced_logging_json":   len(cfg.NotificationLogSettings.AdvancedLoggingJSON) != 0,
-		"advanced_logging_config": cfg.NotificationLogSettings.AdvancedLoggingConfig != nil && *cfg.NotificationLogSettings.AdvancedLoggingConfig != "",
+		"enable_console":                  *cfg.NotificationLogSettings.EnableConsole,
+		"console_level":                   *cfg.NotificationLogSettings.ConsoleLevel,
+		"console_json":                    *cfg.NotificationLogSettings.ConsoleJson,
+		"enable_file":                     *cfg.NotificationLogSettings.EnableFile,
+		"file_level":                      *cfg.NotificationLogSettings.FileLevel,
+		"file_json":                       *cfg.NotificationLogSettings.FileJson,
+		"isdefault_file_location":         isDefault(*cfg.NotificationLogSettings.FileLocation, ""),
+		"advanced_logging_json":           len(cfg.NotificationLogSettings.AdvancedLoggingJSON) != 0,
+		"advanced_logging_config":         cfg.NotificationLogSettings.AdvancedLoggingConfig != nil && *cfg.NotificationLogSettings.AdvancedLoggingConfig != "",
+		"enable_delivery_history":         *cfg.NotificationLogSettings.EnableDeliveryHistory,
+		"delivery_history_retention_days": *cfg.NotificationLogSettings.DeliveryHistoryRetentionDays,
 	})
 
 	ts.SendTelemetry(TrackConfigPassword, map[string]any{
@@ -586,49 +600,59 @@ func (ts *TelemetryService) trackConfig() {
 	})
 
 	ts.SendTelemetry(TrackConfigFile, map[string]any{
-		"enable_public_links":           cfg.FileSettings.EnablePublicLink,
-		"driver_name":                   *cfg.FileSettings.DriverName,
-		"isdefault_directory":           isDefault(*cfg.FileSettings.Directory, model.FileSettingsDefaultDirectory),
-		"isabsolute_directory":          filepath.IsAbs(*cfg.FileSettings.Directory),
-		"extract_content":               *cfg.FileSettings.ExtractContent,
-		"archive_recursion":             *cfg.FileSettings.ArchiveRecursion,
-		"amazon_s3_ssl":                 *cfg.FileSettings.AmazonS3SSL,
-		"amazon_s3_sse":                 *cfg.FileSettings.AmazonS3SSE,
-		"amazon_s3_signv2":              *cfg.FileSettings.AmazonS3SignV2,
-		"amazon_s3_trace":               *cfg.FileSettings.AmazonS3Trace,
-		"max_file_size":                 *cfg.FileSettings.MaxFileSize,
-		"max_image_resolution":          *cfg.FileSettings.MaxImageResolution,
-		"max_image_decoder_concurrency": *cfg.FileSettings.MaxImageDecoderConcurrency,
-		"enable_file_attachments":       *cfg.FileSettings.EnableFileAttachments,
-		"enable_mobile_upload":          *cfg.FileSettings.EnableMobileUpload,
-		"enable_mobile_download":        *cfg.FileSettings.EnableMobileDownload,
+		"enable_public_links":              cfg.FileSettings.EnablePublicLink,
+		"driver_name":                      *cfg.FileSettings.DriverName,
+		"isdefault_directory":              isDefault(*cfg.FileSettings.Directory, model.FileSettingsDefaultDirectory),
+		"isabsolute_directory":             filepath.IsAbs(*cfg.FileSettings.Directory),
+		"extract_content":                  *cfg.FileSettings.ExtractContent,
+		"archive_recursion":                *cfg.FileSettings.ArchiveRecursion,
+		"enable_file_deduplication":        *cfg.FileSettings.EnableFileDeduplication,
+		"enable_file_share_links":          *cfg.FileSettings.EnableFileShareLinks,
+		"max_file_share_link_expiry_hours": *cfg.FileSettings.MaxFileShareLinkExpiryHours,
+		"amazon_s3_ssl":                    *cfg.FileSettings.AmazonS3SSL,
+		"amazon_s3_sse":                    *cfg.FileSettings.AmazonS3SSE,
+		"amazon_s3_signv2":                 *cfg.FileSettings.AmazonS3SignV2,
+		"amazon_s3_trace":                  *cfg.FileSettings.AmazonS3Trace,
+		"max_file_size":                    *cfg.FileSettings.MaxFileSize,
+		"max_image_resolution":             *cfg.FileSettings.MaxImageResolution,
+		"max_image_decoder_concurrency":    *cfg.FileSettings.MaxImageDecoderConcurrency,
+		"enable_file_attachments":          *cfg.FileSettings.EnableFileAttachments,
+		"enable_mobile_upload":             *cfg.FileSettings.EnableMobileUpload,
+		"enable_mobile_download":           *cfg.FileSettings.EnableMobileDownload,
 	})
 
 	ts.SendTelemetry(TrackConfigEmail, map[string]any{
-		"enable_sign_up_with_email":            cfg.EmailSettings.EnableSignUpWithEmail,
-		"enable_sign_in_with_email":            *cfg.EmailSettings.EnableSignInWithEmail,
-		"enable_sign_in_with_username":         *cfg.EmailSettings.EnableSignInWithUsername,
-		"require_email_verification":           cfg.EmailSettings.RequireEmailVerification,
-		"send_email_notifications":             cfg.EmailSettings.SendEmailNotifications,
-		"use_channel_in_email_notifications":   *cfg.EmailSettings.UseChannelInEmailNotifications,
-		"email_notification_contents_type":     *cfg.EmailSettings.EmailNotificationContentsType,
-		"enable_smtp_auth":                     *cfg.EmailSettings.EnableSMTPAuth,
-		"connection_security":                  cfg.EmailSettings.ConnectionSecurity,
-		"send_push_notifications":              *cfg.EmailSettings.SendPushNotifications,
-		"push_notification_contents":           *cfg.EmailSettings.PushNotificationContents,
-		"enable_email_batching":                *cfg.EmailSettings.EnableEmailBatching,
-		"email_batching_buffer_size":           *cfg.EmailSettings.EmailBatchingBufferSize,
-		"email_batching_interval":              *cfg.EmailSettings.EmailBatchingInterval,
-		"enable_preview_mode_banner":           *cfg.EmailSettings.EnablePreviewModeBanner,
-		"isdefault_feedback_name":              isDefault(cfg.EmailSettings.FeedbackName, ""),
-		"isdefault_feedback_email":             isDefault(cfg.EmailSettings.FeedbackEmail, ""),
-		"isdefault_reply_to_address":           isDefault(cfg.EmailSettings.ReplyToAddress, ""),
-		"isdefault_feedback_organization":      isDefault(*cfg.EmailSettings.FeedbackOrganization, model.EmailSettingsDefaultFeedbackOrganization),
-		"skip_server_certificate_verification": *cfg.EmailSettings.SkipServerCertificateVerification,
-		"isdefault_login_button_color":         isDefault(*cfg.EmailSettings.LoginButtonColor, ""),
-		"isdefault_login_button_border_color":  isDefault(*cfg.EmailSettings.LoginButtonBorderColor, ""),
-		"isdefault_login_button_text_color":    isDefault(*cfg.EmailSettings.LoginButtonTextColor, ""),
-		"smtp_server_timeout":                  *cfg.EmailSettings.SMTPServerTimeout,
+		"enable_sign_up_with_email":                    cfg.EmailSettings.EnableSignUpWithEmail,
+		"enable_sign_in_with_email":                    *cfg.EmailSettings.EnableSignInWithEmail,
+		"enable_sign_in_with_username":                 *cfg.EmailSettings.EnableSignInWithUsername,
+		"require_email_verification":                   cfg.EmailSettings.RequireEmailVerification,
+		"send_email_notifications":                     cfg.EmailSettings.SendEmailNotifications,
+		"use_channel_in_email_notifications":           *cfg.EmailSettings.UseChannelInEmailNotifications,
+		"email_notification_contents_type":             *cfg.EmailSettings.EmailNotificationContentsType,
+		"enable_smtp_auth":                             *cfg.EmailSettings.EnableSMTPAuth,
+		"connection_security":                          cfg.EmailSettings.ConnectionSecurity,
+		"send_push_notifications":                      *cfg.EmailSettings.SendPushNotifications,
+		"push_notification_contents":                   *cfg.EmailSettings.PushNotificationContents,
+		"enable_web_push_notifications":                *cfg.EmailSettings.EnableWebPushNotifications,
+		"enable_email_batching":                        *cfg.EmailSettings.EnableEmailBatching,
+		"email_batching_buffer_size":                   *cfg.EmailSettings.EmailBatchingBufferSize,
+		"email_batching_interval":                      *cfg.EmailSettings.EmailBatchingInterval,
+		"enable_preview_mode_banner":                   *cfg.EmailSettings.EnablePreviewModeBanner,
+		"isdefault_feedback_name":                      isDefault(cfg.EmailSettings.FeedbackName, ""),
+		"isdefault_feedback_email":                     isDefault(cfg.EmailSettings.FeedbackEmail, ""),
+		"isdefault_reply_to_address":                   isDefault(cfg.EmailSettings.ReplyToAddress, ""),
+		"isdefault_feedback_organization":              isDefault(*cfg.EmailSettings.FeedbackOrganization, model.EmailSettingsDefaultFeedbackOrganization),
+		"skip_server_certificate_verification":         *cfg.EmailSettings.SkipServerCertificateVerification,
+		"isdefault_login_button_color":                 isDefault(*cfg.EmailSettings.LoginButtonColor, ""),
+		"isdefault_login_button_border_color":          isDefault(*cfg.EmailSettings.LoginButtonBorderColor, ""),
+		"isdefault_login_button_text_color":            isDefault(*cfg.EmailSettings.LoginButtonTextColor, ""),
+		"smtp_server_timeout":                          *cfg.EmailSettings.SMTPServerTimeout,
+		"smtp_max_connections":                         *cfg.EmailSettings.SMTPMaxConnections,
+		"smtp_max_messages_per_second":                 *cfg.EmailSettings.SMTPMaxMessagesPerSecond,
+		"smtp_max_retries":                             *cfg.EmailSettings.SMTPMaxRetries,
+		"enable_reply_by_email":                        *cfg.EmailSettings.EnableReplyByEmail,
+		"enable_bounce_handling":                       *cfg.EmailSettings.EnableBounceHandling,
+		"isdefault_email_template_overrides_directory": isDefault(*cfg.EmailSettings.EmailTemplateOverridesDirectory, ""),
 	})
 
 	ts.SendTelemetry(TrackConfigRate, map[string]any{
@@ -672,6 +696,7 @@ func (ts *TelemetryService) trackConfig() {
 		"isdefault_support_email":                      isDefault(*cfg.SupportSettings.SupportEmail, model.SupportSettingsDefaultSupportEmail),
 		"custom_terms_of_service_enabled":              *cfg.SupportSettings.CustomTermsOfServiceEnabled,
 		"custom_terms_of_service_re_acceptance_period": *cfg.SupportSettings.CustomTermsOfServiceReAcceptancePeriod,
+		"custom_terms_of_service_grace_period":         *cfg.SupportSettings.CustomTermsOfServiceGracePeriod,
 		"enable_ask_community_link":                    *cfg.SupportSettings.EnableAskCommunityLink,
 	})
 
@@ -702,6 +727,7 @@ func (ts *TelemetryService) trackConfig() {
 		"isempty_guest_filter":                   isDefault(*cfg.LdapSettings.GuestFilter, ""),
 		"isempty_admin_filter":                   isDefault(*cfg.LdapSettings.AdminFilter, ""),
 		"isnotempty_picture_attribute":           !isDefault(*cfg.LdapSettings.PictureAttribute, ""),
+		"isdefault_manager_attribute":            isDefault(*cfg.LdapSettings.ManagerAttribute, model.LdapSettingsDefaultManagerAttribute),
 		"isnotempty_public_certificate":          !isDefault(*cfg.LdapSettings.PublicCertificateFile, ""),
 		"isnotempty_private_key":                 !isDefault(*cfg.LdapSettings.PrivateKeyFile, ""),
 	})
@@ -763,6 +789,21 @@ func (ts *TelemetryService) trackConfig() {
 		"enable_client_metrics": *cfg.MetricsSettings.EnableClientMetrics,
 	})
 
+	ts.SendTelemetry(TrackConfigTracing, map[string]any{
+		"enable":            *cfg.TracingSettings.Enable,
+		"protocol":          *cfg.TracingSettings.Protocol,
+		"insecure":          *cfg.TracingSettings.Insecure,
+		"sample_percentage": *cfg.TracingSettings.SamplePercentage,
+	})
+
+	ts.SendTelemetry(TrackConfigSlowOperation, map[string]any{
+		"enable":                         *cfg.SlowOperationSettings.Enable,
+		"query_threshold_milliseconds":   *cfg.SlowOperationSettings.QueryThresholdMilliseconds,
+		"request_threshold_milliseconds": *cfg.SlowOperationSettings.RequestThresholdMilliseconds,
+		"max_entries":                    *cfg.SlowOperationSettings.MaxEntries,
+		"interval_minutes":               *cfg.SlowOperationSettings.IntervalMinutes,
+	})
+
 	ts.SendTelemetry(TrackConfigNativeApp, map[string]any{
 		"isdefault_app_custom_url_schemes":    isDefaultArray(cfg.NativeAppSettings.AppCustomURLSchemes, model.GetDefaultAppCustomURLSchemes()),
 		"isdefault_app_download_link":         isDefault(*cfg.NativeAppSettings.AppDownloadLink, model.NativeappSettingsDefaultAppDownloadLink),
@@ -484,6 +484,8 @@ func TestRudderTelemetry(t *testing.T) {
 			TrackConfigPassword,
 			TrackConfigCluster,
 			TrackConfigMetrics,
+			TrackConfigTracing,
+			TrackConfigSlowOperation,
 			TrackConfigSupport,
 			TrackConfigNativeApp,
 			TrackConfigExperimental,
@@ -527,6 +529,8 @@ func TestRudderTelemetry(t *testing.T) {
 			TrackConfigPassword,
 			TrackConfigCluster,
 			TrackConfigMetrics,
+			TrackConfigTracing,
+			TrackConfigSlowOperation,
 			TrackConfigSupport,
 			TrackConfigNativeApp,
 			TrackConfigExperimental,
@@ -0,0 +1,101 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package tracing
+
+import (
+	"context"
+	"time"
+
+	opentracing "github.com/opentracing/opentracing-go"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	otelbridge "go.opentelemetry.io/otel/bridge/opentracing"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+)
+
+const otlpShutdownTimeout = 10 * time.Second
+
+type closerFunc func() error
+
+func (f closerFunc) Close() error {
+	return f()
+}
+
+// NewOTLP instantiates an OpenTelemetry tracer exporting the spans to a collector over OTLP.
+// It is registered as the global OpenTracing tracer through a bridge, so that the spans of the
+// API, app and store layers are exported, and the trace context is propagated with the W3C
+// Trace Context headers. The standard OTEL_EXPORTER_OTLP_* environment variables, e.g. for the
+// headers sent to the collector, are supported.
+func NewOTLP(settings model.TracingSettings) (*Tracer, error) {
+	ctx := context.Background()
+
+	var client otlptrace.Client
+	switch *settings.Protocol {
+	case model.TracingProtocolGRPC:
+		opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(*settings.Endpoint)}
+		if *settings.Insecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		client = otlptracegrpc.NewClient(opts...)
+	default:
+		opts := []otlptracehttp.Option{otlptracehttp.WithEndpoint(*settings.Endpoint)}
+		if *settings.Insecure {
+			opts = append(opts, otlptracehttp.WithInsecure())
+		}
+		client = otlptracehttp.NewClient(opts...)
+	}
+
+	exporter, err := otlptrace.New(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewSchemaless(
+		attribute.String("service.name", *settings.ServiceName),
+		attribute.String("serverStartTime", time.Now().UTC().Format(time.RFC3339)),
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	// Requests may carry a trace context from any client, so a remote parent flagged as sampled
+	// is still subject to the sample percentage rather than forcing every request to be traced.
+	ratio := sdktrace.TraceIDRatioBased(float64(*settings.SamplePercentage) / 100)
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(ratio, sdktrace.WithRemoteParentSampled(ratio))),
+	)
+
+	propagator := propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{})
+	bridgeTracer, wrapperProvider := otelbridge.NewTracerPair(provider.Tracer("mattermost"))
+	bridgeTracer.SetTextMapPropagator(propagator)
+	bridgeTracer.SetWarningHandler(func(msg string) {
+		mlog.Debug("OpenTelemetry bridge warning", mlog.String("warning", msg))
+	})
+
+	otel.SetTracerProvider(wrapperProvider)
+	otel.SetTextMapPropagator(propagator)
+	otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) {
+		mlog.Warn("Failed to export the traces", mlog.Err(err))
+	}))
+	opentracing.SetGlobalTracer(bridgeTracer)
+
+	mlog.Info("OpenTelemetry tracing initialized", mlog.String("endpoint", *settings.Endpoint), mlog.String("protocol", *settings.Protocol))
+	return &Tracer{
+		closer: closerFunc(func() error {
+			ctx, cancel := context.WithTimeout(context.Background(), otlpShutdownTimeout)
+			defer cancel()
+			return provider.Shutdown(ctx)
+		}),
+	}, nil
+}
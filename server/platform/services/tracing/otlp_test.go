@@ -0,0 +1,82 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package tracing
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	opentracing "github.com/opentracing/opentracing-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+func TestOTLPTracePropagation(t *testing.T) {
+	globalTracer := opentracing.GlobalTracer()
+	defer opentracing.SetGlobalTracer(globalTracer)
+
+	settings := model.TracingSettings{}
+	settings.SetDefaults()
+	settings.Enable = model.NewBool(true)
+	settings.Insecure = model.NewBool(true)
+
+	tracer, err := NewOTLP(settings)
+	require.NoError(t, err)
+	defer tracer.Close()
+
+	const traceID = "4bf92f3577b34da6a3ce929d0e0e4736"
+
+	t.Run("the trace of the incoming request is continued", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/api/v4/users/me", nil)
+		r.Header.Set("traceparent", "00-"+traceID+"-00f067aa0ba902b7-01")
+
+		span, ctx := StartSpanFromRequest(r, "web:ServeHTTP")
+		defer span.Finish()
+
+		traceContext := TraceContext(ctx)
+		require.Contains(t, traceContext, "traceparent")
+		assert.True(t, strings.HasPrefix(traceContext["traceparent"], "00-"+traceID+"-"))
+		assert.NotContains(t, traceContext["traceparent"], "00f067aa0ba902b7")
+	})
+
+	t.Run("a new trace is started without trace context", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/api/v4/users/me", nil)
+
+		span, ctx := StartSpanFromRequest(r, "web:ServeHTTP")
+		defer span.Finish()
+
+		traceContext := TraceContext(ctx)
+		require.Contains(t, traceContext, "traceparent")
+		assert.False(t, strings.HasPrefix(traceContext["traceparent"], "00-"+traceID+"-"))
+	})
+}
+
+func TestOTLPSamplesRemoteParents(t *testing.T) {
+	globalTracer := opentracing.GlobalTracer()
+	defer opentracing.SetGlobalTracer(globalTracer)
+
+	settings := model.TracingSettings{}
+	settings.SetDefaults()
+	settings.Enable = model.NewBool(true)
+	settings.Insecure = model.NewBool(true)
+	settings.SamplePercentage = model.NewInt(0)
+
+	tracer, err := NewOTLP(settings)
+	require.NoError(t, err)
+	defer tracer.Close()
+
+	r := httptest.NewRequest(http.MethodGet, "/api/v4/users/me", nil)
+	r.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+
+	span, ctx := StartSpanFromRequest(r, "web:ServeHTTP")
+	defer span.Finish()
+
+	traceContext := TraceContext(ctx)
+	require.Contains(t, traceContext, "traceparent")
+	assert.True(t, strings.HasSuffix(traceContext["traceparent"], "-00"), "a sampled remote parent shouldn't bypass the sample percentage")
+}
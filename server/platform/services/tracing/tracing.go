@@ -6,14 +6,17 @@ package tracing
 import (
 	"context"
 	"io"
+	"net/http"
 	"time"
 
 	opentracing "github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/ext"
 	"github.com/uber/jaeger-client-go"
 	jaegercfg "github.com/uber/jaeger-client-go/config"
 	"github.com/uber/jaeger-client-go/zipkin"
 	"github.com/uber/jaeger-lib/metrics"
 
+	"github.com/mattermost/mattermost/server/public/model"
 	"github.com/mattermost/mattermost/server/public/shared/mlog"
 )
 
@@ -85,3 +88,35 @@ func StartSpanWithParentByContext(ctx context.Context, operationName string) (op
 
 	return opentracing.StartSpanFromContext(ctx, operationName, opentracing.ChildOf(parentSpan.Context()))
 }
+
+// IsEnabled returns whether the requests are traced, either with Jaeger or with OpenTelemetry.
+func IsEnabled(cfg *model.Config) bool {
+	return *cfg.ServiceSettings.EnableOpenTracing || *cfg.TracingSettings.Enable
+}
+
+// StartSpanFromRequest starts a span continuing the trace of the incoming request, or a root span
+// if the request carries no trace context.
+func StartSpanFromRequest(r *http.Request, operationName string) (opentracing.Span, context.Context) {
+	spanCtx, err := opentracing.GlobalTracer().Extract(opentracing.HTTPHeaders, opentracing.HTTPHeadersCarrier(r.Header))
+	if err != nil {
+		return StartRootSpanByContext(context.Background(), operationName)
+	}
+
+	span := opentracing.StartSpan(operationName, ext.RPCServerOption(spanCtx))
+	return span, opentracing.ContextWithSpan(context.Background(), span)
+}
+
+// TraceContext returns the trace context of the span of ctx as text map entries, e.g. the W3C
+// traceparent header, or nil if ctx has no span.
+func TraceContext(ctx context.Context) map[string]string {
+	span := opentracing.SpanFromContext(ctx)
+	if span == nil {
+		return nil
+	}
+
+	carrier := opentracing.TextMapCarrier{}
+	if err := opentracing.GlobalTracer().Inject(span.Context(), opentracing.TextMap, carrier); err != nil || len(carrier) == 0 {
+		return nil
+	}
+	return carrier
+}
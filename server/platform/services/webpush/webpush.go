@@ -0,0 +1,228 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+// Package webpush sends notifications to the push services of browsers, encrypting them
+// as described by RFC 8291 and authenticating the server with VAPID as described by RFC 8292.
+package webpush
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/hkdf"
+)
+
+const (
+	// recordSize is the size of the single record the payload is encrypted into.
+	recordSize = 4096
+	// headerSize is the size of the salt, record size and key id that precede the record.
+	headerSize = 16 + 4 + 1 + 65
+	// MaxPayloadSize is the largest payload whose encrypted message fits in the 4096 bytes
+	// push services are required to accept, once the padding delimiter and the tag are added.
+	MaxPayloadSize = recordSize - headerSize - 1 - 16
+
+	vapidExpiration = 12 * time.Hour
+)
+
+// ErrSubscriptionExpired is returned when the push service reports that the subscription
+// is no longer valid, in which case it shouldn't be used again.
+var ErrSubscriptionExpired = errors.New("the push subscription has expired or was unsubscribed")
+
+// Subscription holds the endpoint and keys of the push subscription of a browser.
+type Subscription struct {
+	Endpoint string
+	// P256dh is the public key of the browser, base64url encoded.
+	P256dh string
+	// Auth is the authentication secret of the browser, base64url encoded.
+	Auth string
+}
+
+// Options holds the parameters of the messages sent to push services.
+type Options struct {
+	// Subject is the contact of the application server, either a mailto: or https: URL.
+	Subject string
+	// TTL is how long the push service should keep the message if the browser is offline.
+	TTL time.Duration
+	// Urgency hints how soon the message needs to be delivered: very-low, low, normal or high.
+	Urgency string
+	// Topic allows a pending message to be replaced by a newer one with the same topic.
+	Topic string
+}
+
+// PublicKey returns the uncompressed public key of the VAPID key, base64url encoded, as
+// expected by the applicationServerKey option of PushManager.subscribe.
+func PublicKey(key *ecdsa.PrivateKey) (string, error) {
+	publicKey, err := key.PublicKey.ECDH()
+	if err != nil {
+		return "", errors.Wrap(err, "invalid VAPID key")
+	}
+	return base64.RawURLEncoding.EncodeToString(publicKey.Bytes()), nil
+}
+
+// Send encrypts the payload for the subscription and posts it to its push service.
+func Send(ctx context.Context, client *http.Client, subscription *Subscription, payload []byte, key *ecdsa.PrivateKey, options Options) error {
+	body, err := Encrypt(subscription, payload)
+	if err != nil {
+		return err
+	}
+
+	authorization, err := vapidAuthorization(subscription.Endpoint, options.Subject, key, time.Now().Add(vapidExpiration))
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, subscription.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, "failed to create the request")
+	}
+	req.Header.Set("Authorization", authorization)
+	req.Header.Set("Content-Encoding", "aes128gcm")
+	req.Header.Set("Content-Type", "application/octet-stream")
+	req.Header.Set("TTL", strconv.Itoa(int(options.TTL.Seconds())))
+	if options.Urgency != "" {
+		req.Header.Set("Urgency", options.Urgency)
+	}
+	if options.Topic != "" {
+		req.Header.Set("Topic", options.Topic)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "failed to send the request")
+	}
+	defer resp.Body.Close()
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusGone:
+		return ErrSubscriptionExpired
+	case resp.StatusCode < 200 || resp.StatusCode > 299:
+		return fmt.Errorf("push service responded with status %d: %s", resp.StatusCode, respBody)
+	}
+	return nil
+}
+
+// Encrypt encrypts the payload for the subscription using the aes128gcm content encoding.
+func Encrypt(subscription *Subscription, payload []byte) ([]byte, error) {
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, errors.Wrap(err, "failed to generate the salt")
+	}
+	serverKey, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate the server key")
+	}
+	return encrypt(subscription, payload, salt, serverKey)
+}
+
+func encrypt(subscription *Subscription, payload, salt []byte, serverKey *ecdh.PrivateKey) ([]byte, error) {
+	if len(payload) > MaxPayloadSize {
+		return nil, fmt.Errorf("payload of %d bytes exceeds the maximum of %d bytes", len(payload), MaxPayloadSize)
+	}
+
+	clientPublicKeyBytes, err := decodeKey(subscription.P256dh)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid p256dh key")
+	}
+	clientPublicKey, err := ecdh.P256().NewPublicKey(clientPublicKeyBytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid p256dh key")
+	}
+	authSecret, err := decodeKey(subscription.Auth)
+	if err != nil || len(authSecret) != 16 {
+		return nil, errors.New("invalid auth secret")
+	}
+
+	sharedSecret, err := serverKey.ECDH(clientPublicKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to compute the shared secret")
+	}
+
+	serverPublicKeyBytes := serverKey.PublicKey().Bytes()
+
+	// The input keying material combines the shared secret with the auth secret of the browser.
+	keyInfo := append([]byte("WebPush: info\x00"), clientPublicKeyBytes...)
+	keyInfo = append(keyInfo, serverPublicKeyBytes...)
+	ikm := make([]byte, 32)
+	if _, err = io.ReadFull(hkdf.New(sha256.New, sharedSecret, authSecret, keyInfo), ikm); err != nil {
+		return nil, errors.Wrap(err, "failed to derive the keying material")
+	}
+
+	prk := hkdf.Extract(sha256.New, ikm, salt)
+	contentKey := make([]byte, 16)
+	if _, err = io.ReadFull(hkdf.Expand(sha256.New, prk, []byte("Content-Encoding: aes128gcm\x00")), contentKey); err != nil {
+		return nil, errors.Wrap(err, "failed to derive the content encryption key")
+	}
+	nonce := make([]byte, 12)
+	if _, err = io.ReadFull(hkdf.Expand(sha256.New, prk, []byte("Content-Encoding: nonce\x00")), nonce); err != nil {
+		return nil, errors.Wrap(err, "failed to derive the nonce")
+	}
+
+	block, err := aes.NewCipher(contentKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create the cipher")
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create the cipher")
+	}
+
+	// The payload is sent as a single record, terminated by the last record delimiter.
+	plaintext := append(append([]byte{}, payload...), 0x02)
+
+	body := make([]byte, 0, headerSize+len(plaintext)+gcm.Overhead())
+	body = append(body, salt...)
+	body = binary.BigEndian.AppendUint32(body, recordSize)
+	body = append(body, byte(len(serverPublicKeyBytes)))
+	body = append(body, serverPublicKeyBytes...)
+	return gcm.Seal(body, nonce, plaintext, nil), nil
+}
+
+// vapidAuthorization returns the Authorization header identifying the application server
+// to the push service of the endpoint.
+func vapidAuthorization(endpoint, subject string, key *ecdsa.PrivateKey, expiration time.Time) (string, error) {
+	endpointURL, err := url.Parse(endpoint)
+	if err != nil {
+		return "", errors.Wrap(err, "invalid endpoint")
+	}
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.MapClaims{
+		"aud": endpointURL.Scheme + "://" + endpointURL.Host,
+		"exp": expiration.Unix(),
+		"sub": subject,
+	}).SignedString(key)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to sign the VAPID token")
+	}
+
+	publicKey, err := PublicKey(key)
+	if err != nil {
+		return "", err
+	}
+
+	return "vapid t=" + token + ", k=" + publicKey, nil
+}
+
+func decodeKey(key string) ([]byte, error) {
+	if decoded, err := base64.RawURLEncoding.DecodeString(key); err == nil {
+		return decoded, nil
+	}
+	// Some browsers pad the keys.
+	return base64.URLEncoding.DecodeString(key)
+}
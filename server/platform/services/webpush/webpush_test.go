@@ -0,0 +1,185 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package webpush
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/hkdf"
+)
+
+type testBrowser struct {
+	key        *ecdh.PrivateKey
+	authSecret []byte
+}
+
+func newTestBrowser(t *testing.T) *testBrowser {
+	key, err := ecdh.P256().GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	authSecret := make([]byte, 16)
+	_, err = rand.Read(authSecret)
+	require.NoError(t, err)
+	return &testBrowser{key: key, authSecret: authSecret}
+}
+
+func (b *testBrowser) subscription(endpoint string) *Subscription {
+	return &Subscription{
+		Endpoint: endpoint,
+		P256dh:   base64.RawURLEncoding.EncodeToString(b.key.PublicKey().Bytes()),
+		Auth:     base64.RawURLEncoding.EncodeToString(b.authSecret),
+	}
+}
+
+// decrypt decrypts a message the way browsers do.
+func (b *testBrowser) decrypt(t *testing.T, body []byte) []byte {
+	require.Greater(t, len(body), headerSize)
+	salt := body[:16]
+	assert.Equal(t, uint32(recordSize), binary.BigEndian.Uint32(body[16:20]))
+	keyIDLen := int(body[20])
+	serverPublicKey, err := ecdh.P256().NewPublicKey(body[21 : 21+keyIDLen])
+	require.NoError(t, err)
+	record := body[21+keyIDLen:]
+
+	sharedSecret, err := b.key.ECDH(serverPublicKey)
+	require.NoError(t, err)
+
+	keyInfo := append([]byte("WebPush: info\x00"), b.key.PublicKey().Bytes()...)
+	keyInfo = append(keyInfo, serverPublicKey.Bytes()...)
+	ikm := make([]byte, 32)
+	_, err = io.ReadFull(hkdf.New(sha256.New, sharedSecret, b.authSecret, keyInfo), ikm)
+	require.NoError(t, err)
+
+	prk := hkdf.Extract(sha256.New, ikm, salt)
+	contentKey := make([]byte, 16)
+	_, err = io.ReadFull(hkdf.Expand(sha256.New, prk, []byte("Content-Encoding: aes128gcm\x00")), contentKey)
+	require.NoError(t, err)
+	nonce := make([]byte, 12)
+	_, err = io.ReadFull(hkdf.Expand(sha256.New, prk, []byte("Content-Encoding: nonce\x00")), nonce)
+	require.NoError(t, err)
+
+	block, err := aes.NewCipher(contentKey)
+	require.NoError(t, err)
+	gcm, err := cipher.NewGCM(block)
+	require.NoError(t, err)
+	plaintext, err := gcm.Open(nil, nonce, record, nil)
+	require.NoError(t, err)
+
+	require.Equal(t, byte(0x02), plaintext[len(plaintext)-1], "missing last record delimiter")
+	return plaintext[:len(plaintext)-1]
+}
+
+func TestEncrypt(t *testing.T) {
+	browser := newTestBrowser(t)
+	subscription := browser.subscription("https://push.example.com/send/abc")
+
+	t.Run("round trip", func(t *testing.T) {
+		payload := []byte(`{"title":"town-square","body":"hello"}`)
+		body, err := Encrypt(subscription, payload)
+		require.NoError(t, err)
+		assert.Equal(t, payload, browser.decrypt(t, body))
+
+		other, err := Encrypt(subscription, payload)
+		require.NoError(t, err)
+		assert.NotEqual(t, body, other, "each message should use a new salt and server key")
+	})
+
+	t.Run("largest payload", func(t *testing.T) {
+		payload := []byte(strings.Repeat("a", MaxPayloadSize))
+		body, err := Encrypt(subscription, payload)
+		require.NoError(t, err)
+		assert.Len(t, body, recordSize)
+		assert.Equal(t, payload, browser.decrypt(t, body))
+
+		_, err = Encrypt(subscription, append(payload, 'a'))
+		require.Error(t, err)
+	})
+
+	t.Run("padded keys", func(t *testing.T) {
+		padded := &Subscription{
+			Endpoint: subscription.Endpoint,
+			P256dh:   base64.URLEncoding.EncodeToString(browser.key.PublicKey().Bytes()),
+			Auth:     base64.URLEncoding.EncodeToString(browser.authSecret),
+		}
+		body, err := Encrypt(padded, []byte("hi"))
+		require.NoError(t, err)
+		assert.Equal(t, []byte("hi"), browser.decrypt(t, body))
+	})
+
+	t.Run("invalid keys", func(t *testing.T) {
+		_, err := Encrypt(&Subscription{P256dh: "invalid", Auth: subscription.Auth}, []byte("hi"))
+		require.Error(t, err)
+
+		_, err = Encrypt(&Subscription{P256dh: subscription.P256dh, Auth: base64.RawURLEncoding.EncodeToString([]byte("short"))}, []byte("hi"))
+		require.Error(t, err)
+	})
+}
+
+func TestSend(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	publicKey, err := PublicKey(key)
+	require.NoError(t, err)
+	browser := newTestBrowser(t)
+
+	status := http.StatusCreated
+	var received []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "aes128gcm", r.Header.Get("Content-Encoding"))
+		assert.Equal(t, "60", r.Header.Get("TTL"))
+		assert.Equal(t, "high", r.Header.Get("Urgency"))
+
+		authorization := r.Header.Get("Authorization")
+		require.True(t, strings.HasPrefix(authorization, "vapid t="))
+		parts := strings.Split(strings.TrimPrefix(authorization, "vapid t="), ", k=")
+		require.Len(t, parts, 2)
+		assert.Equal(t, publicKey, parts[1])
+
+		claims := jwt.MapClaims{}
+		_, err := jwt.ParseWithClaims(parts[0], claims, func(token *jwt.Token) (any, error) {
+			return &key.PublicKey, nil
+		}, jwt.WithValidMethods([]string{"ES256"}))
+		require.NoError(t, err)
+		assert.Equal(t, "http://"+r.Host, claims["aud"])
+		assert.Equal(t, "mailto:admin@example.com", claims["sub"])
+
+		received, err = io.ReadAll(r.Body)
+		require.NoError(t, err)
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	options := Options{Subject: "mailto:admin@example.com", TTL: time.Minute, Urgency: "high"}
+	subscription := browser.subscription(server.URL + "/push/abc")
+
+	err = Send(context.Background(), server.Client(), subscription, []byte("hello"), key, options)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("hello"), browser.decrypt(t, received))
+
+	status = http.StatusGone
+	err = Send(context.Background(), server.Client(), subscription, []byte("hello"), key, options)
+	require.ErrorIs(t, err, ErrSubscriptionExpired)
+
+	status = http.StatusBadRequest
+	err = Send(context.Background(), server.Client(), subscription, []byte("hello"), key, options)
+	require.Error(t, err)
+	require.NotErrorIs(t, err, ErrSubscriptionExpired)
+}
@@ -0,0 +1,210 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package mail
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+// EmailEvents are the bounces and the complaints reported by an email provider.
+type EmailEvents struct {
+	Undeliverable []*model.UndeliverableEmail
+	// SubscribeURL is the URL to visit to confirm the subscription of an Amazon SNS topic, when
+	// the events are a subscription confirmation rather than a notification.
+	SubscribeURL string
+}
+
+type snsMessage struct {
+	Type         string `json:"Type"`
+	Message      string `json:"Message"`
+	SubscribeURL string `json:"SubscribeURL"`
+}
+
+type sesRecipient struct {
+	EmailAddress   string `json:"emailAddress"`
+	DiagnosticCode string `json:"diagnosticCode"`
+}
+
+type sesNotification struct {
+	NotificationType string `json:"notificationType"`
+	EventType        string `json:"eventType"`
+	Bounce           *struct {
+		BounceType        string          `json:"bounceType"`
+		BounceSubType     string          `json:"bounceSubType"`
+		BouncedRecipients []*sesRecipient `json:"bouncedRecipients"`
+	} `json:"bounce"`
+	Complaint *struct {
+		ComplaintFeedbackType string          `json:"complaintFeedbackType"`
+		ComplainedRecipients  []*sesRecipient `json:"complainedRecipients"`
+	} `json:"complaint"`
+}
+
+type sendGridEvent struct {
+	Email  string `json:"email"`
+	Event  string `json:"event"`
+	Type   string `json:"type"`
+	Reason string `json:"reason"`
+}
+
+type mailgunEvent struct {
+	EventData *struct {
+		Event          string `json:"event"`
+		Severity       string `json:"severity"`
+		Recipient      string `json:"recipient"`
+		DeliveryStatus struct {
+			Description string `json:"description"`
+			Message     string `json:"message"`
+		} `json:"delivery-status"`
+	} `json:"event-data"`
+}
+
+// ParseEmailEvents parses the bounce and complaint callbacks of Amazon SES (directly or through
+// Amazon SNS), SendGrid and Mailgun. The events that don't make an address undeliverable, such as
+// the transient bounces, are ignored.
+func ParseEmailEvents(data []byte) (*EmailEvents, error) {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 {
+		return nil, errors.New("empty email events")
+	}
+
+	// SendGrid posts an array of events.
+	if data[0] == '[' {
+		var events []*sendGridEvent
+		if err := json.Unmarshal(data, &events); err != nil {
+			return nil, errors.Wrap(err, "unable to parse the SendGrid events")
+		}
+		return parseSendGridEvents(events), nil
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, errors.Wrap(err, "unable to parse the email events")
+	}
+
+	switch {
+	case fields["Type"] != nil:
+		var msg snsMessage
+		if err := json.Unmarshal(data, &msg); err != nil {
+			return nil, errors.Wrap(err, "unable to parse the SNS message")
+		}
+		switch msg.Type {
+		case "SubscriptionConfirmation":
+			return &EmailEvents{SubscribeURL: msg.SubscribeURL}, nil
+		case "Notification":
+			return parseSESNotification([]byte(msg.Message))
+		default:
+			return &EmailEvents{}, nil
+		}
+	case fields["notificationType"] != nil || fields["eventType"] != nil:
+		return parseSESNotification(data)
+	case fields["event-data"] != nil:
+		var event mailgunEvent
+		if err := json.Unmarshal(data, &event); err != nil {
+			return nil, errors.Wrap(err, "unable to parse the Mailgun event")
+		}
+		return parseMailgunEvent(&event), nil
+	}
+
+	return nil, errors.New("unknown email events format")
+}
+
+func parseSESNotification(data []byte) (*EmailEvents, error) {
+	var notification sesNotification
+	if err := json.Unmarshal(data, &notification); err != nil {
+		return nil, errors.Wrap(err, "unable to parse the SES notification")
+	}
+
+	events := &EmailEvents{}
+	notificationType := notification.NotificationType
+	if notificationType == "" {
+		notificationType = notification.EventType
+	}
+
+	switch notificationType {
+	case "Bounce":
+		// Transient bounces, such as a full mailbox, may be delivered later.
+		if notification.Bounce == nil || notification.Bounce.BounceType != "Permanent" {
+			return events, nil
+		}
+		for _, recipient := range notification.Bounce.BouncedRecipients {
+			detail := recipient.DiagnosticCode
+			if detail == "" {
+				detail = notification.Bounce.BounceSubType
+			}
+			events.add(recipient.EmailAddress, model.UndeliverableEmailReasonBounce, detail)
+		}
+	case "Complaint":
+		if notification.Complaint == nil {
+			return events, nil
+		}
+		for _, recipient := range notification.Complaint.ComplainedRecipients {
+			events.add(recipient.EmailAddress, model.UndeliverableEmailReasonComplaint, notification.Complaint.ComplaintFeedbackType)
+		}
+	}
+
+	return events, nil
+}
+
+func parseSendGridEvents(sendGridEvents []*sendGridEvent) *EmailEvents {
+	events := &EmailEvents{}
+	for _, event := range sendGridEvents {
+		if event == nil {
+			continue
+		}
+		switch event.Event {
+		case "bounce":
+			// Blocked emails are temporarily rejected, for instance because of the reputation
+			// of the sending server.
+			if event.Type != "blocked" {
+				events.add(event.Email, model.UndeliverableEmailReasonBounce, event.Reason)
+			}
+		case "dropped":
+			if strings.Contains(strings.ToLower(event.Reason), "bounced address") {
+				events.add(event.Email, model.UndeliverableEmailReasonBounce, event.Reason)
+			}
+		case "spamreport":
+			events.add(event.Email, model.UndeliverableEmailReasonComplaint, "")
+		}
+	}
+	return events
+}
+
+func parseMailgunEvent(event *mailgunEvent) *EmailEvents {
+	events := &EmailEvents{}
+	if event.EventData == nil {
+		return events
+	}
+
+	data := event.EventData
+	switch data.Event {
+	case "failed":
+		if data.Severity == "permanent" {
+			detail := data.DeliveryStatus.Description
+			if detail == "" {
+				detail = data.DeliveryStatus.Message
+			}
+			events.add(data.Recipient, model.UndeliverableEmailReasonBounce, detail)
+		}
+	case "complained":
+		events.add(data.Recipient, model.UndeliverableEmailReasonComplaint, "")
+	}
+	return events
+}
+
+func (e *EmailEvents) add(email, reason, detail string) {
+	if email == "" {
+		return
+	}
+	e.Undeliverable = append(e.Undeliverable, &model.UndeliverableEmail{
+		Email:  email,
+		Reason: reason,
+		Detail: detail,
+	})
+}
@@ -0,0 +1,110 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package mail
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+func TestParseEmailEvents(t *testing.T) {
+	sesBounce := `{
+		"notificationType": "Bounce",
+		"bounce": {
+			"bounceType": "Permanent",
+			"bounceSubType": "General",
+			"bouncedRecipients": [
+				{"emailAddress": "bounced@example.com", "diagnosticCode": "smtp; 550 5.1.1 user unknown"},
+				{"emailAddress": "other@example.com"}
+			]
+		}
+	}`
+	snsBounce, err := json.Marshal(map[string]string{"Type": "Notification", "Message": sesBounce})
+	require.NoError(t, err)
+
+	for name, tc := range map[string]struct {
+		data         string
+		expected     []*model.UndeliverableEmail
+		subscribeURL string
+	}{
+		"SES bounce": {
+			data: sesBounce,
+			expected: []*model.UndeliverableEmail{
+				{Email: "bounced@example.com", Reason: model.UndeliverableEmailReasonBounce, Detail: "smtp; 550 5.1.1 user unknown"},
+				{Email: "other@example.com", Reason: model.UndeliverableEmailReasonBounce, Detail: "General"},
+			},
+		},
+		"SES bounce through SNS": {
+			data: string(snsBounce),
+			expected: []*model.UndeliverableEmail{
+				{Email: "bounced@example.com", Reason: model.UndeliverableEmailReasonBounce, Detail: "smtp; 550 5.1.1 user unknown"},
+				{Email: "other@example.com", Reason: model.UndeliverableEmailReasonBounce, Detail: "General"},
+			},
+		},
+		"SES transient bounce": {
+			data: `{"notificationType": "Bounce", "bounce": {"bounceType": "Transient", "bouncedRecipients": [{"emailAddress": "full@example.com"}]}}`,
+		},
+		"SES complaint event": {
+			data: `{"eventType": "Complaint", "complaint": {"complaintFeedbackType": "abuse", "complainedRecipients": [{"emailAddress": "user@example.com"}]}}`,
+			expected: []*model.UndeliverableEmail{
+				{Email: "user@example.com", Reason: model.UndeliverableEmailReasonComplaint, Detail: "abuse"},
+			},
+		},
+		"SES delivery": {
+			data: `{"notificationType": "Delivery"}`,
+		},
+		"SNS subscription confirmation": {
+			data:         `{"Type": "SubscriptionConfirmation", "SubscribeURL": "https://sns.us-east-1.amazonaws.com/?Action=ConfirmSubscription"}`,
+			subscribeURL: "https://sns.us-east-1.amazonaws.com/?Action=ConfirmSubscription",
+		},
+		"SendGrid events": {
+			data: `[
+				{"email": "bounced@example.com", "event": "bounce", "type": "bounce", "reason": "550 5.1.1 user unknown"},
+				{"email": "blocked@example.com", "event": "bounce", "type": "blocked"},
+				{"email": "dropped@example.com", "event": "dropped", "reason": "Bounced Address"},
+				{"email": "spam@example.com", "event": "spamreport"},
+				{"email": "delivered@example.com", "event": "delivered"}
+			]`,
+			expected: []*model.UndeliverableEmail{
+				{Email: "bounced@example.com", Reason: model.UndeliverableEmailReasonBounce, Detail: "550 5.1.1 user unknown"},
+				{Email: "dropped@example.com", Reason: model.UndeliverableEmailReasonBounce, Detail: "Bounced Address"},
+				{Email: "spam@example.com", Reason: model.UndeliverableEmailReasonComplaint},
+			},
+		},
+		"Mailgun permanent failure": {
+			data: `{"signature": {}, "event-data": {"event": "failed", "severity": "permanent", "recipient": "bounced@example.com", "delivery-status": {"description": "No such user"}}}`,
+			expected: []*model.UndeliverableEmail{
+				{Email: "bounced@example.com", Reason: model.UndeliverableEmailReasonBounce, Detail: "No such user"},
+			},
+		},
+		"Mailgun temporary failure": {
+			data: `{"event-data": {"event": "failed", "severity": "temporary", "recipient": "user@example.com"}}`,
+		},
+		"Mailgun complaint": {
+			data: `{"event-data": {"event": "complained", "recipient": "user@example.com"}}`,
+			expected: []*model.UndeliverableEmail{
+				{Email: "user@example.com", Reason: model.UndeliverableEmailReasonComplaint},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			events, err := ParseEmailEvents([]byte(tc.data))
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, events.Undeliverable)
+			assert.Equal(t, tc.subscribeURL, events.SubscribeURL)
+		})
+	}
+
+	t.Run("invalid events", func(t *testing.T) {
+		for _, data := range []string{"", "not json", `{"unknown": true}`, `[{"email": 1}]`} {
+			_, err := ParseEmailEvents([]byte(data))
+			assert.Error(t, err, data)
+		}
+	})
+}
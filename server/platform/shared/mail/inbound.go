@@ -0,0 +1,145 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package mail
+
+import (
+	"encoding/base64"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/textproto"
+	"strings"
+
+	"github.com/jaytaylor/html2text"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+// maxInboundMIMEDepth limits the nesting of the multipart bodies of the inbound emails.
+const maxInboundMIMEDepth = 10
+
+var wordDecoder = &mime.WordDecoder{}
+
+// ParseInboundEmail parses a raw RFC 5322 message, such as one forwarded by an inbound email
+// provider, keeping its text body and its attachments.
+func ParseInboundEmail(r io.Reader) (*model.InboundEmail, error) {
+	msg, err := mail.ReadMessage(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to read the email message")
+	}
+
+	email := &model.InboundEmail{
+		From: decodeHeader(msg.Header.Get("From")),
+		To:   []string{},
+	}
+	for _, header := range []string{"To", "Cc", "Delivered-To", "X-Original-To"} {
+		for _, value := range msg.Header[header] {
+			email.To = append(email.To, decodeHeader(value))
+		}
+	}
+	email.Subject = decodeHeader(msg.Header.Get("Subject"))
+
+	var htmlBody string
+	if err := parseInboundPart(email, &htmlBody, textproto.MIMEHeader(msg.Header), msg.Body, 0); err != nil {
+		return nil, err
+	}
+
+	if email.Text == "" && htmlBody != "" {
+		text, err := html2text.FromString(htmlBody)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to convert the html body to text")
+		}
+		email.Text = text
+	}
+
+	return email, nil
+}
+
+func parseInboundPart(email *model.InboundEmail, htmlBody *string, header textproto.MIMEHeader, body io.Reader, depth int) error {
+	if depth > maxInboundMIMEDepth {
+		return errors.New("the email message is nested too deeply")
+	}
+
+	mediaType, params, err := mime.ParseMediaType(header.Get("Content-Type"))
+	if err != nil {
+		mediaType = "text/plain"
+	}
+
+	if strings.HasPrefix(mediaType, "multipart/") {
+		reader := multipart.NewReader(body, params["boundary"])
+		for {
+			part, err := reader.NextRawPart()
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				return errors.Wrap(err, "unable to read the email message part")
+			}
+			if err := parseInboundPart(email, htmlBody, part.Header, part, depth+1); err != nil {
+				return err
+			}
+		}
+	}
+
+	data, err := io.ReadAll(decodeTransferEncoding(header.Get("Content-Transfer-Encoding"), body))
+	if err != nil {
+		return errors.Wrap(err, "unable to decode the email message part")
+	}
+
+	disposition, dispositionParams, _ := mime.ParseMediaType(header.Get("Content-Disposition"))
+	filename := dispositionParams["filename"]
+	if filename == "" {
+		filename = params["name"]
+	}
+
+	if disposition == "attachment" || (filename != "" && !strings.HasPrefix(mediaType, "text/")) {
+		if len(email.Attachments) >= model.InboundEmailMaxAttachments {
+			return nil
+		}
+		if filename == "" {
+			filename = "attachment"
+		}
+		email.Attachments = append(email.Attachments, &model.InboundEmailAttachment{
+			Name:        decodeHeader(filename),
+			ContentType: mediaType,
+			Data:        data,
+		})
+		return nil
+	}
+
+	switch mediaType {
+	case "text/plain":
+		if email.Text == "" {
+			email.Text = string(data)
+		}
+	case "text/html":
+		if *htmlBody == "" {
+			*htmlBody = string(data)
+		}
+	}
+
+	return nil
+}
+
+func decodeTransferEncoding(encoding string, body io.Reader) io.Reader {
+	switch strings.ToLower(strings.TrimSpace(encoding)) {
+	case "base64":
+		return base64.NewDecoder(base64.StdEncoding, body)
+	case "quoted-printable":
+		return quotedprintable.NewReader(body)
+	default:
+		return body
+	}
+}
+
+func decodeHeader(value string) string {
+	decoded, err := wordDecoder.DecodeHeader(value)
+	if err != nil {
+		return value
+	}
+	return decoded
+}
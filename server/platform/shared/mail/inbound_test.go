@@ -0,0 +1,74 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package mail
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseInboundEmail(t *testing.T) {
+	t.Run("multipart message with an attachment", func(t *testing.T) {
+		raw := strings.Join([]string{
+			"From: Some User <user@example.com>",
+			"To: Mattermost <reply+abc123@example.com>",
+			"Cc: other@example.com",
+			"Subject: =?UTF-8?Q?Re:_caf=C3=A9?=",
+			"MIME-Version: 1.0",
+			`Content-Type: multipart/mixed; boundary="outer"`,
+			"",
+			"--outer",
+			`Content-Type: multipart/alternative; boundary="inner"`,
+			"",
+			"--inner",
+			"Content-Type: text/plain; charset=UTF-8",
+			"Content-Transfer-Encoding: quoted-printable",
+			"",
+			"Caf=C3=A9 sounds good.",
+			"--inner",
+			"Content-Type: text/html; charset=UTF-8",
+			"",
+			"<p>Café sounds good.</p>",
+			"--inner--",
+			"--outer",
+			`Content-Type: image/png; name="image.png"`,
+			`Content-Disposition: attachment; filename="image.png"`,
+			"Content-Transfer-Encoding: base64",
+			"",
+			"aW1hZ2Ug",
+			"ZGF0YQ==",
+			"--outer--",
+			"",
+		}, "\r\n")
+
+		email, err := ParseInboundEmail(strings.NewReader(raw))
+		require.NoError(t, err)
+
+		assert.Equal(t, "Some User <user@example.com>", email.From)
+		assert.Equal(t, []string{"Mattermost <reply+abc123@example.com>", "other@example.com"}, email.To)
+		assert.Equal(t, "Re: café", email.Subject)
+		assert.Equal(t, "Café sounds good.", email.Text)
+		require.Len(t, email.Attachments, 1)
+		assert.Equal(t, "image.png", email.Attachments[0].Name)
+		assert.Equal(t, "image/png", email.Attachments[0].ContentType)
+		assert.Equal(t, []byte("image data"), email.Attachments[0].Data)
+	})
+
+	t.Run("html only message", func(t *testing.T) {
+		raw := "From: user@example.com\r\nTo: reply+abc123@example.com\r\nContent-Type: text/html\r\n\r\n<p>Sounds <b>good</b></p>\r\n"
+
+		email, err := ParseInboundEmail(strings.NewReader(raw))
+		require.NoError(t, err)
+		assert.Equal(t, "Sounds *good*", email.Text)
+		assert.Empty(t, email.Attachments)
+	})
+
+	t.Run("invalid message", func(t *testing.T) {
+		_, err := ParseInboundEmail(strings.NewReader("not an email"))
+		require.Error(t, err)
+	})
+}
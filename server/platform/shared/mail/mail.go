@@ -42,6 +42,12 @@ type SMTPConfig struct {
 	FeedbackName                      string
 	FeedbackEmail                     string
 	ReplyToAddress                    string
+
+	// MaxConnections, MaxMessagesPerSecond and MaxRetries are only used when sending
+	// through an SMTPPool.
+	MaxConnections       int
+	MaxMessagesPerSecond int
+	MaxRetries           int
 }
 
 type mailData struct {
@@ -235,11 +241,11 @@ func TestConnection(config *SMTPConfig) error {
 	return nil
 }
 
-func SendMailWithEmbeddedFilesUsingConfig(to, subject, htmlBody string, embeddedFiles map[string]io.Reader, config *SMTPConfig, enableComplianceFeatures bool, messageID string, inReplyTo string, references string, ccMail string, category string) error {
+func newMailData(to, subject, htmlBody string, embeddedFiles map[string]io.Reader, config *SMTPConfig, messageID string, inReplyTo string, references string, ccMail string, category string) mailData {
 	fromMail := mail.Address{Name: config.FeedbackName, Address: config.FeedbackEmail}
 	replyTo := mail.Address{Name: config.FeedbackName, Address: config.ReplyToAddress}
 
-	mail := mailData{
+	return mailData{
 		mimeTo:        to,
 		smtpTo:        to,
 		from:          fromMail,
@@ -253,6 +259,10 @@ func SendMailWithEmbeddedFilesUsingConfig(to, subject, htmlBody string, embedded
 		references:    references,
 		category:      category,
 	}
+}
+
+func SendMailWithEmbeddedFilesUsingConfig(to, subject, htmlBody string, embeddedFiles map[string]io.Reader, config *SMTPConfig, enableComplianceFeatures bool, messageID string, inReplyTo string, references string, ccMail string, category string) error {
+	mail := newMailData(to, subject, htmlBody, embeddedFiles, config, messageID, inReplyTo, references, ccMail, category)
 
 	return sendMailUsingConfigAdvanced(mail, config)
 }
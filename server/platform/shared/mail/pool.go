@@ -0,0 +1,309 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package mail
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net"
+	"net/textproto"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+)
+
+const (
+	// smtpPoolIdleTimeout is how long an idle connection is kept open. It is kept below the
+	// usual idle timeout of the SMTP servers so that pooled connections are rarely found closed.
+	smtpPoolIdleTimeout = 30 * time.Second
+
+	smtpRetryInitialBackoff = time.Second
+	smtpRetryMaxBackoff     = 30 * time.Second
+)
+
+var errSMTPPoolClosed = errors.New("the SMTP connection pool is closed")
+
+// pooledClient is implemented by an smtp.Client.
+type pooledClient interface {
+	smtpClient
+	Reset() error
+	Quit() error
+	Close() error
+}
+
+type pooledConnection struct {
+	client   pooledClient
+	conn     net.Conn
+	key      string
+	lastUsed time.Time
+}
+
+// SMTPPool sends emails over a set of reused SMTP connections, instead of opening a new
+// connection for every email. It limits the number of concurrent connections and the rate
+// at which emails are sent, and retries the emails rejected with a transient error.
+//
+// The limits are read from the SMTPConfig of each email, so that they follow the changes
+// to the configuration.
+type SMTPPool struct {
+	mut      sync.Mutex
+	cond     *sync.Cond
+	idle     []*pooledConnection
+	inUse    int
+	nextSend time.Time
+	closed   bool
+
+	dial  func(config *SMTPConfig) (pooledClient, net.Conn, error)
+	sleep func(d time.Duration)
+}
+
+func NewSMTPPool() *SMTPPool {
+	p := &SMTPPool{
+		dial:  dialPooledClient,
+		sleep: time.Sleep,
+	}
+	p.cond = sync.NewCond(&p.mut)
+	return p
+}
+
+func dialPooledClient(config *SMTPConfig) (pooledClient, net.Conn, error) {
+	conn, err := ConnectToSMTPServer(config)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(config.ServerTimeout)*time.Second)
+	defer cancel()
+
+	c, err := NewSMTPClient(ctx, conn, config)
+	if err != nil {
+		conn.Close()
+		return nil, nil, err
+	}
+
+	return c, conn, nil
+}
+
+// poolKey identifies the server and the credentials a connection was opened with, so that
+// the connections opened before a configuration change aren't reused.
+func poolKey(config *SMTPConfig) string {
+	return strings.Join([]string{
+		config.ConnectionSecurity,
+		config.Hostname,
+		config.ServerName,
+		config.Server,
+		config.Port,
+		config.Username,
+		config.Password,
+	}, "\x00")
+}
+
+func poolMaxConnections(config *SMTPConfig) int {
+	if config.MaxConnections <= 0 {
+		return 1
+	}
+	return config.MaxConnections
+}
+
+// Close closes the idle connections and makes the pool reject any further email.
+func (p *SMTPPool) Close() {
+	p.mut.Lock()
+	p.closed = true
+	idle := p.idle
+	p.idle = nil
+	p.cond.Broadcast()
+	p.mut.Unlock()
+
+	for _, pc := range idle {
+		closePooledConnection(pc)
+	}
+}
+
+func (p *SMTPPool) SendMailUsingConfig(to, subject, htmlBody string, config *SMTPConfig, enableComplianceFeatures bool, messageID string, inReplyTo string, references string, ccMail, category string) error {
+	return p.SendMailWithEmbeddedFilesUsingConfig(to, subject, htmlBody, nil, config, enableComplianceFeatures, messageID, inReplyTo, references, ccMail, category)
+}
+
+func (p *SMTPPool) SendMailWithEmbeddedFilesUsingConfig(to, subject, htmlBody string, embeddedFiles map[string]io.Reader, config *SMTPConfig, enableComplianceFeatures bool, messageID string, inReplyTo string, references string, ccMail string, category string) error {
+	mail := newMailData(to, subject, htmlBody, embeddedFiles, config, messageID, inReplyTo, references, ccMail, category)
+
+	return p.send(mail, config)
+}
+
+func (p *SMTPPool) send(mail mailData, config *SMTPConfig) error {
+	if config.Server == "" {
+		return nil
+	}
+
+	// The embedded files are read again on every attempt.
+	var embeddedFiles map[string][]byte
+	if config.MaxRetries > 0 && len(mail.embeddedFiles) > 0 {
+		embeddedFiles = make(map[string][]byte, len(mail.embeddedFiles))
+		for name, reader := range mail.embeddedFiles {
+			data, err := io.ReadAll(reader)
+			if err != nil {
+				return errors.Wrapf(err, "unable to read embedded file %s", name)
+			}
+			embeddedFiles[name] = data
+		}
+	}
+
+	backoff := smtpRetryInitialBackoff
+	for attempt := 0; ; attempt++ {
+		if embeddedFiles != nil {
+			mail.embeddedFiles = make(map[string]io.Reader, len(embeddedFiles))
+			for name, data := range embeddedFiles {
+				mail.embeddedFiles[name] = bytes.NewReader(data)
+			}
+		}
+
+		err := p.sendOnce(mail, config)
+		if err == nil || attempt >= config.MaxRetries || !isTransientSMTPError(err) {
+			return err
+		}
+
+		mlog.Debug("Transient error sending mail, retrying", mlog.String("to", mail.smtpTo), mlog.Int("attempt", attempt+1), mlog.Duration("backoff", backoff), mlog.Err(err))
+		p.sleep(backoff)
+
+		backoff *= 2
+		if backoff > smtpRetryMaxBackoff {
+			backoff = smtpRetryMaxBackoff
+		}
+	}
+}
+
+func (p *SMTPPool) sendOnce(mail mailData, config *SMTPConfig) error {
+	pc, err := p.acquire(config)
+	if err != nil {
+		return err
+	}
+
+	p.waitForSendSlot(config.MaxMessagesPerSecond)
+
+	if pc.conn != nil && config.ServerTimeout > 0 {
+		pc.conn.SetDeadline(time.Now().Add(time.Duration(config.ServerTimeout) * time.Second))
+	}
+
+	if err = sendMail(pc.client, mail, time.Now(), config); err != nil {
+		// The state of the connection is unknown after a failure, so it's not reused.
+		p.release(pc, false, config)
+		return err
+	}
+
+	p.release(pc, true, config)
+	return nil
+}
+
+// acquire returns a connection to the SMTP server, waiting until fewer than
+// config.MaxConnections connections are in use.
+func (p *SMTPPool) acquire(config *SMTPConfig) (*pooledConnection, error) {
+	maxConnections := poolMaxConnections(config)
+	key := poolKey(config)
+
+	p.mut.Lock()
+	for p.inUse >= maxConnections && !p.closed {
+		p.cond.Wait()
+	}
+	if p.closed {
+		p.mut.Unlock()
+		return nil, errSMTPPoolClosed
+	}
+	p.inUse++
+
+	var pc *pooledConnection
+	var stale []*pooledConnection
+	for pc == nil && len(p.idle) > 0 {
+		last := p.idle[len(p.idle)-1]
+		p.idle = p.idle[:len(p.idle)-1]
+		if last.key == key && time.Since(last.lastUsed) < smtpPoolIdleTimeout {
+			pc = last
+		} else {
+			stale = append(stale, last)
+		}
+	}
+	p.mut.Unlock()
+
+	for _, s := range stale {
+		closePooledConnection(s)
+	}
+
+	if pc != nil {
+		if pc.conn != nil && config.ServerTimeout > 0 {
+			pc.conn.SetDeadline(time.Now().Add(time.Duration(config.ServerTimeout) * time.Second))
+		}
+		// The server may have closed the connection since it was last used.
+		if err := pc.client.Reset(); err == nil {
+			return pc, nil
+		}
+		pc.client.Close()
+	}
+
+	client, conn, err := p.dial(config)
+	if err != nil {
+		p.release(nil, false, config)
+		return nil, err
+	}
+
+	return &pooledConnection{client: client, conn: conn, key: key}, nil
+}
+
+func (p *SMTPPool) release(pc *pooledConnection, reuse bool, config *SMTPConfig) {
+	p.mut.Lock()
+	p.inUse--
+	if pc != nil && reuse && !p.closed && len(p.idle) < poolMaxConnections(config) {
+		pc.lastUsed = time.Now()
+		p.idle = append(p.idle, pc)
+		pc = nil
+	}
+	p.cond.Signal()
+	p.mut.Unlock()
+
+	if pc != nil {
+		if reuse {
+			closePooledConnection(pc)
+		} else {
+			pc.client.Close()
+		}
+	}
+}
+
+// waitForSendSlot spaces the emails so that no more than maxPerSecond emails are sent each
+// second. A zero limit means that the emails are sent as fast as possible.
+func (p *SMTPPool) waitForSendSlot(maxPerSecond int) {
+	if maxPerSecond <= 0 {
+		return
+	}
+
+	p.mut.Lock()
+	now := time.Now()
+	slot := p.nextSend
+	if slot.Before(now) {
+		slot = now
+	}
+	p.nextSend = slot.Add(time.Second / time.Duration(maxPerSecond))
+	p.mut.Unlock()
+
+	if wait := slot.Sub(now); wait > 0 {
+		p.sleep(wait)
+	}
+}
+
+func closePooledConnection(pc *pooledConnection) {
+	if err := pc.client.Quit(); err != nil {
+		pc.client.Close()
+	}
+}
+
+// isTransientSMTPError returns whether the SMTP server rejected the email with a 4xx code,
+// meaning that the same email may be accepted if sent again later.
+func isTransientSMTPError(err error) bool {
+	var protoErr *textproto.Error
+	if !errors.As(err, &protoErr) {
+		return false
+	}
+	return protoErr.Code >= 400 && protoErr.Code < 500
+}
@@ -0,0 +1,255 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package mail
+
+import (
+	"bytes"
+	"encoding/base64"
+	"io"
+	"net"
+	"net/textproto"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakePooledClient struct {
+	mut      sync.Mutex
+	rcptErrs []error
+	resetErr error
+	sent     []string
+	resets   int
+	closed   bool
+	data     bytes.Buffer
+}
+
+func (c *fakePooledClient) Mail(string) error { return nil }
+
+func (c *fakePooledClient) Rcpt(to string) error {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+	if len(c.rcptErrs) > 0 {
+		err := c.rcptErrs[0]
+		c.rcptErrs = c.rcptErrs[1:]
+		return err
+	}
+	c.sent = append(c.sent, to)
+	return nil
+}
+
+func (c *fakePooledClient) Data() (io.WriteCloser, error) { return c, nil }
+func (c *fakePooledClient) Write(p []byte) (int, error) {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+	return c.data.Write(p)
+}
+
+func (c *fakePooledClient) Reset() error {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+	c.resets++
+	return c.resetErr
+}
+
+func (c *fakePooledClient) Quit() error { return c.Close() }
+func (c *fakePooledClient) Close() error {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+	c.closed = true
+	return nil
+}
+
+type fakeDialer struct {
+	mut     sync.Mutex
+	clients []*fakePooledClient
+	newFn   func() *fakePooledClient
+}
+
+func (d *fakeDialer) dial(*SMTPConfig) (pooledClient, net.Conn, error) {
+	d.mut.Lock()
+	defer d.mut.Unlock()
+	c := &fakePooledClient{}
+	if d.newFn != nil {
+		c = d.newFn()
+	}
+	d.clients = append(d.clients, c)
+	return c, nil, nil
+}
+
+func newTestSMTPPool(d *fakeDialer) (*SMTPPool, *[]time.Duration) {
+	var sleeps []time.Duration
+	p := NewSMTPPool()
+	p.dial = d.dial
+	p.sleep = func(d time.Duration) { sleeps = append(sleeps, d) }
+	return p, &sleeps
+}
+
+func newTestPoolConfig() *SMTPConfig {
+	return &SMTPConfig{
+		Server:         "localhost",
+		Port:           "10025",
+		ServerTimeout:  10,
+		FeedbackEmail:  "feedback@example.com",
+		MaxConnections: 2,
+		MaxRetries:     2,
+	}
+}
+
+func TestSMTPPoolReusesConnections(t *testing.T) {
+	d := &fakeDialer{}
+	p, _ := newTestSMTPPool(d)
+	defer p.Close()
+	config := newTestPoolConfig()
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, p.SendMailUsingConfig("test@example.com", "subject", "body", config, false, "", "", "", "", ""))
+	}
+
+	require.Len(t, d.clients, 1)
+	assert.Len(t, d.clients[0].sent, 3)
+	assert.Equal(t, 2, d.clients[0].resets)
+
+	t.Run("a connection that fails to reset is replaced", func(t *testing.T) {
+		d.clients[0].resetErr = errors.New("connection closed")
+		require.NoError(t, p.SendMailUsingConfig("test@example.com", "subject", "body", config, false, "", "", "", "", ""))
+		require.Len(t, d.clients, 2)
+		assert.True(t, d.clients[0].closed)
+		assert.Len(t, d.clients[1].sent, 1)
+	})
+
+	t.Run("connections aren't reused after a configuration change", func(t *testing.T) {
+		changed := newTestPoolConfig()
+		changed.Username = "other"
+		require.NoError(t, p.SendMailUsingConfig("test@example.com", "subject", "body", changed, false, "", "", "", "", ""))
+		require.Len(t, d.clients, 3)
+		assert.True(t, d.clients[1].closed)
+	})
+
+	t.Run("no email is sent after closing the pool", func(t *testing.T) {
+		p.Close()
+		assert.True(t, d.clients[2].closed)
+		err := p.SendMailUsingConfig("test@example.com", "subject", "body", config, false, "", "", "", "", "")
+		assert.ErrorIs(t, err, errSMTPPoolClosed)
+	})
+}
+
+func TestSMTPPoolLimitsConnections(t *testing.T) {
+	var inFlight, maxInFlight int32
+	release := make(chan struct{})
+	d := &fakeDialer{}
+	p, _ := newTestSMTPPool(d)
+	defer p.Close()
+	p.dial = func(config *SMTPConfig) (pooledClient, net.Conn, error) {
+		n := atomic.AddInt32(&inFlight, 1)
+		for {
+			m := atomic.LoadInt32(&maxInFlight)
+			if n <= m || atomic.CompareAndSwapInt32(&maxInFlight, m, n) {
+				break
+			}
+		}
+		<-release
+		atomic.AddInt32(&inFlight, -1)
+		return d.dial(config)
+	}
+	config := newTestPoolConfig()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, p.SendMailUsingConfig("test@example.com", "subject", "body", config, false, "", "", "", "", ""))
+		}()
+	}
+	close(release)
+	wg.Wait()
+
+	assert.LessOrEqual(t, maxInFlight, int32(config.MaxConnections))
+	assert.LessOrEqual(t, len(d.clients), config.MaxConnections)
+}
+
+func TestSMTPPoolRetries(t *testing.T) {
+	transientErr := &textproto.Error{Code: 451, Msg: "Too many messages, slow down"}
+	permanentErr := &textproto.Error{Code: 550, Msg: "Mailbox unavailable"}
+
+	t.Run("transient errors are retried with backoff", func(t *testing.T) {
+		first := true
+		d := &fakeDialer{newFn: func() *fakePooledClient {
+			c := &fakePooledClient{}
+			if first {
+				c.rcptErrs = []error{transientErr}
+				first = false
+			}
+			return c
+		}}
+		p, sleeps := newTestSMTPPool(d)
+		defer p.Close()
+
+		files := map[string]io.Reader{"logo.png": strings.NewReader("image data")}
+		err := p.SendMailWithEmbeddedFilesUsingConfig("test@example.com", "subject", "body", files, newTestPoolConfig(), false, "", "", "", "", "")
+		require.NoError(t, err)
+
+		require.Len(t, d.clients, 2)
+		assert.True(t, d.clients[0].closed, "a failed connection is not reused")
+		assert.Equal(t, []time.Duration{smtpRetryInitialBackoff}, *sleeps)
+		assert.Contains(t, d.clients[1].data.String(), base64.StdEncoding.EncodeToString([]byte("image data")), "embedded files are sent again")
+	})
+
+	t.Run("retries are limited", func(t *testing.T) {
+		d := &fakeDialer{newFn: func() *fakePooledClient {
+			return &fakePooledClient{rcptErrs: []error{transientErr}}
+		}}
+		p, sleeps := newTestSMTPPool(d)
+		defer p.Close()
+
+		err := p.SendMailUsingConfig("test@example.com", "subject", "body", newTestPoolConfig(), false, "", "", "", "", "")
+		require.Error(t, err)
+		assert.True(t, isTransientSMTPError(err))
+		assert.Len(t, d.clients, 3)
+		assert.Equal(t, []time.Duration{smtpRetryInitialBackoff, 2 * smtpRetryInitialBackoff}, *sleeps)
+	})
+
+	t.Run("permanent errors aren't retried", func(t *testing.T) {
+		d := &fakeDialer{newFn: func() *fakePooledClient {
+			return &fakePooledClient{rcptErrs: []error{permanentErr}}
+		}}
+		p, sleeps := newTestSMTPPool(d)
+		defer p.Close()
+
+		err := p.SendMailUsingConfig("test@example.com", "subject", "body", newTestPoolConfig(), false, "", "", "", "", "")
+		require.Error(t, err)
+		assert.Len(t, d.clients, 1)
+		assert.Empty(t, *sleeps)
+	})
+}
+
+func TestSMTPPoolThrottles(t *testing.T) {
+	d := &fakeDialer{}
+	p, sleeps := newTestSMTPPool(d)
+	defer p.Close()
+	config := newTestPoolConfig()
+	config.MaxMessagesPerSecond = 10
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, p.SendMailUsingConfig("test@example.com", "subject", "body", config, false, "", "", "", "", ""))
+	}
+
+	// The first email is sent right away and the fake sleep doesn't wait, so every
+	// following email is delayed by one more slot.
+	require.Len(t, *sleeps, 2)
+	assert.InDelta(t, 100*time.Millisecond, (*sleeps)[0], float64(20*time.Millisecond))
+	assert.InDelta(t, 200*time.Millisecond, (*sleeps)[1], float64(20*time.Millisecond))
+}
+
+func TestIsTransientSMTPError(t *testing.T) {
+	assert.True(t, isTransientSMTPError(errors.Wrap(&textproto.Error{Code: 421, Msg: "Service not available"}, "failed to set the to address")))
+	assert.False(t, isTransientSMTPError(&textproto.Error{Code: 550, Msg: "Mailbox unavailable"}))
+	assert.False(t, isTransientSMTPError(errors.New("connection refused")))
+}
@@ -5,23 +5,33 @@ package templates
 
 import (
 	"bytes"
+	stderrors "errors"
 	"html/template"
 	"io"
+	"os"
 	"path/filepath"
+	"sort"
+	"strings"
 	"sync"
 
 	"github.com/fsnotify/fsnotify"
+	"github.com/pkg/errors"
 
 	"github.com/mattermost/mattermost/server/v8/channels/utils/fileutils"
 )
 
 // Container represents a set of templates that can be render
 type Container struct {
-	templates *template.Template
-	mutex     sync.RWMutex
-	stop      chan struct{}
-	stopped   chan struct{}
-	watch     bool
+	templates          *template.Template
+	overridden         map[string]bool
+	directory          string
+	overridesDirectory string
+	watcher            *fsnotify.Watcher
+	mutex              sync.RWMutex
+	reloadMutex        sync.Mutex
+	stop               chan struct{}
+	stopped            chan struct{}
+	watch              bool
 }
 
 // Data contains the data used to populate the template variables, it has Props
@@ -43,7 +53,7 @@ func NewFromTemplate(templates *template.Template) *Container {
 
 // New creates a new templates container scanning a directory.
 func New(directory string) (*Container, error) {
-	c := &Container{}
+	c := &Container{directory: directory}
 
 	htmlTemplates, err := template.ParseGlob(filepath.Join(directory, "*.html"))
 	if err != nil {
@@ -79,6 +89,8 @@ func NewWithWatcher(directory string) (*Container, <-chan error, error) {
 
 	c := &Container{
 		templates: htmlTemplates,
+		directory: directory,
+		watcher:   watcher,
 		watch:     true,
 		stop:      make(chan struct{}),
 		stopped:   make(chan struct{}),
@@ -95,13 +107,9 @@ func NewWithWatcher(directory string) (*Container, <-chan error, error) {
 			case <-c.stop:
 				return
 			case event := <-watcher.Events:
-				if event.Op&fsnotify.Write == fsnotify.Write {
-					if htmlTemplates, err := template.ParseGlob(filepath.Join(directory, "*.html")); err != nil {
+				if event.Op&(fsnotify.Write|fsnotify.Create|fsnotify.Remove|fsnotify.Rename) != 0 {
+					if err := c.reload(); err != nil {
 						errors <- err
-					} else {
-						c.mutex.Lock()
-						c.templates = htmlTemplates
-						c.mutex.Unlock()
 					}
 				}
 			case err := <-watcher.Errors:
@@ -113,6 +121,212 @@ func NewWithWatcher(directory string) (*Container, <-chan error, error) {
 	return c, errors, nil
 }
 
+// SetOverridesDirectory sets the directory of the templates overriding the
+// templates of the container, reloading them. The templates are only
+// overridden by the html files of the directory defining templates, the
+// invalid files are skipped and reported in the returned error. An empty
+// directory removes the overrides.
+func (c *Container) SetOverridesDirectory(overridesDirectory string) error {
+	c.mutex.Lock()
+	previous := c.overridesDirectory
+	c.overridesDirectory = overridesDirectory
+	c.mutex.Unlock()
+
+	if c.watch && previous != overridesDirectory {
+		if previous != "" {
+			c.watcher.Remove(previous)
+		}
+		if overridesDirectory != "" {
+			if err := c.watcher.Add(overridesDirectory); err != nil {
+				return errors.Wrapf(err, "unable to watch the template overrides directory %q", overridesDirectory)
+			}
+		}
+	}
+
+	return c.reload()
+}
+
+// Overridden returns the names of the templates defined by the template
+// overrides.
+func (c *Container) Overridden() []string {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	names := make([]string, 0, len(c.overridden))
+	for name := range c.overridden {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Names returns the names of the templates of the container, excluding the
+// templates named after the files they are defined in.
+func (c *Container) Names() []string {
+	c.mutex.RLock()
+	htmlTemplates := c.templates
+	c.mutex.RUnlock()
+
+	var names []string
+	for _, t := range htmlTemplates.Templates() {
+		if t.Name() != "" && !strings.HasSuffix(t.Name(), ".html") {
+			names = append(names, t.Name())
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Validate checks that the content is a valid template override, and returns
+// the names of the templates it defines. Besides parsing the content, the
+// defined templates are escaped, since html templates are only escaped when
+// executed for the first time.
+func (c *Container) Validate(content string) ([]string, error) {
+	htmlTemplates, names, err := c.parseWithOverride(content)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, name := range names {
+		// The templates are executed without data, so only the escaping errors
+		// are relevant.
+		var escapeErr *template.Error
+		if err := htmlTemplates.ExecuteTemplate(io.Discard, name, Data{}); errors.As(err, &escapeErr) {
+			return nil, err
+		}
+	}
+
+	return names, nil
+}
+
+// RenderPreview renders the template referenced with the template name like
+// RenderToString, after overriding the templates of the container with the
+// content, if not empty.
+func (c *Container) RenderPreview(templateName, content string, data Data) (string, error) {
+	htmlTemplates, _, err := c.parseWithOverride(content)
+	if err != nil {
+		return "", err
+	}
+
+	var text bytes.Buffer
+	if err := htmlTemplates.ExecuteTemplate(&text, templateName, data); err != nil {
+		return "", err
+	}
+	return text.String(), nil
+}
+
+// parseWithOverride parses the templates of the container again, since html
+// templates can't be cloned once executed, and overrides them with the
+// content.
+func (c *Container) parseWithOverride(content string) (*template.Template, []string, error) {
+	c.mutex.RLock()
+	directory, overridesDirectory := c.directory, c.overridesDirectory
+	c.mutex.RUnlock()
+
+	if directory == "" {
+		return nil, nil, errors.New("the templates of the container weren't loaded from a directory")
+	}
+
+	htmlTemplates, _, err := parseTemplates(directory, overridesDirectory)
+	if htmlTemplates == nil {
+		return nil, nil, err
+	}
+
+	if content == "" {
+		return htmlTemplates, nil, nil
+	}
+
+	names, err := parseOverride(htmlTemplates, "override", content)
+	if err != nil {
+		return nil, nil, err
+	}
+	return htmlTemplates, names, nil
+}
+
+func (c *Container) reload() error {
+	c.reloadMutex.Lock()
+	defer c.reloadMutex.Unlock()
+
+	c.mutex.RLock()
+	directory, overridesDirectory := c.directory, c.overridesDirectory
+	c.mutex.RUnlock()
+
+	htmlTemplates, overridden, err := parseTemplates(directory, overridesDirectory)
+	if htmlTemplates != nil {
+		c.mutex.Lock()
+		c.templates = htmlTemplates
+		c.overridden = overridden
+		c.mutex.Unlock()
+	}
+	return err
+}
+
+// parseTemplates parses the templates of the directory, overridden by the
+// templates of the overrides directory. The invalid overrides are skipped, in
+// which case both the templates and an error are returned.
+func parseTemplates(directory, overridesDirectory string) (*template.Template, map[string]bool, error) {
+	htmlTemplates, err := template.ParseGlob(filepath.Join(directory, "*.html"))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if overridesDirectory == "" {
+		return htmlTemplates, nil, nil
+	}
+
+	files, err := filepath.Glob(filepath.Join(overridesDirectory, "*.html"))
+	if err != nil {
+		return htmlTemplates, nil, err
+	}
+
+	overridden := map[string]bool{}
+	var errs []error
+	for _, file := range files {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			errs = append(errs, errors.Wrapf(err, "unable to read the template override %q", file))
+			continue
+		}
+
+		names, err := parseOverride(htmlTemplates, "override_"+filepath.Base(file), string(content))
+		if err != nil {
+			errs = append(errs, errors.Wrapf(err, "invalid template override %q", file))
+			continue
+		}
+		for _, name := range names {
+			overridden[name] = true
+		}
+	}
+
+	return htmlTemplates, overridden, stderrors.Join(errs...)
+}
+
+// parseOverride parses the content into the templates, redefining the
+// templates it defines, and returns their names. The templates are left
+// unchanged if the content is invalid.
+func parseOverride(htmlTemplates *template.Template, name, content string) ([]string, error) {
+	defined, err := template.New(name).Parse(content)
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, t := range defined.Templates() {
+		if t.Name() != name {
+			names = append(names, t.Name())
+		}
+	}
+	if len(names) == 0 {
+		return nil, errors.New("the template override doesn't define any template")
+	}
+	sort.Strings(names)
+
+	if _, err := htmlTemplates.New(name).Parse(content); err != nil {
+		return nil, err
+	}
+	return names, nil
+}
+
 // Close stops the templates watcher of the container in case you have created
 // it with watch parameter set to true
 func (c *Container) Close() {
@@ -112,3 +112,103 @@ func TestRenderUnknownTemplate(t *testing.T) {
 	assert.Error(t, mt.Render(buf, "foo", Data{}))
 	assert.Equal(t, "", buf.String())
 }
+
+func TestTemplateOverrides(t *testing.T) {
+	dir := t.TempDir()
+	overridesDir := t.TempDir()
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "foo.html"), []byte(`{{ define "foo" }}foo {{ template "bar" . }}{{ end }}`), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "bar.html"), []byte(`{{ define "bar" }}bar{{ end }}`), 0600))
+
+	watcher, errChan, err := NewWithWatcher(dir)
+	require.NoError(t, err)
+	go func() {
+		for range errChan {
+		}
+	}()
+	defer watcher.Close()
+
+	assert.Equal(t, []string{"bar", "foo"}, watcher.Names())
+
+	t.Run("override", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(filepath.Join(overridesDir, "bar.html"), []byte(`{{ define "bar" }}custom bar{{ end }}`), 0600))
+		require.NoError(t, watcher.SetOverridesDirectory(overridesDir))
+
+		text, err := watcher.RenderToString("foo", Data{})
+		require.NoError(t, err)
+		assert.Equal(t, "foo custom bar", text)
+		assert.Equal(t, []string{"bar"}, watcher.Overridden())
+	})
+
+	t.Run("hot reload", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(filepath.Join(overridesDir, "foo.html"), []byte(`{{ define "foo" }}custom foo {{ template "bar" . }}{{ end }}`), 0600))
+
+		require.Eventually(t, func() bool {
+			text, err := watcher.RenderToString("foo", Data{})
+			return text == "custom foo custom bar" && err == nil
+		}, time.Millisecond*1000, time.Millisecond*50)
+		assert.Equal(t, []string{"bar", "foo"}, watcher.Overridden())
+
+		require.NoError(t, os.Remove(filepath.Join(overridesDir, "foo.html")))
+
+		require.Eventually(t, func() bool {
+			text, err := watcher.RenderToString("foo", Data{})
+			return text == "foo custom bar" && err == nil
+		}, time.Millisecond*1000, time.Millisecond*50)
+	})
+
+	t.Run("invalid override is skipped", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(filepath.Join(overridesDir, "invalid.html"), []byte(`{{ define "foo" }}{{ .Props.Foo }`), 0600))
+		defer os.Remove(filepath.Join(overridesDir, "invalid.html"))
+
+		err := watcher.SetOverridesDirectory(overridesDir)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid.html")
+
+		text, err := watcher.RenderToString("foo", Data{})
+		require.NoError(t, err)
+		assert.Equal(t, "foo custom bar", text)
+	})
+
+	t.Run("validate", func(t *testing.T) {
+		names, err := watcher.Validate(`{{ define "foo" }}validated{{ end }}{{ define "baz" }}baz{{ end }}`)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"baz", "foo"}, names)
+
+		_, err = watcher.Validate(`{{ define "foo" }}{{ .Props.Foo }`)
+		assert.Error(t, err)
+
+		_, err = watcher.Validate(`just text`)
+		assert.Error(t, err)
+
+		_, err = watcher.Validate(`{{ define "foo" }}<a href="{{ .Props.URL }}{{ end }}`)
+		assert.Error(t, err)
+
+		// Validating doesn't change the templates of the container.
+		text, err := watcher.RenderToString("foo", Data{})
+		require.NoError(t, err)
+		assert.Equal(t, "foo custom bar", text)
+	})
+
+	t.Run("preview", func(t *testing.T) {
+		text, err := watcher.RenderPreview("foo", `{{ define "bar" }}{{ .Props.Bar }}{{ end }}`, Data{Props: map[string]any{"Bar": "preview"}})
+		require.NoError(t, err)
+		assert.Equal(t, "foo preview", text)
+
+		text, err = watcher.RenderPreview("foo", "", Data{})
+		require.NoError(t, err)
+		assert.Equal(t, "foo custom bar", text)
+
+		_, err = watcher.RenderPreview("unknown", "", Data{})
+		assert.Error(t, err)
+	})
+
+	t.Run("remove overrides", func(t *testing.T) {
+		require.NoError(t, watcher.SetOverridesDirectory(""))
+
+		text, err := watcher.RenderToString("foo", Data{})
+		require.NoError(t, err)
+		assert.Equal(t, "foo bar", text)
+		assert.Empty(t, watcher.Overridden())
+	})
+}
@@ -0,0 +1,339 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	AutomationTriggerKeywordPosted     = "keyword_posted"
+	AutomationTriggerUserJoinedChannel = "user_joined_channel"
+	AutomationTriggerReactionAdded     = "reaction_added"
+
+	AutomationActionPostMessage       = "post_message"
+	AutomationActionAddToChannel      = "add_to_channel"
+	AutomationActionCallWebhook       = "call_webhook"
+	AutomationActionCreatePlaybookRun = "create_playbook_run"
+
+	AutomationRuleDisplayNameMaxRunes = 64
+	AutomationRuleMaxKeywords         = 20
+	AutomationRuleKeywordMaxRunes     = 64
+	AutomationRuleMaxActions          = 10
+	AutomationActionMessageMaxRunes   = 4000
+	AutomationActionURLMaxLength      = 1024
+	AutomationRuleMaxPage             = 200
+
+	// PostPropsFromAutomation is set on the posts created by automation rules, which never
+	// trigger other rules.
+	PostPropsFromAutomation = "from_automation"
+)
+
+// automationEmojiNameRegexp matches the emoji names accepted in reactions.
+var automationEmojiNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9\-\+_]+$`)
+
+// AutomationRule runs its actions each time something matching its trigger happens in its team.
+type AutomationRule struct {
+	Id          string            `json:"id"`
+	TeamId      string            `json:"team_id"`
+	CreatorId   string            `json:"creator_id"`
+	DisplayName string            `json:"display_name"`
+	Enabled     bool              `json:"enabled"`
+	Trigger     AutomationTrigger `json:"trigger"`
+	Actions     AutomationActions `json:"actions"`
+	CreateAt    int64             `json:"create_at"`
+	UpdateAt    int64             `json:"update_at"`
+	DeleteAt    int64             `json:"delete_at"`
+}
+
+// AutomationTrigger describes the events running a rule.
+type AutomationTrigger struct {
+	Type string `json:"type"`
+	// ChannelId restricts the trigger to a channel of the team, any channel matches when empty.
+	ChannelId string `json:"channel_id,omitempty"`
+	// Keywords are matched as whole words, ignoring case, by keyword_posted triggers.
+	Keywords []string `json:"keywords,omitempty"`
+	// EmojiName restricts reaction_added triggers to an emoji, any emoji matches when empty.
+	EmojiName string `json:"emoji_name,omitempty"`
+}
+
+// AutomationAction is run, in order, when the trigger of its rule matches.
+type AutomationAction struct {
+	Type string `json:"type"`
+	// ChannelId is the channel to post in, to add the user to, or linked to the playbook run.
+	// For post_message actions, the message is posted in the channel of the event when empty.
+	ChannelId string `json:"channel_id,omitempty"`
+	// Message is the text posted by post_message actions, or the name of the playbook run.
+	Message    string `json:"message,omitempty"`
+	URL        string `json:"url,omitempty"`
+	PlaybookId string `json:"playbook_id,omitempty"`
+}
+
+type AutomationActions []*AutomationAction
+
+// AutomationEvent is something that happened in a team, matched against the triggers of its
+// rules. It is the payload sent by call_webhook actions.
+type AutomationEvent struct {
+	RuleId    string `json:"rule_id,omitempty"`
+	Type      string `json:"type"`
+	TeamId    string `json:"team_id"`
+	ChannelId string `json:"channel_id"`
+	UserId    string `json:"user_id"`
+	PostId    string `json:"post_id,omitempty"`
+	Message   string `json:"message,omitempty"`
+	EmojiName string `json:"emoji_name,omitempty"`
+}
+
+func (r *AutomationRule) Auditable() map[string]any {
+	return map[string]any{
+		"id":           r.Id,
+		"team_id":      r.TeamId,
+		"creator_id":   r.CreatorId,
+		"display_name": r.DisplayName,
+		"enabled":      r.Enabled,
+		"trigger":      r.Trigger,
+		"actions":      r.Actions,
+		"create_at":    r.CreateAt,
+		"update_at":    r.UpdateAt,
+		"delete_at":    r.DeleteAt,
+	}
+}
+
+func (r *AutomationRule) PreSave() {
+	if r.Id == "" {
+		r.Id = NewId()
+	}
+
+	r.CreateAt = GetMillis()
+	r.UpdateAt = r.CreateAt
+	r.DeleteAt = 0
+}
+
+func (r *AutomationRule) PreUpdate() {
+	r.UpdateAt = GetMillis()
+}
+
+func (r *AutomationRule) IsValid() *AppError {
+	if !IsValidId(r.Id) {
+		return NewAppError("AutomationRule.IsValid", "model.automation_rule.is_valid.id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if !IsValidId(r.TeamId) {
+		return NewAppError("AutomationRule.IsValid", "model.automation_rule.is_valid.team_id.app_error", nil, "id="+r.Id, http.StatusBadRequest)
+	}
+
+	if !IsValidId(r.CreatorId) {
+		return NewAppError("AutomationRule.IsValid", "model.automation_rule.is_valid.creator_id.app_error", nil, "id="+r.Id, http.StatusBadRequest)
+	}
+
+	if r.DisplayName == "" || utf8.RuneCountInString(r.DisplayName) > AutomationRuleDisplayNameMaxRunes {
+		return NewAppError("AutomationRule.IsValid", "model.automation_rule.is_valid.display_name.app_error", map[string]any{"Max": AutomationRuleDisplayNameMaxRunes}, "id="+r.Id, http.StatusBadRequest)
+	}
+
+	if err := r.Trigger.isValid(); err != nil {
+		return NewAppError("AutomationRule.IsValid", "model.automation_rule.is_valid.trigger.app_error", map[string]any{"Reason": err.Error()}, "id="+r.Id, http.StatusBadRequest)
+	}
+
+	if len(r.Actions) == 0 || len(r.Actions) > AutomationRuleMaxActions {
+		return NewAppError("AutomationRule.IsValid", "model.automation_rule.is_valid.actions.app_error", map[string]any{"Max": AutomationRuleMaxActions}, "id="+r.Id, http.StatusBadRequest)
+	}
+
+	for _, action := range r.Actions {
+		if err := action.isValid(); err != nil {
+			return NewAppError("AutomationRule.IsValid", "model.automation_rule.is_valid.action.app_error", map[string]any{"Reason": err.Error()}, "id="+r.Id, http.StatusBadRequest)
+		}
+	}
+
+	if r.CreateAt == 0 {
+		return NewAppError("AutomationRule.IsValid", "model.automation_rule.is_valid.create_at.app_error", nil, "id="+r.Id, http.StatusBadRequest)
+	}
+
+	if r.UpdateAt == 0 {
+		return NewAppError("AutomationRule.IsValid", "model.automation_rule.is_valid.update_at.app_error", nil, "id="+r.Id, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+// ChannelIds returns the channels referenced by the trigger and the actions of the rule.
+func (r *AutomationRule) ChannelIds() []string {
+	var ids []string
+	if r.Trigger.ChannelId != "" {
+		ids = append(ids, r.Trigger.ChannelId)
+	}
+	for _, action := range r.Actions {
+		if action != nil && action.ChannelId != "" {
+			ids = append(ids, action.ChannelId)
+		}
+	}
+	return ids
+}
+
+func (t *AutomationTrigger) isValid() error {
+	switch t.Type {
+	case AutomationTriggerKeywordPosted:
+		if len(t.Keywords) == 0 || len(t.Keywords) > AutomationRuleMaxKeywords {
+			return errors.Errorf("keyword_posted triggers need between 1 and %d keywords", AutomationRuleMaxKeywords)
+		}
+		for _, keyword := range t.Keywords {
+			if strings.TrimSpace(keyword) == "" || utf8.RuneCountInString(keyword) > AutomationRuleKeywordMaxRunes {
+				return errors.Errorf("keywords must not be empty nor longer than %d characters", AutomationRuleKeywordMaxRunes)
+			}
+		}
+	case AutomationTriggerUserJoinedChannel:
+	case AutomationTriggerReactionAdded:
+		if t.EmojiName != "" && (len(t.EmojiName) > EmojiNameMaxLength || !automationEmojiNameRegexp.MatchString(t.EmojiName)) {
+			return errors.New("invalid emoji name")
+		}
+	default:
+		return errors.Errorf("unknown trigger type %q", t.Type)
+	}
+
+	if t.ChannelId != "" && !IsValidId(t.ChannelId) {
+		return errors.New("invalid channel id")
+	}
+
+	return nil
+}
+
+// Matches returns whether the event runs the rules with this trigger.
+func (t *AutomationTrigger) Matches(event *AutomationEvent) bool {
+	if event.Type != t.Type {
+		return false
+	}
+
+	if t.ChannelId != "" && t.ChannelId != event.ChannelId {
+		return false
+	}
+
+	switch t.Type {
+	case AutomationTriggerKeywordPosted:
+		for _, keyword := range t.Keywords {
+			if containsWord(event.Message, keyword) {
+				return true
+			}
+		}
+		return false
+	case AutomationTriggerReactionAdded:
+		return t.EmojiName == "" || t.EmojiName == event.EmojiName
+	}
+
+	return true
+}
+
+// containsWord returns whether text contains word, ignoring case, not surrounded by letters or digits.
+func containsWord(text, word string) bool {
+	text = strings.ToLower(text)
+	word = strings.ToLower(strings.TrimSpace(word))
+
+	isWordRune := func(r rune) bool {
+		return unicode.IsLetter(r) || unicode.IsDigit(r)
+	}
+
+	for offset := 0; offset < len(text); {
+		index := strings.Index(text[offset:], word)
+		if index == -1 {
+			return false
+		}
+		start := offset + index
+		end := start + len(word)
+
+		before, _ := utf8.DecodeLastRuneInString(text[:start])
+		after, _ := utf8.DecodeRuneInString(text[end:])
+		if (start == 0 || !isWordRune(before)) && (end == len(text) || !isWordRune(after)) {
+			return true
+		}
+
+		_, size := utf8.DecodeRuneInString(text[start:])
+		offset = start + size
+	}
+
+	return false
+}
+
+func (a *AutomationAction) isValid() error {
+	if a == nil {
+		return errors.New("actions must not be null")
+	}
+
+	switch a.Type {
+	case AutomationActionPostMessage:
+		if a.Message == "" || utf8.RuneCountInString(a.Message) > AutomationActionMessageMaxRunes {
+			return errors.Errorf("post_message actions need a message of at most %d characters", AutomationActionMessageMaxRunes)
+		}
+	case AutomationActionAddToChannel:
+		if a.ChannelId == "" {
+			return errors.New("add_to_channel actions need a channel")
+		}
+	case AutomationActionCallWebhook:
+		if len(a.URL) > AutomationActionURLMaxLength || !IsValidHTTPURL(a.URL) {
+			return errors.New("call_webhook actions need an http or https URL")
+		}
+	case AutomationActionCreatePlaybookRun:
+		if !IsValidId(a.PlaybookId) {
+			return errors.New("create_playbook_run actions need a playbook")
+		}
+		if utf8.RuneCountInString(a.Message) > AutomationActionMessageMaxRunes {
+			return errors.Errorf("the run name must be at most %d characters", AutomationActionMessageMaxRunes)
+		}
+	default:
+		return errors.Errorf("unknown action type %q", a.Type)
+	}
+
+	if a.ChannelId != "" && !IsValidId(a.ChannelId) {
+		return errors.New("invalid channel id")
+	}
+
+	return nil
+}
+
+func (t *AutomationTrigger) Scan(value any) error {
+	return scanJSONColumn(value, t)
+}
+
+func (t AutomationTrigger) Value() (driver.Value, error) {
+	buf, err := json.Marshal(t)
+	if err != nil {
+		return nil, err
+	}
+	return string(buf), nil
+}
+
+func (a *AutomationActions) Scan(value any) error {
+	return scanJSONColumn(value, a)
+}
+
+func (a AutomationActions) Value() (driver.Value, error) {
+	buf, err := json.Marshal(a)
+	if err != nil {
+		return nil, err
+	}
+	return string(buf), nil
+}
+
+func scanJSONColumn(value any, dest any) error {
+	if value == nil {
+		return nil
+	}
+
+	buf, ok := value.([]byte)
+	if ok {
+		return json.Unmarshal(buf, dest)
+	}
+
+	str, ok := value.(string)
+	if ok {
+		return json.Unmarshal([]byte(str), dest)
+	}
+
+	return errors.New("received value is neither a byte slice nor string")
+}
@@ -0,0 +1,138 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAutomationRuleIsValid(t *testing.T) {
+	newRule := func() *AutomationRule {
+		rule := &AutomationRule{
+			TeamId:      NewId(),
+			CreatorId:   NewId(),
+			DisplayName: "Welcome",
+			Enabled:     true,
+			Trigger: AutomationTrigger{
+				Type: AutomationTriggerUserJoinedChannel,
+			},
+			Actions: AutomationActions{
+				{Type: AutomationActionPostMessage, Message: "Welcome!"},
+			},
+		}
+		rule.PreSave()
+		return rule
+	}
+
+	require.Nil(t, newRule().IsValid())
+
+	for name, tc := range map[string]func(r *AutomationRule){
+		"missing team":         func(r *AutomationRule) { r.TeamId = "" },
+		"missing creator":      func(r *AutomationRule) { r.CreatorId = "" },
+		"missing display name": func(r *AutomationRule) { r.DisplayName = "" },
+		"long display name":    func(r *AutomationRule) { r.DisplayName = strings.Repeat("a", AutomationRuleDisplayNameMaxRunes+1) },
+		"unknown trigger":      func(r *AutomationRule) { r.Trigger.Type = "user_left_channel" },
+		"invalid channel":      func(r *AutomationRule) { r.Trigger.ChannelId = "town-square" },
+		"keywords missing":     func(r *AutomationRule) { r.Trigger.Type = AutomationTriggerKeywordPosted },
+		"blank keyword": func(r *AutomationRule) {
+			r.Trigger = AutomationTrigger{Type: AutomationTriggerKeywordPosted, Keywords: []string{" "}}
+		},
+		"invalid emoji": func(r *AutomationRule) {
+			r.Trigger = AutomationTrigger{Type: AutomationTriggerReactionAdded, EmojiName: ":)"}
+		},
+		"no actions":           func(r *AutomationRule) { r.Actions = nil },
+		"null action":          func(r *AutomationRule) { r.Actions = AutomationActions{nil} },
+		"unknown action":       func(r *AutomationRule) { r.Actions[0].Type = "send_email" },
+		"empty message":        func(r *AutomationRule) { r.Actions[0].Message = "" },
+		"add without channel":  func(r *AutomationRule) { r.Actions[0].Type = AutomationActionAddToChannel },
+		"webhook without URL":  func(r *AutomationRule) { r.Actions[0].Type = AutomationActionCallWebhook },
+		"run without playbook": func(r *AutomationRule) { r.Actions[0].Type = AutomationActionCreatePlaybookRun },
+		"too many actions": func(r *AutomationRule) {
+			for i := 0; i < AutomationRuleMaxActions; i++ {
+				r.Actions = append(r.Actions, r.Actions[0])
+			}
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			rule := newRule()
+			tc(rule)
+			require.NotNil(t, rule.IsValid())
+		})
+	}
+
+	t.Run("every action type", func(t *testing.T) {
+		rule := newRule()
+		rule.Trigger = AutomationTrigger{Type: AutomationTriggerReactionAdded, EmojiName: "+1"}
+		rule.Actions = AutomationActions{
+			{Type: AutomationActionPostMessage, Message: "Thanks!", ChannelId: NewId()},
+			{Type: AutomationActionAddToChannel, ChannelId: NewId()},
+			{Type: AutomationActionCallWebhook, URL: "https://example.com/hook"},
+			{Type: AutomationActionCreatePlaybookRun, PlaybookId: NewId(), Message: "Incident"},
+		}
+		require.Nil(t, rule.IsValid())
+		assert.Len(t, rule.ChannelIds(), 2)
+	})
+}
+
+func TestAutomationTriggerMatches(t *testing.T) {
+	channelID := NewId()
+
+	t.Run("keyword posted", func(t *testing.T) {
+		trigger := &AutomationTrigger{Type: AutomationTriggerKeywordPosted, Keywords: []string{"outage", "Sev 1"}}
+
+		for message, expected := range map[string]bool{
+			"We have an outage":       true,
+			"OUTAGE!":                 true,
+			"this is a sev 1 now":     true,
+			"outages are rare":        false,
+			"no incident here":        false,
+			"the server is in outage": true,
+			"blackout, outage.":       true,
+			"sev 10":                  false,
+		} {
+			assert.Equal(t, expected, trigger.Matches(&AutomationEvent{Type: AutomationTriggerKeywordPosted, Message: message}), message)
+		}
+
+		assert.False(t, trigger.Matches(&AutomationEvent{Type: AutomationTriggerReactionAdded, Message: "outage"}))
+	})
+
+	t.Run("channel restriction", func(t *testing.T) {
+		trigger := &AutomationTrigger{Type: AutomationTriggerUserJoinedChannel, ChannelId: channelID}
+
+		assert.True(t, trigger.Matches(&AutomationEvent{Type: AutomationTriggerUserJoinedChannel, ChannelId: channelID}))
+		assert.False(t, trigger.Matches(&AutomationEvent{Type: AutomationTriggerUserJoinedChannel, ChannelId: NewId()}))
+	})
+
+	t.Run("reaction added", func(t *testing.T) {
+		trigger := &AutomationTrigger{Type: AutomationTriggerReactionAdded, EmojiName: "white_check_mark"}
+
+		assert.True(t, trigger.Matches(&AutomationEvent{Type: AutomationTriggerReactionAdded, EmojiName: "white_check_mark"}))
+		assert.False(t, trigger.Matches(&AutomationEvent{Type: AutomationTriggerReactionAdded, EmojiName: "smile"}))
+
+		trigger.EmojiName = ""
+		assert.True(t, trigger.Matches(&AutomationEvent{Type: AutomationTriggerReactionAdded, EmojiName: "smile"}))
+	})
+}
+
+func TestAutomationRuleScanValue(t *testing.T) {
+	trigger := AutomationTrigger{Type: AutomationTriggerKeywordPosted, Keywords: []string{"help"}}
+	value, err := trigger.Value()
+	require.NoError(t, err)
+
+	var scannedTrigger AutomationTrigger
+	require.NoError(t, scannedTrigger.Scan(value))
+	assert.Equal(t, trigger, scannedTrigger)
+
+	actions := AutomationActions{{Type: AutomationActionCallWebhook, URL: "https://example.com"}}
+	value, err = actions.Value()
+	require.NoError(t, err)
+
+	var scannedActions AutomationActions
+	require.NoError(t, scannedActions.Scan([]byte(value.(string))))
+	assert.Equal(t, actions, scannedActions)
+}
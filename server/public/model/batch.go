@@ -0,0 +1,84 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+const (
+	BatchMaxOperations    = 20
+	BatchOperationNameMax = 64
+)
+
+// BatchOperation is a read operation of a batch. Its path is relative to the API root, e.g.
+// /users/me or /users/me/teams?page=0, and it's served as a GET request authenticated as the
+// batch request itself.
+type BatchOperation struct {
+	Name string `json:"name"`
+	Path string `json:"path"`
+}
+
+type BatchRequest struct {
+	Operations []*BatchOperation `json:"operations"`
+}
+
+func (r *BatchRequest) IsValid() *AppError {
+	if len(r.Operations) == 0 || len(r.Operations) > BatchMaxOperations {
+		return NewAppError("BatchRequest.IsValid", "model.batch.is_valid.operations.app_error", map[string]any{"Max": BatchMaxOperations}, "", http.StatusBadRequest)
+	}
+
+	names := make(map[string]bool, len(r.Operations))
+	for _, op := range r.Operations {
+		if op == nil {
+			return NewAppError("BatchRequest.IsValid", "model.batch.is_valid.operations.app_error", map[string]any{"Max": BatchMaxOperations}, "", http.StatusBadRequest)
+		}
+
+		if op.Name == "" || len(op.Name) > BatchOperationNameMax {
+			return NewAppError("BatchRequest.IsValid", "model.batch.is_valid.name.app_error", map[string]any{"Max": BatchOperationNameMax}, "", http.StatusBadRequest)
+		}
+
+		if names[op.Name] {
+			return NewAppError("BatchRequest.IsValid", "model.batch.is_valid.duplicate_name.app_error", map[string]any{"Name": op.Name}, "", http.StatusBadRequest)
+		}
+		names[op.Name] = true
+
+		if !op.isValidPath() {
+			return NewAppError("BatchRequest.IsValid", "model.batch.is_valid.path.app_error", map[string]any{"Name": op.Name}, "path="+op.Path, http.StatusBadRequest)
+		}
+	}
+
+	return nil
+}
+
+func (o *BatchOperation) isValidPath() bool {
+	path, _, _ := strings.Cut(o.Path, "?")
+	if !strings.HasPrefix(path, "/") || strings.HasPrefix(path, "//") {
+		return false
+	}
+
+	for _, segment := range strings.Split(path, "/") {
+		if segment == "." || segment == ".." {
+			return false
+		}
+	}
+
+	// Batches can't be nested.
+	return path != "/batch" && !strings.HasPrefix(path, "/batch/")
+}
+
+// BatchResult is the outcome of an operation of a batch. Data holds the response of the
+// operation when it succeeded, and Error its error otherwise.
+type BatchResult struct {
+	Name       string          `json:"name"`
+	StatusCode int             `json:"status_code"`
+	Data       json.RawMessage `json:"data,omitempty"`
+	Error      *AppError       `json:"error,omitempty"`
+}
+
+type BatchResponse struct {
+	Results []*BatchResult `json:"results"`
+}
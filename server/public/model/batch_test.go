@@ -0,0 +1,78 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatchRequestIsValid(t *testing.T) {
+	for name, tc := range map[string]struct {
+		operations []*BatchOperation
+		errorID    string
+	}{
+		"valid": {
+			operations: []*BatchOperation{{Name: "me", Path: "/users/me"}, {Name: "teams", Path: "/users/me/teams?page=0"}},
+		},
+		"no operations": {
+			errorID: "model.batch.is_valid.operations.app_error",
+		},
+		"nil operation": {
+			operations: []*BatchOperation{nil},
+			errorID:    "model.batch.is_valid.operations.app_error",
+		},
+		"missing name": {
+			operations: []*BatchOperation{{Path: "/users/me"}},
+			errorID:    "model.batch.is_valid.name.app_error",
+		},
+		"name too long": {
+			operations: []*BatchOperation{{Name: strings.Repeat("a", BatchOperationNameMax+1), Path: "/users/me"}},
+			errorID:    "model.batch.is_valid.name.app_error",
+		},
+		"duplicate name": {
+			operations: []*BatchOperation{{Name: "me", Path: "/users/me"}, {Name: "me", Path: "/users/me/teams"}},
+			errorID:    "model.batch.is_valid.duplicate_name.app_error",
+		},
+		"relative path": {
+			operations: []*BatchOperation{{Name: "me", Path: "users/me"}},
+			errorID:    "model.batch.is_valid.path.app_error",
+		},
+		"path leaving the API root": {
+			operations: []*BatchOperation{{Name: "me", Path: "/users/../../../static/root.html"}},
+			errorID:    "model.batch.is_valid.path.app_error",
+		},
+		"path to another host": {
+			operations: []*BatchOperation{{Name: "me", Path: "//example.com/users/me"}},
+			errorID:    "model.batch.is_valid.path.app_error",
+		},
+		"nested batch": {
+			operations: []*BatchOperation{{Name: "batch", Path: "/batch"}},
+			errorID:    "model.batch.is_valid.path.app_error",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			appErr := (&BatchRequest{Operations: tc.operations}).IsValid()
+			if tc.errorID == "" {
+				assert.Nil(t, appErr)
+				return
+			}
+			require.NotNil(t, appErr)
+			assert.Equal(t, tc.errorID, appErr.Id)
+		})
+	}
+
+	t.Run("too many operations", func(t *testing.T) {
+		operations := make([]*BatchOperation, BatchMaxOperations+1)
+		for i := range operations {
+			operations[i] = &BatchOperation{Name: NewId(), Path: "/users/me"}
+		}
+		appErr := (&BatchRequest{Operations: operations}).IsValid()
+		require.NotNil(t, appErr)
+		assert.Equal(t, "model.batch.is_valid.operations.app_error", appErr.Id)
+	})
+}
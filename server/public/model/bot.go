@@ -4,8 +4,11 @@
 package model
 
 import (
+	"database/sql/driver"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"slices"
 	"strings"
 	"unicode/utf8"
 )
@@ -16,6 +19,7 @@ const (
 	BotCreatorIdMaxRunes     = KeyValuePluginIdMaxRunes // UserId or PluginId
 	BotWarnMetricBotUsername = "mattermost-advisor"
 	BotSystemBotUsername     = "system-bot"
+	BotScopeMaxIds           = 200
 )
 
 // Bot is a special type of User meant for programmatic interactions.
@@ -31,6 +35,15 @@ type Bot struct {
 	CreateAt       int64  `json:"create_at"`
 	UpdateAt       int64  `json:"update_at"`
 	DeleteAt       int64  `json:"delete_at"`
+	// Scope restricts the teams and channels the bot can access, the bot isn't restricted when nil.
+	Scope *BotScope `json:"scope,omitempty"`
+}
+
+// BotScope restricts a bot to the channels of a set of teams, and to an explicit set of channels.
+// Direct messages with the bot are always in its scope.
+type BotScope struct {
+	TeamIds    []string `json:"team_ids"`
+	ChannelIds []string `json:"channel_ids"`
 }
 
 func (b *Bot) Auditable() map[string]interface{} {
@@ -44,6 +57,7 @@ func (b *Bot) Auditable() map[string]interface{} {
 		"create_at":        b.CreateAt,
 		"update_at":        b.UpdateAt,
 		"delete_at":        b.DeleteAt,
+		"scope":            b.Scope,
 	}
 }
 
@@ -82,6 +96,12 @@ func (b *Bot) Trace() map[string]any {
 // Clone returns a shallow copy of the bot.
 func (b *Bot) Clone() *Bot {
 	bCopy := *b
+	if b.Scope != nil {
+		bCopy.Scope = &BotScope{
+			TeamIds:    slices.Clone(b.Scope.TeamIds),
+			ChannelIds: slices.Clone(b.Scope.ChannelIds),
+		}
+	}
 	return &bCopy
 }
 
@@ -103,6 +123,12 @@ func (b *Bot) IsValidCreate() *AppError {
 		return NewAppError("Bot.IsValid", "model.bot.is_valid.creator_id.app_error", b.Trace(), "", http.StatusBadRequest)
 	}
 
+	if b.Scope != nil {
+		if appErr := b.Scope.IsValid(); appErr != nil {
+			return appErr
+		}
+	}
+
 	return nil
 }
 
@@ -227,3 +253,67 @@ func IsBotDMChannel(channel *Channel, botUserID string) bool {
 
 	return true
 }
+
+func (s *BotScope) IsValid() *AppError {
+	if len(s.TeamIds) > BotScopeMaxIds || len(s.ChannelIds) > BotScopeMaxIds {
+		return NewAppError("BotScope.IsValid", "model.bot_scope.is_valid.too_many.app_error", map[string]any{"Max": BotScopeMaxIds}, "", http.StatusBadRequest)
+	}
+
+	for _, id := range s.TeamIds {
+		if !IsValidId(id) {
+			return NewAppError("BotScope.IsValid", "model.bot_scope.is_valid.team_id.app_error", nil, "team_id="+id, http.StatusBadRequest)
+		}
+	}
+
+	for _, id := range s.ChannelIds {
+		if !IsValidId(id) {
+			return NewAppError("BotScope.IsValid", "model.bot_scope.is_valid.channel_id.app_error", nil, "channel_id="+id, http.StatusBadRequest)
+		}
+	}
+
+	return nil
+}
+
+// AllowsTeam returns whether the team is in the scope.
+func (s *BotScope) AllowsTeam(teamID string) bool {
+	return slices.Contains(s.TeamIds, teamID)
+}
+
+// AllowsChannel returns whether the channel is in the scope of the given bot.
+func (s *BotScope) AllowsChannel(channel *Channel, botUserID string) bool {
+	if slices.Contains(s.ChannelIds, channel.Id) {
+		return true
+	}
+
+	if channel.TeamId != "" {
+		return s.AllowsTeam(channel.TeamId)
+	}
+
+	return IsBotDMChannel(channel, botUserID)
+}
+
+func (s *BotScope) Scan(value any) error {
+	if value == nil {
+		return nil
+	}
+
+	buf, ok := value.([]byte)
+	if ok {
+		return json.Unmarshal(buf, s)
+	}
+
+	str, ok := value.(string)
+	if ok {
+		return json.Unmarshal([]byte(str), s)
+	}
+
+	return fmt.Errorf("received value is neither a byte slice nor string")
+}
+
+func (s BotScope) Value() (driver.Value, error) {
+	buf, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+	return string(buf), nil
+}
@@ -703,3 +703,71 @@ func TestIsBotChannel(t *testing.T) {
 		})
 	}
 }
+
+func TestBotScope(t *testing.T) {
+	botUserID := NewId()
+	teamID := NewId()
+	channelID := NewId()
+	scope := &BotScope{
+		TeamIds:    []string{teamID},
+		ChannelIds: []string{channelID},
+	}
+	require.Nil(t, scope.IsValid())
+
+	t.Run("allows channels", func(t *testing.T) {
+		assert.True(t, scope.AllowsTeam(teamID))
+		assert.False(t, scope.AllowsTeam(NewId()))
+
+		assert.True(t, scope.AllowsChannel(&Channel{Id: channelID, TeamId: NewId()}, botUserID))
+		assert.True(t, scope.AllowsChannel(&Channel{Id: NewId(), TeamId: teamID}, botUserID))
+		assert.False(t, scope.AllowsChannel(&Channel{Id: NewId(), TeamId: NewId()}, botUserID))
+
+		assert.True(t, scope.AllowsChannel(&Channel{Id: NewId(), Name: GetDMNameFromIds(botUserID, NewId()), Type: ChannelTypeDirect}, botUserID))
+		assert.False(t, scope.AllowsChannel(&Channel{Id: NewId(), Name: GetDMNameFromIds(NewId(), NewId()), Type: ChannelTypeDirect}, botUserID))
+		assert.False(t, scope.AllowsChannel(&Channel{Id: NewId(), Type: ChannelTypeGroup}, botUserID))
+	})
+
+	t.Run("invalid ids", func(t *testing.T) {
+		require.NotNil(t, (&BotScope{TeamIds: []string{"team"}}).IsValid())
+		require.NotNil(t, (&BotScope{ChannelIds: []string{"channel"}}).IsValid())
+	})
+
+	t.Run("too many ids", func(t *testing.T) {
+		tooMany := &BotScope{}
+		for i := 0; i <= BotScopeMaxIds; i++ {
+			tooMany.ChannelIds = append(tooMany.ChannelIds, NewId())
+		}
+		require.NotNil(t, tooMany.IsValid())
+	})
+
+	t.Run("scan and value", func(t *testing.T) {
+		value, err := scope.Value()
+		require.NoError(t, err)
+
+		var scanned BotScope
+		require.NoError(t, scanned.Scan(value))
+		assert.Equal(t, *scope, scanned)
+	})
+
+	t.Run("session", func(t *testing.T) {
+		session := &Session{Props: StringMap{}}
+		assert.Nil(t, session.GetBotScope())
+
+		value, err := scope.Value()
+		require.NoError(t, err)
+		session.AddProp(SessionPropBotScope, value.(string))
+		assert.Equal(t, scope, session.GetBotScope())
+
+		session.AddProp(SessionPropBotScope, "{")
+		assert.Equal(t, &BotScope{}, session.GetBotScope())
+	})
+
+	t.Run("clone", func(t *testing.T) {
+		bot := &Bot{UserId: botUserID, Scope: scope}
+		clone := bot.Clone()
+		require.Equal(t, bot, clone)
+
+		clone.Scope.ChannelIds[0] = NewId()
+		assert.Equal(t, channelID, scope.ChannelIds[0])
+	})
+}
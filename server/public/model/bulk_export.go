@@ -13,4 +13,37 @@ type BulkExportOpts struct {
 	IncludeArchivedChannels bool
 	IncludeRolesAndSchemes  bool
 	CreateArchive           bool
+
+	// StartTime and EndTime restrict the exported threads to the ones whose root post was
+	// created within [StartTime, EndTime). A zero value leaves the range open on that side.
+	StartTime int64
+	EndTime   int64
+
+	// TeamIds and ChannelIds restrict the export to the given teams and channels, and the
+	// memberships of users to them. Direct and group messages aren't exported when either
+	// filter is set, since they don't belong to a team.
+	TeamIds    []string
+	ChannelIds []string
+
+	// ChunkSize is the maximum number of lines of each JSONL file of an archive. Every chunk
+	// starts with a version line, which isn't counted, so that the chunks can be imported one
+	// after the other. Zero writes a single file.
+	ChunkSize int
+}
+
+// IsFiltered reports whether the export is restricted to some teams or channels.
+func (o *BulkExportOpts) IsFiltered() bool {
+	return len(o.TeamIds) > 0 || len(o.ChannelIds) > 0
+}
+
+// IncludesTime reports whether a thread with a root post created at the given time
+// is within the exported date range.
+func (o *BulkExportOpts) IncludesTime(createAt int64) bool {
+	if o.StartTime > 0 && createAt < o.StartTime {
+		return false
+	}
+	if o.EndTime > 0 && createAt >= o.EndTime {
+		return false
+	}
+	return true
 }
@@ -0,0 +1,36 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBulkExportOptsIncludesTime(t *testing.T) {
+	for name, tc := range map[string]struct {
+		opts     BulkExportOpts
+		createAt int64
+		expected bool
+	}{
+		"no range":            {BulkExportOpts{}, 1, true},
+		"before start":        {BulkExportOpts{StartTime: 10}, 9, false},
+		"at start":            {BulkExportOpts{StartTime: 10}, 10, true},
+		"before end":          {BulkExportOpts{EndTime: 10}, 9, true},
+		"at end":              {BulkExportOpts{EndTime: 10}, 10, false},
+		"within closed range": {BulkExportOpts{StartTime: 10, EndTime: 20}, 15, true},
+		"after closed range":  {BulkExportOpts{StartTime: 10, EndTime: 20}, 25, false},
+	} {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.opts.IncludesTime(tc.createAt))
+		})
+	}
+}
+
+func TestBulkExportOptsIsFiltered(t *testing.T) {
+	assert.False(t, (&BulkExportOpts{StartTime: 1}).IsFiltered())
+	assert.True(t, (&BulkExportOpts{TeamIds: []string{NewId()}}).IsFiltered())
+	assert.True(t, (&BulkExportOpts{ChannelIds: []string{NewId()}}).IsFiltered())
+}
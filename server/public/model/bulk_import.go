@@ -0,0 +1,68 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+)
+
+type BulkImportOpts struct {
+	DryRun         bool
+	ExtractContent bool
+	Workers        int
+	// ImportPath is the path the attachments of the import are relative to.
+	ImportPath string
+
+	// ResumeFromLine skips the lines of the file before the given line, apart from the version
+	// line. It's the checkpoint recorded by a previous run of the same import.
+	ResumeFromLine int
+
+	// TotalLines is the number of lines of the whole import and PreviousLines the number of
+	// lines of the files of the import that were already processed. They're used to report
+	// the progress of the import job.
+	TotalLines    int
+	PreviousLines int
+}
+
+// ImportMergeMapping describes how the users and teams of an export from another instance
+// are renamed when it's merged into this one, so that they don't collide with the existing
+// ones. The keys are the names on the exported instance and the values the names to use.
+type ImportMergeMapping struct {
+	Users map[string]string `json:"users"`
+	Teams map[string]string `json:"teams"`
+
+	// SourceSiteURL is the site URL of the exported instance. The permalinks to it are
+	// translated to point to the imported posts.
+	SourceSiteURL string `json:"source_site_url"`
+}
+
+func (m *ImportMergeMapping) IsValid() *AppError {
+	usernames := make(map[string]bool, len(m.Users))
+	for from, to := range m.Users {
+		if from == "" || !IsValidUsername(to) {
+			return NewAppError("ImportMergeMapping.IsValid", "model.import_merge_mapping.is_valid.username.app_error", map[string]any{"Username": to}, "", http.StatusBadRequest)
+		}
+		if usernames[to] {
+			return NewAppError("ImportMergeMapping.IsValid", "model.import_merge_mapping.is_valid.duplicate_username.app_error", map[string]any{"Username": to}, "", http.StatusBadRequest)
+		}
+		usernames[to] = true
+	}
+
+	teamNames := make(map[string]bool, len(m.Teams))
+	for from, to := range m.Teams {
+		if from == "" || !IsValidTeamName(to) {
+			return NewAppError("ImportMergeMapping.IsValid", "model.import_merge_mapping.is_valid.team_name.app_error", map[string]any{"Name": to}, "", http.StatusBadRequest)
+		}
+		if teamNames[to] {
+			return NewAppError("ImportMergeMapping.IsValid", "model.import_merge_mapping.is_valid.duplicate_team_name.app_error", map[string]any{"Name": to}, "", http.StatusBadRequest)
+		}
+		teamNames[to] = true
+	}
+
+	if m.SourceSiteURL != "" && !IsValidHTTPURL(m.SourceSiteURL) {
+		return NewAppError("ImportMergeMapping.IsValid", "model.import_merge_mapping.is_valid.source_site_url.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportMergeMappingIsValid(t *testing.T) {
+	for name, tc := range map[string]struct {
+		mapping ImportMergeMapping
+		errorId string
+	}{
+		"empty": {
+			mapping: ImportMergeMapping{},
+		},
+		"valid": {
+			mapping: ImportMergeMapping{
+				Users:         map[string]string{"john": "john.acme", "jane": "jane.acme"},
+				Teams:         map[string]string{"engineering": "acme-engineering"},
+				SourceSiteURL: "https://chat.acme.com",
+			},
+		},
+		"invalid username": {
+			mapping: ImportMergeMapping{Users: map[string]string{"john": "John Doe"}},
+			errorId: "model.import_merge_mapping.is_valid.username.app_error",
+		},
+		"duplicate username": {
+			mapping: ImportMergeMapping{Users: map[string]string{"john": "johnny", "jon": "johnny"}},
+			errorId: "model.import_merge_mapping.is_valid.duplicate_username.app_error",
+		},
+		"invalid team name": {
+			mapping: ImportMergeMapping{Teams: map[string]string{"engineering": "Engineering Team"}},
+			errorId: "model.import_merge_mapping.is_valid.team_name.app_error",
+		},
+		"duplicate team name": {
+			mapping: ImportMergeMapping{Teams: map[string]string{"eng": "acme-eng", "engineering": "acme-eng"}},
+			errorId: "model.import_merge_mapping.is_valid.duplicate_team_name.app_error",
+		},
+		"invalid site url": {
+			mapping: ImportMergeMapping{SourceSiteURL: "chat.acme.com"},
+			errorId: "model.import_merge_mapping.is_valid.source_site_url.app_error",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			appErr := tc.mapping.IsValid()
+			if tc.errorId == "" {
+				require.Nil(t, appErr)
+				return
+			}
+			require.NotNil(t, appErr)
+			assert.Equal(t, tc.errorId, appErr.Id)
+		})
+	}
+}
@@ -4,6 +4,7 @@
 package model
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"strings"
@@ -166,6 +167,25 @@ func IsChannelMemberNotifyPropsValid(notifyProps map[string]string, allowMissing
 		}
 	}
 
+	if quietHours, ok := notifyProps[QuietHoursNotifyProp]; ok && quietHours != "" {
+		var schedule ChannelQuietHours
+		if err := json.Unmarshal([]byte(quietHours), &schedule); err != nil {
+			return NewAppError("ChannelMember.IsValid", "model.channel_member.is_valid.quiet_hours_value.app_error", nil, "", http.StatusBadRequest).Wrap(err)
+		}
+		if schedule.Enabled {
+			if appErr := schedule.IsValid(); appErr != nil {
+				return appErr
+			}
+		}
+	}
+
+	if mutedKeywords, ok := notifyProps[MutedKeywordsNotifyProp]; ok {
+		if !isChannelMutedKeywordsValid(mutedKeywords) {
+			return NewAppError("ChannelMember.IsValid", "model.channel_member.is_valid.muted_keywords_value.app_error",
+				map[string]any{"MaxCount": ChannelMutedKeywordsMaxCount, "MaxLength": ChannelMutedKeywordsMaxLength}, "", http.StatusBadRequest)
+		}
+	}
+
 	jsonStringNotifyProps := string(ToJSON(notifyProps))
 	if utf8.RuneCountInString(jsonStringNotifyProps) > ChannelMemberNotifyPropsMaxRunes {
 		return NewAppError("ChannelMember.IsValid", "model.channel_member.is_valid.notify_props.app_error", nil, fmt.Sprint("length=", utf8.RuneCountInString(jsonStringNotifyProps)), http.StatusBadRequest)
@@ -236,3 +256,39 @@ type ChannelMemberIdentifier struct {
 	ChannelId string `json:"channel_id"`
 	UserId    string `json:"user_id"`
 }
+
+// ChannelMembersGetOptions pages through a channel's members in user id order
+// without relying on an offset, so very large channels can be walked cheaply.
+type ChannelMembersGetOptions struct {
+	// Return only members whose user id sorts after this one.
+	AfterUserId string
+
+	// Restrict to members whose username, first name, last name or nickname
+	// starts with this term. First and last names are only matched if
+	// AllowFullNames is set.
+	Term string
+
+	// AllowFullNames allows Term to match the full names of users, vs. just
+	// usernames and nicknames.
+	AllowFullNames bool
+
+	// Restrict to members holding one of ChannelAdminRoleId, ChannelUserRoleId
+	// or ChannelGuestRoleId through the channel scheme.
+	Role string
+
+	Limit int
+}
+
+func (o *ChannelMembersGetOptions) IsValid() *AppError {
+	switch o.Role {
+	case "", ChannelAdminRoleId, ChannelUserRoleId, ChannelGuestRoleId:
+	default:
+		return NewAppError("ChannelMembersGetOptions.IsValid", "model.channel_member.get_options.role.app_error", nil, "role="+o.Role, http.StatusBadRequest)
+	}
+
+	if o.AfterUserId != "" && !IsValidId(o.AfterUserId) {
+		return NewAppError("ChannelMembersGetOptions.IsValid", "model.channel_member.get_options.after.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	return nil
+}
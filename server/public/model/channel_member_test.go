@@ -57,4 +57,28 @@ func TestIsChannelMemberNotifyPropsValid(t *testing.T) {
 		err = IsChannelMemberNotifyPropsValid(notifyProps, true)
 		assert.Nil(t, err)
 	})
+
+	t.Run("quiet hours", func(t *testing.T) {
+		assert.Nil(t, IsChannelMemberNotifyPropsValid(map[string]string{QuietHoursNotifyProp: ""}, true))
+		assert.Nil(t, IsChannelMemberNotifyPropsValid(map[string]string{QuietHoursNotifyProp: `{"enabled":true,"start":"22:00","end":"07:00"}`}, true))
+		assert.Nil(t, IsChannelMemberNotifyPropsValid(map[string]string{QuietHoursNotifyProp: `{"enabled":false}`}, true))
+
+		assert.NotNil(t, IsChannelMemberNotifyPropsValid(map[string]string{QuietHoursNotifyProp: "22:00-07:00"}, true))
+		assert.NotNil(t, IsChannelMemberNotifyPropsValid(map[string]string{QuietHoursNotifyProp: `{"enabled":true,"start":"22:00"}`}, true))
+	})
+
+	t.Run("muted keywords", func(t *testing.T) {
+		assert.Nil(t, IsChannelMemberNotifyPropsValid(map[string]string{MutedKeywordsNotifyProp: "resolved,build passed"}, true))
+
+		assert.NotNil(t, IsChannelMemberNotifyPropsValid(map[string]string{MutedKeywordsNotifyProp: strings.Repeat("a,", ChannelMutedKeywordsMaxCount)}, true))
+		assert.NotNil(t, IsChannelMemberNotifyPropsValid(map[string]string{MutedKeywordsNotifyProp: strings.Repeat("a", ChannelMutedKeywordsMaxLength+1)}, true))
+	})
+}
+
+func TestChannelMembersGetOptionsIsValid(t *testing.T) {
+	assert.Nil(t, (&ChannelMembersGetOptions{}).IsValid())
+	assert.Nil(t, (&ChannelMembersGetOptions{AfterUserId: NewId(), Role: ChannelAdminRoleId}).IsValid())
+
+	assert.NotNil(t, (&ChannelMembersGetOptions{Role: SystemAdminRoleId}).IsValid())
+	assert.NotNil(t, (&ChannelMembersGetOptions{AfterUserId: "junk"}).IsValid())
 }
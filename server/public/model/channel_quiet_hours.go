@@ -0,0 +1,154 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	// QuietHoursNotifyProp holds the JSON encoded ChannelQuietHours of a channel member.
+	QuietHoursNotifyProp = "quiet_hours"
+	// MutedKeywordsNotifyProp holds the comma separated keywords that don't trigger
+	// notifications when they appear in a post of the channel.
+	MutedKeywordsNotifyProp = "muted_keywords"
+
+	ChannelMutedKeywordsMaxCount  = 50
+	ChannelMutedKeywordsMaxLength = 64
+)
+
+// ChannelQuietHours is the daily window during which the activity of a channel doesn't
+// notify a member, except when the member is explicitly mentioned.
+type ChannelQuietHours struct {
+	Enabled bool `json:"enabled"`
+	// Start and End are times of the day in the 15:04 format, in the timezone of the user.
+	// The window spans midnight when End is before Start.
+	Start string `json:"start"`
+	End   string `json:"end"`
+	// Days are the days of the week, 0 being Sunday, on which the window starts. All days
+	// are included when empty.
+	Days []int `json:"days,omitempty"`
+}
+
+func (q *ChannelQuietHours) IsValid() *AppError {
+	start, startErr := parseQuietHoursTime(q.Start)
+	end, endErr := parseQuietHoursTime(q.End)
+	if startErr != nil || endErr != nil || start == end {
+		return NewAppError("ChannelQuietHours.IsValid", "model.channel_quiet_hours.is_valid.time.app_error", nil, "start="+q.Start+", end="+q.End, http.StatusBadRequest)
+	}
+
+	for _, day := range q.Days {
+		if day < int(time.Sunday) || day > int(time.Saturday) {
+			return NewAppError("ChannelQuietHours.IsValid", "model.channel_quiet_hours.is_valid.days.app_error", nil, "", http.StatusBadRequest)
+		}
+	}
+
+	return nil
+}
+
+// IsActive reports whether now, in the given location, falls within the quiet hours.
+func (q *ChannelQuietHours) IsActive(now time.Time, loc *time.Location) bool {
+	if !q.Enabled {
+		return false
+	}
+	start, err := parseQuietHoursTime(q.Start)
+	if err != nil {
+		return false
+	}
+	end, err := parseQuietHoursTime(q.End)
+	if err != nil {
+		return false
+	}
+
+	now = now.In(loc)
+	minutes := now.Hour()*60 + now.Minute()
+	today := now.Weekday()
+
+	if start < end {
+		return minutes >= start && minutes < end && q.includesDay(today)
+	}
+
+	// The window spans midnight, so the early hours belong to the window of the day before.
+	if minutes >= start {
+		return q.includesDay(today)
+	}
+	if minutes < end {
+		return q.includesDay((today + 6) % 7)
+	}
+	return false
+}
+
+func (q *ChannelQuietHours) includesDay(day time.Weekday) bool {
+	if len(q.Days) == 0 {
+		return true
+	}
+	for _, d := range q.Days {
+		if d == int(day) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseQuietHoursTime returns the number of minutes since midnight of a 15:04 formatted time.
+func parseQuietHoursTime(value string) (int, error) {
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, err
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// ChannelQuietHoursFromNotifyProps returns the quiet hours of a channel member, or nil if
+// they aren't set or are invalid.
+func ChannelQuietHoursFromNotifyProps(notifyProps StringMap) *ChannelQuietHours {
+	value := notifyProps[QuietHoursNotifyProp]
+	if value == "" {
+		return nil
+	}
+	var quietHours ChannelQuietHours
+	if err := json.Unmarshal([]byte(value), &quietHours); err != nil || quietHours.IsValid() != nil {
+		return nil
+	}
+	return &quietHours
+}
+
+// ChannelMutedKeywordsFromNotifyProps returns the lowercased muted keywords of a channel member.
+func ChannelMutedKeywordsFromNotifyProps(notifyProps StringMap) []string {
+	var keywords []string
+	for _, keyword := range strings.Split(notifyProps[MutedKeywordsNotifyProp], ",") {
+		if keyword = strings.ToLower(strings.TrimSpace(keyword)); keyword != "" {
+			keywords = append(keywords, keyword)
+		}
+	}
+	return keywords
+}
+
+// MessageContainsMutedKeyword reports whether the message contains one of the muted keywords,
+// ignoring case. Like mention keywords, a keyword only matches whole words, so "db" doesn't
+// match "feedback".
+func MessageContainsMutedKeyword(message string, keywords []string) bool {
+	for _, keyword := range keywords {
+		if containsWord(message, keyword) {
+			return true
+		}
+	}
+	return false
+}
+
+func isChannelMutedKeywordsValid(value string) bool {
+	keywords := strings.Split(value, ",")
+	if len(keywords) > ChannelMutedKeywordsMaxCount {
+		return false
+	}
+	for _, keyword := range keywords {
+		if len(strings.TrimSpace(keyword)) > ChannelMutedKeywordsMaxLength {
+			return false
+		}
+	}
+	return true
+}
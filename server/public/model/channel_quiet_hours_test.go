@@ -0,0 +1,100 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChannelQuietHoursIsValid(t *testing.T) {
+	assert.Nil(t, (&ChannelQuietHours{Enabled: true, Start: "22:00", End: "07:00"}).IsValid())
+	assert.Nil(t, (&ChannelQuietHours{Enabled: true, Start: "09:30", End: "17:45", Days: []int{1, 2, 3, 4, 5}}).IsValid())
+
+	assert.NotNil(t, (&ChannelQuietHours{Enabled: true, Start: "22:00", End: "22:00"}).IsValid())
+	assert.NotNil(t, (&ChannelQuietHours{Enabled: true, Start: "24:00", End: "07:00"}).IsValid())
+	assert.NotNil(t, (&ChannelQuietHours{Enabled: true, Start: "10pm", End: "07:00"}).IsValid())
+	assert.NotNil(t, (&ChannelQuietHours{Enabled: true, Start: "22:00"}).IsValid())
+	assert.NotNil(t, (&ChannelQuietHours{Enabled: true, Start: "22:00", End: "07:00", Days: []int{7}}).IsValid())
+}
+
+func TestChannelQuietHoursIsActive(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+	// 2024-05-06 is a Monday.
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2024, 5, day, hour, minute, 0, 0, loc)
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		q := &ChannelQuietHours{Start: "00:00", End: "23:59"}
+		assert.False(t, q.IsActive(at(6, 12, 0), loc))
+	})
+
+	t.Run("same day window", func(t *testing.T) {
+		q := &ChannelQuietHours{Enabled: true, Start: "09:00", End: "17:00"}
+		assert.False(t, q.IsActive(at(6, 8, 59), loc))
+		assert.True(t, q.IsActive(at(6, 9, 0), loc))
+		assert.True(t, q.IsActive(at(6, 16, 59), loc))
+		assert.False(t, q.IsActive(at(6, 17, 0), loc))
+	})
+
+	t.Run("overnight window", func(t *testing.T) {
+		q := &ChannelQuietHours{Enabled: true, Start: "22:00", End: "07:00"}
+		assert.False(t, q.IsActive(at(6, 21, 59), loc))
+		assert.True(t, q.IsActive(at(6, 22, 0), loc))
+		assert.True(t, q.IsActive(at(7, 3, 0), loc))
+		assert.False(t, q.IsActive(at(7, 7, 0), loc))
+	})
+
+	t.Run("days", func(t *testing.T) {
+		// Weeknights only, so the window starting Friday evening is the last one.
+		q := &ChannelQuietHours{Enabled: true, Start: "22:00", End: "07:00", Days: []int{1, 2, 3, 4, 5}}
+		assert.True(t, q.IsActive(at(10, 23, 0), loc), "Friday night")
+		assert.True(t, q.IsActive(at(11, 6, 0), loc), "early Saturday belongs to Friday")
+		assert.False(t, q.IsActive(at(11, 23, 0), loc), "Saturday night")
+		assert.False(t, q.IsActive(at(12, 23, 0), loc), "Sunday night")
+		assert.False(t, q.IsActive(at(6, 6, 0), loc), "early Monday belongs to Sunday")
+	})
+
+	t.Run("timezone", func(t *testing.T) {
+		q := &ChannelQuietHours{Enabled: true, Start: "22:00", End: "07:00"}
+		// 03:00 UTC is 23:00 the day before in New York.
+		assert.True(t, q.IsActive(time.Date(2024, 5, 7, 3, 0, 0, 0, time.UTC), loc))
+		assert.False(t, q.IsActive(time.Date(2024, 5, 7, 3, 0, 0, 0, time.UTC), time.FixedZone("UTC+8", 8*60*60)))
+	})
+}
+
+func TestChannelQuietHoursFromNotifyProps(t *testing.T) {
+	assert.Nil(t, ChannelQuietHoursFromNotifyProps(StringMap{}))
+	assert.Nil(t, ChannelQuietHoursFromNotifyProps(StringMap{QuietHoursNotifyProp: "invalid"}))
+	assert.Nil(t, ChannelQuietHoursFromNotifyProps(StringMap{QuietHoursNotifyProp: `{"enabled":true,"start":"22:00","end":"22:00"}`}))
+
+	q := ChannelQuietHoursFromNotifyProps(StringMap{QuietHoursNotifyProp: `{"enabled":true,"start":"22:00","end":"07:00","days":[0,6]}`})
+	require.NotNil(t, q)
+	assert.Equal(t, &ChannelQuietHours{Enabled: true, Start: "22:00", End: "07:00", Days: []int{0, 6}}, q)
+}
+
+func TestChannelMutedKeywords(t *testing.T) {
+	keywords := ChannelMutedKeywordsFromNotifyProps(StringMap{MutedKeywordsNotifyProp: " Resolved ,, build passed,"})
+	assert.Equal(t, []string{"resolved", "build passed"}, keywords)
+	assert.Empty(t, ChannelMutedKeywordsFromNotifyProps(StringMap{}))
+
+	assert.True(t, MessageContainsMutedKeyword("[RESOLVED] disk usage", keywords))
+	assert.True(t, MessageContainsMutedKeyword("nightly Build Passed", keywords))
+	assert.False(t, MessageContainsMutedKeyword("build failed", keywords))
+	assert.False(t, MessageContainsMutedKeyword("resolved", nil))
+
+	t.Run("whole words only", func(t *testing.T) {
+		keywords := []string{"db"}
+		assert.False(t, MessageContainsMutedKeyword("thanks for the feedback", keywords))
+		assert.False(t, MessageContainsMutedKeyword("dbadmin is away", keywords))
+		assert.True(t, MessageContainsMutedKeyword("feedback: DB is down", keywords))
+		assert.True(t, MessageContainsMutedKeyword("db", keywords))
+		assert.True(t, MessageContainsMutedKeyword("restarting the (db).", keywords))
+	})
+}
@@ -76,6 +76,18 @@ type SidebarChannel struct {
 type SidebarChannels []*SidebarChannel
 type SidebarCategoriesWithChannels []*SidebarCategoryWithChannels
 
+// SidebarCategoryUnread rolls up the unread messages and mentions of the channels of a sidebar
+// category. Like the sidebar, muted channels count their mentions but not their messages.
+type SidebarCategoryUnread struct {
+	CategoryId         string `json:"category_id"`
+	TeamId             string `json:"team_id"`
+	MsgCount           int64  `json:"msg_count"`
+	MsgCountRoot       int64  `json:"msg_count_root"`
+	MentionCount       int64  `json:"mention_count"`
+	MentionCountRoot   int64  `json:"mention_count_root"`
+	UrgentMentionCount int64  `json:"urgent_mention_count"`
+}
+
 var categoryIdPattern = regexp.MustCompile("(favorites|channels|direct_messages)_[a-z0-9]{26}_[a-z0-9]{26}")
 
 func IsValidCategoryId(s string) bool {
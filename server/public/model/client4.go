@@ -344,6 +344,10 @@ func (c *Client4) testEmailRoute() string {
 	return "/email/test"
 }
 
+func (c *Client4) emailTemplatesRoute() string {
+	return "/email/templates"
+}
+
 func (c *Client4) usageRoute() string {
 	return "/usage"
 }
@@ -444,6 +448,22 @@ func (c *Client4) commandRoute(commandId string) string {
 	return fmt.Sprintf(c.commandsRoute()+"/%v", commandId)
 }
 
+func (c *Client4) automationRulesForTeamRoute(teamId string) string {
+	return c.teamRoute(teamId) + "/automation_rules"
+}
+
+func (c *Client4) automationRuleRoute(ruleId string) string {
+	return fmt.Sprintf("/automation_rules/%v", ruleId)
+}
+
+func (c *Client4) customProfileAttributeFieldsRoute() string {
+	return "/custom_profile_attributes/fields"
+}
+
+func (c *Client4) customProfileAttributeFieldRoute(fieldId string) string {
+	return fmt.Sprintf(c.customProfileAttributeFieldsRoute()+"/%v", fieldId)
+}
+
 func (c *Client4) commandMoveRoute(commandId string) string {
 	return fmt.Sprintf(c.commandsRoute()+"/%v/move", commandId)
 }
@@ -488,6 +508,14 @@ func (c *Client4) jobsRoute() string {
 	return "/jobs"
 }
 
+func (c *Client4) featureFlagsRoute() string {
+	return "/feature_flags"
+}
+
+func (c *Client4) featureFlagRoute(name string) string {
+	return fmt.Sprintf(c.featureFlagsRoute()+"/%v", name)
+}
+
 func (c *Client4) rolesRoute() string {
 	return "/roles"
 }
@@ -560,6 +588,10 @@ func (c *Client4) importsRoute() string {
 	return "/imports"
 }
 
+func (c *Client4) importRoute(name string) string {
+	return fmt.Sprintf(c.importsRoute()+"/%v", name)
+}
+
 func (c *Client4) exportsRoute() string {
 	return "/exports"
 }
@@ -1707,6 +1739,45 @@ func (c *Client4) AttachDeviceId(ctx context.Context, deviceId string) (*Respons
 	return BuildResponse(r), nil
 }
 
+// AttachWebPushSubscription attaches the Web Push subscription of the browser to the current session.
+func (c *Client4) AttachWebPushSubscription(ctx context.Context, subscription *WebPushSubscription) (*Response, error) {
+	buf, err := json.Marshal(subscription)
+	if err != nil {
+		return nil, NewAppError("AttachWebPushSubscription", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	r, err := c.DoAPIPutBytes(ctx, c.usersRoute()+"/sessions/web_push", buf)
+	if err != nil {
+		return BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return BuildResponse(r), nil
+}
+
+// DetachWebPushSubscription removes the Web Push subscription of the current session.
+func (c *Client4) DetachWebPushSubscription(ctx context.Context) (*Response, error) {
+	r, err := c.DoAPIDelete(ctx, c.usersRoute()+"/sessions/web_push")
+	if err != nil {
+		return BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return BuildResponse(r), nil
+}
+
+// GetWebPushVAPIDKey returns the public key browsers need to subscribe to the Web Push notifications of the server.
+func (c *Client4) GetWebPushVAPIDKey(ctx context.Context) (*WebPushVAPIDKey, *Response, error) {
+	r, err := c.DoAPIGet(ctx, c.usersRoute()+"/sessions/web_push/vapid_key", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var key WebPushVAPIDKey
+	if err := json.NewDecoder(r.Body).Decode(&key); err != nil {
+		return nil, nil, NewAppError("GetWebPushVAPIDKey", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &key, BuildResponse(r), nil
+}
+
 // GetTeamsUnreadForUser will return an array with TeamUnread objects that contain the amount
 // of unread messages and mentions the current user has for the teams it belongs to.
 // An optional team ID can be set to exclude that team from the results.
@@ -1752,6 +1823,56 @@ func (c *Client4) GetUserAudits(ctx context.Context, userId string, page int, pe
 	return audits, BuildResponse(r), nil
 }
 
+// GetNotificationDeliveries returns the recorded outcomes of the notifications sent to a user, most recent first.
+func (c *Client4) GetNotificationDeliveries(ctx context.Context, userId string, opts NotificationDeliveryGetOptions) ([]*NotificationDelivery, *Response, error) {
+	query := url.Values{}
+	query.Set("page", strconv.Itoa(opts.Page))
+	query.Set("per_page", strconv.Itoa(opts.PerPage))
+	if opts.Since > 0 {
+		query.Set("since", strconv.FormatInt(opts.Since, 10))
+	}
+	if opts.PostId != "" {
+		query.Set("post_id", opts.PostId)
+	}
+	r, err := c.DoAPIGet(ctx, c.userRoute(userId)+"/notifications/history?"+query.Encode(), "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var deliveries []*NotificationDelivery
+	if err := json.NewDecoder(r.Body).Decode(&deliveries); err != nil {
+		return nil, nil, NewAppError("GetNotificationDeliveries", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return deliveries, BuildResponse(r), nil
+}
+
+// GetUserEmailStatus returns whether the email address of the user was reported as undeliverable
+// by the email provider.
+func (c *Client4) GetUserEmailStatus(ctx context.Context, userId string) (*UserEmailStatus, *Response, error) {
+	r, err := c.DoAPIGet(ctx, c.userRoute(userId)+"/email/status", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var status UserEmailStatus
+	if err := json.NewDecoder(r.Body).Decode(&status); err != nil {
+		return nil, nil, NewAppError("GetUserEmailStatus", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &status, BuildResponse(r), nil
+}
+
+// ClearUserEmailUndeliverable resumes sending emails to the email address of the user.
+func (c *Client4) ClearUserEmailUndeliverable(ctx context.Context, userId string) (*Response, error) {
+	r, err := c.DoAPIDelete(ctx, c.userRoute(userId)+"/email/status")
+	if err != nil {
+		return BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return BuildResponse(r), nil
+}
+
 // VerifyUserEmail will verify a user's email using the supplied token.
 func (c *Client4) VerifyUserEmail(ctx context.Context, token string) (*Response, error) {
 	requestBody := map[string]string{"token": token}
@@ -1967,6 +2088,39 @@ func (c *Client4) EnableUserAccessToken(ctx context.Context, tokenId string) (*R
 	return BuildResponse(r), nil
 }
 
+// RotateUserAccessToken issues a new token for the user of the given token, with the same
+// description. The given token stays valid for the grace period configured on the server.
+// An expiresAt of 0 creates a token that never expires.
+func (c *Client4) RotateUserAccessToken(ctx context.Context, tokenId string, expiresAt int64) (*UserAccessToken, *Response, error) {
+	requestBody := map[string]any{"token_id": tokenId, "expires_at": expiresAt}
+	r, err := c.DoAPIPost(ctx, c.usersRoute()+"/tokens/rotate", StringInterfaceToJSON(requestBody))
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var uat UserAccessToken
+	if err := json.NewDecoder(r.Body).Decode(&uat); err != nil {
+		return nil, nil, NewAppError("RotateUserAccessToken", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &uat, BuildResponse(r), nil
+}
+
+// GetExpiringUserAccessTokens returns a page of the active tokens expiring within the given
+// number of days, including the ones already expired. Must have the manage_system permission.
+func (c *Client4) GetExpiringUserAccessTokens(ctx context.Context, withinDays, page, perPage int) ([]*UserAccessToken, *Response, error) {
+	query := fmt.Sprintf("?within_days=%v&page=%v&per_page=%v", withinDays, page, perPage)
+	r, err := c.DoAPIGet(ctx, c.usersRoute()+"/tokens/expiring"+query, "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var list []*UserAccessToken
+	if err := json.NewDecoder(r.Body).Decode(&list); err != nil {
+		return nil, nil, NewAppError("GetExpiringUserAccessTokens", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return list, BuildResponse(r), nil
+}
+
 func (c *Client4) GetUsersForReporting(ctx context.Context, options *UserReportOptions) ([]*UserReport, *Response, error) {
 	values := url.Values{}
 	if options.Direction != "" {
@@ -2198,6 +2352,27 @@ func (c *Client4) AssignBot(ctx context.Context, botUserId, newOwnerId string) (
 	return bot, BuildResponse(r), nil
 }
 
+// UpdateBotScope restricts the given bot to a set of teams and channels, or lifts the restriction when scope is nil.
+func (c *Client4) UpdateBotScope(ctx context.Context, botUserId string, scope *BotScope) (*Bot, *Response, error) {
+	buf, err := json.Marshal(scope)
+	if err != nil {
+		return nil, nil, NewAppError("UpdateBotScope", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	r, err := c.DoAPIPutBytes(ctx, c.botRoute(botUserId)+"/scope", buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var bot *Bot
+	err = json.NewDecoder(r.Body).Decode(&bot)
+	if err != nil {
+		return nil, BuildResponse(r), NewAppError("UpdateBotScope", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return bot, BuildResponse(r), nil
+}
+
 // Team Section
 
 // CreateTeam creates a team in the system based on the provided team struct.
@@ -3554,6 +3729,35 @@ func (c *Client4) GetChannelMembers(ctx context.Context, channelId string, page,
 	return ch, BuildResponse(r), nil
 }
 
+// GetChannelMembersAfter gets the channel members whose user id sorts after
+// opts.AfterUserId, optionally filtered by term and role.
+func (c *Client4) GetChannelMembersAfter(ctx context.Context, channelId string, opts *ChannelMembersGetOptions) (ChannelMembers, *Response, error) {
+	query := url.Values{}
+	query.Set("after", opts.AfterUserId)
+	if opts.Term != "" {
+		query.Set("term", opts.Term)
+	}
+	if opts.Role != "" {
+		query.Set("role", opts.Role)
+	}
+	if opts.Limit > 0 {
+		query.Set("per_page", strconv.Itoa(opts.Limit))
+	}
+
+	r, err := c.DoAPIGet(ctx, c.channelMembersRoute(channelId)+"?"+query.Encode(), "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var ch ChannelMembers
+	err = json.NewDecoder(r.Body).Decode(&ch)
+	if err != nil {
+		return nil, BuildResponse(r), NewAppError("GetChannelMembersAfter", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return ch, BuildResponse(r), nil
+}
+
 // GetChannelMembersWithTeamData gets a page of all channel members for a user.
 func (c *Client4) GetChannelMembersWithTeamData(ctx context.Context, userID string, page, perPage int) (ChannelMembersWithTeamData, *Response, error) {
 	query := fmt.Sprintf("?page=%v&per_page=%v", page, perPage)
@@ -4423,6 +4627,26 @@ func (c *Client4) SubmitInteractiveDialog(ctx context.Context, request SubmitDia
 	return &resp, BuildResponse(r), nil
 }
 
+// LookupInteractiveDialog will fetch the options of a dynamic select element of an
+// interactive dialog from the integration configured by the URL.
+func (c *Client4) LookupInteractiveDialog(ctx context.Context, request DialogLookupRequest) (*DialogLookupResponse, *Response, error) {
+	b, err := json.Marshal(request)
+	if err != nil {
+		return nil, nil, NewAppError("LookupInteractiveDialog", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	r, err := c.DoAPIPost(ctx, "/actions/dialogs/lookup", string(b))
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var resp DialogLookupResponse
+	if err := json.NewDecoder(r.Body).Decode(&resp); err != nil {
+		return nil, nil, NewAppError("LookupInteractiveDialog", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &resp, BuildResponse(r), nil
+}
+
 // UploadFile will upload a file to a channel using a multipart request, to be later attached to a post.
 // This method is functionally equivalent to Client4.UploadFileAsRequestBody.
 func (c *Client4) UploadFile(ctx context.Context, data []byte, channelId string, filename string) (*FileUploadResponse, *Response, error) {
@@ -4577,6 +4801,50 @@ func (c *Client4) GetFileInfo(ctx context.Context, fileId string) (*FileInfo, *R
 	return &fi, BuildResponse(r), nil
 }
 
+// CreateFileShareLink creates a temporary public link to a file.
+func (c *Client4) CreateFileShareLink(ctx context.Context, fileId string, linkRequest *FileShareLinkRequest) (*FileShareLink, *Response, error) {
+	buf, err := json.Marshal(linkRequest)
+	if err != nil {
+		return nil, nil, NewAppError("CreateFileShareLink", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	r, err := c.DoAPIPostBytes(ctx, c.fileRoute(fileId)+"/share", buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var link FileShareLink
+	if err := json.NewDecoder(r.Body).Decode(&link); err != nil {
+		return nil, nil, NewAppError("CreateFileShareLink", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &link, BuildResponse(r), nil
+}
+
+// GetFileShareLinks gets the share links of a file that haven't been revoked.
+func (c *Client4) GetFileShareLinks(ctx context.Context, fileId string) ([]*FileShareLink, *Response, error) {
+	r, err := c.DoAPIGet(ctx, c.fileRoute(fileId)+"/share", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var links []*FileShareLink
+	if err := json.NewDecoder(r.Body).Decode(&links); err != nil {
+		return nil, nil, NewAppError("GetFileShareLinks", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return links, BuildResponse(r), nil
+}
+
+// RevokeFileShareLink revokes a share link of a file.
+func (c *Client4) RevokeFileShareLink(ctx context.Context, fileId, linkId string) (*Response, error) {
+	r, err := c.DoAPIDelete(ctx, c.fileRoute(fileId)+"/share/"+linkId)
+	if err != nil {
+		return BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return BuildResponse(r), nil
+}
+
 // GetFileInfosForPost gets all the file info objects attached to a post.
 func (c *Client4) GetFileInfosForPost(ctx context.Context, postId string, etag string) ([]*FileInfo, *Response, error) {
 	r, err := c.DoAPIGet(ctx, c.postRoute(postId)+"/files/info", etag)
@@ -4682,6 +4950,22 @@ func (c *Client4) GetPingWithOptions(ctx context.Context, options SystemPingOpti
 	return MapFromJSON(r.Body), BuildResponse(r), nil
 }
 
+// GetPingWithDependencies returns the health of the server and of each of the services it depends
+// on, such as the database, the file store or the SMTP server.
+func (c *Client4) GetPingWithDependencies(ctx context.Context) (*SystemHealth, *Response, error) {
+	r, err := c.DoAPIGet(ctx, c.systemRoute()+"/ping?deep=true&use_rest_semantics=true", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var health SystemHealth
+	if err := json.NewDecoder(r.Body).Decode(&health); err != nil {
+		return nil, nil, NewAppError("GetPingWithDependencies", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &health, BuildResponse(r), nil
+}
+
 // TestEmail will attempt to connect to the configured SMTP server.
 func (c *Client4) TestEmail(ctx context.Context, config *Config) (*Response, error) {
 	buf, err := json.Marshal(config)
@@ -4696,6 +4980,59 @@ func (c *Client4) TestEmail(ctx context.Context, config *Config) (*Response, err
 	return BuildResponse(r), nil
 }
 
+// GetEmailTemplates returns the templates used to render the emails sent by the server.
+func (c *Client4) GetEmailTemplates(ctx context.Context) ([]*EmailTemplate, *Response, error) {
+	r, err := c.DoAPIGet(ctx, c.emailTemplatesRoute(), "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var list []*EmailTemplate
+	if err := json.NewDecoder(r.Body).Decode(&list); err != nil {
+		return nil, nil, NewAppError("GetEmailTemplates", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return list, BuildResponse(r), nil
+}
+
+// ValidateEmailTemplateOverride checks a template override and returns the templates it defines.
+func (c *Client4) ValidateEmailTemplateOverride(ctx context.Context, override *EmailTemplateOverride) ([]*EmailTemplate, *Response, error) {
+	buf, err := json.Marshal(override)
+	if err != nil {
+		return nil, nil, NewAppError("ValidateEmailTemplateOverride", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	r, err := c.DoAPIPostBytes(ctx, c.emailTemplatesRoute()+"/validate", buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var list []*EmailTemplate
+	if err := json.NewDecoder(r.Body).Decode(&list); err != nil {
+		return nil, nil, NewAppError("ValidateEmailTemplateOverride", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return list, BuildResponse(r), nil
+}
+
+// PreviewEmailTemplate renders an email template with sample data.
+func (c *Client4) PreviewEmailTemplate(ctx context.Context, request *EmailTemplatePreviewRequest) (*EmailTemplatePreview, *Response, error) {
+	buf, err := json.Marshal(request)
+	if err != nil {
+		return nil, nil, NewAppError("PreviewEmailTemplate", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	r, err := c.DoAPIPostBytes(ctx, c.emailTemplatesRoute()+"/preview", buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var preview EmailTemplatePreview
+	if err := json.NewDecoder(r.Body).Decode(&preview); err != nil {
+		return nil, nil, NewAppError("PreviewEmailTemplate", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &preview, BuildResponse(r), nil
+}
+
 // TestSiteURL will test the validity of a site URL.
 func (c *Client4) TestSiteURL(ctx context.Context, siteURL string) (*Response, error) {
 	requestBody := make(map[string]string)
@@ -4816,58 +5153,160 @@ func (c *Client4) UpdateConfig(ctx context.Context, config *Config) (*Config, *R
 	return cfg, BuildResponse(r), d.Decode(&cfg)
 }
 
-// MigrateConfig will migrate existing config to the new one.
-// DEPRECATED: The config migrate API has been moved to be a purely
-// mmctl --local endpoint. This method will be removed in a
-// future major release.
-func (c *Client4) MigrateConfig(ctx context.Context, from, to string) (*Response, error) {
-	m := make(map[string]string, 2)
-	m["from"] = from
-	m["to"] = to
-	r, err := c.DoAPIPost(ctx, c.configRoute()+"/migrate", MapToJSON(m))
+// ValidateConfig validates the given config without applying it. When checkConnections is set,
+// the connections to the configured services are tested as well.
+func (c *Client4) ValidateConfig(ctx context.Context, config *Config, checkConnections bool) (*ConfigValidationResult, *Response, error) {
+	buf, err := json.Marshal(config)
 	if err != nil {
-		return BuildResponse(r), err
+		return nil, nil, NewAppError("ValidateConfig", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	r, err := c.DoAPIPutBytes(ctx, c.configRoute()+fmt.Sprintf("/validate?check_connections=%v", checkConnections), buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
 	}
 	defer closeBody(r)
-	return BuildResponse(r), nil
-}
 
-// UploadLicenseFile will add a license file to the system.
-func (c *Client4) UploadLicenseFile(ctx context.Context, data []byte) (*Response, error) {
-	body := &bytes.Buffer{}
-	writer := multipart.NewWriter(body)
+	var result *ConfigValidationResult
+	d := json.NewDecoder(r.Body)
+	return result, BuildResponse(r), d.Decode(&result)
+}
 
-	part, err := writer.CreateFormFile("license", "test-license.mattermost-license")
+// GetConfigHistory returns the changes made to the config through the API, newest first.
+func (c *Client4) GetConfigHistory(ctx context.Context, page, perPage int) ([]*ConfigHistory, *Response, error) {
+	r, err := c.DoAPIGet(ctx, c.configRoute()+fmt.Sprintf("/history?page=%v&per_page=%v", page, perPage), "")
 	if err != nil {
-		return nil, NewAppError("UploadLicenseFile", "model.client.set_profile_user.no_file.app_error", nil, "", http.StatusBadRequest).Wrap(err)
+		return nil, BuildResponse(r), err
 	}
-
-	if _, err = io.Copy(part, bytes.NewBuffer(data)); err != nil {
-		return nil, NewAppError("UploadLicenseFile", "model.client.set_profile_user.no_file.app_error", nil, "", http.StatusBadRequest).Wrap(err)
+	defer closeBody(r)
+	var history []*ConfigHistory
+	if err := json.NewDecoder(r.Body).Decode(&history); err != nil {
+		return nil, nil, NewAppError("GetConfigHistory", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
 	}
+	return history, BuildResponse(r), nil
+}
 
-	if err = writer.Close(); err != nil {
-		return nil, NewAppError("UploadLicenseFile", "model.client.set_profile_user.writer.app_error", nil, "", http.StatusBadRequest).Wrap(err)
+// RollbackConfig restores the config as it was after the given version of the config history.
+func (c *Client4) RollbackConfig(ctx context.Context, version int64) (*Config, *Response, error) {
+	r, err := c.DoAPIPost(ctx, c.configRoute()+fmt.Sprintf("/rollback/%v", version), "")
+	if err != nil {
+		return nil, BuildResponse(r), err
 	}
+	defer closeBody(r)
 
-	rq, err := http.NewRequest("POST", c.APIURL+c.licenseRoute(), bytes.NewReader(body.Bytes()))
+	var cfg *Config
+	d := json.NewDecoder(r.Body)
+	return cfg, BuildResponse(r), d.Decode(&cfg)
+}
+
+// GetFeatureFlags returns the feature flags with their server-wide values and targeting rules.
+func (c *Client4) GetFeatureFlags(ctx context.Context) ([]*FeatureFlagStatus, *Response, error) {
+	r, err := c.DoAPIGet(ctx, c.featureFlagsRoute(), "")
 	if err != nil {
-		return nil, err
+		return nil, BuildResponse(r), err
 	}
-	rq.Header.Set("Content-Type", writer.FormDataContentType())
+	defer closeBody(r)
 
-	if c.AuthToken != "" {
-		rq.Header.Set(HeaderAuth, c.AuthType+" "+c.AuthToken)
+	var flags []*FeatureFlagStatus
+	if err := json.NewDecoder(r.Body).Decode(&flags); err != nil {
+		return nil, nil, NewAppError("GetFeatureFlags", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
 	}
+	return flags, BuildResponse(r), nil
+}
 
-	rp, err := c.HTTPClient.Do(rq)
+func (c *Client4) GetFeatureFlag(ctx context.Context, name string) (*FeatureFlagStatus, *Response, error) {
+	r, err := c.DoAPIGet(ctx, c.featureFlagRoute(name), "")
 	if err != nil {
-		return BuildResponse(rp), err
+		return nil, BuildResponse(r), err
 	}
-	defer closeBody(rp)
+	defer closeBody(r)
 
-	if rp.StatusCode >= 300 {
-		return BuildResponse(rp), AppErrorFromJSON(rp.Body)
+	var flag FeatureFlagStatus
+	if err := json.NewDecoder(r.Body).Decode(&flag); err != nil {
+		return nil, nil, NewAppError("GetFeatureFlag", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &flag, BuildResponse(r), nil
+}
+
+// UpdateFeatureFlagRule sets the targeting rule of a feature flag, replacing the existing one.
+func (c *Client4) UpdateFeatureFlagRule(ctx context.Context, name string, rule *FeatureFlagRule) (*FeatureFlagStatus, *Response, error) {
+	buf, err := json.Marshal(rule)
+	if err != nil {
+		return nil, nil, NewAppError("UpdateFeatureFlagRule", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	r, err := c.DoAPIPutBytes(ctx, c.featureFlagRoute(name)+"/rule", buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var flag FeatureFlagStatus
+	if err := json.NewDecoder(r.Body).Decode(&flag); err != nil {
+		return nil, nil, NewAppError("UpdateFeatureFlagRule", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &flag, BuildResponse(r), nil
+}
+
+func (c *Client4) DeleteFeatureFlagRule(ctx context.Context, name string) (*Response, error) {
+	r, err := c.DoAPIDelete(ctx, c.featureFlagRoute(name)+"/rule")
+	if err != nil {
+		return BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return BuildResponse(r), nil
+}
+
+// MigrateConfig will migrate existing config to the new one.
+// DEPRECATED: The config migrate API has been moved to be a purely
+// mmctl --local endpoint. This method will be removed in a
+// future major release.
+func (c *Client4) MigrateConfig(ctx context.Context, from, to string) (*Response, error) {
+	m := make(map[string]string, 2)
+	m["from"] = from
+	m["to"] = to
+	r, err := c.DoAPIPost(ctx, c.configRoute()+"/migrate", MapToJSON(m))
+	if err != nil {
+		return BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return BuildResponse(r), nil
+}
+
+// UploadLicenseFile will add a license file to the system.
+func (c *Client4) UploadLicenseFile(ctx context.Context, data []byte) (*Response, error) {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	part, err := writer.CreateFormFile("license", "test-license.mattermost-license")
+	if err != nil {
+		return nil, NewAppError("UploadLicenseFile", "model.client.set_profile_user.no_file.app_error", nil, "", http.StatusBadRequest).Wrap(err)
+	}
+
+	if _, err = io.Copy(part, bytes.NewBuffer(data)); err != nil {
+		return nil, NewAppError("UploadLicenseFile", "model.client.set_profile_user.no_file.app_error", nil, "", http.StatusBadRequest).Wrap(err)
+	}
+
+	if err = writer.Close(); err != nil {
+		return nil, NewAppError("UploadLicenseFile", "model.client.set_profile_user.writer.app_error", nil, "", http.StatusBadRequest).Wrap(err)
+	}
+
+	rq, err := http.NewRequest("POST", c.APIURL+c.licenseRoute(), bytes.NewReader(body.Bytes()))
+	if err != nil {
+		return nil, err
+	}
+	rq.Header.Set("Content-Type", writer.FormDataContentType())
+
+	if c.AuthToken != "" {
+		rq.Header.Set(HeaderAuth, c.AuthType+" "+c.AuthToken)
+	}
+
+	rp, err := c.HTTPClient.Do(rq)
+	if err != nil {
+		return BuildResponse(rp), err
+	}
+	defer closeBody(rp)
+
+	if rp.StatusCode >= 300 {
+		return BuildResponse(rp), AppErrorFromJSON(rp.Body)
 	}
 
 	return BuildResponse(rp), nil
@@ -5125,6 +5564,53 @@ func (c *Client4) RegenOutgoingHookToken(ctx context.Context, hookId string) (*O
 	return &ow, BuildResponse(r), nil
 }
 
+// RegenOutgoingHookSigningSecret regenerates the secret used to sign the requests of the outgoing webhook.
+func (c *Client4) RegenOutgoingHookSigningSecret(ctx context.Context, hookId string) (*OutgoingWebhook, *Response, error) {
+	r, err := c.DoAPIPost(ctx, c.outgoingWebhookRoute(hookId)+"/regen_signing_secret", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var ow OutgoingWebhook
+	if err := json.NewDecoder(r.Body).Decode(&ow); err != nil {
+		return nil, nil, NewAppError("RegenOutgoingHookSigningSecret", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &ow, BuildResponse(r), nil
+}
+
+// GetOutgoingWebhookDeliveries returns the recorded deliveries of an outgoing webhook, most
+// recent first.
+func (c *Client4) GetOutgoingWebhookDeliveries(ctx context.Context, hookID string, page, perPage int) ([]*IntegrationDelivery, *Response, error) {
+	query := fmt.Sprintf("?page=%v&per_page=%v", page, perPage)
+	r, err := c.DoAPIGet(ctx, c.outgoingWebhookRoute(hookID)+"/deliveries"+query, "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var list []*IntegrationDelivery
+	if err := json.NewDecoder(r.Body).Decode(&list); err != nil {
+		return nil, nil, NewAppError("GetOutgoingWebhookDeliveries", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return list, BuildResponse(r), nil
+}
+
+// ReplayOutgoingWebhookDelivery sends the payload of a recorded delivery to an outgoing webhook
+// again, and returns the new delivery.
+func (c *Client4) ReplayOutgoingWebhookDelivery(ctx context.Context, hookID, deliveryID string) (*IntegrationDelivery, *Response, error) {
+	r, err := c.DoAPIPost(ctx, c.outgoingWebhookRoute(hookID)+"/deliveries/"+deliveryID+"/replay", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var delivery IntegrationDelivery
+	if err := json.NewDecoder(r.Body).Decode(&delivery); err != nil {
+		return nil, nil, NewAppError("ReplayOutgoingWebhookDelivery", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &delivery, BuildResponse(r), nil
+}
+
 // DeleteOutgoingWebhook delete the outgoing webhook on the system requested by Hook Id.
 func (c *Client4) DeleteOutgoingWebhook(ctx context.Context, hookId string) (*Response, error) {
 	r, err := c.DoAPIDelete(ctx, c.outgoingWebhookRoute(hookId))
@@ -5211,6 +5697,40 @@ func (c *Client4) GetPreferenceByCategoryAndName(ctx context.Context, userId str
 	return &pref, BuildResponse(r), nil
 }
 
+// GetEmailDigestSettings returns the schedule of the email digests of the user.
+func (c *Client4) GetEmailDigestSettings(ctx context.Context, userId string) (*EmailDigestSettings, *Response, error) {
+	r, err := c.DoAPIGet(ctx, c.userRoute(userId)+"/email_digest", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var settings EmailDigestSettings
+	if err := json.NewDecoder(r.Body).Decode(&settings); err != nil {
+		return nil, nil, NewAppError("GetEmailDigestSettings", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &settings, BuildResponse(r), nil
+}
+
+// UpdateEmailDigestSettings updates the schedule of the email digests of the user.
+func (c *Client4) UpdateEmailDigestSettings(ctx context.Context, userId string, settings *EmailDigestSettings) (*EmailDigestSettings, *Response, error) {
+	buf, err := json.Marshal(settings)
+	if err != nil {
+		return nil, nil, NewAppError("UpdateEmailDigestSettings", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	r, err := c.DoAPIPutBytes(ctx, c.userRoute(userId)+"/email_digest", buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var updated EmailDigestSettings
+	if err := json.NewDecoder(r.Body).Decode(&updated); err != nil {
+		return nil, nil, NewAppError("UpdateEmailDigestSettings", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &updated, BuildResponse(r), nil
+}
+
 // SAML Section
 
 // GetSamlMetadata returns metadata for the SAML configuration.
@@ -5874,6 +6394,54 @@ func (c *Client4) PostLog(ctx context.Context, message map[string]string) (map[s
 	return MapFromJSON(r.Body), BuildResponse(r), nil
 }
 
+// GetLogLevelOverrides returns the active overrides of the log level of the modules on the
+// server handling the request.
+func (c *Client4) GetLogLevelOverrides(ctx context.Context) ([]*LogLevelOverride, *Response, error) {
+	r, err := c.DoAPIGet(ctx, "/logs/level", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var overrides []*LogLevelOverride
+	if err := json.NewDecoder(r.Body).Decode(&overrides); err != nil {
+		return nil, nil, NewAppError("GetLogLevelOverrides", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return overrides, BuildResponse(r), nil
+}
+
+// SetLogLevelOverride raises the log level of a module on the server handling the request until
+// the override expires.
+func (c *Client4) SetLogLevelOverride(ctx context.Context, override *LogLevelOverride) (*LogLevelOverride, *Response, error) {
+	buf, err := json.Marshal(override)
+	if err != nil {
+		return nil, nil, NewAppError("SetLogLevelOverride", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	r, err := c.DoAPIPut(ctx, "/logs/level", string(buf))
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var result LogLevelOverride
+	if err := json.NewDecoder(r.Body).Decode(&result); err != nil {
+		return nil, nil, NewAppError("SetLogLevelOverride", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &result, BuildResponse(r), nil
+}
+
+// RemoveLogLevelOverride removes the override of the log level of a module on the server
+// handling the request.
+func (c *Client4) RemoveLogLevelOverride(ctx context.Context, module string) (*Response, error) {
+	r, err := c.DoAPIDelete(ctx, "/logs/level?module="+url.QueryEscape(module))
+	if err != nil {
+		return BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return BuildResponse(r), nil
+}
+
 // OAuth Section
 
 // CreateOAuthApp will register a new OAuth 2.0 client application with Mattermost acting as an OAuth 2.0 service provider.
@@ -6626,43 +7194,317 @@ func (c *Client4) ExecuteCommandWithTeam(ctx context.Context, channelId, teamId,
 	}
 	buf, err := json.Marshal(commandArgs)
 	if err != nil {
-		return nil, nil, NewAppError("ExecuteCommandWithTeam", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		return nil, nil, NewAppError("ExecuteCommandWithTeam", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	r, err := c.DoAPIPostBytes(ctx, c.commandsRoute()+"/execute", buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	response, err := CommandResponseFromJSON(r.Body)
+	if err != nil {
+		return nil, BuildResponse(r), NewAppError("ExecuteCommandWithTeam", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return response, BuildResponse(r), nil
+}
+
+// ListAutocompleteCommands will retrieve a list of commands available in the team.
+func (c *Client4) ListAutocompleteCommands(ctx context.Context, teamId string) ([]*Command, *Response, error) {
+	r, err := c.DoAPIGet(ctx, c.teamAutoCompleteCommandsRoute(teamId), "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var list []*Command
+	if err := json.NewDecoder(r.Body).Decode(&list); err != nil {
+		return nil, nil, NewAppError("ListAutocompleteCommands", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return list, BuildResponse(r), nil
+}
+
+// GetCommandDeliveries returns the recorded deliveries of a slash command, most recent first.
+func (c *Client4) GetCommandDeliveries(ctx context.Context, commandID string, page, perPage int) ([]*IntegrationDelivery, *Response, error) {
+	query := fmt.Sprintf("?page=%v&per_page=%v", page, perPage)
+	r, err := c.DoAPIGet(ctx, c.commandRoute(commandID)+"/deliveries"+query, "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var list []*IntegrationDelivery
+	if err := json.NewDecoder(r.Body).Decode(&list); err != nil {
+		return nil, nil, NewAppError("GetCommandDeliveries", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return list, BuildResponse(r), nil
+}
+
+// RegenCommandSigningSecret will create a new secret for signing the requests of the command.
+func (c *Client4) RegenCommandSigningSecret(ctx context.Context, commandId string) (string, *Response, error) {
+	r, err := c.DoAPIPut(ctx, c.commandRoute(commandId)+"/regen_signing_secret", "")
+	if err != nil {
+		return "", BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return MapFromJSON(r.Body)["signing_secret"], BuildResponse(r), nil
+}
+
+// UpdateCommandAutocompleteData registers the autocomplete tree of a slash command,
+// or removes it when data is nil.
+func (c *Client4) UpdateCommandAutocompleteData(ctx context.Context, commandId string, data *AutocompleteData) (*Command, *Response, error) {
+	buf, err := json.Marshal(data)
+	if err != nil {
+		return nil, nil, NewAppError("UpdateCommandAutocompleteData", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	r, err := c.DoAPIPutBytes(ctx, c.commandRoute(commandId)+"/autocomplete", buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var cmd Command
+	if err := json.NewDecoder(r.Body).Decode(&cmd); err != nil {
+		return nil, nil, NewAppError("UpdateCommandAutocompleteData", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &cmd, BuildResponse(r), nil
+}
+
+// RegenCommandToken will create a new token if the user have the right permissions.
+func (c *Client4) RegenCommandToken(ctx context.Context, commandId string) (string, *Response, error) {
+	r, err := c.DoAPIPut(ctx, c.commandRoute(commandId)+"/regen_token", "")
+	if err != nil {
+		return "", BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return MapFromJSON(r.Body)["token"], BuildResponse(r), nil
+}
+
+// Automation Rules Section
+
+// CreateAutomationRule creates an automation rule in the team of the rule.
+func (c *Client4) CreateAutomationRule(ctx context.Context, rule *AutomationRule) (*AutomationRule, *Response, error) {
+	buf, err := json.Marshal(rule)
+	if err != nil {
+		return nil, nil, NewAppError("CreateAutomationRule", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	r, err := c.DoAPIPostBytes(ctx, c.automationRulesForTeamRoute(rule.TeamId), buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var savedRule AutomationRule
+	if err := json.NewDecoder(r.Body).Decode(&savedRule); err != nil {
+		return nil, nil, NewAppError("CreateAutomationRule", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &savedRule, BuildResponse(r), nil
+}
+
+// GetAutomationRulesForTeam returns a page of the automation rules of a team.
+func (c *Client4) GetAutomationRulesForTeam(ctx context.Context, teamId string, page, perPage int) ([]*AutomationRule, *Response, error) {
+	query := fmt.Sprintf("?page=%v&per_page=%v", page, perPage)
+	r, err := c.DoAPIGet(ctx, c.automationRulesForTeamRoute(teamId)+query, "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var rules []*AutomationRule
+	if err := json.NewDecoder(r.Body).Decode(&rules); err != nil {
+		return nil, nil, NewAppError("GetAutomationRulesForTeam", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return rules, BuildResponse(r), nil
+}
+
+// GetAutomationRule returns an automation rule.
+func (c *Client4) GetAutomationRule(ctx context.Context, ruleId string) (*AutomationRule, *Response, error) {
+	r, err := c.DoAPIGet(ctx, c.automationRuleRoute(ruleId), "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var rule AutomationRule
+	if err := json.NewDecoder(r.Body).Decode(&rule); err != nil {
+		return nil, nil, NewAppError("GetAutomationRule", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &rule, BuildResponse(r), nil
+}
+
+// UpdateAutomationRule updates the name, enabled state, trigger and actions of an automation rule.
+func (c *Client4) UpdateAutomationRule(ctx context.Context, rule *AutomationRule) (*AutomationRule, *Response, error) {
+	buf, err := json.Marshal(rule)
+	if err != nil {
+		return nil, nil, NewAppError("UpdateAutomationRule", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	r, err := c.DoAPIPutBytes(ctx, c.automationRuleRoute(rule.Id), buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var updatedRule AutomationRule
+	if err := json.NewDecoder(r.Body).Decode(&updatedRule); err != nil {
+		return nil, nil, NewAppError("UpdateAutomationRule", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &updatedRule, BuildResponse(r), nil
+}
+
+// DeleteAutomationRule deletes an automation rule.
+func (c *Client4) DeleteAutomationRule(ctx context.Context, ruleId string) (*Response, error) {
+	r, err := c.DoAPIDelete(ctx, c.automationRuleRoute(ruleId))
+	if err != nil {
+		return BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return BuildResponse(r), nil
+}
+
+// Custom Profile Attributes Section
+
+// GetCustomProfileAttributeFields returns the custom profile fields, by sort order.
+func (c *Client4) GetCustomProfileAttributeFields(ctx context.Context) ([]*CustomProfileAttributeField, *Response, error) {
+	r, err := c.DoAPIGet(ctx, c.customProfileAttributeFieldsRoute(), "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var fields []*CustomProfileAttributeField
+	if err := json.NewDecoder(r.Body).Decode(&fields); err != nil {
+		return nil, nil, NewAppError("GetCustomProfileAttributeFields", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return fields, BuildResponse(r), nil
+}
+
+// CreateCustomProfileAttributeField creates a custom profile field.
+func (c *Client4) CreateCustomProfileAttributeField(ctx context.Context, field *CustomProfileAttributeField) (*CustomProfileAttributeField, *Response, error) {
+	buf, err := json.Marshal(field)
+	if err != nil {
+		return nil, nil, NewAppError("CreateCustomProfileAttributeField", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	r, err := c.DoAPIPostBytes(ctx, c.customProfileAttributeFieldsRoute(), buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var savedField CustomProfileAttributeField
+	if err := json.NewDecoder(r.Body).Decode(&savedField); err != nil {
+		return nil, nil, NewAppError("CreateCustomProfileAttributeField", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &savedField, BuildResponse(r), nil
+}
+
+// UpdateCustomProfileAttributeField updates a custom profile field. The type of a field can't be changed.
+func (c *Client4) UpdateCustomProfileAttributeField(ctx context.Context, field *CustomProfileAttributeField) (*CustomProfileAttributeField, *Response, error) {
+	buf, err := json.Marshal(field)
+	if err != nil {
+		return nil, nil, NewAppError("UpdateCustomProfileAttributeField", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	r, err := c.DoAPIPutBytes(ctx, c.customProfileAttributeFieldRoute(field.Id), buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var updatedField CustomProfileAttributeField
+	if err := json.NewDecoder(r.Body).Decode(&updatedField); err != nil {
+		return nil, nil, NewAppError("UpdateCustomProfileAttributeField", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &updatedField, BuildResponse(r), nil
+}
+
+// DeleteCustomProfileAttributeField deletes a custom profile field along with the values of the users.
+func (c *Client4) DeleteCustomProfileAttributeField(ctx context.Context, fieldId string) (*Response, error) {
+	r, err := c.DoAPIDelete(ctx, c.customProfileAttributeFieldRoute(fieldId))
+	if err != nil {
+		return BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return BuildResponse(r), nil
+}
+
+// GetUserCustomProfileAttributes returns the custom profile attributes of a user, keyed by field id.
+func (c *Client4) GetUserCustomProfileAttributes(ctx context.Context, userId string) (map[string]string, *Response, error) {
+	r, err := c.DoAPIGet(ctx, c.userRoute(userId)+"/custom_profile_attributes", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var values map[string]string
+	if err := json.NewDecoder(r.Body).Decode(&values); err != nil {
+		return nil, nil, NewAppError("GetUserCustomProfileAttributes", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return values, BuildResponse(r), nil
+}
+
+// PatchUserCustomProfileAttributes sets the given custom profile attributes of a user, keyed by
+// field id. An empty value clears the attribute.
+func (c *Client4) PatchUserCustomProfileAttributes(ctx context.Context, userId string, patch map[string]string) (map[string]string, *Response, error) {
+	buf, err := json.Marshal(patch)
+	if err != nil {
+		return nil, nil, NewAppError("PatchUserCustomProfileAttributes", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
 	}
-	r, err := c.DoAPIPostBytes(ctx, c.commandsRoute()+"/execute", buf)
+	r, err := c.DoAPIPatchBytes(ctx, c.userRoute(userId)+"/custom_profile_attributes", buf)
 	if err != nil {
 		return nil, BuildResponse(r), err
 	}
 	defer closeBody(r)
 
-	response, err := CommandResponseFromJSON(r.Body)
+	var values map[string]string
+	if err := json.NewDecoder(r.Body).Decode(&values); err != nil {
+		return nil, nil, NewAppError("PatchUserCustomProfileAttributes", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return values, BuildResponse(r), nil
+}
+
+// Manager Section
+
+// SetUserManager sets the manager of a user. An empty managerId clears it.
+func (c *Client4) SetUserManager(ctx context.Context, userId, managerId string) (*User, *Response, error) {
+	r, err := c.DoAPIPut(ctx, c.userRoute(userId)+"/manager", MapToJSON(map[string]string{"manager_id": managerId}))
 	if err != nil {
-		return nil, BuildResponse(r), NewAppError("ExecuteCommandWithTeam", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		return nil, BuildResponse(r), err
 	}
-	return response, BuildResponse(r), nil
+	defer closeBody(r)
+
+	var user User
+	if err := json.NewDecoder(r.Body).Decode(&user); err != nil {
+		return nil, nil, NewAppError("SetUserManager", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &user, BuildResponse(r), nil
 }
 
-// ListAutocompleteCommands will retrieve a list of commands available in the team.
-func (c *Client4) ListAutocompleteCommands(ctx context.Context, teamId string) ([]*Command, *Response, error) {
-	r, err := c.DoAPIGet(ctx, c.teamAutoCompleteCommandsRoute(teamId), "")
+// GetUserReportingChain returns the managers of a user, starting with the direct manager.
+func (c *Client4) GetUserReportingChain(ctx context.Context, userId string) ([]*User, *Response, error) {
+	r, err := c.DoAPIGet(ctx, c.userRoute(userId)+"/reporting_chain", "")
 	if err != nil {
 		return nil, BuildResponse(r), err
 	}
 	defer closeBody(r)
-	var list []*Command
+
+	var list []*User
 	if err := json.NewDecoder(r.Body).Decode(&list); err != nil {
-		return nil, nil, NewAppError("ListAutocompleteCommands", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		return nil, nil, NewAppError("GetUserReportingChain", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
 	}
 	return list, BuildResponse(r), nil
 }
 
-// RegenCommandToken will create a new token if the user have the right permissions.
-func (c *Client4) RegenCommandToken(ctx context.Context, commandId string) (string, *Response, error) {
-	r, err := c.DoAPIPut(ctx, c.commandRoute(commandId)+"/regen_token", "")
+// GetUserDirectReports returns a page of the users who report to a user.
+func (c *Client4) GetUserDirectReports(ctx context.Context, userId string, page, perPage int) ([]*User, *Response, error) {
+	query := fmt.Sprintf("?page=%v&per_page=%v", page, perPage)
+	r, err := c.DoAPIGet(ctx, c.userRoute(userId)+"/direct_reports"+query, "")
 	if err != nil {
-		return "", BuildResponse(r), err
+		return nil, BuildResponse(r), err
 	}
 	defer closeBody(r)
-	return MapFromJSON(r.Body)["token"], BuildResponse(r), nil
+
+	var list []*User
+	if err := json.NewDecoder(r.Body).Decode(&list); err != nil {
+		return nil, nil, NewAppError("GetUserDirectReports", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return list, BuildResponse(r), nil
 }
 
 // Status Section
@@ -6716,6 +7558,43 @@ func (c *Client4) UpdateUserStatus(ctx context.Context, userId string, userStatu
 	return &s, BuildResponse(r), nil
 }
 
+// UpdateUserTimedStatus sets a user's status to dnd or ooo until the end time of the timed status,
+// after which the previous status of the user is restored.
+func (c *Client4) UpdateUserTimedStatus(ctx context.Context, userId string, timedStatus *TimedStatus) (*Status, *Response, error) {
+	buf, err := json.Marshal(timedStatus)
+	if err != nil {
+		return nil, nil, NewAppError("UpdateUserTimedStatus", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	r, err := c.DoAPIPutBytes(ctx, c.userStatusRoute(userId)+"/timed", buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var s Status
+	if err := json.NewDecoder(r.Body).Decode(&s); err != nil {
+		return nil, nil, NewAppError("UpdateUserTimedStatus", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &s, BuildResponse(r), nil
+}
+
+// UpdateUserTimedStatuses sets the timed statuses of many users at once, as reported by a calendar provider.
+func (c *Client4) UpdateUserTimedStatuses(ctx context.Context, timedStatuses []*TimedStatus) ([]*Status, *Response, error) {
+	buf, err := json.Marshal(timedStatuses)
+	if err != nil {
+		return nil, nil, NewAppError("UpdateUserTimedStatuses", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	r, err := c.DoAPIPutBytes(ctx, c.userStatusesRoute()+"/timed", buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var list []*Status
+	if err := json.NewDecoder(r.Body).Decode(&list); err != nil {
+		return nil, nil, NewAppError("UpdateUserTimedStatuses", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return list, BuildResponse(r), nil
+}
+
 // UpdateUserCustomStatus sets a user's custom status based on the provided user id string.
 // The returned CustomStatus object is the same as the one passed, and it should be just
 // ignored. It's only kept to maintain compatibility.
@@ -7164,6 +8043,38 @@ func (c *Client4) PatchRole(ctx context.Context, roleId string, patch *RolePatch
 	return &role, BuildResponse(r), nil
 }
 
+// CreateRole creates a custom role with the given team and channel permissions.
+func (c *Client4) CreateRole(ctx context.Context, role *Role) (*Role, *Response, error) {
+	buf, err := json.Marshal(role)
+	if err != nil {
+		return nil, nil, NewAppError("CreateRole", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	r, err := c.DoAPIPostBytes(ctx, c.rolesRoute(), buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var newRole Role
+	if err := json.NewDecoder(r.Body).Decode(&newRole); err != nil {
+		return nil, nil, NewAppError("CreateRole", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &newRole, BuildResponse(r), nil
+}
+
+// DeleteRole deletes a custom role.
+func (c *Client4) DeleteRole(ctx context.Context, roleId string) (*Role, *Response, error) {
+	r, err := c.DoAPIDelete(ctx, c.rolesRoute()+fmt.Sprintf("/%v", roleId))
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var role Role
+	if err := json.NewDecoder(r.Body).Decode(&role); err != nil {
+		return nil, nil, NewAppError("DeleteRole", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &role, BuildResponse(r), nil
+}
+
 // Schemes Section
 
 // CreateScheme creates a new Scheme.
@@ -7559,6 +8470,212 @@ func (c *Client4) ClearServerBusy(ctx context.Context) (*Response, error) {
 	return BuildResponse(r), nil
 }
 
+// GetMaintenanceMode returns the state of the maintenance mode, during which the write requests
+// are rejected.
+func (c *Client4) GetMaintenanceMode(ctx context.Context) (*MaintenanceMode, *Response, error) {
+	r, err := c.DoAPIGet(ctx, "/maintenance_mode", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var mode MaintenanceMode
+	if err := json.NewDecoder(r.Body).Decode(&mode); err != nil {
+		return nil, nil, NewAppError("GetMaintenanceMode", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &mode, BuildResponse(r), nil
+}
+
+// EnableMaintenanceMode puts the server in maintenance mode. The current message and retry delay
+// are kept when empty.
+func (c *Client4) EnableMaintenanceMode(ctx context.Context, message string, retryAfterSeconds int) (*MaintenanceMode, *Response, error) {
+	buf, err := json.Marshal(&MaintenanceMode{Message: message, RetryAfterSeconds: retryAfterSeconds})
+	if err != nil {
+		return nil, nil, NewAppError("EnableMaintenanceMode", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	r, err := c.DoAPIPostBytes(ctx, "/maintenance_mode", buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var mode MaintenanceMode
+	if err := json.NewDecoder(r.Body).Decode(&mode); err != nil {
+		return nil, nil, NewAppError("EnableMaintenanceMode", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &mode, BuildResponse(r), nil
+}
+
+func (c *Client4) DisableMaintenanceMode(ctx context.Context) (*MaintenanceMode, *Response, error) {
+	r, err := c.DoAPIDelete(ctx, "/maintenance_mode")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var mode MaintenanceMode
+	if err := json.NewDecoder(r.Body).Decode(&mode); err != nil {
+		return nil, nil, NewAppError("DisableMaintenanceMode", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &mode, BuildResponse(r), nil
+}
+
+// DrainServer starts draining the server handling the request, so that it can be stopped without
+// dropping all of its websocket connections at once.
+func (c *Client4) DrainServer(ctx context.Context, timeoutSeconds int) (*DrainStatus, *Response, error) {
+	url := fmt.Sprintf("%s/drain?timeout=%d", c.systemRoute(), timeoutSeconds)
+	r, err := c.DoAPIPost(ctx, url, "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var status DrainStatus
+	if err := json.NewDecoder(r.Body).Decode(&status); err != nil {
+		return nil, nil, NewAppError("DrainServer", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &status, BuildResponse(r), nil
+}
+
+// GetDrainStatus returns the progress of the drain of the server handling the request.
+func (c *Client4) GetDrainStatus(ctx context.Context) (*DrainStatus, *Response, error) {
+	r, err := c.DoAPIGet(ctx, c.systemRoute()+"/drain", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var status DrainStatus
+	if err := json.NewDecoder(r.Body).Decode(&status); err != nil {
+		return nil, nil, NewAppError("GetDrainStatus", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &status, BuildResponse(r), nil
+}
+
+// CaptureProfile captures a runtime profile of the server handling the request and stores it
+// in the file backend. It returns once the profile is captured.
+func (c *Client4) CaptureProfile(ctx context.Context, profileRequest *ProfileRequest) (*Profile, *Response, error) {
+	buf, err := json.Marshal(profileRequest)
+	if err != nil {
+		return nil, nil, NewAppError("CaptureProfile", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	r, err := c.DoAPIPost(ctx, c.systemRoute()+"/profiles", string(buf))
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var profile Profile
+	if err := json.NewDecoder(r.Body).Decode(&profile); err != nil {
+		return nil, nil, NewAppError("CaptureProfile", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &profile, BuildResponse(r), nil
+}
+
+// GetProfiles returns the runtime profiles stored in the file backend.
+func (c *Client4) GetProfiles(ctx context.Context) ([]*Profile, *Response, error) {
+	r, err := c.DoAPIGet(ctx, c.systemRoute()+"/profiles", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var profiles []*Profile
+	if err := json.NewDecoder(r.Body).Decode(&profiles); err != nil {
+		return nil, nil, NewAppError("GetProfiles", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return profiles, BuildResponse(r), nil
+}
+
+// DownloadProfile writes the content of a stored runtime profile to wr.
+func (c *Client4) DownloadProfile(ctx context.Context, name string, wr io.Writer) (int64, *Response, error) {
+	r, err := c.DoAPIGet(ctx, c.systemRoute()+"/profiles/"+url.PathEscape(name), "")
+	if err != nil {
+		return 0, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	n, err := io.Copy(wr, r.Body)
+	if err != nil {
+		return n, BuildResponse(r), NewAppError("DownloadProfile", "model.client.copy.app_error", nil, "", r.StatusCode).Wrap(err)
+	}
+	return n, BuildResponse(r), nil
+}
+
+// DeleteProfile removes a stored runtime profile.
+func (c *Client4) DeleteProfile(ctx context.Context, name string) (*Response, error) {
+	r, err := c.DoAPIDelete(ctx, c.systemRoute()+"/profiles/"+url.PathEscape(name))
+	if err != nil {
+		return BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return BuildResponse(r), nil
+}
+
+// GetSlowOperations returns the slowest store queries and API requests captured by the server
+// handling the request.
+func (c *Client4) GetSlowOperations(ctx context.Context) (*SlowOperations, *Response, error) {
+	r, err := c.DoAPIGet(ctx, c.systemRoute()+"/slow_operations", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var operations SlowOperations
+	if err := json.NewDecoder(r.Body).Decode(&operations); err != nil {
+		return nil, nil, NewAppError("GetSlowOperations", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &operations, BuildResponse(r), nil
+}
+
+// StartDataSeed starts generating synthetic teams, channels, users and posts on the server
+// handling the request.
+func (c *Client4) StartDataSeed(ctx context.Context, seedRequest *DataSeedRequest) (*DataSeedStatus, *Response, error) {
+	buf, err := json.Marshal(seedRequest)
+	if err != nil {
+		return nil, nil, NewAppError("StartDataSeed", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	r, err := c.DoAPIPostBytes(ctx, c.systemRoute()+"/data_seed", buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var status DataSeedStatus
+	if err := json.NewDecoder(r.Body).Decode(&status); err != nil {
+		return nil, nil, NewAppError("StartDataSeed", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &status, BuildResponse(r), nil
+}
+
+// GetDataSeedStatus returns the progress of the last generation of synthetic data on the server
+// handling the request.
+func (c *Client4) GetDataSeedStatus(ctx context.Context) (*DataSeedStatus, *Response, error) {
+	r, err := c.DoAPIGet(ctx, c.systemRoute()+"/data_seed", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var status DataSeedStatus
+	if err := json.NewDecoder(r.Body).Decode(&status); err != nil {
+		return nil, nil, NewAppError("GetDataSeedStatus", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &status, BuildResponse(r), nil
+}
+
+// CancelDataSeed stops the ongoing generation of synthetic data on the server handling the
+// request.
+func (c *Client4) CancelDataSeed(ctx context.Context) (*Response, error) {
+	r, err := c.DoAPIDelete(ctx, c.systemRoute()+"/data_seed")
+	if err != nil {
+		return BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return BuildResponse(r), nil
+}
+
 // GetServerBusy returns the current ServerBusyState including the time when a server marked busy
 // will automatically have the flag cleared.
 func (c *Client4) GetServerBusy(ctx context.Context) (*ServerBusyState, *Response, error) {
@@ -7633,6 +8750,53 @@ func (c *Client4) CreateTermsOfService(ctx context.Context, text, userId string)
 	return &tos, BuildResponse(r), nil
 }
 
+// CreateTermsOfServiceForAudience creates a new version of the terms of service that only the
+// given audience has to accept again.
+func (c *Client4) CreateTermsOfServiceForAudience(ctx context.Context, text, audience string) (*TermsOfService, *Response, error) {
+	url := c.termsOfServiceRoute()
+	data := map[string]any{"text": text, "audience": audience}
+	r, err := c.DoAPIPost(ctx, url, StringInterfaceToJSON(data))
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var tos TermsOfService
+	if err := json.NewDecoder(r.Body).Decode(&tos); err != nil {
+		return nil, nil, NewAppError("CreateTermsOfServiceForAudience", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &tos, BuildResponse(r), nil
+}
+
+// GetTermsOfServiceVersions returns the versions of the terms of service, newest first.
+func (c *Client4) GetTermsOfServiceVersions(ctx context.Context, page, perPage int) ([]*TermsOfService, *Response, error) {
+	url := c.termsOfServiceRoute() + fmt.Sprintf("/versions?page=%v&per_page=%v", page, perPage)
+	r, err := c.DoAPIGet(ctx, url, "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var versions []*TermsOfService
+	if err := json.NewDecoder(r.Body).Decode(&versions); err != nil {
+		return nil, nil, NewAppError("GetTermsOfServiceVersions", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return versions, BuildResponse(r), nil
+}
+
+// GetTermsOfServiceStats returns the acceptance progress of a version of the terms of service.
+func (c *Client4) GetTermsOfServiceStats(ctx context.Context, termsOfServiceId string) (*TermsOfServiceStats, *Response, error) {
+	url := c.termsOfServiceRoute() + fmt.Sprintf("/%v/stats", termsOfServiceId)
+	r, err := c.DoAPIGet(ctx, url, "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var stats TermsOfServiceStats
+	if err := json.NewDecoder(r.Body).Decode(&stats); err != nil {
+		return nil, nil, NewAppError("GetTermsOfServiceStats", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &stats, BuildResponse(r), nil
+}
+
 func (c *Client4) GetGroup(ctx context.Context, groupID, etag string) (*Group, *Response, error) {
 	r, err := c.DoAPIGet(ctx, c.groupRoute(groupID), etag)
 	if err != nil {
@@ -8005,6 +9169,22 @@ func (c *Client4) GetSidebarCategoriesForTeamForUser(ctx context.Context, userID
 	return cat, BuildResponse(r), nil
 }
 
+// GetSidebarCategoryUnreadsForUser returns the unread messages and mentions of a user rolled up
+// by sidebar category, on all of their teams.
+func (c *Client4) GetSidebarCategoryUnreadsForUser(ctx context.Context, userID string) ([]*SidebarCategoryUnread, *Response, error) {
+	r, err := c.DoAPIGet(ctx, c.userRoute(userID)+"/channels/categories/unreads", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var unreads []*SidebarCategoryUnread
+	if err := json.NewDecoder(r.Body).Decode(&unreads); err != nil {
+		return nil, BuildResponse(r), NewAppError("GetSidebarCategoryUnreadsForUser", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return unreads, BuildResponse(r), nil
+}
+
 func (c *Client4) CreateSidebarCategoryForTeamForUser(ctx context.Context, userID, teamID string, category *SidebarCategoryWithChannels) (*SidebarCategoryWithChannels, *Response, error) {
 	payload, err := json.Marshal(category)
 	if err != nil {
@@ -8456,6 +9636,25 @@ func (c *Client4) ListImports(ctx context.Context) ([]string, *Response, error)
 	return c.ArrayFromJSON(r.Body), BuildResponse(r), nil
 }
 
+// MergeImport starts a job importing the given import file, which is an export of another
+// instance, while renaming its users and teams according to the mapping.
+func (c *Client4) MergeImport(ctx context.Context, name string, mapping *ImportMergeMapping, extractContent bool) (*Job, *Response, error) {
+	buf, err := json.Marshal(mapping)
+	if err != nil {
+		return nil, nil, NewAppError("MergeImport", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	r, err := c.DoAPIPost(ctx, c.importRoute(name)+"/merge?extract_content="+strconv.FormatBool(extractContent), string(buf))
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var job Job
+	if err := json.NewDecoder(r.Body).Decode(&job); err != nil {
+		return nil, nil, NewAppError("MergeImport", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &job, BuildResponse(r), nil
+}
+
 func (c *Client4) ListExports(ctx context.Context) ([]string, *Response, error) {
 	r, err := c.DoAPIGet(ctx, c.exportsRoute(), "")
 	if err != nil {
@@ -8885,3 +10084,23 @@ func (c *Client4) SubmitClientMetrics(ctx context.Context, report *PerformanceRe
 
 	return BuildResponse(res), nil
 }
+
+// ExecuteBatch serves the read operations of the batch in a single request. The operations fail
+// independently of each other, so the result of each operation must be checked.
+func (c *Client4) ExecuteBatch(ctx context.Context, batch *BatchRequest) (*BatchResponse, *Response, error) {
+	buf, err := json.Marshal(batch)
+	if err != nil {
+		return nil, nil, NewAppError("ExecuteBatch", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	r, err := c.DoAPIPostBytes(ctx, "/batch", buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var response BatchResponse
+	if err := json.NewDecoder(r.Body).Decode(&response); err != nil {
+		return nil, nil, NewAppError("ExecuteBatch", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &response, BuildResponse(r), nil
+}
@@ -4,6 +4,7 @@
 package model
 
 import (
+	"encoding/json"
 	"net/http"
 	"strings"
 )
@@ -13,6 +14,9 @@ const (
 	CommandMethodGet  = "G"
 	MinTriggerLength  = 1
 	MaxTriggerLength  = 128
+
+	// CommandAutocompleteDataMaxSize is the size of the AutocompleteData column.
+	CommandAutocompleteDataMaxSize = 65535
 )
 
 type Command struct {
@@ -36,9 +40,11 @@ type Command struct {
 	// PluginId records the id of the plugin that created this Command. If it is blank, the Command
 	// was not created by a plugin.
 	PluginId         string            `json:"plugin_id"`
-	AutocompleteData *AutocompleteData `db:"-" json:"autocomplete_data,omitempty"`
+	AutocompleteData *AutocompleteData `json:"autocomplete_data,omitempty"`
 	// AutocompleteIconData is a base64 encoded svg
 	AutocompleteIconData string `db:"-" json:"autocomplete_icon_data,omitempty"`
+	// SigningSecret is the key used to sign the requests sent to URL, see SignIntegrationRequest.
+	SigningSecret string `json:"signing_secret"`
 }
 
 func (o *Command) Auditable() map[string]interface{} {
@@ -70,6 +76,10 @@ func (o *Command) IsValid() *AppError {
 		return NewAppError("Command.IsValid", "model.command.is_valid.token.app_error", nil, "", http.StatusBadRequest)
 	}
 
+	if len(o.SigningSecret) > IntegrationSigningSecretMaxLength {
+		return NewAppError("Command.IsValid", "model.command.is_valid.signing_secret.app_error", nil, "", http.StatusBadRequest)
+	}
+
 	if o.CreateAt == 0 {
 		return NewAppError("Command.IsValid", "model.command.is_valid.create_at.app_error", nil, "", http.StatusBadRequest)
 	}
@@ -124,6 +134,18 @@ func (o *Command) IsValid() *AppError {
 		if err := o.AutocompleteData.IsValid(); err != nil {
 			return NewAppError("Command.IsValid", "model.command.is_valid.autocomplete_data.app_error", nil, "", http.StatusBadRequest).Wrap(err)
 		}
+
+		if o.AutocompleteData.Trigger != o.Trigger {
+			return NewAppError("Command.IsValid", "model.command.is_valid.autocomplete_data_trigger.app_error", nil, "", http.StatusBadRequest)
+		}
+
+		if o.PluginId == "" && !o.AutocompleteData.hasHTTPFetchURLs() {
+			return NewAppError("Command.IsValid", "model.command.is_valid.autocomplete_data_fetch_url.app_error", nil, "", http.StatusBadRequest)
+		}
+
+		if buf, err := json.Marshal(o.AutocompleteData); err != nil || len(buf) > CommandAutocompleteDataMaxSize {
+			return NewAppError("Command.IsValid", "model.command.is_valid.autocomplete_data_size.app_error", map[string]any{"Max": CommandAutocompleteDataMaxSize}, "", http.StatusBadRequest)
+		}
 	}
 
 	return nil
@@ -138,6 +160,10 @@ func (o *Command) PreSave() {
 		o.Token = NewId()
 	}
 
+	if o.SigningSecret == "" && o.PluginId == "" {
+		o.SigningSecret = NewIntegrationSigningSecret()
+	}
+
 	o.CreateAt = GetMillis()
 	o.UpdateAt = o.CreateAt
 }
@@ -148,6 +174,7 @@ func (o *Command) PreUpdate() {
 
 func (o *Command) Sanitize() {
 	o.Token = ""
+	o.SigningSecret = ""
 	o.CreatorId = ""
 	o.Method = ""
 	o.URL = ""
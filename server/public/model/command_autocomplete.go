@@ -4,6 +4,7 @@
 package model
 
 import (
+	"database/sql/driver"
 	"encoding/json"
 	"net/url"
 	"path"
@@ -84,6 +85,22 @@ type AutocompleteDynamicListArg struct {
 	FetchURL string
 }
 
+// AutocompleteDynamicListMaxCacheTTL is the longest time, in seconds, the server keeps the
+// items fetched for a dynamic list argument.
+const AutocompleteDynamicListMaxCacheTTL = 600
+
+// AutocompleteDynamicListResponse can be returned instead of a plain list of items by the
+// URL of a dynamic list argument.
+type AutocompleteDynamicListResponse struct {
+	Items []AutocompleteListItem
+	// CacheTTL is the number of seconds the server may reuse the items for the same user input,
+	// capped to AutocompleteDynamicListMaxCacheTTL. Items are not cached when it's zero.
+	CacheTTL int
+	// HasMore is set when more items match the user input than were returned, so the user
+	// is told to keep typing.
+	HasMore bool
+}
+
 // AutocompleteSuggestion describes a single suggestion item sent to the front-end
 // Example: for user input `/jira cre` -
 // Complete might be `/jira create`
@@ -222,6 +239,58 @@ func (ad *AutocompleteData) UpdateRelativeURLsForPluginCommands(baseURL *url.URL
 	return nil
 }
 
+// Scan converts the autocomplete data stored in the database.
+func (ad *AutocompleteData) Scan(value any) error {
+	if value == nil {
+		return nil
+	}
+
+	buf, ok := value.([]byte)
+	if ok {
+		return json.Unmarshal(buf, ad)
+	}
+
+	str, ok := value.(string)
+	if ok {
+		return json.Unmarshal([]byte(str), ad)
+	}
+
+	return errors.New("received value is neither a byte slice nor string")
+}
+
+// Value converts the autocomplete data to a database value.
+func (ad *AutocompleteData) Value() (driver.Value, error) {
+	if ad == nil {
+		return nil, nil
+	}
+
+	buf, err := json.Marshal(ad)
+	if err != nil {
+		return nil, err
+	}
+	return string(buf), nil
+}
+
+// hasHTTPFetchURLs checks that all dynamic list arguments are fetched from an http(s) URL,
+// as required for commands not registered by plugins.
+func (ad *AutocompleteData) hasHTTPFetchURLs() bool {
+	for _, arg := range ad.Arguments {
+		if arg.Type != AutocompleteArgTypeDynamicList {
+			continue
+		}
+		dynamicList, ok := arg.Data.(*AutocompleteDynamicListArg)
+		if !ok || !IsValidHTTPURL(dynamicList.FetchURL) {
+			return false
+		}
+	}
+	for _, command := range ad.SubCommands {
+		if !command.hasHTTPFetchURLs() {
+			return false
+		}
+	}
+	return true
+}
+
 // IsValid method checks if autocomplete data is valid.
 func (ad *AutocompleteData) IsValid() error {
 	if ad == nil {
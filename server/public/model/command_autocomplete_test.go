@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestAutocompleteData(t *testing.T) {
@@ -97,3 +98,23 @@ func TestUpdateRelativeURLsForPluginCommands(t *testing.T) {
 	assert.True(t, ok)
 	assert.Equal(t, "http://localhost:8065/plugins/com.mattermost.demo-plugin/other/url", arg.FetchURL)
 }
+
+func TestAutocompleteDataScanValue(t *testing.T) {
+	ad := getAutocompleteData()
+
+	value, err := ad.Value()
+	require.NoError(t, err)
+
+	var scanned AutocompleteData
+	require.NoError(t, scanned.Scan(value))
+	assert.True(t, ad.Equals(&scanned))
+
+	var scannedBytes AutocompleteData
+	require.NoError(t, scannedBytes.Scan([]byte(value.(string))))
+	assert.True(t, ad.Equals(&scannedBytes))
+
+	var nilData *AutocompleteData
+	value, err = nilData.Value()
+	require.NoError(t, err)
+	assert.Nil(t, value)
+}
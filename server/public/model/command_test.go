@@ -104,11 +104,37 @@ func TestCommandIsValid(t *testing.T) {
 
 	o.Description = strings.Repeat("1", 128)
 	require.Nil(t, o.IsValid())
+
+	o.Trigger = "trigger"
+	o.AutocompleteData = NewAutocompleteData("other", "", "")
+	require.NotNil(t, o.IsValid(), "should be invalid")
+
+	o.AutocompleteData = NewAutocompleteData("trigger", "[project]", "")
+	o.AutocompleteData.AddDynamicListArgument("help", "/plugins/com.example/projects", true)
+	require.NotNil(t, o.IsValid(), "should be invalid")
+
+	o.PluginId = "com.example"
+	o.CreatorId = ""
+	require.Nil(t, o.IsValid())
+
+	o.PluginId = ""
+	o.CreatorId = NewId()
+	o.AutocompleteData = NewAutocompleteData("trigger", "[project]", "")
+	o.AutocompleteData.AddDynamicListArgument("help", "https://example.com/projects", true)
+	require.Nil(t, o.IsValid())
+
+	o.AutocompleteData.HelpText = strings.Repeat("1", CommandAutocompleteDataMaxSize)
+	require.NotNil(t, o.IsValid(), "should be invalid")
 }
 
 func TestCommandPreSave(t *testing.T) {
 	o := Command{}
 	o.PreSave()
+	require.Len(t, o.SigningSecret, IntegrationSigningSecretLength)
+
+	pluginCommand := Command{PluginId: "com.example.plugin"}
+	pluginCommand.PreSave()
+	require.Empty(t, pluginCommand.SigningSecret, "plugin commands don't send requests")
 }
 
 func TestCommandPreUpdate(t *testing.T) {
@@ -33,21 +33,30 @@ type Compliance struct {
 	EndAt    int64  `json:"end_at"`
 	Keywords string `json:"keywords"`
 	Emails   string `json:"emails"`
+
+	// Incremental exports start where the last successful incremental export ended.
+	Incremental bool `json:"incremental"`
+
+	// LastPostUpdateAt and LastPostId checkpoint the progress of a streaming export
+	// so that it can be resumed if the export is interrupted.
+	LastPostUpdateAt int64  `json:"-"`
+	LastPostId       string `json:"-"`
 }
 
 func (c *Compliance) Auditable() map[string]interface{} {
 	return map[string]interface{}{
-		"id":        c.Id,
-		"create_at": c.CreateAt,
-		"user_id":   c.UserId,
-		"status":    c.Status,
-		"count":     c.Count,
-		"desc":      c.Desc,
-		"type":      c.Type,
-		"start_at":  c.StartAt,
-		"end_at":    c.EndAt,
-		"keywords":  c.Keywords,
-		"emails":    c.Emails,
+		"id":          c.Id,
+		"create_at":   c.CreateAt,
+		"user_id":     c.UserId,
+		"status":      c.Status,
+		"count":       c.Count,
+		"desc":        c.Desc,
+		"type":        c.Type,
+		"start_at":    c.StartAt,
+		"end_at":      c.EndAt,
+		"keywords":    c.Keywords,
+		"emails":      c.Emails,
+		"incremental": c.Incremental,
 	}
 }
 
@@ -66,6 +75,28 @@ type ComplianceExportCursor struct {
 	DirectMessagesQueryCompleted        bool
 }
 
+// ComplianceIncrementalExportCursor is used for streaming the posts of a compliance
+// export ordered by UpdateAt, so that edits and deletions made since the last
+// export are included in the next incremental one.
+type ComplianceIncrementalExportCursor struct {
+	LastPostUpdateAt int64
+	LastPostId       string
+}
+
+// Cursor returns the checkpointed position of the export.
+func (c *Compliance) Cursor() ComplianceIncrementalExportCursor {
+	return ComplianceIncrementalExportCursor{
+		LastPostUpdateAt: c.LastPostUpdateAt,
+		LastPostId:       c.LastPostId,
+	}
+}
+
+// SetCursor checkpoints the position of the export.
+func (c *Compliance) SetCursor(cursor ComplianceIncrementalExportCursor) {
+	c.LastPostUpdateAt = cursor.LastPostUpdateAt
+	c.LastPostId = cursor.LastPostId
+}
+
 func (c *Compliance) PreSave() {
 	if c.Id == "" {
 		c.Id = NewId()
@@ -76,6 +107,8 @@ func (c *Compliance) PreSave() {
 	}
 
 	c.Count = 0
+	c.LastPostUpdateAt = 0
+	c.LastPostId = ""
 	c.Emails = NormalizeEmail(c.Emails)
 	c.Keywords = strings.ToLower(c.Keywords)
 
@@ -18,6 +18,7 @@ import (
 	"strconv"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/mattermost/ldap"
 
@@ -98,6 +99,9 @@ const (
 	EmailSMTPDefaultServer = "localhost"
 	EmailSMTPDefaultPort   = "10025"
 
+	EmailSMTPDefaultMaxConnections = 5
+	EmailSMTPDefaultMaxRetries     = 3
+
 	SitenameMaxLength = 30
 
 	ServiceSettingsDefaultSiteURL                = "http://localhost:8065"
@@ -125,6 +129,7 @@ const (
 	FileSettingsDefaultDirectory                   = "./data/"
 	FileSettingsDefaultS3UploadPartSizeBytes       = 5 * 1024 * 1024   // 5MB
 	FileSettingsDefaultS3ExportUploadPartSizeBytes = 100 * 1024 * 1024 // 100MB
+	FileSettingsDefaultMaxFileShareLinkExpiryHours = 24 * 7            // 7 days
 
 	ImportSettingsDefaultDirectory     = "./import"
 	ImportSettingsDefaultRetentionDays = 30
@@ -132,6 +137,21 @@ const (
 	ExportSettingsDefaultDirectory     = "./export"
 	ExportSettingsDefaultRetentionDays = 30
 
+	TracingSettingsDefaultServiceName      = "mattermost"
+	TracingSettingsDefaultEndpoint         = "localhost:4318"
+	TracingSettingsDefaultSamplePercentage = 100
+	TracingProtocolHTTP                    = "http"
+	TracingProtocolGRPC                    = "grpc"
+
+	SlowOperationSettingsDefaultQueryThresholdMilliseconds   = 1000
+	SlowOperationSettingsDefaultRequestThresholdMilliseconds = 2000
+	SlowOperationSettingsDefaultMaxEntries                   = 20
+	SlowOperationSettingsDefaultIntervalMinutes              = 60
+	SlowOperationSettingsMaxEntries                          = 1000
+	SlowOperationSettingsMaxIntervalMinutes                  = 24 * 60
+
+	NotificationLogSettingsDefaultDeliveryHistoryRetentionDays = 7
+
 	EmailSettingsDefaultFeedbackOrganization = ""
 
 	SupportSettingsDefaultTermsOfServiceLink = "https://mattermost.com/pl/terms-of-use/"
@@ -141,6 +161,7 @@ const (
 	SupportSettingsDefaultReportAProblemLink = "https://mattermost.com/pl/report-a-bug"
 	SupportSettingsDefaultSupportEmail       = ""
 	SupportSettingsDefaultReAcceptancePeriod = 365
+	SupportSettingsDefaultGracePeriod        = 0
 
 	LdapSettingsDefaultFirstNameAttribute        = ""
 	LdapSettingsDefaultLastNameAttribute         = ""
@@ -153,6 +174,7 @@ const (
 	LdapSettingsDefaultGroupDisplayNameAttribute = ""
 	LdapSettingsDefaultGroupIdAttribute          = ""
 	LdapSettingsDefaultPictureAttribute          = ""
+	LdapSettingsDefaultManagerAttribute          = ""
 
 	SamlSettingsDefaultIdAttribute        = ""
 	SamlSettingsDefaultGuestAttribute     = ""
@@ -221,6 +243,10 @@ const (
 
 	OutgoingIntegrationRequestsDefaultTimeout = 30
 
+	ServiceSettingsDefaultIntegrationDeliveryLogRetentionDays = 7
+
+	ServiceSettingsDefaultMaintenanceModeRetryAfterSeconds = 300
+
 	PluginSettingsDefaultDirectory         = "./plugins"
 	PluginSettingsDefaultClientDirectory   = "./client/plugins"
 	PluginSettingsDefaultEnableMarketplace = true
@@ -231,6 +257,7 @@ const (
 	ComplianceExportTypeActiance       = "actiance"
 	ComplianceExportTypeGlobalrelay    = "globalrelay"
 	ComplianceExportTypeGlobalrelayZip = "globalrelay-zip"
+	ComplianceExportTypeJsonl          = "jsonl"
 	GlobalrelayCustomerTypeA9          = "A9"
 	GlobalrelayCustomerTypeA10         = "A10"
 	GlobalrelayCustomerTypeCustom      = "CUSTOM"
@@ -318,6 +345,11 @@ type ServiceSettings struct {
 	EnableOutgoingOAuthConnections      *bool    `access:"integrations_integration_management"`
 	EnableCommands                      *bool    `access:"integrations_integration_management"`
 	OutgoingIntegrationRequestsTimeout  *int64   `access:"integrations_integration_management"` // In seconds.
+	EnableIntegrationDeliveryLog        *bool    `access:"integrations_integration_management"`
+	IntegrationDeliveryLogRetentionDays *int     `access:"integrations_integration_management"`
+	IncomingWebhookRateLimitPerMinute   *int     `access:"integrations_integration_management"`
+	ValidateIncomingWebhookAttachments  *bool    `access:"integrations_integration_management"`
+	EnableAutomationRules               *bool    `access:"integrations_integration_management"`
 	EnablePostUsernameOverride          *bool    `access:"integrations_integration_management"`
 	EnablePostIconOverride              *bool    `access:"integrations_integration_management"`
 	GoogleDeveloperKey                  *string  `access:"site_posts,write_restrictable,cloud_restrictable"`
@@ -335,6 +367,7 @@ type ServiceSettings struct {
 	EnableMultifactorAuthentication     *bool    `access:"authentication_mfa"`
 	EnforceMultifactorAuthentication    *bool    `access:"authentication_mfa"`
 	EnableUserAccessTokens              *bool    `access:"integrations_integration_management"`
+	UserAccessTokenGracePeriodMinutes   *int     `access:"integrations_integration_management"`
 	AllowCorsFrom                       *string  `access:"integrations_cors,write_restrictable,cloud_restrictable"`
 	CorsExposedHeaders                  *string  `access:"integrations_cors,write_restrictable,cloud_restrictable"`
 	CorsAllowCredentials                *bool    `access:"integrations_cors,write_restrictable,cloud_restrictable"`
@@ -363,6 +396,7 @@ type ServiceSettings struct {
 	EnableEmojiPicker                                 *bool   `access:"site_emoji"`
 	PostEditTimeLimit                                 *int    `access:"user_management_permissions"`
 	TimeBetweenUserTypingUpdatesMilliseconds          *int64  `access:"experimental_features,write_restrictable,cloud_restrictable"`
+	TypingAggregationWindowMilliseconds               *int64  `access:"experimental_features,write_restrictable,cloud_restrictable"`
 	EnablePostSearch                                  *bool   `access:"write_restrictable,cloud_restrictable"`
 	EnableFileSearch                                  *bool   `access:"write_restrictable"`
 	MinimumHashtagLength                              *int    `access:"environment_database,write_restrictable,cloud_restrictable"`
@@ -379,6 +413,7 @@ type ServiceSettings struct {
 	EnableAPITeamDeletion                             *bool
 	EnableAPITriggerAdminNotifications                *bool
 	EnableAPIUserDeletion                             *bool
+	EnableAPIDataSeeding                              *bool `access:"write_restrictable,cloud_restrictable"`
 	ExperimentalEnableHardenedMode                    *bool `access:"experimental_features"`
 	ExperimentalStrictCSRFEnforcement                 *bool `access:"experimental_features,write_restrictable,cloud_restrictable"`
 	EnableEmailInvitations                            *bool `access:"authentication_signup"`
@@ -408,6 +443,9 @@ type ServiceSettings struct {
 	UniqueEmojiReactionLimitPerPost                   *int    `access:"site_posts"`
 	RefreshPostStatsRunTime                           *string `access:"site_users_and_teams"`
 	MaximumPayloadSizeBytes                           *int64  `access:"environment_file_storage,write_restrictable,cloud_restrictable"`
+	EnableMaintenanceMode                             *bool   `access:"environment_web_server,write_restrictable,cloud_restrictable"`
+	MaintenanceModeMessage                            *string `access:"environment_web_server,write_restrictable,cloud_restrictable"` // telemetry: none
+	MaintenanceModeRetryAfterSeconds                  *int    `access:"environment_web_server,write_restrictable,cloud_restrictable"`
 }
 
 var MattermostGiphySdkKey string
@@ -498,6 +536,10 @@ func (s *ServiceSettings) SetDefaults(isUpdate bool) {
 		s.EnableUserAccessTokens = NewBool(false)
 	}
 
+	if s.UserAccessTokenGracePeriodMinutes == nil {
+		s.UserAccessTokenGracePeriodMinutes = NewInt(60)
+	}
+
 	if s.GoroutineHealthThreshold == nil {
 		s.GoroutineHealthThreshold = NewInt(-1)
 	}
@@ -526,6 +568,26 @@ func (s *ServiceSettings) SetDefaults(isUpdate bool) {
 		s.OutgoingIntegrationRequestsTimeout = NewInt64(OutgoingIntegrationRequestsDefaultTimeout)
 	}
 
+	if s.EnableIntegrationDeliveryLog == nil {
+		s.EnableIntegrationDeliveryLog = NewBool(false)
+	}
+
+	if s.IntegrationDeliveryLogRetentionDays == nil {
+		s.IntegrationDeliveryLogRetentionDays = NewInt(ServiceSettingsDefaultIntegrationDeliveryLogRetentionDays)
+	}
+
+	if s.IncomingWebhookRateLimitPerMinute == nil {
+		s.IncomingWebhookRateLimitPerMinute = NewInt(0)
+	}
+
+	if s.ValidateIncomingWebhookAttachments == nil {
+		s.ValidateIncomingWebhookAttachments = NewBool(false)
+	}
+
+	if s.EnableAutomationRules == nil {
+		s.EnableAutomationRules = NewBool(false)
+	}
+
 	if s.ConnectionSecurity == nil {
 		s.ConnectionSecurity = NewString("")
 	}
@@ -590,6 +652,10 @@ func (s *ServiceSettings) SetDefaults(isUpdate bool) {
 		s.TimeBetweenUserTypingUpdatesMilliseconds = NewInt64(5000)
 	}
 
+	if s.TypingAggregationWindowMilliseconds == nil {
+		s.TypingAggregationWindowMilliseconds = NewInt64(0)
+	}
+
 	if s.EnablePostSearch == nil {
 		s.EnablePostSearch = NewBool(true)
 	}
@@ -795,6 +861,10 @@ func (s *ServiceSettings) SetDefaults(isUpdate bool) {
 		s.EnableAPIUserDeletion = NewBool(false)
 	}
 
+	if s.EnableAPIDataSeeding == nil {
+		s.EnableAPIDataSeeding = NewBool(false)
+	}
+
 	if s.EnableAPIChannelDeletion == nil {
 		s.EnableAPIChannelDeletion = NewBool(false)
 	}
@@ -918,6 +988,18 @@ func (s *ServiceSettings) SetDefaults(isUpdate bool) {
 	if s.MaximumPayloadSizeBytes == nil {
 		s.MaximumPayloadSizeBytes = NewInt64(300000)
 	}
+
+	if s.EnableMaintenanceMode == nil {
+		s.EnableMaintenanceMode = NewBool(false)
+	}
+
+	if s.MaintenanceModeMessage == nil {
+		s.MaintenanceModeMessage = NewString("")
+	}
+
+	if s.MaintenanceModeRetryAfterSeconds == nil {
+		s.MaintenanceModeRetryAfterSeconds = NewInt(ServiceSettingsDefaultMaintenanceModeRetryAfterSeconds)
+	}
 }
 
 type ClusterSettings struct {
@@ -1476,6 +1558,18 @@ type NotificationLogSettings struct {
 	FileLocation          *string         `access:"write_restrictable,cloud_restrictable"`
 	AdvancedLoggingJSON   json.RawMessage `access:"write_restrictable,cloud_restrictable"`
 	AdvancedLoggingConfig *string         `access:"write_restrictable,cloud_restrictable"` // Deprecated: use `AdvancedLoggingJSON`
+	// EnableDeliveryHistory records the outcome of the notifications sent to each user, so that
+	// admins can look up why a user wasn't notified.
+	EnableDeliveryHistory        *bool `access:"write_restrictable,cloud_restrictable"`
+	DeliveryHistoryRetentionDays *int  `access:"write_restrictable,cloud_restrictable"`
+}
+
+func (s *NotificationLogSettings) isValid() *AppError {
+	if *s.DeliveryHistoryRetentionDays <= 0 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.notification_log.delivery_history_retention_days.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	return nil
 }
 
 func (s *NotificationLogSettings) SetDefaults() {
@@ -1518,6 +1612,14 @@ func (s *NotificationLogSettings) SetDefaults() {
 	if s.AdvancedLoggingConfig == nil {
 		s.AdvancedLoggingConfig = NewString("")
 	}
+
+	if s.EnableDeliveryHistory == nil {
+		s.EnableDeliveryHistory = NewBool(false)
+	}
+
+	if s.DeliveryHistoryRetentionDays == nil {
+		s.DeliveryHistoryRetentionDays = NewInt(NotificationLogSettingsDefaultDeliveryHistoryRetentionDays)
+	}
 }
 
 // GetAdvancedLoggingConfig returns the advanced logging config as a []byte.
@@ -1579,6 +1681,9 @@ type FileSettings struct {
 	EnablePublicLink                   *bool   `access:"site_public_links,cloud_restrictable"`
 	ExtractContent                     *bool   `access:"environment_file_storage,write_restrictable"`
 	ArchiveRecursion                   *bool   `access:"environment_file_storage,write_restrictable"`
+	EnableFileDeduplication            *bool   `access:"environment_file_storage,write_restrictable"`
+	EnableFileShareLinks               *bool   `access:"site_public_links,cloud_restrictable"`
+	MaxFileShareLinkExpiryHours        *int    `access:"site_public_links,cloud_restrictable"`
 	PublicLinkSalt                     *string `access:"site_public_links,cloud_restrictable"`                           // telemetry: none
 	InitialFont                        *string `access:"environment_file_storage,cloud_restrictable"`                    // telemetry: none
 	AmazonS3AccessKeyId                *string `access:"environment_file_storage,write_restrictable,cloud_restrictable"` // telemetry: none
@@ -1657,6 +1762,18 @@ func (s *FileSettings) SetDefaults(isUpdate bool) {
 		s.ArchiveRecursion = NewBool(false)
 	}
 
+	if s.EnableFileDeduplication == nil {
+		s.EnableFileDeduplication = NewBool(false)
+	}
+
+	if s.EnableFileShareLinks == nil {
+		s.EnableFileShareLinks = NewBool(false)
+	}
+
+	if s.MaxFileShareLinkExpiryHours == nil {
+		s.MaxFileShareLinkExpiryHours = NewInt(FileSettingsDefaultMaxFileShareLinkExpiryHours)
+	}
+
 	if isUpdate {
 		// When updating an existing configuration, ensure link salt has been specified.
 		if s.PublicLinkSalt == nil || *s.PublicLinkSalt == "" {
@@ -1806,17 +1923,27 @@ type EmailSettings struct {
 	SMTPServer                        *string `access:"environment_smtp,write_restrictable,cloud_restrictable"` // telemetry: none
 	SMTPPort                          *string `access:"environment_smtp,write_restrictable,cloud_restrictable"` // telemetry: none
 	SMTPServerTimeout                 *int    `access:"cloud_restrictable"`
+	SMTPMaxConnections                *int    `access:"environment_smtp,write_restrictable,cloud_restrictable"`
+	SMTPMaxMessagesPerSecond          *int    `access:"environment_smtp,write_restrictable,cloud_restrictable"`
+	SMTPMaxRetries                    *int    `access:"environment_smtp,write_restrictable,cloud_restrictable"`
 	ConnectionSecurity                *string `access:"environment_smtp,write_restrictable,cloud_restrictable"`
 	SendPushNotifications             *bool   `access:"environment_push_notification_server"`
 	PushNotificationServer            *string `access:"environment_push_notification_server"` // telemetry: none
 	PushNotificationContents          *string `access:"site_notifications"`
 	PushNotificationBuffer            *int    // telemetry: none
+	EnableWebPushNotifications        *bool   `access:"environment_push_notification_server"`
 	EnableEmailBatching               *bool   `access:"site_notifications"`
 	EmailBatchingBufferSize           *int    `access:"experimental_features"`
 	EmailBatchingInterval             *int    `access:"experimental_features"`
 	EnablePreviewModeBanner           *bool   `access:"site_notifications"`
 	SkipServerCertificateVerification *bool   `access:"environment_smtp,write_restrictable,cloud_restrictable"`
 	EmailNotificationContentsType     *string `access:"site_notifications"`
+	EnableReplyByEmail                *bool   `access:"site_notifications"`
+	ReplyByEmailAddress               *string `access:"site_notifications,cloud_restrictable"` // telemetry: none
+	InboundEmailSecret                *string `access:"site_notifications,cloud_restrictable"` // telemetry: none
+	EnableBounceHandling              *bool   `access:"site_notifications"`
+	EmailEventsSecret                 *string `access:"site_notifications,cloud_restrictable"` // telemetry: none
+	EmailTemplateOverridesDirectory   *string `access:"site_customization,write_restrictable,cloud_restrictable"`
 	LoginButtonColor                  *string `access:"experimental_features"`
 	LoginButtonBorderColor            *string `access:"experimental_features"`
 	LoginButtonTextColor              *string `access:"experimental_features"`
@@ -1891,6 +2018,42 @@ func (s *EmailSettings) SetDefaults(isUpdate bool) {
 		s.SMTPServerTimeout = NewInt(10)
 	}
 
+	if s.SMTPMaxConnections == nil {
+		s.SMTPMaxConnections = NewInt(EmailSMTPDefaultMaxConnections)
+	}
+
+	if s.SMTPMaxMessagesPerSecond == nil {
+		s.SMTPMaxMessagesPerSecond = NewInt(0)
+	}
+
+	if s.SMTPMaxRetries == nil {
+		s.SMTPMaxRetries = NewInt(EmailSMTPDefaultMaxRetries)
+	}
+
+	if s.EnableReplyByEmail == nil {
+		s.EnableReplyByEmail = NewBool(false)
+	}
+
+	if s.ReplyByEmailAddress == nil {
+		s.ReplyByEmailAddress = NewString("")
+	}
+
+	if s.InboundEmailSecret == nil {
+		s.InboundEmailSecret = NewString("")
+	}
+
+	if s.EnableBounceHandling == nil {
+		s.EnableBounceHandling = NewBool(false)
+	}
+
+	if s.EmailEventsSecret == nil {
+		s.EmailEventsSecret = NewString("")
+	}
+
+	if s.EmailTemplateOverridesDirectory == nil {
+		s.EmailTemplateOverridesDirectory = NewString("")
+	}
+
 	if s.ConnectionSecurity == nil || *s.ConnectionSecurity == ConnSecurityPlain {
 		s.ConnectionSecurity = NewString(ConnSecurityNone)
 	}
@@ -1915,6 +2078,10 @@ func (s *EmailSettings) SetDefaults(isUpdate bool) {
 		s.PushNotificationBuffer = NewInt(1000)
 	}
 
+	if s.EnableWebPushNotifications == nil {
+		s.EnableWebPushNotifications = NewBool(false)
+	}
+
 	if s.EnableEmailBatching == nil {
 		s.EnableEmailBatching = NewBool(false)
 	}
@@ -2025,6 +2192,7 @@ type SupportSettings struct {
 	SupportEmail                           *string `access:"site_notifications"`
 	CustomTermsOfServiceEnabled            *bool   `access:"compliance_custom_terms_of_service"`
 	CustomTermsOfServiceReAcceptancePeriod *int    `access:"compliance_custom_terms_of_service"`
+	CustomTermsOfServiceGracePeriod        *int    `access:"compliance_custom_terms_of_service"`
 	EnableAskCommunityLink                 *bool   `access:"site_customization"`
 }
 
@@ -2089,6 +2257,10 @@ func (s *SupportSettings) SetDefaults() {
 		s.CustomTermsOfServiceReAcceptancePeriod = NewInt(SupportSettingsDefaultReAcceptancePeriod)
 	}
 
+	if s.CustomTermsOfServiceGracePeriod == nil {
+		s.CustomTermsOfServiceGracePeriod = NewInt(SupportSettingsDefaultGracePeriod)
+	}
+
 	if s.EnableAskCommunityLink == nil {
 		s.EnableAskCommunityLink = NewBool(true)
 	}
@@ -2331,6 +2503,7 @@ type LdapSettings struct {
 	PositionAttribute  *string `access:"authentication_ldap"`
 	LoginIdAttribute   *string `access:"authentication_ldap"`
 	PictureAttribute   *string `access:"authentication_ldap"`
+	ManagerAttribute   *string `access:"authentication_ldap"`
 
 	// Synchronization
 	SyncIntervalMinutes *int `access:"authentication_ldap"`
@@ -2455,6 +2628,10 @@ func (s *LdapSettings) SetDefaults() {
 		s.PictureAttribute = NewString(LdapSettingsDefaultPictureAttribute)
 	}
 
+	if s.ManagerAttribute == nil {
+		s.ManagerAttribute = NewString(LdapSettingsDefaultManagerAttribute)
+	}
+
 	// For those upgrading to the version when LoginIdAttribute was added
 	// they need IdAttribute == LoginIdAttribute not to break
 	if s.LoginIdAttribute == nil {
@@ -3205,6 +3382,34 @@ func (w *WranglerSettings) SetDefaults() {
 	}
 }
 
+// FeatureFlagSettings holds the targeting rules of the feature flags, by flag name.
+type FeatureFlagSettings struct {
+	Rules map[string]*FeatureFlagRule `access:"experimental_feature_flags"`
+}
+
+func (s *FeatureFlagSettings) SetDefaults() {
+	if s.Rules == nil {
+		s.Rules = make(map[string]*FeatureFlagRule)
+	}
+}
+
+func (s *FeatureFlagSettings) isValid() *AppError {
+	flags := (&FeatureFlags{}).ToMap()
+	for name, rule := range s.Rules {
+		if _, ok := flags[name]; !ok {
+			return NewAppError("Config.IsValid", "model.config.is_valid.feature_flag_rules.unknown_flag.app_error", map[string]any{"Name": name}, "", http.StatusBadRequest)
+		}
+		if rule == nil {
+			return NewAppError("Config.IsValid", "model.config.is_valid.feature_flag_rules.empty_rule.app_error", map[string]any{"Name": name}, "", http.StatusBadRequest)
+		}
+		if appErr := rule.IsValid(); appErr != nil {
+			return appErr
+		}
+	}
+
+	return nil
+}
+
 func (w *WranglerSettings) IsValid() *AppError {
 	validDomainRegex := regexp.MustCompile(`^(([a-zA-Z]{1})|([a-zA-Z]{1}[a-zA-Z]{1})|([a-zA-Z]{1}[0-9]{1})|([0-9]{1}[a-zA-Z]{1})|([a-zA-Z0-9][a-zA-Z0-9-_]{1,61}[a-zA-Z0-9]))\.([a-zA-Z]{2,6}|[a-zA-Z0-9-]{2,30}\.[a-zA-Z]{2,3})$`)
 	for _, domain := range w.AllowedEmailDomain {
@@ -3426,6 +3631,118 @@ func (s *ExportSettings) SetDefaults() {
 	}
 }
 
+// TracingSettings configures the export of the traces of the requests to an OpenTelemetry
+// collector over OTLP. SamplePercentage applies to the requests which don't carry a trace
+// context and to those whose remote parent is sampled, so that clients can't force a request
+// to be traced. The changes are applied when the server restarts.
+type TracingSettings struct {
+	Enable           *bool   `access:"environment_performance_monitoring,write_restrictable,cloud_restrictable"`
+	ServiceName      *string `access:"environment_performance_monitoring,write_restrictable,cloud_restrictable"` // telemetry: none
+	Endpoint         *string `access:"environment_performance_monitoring,write_restrictable,cloud_restrictable"` // telemetry: none
+	Protocol         *string `access:"environment_performance_monitoring,write_restrictable,cloud_restrictable"`
+	Insecure         *bool   `access:"environment_performance_monitoring,write_restrictable,cloud_restrictable"`
+	SamplePercentage *int    `access:"environment_performance_monitoring,write_restrictable,cloud_restrictable"`
+}
+
+func (s *TracingSettings) SetDefaults() {
+	if s.Enable == nil {
+		s.Enable = NewBool(false)
+	}
+
+	if s.ServiceName == nil || *s.ServiceName == "" {
+		s.ServiceName = NewString(TracingSettingsDefaultServiceName)
+	}
+
+	if s.Endpoint == nil {
+		s.Endpoint = NewString(TracingSettingsDefaultEndpoint)
+	}
+
+	if s.Protocol == nil {
+		s.Protocol = NewString(TracingProtocolHTTP)
+	}
+
+	if s.Insecure == nil {
+		s.Insecure = NewBool(false)
+	}
+
+	if s.SamplePercentage == nil {
+		s.SamplePercentage = NewInt(TracingSettingsDefaultSamplePercentage)
+	}
+}
+
+func (s *TracingSettings) isValid() *AppError {
+	if !*s.Enable {
+		return nil
+	}
+
+	if *s.Endpoint == "" {
+		return NewAppError("Config.IsValid", "model.config.is_valid.tracing.endpoint.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if *s.Protocol != TracingProtocolHTTP && *s.Protocol != TracingProtocolGRPC {
+		return NewAppError("Config.IsValid", "model.config.is_valid.tracing.protocol.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if *s.SamplePercentage < 0 || *s.SamplePercentage > 100 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.tracing.sample_percentage.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+// SlowOperationSettings configures the capture of the slowest store queries and API requests
+// of each server. Only the operations exceeding the thresholds are captured, and the MaxEntries
+// slowest of each kind are kept per interval.
+type SlowOperationSettings struct {
+	Enable                       *bool `access:"environment_performance_monitoring,write_restrictable,cloud_restrictable"`
+	QueryThresholdMilliseconds   *int  `access:"environment_performance_monitoring,write_restrictable,cloud_restrictable"`
+	RequestThresholdMilliseconds *int  `access:"environment_performance_monitoring,write_restrictable,cloud_restrictable"`
+	MaxEntries                   *int  `access:"environment_performance_monitoring,write_restrictable,cloud_restrictable"`
+	IntervalMinutes              *int  `access:"environment_performance_monitoring,write_restrictable,cloud_restrictable"`
+}
+
+func (s *SlowOperationSettings) SetDefaults() {
+	if s.Enable == nil {
+		s.Enable = NewBool(false)
+	}
+
+	if s.QueryThresholdMilliseconds == nil {
+		s.QueryThresholdMilliseconds = NewInt(SlowOperationSettingsDefaultQueryThresholdMilliseconds)
+	}
+
+	if s.RequestThresholdMilliseconds == nil {
+		s.RequestThresholdMilliseconds = NewInt(SlowOperationSettingsDefaultRequestThresholdMilliseconds)
+	}
+
+	if s.MaxEntries == nil {
+		s.MaxEntries = NewInt(SlowOperationSettingsDefaultMaxEntries)
+	}
+
+	if s.IntervalMinutes == nil {
+		s.IntervalMinutes = NewInt(SlowOperationSettingsDefaultIntervalMinutes)
+	}
+}
+
+func (s *SlowOperationSettings) isValid() *AppError {
+	if *s.QueryThresholdMilliseconds < 1 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.slow_operation.query_threshold.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if *s.RequestThresholdMilliseconds < 1 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.slow_operation.request_threshold.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if *s.MaxEntries < 1 || *s.MaxEntries > SlowOperationSettingsMaxEntries {
+		return NewAppError("Config.IsValid", "model.config.is_valid.slow_operation.max_entries.app_error", map[string]any{"Max": SlowOperationSettingsMaxEntries}, "", http.StatusBadRequest)
+	}
+
+	if *s.IntervalMinutes < 1 || *s.IntervalMinutes > SlowOperationSettingsMaxIntervalMinutes {
+		return NewAppError("Config.IsValid", "model.config.is_valid.slow_operation.interval.app_error", map[string]any{"Max": SlowOperationSettingsMaxIntervalMinutes}, "", http.StatusBadRequest)
+	}
+
+	return nil
+}
+
 type ConfigFunc func() *Config
 
 const ConfigAccessTagType = "access"
@@ -3514,6 +3831,9 @@ type Config struct {
 	ImportSettings            ImportSettings // telemetry: none
 	ExportSettings            ExportSettings
 	WranglerSettings          WranglerSettings
+	FeatureFlagSettings       FeatureFlagSettings // telemetry: none
+	TracingSettings           TracingSettings
+	SlowOperationSettings     SlowOperationSettings
 }
 
 func (o *Config) Auditable() map[string]interface{} {
@@ -3630,102 +3950,99 @@ func (o *Config) SetDefaults() {
 	o.ImportSettings.SetDefaults()
 	o.ExportSettings.SetDefaults()
 	o.WranglerSettings.SetDefaults()
+	o.FeatureFlagSettings.SetDefaults()
+	o.TracingSettings.SetDefaults()
+	o.SlowOperationSettings.SetDefaults()
 }
 
-func (o *Config) IsValid() *AppError {
-	if *o.ServiceSettings.SiteURL == "" && *o.EmailSettings.EnableEmailBatching {
-		return NewAppError("Config.IsValid", "model.config.is_valid.site_url_email_batching.app_error", nil, "", http.StatusBadRequest)
-	}
-
-	if *o.ClusterSettings.Enable && *o.EmailSettings.EnableEmailBatching {
-		return NewAppError("Config.IsValid", "model.config.is_valid.cluster_email_batching.app_error", nil, "", http.StatusBadRequest)
-	}
-
-	if *o.ServiceSettings.SiteURL == "" && *o.ServiceSettings.AllowCookiesForSubdomains {
-		return NewAppError("Config.IsValid", "model.config.is_valid.allow_cookies_for_subdomains.app_error", nil, "", http.StatusBadRequest)
-	}
-
-	if appErr := o.TeamSettings.isValid(); appErr != nil {
-		return appErr
-	}
-
-	if appErr := o.ExperimentalSettings.isValid(); appErr != nil {
-		return appErr
-	}
-
-	if appErr := o.SqlSettings.isValid(); appErr != nil {
-		return appErr
-	}
-
-	if appErr := o.FileSettings.isValid(); appErr != nil {
-		return appErr
-	}
-
-	if appErr := o.EmailSettings.isValid(); appErr != nil {
-		return appErr
-	}
-
-	if appErr := o.LdapSettings.isValid(); appErr != nil {
-		return appErr
-	}
-
-	if appErr := o.SamlSettings.isValid(); appErr != nil {
-		return appErr
-	}
-
-	if *o.PasswordSettings.MinimumLength < PasswordMinimumLength || *o.PasswordSettings.MinimumLength > PasswordMaximumLength {
-		return NewAppError("Config.IsValid", "model.config.is_valid.password_length.app_error", map[string]any{"MinLength": PasswordMinimumLength, "MaxLength": PasswordMaximumLength}, "", http.StatusBadRequest)
-	}
-
-	if appErr := o.RateLimitSettings.isValid(); appErr != nil {
-		return appErr
-	}
-
-	if appErr := o.ServiceSettings.isValid(); appErr != nil {
-		return appErr
-	}
-
-	if appErr := o.ElasticsearchSettings.isValid(); appErr != nil {
-		return appErr
-	}
-
-	if appErr := o.BleveSettings.isValid(); appErr != nil {
-		return appErr
-	}
-
-	if appErr := o.DataRetentionSettings.isValid(); appErr != nil {
-		return appErr
-	}
-
-	if appErr := o.LogSettings.isValid(); appErr != nil {
-		return appErr
-	}
+// ConfigValidationError is a validation error of a config setting. The field is the settings
+// section, e.g. TeamSettings, unless the error comes from a single setting.
+type ConfigValidationError struct {
+	Field string    `json:"field"`
+	Error *AppError `json:"error"`
+}
 
-	if appErr := o.LocalizationSettings.isValid(); appErr != nil {
-		return appErr
-	}
+// ConfigValidationResult is the outcome of validating a config without applying it.
+type ConfigValidationResult struct {
+	Valid  bool                     `json:"valid"`
+	Errors []*ConfigValidationError `json:"errors"`
+}
 
-	if appErr := o.MessageExportSettings.isValid(); appErr != nil {
-		return appErr
-	}
+type configValidator struct {
+	field   string
+	isValid func() *AppError
+}
 
-	if appErr := o.DisplaySettings.isValid(); appErr != nil {
-		return appErr
+func (o *Config) validators() []configValidator {
+	return []configValidator{
+		{"EmailSettings.EnableEmailBatching", func() *AppError {
+			if *o.ServiceSettings.SiteURL == "" && *o.EmailSettings.EnableEmailBatching {
+				return NewAppError("Config.IsValid", "model.config.is_valid.site_url_email_batching.app_error", nil, "", http.StatusBadRequest)
+			}
+			if *o.ClusterSettings.Enable && *o.EmailSettings.EnableEmailBatching {
+				return NewAppError("Config.IsValid", "model.config.is_valid.cluster_email_batching.app_error", nil, "", http.StatusBadRequest)
+			}
+			return nil
+		}},
+		{"ServiceSettings.AllowCookiesForSubdomains", func() *AppError {
+			if *o.ServiceSettings.SiteURL == "" && *o.ServiceSettings.AllowCookiesForSubdomains {
+				return NewAppError("Config.IsValid", "model.config.is_valid.allow_cookies_for_subdomains.app_error", nil, "", http.StatusBadRequest)
+			}
+			return nil
+		}},
+		{"TeamSettings", o.TeamSettings.isValid},
+		{"ExperimentalSettings", o.ExperimentalSettings.isValid},
+		{"SqlSettings", o.SqlSettings.isValid},
+		{"FileSettings", o.FileSettings.isValid},
+		{"EmailSettings", o.EmailSettings.isValid},
+		{"LdapSettings", o.LdapSettings.isValid},
+		{"SamlSettings", o.SamlSettings.isValid},
+		{"PasswordSettings.MinimumLength", func() *AppError {
+			if *o.PasswordSettings.MinimumLength < PasswordMinimumLength || *o.PasswordSettings.MinimumLength > PasswordMaximumLength {
+				return NewAppError("Config.IsValid", "model.config.is_valid.password_length.app_error", map[string]any{"MinLength": PasswordMinimumLength, "MaxLength": PasswordMaximumLength}, "", http.StatusBadRequest)
+			}
+			return nil
+		}},
+		{"RateLimitSettings", o.RateLimitSettings.isValid},
+		{"ServiceSettings", o.ServiceSettings.isValid},
+		{"ElasticsearchSettings", o.ElasticsearchSettings.isValid},
+		{"BleveSettings", o.BleveSettings.isValid},
+		{"DataRetentionSettings", o.DataRetentionSettings.isValid},
+		{"LogSettings", o.LogSettings.isValid},
+		{"NotificationLogSettings", o.NotificationLogSettings.isValid},
+		{"LocalizationSettings", o.LocalizationSettings.isValid},
+		{"MessageExportSettings", o.MessageExportSettings.isValid},
+		{"DisplaySettings", o.DisplaySettings.isValid},
+		{"ImageProxySettings", o.ImageProxySettings.isValid},
+		{"ImportSettings", o.ImportSettings.isValid},
+		{"WranglerSettings", o.WranglerSettings.IsValid},
+		{"FeatureFlagSettings", o.FeatureFlagSettings.isValid},
+		{"TracingSettings", o.TracingSettings.isValid},
+		{"SlowOperationSettings", o.SlowOperationSettings.isValid},
 	}
+}
 
-	if appErr := o.ImageProxySettings.isValid(); appErr != nil {
-		return appErr
+func (o *Config) IsValid() *AppError {
+	for _, validator := range o.validators() {
+		if appErr := validator.isValid(); appErr != nil {
+			return appErr
+		}
 	}
 
-	if appErr := o.ImportSettings.isValid(); appErr != nil {
-		return appErr
-	}
+	return nil
+}
 
-	if appErr := o.WranglerSettings.IsValid(); appErr != nil {
-		return appErr
+// ValidationErrors returns the validation errors of all the settings sections, where IsValid
+// stops at the first one.
+func (o *Config) ValidationErrors() []*ConfigValidationError {
+	var validationErrors []*ConfigValidationError
+	for _, validator := range o.validators() {
+		if appErr := validator.isValid(); appErr != nil {
+			validationErrors = append(validationErrors, &ConfigValidationError{Field: validator.field, Error: appErr})
+		}
 	}
 
-	return nil
+	return validationErrors
 }
 
 func (s *TeamSettings) isValid() *AppError {
@@ -3829,6 +4146,10 @@ func (s *FileSettings) isValid() *AppError {
 		return NewAppError("Config.IsValid", "model.config.is_valid.amazons3_timeout.app_error", map[string]any{"Value": *s.MaxImageDecoderConcurrency}, "", http.StatusBadRequest)
 	}
 
+	if *s.MaxFileShareLinkExpiryHours <= 0 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.max_file_share_link_expiry_hours.app_error", nil, "", http.StatusBadRequest)
+	}
+
 	return nil
 }
 
@@ -3845,6 +4166,32 @@ func (s *EmailSettings) isValid() *AppError {
 		return NewAppError("Config.IsValid", "model.config.is_valid.email_batching_interval.app_error", nil, "", http.StatusBadRequest)
 	}
 
+	if *s.SMTPMaxConnections <= 0 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.smtp_max_connections.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if *s.SMTPMaxMessagesPerSecond < 0 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.smtp_max_messages_per_second.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if *s.SMTPMaxRetries < 0 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.smtp_max_retries.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if *s.EnableReplyByEmail {
+		if !IsValidEmail(*s.ReplyByEmailAddress) {
+			return NewAppError("Config.IsValid", "model.config.is_valid.reply_by_email_address.app_error", nil, "", http.StatusBadRequest)
+		}
+
+		if len(*s.InboundEmailSecret) < InboundEmailSecretMinLength {
+			return NewAppError("Config.IsValid", "model.config.is_valid.inbound_email_secret.app_error", map[string]any{"MinLength": InboundEmailSecretMinLength}, "", http.StatusBadRequest)
+		}
+	}
+
+	if *s.EnableBounceHandling && len(*s.EmailEventsSecret) < EmailEventsSecretMinLength {
+		return NewAppError("Config.IsValid", "model.config.is_valid.email_events_secret.app_error", map[string]any{"MinLength": EmailEventsSecretMinLength}, "", http.StatusBadRequest)
+	}
+
 	if !(*s.EmailNotificationContentsType == EmailNotificationContentsFull || *s.EmailNotificationContentsType == EmailNotificationContentsGeneric) {
 		return NewAppError("Config.IsValid", "model.config.is_valid.email_notification_contents_type.app_error", nil, "", http.StatusBadRequest)
 	}
@@ -4038,6 +4385,14 @@ func (s *ServiceSettings) isValid() *AppError {
 		return NewAppError("Config.IsValid", "model.config.is_valid.max_payload_size.app_error", nil, "", http.StatusBadRequest)
 	}
 
+	if *s.MaintenanceModeRetryAfterSeconds <= 0 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.maintenance_mode_retry_after.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if utf8.RuneCountInString(*s.MaintenanceModeMessage) > MaintenanceModeMessageMaxRunes {
+		return NewAppError("Config.IsValid", "model.config.is_valid.maintenance_mode_message.app_error", map[string]any{"MaxLength": MaintenanceModeMessageMaxRunes}, "", http.StatusBadRequest)
+	}
+
 	if *s.ReadTimeout <= 0 {
 		return NewAppError("Config.IsValid", "model.config.is_valid.read_timeout.app_error", nil, "", http.StatusBadRequest)
 	}
@@ -4050,10 +4405,18 @@ func (s *ServiceSettings) isValid() *AppError {
 		return NewAppError("Config.IsValid", "model.config.is_valid.time_between_user_typing.app_error", nil, "", http.StatusBadRequest)
 	}
 
+	if *s.TypingAggregationWindowMilliseconds < 0 || *s.TypingAggregationWindowMilliseconds >= *s.TimeBetweenUserTypingUpdatesMilliseconds {
+		return NewAppError("Config.IsValid", "model.config.is_valid.typing_aggregation_window.app_error", nil, "", http.StatusBadRequest)
+	}
+
 	if *s.MaximumLoginAttempts <= 0 {
 		return NewAppError("Config.IsValid", "model.config.is_valid.login_attempts.app_error", nil, "", http.StatusBadRequest)
 	}
 
+	if *s.UserAccessTokenGracePeriodMinutes < 0 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.user_access_token_grace_period.app_error", nil, "", http.StatusBadRequest)
+	}
+
 	if *s.SiteURL != "" {
 		if _, err := url.ParseRequestURI(*s.SiteURL); err != nil {
 			return NewAppError("Config.IsValid", "model.config.is_valid.site_url.app_error", nil, "", http.StatusBadRequest).Wrap(err)
@@ -4082,6 +4445,14 @@ func (s *ServiceSettings) isValid() *AppError {
 		return NewAppError("Config.IsValid", "model.config.is_valid.outgoing_integrations_request_timeout.app_error", nil, "", http.StatusBadRequest)
 	}
 
+	if *s.IntegrationDeliveryLogRetentionDays <= 0 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.integration_delivery_log_retention_days.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if *s.IncomingWebhookRateLimitPerMinute < 0 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.incoming_webhook_rate_limit_per_minute.app_error", nil, "", http.StatusBadRequest)
+	}
+
 	if *s.ExperimentalGroupUnreadChannels != GroupUnreadChannelsDisabled &&
 		*s.ExperimentalGroupUnreadChannels != GroupUnreadChannelsDefaultOn &&
 		*s.ExperimentalGroupUnreadChannels != GroupUnreadChannelsDefaultOff {
@@ -4345,6 +4716,14 @@ func (o *Config) Sanitize() {
 		*o.EmailSettings.SMTPPassword = FakeSetting
 	}
 
+	if o.EmailSettings.InboundEmailSecret != nil && *o.EmailSettings.InboundEmailSecret != "" {
+		*o.EmailSettings.InboundEmailSecret = FakeSetting
+	}
+
+	if o.EmailSettings.EmailEventsSecret != nil && *o.EmailSettings.EmailEventsSecret != "" {
+		*o.EmailSettings.EmailEventsSecret = FakeSetting
+	}
+
 	if o.GitLabSettings.Secret != nil && *o.GitLabSettings.Secret != "" {
 		*o.GitLabSettings.Secret = FakeSetting
 	}
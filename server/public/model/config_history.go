@@ -0,0 +1,47 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+)
+
+const ConfigHistoryMaxPerPage = 200
+
+// ConfigHistory records a change made to the configuration through the API. Config holds the
+// whole configuration after the change so it can be restored later, and is never returned to
+// clients. Diff holds the sanitized JSON list of the changed settings.
+type ConfigHistory struct {
+	Id       string `json:"id"`
+	Version  int64  `json:"version"`
+	UserId   string `json:"user_id"`
+	CreateAt int64  `json:"create_at"`
+	Diff     string `json:"diff"`
+	Config   string `json:"-"`
+}
+
+func (h *ConfigHistory) PreSave() {
+	if h.Id == "" {
+		h.Id = NewId()
+	}
+	if h.CreateAt == 0 {
+		h.CreateAt = GetMillis()
+	}
+}
+
+func (h *ConfigHistory) IsValid() *AppError {
+	if !IsValidId(h.Id) {
+		return NewAppError("ConfigHistory.IsValid", "model.config_history.is_valid.id.app_error", nil, "", http.StatusBadRequest)
+	}
+	if h.UserId != "" && !IsValidId(h.UserId) {
+		return NewAppError("ConfigHistory.IsValid", "model.config_history.is_valid.user_id.app_error", nil, "id="+h.Id, http.StatusBadRequest)
+	}
+	if h.Version < 1 {
+		return NewAppError("ConfigHistory.IsValid", "model.config_history.is_valid.version.app_error", nil, "id="+h.Id, http.StatusBadRequest)
+	}
+	if h.Config == "" {
+		return NewAppError("ConfigHistory.IsValid", "model.config_history.is_valid.config.app_error", nil, "id="+h.Id, http.StatusBadRequest)
+	}
+	return nil
+}
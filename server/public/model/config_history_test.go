@@ -0,0 +1,29 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigHistoryIsValid(t *testing.T) {
+	history := &ConfigHistory{Version: 1, Config: "{}"}
+	history.PreSave()
+	require.Nil(t, history.IsValid())
+	assert.NotZero(t, history.CreateAt)
+
+	history.UserId = "junk"
+	assert.NotNil(t, history.IsValid())
+
+	history.UserId = NewId()
+	history.Version = 0
+	assert.NotNil(t, history.IsValid())
+
+	history.Version = 2
+	history.Config = ""
+	assert.NotNil(t, history.IsValid())
+}
@@ -221,6 +221,30 @@ func TestConfigIsValidDefaultAlgorithms(t *testing.T) {
 	require.Nil(t, appErr)
 }
 
+func TestConfigValidationErrors(t *testing.T) {
+	c1 := Config{}
+	c1.SetDefaults()
+	require.Empty(t, c1.ValidationErrors())
+
+	*c1.TeamSettings.MaxUsersPerTeam = 0
+	*c1.PasswordSettings.MinimumLength = 1
+	*c1.ServiceSettings.SiteURL = ""
+	*c1.ServiceSettings.AllowCookiesForSubdomains = true
+
+	validationErrors := c1.ValidationErrors()
+	require.Len(t, validationErrors, 3)
+	assert.Equal(t, "ServiceSettings.AllowCookiesForSubdomains", validationErrors[0].Field)
+	assert.Equal(t, "model.config.is_valid.allow_cookies_for_subdomains.app_error", validationErrors[0].Error.Id)
+	assert.Equal(t, "TeamSettings", validationErrors[1].Field)
+	assert.Equal(t, "model.config.is_valid.max_users.app_error", validationErrors[1].Error.Id)
+	assert.Equal(t, "PasswordSettings.MinimumLength", validationErrors[2].Field)
+	assert.Equal(t, "model.config.is_valid.password_length.app_error", validationErrors[2].Error.Id)
+
+	appErr := c1.IsValid()
+	require.NotNil(t, appErr)
+	assert.Equal(t, validationErrors[0].Error.Id, appErr.Id)
+}
+
 func TestConfigServiceProviderDefault(t *testing.T) {
 	c1 := &Config{
 		SamlSettings: SamlSettings{
@@ -474,6 +498,19 @@ func TestMessageExportSettingsIsValidExportFormatInvalid(t *testing.T) {
 	require.NotNil(t, mes.isValid())
 }
 
+func TestMessageExportSettingsIsValidExportFormatJsonl(t *testing.T) {
+	mes := &MessageExportSettings{
+		EnableExport:        NewBool(true),
+		ExportFormat:        NewString(ComplianceExportTypeJsonl),
+		ExportFromTimestamp: NewInt64(0),
+		DailyRunTime:        NewString("15:04"),
+		BatchSize:           NewInt(100),
+	}
+
+	// should fail because the jsonl format is only produced by the CLI export
+	require.NotNil(t, mes.isValid())
+}
+
 func TestMessageExportSettingsIsValidGlobalRelayEmailAddressInvalid(t *testing.T) {
 	mes := &MessageExportSettings{
 		EnableExport:        NewBool(true),
@@ -1383,6 +1420,8 @@ func TestConfigSanitize(t *testing.T) {
 	*c.LdapSettings.BindPassword = "foo"
 	*c.FileSettings.AmazonS3SecretAccessKey = "bar"
 	*c.EmailSettings.SMTPPassword = "baz"
+	*c.EmailSettings.InboundEmailSecret = "inbound"
+	*c.EmailSettings.EmailEventsSecret = "events"
 	*c.GitLabSettings.Secret = "bingo"
 	*c.OpenIdSettings.Secret = "secret"
 	c.SqlSettings.DataSourceReplicas = []string{"stuff"}
@@ -1399,6 +1438,8 @@ func TestConfigSanitize(t *testing.T) {
 	assert.Equal(t, FakeSetting, *c.FileSettings.PublicLinkSalt)
 	assert.Equal(t, FakeSetting, *c.FileSettings.AmazonS3SecretAccessKey)
 	assert.Equal(t, FakeSetting, *c.EmailSettings.SMTPPassword)
+	assert.Equal(t, FakeSetting, *c.EmailSettings.InboundEmailSecret)
+	assert.Equal(t, FakeSetting, *c.EmailSettings.EmailEventsSecret)
 	assert.Equal(t, FakeSetting, *c.GitLabSettings.Secret)
 	assert.Equal(t, FakeSetting, *c.OpenIdSettings.Secret)
 	assert.Equal(t, FakeSetting, *c.SqlSettings.DataSource)
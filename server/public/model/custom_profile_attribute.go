@@ -0,0 +1,178 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+	"net/url"
+	"regexp"
+	"slices"
+	"unicode/utf8"
+)
+
+const (
+	CustomProfileAttributeTypeText   = "text"
+	CustomProfileAttributeTypeSelect = "select"
+	CustomProfileAttributeTypeURL    = "url"
+
+	// CustomProfileAttributeVisibilityAlways fields are returned to everyone who can see the user.
+	CustomProfileAttributeVisibilityAlways = "always"
+	// CustomProfileAttributeVisibilityHidden fields are only returned to the user and to admins.
+	CustomProfileAttributeVisibilityHidden = "hidden"
+
+	CustomProfileAttributeFieldsMax         = 20
+	CustomProfileAttributeNameMaxRunes      = 64
+	CustomProfileAttributeOptionsMax        = 100
+	CustomProfileAttributeOptionMaxRunes    = 64
+	CustomProfileAttributeRegexMaxLength    = 256
+	CustomProfileAttributeAuthAttrMaxLength = 128
+	CustomProfileAttributeValueMaxRunes     = 256
+)
+
+// CustomProfileAttributeField is a profile field defined by the admins, such as a cost center or a
+// location. Users have at most one value per field.
+type CustomProfileAttributeField struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+	Type string `json:"type"`
+	// Options are the accepted values of select fields.
+	Options StringArray `json:"options"`
+	// ValidationRegex, when set, must match the values of text fields.
+	ValidationRegex string `json:"validation_regex"`
+	Visibility      string `json:"visibility"`
+	// LdapAttribute and SamlAttribute name the attributes of the identity provider the values of
+	// the users are synchronized from. Values of synchronized fields can't be edited by the users.
+	LdapAttribute string `json:"ldap_attribute"`
+	SamlAttribute string `json:"saml_attribute"`
+	SortOrder     int    `json:"sort_order"`
+	CreateAt      int64  `json:"create_at"`
+	UpdateAt      int64  `json:"update_at"`
+	DeleteAt      int64  `json:"delete_at"`
+}
+
+// CustomProfileAttributeValue is the value of a custom profile field for a user.
+type CustomProfileAttributeValue struct {
+	FieldId  string `json:"field_id"`
+	UserId   string `json:"user_id"`
+	Value    string `json:"value"`
+	UpdateAt int64  `json:"update_at"`
+}
+
+func (f *CustomProfileAttributeField) Auditable() map[string]any {
+	return map[string]any{
+		"id":               f.Id,
+		"name":             f.Name,
+		"type":             f.Type,
+		"options":          f.Options,
+		"validation_regex": f.ValidationRegex,
+		"visibility":       f.Visibility,
+		"ldap_attribute":   f.LdapAttribute,
+		"saml_attribute":   f.SamlAttribute,
+		"sort_order":       f.SortOrder,
+		"create_at":        f.CreateAt,
+		"update_at":        f.UpdateAt,
+		"delete_at":        f.DeleteAt,
+	}
+}
+
+func (f *CustomProfileAttributeField) PreSave() {
+	if f.Id == "" {
+		f.Id = NewId()
+	}
+
+	if f.Visibility == "" {
+		f.Visibility = CustomProfileAttributeVisibilityAlways
+	}
+
+	f.CreateAt = GetMillis()
+	f.UpdateAt = f.CreateAt
+	f.DeleteAt = 0
+}
+
+func (f *CustomProfileAttributeField) PreUpdate() {
+	f.UpdateAt = GetMillis()
+}
+
+// IsSynced returns whether the values of the field come from an identity provider.
+func (f *CustomProfileAttributeField) IsSynced() bool {
+	return f.LdapAttribute != "" || f.SamlAttribute != ""
+}
+
+func (f *CustomProfileAttributeField) IsValid() *AppError {
+	if !IsValidId(f.Id) {
+		return NewAppError("CustomProfileAttributeField.IsValid", "model.custom_profile_attribute.is_valid.id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if f.Name == "" || utf8.RuneCountInString(f.Name) > CustomProfileAttributeNameMaxRunes {
+		return NewAppError("CustomProfileAttributeField.IsValid", "model.custom_profile_attribute.is_valid.name.app_error", map[string]any{"Max": CustomProfileAttributeNameMaxRunes}, "id="+f.Id, http.StatusBadRequest)
+	}
+
+	switch f.Type {
+	case CustomProfileAttributeTypeText, CustomProfileAttributeTypeURL:
+		if len(f.Options) > 0 {
+			return NewAppError("CustomProfileAttributeField.IsValid", "model.custom_profile_attribute.is_valid.options.app_error", nil, "id="+f.Id, http.StatusBadRequest)
+		}
+	case CustomProfileAttributeTypeSelect:
+		if len(f.Options) == 0 || len(f.Options) > CustomProfileAttributeOptionsMax {
+			return NewAppError("CustomProfileAttributeField.IsValid", "model.custom_profile_attribute.is_valid.options.app_error", nil, "id="+f.Id, http.StatusBadRequest)
+		}
+		for _, option := range f.Options {
+			if option == "" || utf8.RuneCountInString(option) > CustomProfileAttributeOptionMaxRunes {
+				return NewAppError("CustomProfileAttributeField.IsValid", "model.custom_profile_attribute.is_valid.options.app_error", nil, "id="+f.Id, http.StatusBadRequest)
+			}
+		}
+	default:
+		return NewAppError("CustomProfileAttributeField.IsValid", "model.custom_profile_attribute.is_valid.type.app_error", nil, "id="+f.Id, http.StatusBadRequest)
+	}
+
+	if f.ValidationRegex != "" {
+		if f.Type != CustomProfileAttributeTypeText || len(f.ValidationRegex) > CustomProfileAttributeRegexMaxLength {
+			return NewAppError("CustomProfileAttributeField.IsValid", "model.custom_profile_attribute.is_valid.validation_regex.app_error", nil, "id="+f.Id, http.StatusBadRequest)
+		}
+		if _, err := regexp.Compile(f.ValidationRegex); err != nil {
+			return NewAppError("CustomProfileAttributeField.IsValid", "model.custom_profile_attribute.is_valid.validation_regex.app_error", nil, "id="+f.Id, http.StatusBadRequest).Wrap(err)
+		}
+	}
+
+	if f.Visibility != CustomProfileAttributeVisibilityAlways && f.Visibility != CustomProfileAttributeVisibilityHidden {
+		return NewAppError("CustomProfileAttributeField.IsValid", "model.custom_profile_attribute.is_valid.visibility.app_error", nil, "id="+f.Id, http.StatusBadRequest)
+	}
+
+	if len(f.LdapAttribute) > CustomProfileAttributeAuthAttrMaxLength || len(f.SamlAttribute) > CustomProfileAttributeAuthAttrMaxLength {
+		return NewAppError("CustomProfileAttributeField.IsValid", "model.custom_profile_attribute.is_valid.auth_attribute.app_error", nil, "id="+f.Id, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+// ValidateValue checks that the value is accepted by the field. The empty value, clearing the
+// value of the user, is always accepted.
+func (f *CustomProfileAttributeField) ValidateValue(value string) *AppError {
+	if value == "" {
+		return nil
+	}
+
+	if utf8.RuneCountInString(value) > CustomProfileAttributeValueMaxRunes {
+		return NewAppError("CustomProfileAttributeField.ValidateValue", "model.custom_profile_attribute.value.too_long.app_error", map[string]any{"Name": f.Name, "Max": CustomProfileAttributeValueMaxRunes}, "", http.StatusBadRequest)
+	}
+
+	switch f.Type {
+	case CustomProfileAttributeTypeSelect:
+		if !slices.Contains(f.Options, value) {
+			return NewAppError("CustomProfileAttributeField.ValidateValue", "model.custom_profile_attribute.value.invalid.app_error", map[string]any{"Name": f.Name}, "", http.StatusBadRequest)
+		}
+	case CustomProfileAttributeTypeURL:
+		if u, err := url.ParseRequestURI(value); err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+			return NewAppError("CustomProfileAttributeField.ValidateValue", "model.custom_profile_attribute.value.invalid.app_error", map[string]any{"Name": f.Name}, "", http.StatusBadRequest)
+		}
+	case CustomProfileAttributeTypeText:
+		if f.ValidationRegex != "" {
+			if re, err := regexp.Compile(f.ValidationRegex); err != nil || !re.MatchString(value) {
+				return NewAppError("CustomProfileAttributeField.ValidateValue", "model.custom_profile_attribute.value.invalid.app_error", map[string]any{"Name": f.Name}, "", http.StatusBadRequest)
+			}
+		}
+	}
+
+	return nil
+}
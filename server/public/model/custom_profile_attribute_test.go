@@ -0,0 +1,74 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCustomProfileAttributeFieldIsValid(t *testing.T) {
+	newField := func() *CustomProfileAttributeField {
+		field := &CustomProfileAttributeField{
+			Name: "Cost center",
+			Type: CustomProfileAttributeTypeText,
+		}
+		field.PreSave()
+		return field
+	}
+
+	require.Nil(t, newField().IsValid())
+	assert.Equal(t, CustomProfileAttributeVisibilityAlways, newField().Visibility)
+
+	for name, tc := range map[string]func(f *CustomProfileAttributeField){
+		"invalid id":   func(f *CustomProfileAttributeField) { f.Id = "cost-center" },
+		"missing name": func(f *CustomProfileAttributeField) { f.Name = "" },
+		"long name": func(f *CustomProfileAttributeField) {
+			f.Name = strings.Repeat("a", CustomProfileAttributeNameMaxRunes+1)
+		},
+		"unknown type":      func(f *CustomProfileAttributeField) { f.Type = "date" },
+		"text with options": func(f *CustomProfileAttributeField) { f.Options = StringArray{"a"} },
+		"select no options": func(f *CustomProfileAttributeField) { f.Type = CustomProfileAttributeTypeSelect },
+		"select empty option": func(f *CustomProfileAttributeField) {
+			f.Type, f.Options = CustomProfileAttributeTypeSelect, StringArray{""}
+		},
+		"invalid regex": func(f *CustomProfileAttributeField) { f.ValidationRegex = "[" },
+		"regex on url": func(f *CustomProfileAttributeField) {
+			f.Type, f.ValidationRegex = CustomProfileAttributeTypeURL, "^https"
+		},
+		"unknown visibility": func(f *CustomProfileAttributeField) { f.Visibility = "admins" },
+		"long LDAP attribute": func(f *CustomProfileAttributeField) {
+			f.LdapAttribute = strings.Repeat("a", CustomProfileAttributeAuthAttrMaxLength+1)
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			field := newField()
+			tc(field)
+			assert.NotNil(t, field.IsValid())
+		})
+	}
+}
+
+func TestCustomProfileAttributeFieldValidateValue(t *testing.T) {
+	text := &CustomProfileAttributeField{Name: "Cost center", Type: CustomProfileAttributeTypeText, ValidationRegex: "^[0-9]{4}$"}
+	assert.Nil(t, text.ValidateValue(""))
+	assert.Nil(t, text.ValidateValue("1234"))
+	assert.NotNil(t, text.ValidateValue("12345"))
+
+	free := &CustomProfileAttributeField{Name: "Team", Type: CustomProfileAttributeTypeText}
+	assert.Nil(t, free.ValidateValue("Platform"))
+	assert.NotNil(t, free.ValidateValue(strings.Repeat("a", CustomProfileAttributeValueMaxRunes+1)))
+
+	selectField := &CustomProfileAttributeField{Name: "Location", Type: CustomProfileAttributeTypeSelect, Options: StringArray{"Berlin", "Toronto"}}
+	assert.Nil(t, selectField.ValidateValue("Berlin"))
+	assert.NotNil(t, selectField.ValidateValue("Paris"))
+
+	urlField := &CustomProfileAttributeField{Name: "Website", Type: CustomProfileAttributeTypeURL}
+	assert.Nil(t, urlField.ValidateValue("https://example.com/me"))
+	assert.NotNil(t, urlField.ValidateValue("example.com"))
+	assert.NotNil(t, urlField.ValidateValue("javascript:alert(1)"))
+}
@@ -0,0 +1,112 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+)
+
+const (
+	DataSeedMaxTeams           = 100
+	DataSeedMaxChannelsPerTeam = 500
+	DataSeedMaxUsers           = 50000
+	DataSeedMaxPosts           = 5000000
+	DataSeedMaxDaysOfHistory   = 365
+
+	DataSeedDefaultDaysOfHistory = 30
+)
+
+// DataSeedRequest describes the synthetic data to generate. The channels are created in each of
+// the teams, and the posts are spread over all the channels and over the days of history.
+type DataSeedRequest struct {
+	Teams           int `json:"teams"`
+	ChannelsPerTeam int `json:"channels_per_team"`
+	Users           int `json:"users"`
+	Posts           int `json:"posts"`
+	// ReplyPercentage is the share of the posts which are replies to the earlier posts of their
+	// channel.
+	ReplyPercentage int `json:"reply_percentage"`
+	DaysOfHistory   int `json:"days_of_history"`
+	// Seed makes the generated data reproducible. A random seed is used when zero.
+	Seed int64 `json:"seed"`
+	// Password is the password of the generated users, so that the load tests can log in as
+	// them. It isn't kept once the generation has started.
+	Password string `json:"password,omitempty"`
+}
+
+func (r *DataSeedRequest) SetDefaults() {
+	if r.DaysOfHistory == 0 {
+		r.DaysOfHistory = DataSeedDefaultDaysOfHistory
+	}
+}
+
+func (r *DataSeedRequest) IsValid() *AppError {
+	if r.Teams < 1 || r.Teams > DataSeedMaxTeams {
+		return NewAppError("DataSeedRequest.IsValid", "model.data_seed.is_valid.teams.app_error", map[string]any{"Max": DataSeedMaxTeams}, "", http.StatusBadRequest)
+	}
+
+	if r.ChannelsPerTeam < 0 || r.ChannelsPerTeam > DataSeedMaxChannelsPerTeam {
+		return NewAppError("DataSeedRequest.IsValid", "model.data_seed.is_valid.channels.app_error", map[string]any{"Max": DataSeedMaxChannelsPerTeam}, "", http.StatusBadRequest)
+	}
+
+	if r.Users < 1 || r.Users > DataSeedMaxUsers {
+		return NewAppError("DataSeedRequest.IsValid", "model.data_seed.is_valid.users.app_error", map[string]any{"Max": DataSeedMaxUsers}, "", http.StatusBadRequest)
+	}
+
+	if r.Posts < 0 || r.Posts > DataSeedMaxPosts {
+		return NewAppError("DataSeedRequest.IsValid", "model.data_seed.is_valid.posts.app_error", map[string]any{"Max": DataSeedMaxPosts}, "", http.StatusBadRequest)
+	}
+
+	if r.ReplyPercentage < 0 || r.ReplyPercentage > 100 {
+		return NewAppError("DataSeedRequest.IsValid", "model.data_seed.is_valid.reply_percentage.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if r.DaysOfHistory < 1 || r.DaysOfHistory > DataSeedMaxDaysOfHistory {
+		return NewAppError("DataSeedRequest.IsValid", "model.data_seed.is_valid.days_of_history.app_error", map[string]any{"Max": DataSeedMaxDaysOfHistory}, "", http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+func (r *DataSeedRequest) Auditable() map[string]any {
+	return map[string]any{
+		"teams":             r.Teams,
+		"channels_per_team": r.ChannelsPerTeam,
+		"users":             r.Users,
+		"posts":             r.Posts,
+		"reply_percentage":  r.ReplyPercentage,
+		"days_of_history":   r.DaysOfHistory,
+		"seed":              r.Seed,
+	}
+}
+
+// DataSeedStatus reports the progress of the generation of synthetic data. The generated teams
+// and users share the Prefix of their names, so that they can be told apart from the real ones.
+type DataSeedStatus struct {
+	Request    DataSeedRequest `json:"request"`
+	Prefix     string          `json:"prefix"`
+	StartAt    int64           `json:"start_at"`
+	CompleteAt int64           `json:"complete_at"`
+	Teams      int             `json:"teams"`
+	Channels   int             `json:"channels"`
+	Users      int             `json:"users"`
+	Posts      int             `json:"posts"`
+	Canceled   bool            `json:"canceled"`
+	Error      string          `json:"error,omitempty"`
+}
+
+// IsComplete returns whether the generation is over, whether it succeeded, failed or was
+// canceled.
+func (s *DataSeedStatus) IsComplete() bool {
+	return s.CompleteAt != 0
+}
+
+func (s *DataSeedStatus) Auditable() map[string]any {
+	return map[string]any{
+		"request":     s.Request.Auditable(),
+		"prefix":      s.Prefix,
+		"start_at":    s.StartAt,
+		"complete_at": s.CompleteAt,
+	}
+}
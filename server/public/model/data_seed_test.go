@@ -0,0 +1,39 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataSeedRequestIsValid(t *testing.T) {
+	testCases := []struct {
+		name    string
+		request DataSeedRequest
+		valid   bool
+	}{
+		{"minimal request", DataSeedRequest{Teams: 1, Users: 1}, true},
+		{"maximal request", DataSeedRequest{Teams: DataSeedMaxTeams, ChannelsPerTeam: DataSeedMaxChannelsPerTeam, Users: DataSeedMaxUsers, Posts: DataSeedMaxPosts, ReplyPercentage: 100, DaysOfHistory: DataSeedMaxDaysOfHistory}, true},
+		{"no team", DataSeedRequest{Users: 1}, false},
+		{"too many teams", DataSeedRequest{Teams: DataSeedMaxTeams + 1, Users: 1}, false},
+		{"too many channels", DataSeedRequest{Teams: 1, ChannelsPerTeam: DataSeedMaxChannelsPerTeam + 1, Users: 1}, false},
+		{"no user", DataSeedRequest{Teams: 1}, false},
+		{"too many posts", DataSeedRequest{Teams: 1, Users: 1, Posts: DataSeedMaxPosts + 1}, false},
+		{"negative reply percentage", DataSeedRequest{Teams: 1, Users: 1, ReplyPercentage: -1}, false},
+		{"too many days of history", DataSeedRequest{Teams: 1, Users: 1, DaysOfHistory: DataSeedMaxDaysOfHistory + 1}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.request.SetDefaults()
+			if tc.valid {
+				assert.Nil(t, tc.request.IsValid())
+			} else {
+				assert.NotNil(t, tc.request.IsValid())
+			}
+		})
+	}
+}
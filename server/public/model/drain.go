@@ -0,0 +1,22 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+// DrainStatus reports the progress of a server drain, which prepares a server to be stopped
+// without dropping all of its websocket connections at once.
+type DrainStatus struct {
+	Draining                    bool  `json:"draining"`
+	StartAt                     int64 `json:"start_at"`
+	Deadline                    int64 `json:"deadline"`
+	CompleteAt                  int64 `json:"complete_at"`
+	InitialWebSocketConnections int   `json:"initial_websocket_connections"`
+	WebSocketConnections        int   `json:"websocket_connections"`
+	JobsStopped                 bool  `json:"jobs_stopped"`
+}
+
+// IsComplete returns whether the drain is over, either because the server has no work left or
+// because its deadline has passed.
+func (ds *DrainStatus) IsComplete() bool {
+	return ds.CompleteAt != 0
+}
@@ -0,0 +1,83 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+)
+
+const (
+	PreferenceNameEmailDigest         = "email_digest"
+	PreferenceNameEmailDigestLastSent = "email_digest_last_sent"
+
+	EmailDigestFrequencyOff    = "off"
+	EmailDigestFrequencyHourly = "hourly"
+	EmailDigestFrequencyDaily  = "daily"
+)
+
+// EmailDigestSettings holds the schedule of the emails summarizing the mentions and channel
+// activity a user missed. They're stored as a preference of the user.
+type EmailDigestSettings struct {
+	Frequency string `json:"frequency"`
+	// Hour is the hour of the day, in the timezone of the user, at which daily digests are sent.
+	Hour int `json:"hour"`
+}
+
+func (s *EmailDigestSettings) IsValid() *AppError {
+	switch s.Frequency {
+	case EmailDigestFrequencyOff, EmailDigestFrequencyHourly, EmailDigestFrequencyDaily:
+	default:
+		return NewAppError("EmailDigestSettings.IsValid", "model.email_digest_settings.is_valid.frequency.app_error", nil, "frequency="+s.Frequency, http.StatusBadRequest)
+	}
+
+	if s.Hour < 0 || s.Hour > 23 {
+		return NewAppError("EmailDigestSettings.IsValid", "model.email_digest_settings.is_valid.hour.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+func (s *EmailDigestSettings) IsEnabled() bool {
+	return s.Frequency == EmailDigestFrequencyHourly || s.Frequency == EmailDigestFrequencyDaily
+}
+
+// LastScheduledAt returns the latest time at or before now at which a digest is scheduled,
+// in the given location. It returns the zero time if digests are disabled.
+func (s *EmailDigestSettings) LastScheduledAt(now time.Time, loc *time.Location) time.Time {
+	now = now.In(loc)
+	switch s.Frequency {
+	case EmailDigestFrequencyHourly:
+		return time.Date(now.Year(), now.Month(), now.Day(), now.Hour(), 0, 0, 0, loc)
+	case EmailDigestFrequencyDaily:
+		scheduled := time.Date(now.Year(), now.Month(), now.Day(), s.Hour, 0, 0, 0, loc)
+		if scheduled.After(now) {
+			scheduled = scheduled.AddDate(0, 0, -1)
+		}
+		return scheduled
+	}
+	return time.Time{}
+}
+
+// IsDue reports whether a digest has to be sent at now, given when the last one was sent.
+func (s *EmailDigestSettings) IsDue(now time.Time, lastSentAt int64, loc *time.Location) bool {
+	if !s.IsEnabled() {
+		return false
+	}
+	return lastSentAt < s.LastScheduledAt(now, loc).UnixMilli()
+}
+
+// EmailDigestSettingsFromPreference returns the settings stored in the preference, which are
+// disabled if the preference is missing or invalid.
+func EmailDigestSettingsFromPreference(preference *Preference) *EmailDigestSettings {
+	settings := &EmailDigestSettings{Frequency: EmailDigestFrequencyOff}
+	if preference == nil {
+		return settings
+	}
+	if err := json.Unmarshal([]byte(preference.Value), settings); err != nil || settings.IsValid() != nil {
+		return &EmailDigestSettings{Frequency: EmailDigestFrequencyOff}
+	}
+	return settings
+}
@@ -0,0 +1,86 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmailDigestSettingsIsValid(t *testing.T) {
+	for name, tc := range map[string]struct {
+		settings EmailDigestSettings
+		valid    bool
+	}{
+		"off":               {EmailDigestSettings{Frequency: EmailDigestFrequencyOff}, true},
+		"hourly":            {EmailDigestSettings{Frequency: EmailDigestFrequencyHourly}, true},
+		"daily":             {EmailDigestSettings{Frequency: EmailDigestFrequencyDaily, Hour: 23}, true},
+		"unknown frequency": {EmailDigestSettings{Frequency: "weekly"}, false},
+		"empty frequency":   {EmailDigestSettings{}, false},
+		"negative hour":     {EmailDigestSettings{Frequency: EmailDigestFrequencyDaily, Hour: -1}, false},
+		"hour too large":    {EmailDigestSettings{Frequency: EmailDigestFrequencyDaily, Hour: 24}, false},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if tc.valid {
+				assert.Nil(t, tc.settings.IsValid())
+			} else {
+				assert.NotNil(t, tc.settings.IsValid())
+			}
+		})
+	}
+}
+
+func TestEmailDigestSettingsIsDue(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+	now := time.Date(2024, 3, 12, 9, 30, 0, 0, loc)
+
+	t.Run("off", func(t *testing.T) {
+		settings := &EmailDigestSettings{Frequency: EmailDigestFrequencyOff}
+		assert.False(t, settings.IsDue(now, 0, loc))
+	})
+
+	t.Run("hourly", func(t *testing.T) {
+		settings := &EmailDigestSettings{Frequency: EmailDigestFrequencyHourly}
+		assert.True(t, settings.IsDue(now, 0, loc))
+		assert.True(t, settings.IsDue(now, now.Add(-time.Hour).UnixMilli(), loc))
+		assert.False(t, settings.IsDue(now, now.Add(-10*time.Minute).UnixMilli(), loc))
+	})
+
+	t.Run("daily", func(t *testing.T) {
+		settings := &EmailDigestSettings{Frequency: EmailDigestFrequencyDaily, Hour: 9}
+		assert.True(t, settings.IsDue(now, now.Add(-time.Hour).UnixMilli(), loc))
+		assert.False(t, settings.IsDue(now, now.Add(-10*time.Minute).UnixMilli(), loc))
+
+		// Before the hour, the digest of the previous day is the last scheduled one.
+		settings.Hour = 10
+		assert.Equal(t, time.Date(2024, 3, 11, 10, 0, 0, 0, loc), settings.LastScheduledAt(now, loc))
+		assert.False(t, settings.IsDue(now, now.Add(-2*time.Hour).UnixMilli(), loc))
+		assert.True(t, settings.IsDue(now, now.Add(-24*time.Hour).UnixMilli(), loc))
+	})
+
+	t.Run("timezone", func(t *testing.T) {
+		settings := &EmailDigestSettings{Frequency: EmailDigestFrequencyDaily, Hour: 9}
+		// 9:30 in New York is 14:30 in UTC, before the digest is due in UTC.
+		assert.Equal(t, time.Date(2024, 3, 12, 9, 0, 0, 0, time.UTC), settings.LastScheduledAt(now, time.UTC))
+		assert.Equal(t, time.Date(2024, 3, 12, 9, 0, 0, 0, loc), settings.LastScheduledAt(now, loc))
+	})
+}
+
+func TestEmailDigestSettingsFromPreference(t *testing.T) {
+	settings := EmailDigestSettingsFromPreference(nil)
+	assert.False(t, settings.IsEnabled())
+
+	settings = EmailDigestSettingsFromPreference(&Preference{Value: `{"frequency":"daily","hour":8}`})
+	assert.Equal(t, &EmailDigestSettings{Frequency: EmailDigestFrequencyDaily, Hour: 8}, settings)
+
+	settings = EmailDigestSettingsFromPreference(&Preference{Value: `{"frequency":"daily","hour":30}`})
+	assert.Equal(t, EmailDigestFrequencyOff, settings.Frequency)
+
+	settings = EmailDigestSettingsFromPreference(&Preference{Value: `not json`})
+	assert.Equal(t, EmailDigestFrequencyOff, settings.Frequency)
+}
@@ -0,0 +1,59 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import "net/http"
+
+const (
+	EmailTemplateOverrideMaxSize   = 1 * 1024 * 1024 // 1 MB
+	EmailTemplatePreviewMaxProps   = 100
+	EmailTemplatePreviewMaxPropLen = 4096
+)
+
+// EmailTemplate is a template used to render the emails sent by the server.
+type EmailTemplate struct {
+	Name string `json:"name"`
+	// Overridden is whether the template is redefined by a template override.
+	Overridden bool `json:"overridden"`
+}
+
+// EmailTemplateOverride is the content of a template override, defining
+// templates with the same names as the ones they replace.
+type EmailTemplateOverride struct {
+	Content string `json:"content"`
+}
+
+// EmailTemplatePreviewRequest asks to render an email template with sample
+// data, optionally overriding the templates with Content.
+type EmailTemplatePreviewRequest struct {
+	TemplateName string            `json:"template_name"`
+	Content      string            `json:"content,omitempty"`
+	Locale       string            `json:"locale,omitempty"`
+	Props        map[string]string `json:"props,omitempty"`
+}
+
+type EmailTemplatePreview struct {
+	Html string `json:"html"`
+}
+
+func (r *EmailTemplatePreviewRequest) IsValid() *AppError {
+	if r.TemplateName == "" {
+		return NewAppError("EmailTemplatePreviewRequest.IsValid", "model.email_template.is_valid.template_name.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if len(r.Content) > EmailTemplateOverrideMaxSize {
+		return NewAppError("EmailTemplatePreviewRequest.IsValid", "model.email_template.is_valid.content.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if len(r.Props) > EmailTemplatePreviewMaxProps {
+		return NewAppError("EmailTemplatePreviewRequest.IsValid", "model.email_template.is_valid.props.app_error", nil, "", http.StatusBadRequest)
+	}
+	for _, value := range r.Props {
+		if len(value) > EmailTemplatePreviewMaxPropLen {
+			return NewAppError("EmailTemplatePreviewRequest.IsValid", "model.email_template.is_valid.props.app_error", nil, "", http.StatusBadRequest)
+		}
+	}
+
+	return nil
+}
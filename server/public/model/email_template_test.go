@@ -0,0 +1,31 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEmailTemplatePreviewRequestIsValid(t *testing.T) {
+	r := &EmailTemplatePreviewRequest{TemplateName: "verify_body"}
+	assert.Nil(t, r.IsValid())
+
+	r.TemplateName = ""
+	assert.NotNil(t, r.IsValid())
+
+	r = &EmailTemplatePreviewRequest{TemplateName: "verify_body", Content: strings.Repeat("a", EmailTemplateOverrideMaxSize+1)}
+	assert.NotNil(t, r.IsValid())
+
+	r = &EmailTemplatePreviewRequest{TemplateName: "verify_body", Props: map[string]string{"Title": strings.Repeat("a", EmailTemplatePreviewMaxPropLen+1)}}
+	assert.NotNil(t, r.IsValid())
+
+	r = &EmailTemplatePreviewRequest{TemplateName: "verify_body", Props: map[string]string{}}
+	for i := 0; i <= EmailTemplatePreviewMaxProps; i++ {
+		r.Props[NewId()] = "value"
+	}
+	assert.NotNil(t, r.IsValid())
+}
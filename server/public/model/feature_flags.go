@@ -4,10 +4,15 @@
 package model
 
 import (
+	"hash/fnv"
+	"net/http"
 	"reflect"
 	"strconv"
+	"strings"
 )
 
+const FeatureFlagRuleMaxTargets = 1000
+
 type FeatureFlags struct {
 	// Exists only for unit and manual testing.
 	// When set to a value, will be returned by the ping endpoint.
@@ -108,3 +113,85 @@ func (f *FeatureFlags) ToMap() map[string]string {
 
 	return ret
 }
+
+// SetValue sets the feature flag with the given name, returning false if there is no such flag.
+// Boolean feature flags interpret "on" or any value considered true by strconv.ParseBool as true.
+func (f *FeatureFlags) SetValue(name, value string) bool {
+	refField := reflect.ValueOf(f).Elem().FieldByName(name)
+	if !refField.IsValid() || !refField.CanSet() {
+		return false
+	}
+
+	switch refField.Kind() {
+	case reflect.Bool:
+		parsedBoolValue, _ := strconv.ParseBool(value)
+		refField.SetBool(strings.ToLower(value) == "on" || parsedBoolValue)
+	default:
+		refField.SetString(value)
+	}
+	return true
+}
+
+// FeatureFlagRule targets a value of a feature flag to some of the users, e.g. to stage a feature
+// with a pilot team before enabling it for everyone. A user matches the rule when listed in
+// UserIds, when member of one of the TeamIds, or when part of the Percentage of users picked by a
+// stable hash of their id.
+type FeatureFlagRule struct {
+	Value      string
+	Percentage int
+	TeamIds    []string
+	UserIds    []string
+}
+
+func (r *FeatureFlagRule) IsValid() *AppError {
+	if r.Percentage < 0 || r.Percentage > 100 {
+		return NewAppError("FeatureFlagRule.IsValid", "model.feature_flag_rule.is_valid.percentage.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if len(r.TeamIds)+len(r.UserIds) > FeatureFlagRuleMaxTargets {
+		return NewAppError("FeatureFlagRule.IsValid", "model.feature_flag_rule.is_valid.targets.app_error", map[string]any{"Max": FeatureFlagRuleMaxTargets}, "", http.StatusBadRequest)
+	}
+
+	for _, ids := range [][]string{r.TeamIds, r.UserIds} {
+		for _, id := range ids {
+			if !IsValidId(id) {
+				return NewAppError("FeatureFlagRule.IsValid", "model.feature_flag_rule.is_valid.id.app_error", nil, "id="+id, http.StatusBadRequest)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Matches returns whether the rule targets the user, given the teams they belong to.
+func (r *FeatureFlagRule) Matches(flagName, userID string, teamIDs []string) bool {
+	for _, id := range r.UserIds {
+		if id == userID {
+			return true
+		}
+	}
+
+	for _, id := range r.TeamIds {
+		for _, teamID := range teamIDs {
+			if id == teamID {
+				return true
+			}
+		}
+	}
+
+	if r.Percentage <= 0 {
+		return false
+	}
+
+	// The flag name is part of the hash so that each flag targets a different set of users.
+	h := fnv.New32a()
+	h.Write([]byte(flagName + ":" + userID))
+	return int(h.Sum32()%100) < r.Percentage
+}
+
+// FeatureFlagStatus is a feature flag with its server-wide value and its targeting rule, if any.
+type FeatureFlagStatus struct {
+	Name  string           `json:"name"`
+	Value string           `json:"value"`
+	Rule  *FeatureFlagRule `json:"rule"`
+}
@@ -52,3 +52,47 @@ func TestFeatureFlagsToMapBool(t *testing.T) {
 		})
 	}
 }
+
+func TestFeatureFlagsSetValue(t *testing.T) {
+	flags := FeatureFlags{}
+
+	require.True(t, flags.SetValue("TestFeature", "value"))
+	require.Equal(t, "value", flags.TestFeature)
+
+	for value, expected := range map[string]bool{"on": true, "ON": true, "true": true, "1": true, "off": false, "false": false, "": false} {
+		require.True(t, flags.SetValue("TestBoolFeature", value))
+		require.Equal(t, expected, flags.TestBoolFeature, value)
+	}
+
+	require.False(t, flags.SetValue("UnknownFeature", "on"))
+}
+
+func TestFeatureFlagRuleIsValid(t *testing.T) {
+	require.Nil(t, (&FeatureFlagRule{Value: "on", Percentage: 50, TeamIds: []string{NewId()}}).IsValid())
+	require.NotNil(t, (&FeatureFlagRule{Percentage: 101}).IsValid())
+	require.NotNil(t, (&FeatureFlagRule{Percentage: -1}).IsValid())
+	require.NotNil(t, (&FeatureFlagRule{UserIds: []string{"invalid"}}).IsValid())
+	require.NotNil(t, (&FeatureFlagRule{TeamIds: make([]string, FeatureFlagRuleMaxTargets+1)}).IsValid())
+}
+
+func TestFeatureFlagRuleMatches(t *testing.T) {
+	userID := NewId()
+	teamID := NewId()
+
+	require.True(t, (&FeatureFlagRule{UserIds: []string{userID}}).Matches("TestFeature", userID, nil))
+	require.True(t, (&FeatureFlagRule{TeamIds: []string{teamID}}).Matches("TestFeature", userID, []string{NewId(), teamID}))
+	require.False(t, (&FeatureFlagRule{TeamIds: []string{teamID}}).Matches("TestFeature", userID, []string{NewId()}))
+	require.True(t, (&FeatureFlagRule{Percentage: 100}).Matches("TestFeature", userID, nil))
+	require.False(t, (&FeatureFlagRule{Percentage: 0}).Matches("TestFeature", userID, nil))
+
+	rule := &FeatureFlagRule{Percentage: 30}
+	matched := 0
+	for i := 0; i < 1000; i++ {
+		id := NewId()
+		if rule.Matches("TestFeature", id, nil) {
+			matched++
+			require.True(t, rule.Matches("TestFeature", id, nil), "the same user must always match")
+		}
+	}
+	require.InDelta(t, 300, matched, 100)
+}
@@ -13,6 +13,9 @@ import (
 const (
 	FileinfoSortByCreated = "CreateAt"
 	FileinfoSortBySize    = "Size"
+
+	// FileInfoContentHashLength is the length of a hex-encoded SHA-256 content hash.
+	FileInfoContentHashLength = 64
 )
 
 // GetFileInfosOptions contains options for getting FileInfos
@@ -57,6 +60,9 @@ type FileInfo struct {
 	Content         string  `json:"-"`
 	RemoteId        *string `json:"remote_id"`
 	Archived        bool    `json:"archived"`
+	// ContentHash is the hex-encoded SHA-256 digest of the stored file. It is empty for
+	// files uploaded before hashing was introduced until the backfill job processes them.
+	ContentHash string `json:"content_hash,omitempty"`
 }
 
 func (fi *FileInfo) Auditable() map[string]interface{} {
@@ -117,6 +123,10 @@ func (fi *FileInfo) IsValid() *AppError {
 		return NewAppError("FileInfo.IsValid", "model.file_info.is_valid.path.app_error", nil, "id="+fi.Id, http.StatusBadRequest)
 	}
 
+	if fi.ContentHash != "" && len(fi.ContentHash) != FileInfoContentHashLength {
+		return NewAppError("FileInfo.IsValid", "model.file_info.is_valid.content_hash.app_error", nil, "id="+fi.Id, http.StatusBadRequest)
+	}
+
 	return nil
 }
 
@@ -6,6 +6,7 @@ package model
 import (
 	_ "image/gif"
 	_ "image/png"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -54,6 +55,14 @@ func TestFileInfoIsValid(t *testing.T) {
 		info.Path = "fake/path.png"
 	})
 
+	t.Run("Content hash must be a SHA-256 hex digest", func(t *testing.T) {
+		info.ContentHash = "abc"
+		assert.NotNil(t, info.IsValid(), "short ContentHash isn't valid")
+		info.ContentHash = strings.Repeat("a", FileInfoContentHashLength)
+		assert.Nil(t, info.IsValid())
+		info.ContentHash = ""
+	})
+
 	t.Run("Creator ID for bookmarks is valid", func(t *testing.T) {
 		creatorId := info.CreatorId
 		info.CreatorId = BookmarkFileOwner
@@ -0,0 +1,108 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+)
+
+const (
+	FileShareLinkMaxPasswordLength = 72 // bcrypt limit
+)
+
+// FileShareLink is a revocable, time-limited public link to a single file. Unlike the
+// public link of a file, it is signed per link and can be protected by a password
+// and limited to a number of downloads.
+type FileShareLink struct {
+	Id            string `json:"id"`
+	FileId        string `json:"file_id"`
+	CreatorId     string `json:"creator_id"`
+	CreateAt      int64  `json:"create_at"`
+	ExpireAt      int64  `json:"expire_at"`
+	DeleteAt      int64  `json:"delete_at"`
+	Password      string `json:"-"` // bcrypt hash, never sent to clients
+	MaxDownloads  int64  `json:"max_downloads"`
+	DownloadCount int64  `json:"download_count"`
+
+	// Link is the signed URL of the share link. It is only populated in API responses.
+	Link string `json:"link,omitempty" db:"-"`
+	// HasPassword reports whether a password is required to download the file.
+	HasPassword bool `json:"has_password" db:"-"`
+}
+
+// FileShareLinkRequest is the payload used to create a FileShareLink.
+type FileShareLinkRequest struct {
+	// ExpiresIn is the lifetime of the link in seconds.
+	ExpiresIn    int64  `json:"expires_in"`
+	Password     string `json:"password,omitempty"`
+	MaxDownloads int64  `json:"max_downloads,omitempty"`
+}
+
+func (l *FileShareLink) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"id":             l.Id,
+		"file_id":        l.FileId,
+		"creator_id":     l.CreatorId,
+		"create_at":      l.CreateAt,
+		"expire_at":      l.ExpireAt,
+		"delete_at":      l.DeleteAt,
+		"has_password":   l.Password != "",
+		"max_downloads":  l.MaxDownloads,
+		"download_count": l.DownloadCount,
+	}
+}
+
+func (l *FileShareLink) PreSave() {
+	if l.Id == "" {
+		l.Id = NewId()
+	}
+
+	if l.CreateAt == 0 {
+		l.CreateAt = GetMillis()
+	}
+}
+
+// Sanitize hides the password hash and exposes whether the link is password protected.
+func (l *FileShareLink) Sanitize() {
+	l.HasPassword = l.Password != ""
+	l.Password = ""
+}
+
+// IsExpired reports whether the link can no longer be used at the given time.
+func (l *FileShareLink) IsExpired(now int64) bool {
+	return l.ExpireAt <= now
+}
+
+// IsExhausted reports whether the download limit of the link has been reached.
+func (l *FileShareLink) IsExhausted() bool {
+	return l.MaxDownloads > 0 && l.DownloadCount >= l.MaxDownloads
+}
+
+func (l *FileShareLink) IsValid() *AppError {
+	if !IsValidId(l.Id) {
+		return NewAppError("FileShareLink.IsValid", "model.file_share_link.is_valid.id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if !IsValidId(l.FileId) {
+		return NewAppError("FileShareLink.IsValid", "model.file_share_link.is_valid.file_id.app_error", nil, "id="+l.Id, http.StatusBadRequest)
+	}
+
+	if !IsValidId(l.CreatorId) {
+		return NewAppError("FileShareLink.IsValid", "model.file_share_link.is_valid.creator_id.app_error", nil, "id="+l.Id, http.StatusBadRequest)
+	}
+
+	if l.CreateAt == 0 {
+		return NewAppError("FileShareLink.IsValid", "model.file_share_link.is_valid.create_at.app_error", nil, "id="+l.Id, http.StatusBadRequest)
+	}
+
+	if l.ExpireAt <= l.CreateAt {
+		return NewAppError("FileShareLink.IsValid", "model.file_share_link.is_valid.expire_at.app_error", nil, "id="+l.Id, http.StatusBadRequest)
+	}
+
+	if l.MaxDownloads < 0 {
+		return NewAppError("FileShareLink.IsValid", "model.file_share_link.is_valid.max_downloads.app_error", nil, "id="+l.Id, http.StatusBadRequest)
+	}
+
+	return nil
+}
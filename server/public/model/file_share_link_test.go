@@ -0,0 +1,77 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileShareLinkIsValid(t *testing.T) {
+	link := &FileShareLink{
+		FileId:    NewId(),
+		CreatorId: NewId(),
+	}
+	link.PreSave()
+	link.ExpireAt = link.CreateAt + 1000
+
+	require.Nil(t, link.IsValid())
+
+	t.Run("invalid file id", func(t *testing.T) {
+		l := *link
+		l.FileId = "invalid"
+		require.NotNil(t, l.IsValid())
+	})
+
+	t.Run("invalid creator id", func(t *testing.T) {
+		l := *link
+		l.CreatorId = ""
+		require.NotNil(t, l.IsValid())
+	})
+
+	t.Run("expiry before creation", func(t *testing.T) {
+		l := *link
+		l.ExpireAt = l.CreateAt
+		require.NotNil(t, l.IsValid())
+	})
+
+	t.Run("negative max downloads", func(t *testing.T) {
+		l := *link
+		l.MaxDownloads = -1
+		require.NotNil(t, l.IsValid())
+	})
+}
+
+func TestFileShareLinkIsExpired(t *testing.T) {
+	link := &FileShareLink{ExpireAt: 1000}
+
+	assert.False(t, link.IsExpired(999))
+	assert.True(t, link.IsExpired(1000))
+	assert.True(t, link.IsExpired(1001))
+}
+
+func TestFileShareLinkIsExhausted(t *testing.T) {
+	link := &FileShareLink{DownloadCount: 10}
+	assert.False(t, link.IsExhausted(), "links without a limit are never exhausted")
+
+	link.MaxDownloads = 11
+	assert.False(t, link.IsExhausted())
+
+	link.DownloadCount = 11
+	assert.True(t, link.IsExhausted())
+}
+
+func TestFileShareLinkSanitize(t *testing.T) {
+	link := &FileShareLink{Password: "hash"}
+	link.Sanitize()
+
+	assert.Empty(t, link.Password)
+	assert.True(t, link.HasPassword)
+
+	link = &FileShareLink{}
+	link.Sanitize()
+	assert.False(t, link.HasPassword)
+}
@@ -17,10 +17,20 @@ const (
 	GroupDisplayNameMaxLength = 128
 	GroupDescriptionMaxLength = 1024
 	GroupRemoteIDMaxLength    = 48
+
+	GroupMentionPolicyAnyone  GroupMentionPolicy = "anyone"
+	GroupMentionPolicyMembers GroupMentionPolicy = "members"
+	GroupMentionPolicyAdmins  GroupMentionPolicy = "admins"
+
+	GroupMentionCooldownMaxSeconds = 24 * 60 * 60
 )
 
 type GroupSource string
 
+// GroupMentionPolicy controls who can notify the members of a group by mentioning it.
+// An empty policy is the same as GroupMentionPolicyAnyone.
+type GroupMentionPolicy string
+
 var allGroupSources = []GroupSource{
 	GroupSourceLdap,
 	GroupSourceCustom,
@@ -46,19 +56,29 @@ type Group struct {
 	ChannelMemberCount          *int        `db:"-" json:"channel_member_count,omitempty"`
 	ChannelMemberTimezonesCount *int        `db:"-" json:"channel_member_timezones_count,omitempty"`
 	MemberIDs                   []string    `db:"-" json:"member_ids"`
+
+	// MentionPolicy, MentionCooldownSeconds and MentionMemberLimit guard against
+	// notification storms when large groups are mentioned.
+	MentionPolicy          GroupMentionPolicy `json:"mention_policy"`
+	MentionCooldownSeconds int                `json:"mention_cooldown_seconds"`
+	MentionMemberLimit     int                `json:"mention_member_limit"`
+	LastMentionAt          int64              `json:"last_mention_at"`
 }
 
 func (group *Group) Auditable() map[string]interface{} {
 	return map[string]interface{}{
-		"id":              group.Id,
-		"source":          group.Source,
-		"remote_id":       group.RemoteId,
-		"create_at":       group.CreateAt,
-		"update_at":       group.UpdateAt,
-		"delete_at":       group.DeleteAt,
-		"has_syncables":   group.HasSyncables,
-		"member_count":    group.MemberCount,
-		"allow_reference": group.AllowReference,
+		"id":                       group.Id,
+		"source":                   group.Source,
+		"remote_id":                group.RemoteId,
+		"create_at":                group.CreateAt,
+		"update_at":                group.UpdateAt,
+		"delete_at":                group.DeleteAt,
+		"has_syncables":            group.HasSyncables,
+		"member_count":             group.MemberCount,
+		"allow_reference":          group.AllowReference,
+		"mention_policy":           group.MentionPolicy,
+		"mention_cooldown_seconds": group.MentionCooldownSeconds,
+		"mention_member_limit":     group.MentionMemberLimit,
 	}
 }
 
@@ -106,6 +126,10 @@ type GroupPatch struct {
 	DisplayName    *string `json:"display_name"`
 	Description    *string `json:"description"`
 	AllowReference *bool   `json:"allow_reference"`
+
+	MentionPolicy          *GroupMentionPolicy `json:"mention_policy"`
+	MentionCooldownSeconds *int                `json:"mention_cooldown_seconds"`
+	MentionMemberLimit     *int                `json:"mention_member_limit"`
 	// For security reasons (including preventing unintended LDAP group synchronization) do no allow a Group's RemoteId or Source field to be
 	// included in patches.
 }
@@ -185,6 +209,26 @@ func (group *Group) Patch(patch *GroupPatch) {
 	if patch.AllowReference != nil {
 		group.AllowReference = *patch.AllowReference
 	}
+	if patch.MentionPolicy != nil {
+		group.MentionPolicy = *patch.MentionPolicy
+	}
+	if patch.MentionCooldownSeconds != nil {
+		group.MentionCooldownSeconds = *patch.MentionCooldownSeconds
+	}
+	if patch.MentionMemberLimit != nil {
+		group.MentionMemberLimit = *patch.MentionMemberLimit
+	}
+}
+
+// HasMentionSettings returns true if the patch changes how mentions of the group are restricted.
+func (patch *GroupPatch) HasMentionSettings() bool {
+	return patch.MentionPolicy != nil || patch.MentionCooldownSeconds != nil || patch.MentionMemberLimit != nil
+}
+
+// HasMentionSettings returns true if the group restricts how it can be mentioned.
+func (group *Group) HasMentionSettings() bool {
+	return (group.MentionPolicy != "" && group.MentionPolicy != GroupMentionPolicyAnyone) ||
+		group.MentionCooldownSeconds != 0 || group.MentionMemberLimit != 0
 }
 
 func (group *Group) IsValidForCreate() *AppError {
@@ -216,6 +260,20 @@ func (group *Group) IsValidForCreate() *AppError {
 		return NewAppError("Group.IsValidForCreate", "model.group.remote_id.app_error", nil, "", http.StatusBadRequest)
 	}
 
+	switch group.MentionPolicy {
+	case "", GroupMentionPolicyAnyone, GroupMentionPolicyMembers, GroupMentionPolicyAdmins:
+	default:
+		return NewAppError("Group.IsValidForCreate", "model.group.mention_policy.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if group.MentionCooldownSeconds < 0 || group.MentionCooldownSeconds > GroupMentionCooldownMaxSeconds {
+		return NewAppError("Group.IsValidForCreate", "model.group.mention_cooldown_seconds.app_error", map[string]any{"Max": GroupMentionCooldownMaxSeconds}, "", http.StatusBadRequest)
+	}
+
+	if group.MentionMemberLimit < 0 {
+		return NewAppError("Group.IsValidForCreate", "model.group.mention_member_limit.app_error", nil, "", http.StatusBadRequest)
+	}
+
 	return nil
 }
 
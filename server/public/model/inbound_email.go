@@ -0,0 +1,112 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/mail"
+	"regexp"
+	"strings"
+)
+
+const (
+	InboundEmailSecretMinLength = 16
+	InboundEmailMaxSize         = 25 * 1024 * 1024
+	InboundEmailMaxAttachments  = 10
+
+	// replyByEmailSubaddressSeparator separates the local part of the reply-by-email address
+	// from the token identifying the post being replied to, as in reply+token@example.com.
+	replyByEmailSubaddressSeparator = "+"
+)
+
+// InboundEmail is an email received by the server, such as a reply to a notification email.
+type InboundEmail struct {
+	From        string
+	To          []string
+	Subject     string
+	Text        string
+	Attachments []*InboundEmailAttachment
+}
+
+type InboundEmailAttachment struct {
+	Name        string
+	ContentType string
+	Data        []byte
+}
+
+// FromAddress returns the address of the sender, without its display name.
+func (e *InboundEmail) FromAddress() string {
+	addr, err := mail.ParseAddress(e.From)
+	if err != nil {
+		return strings.ToLower(strings.TrimSpace(e.From))
+	}
+	return strings.ToLower(addr.Address)
+}
+
+// ReplyByEmailAddress returns the address the replies to a notification email are sent to,
+// adding the token as a subaddress of the configured reply-by-email address.
+func ReplyByEmailAddress(address, token string) string {
+	at := strings.LastIndex(address, "@")
+	if at < 0 || token == "" {
+		return ""
+	}
+	return address[:at] + replyByEmailSubaddressSeparator + token + address[at:]
+}
+
+// ReplyByEmailTokenFromAddresses returns the token of the first address built from the given
+// reply-by-email address with ReplyByEmailAddress, if any.
+func ReplyByEmailTokenFromAddresses(address string, addresses []string) string {
+	at := strings.LastIndex(address, "@")
+	if at < 0 {
+		return ""
+	}
+	prefix := strings.ToLower(address[:at] + replyByEmailSubaddressSeparator)
+	suffix := strings.ToLower(address[at:])
+
+	for _, value := range addresses {
+		list, err := mail.ParseAddressList(value)
+		if err != nil {
+			continue
+		}
+		for _, addr := range list {
+			candidate := strings.ToLower(addr.Address)
+			if len(candidate) > len(prefix)+len(suffix) && strings.HasPrefix(candidate, prefix) && strings.HasSuffix(candidate, suffix) {
+				return candidate[len(prefix) : len(candidate)-len(suffix)]
+			}
+		}
+	}
+
+	return ""
+}
+
+var (
+	emailReplyHeaderRegexp    = regexp.MustCompile(`(?i)^\s*On\b.*\bwrote:\s*$`)
+	emailReplySeparatorRegexp = regexp.MustCompile(`(?i)^\s*(-{2,}\s*Original Message\s*-{2,}|_{5,}|From:\s.*)$`)
+)
+
+// ExtractEmailReplyText returns the new text of an email reply, removing the quoted message
+// and the signature that email clients append after it.
+func ExtractEmailReplyText(text string) string {
+	lines := strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n")
+
+	end := len(lines)
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, ">") || emailReplyHeaderRegexp.MatchString(line) || emailReplySeparatorRegexp.MatchString(line) {
+			end = i
+			break
+		}
+		// Some clients wrap the "On ... wrote:" header over two lines.
+		if i+1 < len(lines) && emailReplyHeaderRegexp.MatchString(line+" "+lines[i+1]) && strings.HasPrefix(strings.TrimSpace(line), "On ") {
+			end = i
+			break
+		}
+		// "-- " is the usual signature separator.
+		if trimmed == "--" {
+			end = i
+			break
+		}
+	}
+
+	return strings.TrimSpace(strings.Join(lines[:end], "\n"))
+}
@@ -0,0 +1,83 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInboundEmailFromAddress(t *testing.T) {
+	assert.Equal(t, "user@example.com", (&InboundEmail{From: "Some User <User@Example.com>"}).FromAddress())
+	assert.Equal(t, "user@example.com", (&InboundEmail{From: " user@example.com "}).FromAddress())
+}
+
+func TestReplyByEmailAddress(t *testing.T) {
+	assert.Equal(t, "reply+abc123@example.com", ReplyByEmailAddress("reply@example.com", "abc123"))
+	assert.Empty(t, ReplyByEmailAddress("invalid", "abc123"))
+	assert.Empty(t, ReplyByEmailAddress("reply@example.com", ""))
+}
+
+func TestReplyByEmailTokenFromAddresses(t *testing.T) {
+	for name, tc := range map[string]struct {
+		addresses []string
+		expected  string
+	}{
+		"single address":      {[]string{"reply+abc123@example.com"}, "abc123"},
+		"display name":        {[]string{`"Mattermost" <Reply+ABC123@Example.com>`}, "abc123"},
+		"address list":        {[]string{"other@example.com, reply+abc123@example.com"}, "abc123"},
+		"second value":        {[]string{"other@example.com", "reply+abc123@example.com"}, "abc123"},
+		"no token":            {[]string{"reply@example.com"}, ""},
+		"empty token":         {[]string{"reply+@example.com"}, ""},
+		"other domain":        {[]string{"reply+abc123@example.org"}, ""},
+		"other local part":    {[]string{"noreply+abc123@example.com"}, ""},
+		"invalid address":     {[]string{"not an address"}, ""},
+		"no addresses at all": {nil, ""},
+	} {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, ReplyByEmailTokenFromAddresses("reply@example.com", tc.addresses))
+		})
+	}
+}
+
+func TestExtractEmailReplyText(t *testing.T) {
+	for name, tc := range map[string]struct {
+		text     string
+		expected string
+	}{
+		"plain text": {
+			"Sounds good to me.\n\nSee you tomorrow",
+			"Sounds good to me.\n\nSee you tomorrow",
+		},
+		"quoted reply": {
+			"Sounds good.\r\n\r\nOn Tue, Jan 2, 2024 at 10:00 AM Mattermost <reply@example.com> wrote:\r\n> Are you coming?\r\n",
+			"Sounds good.",
+		},
+		"wrapped reply header": {
+			"Sounds good.\n\nOn Tue, Jan 2, 2024 at 10:00 AM Mattermost\n<reply@example.com> wrote:\n> Are you coming?",
+			"Sounds good.",
+		},
+		"quote without header": {
+			"Yes\n> Are you coming?",
+			"Yes",
+		},
+		"original message": {
+			"Yes\n\n-----Original Message-----\nFrom: Mattermost",
+			"Yes",
+		},
+		"signature": {
+			"Yes\n\n-- \nSent from my phone",
+			"Yes",
+		},
+		"only quote": {
+			"> Are you coming?",
+			"",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, ExtractEmailReplyText(tc.text))
+		})
+	}
+}
@@ -28,22 +28,27 @@ type IncomingWebhook struct {
 	Username      string `json:"username"`
 	IconURL       string `json:"icon_url"`
 	ChannelLocked bool   `json:"channel_locked"`
+	// RateLimitPerMinute is the maximum number of posts the webhook can create per minute.
+	// Zero uses ServiceSettings.IncomingWebhookRateLimitPerMinute, which is also the upper
+	// bound of the value when set.
+	RateLimitPerMinute int `json:"rate_limit_per_minute"`
 }
 
 func (o *IncomingWebhook) Auditable() map[string]interface{} {
 	return map[string]interface{}{
-		"id":             o.Id,
-		"create_at":      o.CreateAt,
-		"update_at":      o.UpdateAt,
-		"delete_at":      o.DeleteAt,
-		"user_id":        o.UserId,
-		"channel_id":     o.ChannelId,
-		"team_id":        o.TeamId,
-		"display_name":   o.DisplayName,
-		"description":    o.Description,
-		"username":       o.Username,
-		"icon_url:":      o.IconURL,
-		"channel_locked": o.ChannelLocked,
+		"id":                    o.Id,
+		"create_at":             o.CreateAt,
+		"update_at":             o.UpdateAt,
+		"delete_at":             o.DeleteAt,
+		"user_id":               o.UserId,
+		"channel_id":            o.ChannelId,
+		"team_id":               o.TeamId,
+		"display_name":          o.DisplayName,
+		"description":           o.Description,
+		"username":              o.Username,
+		"icon_url:":             o.IconURL,
+		"channel_locked":        o.ChannelLocked,
+		"rate_limit_per_minute": o.RateLimitPerMinute,
 	}
 }
 
@@ -100,6 +105,10 @@ func (o *IncomingWebhook) IsValid() *AppError {
 		return NewAppError("IncomingWebhook.IsValid", "model.incoming_hook.icon_url.app_error", nil, "", http.StatusBadRequest)
 	}
 
+	if o.RateLimitPerMinute < 0 {
+		return NewAppError("IncomingWebhook.IsValid", "model.incoming_hook.rate_limit_per_minute.app_error", nil, "", http.StatusBadRequest)
+	}
+
 	return nil
 }
 
@@ -16,6 +16,7 @@ import (
 	"io"
 	"math/big"
 	"net/http"
+	"path"
 	"reflect"
 	"strconv"
 	"strings"
@@ -36,6 +37,21 @@ const (
 	DialogElementTextareaMaxLength    = 3000
 	DialogElementSelectMaxLength      = 3000
 	DialogElementBoolMaxLength        = 150
+	DialogElementFileMaxLength        = 150
+
+	// DialogDataSourceDynamic populates the options of a select element by calling the
+	// DataSourceURL of the element as the user types.
+	DialogDataSourceDynamic = "dynamic"
+	DialogLookupMaxItems    = 100
+
+	SubmitDialogTypeSubmission = "dialog_submission"
+	// SubmitDialogTypeRefresh is sent when the value of an element with Refresh set changes,
+	// for the integration to respond with an updated form.
+	SubmitDialogTypeRefresh = "refresh"
+
+	// SubmitDialogResponseTypeForm is used by integrations to respond to a submission with the
+	// next step of a multi-step form, replacing the dialog shown to the user.
+	SubmitDialogResponseTypeForm = "form"
 )
 
 var PostActionRetainPropKeys = []string{"from_webhook", "override_username", "override_icon_url"}
@@ -243,6 +259,12 @@ type DialogElement struct {
 	MaxLength   int                  `json:"max_length"`
 	DataSource  string               `json:"data_source"`
 	Options     []*PostActionOptions `json:"options"`
+	// DataSourceURL is called to look up the options of a select element using the dynamic
+	// data source, see DialogLookupRequest.
+	DataSourceURL string `json:"data_source_url,omitempty"`
+	// Refresh asks the client to send a refresh submission when the value of the element
+	// changes, so the integration can update the rest of the form.
+	Refresh bool `json:"refresh,omitempty"`
 }
 
 type OpenDialogRequest struct {
@@ -266,6 +288,28 @@ type SubmitDialogRequest struct {
 type SubmitDialogResponse struct {
 	Error  string            `json:"error,omitempty"`
 	Errors map[string]string `json:"errors,omitempty"`
+	Type   string            `json:"type,omitempty"`
+	// Form is the next step of the dialog when Type is SubmitDialogResponseTypeForm. It's
+	// submitted to the same URL as the current step.
+	Form *Dialog `json:"form,omitempty"`
+}
+
+// DialogLookupRequest is sent to the DataSourceURL of a dynamic select element to look up
+// the options matching what the user typed.
+type DialogLookupRequest struct {
+	URL        string         `json:"url,omitempty"`
+	CallbackId string         `json:"callback_id"`
+	State      string         `json:"state"`
+	UserId     string         `json:"user_id"`
+	ChannelId  string         `json:"channel_id"`
+	TeamId     string         `json:"team_id"`
+	Submission map[string]any `json:"submission"`
+	Name       string         `json:"name"`
+	Query      string         `json:"query"`
+}
+
+type DialogLookupResponse struct {
+	Items []*PostActionOptions `json:"items"`
 }
 
 func GenerateTriggerId(userId string, s crypto.Signer) (string, string, *AppError) {
@@ -393,6 +437,20 @@ func (d *Dialog) IsValid() error {
 	return multiErr.ErrorOrNil()
 }
 
+func (r *SubmitDialogResponse) IsValid() error {
+	switch r.Type {
+	case "":
+		return nil
+	case SubmitDialogResponseTypeForm:
+		if r.Form == nil {
+			return errors.New("missing form")
+		}
+		return r.Form.IsValid()
+	default:
+		return errors.Errorf("invalid response type %q", r.Type)
+	}
+}
+
 func (e *DialogElement) IsValid() error {
 	var multiErr *multierror.Error
 	textSubTypes := map[string]bool{
@@ -435,8 +493,11 @@ func (e *DialogElement) IsValid() error {
 	case "select":
 		multiErr = multierror.Append(multiErr, checkMaxLength("Default", e.Default, DialogElementSelectMaxLength))
 		multiErr = multierror.Append(multiErr, checkMaxLength("Placeholder", e.Placeholder, DialogElementSelectMaxLength))
-		if e.DataSource != "" && e.DataSource != "users" && e.DataSource != "channels" {
-			multiErr = multierror.Append(multiErr, errors.Errorf("invalid data source %q, allowed are 'users' or 'channels'", e.DataSource))
+		if e.DataSource != "" && e.DataSource != "users" && e.DataSource != "channels" && e.DataSource != DialogDataSourceDynamic {
+			multiErr = multierror.Append(multiErr, errors.Errorf("invalid data source %q, allowed are 'users', 'channels' or 'dynamic'", e.DataSource))
+		}
+		if e.DataSource == DialogDataSourceDynamic && !isValidDialogURL(e.DataSourceURL) {
+			multiErr = multierror.Append(multiErr, errors.Errorf("invalid data source url %q", e.DataSourceURL))
 		}
 		if e.DataSource == "" && !isDefaultInOptions(e.Default, e.Options) {
 			multiErr = multierror.Append(multiErr, errors.Errorf("default value %q doesn't exist in options ", e.Default))
@@ -453,6 +514,12 @@ func (e *DialogElement) IsValid() error {
 			multiErr = multierror.Append(multiErr, errors.Errorf("default value %q doesn't exist in options ", e.Default))
 		}
 
+	case "file":
+		if e.Default != "" {
+			multiErr = multierror.Append(multiErr, errors.New("file elements cannot have a default"))
+		}
+		multiErr = multierror.Append(multiErr, checkMaxLength("Placeholder", e.Placeholder, DialogElementFileMaxLength))
+
 	default:
 		multiErr = multierror.Append(multiErr, errors.Errorf("invalid element type: %q", e.Type))
 	}
@@ -460,6 +527,12 @@ func (e *DialogElement) IsValid() error {
 	return multiErr.ErrorOrNil()
 }
 
+// isValidDialogURL checks a URL called by the server on behalf of a dialog, which is either
+// absolute or the path of a plugin route.
+func isValidDialogURL(rawURL string) bool {
+	return IsValidHTTPURL(rawURL) || strings.HasPrefix(path.Clean(rawURL), "/plugins/")
+}
+
 func isDefaultInOptions(defaultValue string, options []*PostActionOptions) bool {
 	if defaultValue == "" {
 		return true
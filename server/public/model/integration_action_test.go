@@ -367,4 +367,90 @@ func TestOpenDialogRequestIsValid(t *testing.T) {
 		err := request.IsValid()
 		assert.ErrorContains(t, err, "Placeholder cannot be longer than 150 characters")
 	})
+
+	t.Run("should pass dynamic select with data source url", func(t *testing.T) {
+		for _, dataSourceURL := range []string{"https://example.com/lookup", "/plugins/myplugin/lookup"} {
+			request := getBaseOpenDialogRequest()
+			request.Dialog.Elements = append(request.Dialog.Elements, DialogElement{
+				DisplayName:   "Project",
+				Name:          "project",
+				Type:          "select",
+				DataSource:    DialogDataSourceDynamic,
+				DataSourceURL: dataSourceURL,
+				Refresh:       true,
+			})
+			assert.NoError(t, request.IsValid(), dataSourceURL)
+		}
+	})
+
+	t.Run("should fail dynamic select without data source url", func(t *testing.T) {
+		request := getBaseOpenDialogRequest()
+		request.Dialog.Elements = append(request.Dialog.Elements, DialogElement{
+			DisplayName: "Project",
+			Name:        "project",
+			Type:        "select",
+			DataSource:  DialogDataSourceDynamic,
+		})
+		err := request.IsValid()
+		assert.ErrorContains(t, err, "invalid data source url")
+	})
+
+	t.Run("should pass file element", func(t *testing.T) {
+		request := getBaseOpenDialogRequest()
+		request.Dialog.Elements = append(request.Dialog.Elements, DialogElement{
+			DisplayName: "Log file",
+			Name:        "log_file",
+			Type:        "file",
+			Placeholder: "Attach the build log",
+		})
+		assert.NoError(t, request.IsValid())
+	})
+
+	t.Run("should fail file element with default", func(t *testing.T) {
+		request := getBaseOpenDialogRequest()
+		request.Dialog.Elements = append(request.Dialog.Elements, DialogElement{
+			DisplayName: "Log file",
+			Name:        "log_file",
+			Type:        "file",
+			Default:     NewId(),
+		})
+		err := request.IsValid()
+		assert.ErrorContains(t, err, "file elements cannot have a default")
+	})
+}
+
+func TestSubmitDialogResponseIsValid(t *testing.T) {
+	t.Run("should pass empty and error responses", func(t *testing.T) {
+		assert.NoError(t, (&SubmitDialogResponse{}).IsValid())
+		assert.NoError(t, (&SubmitDialogResponse{Errors: map[string]string{"name": "required"}}).IsValid())
+	})
+
+	t.Run("should pass form response", func(t *testing.T) {
+		response := &SubmitDialogResponse{
+			Type: SubmitDialogResponseTypeForm,
+			Form: &Dialog{
+				CallbackId: "step2",
+				Title:      "Step 2",
+				Elements: []DialogElement{
+					{DisplayName: "Reason", Name: "reason", Type: "textarea"},
+				},
+			},
+		}
+		assert.NoError(t, response.IsValid())
+	})
+
+	t.Run("should fail form response without form", func(t *testing.T) {
+		err := (&SubmitDialogResponse{Type: SubmitDialogResponseTypeForm}).IsValid()
+		assert.ErrorContains(t, err, "missing form")
+	})
+
+	t.Run("should fail form response with invalid form", func(t *testing.T) {
+		err := (&SubmitDialogResponse{Type: SubmitDialogResponseTypeForm, Form: &Dialog{}}).IsValid()
+		assert.ErrorContains(t, err, "invalid dialog title")
+	})
+
+	t.Run("should fail unknown type", func(t *testing.T) {
+		err := (&SubmitDialogResponse{Type: "redirect"}).IsValid()
+		assert.ErrorContains(t, err, "invalid response type")
+	})
 }
@@ -0,0 +1,106 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	IntegrationDeliveryTypeOutgoingWebhook = "outgoing_webhook"
+	IntegrationDeliveryTypeCommand         = "command"
+
+	IntegrationDeliveryResponseMaxRunes = 4096
+	IntegrationDeliveryErrorMaxRunes    = 1024
+	// IntegrationDeliveryPayloadMaxSize is the size above which the payload of a delivery isn't
+	// kept, in which case the delivery can't be replayed.
+	IntegrationDeliveryPayloadMaxSize = 256 * 1024
+	// IntegrationDeliveryMaxPerHook is the number of deliveries kept for each integration.
+	IntegrationDeliveryMaxPerHook = 100
+	IntegrationDeliveryMaxPage    = 100
+)
+
+// IntegrationDelivery records an attempt to deliver a request to an outgoing webhook or a slash
+// command, so that integration authors can debug the failures.
+type IntegrationDelivery struct {
+	Id string `json:"id"`
+	// HookId is the id of the outgoing webhook or of the slash command.
+	HookId      string `json:"hook_id"`
+	Type        string `json:"type"`
+	URL         string `json:"url"`
+	ContentType string `json:"content_type"`
+	// Payload is the body of the request, empty if larger than IntegrationDeliveryPayloadMaxSize.
+	Payload   string `json:"payload"`
+	UserId    string `json:"user_id"`
+	ChannelId string `json:"channel_id"`
+	PostId    string `json:"post_id,omitempty"`
+	// StatusCode is 0 if no response was received.
+	StatusCode int `json:"status_code"`
+	// Latency is the duration of the request, in milliseconds.
+	Latency  int64  `json:"latency"`
+	Response string `json:"response"`
+	Error    string `json:"error,omitempty"`
+	// ReplayOf is the id of the delivery replayed by this one.
+	ReplayOf string `json:"replay_of,omitempty"`
+	CreateAt int64  `json:"create_at"`
+}
+
+func (d *IntegrationDelivery) PreSave() {
+	if d.Id == "" {
+		d.Id = NewId()
+	}
+	if d.CreateAt == 0 {
+		d.CreateAt = GetMillis()
+	}
+	if len(d.Payload) > IntegrationDeliveryPayloadMaxSize {
+		d.Payload = ""
+	}
+	// The response is stored as text, whatever the integration responded with.
+	d.Response = strings.ToValidUTF8(d.Response, "")
+	if utf8.RuneCountInString(d.Response) > IntegrationDeliveryResponseMaxRunes {
+		d.Response = string([]rune(d.Response)[:IntegrationDeliveryResponseMaxRunes])
+	}
+	if utf8.RuneCountInString(d.Error) > IntegrationDeliveryErrorMaxRunes {
+		d.Error = string([]rune(d.Error)[:IntegrationDeliveryErrorMaxRunes])
+	}
+}
+
+func (d *IntegrationDelivery) IsValid() *AppError {
+	if !IsValidId(d.Id) {
+		return NewAppError("IntegrationDelivery.IsValid", "model.integration_delivery.is_valid.id.app_error", nil, "", http.StatusBadRequest)
+	}
+	if !IsValidId(d.HookId) {
+		return NewAppError("IntegrationDelivery.IsValid", "model.integration_delivery.is_valid.hook_id.app_error", nil, "id="+d.Id, http.StatusBadRequest)
+	}
+	if d.Type != IntegrationDeliveryTypeOutgoingWebhook && d.Type != IntegrationDeliveryTypeCommand {
+		return NewAppError("IntegrationDelivery.IsValid", "model.integration_delivery.is_valid.type.app_error", nil, "id="+d.Id, http.StatusBadRequest)
+	}
+	if d.URL == "" || len(d.URL) > 1024 {
+		return NewAppError("IntegrationDelivery.IsValid", "model.integration_delivery.is_valid.url.app_error", nil, "id="+d.Id, http.StatusBadRequest)
+	}
+	if d.UserId != "" && !IsValidId(d.UserId) {
+		return NewAppError("IntegrationDelivery.IsValid", "model.integration_delivery.is_valid.user_id.app_error", nil, "id="+d.Id, http.StatusBadRequest)
+	}
+	if d.ChannelId != "" && !IsValidId(d.ChannelId) {
+		return NewAppError("IntegrationDelivery.IsValid", "model.integration_delivery.is_valid.channel_id.app_error", nil, "id="+d.Id, http.StatusBadRequest)
+	}
+	if d.PostId != "" && !IsValidId(d.PostId) {
+		return NewAppError("IntegrationDelivery.IsValid", "model.integration_delivery.is_valid.post_id.app_error", nil, "id="+d.Id, http.StatusBadRequest)
+	}
+	if d.ReplayOf != "" && !IsValidId(d.ReplayOf) {
+		return NewAppError("IntegrationDelivery.IsValid", "model.integration_delivery.is_valid.replay_of.app_error", nil, "id="+d.Id, http.StatusBadRequest)
+	}
+	if d.CreateAt == 0 {
+		return NewAppError("IntegrationDelivery.IsValid", "model.integration_delivery.is_valid.create_at.app_error", nil, "id="+d.Id, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+// IsSuccess returns whether the integration responded with a 2xx status code.
+func (d *IntegrationDelivery) IsSuccess() bool {
+	return d.StatusCode >= 200 && d.StatusCode < 300 && d.Error == ""
+}
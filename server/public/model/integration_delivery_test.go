@@ -0,0 +1,68 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIntegrationDeliveryPreSave(t *testing.T) {
+	d := &IntegrationDelivery{
+		Payload:  strings.Repeat("a", IntegrationDeliveryPayloadMaxSize+1),
+		Response: strings.Repeat("é", IntegrationDeliveryResponseMaxRunes+10),
+		Error:    strings.Repeat("e", IntegrationDeliveryErrorMaxRunes+10),
+	}
+	d.PreSave()
+
+	assert.True(t, IsValidId(d.Id))
+	assert.NotZero(t, d.CreateAt)
+	assert.Empty(t, d.Payload)
+	assert.Len(t, []rune(d.Response), IntegrationDeliveryResponseMaxRunes)
+	assert.Len(t, d.Error, IntegrationDeliveryErrorMaxRunes)
+
+	d = &IntegrationDelivery{Response: "ok\xff"}
+	d.PreSave()
+	assert.Equal(t, "ok", d.Response)
+}
+
+func TestIntegrationDeliveryIsValid(t *testing.T) {
+	d := &IntegrationDelivery{
+		HookId:    NewId(),
+		Type:      IntegrationDeliveryTypeOutgoingWebhook,
+		URL:       "https://example.com/hook",
+		UserId:    NewId(),
+		ChannelId: NewId(),
+	}
+	d.PreSave()
+	require.Nil(t, d.IsValid())
+
+	for name, update := range map[string]func(d *IntegrationDelivery){
+		"hook id":    func(d *IntegrationDelivery) { d.HookId = "invalid" },
+		"type":       func(d *IntegrationDelivery) { d.Type = "unknown" },
+		"url":        func(d *IntegrationDelivery) { d.URL = "" },
+		"user id":    func(d *IntegrationDelivery) { d.UserId = "invalid" },
+		"channel id": func(d *IntegrationDelivery) { d.ChannelId = "invalid" },
+		"post id":    func(d *IntegrationDelivery) { d.PostId = "invalid" },
+		"replay of":  func(d *IntegrationDelivery) { d.ReplayOf = "invalid" },
+		"create at":  func(d *IntegrationDelivery) { d.CreateAt = 0 },
+	} {
+		t.Run(name, func(t *testing.T) {
+			invalid := *d
+			update(&invalid)
+			assert.NotNil(t, invalid.IsValid())
+		})
+	}
+}
+
+func TestIntegrationDeliveryIsSuccess(t *testing.T) {
+	assert.True(t, (&IntegrationDelivery{StatusCode: 200}).IsSuccess())
+	assert.True(t, (&IntegrationDelivery{StatusCode: 204}).IsSuccess())
+	assert.False(t, (&IntegrationDelivery{StatusCode: 500}).IsSuccess())
+	assert.False(t, (&IntegrationDelivery{StatusCode: 0, Error: "timeout"}).IsSuccess())
+	assert.False(t, (&IntegrationDelivery{StatusCode: 200, Error: "invalid response"}).IsSuccess())
+}
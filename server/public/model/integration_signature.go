@@ -0,0 +1,64 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"time"
+)
+
+const (
+	HeaderIntegrationSignature = "X-Mattermost-Signature"
+	HeaderIntegrationTimestamp = "X-Mattermost-Request-Timestamp"
+
+	IntegrationSignatureVersion = "v1"
+	// IntegrationSigningSecretLength is the length of the secrets generated for
+	// outgoing webhooks and slash commands.
+	IntegrationSigningSecretLength = 32
+	// IntegrationSigningSecretMaxLength is the size of the SigningSecret columns.
+	IntegrationSigningSecretMaxLength = 64
+	// IntegrationSignatureMaxAge is the tolerance receivers are expected to allow
+	// between the signed timestamp and their own clock.
+	IntegrationSignatureMaxAge = 5 * time.Minute
+)
+
+// NewIntegrationSigningSecret returns a new secret for signing integration requests.
+func NewIntegrationSigningSecret() string {
+	return NewRandomString(IntegrationSigningSecretLength)
+}
+
+// SignIntegrationRequest computes the value of the X-Mattermost-Signature header sent with the
+// requests of outgoing webhooks and slash commands. The signature is a hex encoded HMAC-SHA256
+// of "v1:<timestamp>:<body>" keyed with the signing secret, where timestamp is the value of the
+// X-Mattermost-Request-Timestamp header in seconds since epoch. For slash commands using the GET
+// method, the body is the raw query string of the request.
+func SignIntegrationRequest(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(IntegrationSignatureVersion + ":" + strconv.FormatInt(timestamp, 10) + ":"))
+	mac.Write(body)
+	return IntegrationSignatureVersion + "=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifyIntegrationSignature checks a signature computed by SignIntegrationRequest, rejecting
+// timestamps further than IntegrationSignatureMaxAge from now to prevent replays.
+func VerifyIntegrationSignature(secret, timestamp string, body []byte, signature string) bool {
+	if secret == "" {
+		return false
+	}
+
+	ts, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return false
+	}
+
+	age := time.Since(time.Unix(ts, 0))
+	if age > IntegrationSignatureMaxAge || age < -IntegrationSignatureMaxAge {
+		return false
+	}
+
+	return hmac.Equal([]byte(SignIntegrationRequest(secret, ts, body)), []byte(signature))
+}
@@ -0,0 +1,54 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSignIntegrationRequest(t *testing.T) {
+	// Computed independently with: printf 'v1:1700000000:{"text":"hello"}' | openssl dgst -sha256 -hmac secret
+	signature := SignIntegrationRequest("secret", 1700000000, []byte(`{"text":"hello"}`))
+	assert.Equal(t, "v1=26dc59974268c60925e1b720e9f40edbd5e62eec1b41d0f69b56170848e3e827", signature)
+
+	assert.NotEqual(t, signature, SignIntegrationRequest("other", 1700000000, []byte(`{"text":"hello"}`)))
+	assert.NotEqual(t, signature, SignIntegrationRequest("secret", 1700000001, []byte(`{"text":"hello"}`)))
+	assert.NotEqual(t, signature, SignIntegrationRequest("secret", 1700000000, []byte(`{"text":"hellp"}`)))
+}
+
+func TestVerifyIntegrationSignature(t *testing.T) {
+	secret := NewIntegrationSigningSecret()
+	body := []byte("token=abc&text=hello")
+	now := time.Now().Unix()
+	signature := SignIntegrationRequest(secret, now, body)
+
+	t.Run("valid", func(t *testing.T) {
+		assert.True(t, VerifyIntegrationSignature(secret, strconv.FormatInt(now, 10), body, signature))
+	})
+
+	t.Run("tampered body", func(t *testing.T) {
+		assert.False(t, VerifyIntegrationSignature(secret, strconv.FormatInt(now, 10), []byte("token=abc&text=bye"), signature))
+	})
+
+	t.Run("wrong secret", func(t *testing.T) {
+		assert.False(t, VerifyIntegrationSignature(NewIntegrationSigningSecret(), strconv.FormatInt(now, 10), body, signature))
+	})
+
+	t.Run("empty secret", func(t *testing.T) {
+		assert.False(t, VerifyIntegrationSignature("", strconv.FormatInt(now, 10), body, SignIntegrationRequest("", now, body)))
+	})
+
+	t.Run("invalid timestamp", func(t *testing.T) {
+		assert.False(t, VerifyIntegrationSignature(secret, "yesterday", body, signature))
+	})
+
+	t.Run("expired timestamp", func(t *testing.T) {
+		old := time.Now().Add(-2 * IntegrationSignatureMaxAge).Unix()
+		assert.False(t, VerifyIntegrationSignature(secret, strconv.FormatInt(old, 10), body, SignIntegrationRequest(secret, old, body)))
+	})
+}
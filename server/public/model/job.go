@@ -17,10 +17,13 @@ const (
 	JobTypeMigrations                   = "migrations"
 	JobTypePlugins                      = "plugins"
 	JobTypeExpiryNotify                 = "expiry_notify"
+	JobTypeEmailDigest                  = "email_digest"
 	JobTypeProductNotices               = "product_notices"
 	JobTypeActiveUsers                  = "active_users"
 	JobTypeImportProcess                = "import_process"
 	JobTypeImportDelete                 = "import_delete"
+	JobTypeTeamsImport                  = "teams_import"
+	JobTypeImportMerge                  = "import_merge"
 	JobTypeExportProcess                = "export_process"
 	JobTypeExportDelete                 = "export_delete"
 	JobTypeCloud                        = "cloud"
@@ -39,6 +42,7 @@ const (
 	JobTypeRefreshPostStats             = "refresh_post_stats"
 	JobTypeDeleteOrphanDraftsMigration  = "delete_orphan_drafts_migration"
 	JobTypeExportUsersToCSV             = "export_users_to_csv"
+	JobTypeFileContentHashBackfill      = "file_content_hash_backfill"
 
 	JobStatusPending         = "pending"
 	JobStatusInProgress      = "in_progress"
@@ -59,10 +63,13 @@ var AllJobTypes = [...]string{
 	JobTypeMigrations,
 	JobTypePlugins,
 	JobTypeExpiryNotify,
+	JobTypeEmailDigest,
 	JobTypeProductNotices,
 	JobTypeActiveUsers,
 	JobTypeImportProcess,
 	JobTypeImportDelete,
+	JobTypeTeamsImport,
+	JobTypeImportMerge,
 	JobTypeExportProcess,
 	JobTypeExportDelete,
 	JobTypeCloud,
@@ -71,6 +78,7 @@ var AllJobTypes = [...]string{
 	JobTypeLastAccessibleFile,
 	JobTypeCleanupDesktopTokens,
 	JobTypeRefreshPostStats,
+	JobTypeFileContentHashBackfill,
 }
 
 type Job struct {
@@ -0,0 +1,66 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+	"slices"
+)
+
+// The modules whose log level can be raised on their own, without changing the level of the
+// whole server.
+const (
+	LogModuleLDAP           = "ldap"
+	LogModuleRemoteCluster  = "remote_cluster"
+	LogModuleSharedChannels = "shared_channels"
+)
+
+const (
+	LogLevelOverrideDefaultDurationMinutes = 30
+	LogLevelOverrideMaxDurationMinutes     = 24 * 60
+)
+
+var (
+	LogModules        = []string{LogModuleLDAP, LogModuleRemoteCluster, LogModuleSharedChannels}
+	LogOverrideLevels = []string{"error", "warn", "info", "debug", "trace"}
+)
+
+// LogLevelOverride raises the level of the logs of a module until it expires.
+type LogLevelOverride struct {
+	Module          string `json:"module"`
+	Level           string `json:"level"`
+	DurationMinutes int    `json:"duration_minutes,omitempty"`
+	ExpireAt        int64  `json:"expire_at"`
+}
+
+func (o *LogLevelOverride) SetDefaults() {
+	if o.DurationMinutes == 0 {
+		o.DurationMinutes = LogLevelOverrideDefaultDurationMinutes
+	}
+}
+
+func (o *LogLevelOverride) IsValid() *AppError {
+	if !slices.Contains(LogModules, o.Module) {
+		return NewAppError("LogLevelOverride.IsValid", "model.log_level_override.is_valid.module.app_error", map[string]any{"Module": o.Module}, "", http.StatusBadRequest)
+	}
+
+	if !slices.Contains(LogOverrideLevels, o.Level) {
+		return NewAppError("LogLevelOverride.IsValid", "model.log_level_override.is_valid.level.app_error", map[string]any{"Level": o.Level}, "", http.StatusBadRequest)
+	}
+
+	if o.DurationMinutes < 1 || o.DurationMinutes > LogLevelOverrideMaxDurationMinutes {
+		return NewAppError("LogLevelOverride.IsValid", "model.log_level_override.is_valid.duration.app_error", map[string]any{"Max": LogLevelOverrideMaxDurationMinutes}, "", http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+func (o *LogLevelOverride) Auditable() map[string]any {
+	return map[string]any{
+		"module":           o.Module,
+		"level":            o.Level,
+		"duration_minutes": o.DurationMinutes,
+		"expire_at":        o.ExpireAt,
+	}
+}
@@ -0,0 +1,36 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogLevelOverrideIsValid(t *testing.T) {
+	testCases := []struct {
+		name     string
+		override LogLevelOverride
+		valid    bool
+	}{
+		{"default duration", LogLevelOverride{Module: LogModuleLDAP, Level: "debug"}, true},
+		{"maximum duration", LogLevelOverride{Module: LogModuleSharedChannels, Level: "trace", DurationMinutes: LogLevelOverrideMaxDurationMinutes}, true},
+		{"unknown module", LogLevelOverride{Module: "store", Level: "debug"}, false},
+		{"unknown level", LogLevelOverride{Module: LogModuleLDAP, Level: "verbose"}, false},
+		{"negative duration", LogLevelOverride{Module: LogModuleRemoteCluster, Level: "debug", DurationMinutes: -1}, false},
+		{"duration exceeding the maximum", LogLevelOverride{Module: LogModuleLDAP, Level: "debug", DurationMinutes: LogLevelOverrideMaxDurationMinutes + 1}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.override.SetDefaults()
+			if tc.valid {
+				assert.Nil(t, tc.override.IsValid())
+			} else {
+				assert.NotNil(t, tc.override.IsValid())
+			}
+		})
+	}
+}
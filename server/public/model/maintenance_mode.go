@@ -0,0 +1,20 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+const MaintenanceModeMessageMaxRunes = 1024
+
+// MaintenanceMode is the state of the server maintenance mode, during which the write requests
+// to the API are rejected.
+type MaintenanceMode struct {
+	Enabled           bool   `json:"enabled"`
+	Message           string `json:"message"`
+	RetryAfterSeconds int    `json:"retry_after_seconds"`
+}
+
+// RetryableAppError is an error telling the client when to retry the request.
+type RetryableAppError struct {
+	*AppError
+	RetryAfterSeconds int `json:"retry_after_seconds"`
+}
@@ -36,4 +36,8 @@ const (
 	NotificationReasonTooManyUsersInChannel              NotificationReason = "too_many_users_in_channel"
 	NotificationReasonResolvePersistentNotificationError NotificationReason = "resolve_persistent_notification_error"
 	NotificationReasonMissingThreadMembership            NotificationReason = "missing_thread_membership"
+	NotificationReasonQuietHours                         NotificationReason = "quiet_hours"
+	NotificationReasonMutedKeyword                       NotificationReason = "muted_keyword"
+	NotificationReasonEmailDisallowedByUser              NotificationReason = "email_disallowed_by_user"
+	NotificationReasonEmailUndeliverable                 NotificationReason = "email_undeliverable"
 )
@@ -0,0 +1,92 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+	"unicode/utf8"
+)
+
+const (
+	NotificationDeliveryDetailMaxRunes = 512
+	NotificationDeliveryHistoryMaxPage = 200
+
+	// NotificationTypeWebPush is the type of the deliveries of notifications to browsers
+	// subscribed to Web Push.
+	NotificationTypeWebPush NotificationType = "web_push"
+)
+
+// NotificationDelivery records the outcome of sending a notification of a post to a user
+// through one of the notification channels.
+type NotificationDelivery struct {
+	Id        string             `json:"id"`
+	UserId    string             `json:"user_id"`
+	PostId    string             `json:"post_id"`
+	ChannelId string             `json:"channel_id"`
+	Type      NotificationType   `json:"type"`
+	Status    NotificationStatus `json:"status"`
+	Reason    NotificationReason `json:"reason,omitempty"`
+	// Detail holds additional information about the outcome, such as the error returned
+	// by the push proxy.
+	Detail   string `json:"detail,omitempty"`
+	CreateAt int64  `json:"create_at"`
+}
+
+type NotificationDeliveryGetOptions struct {
+	// Since only returns the deliveries recorded after the given time, in milliseconds.
+	Since int64
+	// PostId only returns the deliveries of the given post.
+	PostId  string
+	Page    int
+	PerPage int
+}
+
+func (d *NotificationDelivery) PreSave() {
+	if d.Id == "" {
+		d.Id = NewId()
+	}
+	if d.CreateAt == 0 {
+		d.CreateAt = GetMillis()
+	}
+	if utf8.RuneCountInString(d.Detail) > NotificationDeliveryDetailMaxRunes {
+		d.Detail = string([]rune(d.Detail)[:NotificationDeliveryDetailMaxRunes])
+	}
+}
+
+func (d *NotificationDelivery) IsValid() *AppError {
+	if !IsValidId(d.Id) {
+		return NewAppError("NotificationDelivery.IsValid", "model.notification_delivery.is_valid.id.app_error", nil, "", http.StatusBadRequest)
+	}
+	if !IsValidId(d.UserId) {
+		return NewAppError("NotificationDelivery.IsValid", "model.notification_delivery.is_valid.user_id.app_error", nil, "id="+d.Id, http.StatusBadRequest)
+	}
+	if d.PostId != "" && !IsValidId(d.PostId) {
+		return NewAppError("NotificationDelivery.IsValid", "model.notification_delivery.is_valid.post_id.app_error", nil, "id="+d.Id, http.StatusBadRequest)
+	}
+	if d.ChannelId != "" && !IsValidId(d.ChannelId) {
+		return NewAppError("NotificationDelivery.IsValid", "model.notification_delivery.is_valid.channel_id.app_error", nil, "id="+d.Id, http.StatusBadRequest)
+	}
+
+	switch d.Type {
+	case NotificationTypeEmail, NotificationTypePush, NotificationTypeWebPush, NotificationTypeWebsocket:
+	default:
+		return NewAppError("NotificationDelivery.IsValid", "model.notification_delivery.is_valid.type.app_error", nil, "id="+d.Id, http.StatusBadRequest)
+	}
+
+	switch d.Status {
+	case NotificationStatusSuccess, NotificationStatusError, NotificationStatusNotSent, NotificationStatusUnsupported:
+	default:
+		return NewAppError("NotificationDelivery.IsValid", "model.notification_delivery.is_valid.status.app_error", nil, "id="+d.Id, http.StatusBadRequest)
+	}
+
+	if len(d.Reason) > 64 {
+		return NewAppError("NotificationDelivery.IsValid", "model.notification_delivery.is_valid.reason.app_error", nil, "id="+d.Id, http.StatusBadRequest)
+	}
+
+	if d.CreateAt == 0 {
+		return NewAppError("NotificationDelivery.IsValid", "model.notification_delivery.is_valid.create_at.app_error", nil, "id="+d.Id, http.StatusBadRequest)
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotificationDeliveryPreSave(t *testing.T) {
+	d := &NotificationDelivery{Detail: strings.Repeat("é", NotificationDeliveryDetailMaxRunes+10)}
+	d.PreSave()
+
+	assert.True(t, IsValidId(d.Id))
+	assert.NotZero(t, d.CreateAt)
+	assert.Equal(t, NotificationDeliveryDetailMaxRunes, utf8.RuneCountInString(d.Detail))
+}
+
+func TestNotificationDeliveryIsValid(t *testing.T) {
+	valid := func() *NotificationDelivery {
+		d := &NotificationDelivery{
+			UserId:    NewId(),
+			PostId:    NewId(),
+			ChannelId: NewId(),
+			Type:      NotificationTypeEmail,
+			Status:    NotificationStatusNotSent,
+			Reason:    NotificationReasonEmailNotVerified,
+		}
+		d.PreSave()
+		return d
+	}
+
+	require.Nil(t, valid().IsValid())
+
+	d := valid()
+	d.PostId = ""
+	d.ChannelId = ""
+	assert.Nil(t, d.IsValid(), "post and channel are optional")
+
+	for name, modify := range map[string]func(d *NotificationDelivery){
+		"invalid id":      func(d *NotificationDelivery) { d.Id = "invalid" },
+		"missing user id": func(d *NotificationDelivery) { d.UserId = "" },
+		"invalid post id": func(d *NotificationDelivery) { d.PostId = "invalid" },
+		"invalid type":    func(d *NotificationDelivery) { d.Type = NotificationTypeAll },
+		"invalid status":  func(d *NotificationDelivery) { d.Status = "delivered" },
+		"long reason":     func(d *NotificationDelivery) { d.Reason = NotificationReason(strings.Repeat("a", 65)) },
+		"missing time":    func(d *NotificationDelivery) { d.CreateAt = 0 },
+	} {
+		t.Run(name, func(t *testing.T) {
+			d := valid()
+			modify(d)
+			assert.NotNil(t, d.IsValid())
+		})
+	}
+}
@@ -28,6 +28,10 @@ type OutgoingWebhook struct {
 	ContentType  string      `json:"content_type"`
 	Username     string      `json:"username"`
 	IconURL      string      `json:"icon_url"`
+	// SigningSecret is the key used to sign the requests sent to the callback URLs, see
+	// SignIntegrationRequest. Webhooks created before signing was introduced have none
+	// until it is regenerated.
+	SigningSecret string `json:"signing_secret"`
 }
 
 func (o *OutgoingWebhook) Auditable() map[string]interface{} {
@@ -105,6 +109,10 @@ func (o *OutgoingWebhook) IsValid() *AppError {
 		return NewAppError("OutgoingWebhook.IsValid", "model.outgoing_hook.is_valid.token.app_error", nil, "", http.StatusBadRequest)
 	}
 
+	if len(o.SigningSecret) > IntegrationSigningSecretMaxLength {
+		return NewAppError("OutgoingWebhook.IsValid", "model.outgoing_hook.is_valid.signing_secret.app_error", nil, "", http.StatusBadRequest)
+	}
+
 	if o.CreateAt == 0 {
 		return NewAppError("OutgoingWebhook.IsValid", "model.outgoing_hook.is_valid.create_at.app_error", nil, "id="+o.Id, http.StatusBadRequest)
 	}
@@ -183,6 +191,10 @@ func (o *OutgoingWebhook) PreSave() {
 		o.Token = NewId()
 	}
 
+	if o.SigningSecret == "" {
+		o.SigningSecret = NewIntegrationSigningSecret()
+	}
+
 	o.CreateAt = GetMillis()
 	o.UpdateAt = o.CreateAt
 }
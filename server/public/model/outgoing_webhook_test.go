@@ -121,6 +121,11 @@ func TestOutgoingWebhookPayloadToFormValues(t *testing.T) {
 func TestOutgoingWebhookPreSave(t *testing.T) {
 	o := OutgoingWebhook{}
 	o.PreSave()
+	assert.Len(t, o.SigningSecret, IntegrationSigningSecretLength)
+
+	secret := o.SigningSecret
+	o.PreSave()
+	assert.Equal(t, secret, o.SigningSecret, "existing secret should be kept")
 }
 
 func TestOutgoingWebhookPreUpdate(t *testing.T) {
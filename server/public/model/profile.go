@@ -0,0 +1,74 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+	"strings"
+)
+
+const (
+	ProfileTypeCPU       = "cpu"
+	ProfileTypeHeap      = "heap"
+	ProfileTypeGoroutine = "goroutine"
+	ProfileTypeTrace     = "trace"
+
+	ProfileDefaultDurationSeconds = 30
+	ProfileMaxDurationSeconds     = 120
+)
+
+// ProfileRequest describes a runtime profile to capture. The duration only applies to the CPU
+// profiles and the execution traces, the other profiles being snapshots.
+type ProfileRequest struct {
+	Type            string `json:"type"`
+	DurationSeconds int    `json:"duration_seconds"`
+}
+
+func (r *ProfileRequest) SetDefaults() {
+	if r.HasDuration() && r.DurationSeconds == 0 {
+		r.DurationSeconds = ProfileDefaultDurationSeconds
+	}
+}
+
+// HasDuration returns whether the profile is captured over a period of time.
+func (r *ProfileRequest) HasDuration() bool {
+	return r.Type == ProfileTypeCPU || r.Type == ProfileTypeTrace
+}
+
+func (r *ProfileRequest) IsValid() *AppError {
+	switch r.Type {
+	case ProfileTypeCPU, ProfileTypeHeap, ProfileTypeGoroutine, ProfileTypeTrace:
+	default:
+		return NewAppError("ProfileRequest.IsValid", "model.profile.is_valid.type.app_error", map[string]any{"Type": r.Type}, "", http.StatusBadRequest)
+	}
+
+	if r.HasDuration() && (r.DurationSeconds < 1 || r.DurationSeconds > ProfileMaxDurationSeconds) {
+		return NewAppError("ProfileRequest.IsValid", "model.profile.is_valid.duration.app_error", map[string]any{"Max": ProfileMaxDurationSeconds}, "", http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+// Profile is a runtime profile captured by a server and stored in the file backend.
+type Profile struct {
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Size     int64  `json:"size"`
+	CreateAt int64  `json:"create_at"`
+}
+
+func (p *Profile) Auditable() map[string]any {
+	return map[string]any{
+		"name":      p.Name,
+		"type":      p.Type,
+		"size":      p.Size,
+		"create_at": p.CreateAt,
+	}
+}
+
+// ProfileTypeFromName returns the type of a profile from its file name, which starts with it.
+func ProfileTypeFromName(name string) string {
+	profileType, _, _ := strings.Cut(name, "_")
+	return profileType
+}
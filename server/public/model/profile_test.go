@@ -0,0 +1,42 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProfileRequestIsValid(t *testing.T) {
+	testCases := []struct {
+		name    string
+		request ProfileRequest
+		valid   bool
+	}{
+		{"cpu profile with the default duration", ProfileRequest{Type: ProfileTypeCPU}, true},
+		{"trace with the maximum duration", ProfileRequest{Type: ProfileTypeTrace, DurationSeconds: ProfileMaxDurationSeconds}, true},
+		{"heap profile", ProfileRequest{Type: ProfileTypeHeap}, true},
+		{"goroutine profile ignoring the duration", ProfileRequest{Type: ProfileTypeGoroutine, DurationSeconds: -1}, true},
+		{"cpu profile exceeding the maximum duration", ProfileRequest{Type: ProfileTypeCPU, DurationSeconds: ProfileMaxDurationSeconds + 1}, false},
+		{"trace with a negative duration", ProfileRequest{Type: ProfileTypeTrace, DurationSeconds: -1}, false},
+		{"unknown type", ProfileRequest{Type: "mutex"}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.request.SetDefaults()
+			if tc.valid {
+				assert.Nil(t, tc.request.IsValid())
+			} else {
+				assert.NotNil(t, tc.request.IsValid())
+			}
+		})
+	}
+}
+
+func TestProfileTypeFromName(t *testing.T) {
+	assert.Equal(t, ProfileTypeCPU, ProfileTypeFromName("cpu_app-1_20240101T120000.000Z.pprof"))
+	assert.Equal(t, ProfileTypeTrace, ProfileTypeFromName("trace_app-1_20240101T120000.000Z.out"))
+}
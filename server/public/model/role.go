@@ -5,11 +5,16 @@ package model
 
 import (
 	"fmt"
+	"net/http"
 	"strings"
 )
 
 // SysconsoleAncillaryPermissions maps the non-sysconsole permissions required by each sysconsole view.
 var SysconsoleAncillaryPermissions map[string][]*Permission
+
+// CustomRolePermissionDependencies maps the permissions that can't be used without others, so that
+// custom roles are rejected when they grant a permission without what it depends on.
+var CustomRolePermissionDependencies map[string][]*Permission
 var SystemManagerDefaultPermissions []string
 var SystemUserManagerDefaultPermissions []string
 var SystemReadOnlyAdminDefaultPermissions []string
@@ -52,6 +57,24 @@ func init() {
 		RunMemberRoleId,
 	}, NewSystemRoleIDs...)
 
+	CustomRolePermissionDependencies = map[string][]*Permission{
+		PermissionReadChannelContent.Id:           {PermissionReadChannel},
+		PermissionCreatePost.Id:                   {PermissionReadChannel},
+		PermissionAddReaction.Id:                  {PermissionReadChannel},
+		PermissionRemoveReaction.Id:               {PermissionAddReaction},
+		PermissionRemoveOthersReactions.Id:        {PermissionRemoveReaction},
+		PermissionEditPost.Id:                     {PermissionCreatePost},
+		PermissionEditOthersPosts.Id:              {PermissionEditPost},
+		PermissionDeletePost.Id:                   {PermissionReadChannel},
+		PermissionDeleteOthersPosts.Id:            {PermissionDeletePost},
+		PermissionUploadFile.Id:                   {PermissionCreatePost},
+		PermissionUseChannelMentions.Id:           {PermissionCreatePost},
+		PermissionUseGroupMentions.Id:             {PermissionCreatePost},
+		PermissionManageOthersIncomingWebhooks.Id: {PermissionManageIncomingWebhooks},
+		PermissionManageOthersOutgoingWebhooks.Id: {PermissionManageOutgoingWebhooks},
+		PermissionManageOthersSlashCommands.Id:    {PermissionManageSlashCommands},
+	}
+
 	// When updating the values here, the values in mattermost-redux must also be updated.
 	SysconsoleAncillaryPermissions = map[string][]*Permission{
 		PermissionSysconsoleReadAboutEditionAndLicense.Id: {
@@ -725,6 +748,41 @@ func (r *Role) IsValidWithoutId() bool {
 	return true
 }
 
+// IsCustom returns true for roles created through the API rather than by the server or a scheme.
+func (r *Role) IsCustom() bool {
+	return !r.BuiltIn && !r.SchemeManaged
+}
+
+// IsValidCustomRolePermissions checks that every permission of a custom role can be granted per team
+// or channel, and that the permissions it depends on are granted too.
+func (r *Role) IsValidCustomRolePermissions() *AppError {
+	granted := make(map[string]bool, len(r.Permissions))
+	for _, permission := range r.Permissions {
+		granted[permission] = true
+	}
+
+	for _, permission := range r.Permissions {
+		scoped := false
+		for _, p := range AllPermissions {
+			if p.Id == permission {
+				scoped = p.Scope == PermissionScopeTeam || p.Scope == PermissionScopeChannel
+				break
+			}
+		}
+		if !scoped {
+			return NewAppError("Role.IsValidCustomRolePermissions", "model.role.custom.permission_scope.app_error", map[string]any{"Permission": permission}, "", http.StatusBadRequest)
+		}
+
+		for _, dependency := range CustomRolePermissionDependencies[permission] {
+			if !granted[dependency.Id] {
+				return NewAppError("Role.IsValidCustomRolePermissions", "model.role.custom.permission_dependency.app_error", map[string]any{"Permission": permission, "Dependency": dependency.Id}, "", http.StatusBadRequest)
+			}
+		}
+	}
+
+	return nil
+}
+
 func CleanRoleNames(roleNames []string) ([]string, bool) {
 	var cleanedRoleNames []string
 	for _, roleName := range roleNames {
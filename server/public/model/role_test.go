@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestChannelModeratedPermissionsChangedByPatch(t *testing.T) {
@@ -275,3 +276,43 @@ func TestGetChannelModeratedPermissions(t *testing.T) {
 		})
 	}
 }
+
+func TestRoleIsValidCustomRolePermissions(t *testing.T) {
+	t.Run("dependencies are team or channel scoped", func(t *testing.T) {
+		scopes := map[string]string{}
+		for _, p := range AllPermissions {
+			scopes[p.Id] = p.Scope
+		}
+
+		for permission, dependencies := range CustomRolePermissionDependencies {
+			assert.Contains(t, []string{PermissionScopeTeam, PermissionScopeChannel}, scopes[permission], permission)
+			for _, dependency := range dependencies {
+				assert.Contains(t, []string{PermissionScopeTeam, PermissionScopeChannel}, scopes[dependency.Id], dependency.Id)
+			}
+		}
+	})
+
+	t.Run("moderator", func(t *testing.T) {
+		role := &Role{Permissions: []string{
+			PermissionReadChannel.Id,
+			PermissionDeletePost.Id,
+			PermissionDeleteOthersPosts.Id,
+			PermissionManagePublicChannelMembers.Id,
+		}}
+		assert.Nil(t, role.IsValidCustomRolePermissions())
+	})
+
+	t.Run("missing dependency", func(t *testing.T) {
+		role := &Role{Permissions: []string{PermissionReadChannel.Id, PermissionDeleteOthersPosts.Id}}
+		appErr := role.IsValidCustomRolePermissions()
+		require.NotNil(t, appErr)
+		assert.Equal(t, "model.role.custom.permission_dependency.app_error", appErr.Id)
+	})
+
+	t.Run("system scoped permission", func(t *testing.T) {
+		role := &Role{Permissions: []string{PermissionManageSystem.Id}}
+		appErr := role.IsValidCustomRolePermissions()
+		require.NotNil(t, appErr)
+		assert.Equal(t, "model.role.custom.permission_scope.app_error", appErr.Id)
+	})
+}
@@ -4,6 +4,7 @@
 package model
 
 import (
+	"encoding/json"
 	"net/http"
 	"strconv"
 	"strings"
@@ -24,6 +25,7 @@ const (
 	SessionPropUserAccessTokenId      = "user_access_token_id"
 	SessionPropIsBot                  = "is_bot"
 	SessionPropIsBotValue             = "true"
+	SessionPropBotScope               = "bot_scope"
 	SessionPropOAuthAppID             = "oauth_app_id"
 	SessionPropMattermostAppID        = "mattermost_app_id"
 	SessionTypeUserAccessToken        = "UserAccessToken"
@@ -223,6 +225,23 @@ func (s *Session) IsBotUser() bool {
 	return false
 }
 
+// GetBotScope returns the scope restricting the bot authenticated by the session, or nil when the
+// session isn't restricted.
+func (s *Session) GetBotScope() *BotScope {
+	val, ok := s.Props[SessionPropBotScope]
+	if !ok {
+		return nil
+	}
+
+	var scope BotScope
+	if err := json.Unmarshal([]byte(val), &scope); err != nil {
+		mlog.Warn("Error parsing the bot scope of the session", mlog.String("session_id", s.Id), mlog.Err(err))
+		// An unreadable scope restricts the session to nothing rather than to everything.
+		return &BotScope{}
+	}
+	return &scope
+}
+
 func (s *Session) IsUserAccessToken() bool {
 	val, ok := s.Props[SessionPropType]
 	if !ok {
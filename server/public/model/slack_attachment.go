@@ -4,6 +4,7 @@
 package model
 
 import (
+	"encoding/json"
 	"fmt"
 	"regexp"
 )
@@ -194,3 +195,119 @@ func ParseSlackAttachment(post *Post, attachments []*SlackAttachment) {
 func ParseSlackLinksToMarkdown(text string) string {
 	return linkWithTextRegex.ReplaceAllString(text, "[${2}](${1})")
 }
+
+const (
+	SlackAttachmentsMaxCount       = 100
+	SlackAttachmentFieldsMaxCount  = 100
+	SlackAttachmentActionsMaxCount = 25
+)
+
+var slackAttachmentColorRegex = regexp.MustCompile(`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+
+// SlackAttachmentError describes an invalid value found by ValidateSlackAttachments.
+type SlackAttachmentError struct {
+	// Field is the path of the value in the payload, e.g. attachments[0].fields[1].value.
+	Field  string `json:"field"`
+	Reason string `json:"reason"`
+}
+
+func (e *SlackAttachmentError) Error() string {
+	return e.Field + ": " + e.Reason
+}
+
+// ValidateSlackAttachments checks that attachments have the shape expected by the clients
+// rendering them, which the lenient decoding of integration payloads doesn't guarantee.
+// It returns every problem found rather than stopping at the first one.
+func ValidateSlackAttachments(attachments []*SlackAttachment) []*SlackAttachmentError {
+	var errs []*SlackAttachmentError
+	invalid := func(field, reason string, args ...any) {
+		errs = append(errs, &SlackAttachmentError{Field: field, Reason: fmt.Sprintf(reason, args...)})
+	}
+
+	if len(attachments) > SlackAttachmentsMaxCount {
+		invalid("attachments", "must contain at most %d attachments", SlackAttachmentsMaxCount)
+	}
+
+	for i, attachment := range attachments {
+		path := fmt.Sprintf("attachments[%d]", i)
+		if attachment == nil {
+			invalid(path, "must be an object")
+			continue
+		}
+
+		switch attachment.Color {
+		case "", "good", "warning", "danger":
+		default:
+			if !slackAttachmentColorRegex.MatchString(attachment.Color) {
+				invalid(path+".color", "must be good, warning, danger or a hex color")
+			}
+		}
+
+		for name, value := range map[string]string{
+			"author_link": attachment.AuthorLink,
+			"author_icon": attachment.AuthorIcon,
+			"title_link":  attachment.TitleLink,
+			"image_url":   attachment.ImageURL,
+			"thumb_url":   attachment.ThumbURL,
+			"footer_icon": attachment.FooterIcon,
+		} {
+			if value != "" && !IsValidHTTPURL(value) {
+				invalid(path+"."+name, "must be an http or https URL")
+			}
+		}
+
+		switch attachment.Timestamp.(type) {
+		case nil, string, float64, int64, json.Number:
+		default:
+			invalid(path+".ts", "must be a string or a number")
+		}
+
+		if len(attachment.Fields) > SlackAttachmentFieldsMaxCount {
+			invalid(path+".fields", "must contain at most %d fields", SlackAttachmentFieldsMaxCount)
+		}
+		for j, field := range attachment.Fields {
+			fieldPath := fmt.Sprintf("%s.fields[%d]", path, j)
+			if field == nil {
+				invalid(fieldPath, "must be an object")
+				continue
+			}
+
+			switch field.Value.(type) {
+			case nil, string, float64, int64, bool, json.Number:
+			default:
+				invalid(fieldPath+".value", "must be a string, a number or a boolean")
+			}
+		}
+
+		if len(attachment.Actions) > SlackAttachmentActionsMaxCount {
+			invalid(path+".actions", "must contain at most %d actions", SlackAttachmentActionsMaxCount)
+		}
+		for j, action := range attachment.Actions {
+			actionPath := fmt.Sprintf("%s.actions[%d]", path, j)
+			if action == nil {
+				invalid(actionPath, "must be an object")
+				continue
+			}
+
+			switch action.Type {
+			case "", PostActionTypeButton, PostActionTypeSelect:
+			default:
+				invalid(actionPath+".type", "must be button or select")
+			}
+
+			switch action.DataSource {
+			case "", "users", "channels":
+			default:
+				invalid(actionPath+".data_source", "must be users or channels")
+			}
+
+			for k, option := range action.Options {
+				if option == nil {
+					invalid(fmt.Sprintf("%s.options[%d]", actionPath, k), "must be an object")
+				}
+			}
+		}
+	}
+
+	return errs
+}
@@ -42,3 +42,80 @@ func TestParseSlackAttachment(t *testing.T) {
 		assert.Equal(t, expectedPost, post)
 	})
 }
+
+func TestValidateSlackAttachments(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		attachments := []*SlackAttachment{
+			{
+				Color:     "#36a64f",
+				Text:      "Build passed",
+				TitleLink: "https://ci.example.com/builds/1",
+				Timestamp: float64(1700000000),
+				Fields: []*SlackAttachmentField{
+					{Title: "Duration", Value: "2m"},
+					{Title: "Tests", Value: float64(120)},
+					{Title: "Flaky", Value: false},
+				},
+				Actions: []*PostAction{
+					{Type: PostActionTypeButton, Name: "Rerun"},
+					{Type: PostActionTypeSelect, DataSource: "users"},
+				},
+			},
+			{Color: "danger", Timestamp: "1700000000"},
+		}
+
+		assert.Empty(t, ValidateSlackAttachments(attachments))
+		assert.Empty(t, ValidateSlackAttachments(nil))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		attachments := []*SlackAttachment{
+			nil,
+			{
+				Color:     "purple",
+				ImageURL:  "javascript:alert(1)",
+				Timestamp: map[string]any{"seconds": 1},
+				Fields: []*SlackAttachmentField{
+					nil,
+					{Title: "Nested", Value: []any{"a", "b"}},
+				},
+				Actions: []*PostAction{
+					{Type: "link", DataSource: "teams", Options: []*PostActionOptions{nil}},
+				},
+			},
+		}
+
+		errs := ValidateSlackAttachments(attachments)
+		fields := make([]string, 0, len(errs))
+		for _, err := range errs {
+			fields = append(fields, err.Field)
+		}
+		assert.ElementsMatch(t, []string{
+			"attachments[0]",
+			"attachments[1].color",
+			"attachments[1].image_url",
+			"attachments[1].ts",
+			"attachments[1].fields[0]",
+			"attachments[1].fields[1].value",
+			"attachments[1].actions[0].type",
+			"attachments[1].actions[0].data_source",
+			"attachments[1].actions[0].options[0]",
+		}, fields)
+	})
+
+	t.Run("too many", func(t *testing.T) {
+		attachments := make([]*SlackAttachment, SlackAttachmentsMaxCount+1)
+		for i := range attachments {
+			attachments[i] = &SlackAttachment{}
+		}
+		attachments[0].Fields = make([]*SlackAttachmentField, SlackAttachmentFieldsMaxCount+1)
+		for i := range attachments[0].Fields {
+			attachments[0].Fields[i] = &SlackAttachmentField{}
+		}
+
+		errs := ValidateSlackAttachments(attachments)
+		assert.Len(t, errs, 2)
+		assert.Equal(t, "attachments", errs[0].Field)
+		assert.Equal(t, "attachments[0].fields", errs[1].Field)
+	})
+}
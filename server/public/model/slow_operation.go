@@ -0,0 +1,38 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+const (
+	SlowOperationKindQuery   = "query"
+	SlowOperationKindRequest = "request"
+)
+
+// SlowOperation is a store query or an API request which exceeded the capture threshold. The
+// queries are captured without the values of their bind parameters.
+type SlowOperation struct {
+	Kind       string `json:"kind"`
+	Query      string `json:"query,omitempty"`
+	Method     string `json:"method,omitempty"`
+	Route      string `json:"route,omitempty"`
+	StatusCode string `json:"status_code,omitempty"`
+	Elapsed    int64  `json:"elapsed"`
+	CreateAt   int64  `json:"create_at"`
+}
+
+// SlowOperationsInterval holds the slowest operations captured over an interval, slowest first.
+// The interval is still ongoing when EndAt is zero.
+type SlowOperationsInterval struct {
+	StartAt  int64            `json:"start_at"`
+	EndAt    int64            `json:"end_at"`
+	Queries  []*SlowOperation `json:"queries"`
+	Requests []*SlowOperation `json:"requests"`
+}
+
+// SlowOperations holds the slowest operations of the ongoing interval of a server, and of the
+// interval before it, if any.
+type SlowOperations struct {
+	Enabled  bool                    `json:"enabled"`
+	Current  *SlowOperationsInterval `json:"current"`
+	Previous *SlowOperationsInterval `json:"previous,omitempty"`
+}
@@ -5,6 +5,8 @@ package model
 
 import (
 	"encoding/json"
+	"net/http"
+	"time"
 )
 
 const (
@@ -16,6 +18,12 @@ const (
 	StatusCacheSize      = SessionCacheSize
 	StatusChannelTimeout = 20000  // 20 seconds
 	StatusMinUpdateTime  = 120000 // 2 minutes
+
+	TimedStatusesMaxBulkSize = 200
+
+	// PresenceSubscriptionMaxUsers is the maximum number of users a websocket connection can
+	// subscribe to the presence of.
+	PresenceSubscriptionMaxUsers = 1000
 )
 
 type Status struct {
@@ -28,6 +36,37 @@ type Status struct {
 	PrevStatus     string `json:"-"`
 }
 
+// TimedStatus is a status set by a calendar provider, such as being in a meeting or out of office.
+// The status the user had before is restored by the server once EndTime is reached.
+type TimedStatus struct {
+	UserId string `json:"user_id"`
+	Status string `json:"status"`
+	// EndTime is in unix epoch format in UTC, like Status.DNDEndTime.
+	EndTime int64 `json:"end_time"`
+	// CustomStatus is optionally set alongside the status, and expires at EndTime too.
+	CustomStatus *CustomStatus `json:"custom_status,omitempty"`
+}
+
+func (ts *TimedStatus) IsValid() *AppError {
+	if !IsValidId(ts.UserId) {
+		return NewAppError("TimedStatus.IsValid", "model.timed_status.is_valid.user_id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if ts.Status != StatusDnd && ts.Status != StatusOutOfOffice {
+		return NewAppError("TimedStatus.IsValid", "model.timed_status.is_valid.status.app_error", nil, "status="+ts.Status, http.StatusBadRequest)
+	}
+
+	if ts.EndTime <= time.Now().UTC().Unix() {
+		return NewAppError("TimedStatus.IsValid", "model.timed_status.is_valid.end_time.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if ts.CustomStatus != nil && ts.CustomStatus.Emoji == "" && ts.CustomStatus.Text == "" {
+		return NewAppError("TimedStatus.IsValid", "model.timed_status.is_valid.custom_status.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	return nil
+}
+
 func (s *Status) ToJSON() ([]byte, error) {
 	sCopy := *s
 	sCopy.ActiveChannel = ""
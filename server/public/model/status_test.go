@@ -6,6 +6,7 @@ package model
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -43,3 +44,27 @@ func TestStatusListToJSON(t *testing.T) {
 	assert.Equal(t, statuses[0].UserId, dat[0]["user_id"])
 	assert.Equal(t, statuses[1].UserId, dat[1]["user_id"])
 }
+
+func TestTimedStatusIsValid(t *testing.T) {
+	endTime := time.Now().Add(time.Hour).Unix()
+
+	for name, tc := range map[string]struct {
+		status  TimedStatus
+		isValid bool
+	}{
+		"meeting":             {TimedStatus{UserId: NewId(), Status: StatusDnd, EndTime: endTime}, true},
+		"out of office":       {TimedStatus{UserId: NewId(), Status: StatusOutOfOffice, EndTime: endTime, CustomStatus: &CustomStatus{Text: "On vacation"}}, true},
+		"invalid user id":     {TimedStatus{UserId: "user", Status: StatusDnd, EndTime: endTime}, false},
+		"online":              {TimedStatus{UserId: NewId(), Status: StatusOnline, EndTime: endTime}, false},
+		"past end time":       {TimedStatus{UserId: NewId(), Status: StatusDnd, EndTime: time.Now().Add(-time.Hour).Unix()}, false},
+		"empty custom status": {TimedStatus{UserId: NewId(), Status: StatusDnd, EndTime: endTime, CustomStatus: &CustomStatus{}}, false},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if tc.isValid {
+				assert.Nil(t, tc.status.IsValid())
+			} else {
+				assert.NotNil(t, tc.status.IsValid())
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ package model
 
 import (
 	"math/big"
+	"time"
 )
 
 const (
@@ -83,16 +84,27 @@ type AppliedMigration struct {
 	Name    string `json:"name"`
 }
 
+// LogFilterDateFormat is the format of the dates of the log filters and of the timestamps of the
+// log entries.
+const LogFilterDateFormat = "2006-01-02 15:04:05.999 -07:00"
+
 type LogFilter struct {
 	ServerNames []string `json:"server_names"`
 	LogLevels   []string `json:"log_levels"`
 	DateFrom    string   `json:"date_from"`
 	DateTo      string   `json:"date_to"`
+	// Caller matches the entries logged from a source file whose path contains it.
+	Caller    string `json:"caller"`
+	UserId    string `json:"user_id"`
+	RequestId string `json:"request_id"`
 }
 
 type LogEntry struct {
 	Timestamp string
 	Level     string
+	Caller    string `json:"caller"`
+	UserId    string `json:"user_id"`
+	RequestId string `json:"request_id"`
 }
 
 // SystemPingOptions is the options for setting contents of the system ping
@@ -105,3 +117,33 @@ type SystemPingOptions struct {
 	// status is unhealthy.
 	RESTSemantics bool
 }
+
+// DependencyHealth is the health of one of the services the server depends on, as reported by
+// the deep mode of the system ping.
+type DependencyHealth struct {
+	Name      string `json:"name"`
+	Status    string `json:"status"`
+	LatencyMs int64  `json:"latency_ms"`
+	Error     string `json:"error,omitempty"`
+}
+
+// NewDependencyHealth creates the health of the named dependency from the outcome of a check
+// started at start.
+func NewDependencyHealth(name string, start time.Time, err error) *DependencyHealth {
+	health := &DependencyHealth{
+		Name:      name,
+		Status:    StatusOk,
+		LatencyMs: time.Since(start).Milliseconds(),
+	}
+	if err != nil {
+		health.Status = StatusUnhealthy
+		health.Error = err.Error()
+	}
+	return health
+}
+
+// SystemHealth is the response of the deep mode of the system ping.
+type SystemHealth struct {
+	Status       string              `json:"status"`
+	Dependencies []*DependencyHealth `json:"dependencies"`
+}
@@ -0,0 +1,26 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewDependencyHealth(t *testing.T) {
+	start := time.Now().Add(-50 * time.Millisecond)
+
+	health := NewDependencyHealth("database_primary", start, nil)
+	assert.Equal(t, "database_primary", health.Name)
+	assert.Equal(t, StatusOk, health.Status)
+	assert.GreaterOrEqual(t, health.LatencyMs, int64(50))
+	assert.Empty(t, health.Error)
+
+	health = NewDependencyHealth("smtp", start, errors.New("connection refused"))
+	assert.Equal(t, StatusUnhealthy, health.Status)
+	assert.Equal(t, "connection refused", health.Error)
+}
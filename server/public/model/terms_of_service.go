@@ -9,11 +9,53 @@ import (
 	"unicode/utf8"
 )
 
+const (
+	// TermsOfServiceAudienceAll requires every user to accept the terms.
+	TermsOfServiceAudienceAll = ""
+	// TermsOfServiceAudienceGuests only requires guests to accept the terms again. Other users who
+	// accepted a previous version aren't asked again.
+	TermsOfServiceAudienceGuests = "guests"
+	// TermsOfServiceAudienceMembers only requires non guest users to accept the terms again.
+	TermsOfServiceAudienceMembers = "members"
+)
+
 type TermsOfService struct {
 	Id       string `json:"id"`
 	CreateAt int64  `json:"create_at"`
 	UserId   string `json:"user_id"`
 	Text     string `json:"text"`
+	Version  int    `json:"version"`
+	Audience string `json:"audience"`
+}
+
+// TermsOfServiceStats reports how many of the users targeted by a version of the terms of service
+// have accepted it.
+type TermsOfServiceStats struct {
+	TermsOfServiceId string `json:"terms_of_service_id"`
+	Version          int    `json:"version"`
+	TargetedUsers    int64  `json:"targeted_users"`
+	AcceptedUsers    int64  `json:"accepted_users"`
+	GracePeriodEndAt int64  `json:"grace_period_end_at"`
+}
+
+func IsValidTermsOfServiceAudience(audience string) bool {
+	switch audience {
+	case TermsOfServiceAudienceAll, TermsOfServiceAudienceGuests, TermsOfServiceAudienceMembers:
+		return true
+	}
+	return false
+}
+
+// IncludesUser returns true if the user is required to accept this version of the terms, even if
+// they already accepted a previous one.
+func (t *TermsOfService) IncludesUser(user *User) bool {
+	switch t.Audience {
+	case TermsOfServiceAudienceGuests:
+		return user.IsGuest()
+	case TermsOfServiceAudienceMembers:
+		return !user.IsGuest()
+	}
+	return true
 }
 
 func (t *TermsOfService) IsValid() *AppError {
@@ -33,6 +75,14 @@ func (t *TermsOfService) IsValid() *AppError {
 		return InvalidTermsOfServiceError("text", t.Id)
 	}
 
+	if t.Version < 0 {
+		return InvalidTermsOfServiceError("version", t.Id)
+	}
+
+	if !IsValidTermsOfServiceAudience(t.Audience) {
+		return InvalidTermsOfServiceError("audience", t.Id)
+	}
+
 	return nil
 }
 
@@ -32,4 +32,31 @@ func TestTermsOfServiceIsValid(t *testing.T) {
 
 	s.Text = "test"
 	assert.Nil(t, s.IsValid(), "should be valid")
+
+	s.Version = -1
+	assert.NotNil(t, s.IsValid(), "should be invalid")
+
+	s.Version = 2
+	s.Audience = "admins"
+	assert.NotNil(t, s.IsValid(), "should be invalid")
+
+	s.Audience = TermsOfServiceAudienceGuests
+	assert.Nil(t, s.IsValid(), "should be valid")
+}
+
+func TestTermsOfServiceIncludesUser(t *testing.T) {
+	guest := &User{Roles: SystemGuestRoleId}
+	member := &User{Roles: SystemUserRoleId}
+
+	terms := &TermsOfService{Audience: TermsOfServiceAudienceAll}
+	assert.True(t, terms.IncludesUser(guest))
+	assert.True(t, terms.IncludesUser(member))
+
+	terms.Audience = TermsOfServiceAudienceGuests
+	assert.True(t, terms.IncludesUser(guest))
+	assert.False(t, terms.IncludesUser(member))
+
+	terms.Audience = TermsOfServiceAudienceMembers
+	assert.False(t, terms.IncludesUser(guest))
+	assert.True(t, terms.IncludesUser(member))
 }
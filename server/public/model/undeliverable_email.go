@@ -0,0 +1,73 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// UndeliverableEmailReasonBounce is the reason of the addresses the mail server of the
+	// recipient permanently rejected.
+	UndeliverableEmailReasonBounce = "bounce"
+	// UndeliverableEmailReasonComplaint is the reason of the addresses whose recipient marked an
+	// email as spam.
+	UndeliverableEmailReasonComplaint = "complaint"
+
+	UndeliverableEmailDetailMaxRunes = 512
+	EmailEventsMaxSize               = 5 * 1024 * 1024
+	EmailEventsSecretMinLength       = 16
+)
+
+// UndeliverableEmail is an email address no email is sent to anymore, as reported by the email
+// provider through a bounce or a complaint.
+type UndeliverableEmail struct {
+	Email    string `json:"email"`
+	Reason   string `json:"reason"`
+	Detail   string `json:"detail,omitempty"`
+	CreateAt int64  `json:"create_at"`
+	UpdateAt int64  `json:"update_at"`
+}
+
+// UserEmailStatus is the delivery state of the email address of a user.
+type UserEmailStatus struct {
+	UserId        string `json:"user_id"`
+	Undeliverable bool   `json:"undeliverable"`
+	Reason        string `json:"reason,omitempty"`
+	Detail        string `json:"detail,omitempty"`
+	UpdateAt      int64  `json:"update_at,omitempty"`
+}
+
+func NormalizeUndeliverableEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+func (e *UndeliverableEmail) PreSave() {
+	e.Email = NormalizeUndeliverableEmail(e.Email)
+	if e.CreateAt == 0 {
+		e.CreateAt = GetMillis()
+	}
+	e.UpdateAt = e.CreateAt
+	if utf8.RuneCountInString(e.Detail) > UndeliverableEmailDetailMaxRunes {
+		e.Detail = string([]rune(e.Detail)[:UndeliverableEmailDetailMaxRunes])
+	}
+}
+
+func (e *UndeliverableEmail) IsValid() *AppError {
+	if e.Email == "" || len(e.Email) > UserEmailMaxLength || !IsValidEmail(e.Email) {
+		return NewAppError("UndeliverableEmail.IsValid", "model.undeliverable_email.is_valid.email.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if e.Reason != UndeliverableEmailReasonBounce && e.Reason != UndeliverableEmailReasonComplaint {
+		return NewAppError("UndeliverableEmail.IsValid", "model.undeliverable_email.is_valid.reason.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if e.CreateAt == 0 || e.UpdateAt == 0 {
+		return NewAppError("UndeliverableEmail.IsValid", "model.undeliverable_email.is_valid.create_at.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUndeliverableEmailPreSave(t *testing.T) {
+	e := &UndeliverableEmail{
+		Email:  " User@Example.com ",
+		Reason: UndeliverableEmailReasonBounce,
+		Detail: strings.Repeat("é", UndeliverableEmailDetailMaxRunes+1),
+	}
+	e.PreSave()
+
+	assert.Equal(t, "user@example.com", e.Email)
+	assert.NotZero(t, e.CreateAt)
+	assert.Equal(t, e.CreateAt, e.UpdateAt)
+	assert.Equal(t, UndeliverableEmailDetailMaxRunes, utf8.RuneCountInString(e.Detail))
+}
+
+func TestUndeliverableEmailIsValid(t *testing.T) {
+	valid := func() *UndeliverableEmail {
+		e := &UndeliverableEmail{Email: "user@example.com", Reason: UndeliverableEmailReasonComplaint}
+		e.PreSave()
+		return e
+	}
+
+	require.Nil(t, valid().IsValid())
+
+	for name, modify := range map[string]func(e *UndeliverableEmail){
+		"missing email":  func(e *UndeliverableEmail) { e.Email = "" },
+		"invalid email":  func(e *UndeliverableEmail) { e.Email = "user" },
+		"long email":     func(e *UndeliverableEmail) { e.Email = strings.Repeat("a", UserEmailMaxLength) + "@example.com" },
+		"invalid reason": func(e *UndeliverableEmail) { e.Reason = "delay" },
+		"missing time":   func(e *UndeliverableEmail) { e.CreateAt = 0 },
+	} {
+		t.Run(name, func(t *testing.T) {
+			e := valid()
+			modify(e)
+			assert.NotNil(t, e.IsValid())
+		})
+	}
+}
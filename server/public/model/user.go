@@ -107,6 +107,11 @@ type User struct {
 	TermsOfServiceCreateAt int64     `json:"terms_of_service_create_at,omitempty"`
 	DisableWelcomeEmail    bool      `json:"disable_welcome_email"`
 	LastLogin              int64     `json:"last_login,omitempty"`
+	// ManagerId is the id of the user this user reports to, if any.
+	ManagerId string `json:"manager_id,omitempty"`
+	// CustomProfileAttributes are the values of the custom profile fields of the user, keyed by
+	// field id. They aren't stored with the user, and are only filled in by the API.
+	CustomProfileAttributes map[string]string `json:"custom_profile_attributes,omitempty" db:"-" msg:"-"`
 }
 
 func (u *User) Auditable() map[string]interface{} {
@@ -358,6 +363,10 @@ func (u *User) IsValid() *AppError {
 		return InvalidUserError("position", u.Id, u.Position)
 	}
 
+	if u.ManagerId != "" && (!IsValidId(u.ManagerId) || u.ManagerId == u.Id) {
+		return InvalidUserError("manager_id", u.Id, u.ManagerId)
+	}
+
 	if utf8.RuneCountInString(u.FirstName) > UserFirstNameMaxRunes {
 		return InvalidUserError("first_name", u.Id, u.FirstName)
 	}
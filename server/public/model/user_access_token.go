@@ -13,8 +13,15 @@ type UserAccessToken struct {
 	UserId      string `json:"user_id"`
 	Description string `json:"description"`
 	IsActive    bool   `json:"is_active"`
+	// ExpiresAt is the time in milliseconds after which the token can't be used, 0 if it never expires.
+	ExpiresAt int64 `json:"expires_at"`
 }
 
+const (
+	// UserAccessTokenExpiringDefaultDays is the default window used to report the tokens approaching expiry.
+	UserAccessTokenExpiringDefaultDays = 14
+)
+
 func (t *UserAccessToken) IsValid() *AppError {
 	if !IsValidId(t.Id) {
 		return NewAppError("UserAccessToken.IsValid", "model.user_access_token.is_valid.id.app_error", nil, "", http.StatusBadRequest)
@@ -32,6 +39,10 @@ func (t *UserAccessToken) IsValid() *AppError {
 		return NewAppError("UserAccessToken.IsValid", "model.user_access_token.is_valid.description.app_error", nil, "", http.StatusBadRequest)
 	}
 
+	if t.ExpiresAt < 0 {
+		return NewAppError("UserAccessToken.IsValid", "model.user_access_token.is_valid.expires_at.app_error", nil, "", http.StatusBadRequest)
+	}
+
 	return nil
 }
 
@@ -39,3 +50,8 @@ func (t *UserAccessToken) PreSave() {
 	t.Id = NewId()
 	t.IsActive = true
 }
+
+// IsExpired returns whether the token has an expiry date in the past.
+func (t *UserAccessToken) IsExpired() bool {
+	return t.ExpiresAt > 0 && t.ExpiresAt <= GetMillis()
+}
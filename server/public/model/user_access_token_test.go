@@ -30,3 +30,25 @@ func TestUserAccessTokenIsValid(t *testing.T) {
 	appErr = ad.IsValid()
 	require.False(t, appErr == nil || appErr.Id != "model.user_access_token.is_valid.description.app_error")
 }
+
+func TestUserAccessTokenExpiry(t *testing.T) {
+	token := UserAccessToken{
+		Id:     NewId(),
+		Token:  NewId(),
+		UserId: NewId(),
+	}
+	require.Nil(t, token.IsValid())
+	require.False(t, token.IsExpired())
+
+	token.ExpiresAt = -1
+	appErr := token.IsValid()
+	require.NotNil(t, appErr)
+	require.Equal(t, "model.user_access_token.is_valid.expires_at.app_error", appErr.Id)
+
+	token.ExpiresAt = GetMillis() + 60*1000
+	require.Nil(t, token.IsValid())
+	require.False(t, token.IsExpired())
+
+	token.ExpiresAt = GetMillis() - 1
+	require.True(t, token.IsExpired())
+}
@@ -32,6 +32,8 @@ type UserGetOptions struct {
 	ChannelRoles []string
 	// Filters for users matching any of the given team roles, must be used with InTeamId
 	TeamRoles []string
+	// Filters for users with the given values of custom profile fields, keyed by field id
+	CustomProfileAttributes map[string]string
 	// Sorting option
 	Sort string
 	// Restrict to search in a list of teams and channels
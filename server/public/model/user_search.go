@@ -23,6 +23,8 @@ type UserSearch struct {
 	ChannelRoles     []string `json:"channel_roles"`
 	TeamRoles        []string `json:"team_roles"`
 	NotInGroupId     string   `json:"not_in_group_id"`
+	// CustomProfileAttributes filters the users by the values of custom profile fields, keyed by field id.
+	CustomProfileAttributes map[string]string `json:"custom_profile_attributes,omitempty"`
 }
 
 // UserSearchOptions captures internal parameters derived from the user's permissions and a
@@ -52,4 +54,6 @@ type UserSearchOptions struct {
 	ViewRestrictions *ViewUsersRestrictions
 	// List of allowed channels
 	ListOfAllowedChannels []string
+	// Filters for users with the given values of custom profile fields, keyed by field id
+	CustomProfileAttributes map[string]string
 }
@@ -135,6 +135,18 @@ func TestUserIsValid(t *testing.T) {
 	require.True(t, HasExpectedUserIsValidError(appErr, "position", user.Id, user.Position), "expected user is valid error: %s", appErr.Error())
 	user.Position = ""
 
+	user.ManagerId = "junk"
+	appErr = user.IsValid()
+	require.True(t, HasExpectedUserIsValidError(appErr, "manager_id", user.Id, user.ManagerId), "expected user is valid error: %s", appErr.Error())
+
+	user.ManagerId = user.Id
+	appErr = user.IsValid()
+	require.True(t, HasExpectedUserIsValidError(appErr, "manager_id", user.Id, user.ManagerId), "expected user is valid error: %s", appErr.Error())
+
+	user.ManagerId = NewId()
+	require.Nil(t, user.IsValid())
+	user.ManagerId = ""
+
 	user.Roles = strings.Repeat("a", UserRolesMaxLength)
 	appErr = user.IsValid()
 	require.Nil(t, appErr)
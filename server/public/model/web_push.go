@@ -0,0 +1,87 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/url"
+)
+
+const (
+	// SessionPropWebPushSubscription holds the Web Push subscription of the browser of a
+	// session, so that it's removed along with the session.
+	SessionPropWebPushSubscription = "web_push_subscription"
+
+	WebPushSubscriptionEndpointMaxLength = 1024
+)
+
+// WebPushSubscription is the subscription of a browser to its push service, as returned by
+// PushSubscription.toJSON.
+type WebPushSubscription struct {
+	Endpoint string                  `json:"endpoint"`
+	Keys     WebPushSubscriptionKeys `json:"keys"`
+}
+
+type WebPushSubscriptionKeys struct {
+	P256dh string `json:"p256dh"`
+	Auth   string `json:"auth"`
+}
+
+// WebPushVAPIDKey is the public key browsers need to subscribe to the notifications of the server.
+type WebPushVAPIDKey struct {
+	PublicKey string `json:"public_key"`
+}
+
+// WebPushNotification is the payload the service worker of the web app receives and shows
+// as a notification.
+type WebPushNotification struct {
+	Title     string `json:"title"`
+	Body      string `json:"body"`
+	PostId    string `json:"post_id"`
+	ChannelId string `json:"channel_id"`
+	RootId    string `json:"root_id,omitempty"`
+	TeamId    string `json:"team_id,omitempty"`
+	SenderId  string `json:"sender_id,omitempty"`
+}
+
+func (s *WebPushSubscription) IsValid() *AppError {
+	if len(s.Endpoint) > WebPushSubscriptionEndpointMaxLength {
+		return NewAppError("WebPushSubscription.IsValid", "model.web_push_subscription.is_valid.endpoint.app_error", nil, "", http.StatusBadRequest)
+	}
+	if endpoint, err := url.Parse(s.Endpoint); err != nil || endpoint.Scheme != "https" || endpoint.Host == "" {
+		return NewAppError("WebPushSubscription.IsValid", "model.web_push_subscription.is_valid.endpoint.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	// The public key is an uncompressed P-256 point and the auth secret 16 bytes.
+	if key, err := decodeWebPushKey(s.Keys.P256dh); err != nil || len(key) != 65 || key[0] != 0x04 {
+		return NewAppError("WebPushSubscription.IsValid", "model.web_push_subscription.is_valid.p256dh.app_error", nil, "", http.StatusBadRequest)
+	}
+	if auth, err := decodeWebPushKey(s.Keys.Auth); err != nil || len(auth) != 16 {
+		return NewAppError("WebPushSubscription.IsValid", "model.web_push_subscription.is_valid.auth.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+// WebPushSubscriptionFromSession returns the subscription attached to the session, if any.
+func WebPushSubscriptionFromSession(session *Session) *WebPushSubscription {
+	value, ok := session.Props[SessionPropWebPushSubscription]
+	if !ok || value == "" {
+		return nil
+	}
+	var subscription WebPushSubscription
+	if err := json.Unmarshal([]byte(value), &subscription); err != nil {
+		return nil
+	}
+	return &subscription
+}
+
+func decodeWebPushKey(key string) ([]byte, error) {
+	if decoded, err := base64.RawURLEncoding.DecodeString(key); err == nil {
+		return decoded, nil
+	}
+	return base64.URLEncoding.DecodeString(key)
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebPushSubscriptionIsValid(t *testing.T) {
+	p256dh := base64.RawURLEncoding.EncodeToString(append([]byte{0x04}, make([]byte, 64)...))
+	auth := base64.RawURLEncoding.EncodeToString(make([]byte, 16))
+
+	valid := func() *WebPushSubscription {
+		return &WebPushSubscription{
+			Endpoint: "https://fcm.googleapis.com/fcm/send/abc",
+			Keys:     WebPushSubscriptionKeys{P256dh: p256dh, Auth: auth},
+		}
+	}
+
+	require.Nil(t, valid().IsValid())
+
+	s := valid()
+	s.Keys.P256dh = base64.URLEncoding.EncodeToString(append([]byte{0x04}, make([]byte, 64)...))
+	s.Keys.Auth = base64.URLEncoding.EncodeToString(make([]byte, 16))
+	assert.Nil(t, s.IsValid(), "padded keys should be accepted")
+
+	for name, modify := range map[string]func(s *WebPushSubscription){
+		"http endpoint":     func(s *WebPushSubscription) { s.Endpoint = "http://push.example.com/abc" },
+		"relative endpoint": func(s *WebPushSubscription) { s.Endpoint = "/abc" },
+		"endpoint too long": func(s *WebPushSubscription) { s.Endpoint = "https://push.example.com/" + strings.Repeat("a", 1024) },
+		"missing p256dh":    func(s *WebPushSubscription) { s.Keys.P256dh = "" },
+		"compressed p256dh": func(s *WebPushSubscription) {
+			s.Keys.P256dh = base64.RawURLEncoding.EncodeToString(append([]byte{0x02}, make([]byte, 32)...))
+		},
+		"invalid p256dh":       func(s *WebPushSubscription) { s.Keys.P256dh = "not base64!" },
+		"missing auth":         func(s *WebPushSubscription) { s.Keys.Auth = "" },
+		"auth of invalid size": func(s *WebPushSubscription) { s.Keys.Auth = base64.RawURLEncoding.EncodeToString(make([]byte, 8)) },
+	} {
+		t.Run(name, func(t *testing.T) {
+			s := valid()
+			modify(s)
+			assert.NotNil(t, s.IsValid())
+		})
+	}
+}
+
+func TestWebPushSubscriptionFromSession(t *testing.T) {
+	assert.Nil(t, WebPushSubscriptionFromSession(&Session{}))
+	assert.Nil(t, WebPushSubscriptionFromSession(&Session{Props: StringMap{SessionPropWebPushSubscription: "invalid"}}))
+
+	subscription := WebPushSubscriptionFromSession(&Session{Props: StringMap{
+		SessionPropWebPushSubscription: `{"endpoint":"https://push.example.com/abc","keys":{"p256dh":"key","auth":"secret"}}`,
+	}})
+	require.NotNil(t, subscription)
+	assert.Equal(t, &WebPushSubscription{
+		Endpoint: "https://push.example.com/abc",
+		Keys:     WebPushSubscriptionKeys{P256dh: "key", Auth: "secret"},
+	}, subscription)
+}
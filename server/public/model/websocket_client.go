@@ -326,6 +326,15 @@ func (wsc *WebSocketClient) GetStatusesByIds(userIds []string) {
 	wsc.SendMessage("get_statuses_by_ids", data)
 }
 
+// SubscribePresence replaces the users whose status changes are sent to the connection, e.g. the
+// users visible to the client. An empty list unsubscribes from all of them.
+func (wsc *WebSocketClient) SubscribePresence(userIDs []string) {
+	data := map[string]any{
+		"user_ids": userIDs,
+	}
+	wsc.SendMessage(string(WebsocketPresenceSubscribe), data)
+}
+
 // UpdateActiveChannel sets the current channel that the user is viewing.
 func (wsc *WebSocketClient) UpdateActiveChannel(channelID string) {
 	data := map[string]any{
@@ -14,6 +14,7 @@ type WebsocketEventType string
 
 const (
 	WebsocketEventTyping                              WebsocketEventType = "typing"
+	WebsocketEventUsersTyping                         WebsocketEventType = "users_typing"
 	WebsocketEventPosted                              WebsocketEventType = "posted"
 	WebsocketEventPostEdited                          WebsocketEventType = "post_edited"
 	WebsocketEventPostDeleted                         WebsocketEventType = "post_deleted"
@@ -35,6 +36,7 @@ const (
 	WebsocketEventRestoreTeam                         WebsocketEventType = "restore_team"
 	WebsocketEventUpdateTeamScheme                    WebsocketEventType = "update_team_scheme"
 	WebsocketEventUserAdded                           WebsocketEventType = "user_added"
+	WebsocketEventChannelMembersAdded                 WebsocketEventType = "channel_members_added"
 	WebsocketEventUserUpdated                         WebsocketEventType = "user_updated"
 	WebsocketEventUserRoleUpdated                     WebsocketEventType = "user_role_updated"
 	WebsocketEventMemberroleUpdated                   WebsocketEventType = "memberrole_updated"
@@ -91,7 +93,11 @@ const (
 	WebsocketEventChannelBookmarkUpdated              WebsocketEventType = "channel_bookmark_updated"
 	WebsocketEventChannelBookmarkDeleted              WebsocketEventType = "channel_bookmark_deleted"
 	WebsocketEventChannelBookmarkSorted               WebsocketEventType = "channel_bookmark_sorted"
+	WebsocketEventMaintenanceModeChanged              WebsocketEventType = "maintenance_mode_changed"
+	WebsocketEventServerDraining                      WebsocketEventType = "server_draining"
+	WebsocketEventPresenceChanged                     WebsocketEventType = "presence_changed"
 	WebsocketPresenceIndicator                        WebsocketEventType = "presence"
+	WebsocketPresenceSubscribe                        WebsocketEventType = "presence_subscribe"
 	WebsocketPostedNotifyAck                          WebsocketEventType = "posted_notify_ack"
 )
 
@@ -108,6 +114,7 @@ type WebsocketBroadcast struct {
 	TeamId                string          `json:"team_id"`                           // broadcast only occurs for users in this team
 	ConnectionId          string          `json:"connection_id"`                     // broadcast only occurs for this connection
 	OmitConnectionId      string          `json:"omit_connection_id"`                // broadcast is omitted for this connection
+	PresenceUserId        string          `json:"presence_user_id,omitempty"`        // broadcast only occurs for connections subscribed to the presence of this user
 	ContainsSanitizedData bool            `json:"contains_sanitized_data,omitempty"` // broadcast only occurs for non-sysadmins
 	ContainsSensitiveData bool            `json:"contains_sensitive_data,omitempty"` // broadcast only occurs for sysadmins
 	// ReliableClusterSend indicates whether or not the message should
@@ -142,6 +149,7 @@ func (wb *WebsocketBroadcast) copy() *WebsocketBroadcast {
 	c.ChannelId = wb.ChannelId
 	c.TeamId = wb.TeamId
 	c.OmitConnectionId = wb.OmitConnectionId
+	c.PresenceUserId = wb.PresenceUserId
 	c.ContainsSanitizedData = wb.ContainsSanitizedData
 	c.ContainsSensitiveData = wb.ContainsSensitiveData
 	c.BroadcastHooks = wb.BroadcastHooks
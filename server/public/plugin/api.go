@@ -284,6 +284,13 @@ type API interface {
 	// Minimum server version: 5.35
 	SetUserStatusTimedDND(userId string, endtime int64) (*model.Status, *model.AppError)
 
+	// SetUserTimedStatuses sets the statuses of users to dnd or ooo until their end time, after
+	// which the server restores the previous status of each user. Meant for calendar integrations.
+	//
+	// @tag User
+	// Minimum server version: 9.9
+	SetUserTimedStatuses(statuses []*model.TimedStatus) *model.AppError
+
 	// UpdateUserActive deactivates or reactivates an user.
 	//
 	// @tag User
@@ -328,6 +328,13 @@ func (api *apiTimerLayer) SetUserStatusTimedDND(userId string, endtime int64) (*
 	return _returnsA, _returnsB
 }
 
+func (api *apiTimerLayer) SetUserTimedStatuses(statuses []*model.TimedStatus) *model.AppError {
+	startTime := timePkg.Now()
+	_returnsA := api.apiImpl.SetUserTimedStatuses(statuses)
+	api.recordTime(startTime, "SetUserTimedStatuses", _returnsA == nil)
+	return _returnsA
+}
+
 func (api *apiTimerLayer) UpdateUserActive(userID string, active bool) *model.AppError {
 	startTime := timePkg.Now()
 	_returnsA := api.apiImpl.UpdateUserActive(userID, active)
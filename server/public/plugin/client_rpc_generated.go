@@ -2364,6 +2364,34 @@ func (s *apiRPCServer) SetUserStatusTimedDND(args *Z_SetUserStatusTimedDNDArgs,
 	return nil
 }
 
+type Z_SetUserTimedStatusesArgs struct {
+	A []*model.TimedStatus
+}
+
+type Z_SetUserTimedStatusesReturns struct {
+	A *model.AppError
+}
+
+func (g *apiRPCClient) SetUserTimedStatuses(statuses []*model.TimedStatus) *model.AppError {
+	_args := &Z_SetUserTimedStatusesArgs{statuses}
+	_returns := &Z_SetUserTimedStatusesReturns{}
+	if err := g.client.Call("Plugin.SetUserTimedStatuses", _args, _returns); err != nil {
+		log.Printf("RPC call to SetUserTimedStatuses API failed: %s", err.Error())
+	}
+	return _returns.A
+}
+
+func (s *apiRPCServer) SetUserTimedStatuses(args *Z_SetUserTimedStatusesArgs, returns *Z_SetUserTimedStatusesReturns) error {
+	if hook, ok := s.impl.(interface {
+		SetUserTimedStatuses(statuses []*model.TimedStatus) *model.AppError
+	}); ok {
+		returns.A = hook.SetUserTimedStatuses(args.A)
+	} else {
+		return encodableError(fmt.Errorf("API SetUserTimedStatuses called but not implemented."))
+	}
+	return nil
+}
+
 type Z_UpdateUserActiveArgs struct {
 	A string
 	B bool
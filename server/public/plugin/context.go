@@ -12,4 +12,7 @@ type Context struct {
 	IPAddress      string
 	AcceptLanguage string
 	UserAgent      string
+	// TraceContext holds the trace context of the request when tracing is enabled, e.g. the W3C
+	// traceparent header, so that the plugins can continue the trace.
+	TraceContext map[string]string
 }
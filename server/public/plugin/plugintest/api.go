@@ -4479,6 +4479,26 @@ func (_m *API) SetUserStatusTimedDND(userId string, endtime int64) (*model.Statu
 	return r0, r1
 }
 
+// SetUserTimedStatuses provides a mock function with given fields: statuses
+func (_m *API) SetUserTimedStatuses(statuses []*model.TimedStatus) *model.AppError {
+	ret := _m.Called(statuses)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetUserTimedStatuses")
+	}
+
+	var r0 *model.AppError
+	if rf, ok := ret.Get(0).(func([]*model.TimedStatus) *model.AppError); ok {
+		r0 = rf(statuses)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.AppError)
+		}
+	}
+
+	return r0
+}
+
 // ShareChannel provides a mock function with given fields: sc
 func (_m *API) ShareChannel(sc *model.SharedChannel) (*model.SharedChannel, error) {
 	ret := _m.Called(sc)
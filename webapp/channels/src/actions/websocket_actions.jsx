@@ -380,6 +380,10 @@ export function handleEvent(msg) {
         dispatch(handleUserAddedEvent(msg));
         break;
 
+    case SocketEvents.CHANNEL_MEMBERS_ADDED:
+        dispatch(handleChannelMembersAddedEvent(msg));
+        break;
+
     case SocketEvents.USER_REMOVED:
         handleUserRemovedEvent(msg);
         break;
@@ -453,6 +457,7 @@ export function handleEvent(msg) {
         break;
 
     case SocketEvents.STATUS_CHANGED:
+    case SocketEvents.PRESENCE_CHANGED:
         handleStatusChangedEvent(msg);
         break;
 
@@ -492,6 +497,10 @@ export function handleEvent(msg) {
         handleConfigChanged(msg);
         break;
 
+    case SocketEvents.MAINTENANCE_MODE_CHANGED:
+        handleMaintenanceModeChanged(msg);
+        break;
+
     case SocketEvents.LICENSE_CHANGED:
         handleLicenseChanged(msg);
         break;
@@ -1020,6 +1029,23 @@ function handleUserAddedEvent(msg) {
     };
 }
 
+// Sent instead of one user_added event per user when many members are added to a channel at once.
+function handleChannelMembersAddedEvent(msg) {
+    return (doDispatch, doGetState) => {
+        const currentChannelId = getCurrentChannelId(doGetState());
+        if (currentChannelId !== msg.broadcast.channel_id) {
+            return;
+        }
+
+        doDispatch(getChannelStats(currentChannelId));
+        doDispatch({
+            type: UserTypes.RECEIVED_PROFILES_LIST_IN_CHANNEL,
+            id: currentChannelId,
+            data: msg.data.user_ids.map((userId) => ({id: userId})),
+        });
+    };
+}
+
 function fetchChannelAndAddToSidebar(channelId) {
     return async (doDispatch) => {
         const {data, error} = await doDispatch(getChannelAndMyMember(channelId));
@@ -1359,6 +1385,16 @@ function handleConfigChanged(msg) {
     store.dispatch({type: GeneralTypes.CLIENT_CONFIG_RECEIVED, data: msg.data.config});
 }
 
+function handleMaintenanceModeChanged(msg) {
+    store.dispatch({
+        type: GeneralTypes.CLIENT_CONFIG_RECEIVED,
+        data: {
+            EnableMaintenanceMode: String(msg.data.enabled),
+            MaintenanceModeMessage: msg.data.message,
+        },
+    });
+}
+
 function handleLicenseChanged(msg) {
     store.dispatch({type: GeneralTypes.CLIENT_LICENSE_RECEIVED, data: msg.data.license});
 }
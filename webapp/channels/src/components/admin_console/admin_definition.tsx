@@ -6153,6 +6153,18 @@ const AdminDefinition: AdminDefinitionType = {
                                 it.stateIsFalse('ServiceSettings.EnableUserTypingMessages'),
                             ),
                         },
+                        {
+                            type: 'number',
+                            key: 'ServiceSettings.TypingAggregationWindowMilliseconds',
+                            label: defineMessage({id: 'admin.experimental.typingAggregationWindowMilliseconds.title', defaultMessage: 'User Typing Aggregation Window:'}),
+                            help_text: defineMessage({id: 'admin.experimental.typingAggregationWindowMilliseconds.desc', defaultMessage: 'The number of milliseconds during which the users typing in a channel are collected into a single websocket event. Set to 0 to send an event for every typing user. Clients older than this server only display typing events when this is 0.'}),
+                            help_text_markdown: false,
+                            placeholder: defineMessage({id: 'admin.experimental.typingAggregationWindowMilliseconds.example', defaultMessage: 'E.g.: "1000"'}),
+                            isDisabled: it.any(
+                                it.not(it.userHasWritePermissionOnResource(RESOURCE_KEYS.EXPERIMENTAL.FEATURES)),
+                                it.stateIsFalse('ServiceSettings.EnableUserTypingMessages'),
+                            ),
+                        },
                         {
                             type: 'number',
                             key: 'ExperimentalSettings.UsersStatusAndProfileFetchingPollIntervalMilliseconds',
@@ -2,12 +2,15 @@
 // See LICENSE.txt for license information.
 
 import React from 'react';
+import {FormattedMessage} from 'react-intl';
 
 import type {ClientLicense, ClientConfig, WarnMetricStatus} from '@mattermost/types/config';
 
 import {ToPaidPlanBannerDismissable} from 'components/admin_console/billing/billing_subscriptions/to_paid_plan_nudge_banner';
 import withGetCloudSubscription from 'components/common/hocs/cloud/with_get_cloud_subscription';
 
+import {AnnouncementBarTypes} from 'utils/constants';
+
 import CloudTrialAnnouncementBar from './cloud_trial_announcement_bar';
 import CloudTrialEndAnnouncementBar from './cloud_trial_ended_announcement_bar';
 import ConfigurationAnnouncementBar from './configuration_bar';
@@ -52,6 +55,22 @@ class AnnouncementBarController extends React.PureComponent<Props> {
             );
         }
 
+        let maintenanceModeBar = null;
+        if (this.props.config?.EnableMaintenanceMode === 'true') {
+            maintenanceModeBar = (
+                <AnnouncementBar
+                    type={AnnouncementBarTypes.CRITICAL}
+                    message={this.props.config.MaintenanceModeMessage?.trim() || (
+                        <FormattedMessage
+                            id='announcement_bar.maintenance_mode'
+                            defaultMessage='The server is in maintenance mode. Changes cannot be saved until it ends.'
+                        />
+                    )}
+                    showCloseButton={false}
+                />
+            );
+        }
+
         let errorBar = null;
         if (this.props.latestError) {
             errorBar = (
@@ -105,6 +124,7 @@ class AnnouncementBarController extends React.PureComponent<Props> {
             <>
                 <NotificationPermissionBar/>
                 {adminConfiguredAnnouncementBar}
+                {maintenanceModeBar}
                 {errorBar}
                 <UsersLimitsAnnouncementBar
                     license={this.props.license}
@@ -48,6 +48,14 @@ describe('handleUserTypingEvent', () => {
         });
     });
 
+    test('should not dispatch a TYPING event for the current user', () => {
+        const store = configureStore(initialState);
+
+        store.dispatch(userStartedTyping('user', channelId, rootId, Date.now()));
+
+        expect(store.getActions().find((action) => action.type === WebsocketEvents.TYPING)).toBeUndefined();
+    });
+
     test('should possibly load missing users and not get again the state', () => {
         const store = configureStore(initialState);
 
@@ -21,6 +21,11 @@ export function userStartedTyping(userId: string, channelId: string, rootId: str
     return (dispatch, getState) => {
         const state = getState();
 
+        // Aggregated typing events also list the current user when others typed in the same window
+        if (userId === getCurrentUserId(state)) {
+            return;
+        }
+
         if (
             isPerformanceDebuggingEnabled(state) &&
             getBool(state, Preferences.CATEGORY_PERFORMANCE_DEBUGGING, Preferences.NAME_DISABLE_TYPING_MESSAGES)
@@ -29,6 +29,16 @@ export default function MsgTyping(props: Props) {
                 if (props.channelId === channelId && props.postId === rootId) {
                     userStartedTyping(userId, channelId, rootId, Date.now());
                 }
+            } else if (msg.event === SocketEvents.USERS_TYPING) {
+                const channelId = msg.broadcast.channel_id;
+                const rootId = msg.data.parent_id;
+
+                if (props.channelId === channelId && props.postId === rootId) {
+                    const now = Date.now();
+                    for (const userId of msg.data.user_ids) {
+                        userStartedTyping(userId, channelId, rootId, now);
+                    }
+                }
             } else if (msg.event === SocketEvents.POSTED) {
                 const post = JSON.parse(msg.data.post);
 
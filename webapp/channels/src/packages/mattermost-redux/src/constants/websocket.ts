@@ -19,6 +19,7 @@ const WebsocketEvents = {
     LEAVE_TEAM: 'leave_team',
     UPDATE_TEAM: 'update_team',
     USER_ADDED: 'user_added',
+    CHANNEL_MEMBERS_ADDED: 'channel_members_added',
     USER_REMOVED: 'user_removed',
     USER_UPDATED: 'user_updated',
     USER_ROLE_UPDATED: 'user_role_updated',
@@ -26,12 +27,14 @@ const WebsocketEvents = {
     ROLE_REMOVED: 'role_removed',
     ROLE_UPDATED: 'role_updated',
     TYPING: 'typing',
+    USERS_TYPING: 'users_typing',
     STOP_TYPING: 'stop_typing',
     PREFERENCE_CHANGED: 'preference_changed',
     PREFERENCES_CHANGED: 'preferences_changed',
     PREFERENCES_DELETED: 'preferences_deleted',
     EPHEMERAL_MESSAGE: 'ephemeral_message',
     STATUS_CHANGED: 'status_change',
+    PRESENCE_CHANGED: 'presence_changed',
     HELLO: 'hello',
     WEBRTC: 'webrtc',
     REACTION_ADDED: 'reaction_added',
@@ -580,7 +580,16 @@ export const shouldShowTermsOfService: (state: GlobalState) => boolean = createS
         const featureEnabled = license.IsLicensed === 'true' && config.EnableCustomTermsOfService === 'true';
         const reacceptanceTime = parseInt(config.CustomTermsOfServiceReAcceptancePeriod!, 10) * 1000 * 60 * 60 * 24;
         const timeElapsed = new Date().getTime() - acceptedAt;
-        return Boolean(user && featureEnabled && (config.CustomTermsOfServiceId !== acceptedTermsId || timeElapsed > reacceptanceTime));
+
+        // Users outside of the audience of the latest terms only need to have accepted any version
+        let outdatedTerms = config.CustomTermsOfServiceId !== acceptedTermsId;
+        if (user && acceptedTermsId && config.CustomTermsOfServiceAudience) {
+            const isGuest = user.roles.split(' ').includes(General.SYSTEM_GUEST_ROLE);
+            const includesUser = config.CustomTermsOfServiceAudience === 'guests' ? isGuest : !isGuest;
+            outdatedTerms = outdatedTerms && includesUser;
+        }
+
+        return Boolean(user && featureEnabled && (outdatedTerms || timeElapsed > reacceptanceTime));
     },
 );
 
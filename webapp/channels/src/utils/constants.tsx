@@ -597,6 +597,7 @@ export const SocketEvents = {
     DELETE_TEAM: 'delete_team',
     UPDATE_TEAM_SCHEME: 'update_team_scheme',
     USER_ADDED: 'user_added',
+    CHANNEL_MEMBERS_ADDED: 'channel_members_added',
     USER_REMOVED: 'user_removed',
     USER_UPDATED: 'user_updated',
     USER_ROLE_UPDATED: 'user_role_updated',
@@ -605,11 +606,13 @@ export const SocketEvents = {
     ROLE_REMOVED: 'role_removed',
     ROLE_UPDATED: 'role_updated',
     TYPING: 'typing',
+    USERS_TYPING: 'users_typing',
     PREFERENCE_CHANGED: 'preference_changed',
     PREFERENCES_CHANGED: 'preferences_changed',
     PREFERENCES_DELETED: 'preferences_deleted',
     EPHEMERAL_MESSAGE: 'ephemeral_message',
     STATUS_CHANGED: 'status_change',
+    PRESENCE_CHANGED: 'presence_changed',
     HELLO: 'hello',
     REACTION_ADDED: 'reaction_added',
     REACTION_REMOVED: 'reaction_removed',
@@ -618,6 +621,8 @@ export const SocketEvents = {
     PLUGIN_DISABLED: 'plugin_disabled',
     LICENSE_CHANGED: 'license_changed',
     CONFIG_CHANGED: 'config_changed',
+    MAINTENANCE_MODE_CHANGED: 'maintenance_mode_changed',
+    SERVER_DRAINING: 'server_draining',
     PLUGIN_STATUSES_CHANGED: 'plugin_statuses_changed',
     OPEN_DIALOG: 'open_dialog',
     RECEIVED_GROUP: 'received_group',
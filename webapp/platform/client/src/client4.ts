@@ -5,12 +5,12 @@
 
 import FormData from 'form-data';
 
-import type {ClusterInfo, AnalyticsRow, SchemaMigration, LogFilterQuery} from '@mattermost/types/admin';
+import type {ClusterInfo, AnalyticsRow, SchemaMigration, LogFilterQuery, LogLevelOverride, LogQueryParams, SlowOperations, DataSeedRequest, DataSeedStatus, Profile, ProfileRequest} from '@mattermost/types/admin';
 import type {AppBinding, AppCallRequest, AppCallResponse} from '@mattermost/types/apps';
 import type {Audit} from '@mattermost/types/audits';
 import type {UserAutocomplete, AutocompleteSuggestion} from '@mattermost/types/autocomplete';
 import type {Bot, BotPatch} from '@mattermost/types/bots';
-import type {ChannelCategory, OrderedChannelCategories} from '@mattermost/types/channel_categories';
+import type {ChannelCategory, ChannelCategoryUnread, OrderedChannelCategories} from '@mattermost/types/channel_categories';
 import type {
     Channel,
     ChannelMemberCountsByGroup,
@@ -25,7 +25,7 @@ import type {
     ChannelSearchOpts,
     ServerChannel,
 } from '@mattermost/types/channels';
-import type {Options, StatusOK, ClientResponse, FetchPaginatedThreadOptions} from '@mattermost/types/client4';
+import type {Options, StatusOK, ClientResponse, FetchPaginatedThreadOptions, BatchRequest, BatchResponse} from '@mattermost/types/client4';
 import {LogLevel} from '@mattermost/types/client4';
 import type {
     Address,
@@ -52,6 +52,11 @@ import type {
     AllowedIPRanges,
     AllowedIPRange,
     FetchIPResponse,
+    FeatureFlagRule,
+    FeatureFlagStatus,
+    MaintenanceMode,
+    DrainStatus,
+    SystemHealth,
 } from '@mattermost/types/config';
 import type {
     DataRetentionCustomPolicies,
@@ -1960,6 +1965,13 @@ export default class Client4 {
         );
     };
 
+    getChannelCategoryUnreads = (userId: string) => {
+        return this.doFetch<ChannelCategoryUnread[]>(
+            `${this.getUserRoute(userId)}/channels/categories/unreads`,
+            {method: 'get'},
+        );
+    };
+
     createChannelCategory = (userId: string, teamId: string, category: Partial<ChannelCategory>) => {
         return this.doFetch<ChannelCategory>(
             `${this.getChannelCategoriesRoute(userId, teamId)}`,
@@ -2450,6 +2462,13 @@ export default class Client4 {
         );
     };
 
+    getSystemHealth = () => {
+        return this.doFetch<SystemHealth>(
+            `${this.getBaseRoute()}/system/ping${buildQueryString({deep: true, use_rest_semantics: true})}`,
+            {method: 'get'},
+        );
+    };
+
     upgradeToEnterprise = async () => {
         return this.doFetch<StatusOK>(
             `${this.getBaseRoute()}/upgrade_to_enterprise`,
@@ -3072,6 +3091,13 @@ export default class Client4 {
         );
     };
 
+    queryLogs = (params: LogQueryParams, page = 0, perPage = LOGS_PER_PAGE_DEFAULT) => {
+        return this.doFetch<Record<string, Array<Record<string, unknown>>>>(
+            `${this.getBaseRoute()}/logs/query${buildQueryString({...params, page, logs_per_page: perPage})}`,
+            {method: 'get'},
+        );
+    };
+
     getPlainLogs = (page = 0, perPage = LOGS_PER_PAGE_DEFAULT) => {
         return this.doFetch<string[]>(
             `${this.getBaseRoute()}/logs${buildQueryString({page, logs_per_page: perPage})}`,
@@ -3079,6 +3105,27 @@ export default class Client4 {
         );
     };
 
+    getLogLevelOverrides = () => {
+        return this.doFetch<LogLevelOverride[]>(
+            `${this.getBaseRoute()}/logs/level`,
+            {method: 'get'},
+        );
+    };
+
+    setLogLevelOverride = (override: LogLevelOverride) => {
+        return this.doFetch<LogLevelOverride>(
+            `${this.getBaseRoute()}/logs/level`,
+            {method: 'put', body: JSON.stringify(override)},
+        );
+    };
+
+    removeLogLevelOverride = (module: LogLevelOverride['module']) => {
+        return this.doFetch<StatusOK>(
+            `${this.getBaseRoute()}/logs/level${buildQueryString({module})}`,
+            {method: 'delete'},
+        );
+    };
+
     getAudits = (page = 0, perPage = PER_PAGE_DEFAULT) => {
         return this.doFetch<Audit[]>(
             `${this.getBaseRoute()}/audits${buildQueryString({page, per_page: perPage})}`,
@@ -3100,6 +3147,108 @@ export default class Client4 {
         );
     };
 
+    validateConfig = (config: AdminConfig, checkConnections = false) => {
+        return this.doFetch<{valid: boolean; errors: Array<{field: string; error: {id: string; message: string; detailed_error: string; status_code: number}}>}>(
+            `${this.getBaseRoute()}/config/validate${buildQueryString({check_connections: checkConnections})}`,
+            {method: 'put', body: JSON.stringify(config)},
+        );
+    };
+
+    drainServer = (timeout = 300) => {
+        return this.doFetch<DrainStatus>(
+            `${this.getSystemRoute()}/drain${buildQueryString({timeout})}`,
+            {method: 'post'},
+        );
+    };
+
+    getDrainStatus = () => {
+        return this.doFetch<DrainStatus>(
+            `${this.getSystemRoute()}/drain`,
+            {method: 'get'},
+        );
+    };
+
+    captureProfile = (profileRequest: ProfileRequest) => {
+        return this.doFetch<Profile>(
+            `${this.getSystemRoute()}/profiles`,
+            {method: 'post', body: JSON.stringify(profileRequest)},
+        );
+    };
+
+    getProfiles = () => {
+        return this.doFetch<Profile[]>(
+            `${this.getSystemRoute()}/profiles`,
+            {method: 'get'},
+        );
+    };
+
+    getProfileDownloadUrl = (name: string) => {
+        return `${this.getSystemRoute()}/profiles/${encodeURIComponent(name)}`;
+    };
+
+    deleteProfile = (name: string) => {
+        return this.doFetch<StatusOK>(
+            `${this.getSystemRoute()}/profiles/${encodeURIComponent(name)}`,
+            {method: 'delete'},
+        );
+    };
+
+    getSlowOperations = () => {
+        return this.doFetch<SlowOperations>(
+            `${this.getSystemRoute()}/slow_operations`,
+            {method: 'get'},
+        );
+    };
+
+    startDataSeed = (seedRequest: DataSeedRequest) => {
+        return this.doFetch<DataSeedStatus>(
+            `${this.getSystemRoute()}/data_seed`,
+            {method: 'post', body: JSON.stringify(seedRequest)},
+        );
+    };
+
+    getDataSeedStatus = () => {
+        return this.doFetch<DataSeedStatus>(
+            `${this.getSystemRoute()}/data_seed`,
+            {method: 'get'},
+        );
+    };
+
+    cancelDataSeed = () => {
+        return this.doFetch<StatusOK>(
+            `${this.getSystemRoute()}/data_seed`,
+            {method: 'delete'},
+        );
+    };
+
+    executeBatch = (batch: BatchRequest) => {
+        return this.doFetch<BatchResponse>(
+            `${this.getBaseRoute()}/batch`,
+            {method: 'post', body: JSON.stringify(batch)},
+        );
+    };
+
+    getMaintenanceMode = () => {
+        return this.doFetch<MaintenanceMode>(
+            `${this.getBaseRoute()}/maintenance_mode`,
+            {method: 'get'},
+        );
+    };
+
+    enableMaintenanceMode = (message = '', retryAfterSeconds = 0) => {
+        return this.doFetch<MaintenanceMode>(
+            `${this.getBaseRoute()}/maintenance_mode`,
+            {method: 'post', body: JSON.stringify({message, retry_after_seconds: retryAfterSeconds})},
+        );
+    };
+
+    disableMaintenanceMode = () => {
+        return this.doFetch<MaintenanceMode>(
+            `${this.getBaseRoute()}/maintenance_mode`,
+            {method: 'delete'},
+        );
+    };
+
     patchConfig = (patch: DeepPartial<AdminConfig>) => {
         return this.doFetch<AdminConfig>(
             `${this.getBaseRoute()}/config/patch`,
@@ -3114,6 +3263,41 @@ export default class Client4 {
         );
     };
 
+    getConfigHistory = (page = 0, perPage = PER_PAGE_DEFAULT) => {
+        return this.doFetch<Array<{id: string; version: number; user_id: string; create_at: number; diff: string}>>(
+            `${this.getBaseRoute()}/config/history${buildQueryString({page, per_page: perPage})}`,
+            {method: 'get'},
+        );
+    };
+
+    rollbackConfig = (version: number) => {
+        return this.doFetch<AdminConfig>(
+            `${this.getBaseRoute()}/config/rollback/${version}`,
+            {method: 'post'},
+        );
+    };
+
+    getFeatureFlags = () => {
+        return this.doFetch<FeatureFlagStatus[]>(
+            `${this.getBaseRoute()}/feature_flags`,
+            {method: 'get'},
+        );
+    };
+
+    updateFeatureFlagRule = (name: string, rule: FeatureFlagRule) => {
+        return this.doFetch<FeatureFlagStatus>(
+            `${this.getBaseRoute()}/feature_flags/${name}/rule`,
+            {method: 'put', body: JSON.stringify(rule)},
+        );
+    };
+
+    deleteFeatureFlagRule = (name: string) => {
+        return this.doFetch<StatusOK>(
+            `${this.getBaseRoute()}/feature_flags/${name}/rule`,
+            {method: 'delete'},
+        );
+    };
+
     getEnvironmentConfig = () => {
         return this.doFetch<EnvironmentConfig>(
             `${this.getBaseRoute()}/config/environment`,
@@ -3447,6 +3631,20 @@ export default class Client4 {
         );
     };
 
+    createRole = (role: Partial<Role>) => {
+        return this.doFetch<Role>(
+            `${this.getRolesRoute()}`,
+            {method: 'post', body: JSON.stringify(role)},
+        );
+    };
+
+    deleteRole = (roleId: string) => {
+        return this.doFetch<Role>(
+            `${this.getRolesRoute()}/${roleId}`,
+            {method: 'delete'},
+        );
+    };
+
     // Scheme Routes
 
     getSchemes = (scope = '', page = 0, perPage = PER_PAGE_DEFAULT) => {
@@ -410,6 +410,15 @@ export default class WebSocketClient {
         this.sendMessage('presence', data, callback);
     }
 
+    // subscribePresence replaces the users whose status changes are sent to this connection. The
+    // response contains their current statuses.
+    subscribePresence(userIds: string[], callback?: (msg: any) => void) {
+        const data = {
+            user_ids: userIds,
+        };
+        this.sendMessage('presence_subscribe', data, callback);
+    }
+
     userUpdateActiveStatus(userIsActive: boolean, manual: boolean, callback?: () => void) {
         const data = {
             user_is_active: userIsActive,
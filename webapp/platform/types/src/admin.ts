@@ -48,6 +48,82 @@ export type LogFilterQuery = {
     date_to: LogDateTo;
 }
 
+export type LogQueryParams = {
+    server_names?: string;
+    levels?: string;
+    since?: number;
+    until?: number;
+    caller?: string;
+    user_id?: string;
+    request_id?: string;
+}
+
+export type LogLevelOverride = {
+    module: 'ldap' | 'remote_cluster' | 'shared_channels';
+    level: 'error' | 'warn' | 'info' | 'debug' | 'trace';
+    duration_minutes?: number;
+    expire_at?: number;
+}
+
+export type SlowOperation = {
+    kind: 'query' | 'request';
+    query?: string;
+    method?: string;
+    route?: string;
+    status_code?: string;
+    elapsed: number;
+    create_at: number;
+}
+
+export type SlowOperationsInterval = {
+    start_at: number;
+    end_at: number;
+    queries: SlowOperation[];
+    requests: SlowOperation[];
+}
+
+export type SlowOperations = {
+    enabled: boolean;
+    current: SlowOperationsInterval;
+    previous?: SlowOperationsInterval;
+}
+
+export type DataSeedRequest = {
+    teams: number;
+    channels_per_team: number;
+    users: number;
+    posts: number;
+    reply_percentage: number;
+    days_of_history?: number;
+    seed?: number;
+    password: string;
+}
+
+export type DataSeedStatus = {
+    request: Omit<DataSeedRequest, 'password'>;
+    prefix: string;
+    start_at: number;
+    complete_at: number;
+    teams: number;
+    channels: number;
+    users: number;
+    posts: number;
+    canceled: boolean;
+    error?: string;
+}
+
+export type ProfileRequest = {
+    type: 'cpu' | 'heap' | 'goroutine' | 'trace';
+    duration_seconds?: number;
+}
+
+export type Profile = {
+    name: string;
+    type: string;
+    size: number;
+    create_at: number;
+}
+
 export type AdminState = {
     logs: LogObject[];
     plainLogs: string[];
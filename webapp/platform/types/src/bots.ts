@@ -10,6 +10,12 @@ export type Bot = {
     create_at: number ;
     update_at: number ;
     delete_at: number ;
+    scope?: BotScope ;
+}
+
+export type BotScope = {
+    team_ids: string[];
+    channel_ids: string[];
 }
 
 // BotPatch is a description of what fields to update on an existing bot.
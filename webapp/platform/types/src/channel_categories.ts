@@ -32,6 +32,16 @@ export type OrderedChannelCategories = {
     order: string[];
 };
 
+export type ChannelCategoryUnread = {
+    category_id: ChannelCategory['id'];
+    team_id: Team['id'];
+    msg_count: number;
+    msg_count_root: number;
+    mention_count: number;
+    mention_count_root: number;
+    urgent_mention_count: number;
+};
+
 export type ChannelCategoriesState = {
     byId: IDMappedObjects<ChannelCategory>;
     orderByTeam: RelationOneToOne<Team, Array<ChannelCategory['id']>>;
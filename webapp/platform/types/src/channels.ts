@@ -30,6 +30,19 @@ export type ChannelNotifyProps = {
     push_threads: 'default' | 'all' | 'mention' | 'none';
     ignore_channel_mentions: 'default' | 'off' | 'on';
     channel_auto_follow_threads: 'off' | 'on';
+
+    /** JSON encoded ChannelQuietHours */
+    quiet_hours?: string;
+
+    /** Comma separated keywords that don't trigger notifications */
+    muted_keywords?: string;
+};
+
+export type ChannelQuietHours = {
+    enabled: boolean;
+    start: string;
+    end: string;
+    days?: number[];
 };
 
 export type Channel = {
@@ -37,3 +37,29 @@ export type FetchPaginatedThreadOptions = {
     fromCreateAt?: number;
     fromPost?: string;
 }
+
+export type BatchOperation = {
+    name: string;
+    path: string;
+};
+
+export type BatchRequest = {
+    operations: BatchOperation[];
+};
+
+export type BatchResult<T = unknown> = {
+    name: string;
+    status_code: number;
+    data?: T;
+    error?: {
+        id: string;
+        message: string;
+        detailed_error?: string;
+        request_id?: string;
+        status_code: number;
+    };
+};
+
+export type BatchResponse = {
+    results: BatchResult[];
+};
@@ -26,6 +26,8 @@ export type ClientConfig = {
     CustomDescriptionText: string;
     CustomTermsOfServiceId: string;
     CustomTermsOfServiceReAcceptancePeriod: string;
+    CustomTermsOfServiceGracePeriod: string;
+    CustomTermsOfServiceAudience: string;
     CustomUrlSchemes: string;
     CWSURL: string;
     CWSMock: string;
@@ -108,6 +110,7 @@ export type ClientConfig = {
     EnableUserCreation: string;
     EnableUserDeactivation: string;
     EnableUserTypingMessages: string;
+    EnableWebPushNotifications: string;
     EnforceMultifactorAuthentication: string;
     ExperimentalClientSideCertCheck: string;
     ExperimentalClientSideCertEnable: string;
@@ -211,6 +214,8 @@ export type ClientConfig = {
     ServiceEnvironment: string;
     UniqueEmojiReactionLimitPerPost: string;
     UsersStatusAndProfileFetchingPollIntervalMilliseconds: string;
+    EnableMaintenanceMode: string;
+    MaintenanceModeMessage: string;
 };
 
 export type License = {
@@ -309,6 +314,11 @@ export type ServiceSettings = {
     EnableOutgoingOAuthConnections: boolean;
     EnableCommands: boolean;
     OutgoingIntegrationRequestsTimeout: number;
+    EnableIntegrationDeliveryLog: boolean;
+    IntegrationDeliveryLogRetentionDays: number;
+    IncomingWebhookRateLimitPerMinute: number;
+    ValidateIncomingWebhookAttachments: boolean;
+    EnableAutomationRules: boolean;
     EnablePostUsernameOverride: boolean;
     EnablePostIconOverride: boolean;
     EnableLinkPreviews: boolean;
@@ -325,6 +335,7 @@ export type ServiceSettings = {
     EnableMultifactorAuthentication: boolean;
     EnforceMultifactorAuthentication: boolean;
     EnableUserAccessTokens: boolean;
+    UserAccessTokenGracePeriodMinutes: number;
     AllowCorsFrom: string;
     CorsExposedHeaders: string;
     CorsAllowCredentials: boolean;
@@ -348,6 +359,7 @@ export type ServiceSettings = {
     GiphySdkKey: string;
     PostEditTimeLimit: number;
     TimeBetweenUserTypingUpdatesMilliseconds: number;
+    TypingAggregationWindowMilliseconds: number;
     EnablePostSearch: boolean;
     EnableFileSearch: boolean;
     MinimumHashtagLength: number;
@@ -364,6 +376,7 @@ export type ServiceSettings = {
     EnableAPITeamDeletion: boolean;
     EnableAPITriggerAdminNotifications: boolean;
     EnableAPIUserDeletion: boolean;
+    EnableAPIDataSeeding: boolean;
     ExperimentalEnableHardenedMode: boolean;
     ExperimentalStrictCSRFEnforcement: boolean;
     EnableEmailInvitations: boolean;
@@ -394,6 +407,9 @@ export type ServiceSettings = {
     UniqueEmojiReactionLimitPerPost: number;
     RefreshPostStatsRunTime: string;
     MaximumPayloadSizeBytes: number;
+    EnableMaintenanceMode: boolean;
+    MaintenanceModeMessage: string;
+    MaintenanceModeRetryAfterSeconds: number;
 };
 
 export type TeamSettings = {
@@ -488,6 +504,8 @@ export type NotificationLogSettings = {
     FileLocation: string;
     AdvancedLoggingConfig: string;
     AdvancedLoggingJSON: Record<string, any>;
+    EnableDeliveryHistory: boolean;
+    DeliveryHistoryRetentionDays: number;
 };
 
 export type PasswordSettings = {
@@ -570,6 +588,9 @@ export type EmailSettings = {
     SMTPServer: string;
     SMTPPort: string;
     SMTPServerTimeout: number;
+    SMTPMaxConnections: number;
+    SMTPMaxMessagesPerSecond: number;
+    SMTPMaxRetries: number;
     ConnectionSecurity: string;
     SendPushNotifications: boolean;
     PushNotificationServer: string;
@@ -577,12 +598,19 @@ export type EmailSettings = {
     PushNotificationServerLocation: 'us' | 'de';
     PushNotificationContents: string;
     PushNotificationBuffer: number;
+    EnableWebPushNotifications: boolean;
     EnableEmailBatching: boolean;
     EmailBatchingBufferSize: number;
     EmailBatchingInterval: number;
     EnablePreviewModeBanner: boolean;
     SkipServerCertificateVerification: boolean;
     EmailNotificationContentsType: string;
+    EnableReplyByEmail: boolean;
+    ReplyByEmailAddress: string;
+    InboundEmailSecret: string;
+    EnableBounceHandling: boolean;
+    EmailEventsSecret: string;
+    EmailTemplateOverridesDirectory: string;
     LoginButtonColor: string;
     LoginButtonBorderColor: string;
     LoginButtonTextColor: string;
@@ -613,6 +641,7 @@ export type SupportSettings = {
     SupportEmail: string;
     CustomTermsOfServiceEnabled: boolean;
     CustomTermsOfServiceReAcceptancePeriod: number;
+    CustomTermsOfServiceGracePeriod: number;
     EnableAskCommunityLink: boolean;
 };
 
@@ -686,6 +715,7 @@ export type LdapSettings = {
     PositionAttribute: string;
     LoginIdAttribute: string;
     PictureAttribute: string;
+    ManagerAttribute: string;
     SyncIntervalMinutes: number;
     SkipCertificateVerification: boolean;
     PublicCertificateFile: string;
@@ -917,6 +947,68 @@ export type CloudSettings = {
 
 export type FeatureFlags = Record<string, string | boolean>;
 
+export type FeatureFlagRule = {
+    Value: string;
+    Percentage: number;
+    TeamIds: string[];
+    UserIds: string[];
+};
+
+export type FeatureFlagSettings = {
+    Rules: Record<string, FeatureFlagRule>;
+};
+
+export type FeatureFlagStatus = {
+    name: string;
+    value: string;
+    rule: FeatureFlagRule | null;
+};
+
+export type MaintenanceMode = {
+    enabled: boolean;
+    message: string;
+    retry_after_seconds: number;
+};
+
+export type DependencyHealth = {
+    name: string;
+    status: string;
+    latency_ms: number;
+    error?: string;
+};
+
+export type SystemHealth = {
+    status: string;
+    dependencies: DependencyHealth[];
+};
+
+export type DrainStatus = {
+    draining: boolean;
+    start_at: number;
+    deadline: number;
+    complete_at: number;
+    initial_websocket_connections: number;
+    websocket_connections: number;
+    jobs_stopped: boolean;
+};
+
+export type TracingSettings = {
+    Enable: boolean;
+    ServiceName: string;
+    Endpoint: string;
+    Protocol: string;
+    Insecure: boolean;
+    SamplePercentage: number;
+};
+
+export type SlowOperationSettings = {
+    Enable: boolean;
+    QueryThresholdMilliseconds: number;
+    RequestThresholdMilliseconds: number;
+    MaxEntries: number;
+    IntervalMinutes: number;
+};
+
 export type ImportSettings = {
     Directory: string;
     RetentionDays: number;
@@ -971,6 +1063,9 @@ export type AdminConfig = {
     ImportSettings: ImportSettings;
     ExportSettings: ExportSettings;
     WranglerSettings: WranglerSettings;
+    FeatureFlagSettings: FeatureFlagSettings;
+    TracingSettings: TracingSettings;
+    SlowOperationSettings: SlowOperationSettings;
 };
 
 export type ReplicaLagSetting = {
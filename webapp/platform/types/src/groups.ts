@@ -14,9 +14,14 @@ export type SyncablePatch = {
     auto_add: boolean;
 };
 
+export type GroupMentionPolicy = '' | 'anyone' | 'members' | 'admins';
+
 export type GroupPatch = {
     allow_reference: boolean;
     name?: string;
+    mention_policy?: GroupMentionPolicy;
+    mention_cooldown_seconds?: number;
+    mention_member_limit?: number;
 };
 
 export type CustomGroupPatch = {
@@ -41,6 +46,10 @@ export type Group = {
     channel_member_count?: number;
     channel_member_timezones_count?: number;
     member_ids?: string[];
+    mention_policy?: GroupMentionPolicy;
+    mention_cooldown_seconds?: number;
+    mention_member_limit?: number;
+    last_mention_at?: number;
 };
 
 export enum GroupSource {
@@ -17,6 +17,7 @@ export type IncomingWebhook = {
     username: string;
     icon_url: string;
     channel_locked: boolean;
+    rate_limit_per_minute?: number;
 };
 
 export type OutgoingWebhook = {
@@ -36,6 +37,7 @@ export type OutgoingWebhook = {
     content_type: string;
     username: string;
     icon_url: string;
+    signing_secret?: string;
 };
 
 export type Command = {
@@ -56,6 +58,23 @@ export type Command = {
     'display_name': string;
     'description': string;
     'url': string;
+    'signing_secret'?: string;
+    'autocomplete_data'?: CommandAutocompleteData;
+};
+
+export type CommandAutocompleteData = {
+    Trigger: string;
+    Hint: string;
+    HelpText: string;
+    RoleID: string;
+    Arguments: Array<{
+        Name: string;
+        HelpText: string;
+        Type: 'TextInput' | 'StaticList' | 'DynamicList';
+        Required: boolean;
+        Data: unknown;
+    }>;
+    SubCommands: CommandAutocompleteData[];
 };
 
 export type CommandArgs = {
@@ -90,6 +109,34 @@ export type AutocompleteSuggestion = {
 
 export type CommandAutocompleteSuggestion = AutocompleteSuggestion; // TODO remove this alias after the mattermost-redux migration
 
+export type AutomationTrigger = {
+    type: 'keyword_posted' | 'user_joined_channel' | 'reaction_added';
+    channel_id?: string;
+    keywords?: string[];
+    emoji_name?: string;
+};
+
+export type AutomationAction = {
+    type: 'post_message' | 'add_to_channel' | 'call_webhook' | 'create_playbook_run';
+    channel_id?: string;
+    message?: string;
+    url?: string;
+    playbook_id?: string;
+};
+
+export type AutomationRule = {
+    id: string;
+    team_id: string;
+    creator_id: string;
+    display_name: string;
+    enabled: boolean;
+    trigger: AutomationTrigger;
+    actions: AutomationAction[];
+    create_at: number;
+    update_at: number;
+    delete_at: number;
+};
+
 export type OAuthApp = {
     'id': string;
     'creator_id': string;
@@ -156,6 +203,7 @@ export type DialogSubmission = {
         [x: string]: string;
     };
     cancelled: boolean;
+    type?: 'dialog_submission' | 'refresh';
 };
 
 export type DialogElement = {
@@ -170,6 +218,8 @@ export type DialogElement = {
     min_length: number;
     max_length: number;
     data_source: string;
+    data_source_url?: string;
+    refresh?: boolean;
     options: Array<{
         text: string;
         value: any;
@@ -179,4 +229,24 @@ export type DialogElement = {
 export type SubmitDialogResponse = {
     error?: string;
     errors?: Record<string, string>;
+    type?: 'form';
+    form?: Dialog;
+};
+
+export type DialogLookupRequest = {
+    url: string;
+    callback_id: string;
+    state: string;
+    channel_id: string;
+    team_id: string;
+    submission: Record<string, unknown>;
+    name: string;
+    query: string;
+};
+
+export type DialogLookupResponse = {
+    items: Array<{
+        text: string;
+        value: string;
+    }>;
 };
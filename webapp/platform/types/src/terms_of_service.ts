@@ -6,4 +6,6 @@ export type TermsOfService = {
     create_at: number;
     user_id: string;
     text: string;
+    version: number;
+    audience: '' | 'guests' | 'members';
 }
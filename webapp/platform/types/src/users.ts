@@ -58,6 +58,8 @@ export type UserProfile = {
     terms_of_service_create_at: number;
     remote_id?: string;
     status?: string;
+    manager_id?: string;
+    custom_profile_attributes?: Record<string, string>;
 };
 
 export type UserProfileWithLastViewAt = UserProfile & {
@@ -118,12 +120,35 @@ export type UserCustomStatus = {
     expires_at?: string;
 };
 
+export type UserTimedStatus = {
+    user_id: string;
+    status: 'dnd' | 'ooo';
+    end_time: number;
+    custom_status?: Pick<UserCustomStatus, 'emoji' | 'text'>;
+};
+
+export type CustomProfileAttributeField = {
+    id: string;
+    name: string;
+    type: 'text' | 'select' | 'url';
+    options: string[];
+    validation_regex: string;
+    visibility: 'always' | 'hidden';
+    ldap_attribute: string;
+    saml_attribute: string;
+    sort_order: number;
+    create_at: number;
+    update_at: number;
+    delete_at: number;
+};
+
 export type UserAccessToken = {
     id: string;
     token?: string;
     user_id: string;
     description: string;
     is_active: boolean;
+    expires_at?: number;
 };
 
 export type UsersStats = {